@@ -16,6 +16,9 @@ import (
 const (
 	interfacePkg     = "vocab"
 	resolverPkg      = "resolver"
+	graphQLPkg       = "graphql"
+	namesPkg         = "names"
+	registryPkg      = "registry"
 	typePropertyName = "type"
 )
 
@@ -217,9 +220,14 @@ const (
 // The specifications' generated code contains both interfaces and
 // implementations. Developers' applications should only rely on the interfaces,
 // which are used internally anyway.
+//
+// If GenerateGraphQL is set, a "graphql" package is additionally generated
+// under the root package. It contains a GraphQL schema and resolver shims for
+// applications exposing their data through GraphQL.
 type Converter struct {
 	GenRoot               *gen.PackageManager
 	PackagePolicy         PackagePolicy
+	GenerateGraphQL       bool
 	typeProperty          *gen.PropertyGenerator
 	typePropertyVocabName string
 }
@@ -260,6 +268,13 @@ func (c *Converter) Convert(p *rdf.ParsedVocabulary) (f []*File, e error) {
 	for k, rv := range recurV {
 		v.References[k] = rv
 	}
+	// Types whose properties can only hold values of this vocabulary
+	// have their JSON-LD context determined now.
+	if !hasReferencedTypesOrProperties(v.References) {
+		for _, t := range v.Types {
+			t.SetOwnContextOnly()
+		}
+	}
 	// Step 3: Create code-wide generators
 	e = c.convertGenRoot(&v)
 	if e != nil {
@@ -271,6 +286,18 @@ func (c *Converter) Convert(p *rdf.ParsedVocabulary) (f []*File, e error) {
 	return
 }
 
+// hasReferencedTypesOrProperties returns true if any of the referenced
+// vocabularies defines types or properties, which the types of the vocabulary
+// may then hold, rather than only values.
+func hasReferencedTypesOrProperties(refs map[string]*vocabulary) bool {
+	for _, ref := range refs {
+		if len(ref.Types) > 0 || len(ref.FProps) > 0 || len(ref.NFProps) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // convertReferenceVocabularyRecursively will convert all references nested in
 // all vocabularies and results in a flattened converted map.
 func (c *Converter) convertReferenceVocabularyRecursively(skip map[string]bool, p *rdf.ParsedVocabulary, refs map[string]*vocabulary) (v map[string]*vocabulary, e error) {
@@ -375,18 +402,58 @@ func (c *Converter) convertToFiles(v vocabulary) (f []*File, e error) {
 		FileName:  "gen_doc.go",
 		Directory: pub.WriteDir(),
 	})
+	// Shallow View
+	viewFile := jen.NewFilePath(pub.Path())
+	viewFile.Add(gen.ShallowViewDefinition(pub, v.allTypeArray()).Definition())
+	f = append(f, &File{
+		F:         viewFile,
+		FileName:  "gen_shallow_view.go",
+		Directory: pub.WriteDir(),
+	})
+	// Activity Pairings
+	pairingDefs, pairingFn := gen.ActivityPairingDefinitions(pub, v.allTypeArray())
+	pairingFile := jen.NewFilePath(pub.Path())
+	for _, def := range pairingDefs {
+		pairingFile.Add(def).Line()
+	}
+	pairingFile.Add(pairingFn.Definition())
+	f = append(f, &File{
+		F:         pairingFile,
+		FileName:  "gen_activity_pairings.go",
+		Directory: pub.WriteDir(),
+	})
 	// Constants
 	files, e = c.constFiles(c.GenRoot.PublicPackage(), v.allTypeArray(), v.allPropArray())
 	if e != nil {
 		return
 	}
 	f = append(f, files...)
+	// Names
+	files, e = c.namesFiles(c.GenRoot.SubPublic(namesPkg).PublicPackage(), v.allTypeArray(), v.allPropArray())
+	if e != nil {
+		return
+	}
+	f = append(f, files...)
+	// Registry
+	files, e = c.registryFiles(c.GenRoot.SubPublic(registryPkg).PublicPackage(), v.Manager)
+	if e != nil {
+		return
+	}
+	f = append(f, files...)
 	// Resolvers
 	files, e = c.resolverFiles(c.GenRoot.PublicPackage(), v.Manager, v)
 	if e != nil {
 		return
 	}
 	f = append(f, files...)
+	// GraphQL
+	if c.GenerateGraphQL {
+		files, e = c.graphQLFiles(c.GenRoot.SubPublic(graphQLPkg).PublicPackage(), v.allTypeArray())
+		if e != nil {
+			return
+		}
+		f = append(f, files...)
+	}
 	return
 }
 
@@ -648,6 +715,13 @@ func (c *Converter) convertType(t rdf.VocabularyType,
 		rangeProps,
 		ext,
 		disjoint)
+	if e != nil {
+		return
+	}
+	// Alternate spellings of this type, accepted when deserializing.
+	for _, same := range t.SameAs {
+		tg.AddAlias(same.Name)
+	}
 	return
 }
 
@@ -877,7 +951,7 @@ func (c *Converter) packageManager(s, vocabName string) (pkg *gen.PackageManager
 // are the ones typically used by developers.
 func (c *Converter) rootFiles(pkg gen.Package, vocabName string, v vocabulary, m *gen.ManagerGenerator) (f []*File, e error) {
 	pg := gen.NewPackageGenerator(c.typePropertyVocabName, m, c.typeProperty)
-	typeCtors, propCtors, ext, disj, extBy, isA := pg.RootDefinitions(vocabName, v.typeArray(), v.propArray())
+	typeCtors, propCtors, ext, disj, extBy, isA, to := pg.RootDefinitions(vocabName, v.typeArray(), v.propArray())
 	lowerVocabName := strings.ToLower(vocabName)
 	if file := funcsToFile(pkg, typeCtors, fmt.Sprintf("gen_pkg_%s_type_constructors.go", lowerVocabName)); file != nil {
 		f = append(f, file)
@@ -897,6 +971,13 @@ func (c *Converter) rootFiles(pkg gen.Package, vocabName string, v vocabulary, m
 	if file := funcsToFile(pkg, isA, fmt.Sprintf("gen_pkg_%s_isorextends.go", lowerVocabName)); file != nil {
 		f = append(f, file)
 	}
+	if file := funcsToFile(pkg, to, fmt.Sprintf("gen_pkg_%s_to.go", lowerVocabName)); file != nil {
+		f = append(f, file)
+	}
+	views := gen.ShallowViewFunctions(pkg, v.typeArray())
+	if file := funcsToFile(pkg, views, fmt.Sprintf("gen_pkg_%s_shallow_views.go", lowerVocabName)); file != nil {
+		f = append(f, file)
+	}
 	return
 }
 
@@ -1171,6 +1252,60 @@ func (c *Converter) constFiles(pkg gen.Package, types []*gen.TypeGenerator, prop
 	return files, e
 }
 
+// namesFiles creates the files for the typed name constants.
+func (c *Converter) namesFiles(pkg gen.Package, types []*gen.TypeGenerator, props []*gen.PropertyGenerator) (files []*File, e error) {
+	names := gen.GenerateNames(types, props)
+	file := jen.NewFilePath(pkg.Path())
+	file.PackageComment(gen.NamesPackageComment(pkg.Name()))
+	for _, elem := range names {
+		file.Add(elem).Line()
+	}
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_names.go",
+		Directory: pkg.WriteDir(),
+	})
+	return files, e
+}
+
+// registryFiles creates the files for the Registry of the types an Application
+// handles.
+func (c *Converter) registryFiles(pkg gen.Package, m *gen.ManagerGenerator) (files []*File, e error) {
+	defs, registry, fns := gen.RegistryDefinitions(pkg, m, c.typeProperty)
+	file := jen.NewFilePath(pkg.Path())
+	file.PackageComment(gen.RegistryPackageComment(pkg.Name()))
+	for _, def := range defs {
+		file.Add(def).Line()
+	}
+	file.Add(registry.Definition()).Line()
+	for _, fn := range fns {
+		file.Add(fn.Definition()).Line()
+	}
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_registry.go",
+		Directory: pkg.WriteDir(),
+	})
+	return files, e
+}
+
+// graphQLFiles creates the files for the GraphQL schema and resolver shims.
+func (c *Converter) graphQLFiles(pkg gen.Package, types []*gen.TypeGenerator) (files []*File, e error) {
+	schema, fns := gen.GraphQLDefinitions(pkg, types)
+	file := jen.NewFilePath(pkg.Path())
+	file.PackageComment(gen.GraphQLPackageComment(pkg.Name()))
+	file.Add(schema).Line()
+	for _, fn := range fns {
+		file.Add(fn.Definition()).Line()
+	}
+	files = append(files, &File{
+		F:         file,
+		FileName:  "gen_graphql.go",
+		Directory: pkg.WriteDir(),
+	})
+	return files, e
+}
+
 // allExtendsAreIn determines if a VocabularyType's parents are all already
 // converted to a TypeGenerator.
 func (c *Converter) allExtendsAreIn(registry *rdf.RDFRegistry, t rdf.VocabularyType, v map[string]*gen.TypeGenerator, genRefs map[string]*vocabulary) bool {
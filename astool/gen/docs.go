@@ -195,3 +195,47 @@ func PrivateIndividualPropertyPackageComment(pkgName, propertyName string) strin
 		"package.",
 		pkgName, propertyName))
 }
+
+func GraphQLPackageComment(pkgName string) string {
+	return codegen.FormatPackageDocumentation(fmt.Sprintf("Package %s "+
+		"contains a GraphQL schema and resolver shims for the "+
+		"generated ActivityStreams types. This package is "+
+		"code-generated and subject to the same license as the "+
+		"go-fed tool used to generate it.\n\n"+
+		"Applications exposing their data through a GraphQL server "+
+		"can load the Schema into their GraphQL library of choice "+
+		"and use the resolver shims to convert a type into the map "+
+		"of its GraphQL fields. Properties that cannot be "+
+		"represented by a single GraphQL scalar are exposed as the "+
+		"JSON scalar, containing their serialized ActivityStreams "+
+		"value.",
+		pkgName))
+}
+
+func NamesPackageComment(pkgName string) string {
+	return codegen.FormatPackageDocumentation(fmt.Sprintf("Package %s "+
+		"contains typed constants for the names of the generated "+
+		"ActivityStreams types and properties, as well as the "+
+		"context IRIs of their vocabularies. This package is "+
+		"code-generated and subject to the same license as the "+
+		"go-fed tool used to generate it.\n\n"+
+		"Applications should prefer these constants over string "+
+		"literals in switch statements, database queries, and "+
+		"anywhere else a name is compared.",
+		pkgName))
+}
+
+func RegistryPackageComment(pkgName string) string {
+	return codegen.FormatPackageDocumentation(fmt.Sprintf("Package %s "+
+		"contains a Registry of only the ActivityStreams types an "+
+		"Application handles. This package is code-generated and "+
+		"subject to the same license as the go-fed tool used to "+
+		"generate it.\n\n"+
+		"Importing the root package initializes the implementation "+
+		"of every type and property, and links all of them into the "+
+		"binary. Applications in constrained deployments may "+
+		"instead create a Registry, register the types they handle, "+
+		"and deserialize values with it, without importing the root "+
+		"package or any package importing it.",
+		pkgName))
+}
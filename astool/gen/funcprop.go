@@ -116,7 +116,7 @@ func (p *FunctionalPropertyGenerator) singleTypeClearNonLanguageMapMembers() []j
 	} else {
 		clearCode = append(clearCode, jen.Id(codegen.This()).Dot(p.hasMemberName(0)).Op("=").False())
 	}
-	return clearCode
+	return append(clearCode, p.clearDeferredMembers()...)
 }
 
 // multiTypeClearNonLanguageMapMembers generates code to clear all members for
@@ -134,7 +134,19 @@ func (p *FunctionalPropertyGenerator) multiTypeClearNonLanguageMapMembers() []je
 	if !p.hasURIKind() {
 		clearLine = append(clearLine, jen.Id(codegen.This()).Dot(iriMember).Op("=").Nil())
 	}
-	return clearLine
+	return append(clearLine, p.clearDeferredMembers()...)
+}
+
+// clearDeferredMembers generates the code to discard a value that has not yet
+// been deserialized, so that it is not resolved after a new value is set.
+func (p *FunctionalPropertyGenerator) clearDeferredMembers() []jen.Code {
+	if !p.defersTypes() {
+		return nil
+	}
+	return []jen.Code{
+		jen.Id(codegen.This()).Dot(rawMemberName).Op("=").Nil(),
+		jen.Id(codegen.This()).Dot(rawAliasMapMemberName).Op("=").Nil(),
+	}
 }
 
 // funcs produces the methods needed for the functional property.
@@ -155,10 +167,8 @@ func (p *FunctionalPropertyGenerator) funcs() []*codegen.Method {
 		))
 	methods := []*codegen.Method{
 		p.contextMethod(),
-		codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
+		p.newReadMethod(
 			kindIndexMethod,
-			p.StructName(),
 			/*params=*/ nil,
 			[]jen.Code{jen.Int()},
 			[]jen.Code{
@@ -184,10 +194,8 @@ func (p *FunctionalPropertyGenerator) funcs() []*codegen.Method {
 		}
 		// GetType
 		methods = append(methods,
-			codegen.NewCommentedValueMethod(
-				p.GetPrivatePackage().Path(),
+			p.newReadMethod(
 				fmt.Sprintf("Get%s", typeInterfaceName),
-				p.StructName(),
 				/*params=*/ nil,
 				// Requires the property and type public path to be the same.
 				[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), typeInterfaceName)},
@@ -230,13 +238,55 @@ func (p *FunctionalPropertyGenerator) funcs() []*codegen.Method {
 				},
 				fmt.Sprintf("Set%s attempts to set the property for the arbitrary type. Returns an error if it is not a valid type to set on this property.", typeInterfaceName)))
 	}
+	// String Method
+	var stringType jen.Code = jen.Empty()
+	if p.hasTypeKind() {
+		stringType = jen.If(
+			jen.Id("t").Op(":=").Id(codegen.This()).Dot(fmt.Sprintf("Get%s", typeInterfaceName)).Call(),
+			jen.Id("t").Op("!=").Nil(),
+		).Block(
+			jen.If(
+				jen.Id("id").Op(":=").Id("t").Dot(getIdFunction).Call(),
+				jen.Id("id").Op("!=").Nil().Op("&&").Id("id").Dot(getMethod).Call().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Id("t").Dot(typeNameMethod).Call().Op("+").Lit("(").Op("+").Id("id").Dot(getMethod).Call().Dot("String").Call().Op("+").Lit(")")),
+			),
+			jen.Return(jen.Id("t").Dot(typeNameMethod).Call()),
+		)
+	}
+	methods = append(methods, p.newReadMethod(
+		stringMethod,
+		/*params=*/ nil,
+		[]jen.Code{jen.String()},
+		[]jen.Code{
+			jen.If(jen.Id(codegen.This()).Dot(isIRIMethod).Call()).Block(
+				jen.Return(jen.Id(codegen.This()).Dot(getIRIMethod).Call().Dot("String").Call()),
+			),
+			stringType,
+			jen.List(jen.Id("v"), jen.Err()).Op(":=").Id(codegen.This()).Dot(p.serializeFnName()).Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Lit("!(").Op("+").Err().Dot("Error").Call().Op("+").Lit(")")),
+			),
+			jen.If(
+				jen.List(jen.Id("s"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.String()),
+				jen.Id("ok"),
+			).Block(
+				jen.If(
+					jen.Id("r").Op(":=").Index().Rune().Call(jen.Id("s")),
+					jen.Len(jen.Id("r")).Op(">").Lit(maxStringRunes),
+				).Block(
+					jen.Id("s").Op("=").String().Call(jen.Id("r").Index(jen.Empty(), jen.Lit(maxStringRunes-3))).Op("+").Lit("..."),
+				),
+				jen.Return(jen.Qual("strconv", "Quote").Call(jen.Id("s"))),
+			),
+			jen.Return(jen.Qual("fmt", "Sprint").Call(jen.Id("v"))),
+		},
+		fmt.Sprintf("%s returns a compact, human-readable form of the value of this property for logs and debugging: an IRI, a quoted string shortened to %d characters, the name and id of a type, or another value as printed by fmt. It is not a serialization.", stringMethod, maxStringRunes)))
 	if p.hasNaturalLanguageMap {
 		// HasLanguage Method
 		methods = append(methods,
-			codegen.NewCommentedValueMethod(
-				p.GetPrivatePackage().Path(),
+			p.newReadMethod(
 				hasLanguageMethod,
-				p.StructName(),
 				[]jen.Code{jen.Id("bcp47").String()},
 				[]jen.Code{jen.Bool()},
 				[]jen.Code{
@@ -261,10 +311,8 @@ func (p *FunctionalPropertyGenerator) funcs() []*codegen.Method {
 			))
 		// GetLanguage Method
 		methods = append(methods,
-			codegen.NewCommentedValueMethod(
-				p.GetPrivatePackage().Path(),
+			p.newReadMethod(
 				getLanguageMethod,
-				p.StructName(),
 				[]jen.Code{jen.Id("bcp47").String()},
 				[]jen.Code{jen.String()},
 				[]jen.Code{
@@ -366,22 +414,22 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 			),
 		)
 	}
-	serialize := codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	serialize := p.newReadMethod(
 		p.serializeFnName(),
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Interface(), jen.Error()},
-		[]jen.Code{serializeFns, jen.Return(
+		p.resolved(serializeFns, jen.Return(
 			jen.Id(codegen.This()).Dot(unknownMemberName),
 			jen.Nil(),
-		)},
+		)),
 		fmt.Sprintf("%s converts this into an interface representation suitable for marshalling into a text or binary format. Applications should not need this function as most typical use cases serialize types instead of individual properties. It is exposed for alternatives to go-fed implementations to use.", p.serializeFnName()))
 	valueDeserializeFns := jen.Empty()
-	typeDeserializeFns := jen.Empty()
 	foundValue := false
-	foundType := false
 	for i, kind := range p.kinds {
+		if !kind.isValue() {
+			// Types are deserialized when first accessed.
+			continue
+		}
 		values := jen.Dict{
 			jen.Id(p.memberName(i)): jen.Id("v"),
 			jen.Id(aliasMember):     jen.Id("alias"),
@@ -390,20 +438,14 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 			values[jen.Id(p.hasMemberName(i))] = jen.True()
 		}
 		tmp := jen.Empty()
-		if kind.isValue() && foundValue {
+		if foundValue {
 			tmp = tmp.Else()
-		} else if !kind.isValue() && foundType {
-			tmp = tmp.Else()
-		}
-		variable := jen.Id("i")
-		if !kind.isValue() {
-			variable = jen.Id("m")
 		}
 		tmp = tmp.If(
 			jen.List(
 				jen.Id("v"),
 				jen.Err(),
-			).Op(":=").Add(kind.deserializeFnCode(variable, jen.Id("aliasMap"))),
+			).Op(":=").Add(kind.deserializeFnCode(jen.Id("i"), jen.Id("aliasMap"))),
 			jen.Err().Op("==").Nil(),
 		).Block(
 			jen.Id(codegen.This()).Op(":=").Op("&").Id(p.StructName()).Values(
@@ -414,13 +456,8 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 				jen.Nil(),
 			),
 		)
-		if kind.isValue() {
-			foundValue = true
-			valueDeserializeFns = valueDeserializeFns.Add(tmp)
-		} else {
-			foundType = true
-			typeDeserializeFns = typeDeserializeFns.Add(tmp)
-		}
+		foundValue = true
+		valueDeserializeFns = valueDeserializeFns.Add(tmp)
 	}
 	mapProperty := jen.Empty()
 	if p.hasNaturalLanguageMap {
@@ -454,7 +491,7 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 				).Block(
 					jen.Id("alias").Op("=").Id("a"),
 				),
-				p.wrapDeserializeCode(valueDeserializeFns, typeDeserializeFns),
+				p.wrapDeserializeCode(valueDeserializeFns),
 			},
 			fmt.Sprintf("%s creates an iterator from an element that has been unmarshalled from a text or binary format.", p.DeserializeFnName()))
 	} else {
@@ -493,7 +530,7 @@ func (p *FunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *co
 				),
 				mapProperty,
 				jen.If(jen.Id("ok")).Block(
-					p.wrapDeserializeCode(valueDeserializeFns, typeDeserializeFns),
+					p.wrapDeserializeCode(valueDeserializeFns),
 				),
 				jen.Return(
 					jen.Nil(),
@@ -529,6 +566,7 @@ func (p *FunctionalPropertyGenerator) singleTypeDef() *codegen.Struct {
 		}
 	}
 	kindMembers = append(kindMembers, p.unknownMemberDef())
+	kindMembers = append(kindMembers, p.deferredMemberDefs()...)
 	if !p.hasURIKind() {
 		kindMembers = append(kindMembers, p.iriMemberDef())
 	}
@@ -548,6 +586,10 @@ func (p *FunctionalPropertyGenerator) singleTypeDef() *codegen.Struct {
 	methods = append(methods, p.funcs()...)
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
+	if p.defersTypes() {
+		comment += " A value that is a type is deserialized when it is first accessed."
+		methods = append(methods, p.resolveMethod())
+	}
 	return codegen.NewStruct(comment,
 		p.StructName(),
 		methods,
@@ -564,10 +606,8 @@ func (p *FunctionalPropertyGenerator) singleTypeFuncs() []*codegen.Method {
 	if !p.hasURIKind() {
 		isLine.Op("||").Id(codegen.This()).Dot(iriMember).Op("!=").Nil()
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		hasAnyMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Bool()},
 		[]jen.Code{jen.Return(isLine)},
@@ -585,30 +625,24 @@ func (p *FunctionalPropertyGenerator) singleTypeFuncs() []*codegen.Method {
 		)
 	}
 	if p.kinds[0].Nilable {
-		methods = append(methods, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
+		methods = append(methods, p.newReadMethod(
 			p.isMethodName(0),
-			p.StructName(),
 			/*params=*/ nil,
 			[]jen.Code{jen.Bool()},
-			[]jen.Code{jen.Return(jen.Id(codegen.This()).Dot(p.memberName(0)).Op("!=").Nil())},
+			p.resolved(jen.Return(jen.Id(codegen.This()).Dot(p.memberName(0)).Op("!=").Nil())),
 			hasComment,
 		))
 	} else {
-		methods = append(methods, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
+		methods = append(methods, p.newReadMethod(
 			p.isMethodName(0),
-			p.StructName(),
 			/*params=*/ nil,
 			[]jen.Code{jen.Bool()},
-			[]jen.Code{jen.Return(jen.Id(codegen.This()).Dot(p.hasMemberName(0)))},
+			p.resolved(jen.Return(jen.Id(codegen.This()).Dot(p.hasMemberName(0)))),
 			hasComment,
 		))
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		isIRIMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Bool()},
 		[]jen.Code{jen.Return(p.thisIRI().Op("!=").Nil())},
@@ -616,19 +650,15 @@ func (p *FunctionalPropertyGenerator) singleTypeFuncs() []*codegen.Method {
 	))
 	// Get Method
 	getComment := fmt.Sprintf("%s returns the value of this property. When %s returns false, %s will return any arbitrary value.", getMethod, p.isMethodName(0), getMethod)
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		p.getFnName(0),
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{p.kinds[0].ConcreteKind},
-		[]jen.Code{jen.Return(jen.Id(codegen.This()).Dot(p.memberName(0)))},
+		p.resolved(jen.Return(jen.Id(codegen.This()).Dot(p.memberName(0)))),
 		getComment,
 	))
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		getIRIMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Op("*").Qual("net/url", "URL")},
 		[]jen.Code{jen.Return(p.thisIRI())},
@@ -719,10 +749,8 @@ func (p *FunctionalPropertyGenerator) singleTypeFuncs() []*codegen.Method {
 				),
 			).Else())
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		compareLessMethod,
-		p.StructName(),
 		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
 		[]jen.Code{jen.Bool()},
 		[]jen.Code{
@@ -776,6 +804,7 @@ func (p *FunctionalPropertyGenerator) multiTypeDef() *codegen.Struct {
 		}
 	}
 	kindMembers = append(kindMembers, p.unknownMemberDef())
+	kindMembers = append(kindMembers, p.deferredMemberDefs()...)
 	if !p.hasURIKind() {
 		kindMembers = append(kindMembers, p.iriMemberDef())
 	}
@@ -806,6 +835,10 @@ func (p *FunctionalPropertyGenerator) multiTypeDef() *codegen.Struct {
 	methods = append(methods, p.funcs()...)
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
+	if p.defersTypes() {
+		comment += " A value that is a type is deserialized when it is first accessed."
+		methods = append(methods, p.resolveMethod())
+	}
 	return codegen.NewStruct(comment,
 		p.StructName(),
 		methods,
@@ -840,10 +873,8 @@ func (p *FunctionalPropertyGenerator) multiTypeFuncs() []*codegen.Method {
 			isLanguageMapMethod,
 		)
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		hasAnyMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Bool()},
 		[]jen.Code{jen.Return(join(isLine))},
@@ -888,31 +919,25 @@ func (p *FunctionalPropertyGenerator) multiTypeFuncs() []*codegen.Method {
 			)
 		}
 		if kind.Nilable {
-			methods = append(methods, codegen.NewCommentedValueMethod(
-				p.GetPrivatePackage().Path(),
+			methods = append(methods, p.newReadMethod(
 				p.isMethodName(i),
-				p.StructName(),
 				/*params=*/ nil,
 				[]jen.Code{jen.Bool()},
-				[]jen.Code{jen.Return(jen.Id(codegen.This()).Dot(p.memberName(i)).Op("!=").Nil())},
+				p.resolved(jen.Return(jen.Id(codegen.This()).Dot(p.memberName(i)).Op("!=").Nil())),
 				isComment,
 			))
 		} else {
-			methods = append(methods, codegen.NewCommentedValueMethod(
-				p.GetPrivatePackage().Path(),
+			methods = append(methods, p.newReadMethod(
 				p.isMethodName(i),
-				p.StructName(),
 				/*params=*/ nil,
 				[]jen.Code{jen.Bool()},
-				[]jen.Code{jen.Return(jen.Id(codegen.This()).Dot(p.hasMemberName(i)))},
+				p.resolved(jen.Return(jen.Id(codegen.This()).Dot(p.hasMemberName(i)))),
 				isComment,
 			))
 		}
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		isIRIMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Bool()},
 		[]jen.Code{jen.Return(p.thisIRI().Op("!=").Nil())},
@@ -977,20 +1002,16 @@ func (p *FunctionalPropertyGenerator) multiTypeFuncs() []*codegen.Method {
 	// Get Method
 	for i, kind := range p.kinds {
 		getComment := fmt.Sprintf("%s returns the value of this property. When %s returns false, %s will return an arbitrary value.", p.getFnName(i), p.isMethodName(i), p.getFnName(i))
-		methods = append(methods, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
+		methods = append(methods, p.newReadMethod(
 			p.getFnName(i),
-			p.StructName(),
 			/*params=*/ nil,
 			[]jen.Code{jen.Add(kind.ConcreteKind)},
-			[]jen.Code{jen.Return(jen.Id(codegen.This()).Dot(p.memberName(i)))},
+			p.resolved(jen.Return(jen.Id(codegen.This()).Dot(p.memberName(i)))),
 			getComment,
 		))
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		getIRIMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Op("*").Qual("net/url", "URL")},
 		[]jen.Code{jen.Return(p.thisIRI())},
@@ -1022,10 +1043,8 @@ func (p *FunctionalPropertyGenerator) multiTypeFuncs() []*codegen.Method {
 				),
 			))
 	}
-	methods = append(methods, codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	methods = append(methods, p.newReadMethod(
 		compareLessMethod,
-		p.StructName(),
 		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
 		[]jen.Code{jen.Bool()},
 		[]jen.Code{
@@ -1049,9 +1068,98 @@ func (p *FunctionalPropertyGenerator) iriMemberDef() jen.Code {
 	return jen.Id(iriMember).Op("*").Qual("net/url", "URL")
 }
 
+// deferredMemberDefs returns the definitions of the struct members that keep
+// a decoded value until it is deserialized into one of the types, if this
+// property defers doing so.
+func (p *FunctionalPropertyGenerator) deferredMemberDefs() []jen.Code {
+	if !p.defersTypes() {
+		return nil
+	}
+	return []jen.Code{
+		jen.Id(rawMemberName).Map(jen.String()).Interface(),
+		jen.Id(rawAliasMapMemberName).Map(jen.String()).String(),
+		jen.Id(resolveOnceMemberName).Qual("sync", "Once"),
+	}
+}
+
+// resolved prepends deserializing a deferred value to the code accessing the
+// members of this property, if this property defers doing so.
+func (p *FunctionalPropertyGenerator) resolved(code ...jen.Code) []jen.Code {
+	if !p.defersTypes() {
+		return code
+	}
+	return append([]jen.Code{jen.Id(codegen.This()).Dot(resolveDeferredMethod).Call()}, code...)
+}
+
+// resolveMethod returns the method deserializing the decoded value kept by the
+// deserialize function, the first time any value of this property is accessed.
+// Types are attempted before values, and the value is unknown if none match.
+func (p *FunctionalPropertyGenerator) resolveMethod() *codegen.Method {
+	resolveCode := jen.Empty()
+	found := false
+	add := func(i int, kind Kind) {
+		if found {
+			resolveCode = resolveCode.Else()
+		}
+		found = true
+		set := []jen.Code{jen.Id(codegen.This()).Dot(p.memberName(i)).Op("=").Id("v")}
+		if !kind.Nilable {
+			set = append(set, jen.Id(codegen.This()).Dot(p.hasMemberName(i)).Op("=").True())
+		}
+		resolveCode = resolveCode.If(
+			jen.List(
+				jen.Id("v"),
+				jen.Err(),
+			).Op(":=").Add(kind.deserializeFnCode(jen.Id("m"), jen.Id("aliasMap"))),
+			jen.Err().Op("==").Nil(),
+		).Block(set...)
+	}
+	for i, kind := range p.kinds {
+		if !kind.isValue() {
+			add(i, kind)
+		}
+	}
+	for i, kind := range p.kinds {
+		if kind.isValue() {
+			add(i, kind)
+		}
+	}
+	resolveCode = resolveCode.Else().Block(
+		jen.Id(codegen.This()).Dot(unknownMemberName).Op("=").Id("m"),
+	)
+	return codegen.NewCommentedPointerMethod(
+		p.GetPrivatePackage().Path(),
+		resolveDeferredMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		/*ret=*/ nil,
+		[]jen.Code{
+			jen.Id(codegen.This()).Dot(resolveOnceMemberName).Dot("Do").Call(
+				jen.Func().Params().Block(
+					jen.If(jen.Id(codegen.This()).Dot(rawMemberName).Op("==").Nil()).Block(
+						jen.Return(),
+					),
+					jen.List(
+						jen.Id("m"),
+						jen.Id("aliasMap"),
+					).Op(":=").List(
+						jen.Id(codegen.This()).Dot(rawMemberName),
+						jen.Id(codegen.This()).Dot(rawAliasMapMemberName),
+					),
+					jen.List(
+						jen.Id(codegen.This()).Dot(rawMemberName),
+						jen.Id(codegen.This()).Dot(rawAliasMapMemberName),
+					).Op("=").List(jen.Nil(), jen.Nil()),
+					resolveCode,
+				),
+			),
+		},
+		fmt.Sprintf("%s deserializes the value kept when this property was deserialized, the first time this property is accessed.", resolveDeferredMethod))
+}
+
 // wrapDeserializeCode generates the "else if it's a []byte" code and IRI code
 // used for deserializing unknown values.
-func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExisting jen.Code) *jen.Statement {
+func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting jen.Code) *jen.Statement {
 	iriCode := jen.Empty()
 	if !p.hasURIKind() {
 		iriCode = jen.If(
@@ -1090,7 +1198,18 @@ func (p *FunctionalPropertyGenerator) wrapDeserializeCode(valueExisting, typeExi
 			).Op(":=").Id("i").Assert(jen.Map(jen.String()).Interface()),
 			jen.Id("ok"),
 		).Block(
-			typeExisting,
+			jen.Commentf("Defer deserializing the value until it is first accessed."),
+			jen.Id(codegen.This()).Op(":=").Op("&").Id(p.StructName()).Values(
+				jen.Dict{
+					jen.Id(aliasMember):           jen.Id("alias"),
+					jen.Id(rawMemberName):         jen.Id("m"),
+					jen.Id(rawAliasMapMemberName): jen.Id("aliasMap"),
+				},
+			),
+			jen.Return(
+				jen.Id(codegen.This()),
+				jen.Nil(),
+			),
 		).Line()
 	}
 	if p.hasValueKind() {
@@ -1133,10 +1252,8 @@ func (p *FunctionalPropertyGenerator) contextMethod() *codegen.Method {
 			).Block(
 				jen.Id("child").Op("=").Id(codegen.This()).Dot(p.getFnName(i)).Call().Dot(contextMethod).Call()))
 	}
-	return codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	return p.newReadMethod(
 		contextMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.Map(jen.String()).String()},
 		[]jen.Code{
@@ -1218,10 +1335,8 @@ func (p *FunctionalPropertyGenerator) nameMethod() *codegen.Method {
 			),
 		)
 	}
-	return codegen.NewCommentedValueMethod(
-		p.GetPrivatePackage().Path(),
+	return p.newReadMethod(
 		nameMethod,
-		p.StructName(),
 		/*params=*/ nil,
 		[]jen.Code{jen.String()},
 		[]jen.Code{
@@ -0,0 +1,172 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"sort"
+	"strings"
+)
+
+const (
+	graphQLSchemaName     = "Schema"
+	graphQLJSONScalar     = "JSON"
+	graphQLResolveFnName  = "resolveFields"
+	graphQLLanguageMapSfx = "Map"
+)
+
+// graphQLScalars maps the names of value Kinds to the GraphQL scalar their
+// serialized form is compatible with. Values not in this map, as well as all
+// ActivityStreams types, are exposed through the JSON scalar.
+var graphQLScalars = map[string]string{
+	"anyURI":             "String",
+	"bcp47":              "String",
+	"boolean":            "Boolean",
+	"dateTime":           "String",
+	"duration":           "String",
+	"float":              "Float",
+	"nonNegativeInteger": "Int",
+	"rfc2045":            "String",
+	"rfc5988":            "String",
+	"string":             "String",
+}
+
+// graphQLField is a single field of a GraphQL object type.
+type graphQLField struct {
+	name   string
+	scalar string
+	isList bool
+}
+
+// definition returns the SDL for this field.
+func (g graphQLField) definition() string {
+	if g.isList {
+		return fmt.Sprintf("%s: [%s]", g.name, g.scalar)
+	}
+	return fmt.Sprintf("%s: %s", g.name, g.scalar)
+}
+
+// graphQLFields determines the GraphQL fields for an ActivityStreams type.
+func graphQLFields(t *TypeGenerator) (f []graphQLField) {
+	for _, prop := range t.allProperties() {
+		var kinds []Kind
+		isList := false
+		switch p := prop.(type) {
+		case *FunctionalPropertyGenerator:
+			kinds = p.GetKinds()
+		case *NonFunctionalPropertyGenerator:
+			kinds = p.GetKinds()
+			isList = true
+		}
+		f = append(f, graphQLField{
+			name:   prop.PropertyName(),
+			scalar: graphQLScalar(kinds),
+			isList: isList,
+		})
+		if prop.HasNaturalLanguageMap() {
+			f = append(f, graphQLField{
+				name:   prop.PropertyName() + graphQLLanguageMapSfx,
+				scalar: graphQLJSONScalar,
+			})
+		}
+	}
+	sort.Slice(f, func(i, j int) bool {
+		return f[i].name < f[j].name
+	})
+	return
+}
+
+// graphQLScalar determines the single GraphQL scalar able to represent all of
+// the kinds of a property, falling back to the JSON scalar.
+func graphQLScalar(kinds []Kind) string {
+	scalar := ""
+	for _, k := range kinds {
+		s, ok := graphQLScalars[k.Name.LowerName]
+		if !k.isValue() || !ok {
+			return graphQLJSONScalar
+		} else if len(scalar) > 0 && scalar != s {
+			return graphQLJSONScalar
+		}
+		scalar = s
+	}
+	if len(scalar) == 0 {
+		return graphQLJSONScalar
+	}
+	return scalar
+}
+
+// GraphQLDefinitions generates a GraphQL schema describing the provided types
+// alongside one resolver shim per type. The shims serialize the type into a
+// map whose keys are the GraphQL field names, so that they can be returned
+// as-is from a GraphQL server's resolvers.
+func GraphQLDefinitions(pkg Package, tgs []*TypeGenerator) (schema *jen.Statement, fns []*codegen.Function) {
+	var sdl []string
+	sdl = append(sdl, fmt.Sprintf("scalar %s", graphQLJSONScalar))
+	for _, tg := range tgs {
+		fields := graphQLFields(tg)
+		var defs []string
+		dict := jen.Dict{}
+		for _, field := range fields {
+			defs = append(defs, "  "+field.definition())
+			dict[jen.Lit(field.name)] = jen.Lit(field.isList)
+		}
+		sdl = append(sdl, fmt.Sprintf("type %s {\n%s\n}", tg.InterfaceName(), strings.Join(defs, "\n")))
+		fns = append(fns, codegen.NewCommentedFunction(
+			pkg.Path(),
+			tg.InterfaceName(),
+			[]jen.Code{jen.Id("t").Qual(tg.PublicPackage().Path(), tg.InterfaceName())},
+			[]jen.Code{jen.Map(jen.String()).Interface(), jen.Error()},
+			[]jen.Code{
+				jen.Return(
+					jen.Id(graphQLResolveFnName).Call(
+						jen.Id("t"),
+						jen.Map(jen.String()).Bool().Values(dict),
+					),
+				),
+			},
+			fmt.Sprintf("%s resolves the %s type into the fields of the %q GraphQL object type.", tg.InterfaceName(), tg.TypeName(), tg.InterfaceName())))
+	}
+	schema = jen.Commentf("%s is the GraphQL schema for the generated types. Values that cannot be represented by a single GraphQL scalar use the %s scalar.", graphQLSchemaName, graphQLJSONScalar).Line().Const().Id(graphQLSchemaName).Op("=").Op("`" + strings.Join(sdl, "\n\n") + "\n`")
+	if len(tgs) == 0 {
+		return
+	}
+	fns = append(fns, codegen.NewCommentedFunction(
+		pkg.Path(),
+		graphQLResolveFnName,
+		[]jen.Code{
+			jen.Id("t").Qual(tgs[0].PublicPackage().Path(), typeInterfaceName),
+			jen.Id("fields").Map(jen.String()).Bool(),
+		},
+		[]jen.Code{jen.Map(jen.String()).Interface(), jen.Error()},
+		[]jen.Code{
+			jen.List(jen.Id("m"), jen.Err()).Op(":=").Id("t").Dot(serializeMethodName).Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Id("r").Op(":=").Make(jen.Map(jen.String()).Interface(), jen.Len(jen.Id("fields"))),
+			jen.For(jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Id("m")).Block(
+				jen.Commentf("Properties from aliased vocabularies are prefixed"),
+				jen.Id("name").Op(":=").Id("k"),
+				jen.If(
+					jen.Id("i").Op(":=").Qual("strings", "LastIndex").Call(jen.Id("k"), jen.Lit(":")),
+					jen.Id("i").Op(">=").Lit(0),
+				).Block(
+					jen.Id("name").Op("=").Id("k").Index(jen.Id("i").Op("+").Lit(1).Op(":")),
+				),
+				jen.List(jen.Id("isList"), jen.Id("ok")).Op(":=").Id("fields").Index(jen.Id("name")),
+				jen.If(jen.Op("!").Id("ok")).Block(
+					jen.Continue(),
+				),
+				jen.If(
+					jen.List(jen.Id("_"), jen.Id("isSlice")).Op(":=").Id("v").Assert(jen.Index().Interface()),
+					jen.Id("isList").Op("&&").Op("!").Id("isSlice"),
+				).Block(
+					jen.Id("v").Op("=").Index().Interface().Values(jen.Id("v")),
+				),
+				jen.Id("r").Index(jen.Id("name")).Op("=").Id("v"),
+			),
+			jen.Return(jen.Id("r"), jen.Nil()),
+		},
+		fmt.Sprintf("%s serializes the type and keeps only the known GraphQL fields, wrapping single values of list fields into a slice.", graphQLResolveFnName)))
+	return
+}
@@ -200,38 +200,51 @@ func (m *ManagerGenerator) createDeserializationMethod(deserName string, pubPkg,
 		managerName,
 		/*param=*/ nil,
 		[]jen.Code{
-			jen.Func().Params(
-				jen.Map(jen.String()).Interface(),
-				jen.Map(jen.String()).String(),
-			).Params(
-				jen.Qual(pubPkg.Path(), interfaceName),
-				jen.Error(),
-			),
+			deserializationFnType(pubPkg, interfaceName),
 		},
 		[]jen.Code{
 			jen.Return(
-				jen.Func().Params(
-					jen.Id("m").Map(jen.String()).Interface(),
-					jen.Id("aliasMap").Map(jen.String()).String(),
-				).Params(
-					jen.Qual(pubPkg.Path(), interfaceName),
-					jen.Error(),
-				).Block(
-					jen.List(
-						jen.Id("i"),
-						jen.Err(),
-					).Op(":=").Qual(privPkg.Path(), deserName).Call(jen.Id("m"), jen.Id("aliasMap")),
-					jen.If(
-						jen.Id("i").Op("==").Nil(),
-					).Block(
-						jen.Return(jen.Nil(), jen.Err()),
-					),
-					jen.Return(jen.List(
-						jen.Id("i"),
-						jen.Err(),
-					)),
-				),
+				deserializationFn(deserName, pubPkg, privPkg, interfaceName),
 			),
 		},
 		fmt.Sprintf("%s returns the deserialization method for the %q non-functional property in the vocabulary %q", name, interfaceName, vocabName))
 }
+
+// deserializationFnType returns the type of the functions deserializing the
+// interface.
+func deserializationFnType(pubPkg Package, interfaceName string) *jen.Statement {
+	return jen.Func().Params(
+		jen.Map(jen.String()).Interface(),
+		jen.Map(jen.String()).String(),
+	).Params(
+		jen.Qual(pubPkg.Path(), interfaceName),
+		jen.Error(),
+	)
+}
+
+// deserializationFn returns a function literal calling the deserialization
+// function of the private package, so that a nil implementation is returned as
+// a nil interface.
+func deserializationFn(deserName string, pubPkg, privPkg Package, interfaceName string) *jen.Statement {
+	return jen.Func().Params(
+		jen.Id("m").Map(jen.String()).Interface(),
+		jen.Id("aliasMap").Map(jen.String()).String(),
+	).Params(
+		jen.Qual(pubPkg.Path(), interfaceName),
+		jen.Error(),
+	).Block(
+		jen.List(
+			jen.Id("i"),
+			jen.Err(),
+		).Op(":=").Qual(privPkg.Path(), deserName).Call(jen.Id("m"), jen.Id("aliasMap")),
+		jen.If(
+			jen.Id("i").Op("==").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.List(
+			jen.Id("i"),
+			jen.Err(),
+		)),
+	)
+}
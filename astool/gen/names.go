@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"sort"
+	"strings"
+)
+
+const (
+	typeNameType     = "TypeName"
+	propertyNameType = "PropertyName"
+	contextIRIType   = "ContextIRI"
+)
+
+// GenerateNames generates typed constants for the type names, property names,
+// and vocabulary context IRIs. Unlike GenerateConstants, the constants are
+// typed so that they cannot be accidentally mixed up.
+func GenerateNames(types []*TypeGenerator, props []*PropertyGenerator) (c []jen.Code) {
+	c = append(c,
+		jen.Commentf("%s is the name of a type in a vocabulary.", typeNameType).Line().Type().Id(typeNameType).String(),
+		jen.Commentf("%s is the name of a property in a vocabulary.", propertyNameType).Line().Type().Id(propertyNameType).String(),
+		jen.Commentf("%s is the IRI of a vocabulary, as used in a JSON-LD @context.", contextIRIType).Line().Type().Id(contextIRIType).String(),
+	)
+	contexts := make(map[string]string)
+	for _, t := range types {
+		if t.vocabURI != nil {
+			contexts[t.VocabName()] = t.vocabURI.String()
+		}
+	}
+	for _, p := range props {
+		if p.vocabURI != nil {
+			contexts[p.VocabName()] = p.vocabURI.String()
+		}
+	}
+	vocabs := make([]string, 0, len(contexts))
+	for v := range contexts {
+		vocabs = append(vocabs, v)
+	}
+	sort.Strings(vocabs)
+	var ctxDefs []jen.Code
+	for _, v := range vocabs {
+		ctxDefs = append(ctxDefs, jen.Commentf("%sContext is the context IRI of the %s vocabulary.", v, v).Line().Id(fmt.Sprintf("%sContext", v)).Id(contextIRIType).Op("=").Lit(contexts[v]))
+	}
+	var typeDefs []jen.Code
+	for _, t := range types {
+		typeDefs = append(typeDefs, jen.Commentf("%s%s is the name of the %s type in the %s vocabulary.", t.VocabName(), t.TypeName(), t.TypeName(), t.VocabName()).Line().Id(fmt.Sprintf("%s%s", t.VocabName(), t.TypeName())).Id(typeNameType).Op("=").Lit(t.TypeName()))
+	}
+	var propDefs []jen.Code
+	for _, p := range props {
+		name := fmt.Sprintf("%s%sProperty", p.VocabName(), strings.Title(p.PropertyName()))
+		propDefs = append(propDefs, jen.Commentf("%s is the name of the %s property in the %s vocabulary.", name, p.PropertyName(), p.VocabName()).Line().Id(name).Id(propertyNameType).Op("=").Lit(p.PropertyName()))
+		if p.HasNaturalLanguageMap() {
+			name = fmt.Sprintf("%s%sMapProperty", p.VocabName(), strings.Title(p.PropertyName()))
+			propDefs = append(propDefs, jen.Commentf("%s is the name of the %s property in the %s vocabulary when it is a natural language map.", name, p.PropertyName(), p.VocabName()).Line().Id(name).Id(propertyNameType).Op("=").Lit(p.PropertyName()+"Map"))
+		}
+	}
+	for _, defs := range [][]jen.Code{ctxDefs, typeDefs, propDefs} {
+		if len(defs) > 0 {
+			c = append(c, jen.Const().Defs(defs...))
+		}
+	}
+	return
+}
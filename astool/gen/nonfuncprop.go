@@ -613,6 +613,60 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 			jen.Return(jen.Nil()),
 		},
 		fmt.Sprintf("%s returns beyond-the-last iterator, which is nil. Can be used with the iterator's %s method and this property's %s method to iterate from front to back through all values.", endMethod, nextMethod, beginMethod)))
+	// ForEach Method
+	methods = append(methods, codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		forEachMethod,
+		p.StructName(),
+		[]jen.Code{
+			jen.Id("fn").Func().Params(
+				jen.Id("idx").Int(),
+				jen.Id("it").Qual(p.GetPublicPackage().Path(), p.iteratorInterfaceName()),
+			).Bool(),
+		},
+		/*ret=*/ nil,
+		[]jen.Code{
+			jen.For(
+				jen.List(
+					jen.Id("i"),
+					jen.Id("elem"),
+				).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.If(
+					jen.Op("!").Id("fn").Call(jen.Id("i"), jen.Id("elem")),
+				).Block(
+					jen.Return(),
+				),
+			),
+		},
+		fmt.Sprintf("%s calls fn with the index and iterator of each value, from front to back, until fn returns false. Unlike the iterator's %s method, which copies the iterator it is called on, it neither copies nor allocates per value, so that it suits hot loops over long properties. The property must not be modified by fn.", forEachMethod, nextMethod)))
+	// String Method
+	methods = append(methods, codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		stringMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.String()},
+		[]jen.Code{
+			jen.Id("s").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(jen.Id(codegen.This()).Dot(propertiesName))),
+			jen.For(
+				jen.List(
+					jen.Id("i"),
+					jen.Id("elem"),
+				).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.If(jen.Id("i").Op("==").Lit(maxStringValues)).Block(
+					jen.Id("s").Op("=").Append(jen.Id("s"), jen.Qual("fmt", "Sprintf").Call(
+						jen.Lit("...+%d"),
+						jen.Len(jen.Id(codegen.This()).Dot(propertiesName)).Op("-").Lit(maxStringValues),
+					)),
+					jen.Break(),
+				),
+				jen.Id("s").Op("=").Append(jen.Id("s"), jen.Id("elem").Dot(stringMethod).Call()),
+			),
+			jen.Return(jen.Lit("[").Op("+").Qual("strings", "Join").Call(jen.Id("s"), jen.Lit(", ")).Op("+").Lit("]")),
+		},
+		fmt.Sprintf("%s returns a compact, human-readable form of the values of this property for logs and debugging, listing at most the first %d. It is not a serialization.", stringMethod, maxStringValues)))
 	// Context Method
 	methods = append(methods, codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
@@ -0,0 +1,152 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+)
+
+const (
+	activityPairingName      = "ActivityPairing"
+	activityPairingsVar      = "ActivityPairings"
+	activityPairingFnName    = "GetActivityPairing"
+	sideEffectCategoryName   = "SideEffectCategory"
+	pairingVocabName         = "ActivityStreams"
+	pairingActivityField     = "Activity"
+	pairingVocabularyField   = "VocabularyURI"
+	pairingObjectsField      = "Objects"
+	pairingSideEffectField   = "SideEffect"
+	sideEffectCategoryPrefix = "SideEffect"
+)
+
+// sideEffectCategories are the categories of side effects an activity has, as
+// described by the ActivityPub specification.
+var sideEffectCategories = []struct {
+	name    string
+	value   string
+	comment string
+}{
+	{"None", "none", "the activity has no side effects defined by the ActivityPub specification"},
+	{"Create", "create", "the activity creates its object"},
+	{"Update", "update", "the activity replaces or updates its object"},
+	{"Delete", "delete", "the activity deletes its object"},
+	{"Follow", "follow", "the activity requests to follow its object"},
+	{"Response", "response", "the activity responds to a previous activity, such as a Follow"},
+	{"Collection", "collection", "the activity adds its object to, or removes it from, the target collection"},
+	{"Reaction", "reaction", "the activity adds its actor to a collection of reactions of its object, such as likes"},
+	{"Share", "share", "the activity adds its actor to the shares of its object"},
+	{"Undo", "undo", "the activity reverses the side effects of a previous activity"},
+	{"Block", "block", "the activity blocks its object from interacting with its actor"},
+	{"Flag", "flag", "the activity reports its object for moderation"},
+	{"Move", "move", "the activity moves its object from the origin to the target"},
+}
+
+// activityPairings is the table of ActivityStreams activities, the object
+// types they conventionally wrap, and their side effect category.
+//
+// Like the 'type' and 'id' kluges, these refer to specific types by name. An
+// object type of "Object" or "Activity" includes all types extending from it.
+var activityPairings = []struct {
+	activity   string
+	objects    []string
+	sideEffect string
+}{
+	{"Accept", []string{"Follow", "Invite", "Offer"}, "Response"},
+	{"Add", []string{"Object"}, "Collection"},
+	{"Announce", []string{"Object"}, "Share"},
+	{"Arrive", nil, "None"},
+	{"Block", []string{"Application", "Group", "Organization", "Person", "Service"}, "Block"},
+	{"Create", []string{"Object"}, "Create"},
+	{"Delete", []string{"Object"}, "Delete"},
+	{"Dislike", []string{"Object"}, "Reaction"},
+	{"Flag", []string{"Object"}, "Flag"},
+	{"Follow", []string{"Application", "Group", "Organization", "Person", "Service"}, "Follow"},
+	{"Ignore", []string{"Object"}, "None"},
+	{"Invite", []string{"Event", "Group", "Organization"}, "None"},
+	{"Join", []string{"Event", "Group", "Organization"}, "None"},
+	{"Leave", []string{"Event", "Group", "Organization"}, "None"},
+	{"Like", []string{"Object"}, "Reaction"},
+	{"Listen", []string{"Audio", "Video"}, "None"},
+	{"Move", []string{"Object"}, "Move"},
+	{"Offer", []string{"Object"}, "None"},
+	{"Question", nil, "None"},
+	{"Read", []string{"Article", "Document", "Note", "Page"}, "None"},
+	{"Reject", []string{"Follow", "Invite", "Offer"}, "Response"},
+	{"Remove", []string{"Object"}, "Collection"},
+	{"TentativeAccept", []string{"Invite", "Offer"}, "Response"},
+	{"TentativeReject", []string{"Invite", "Offer"}, "Response"},
+	{"Travel", nil, "None"},
+	{"Undo", []string{"Activity"}, "Undo"},
+	{"Update", []string{"Object"}, "Update"},
+	{"View", []string{"Object"}, "None"},
+}
+
+// ActivityPairingDefinitions generates a machine-readable table of which
+// activity types conventionally wrap which object types, and the category of
+// their side effects. Only activities and objects within the provided types
+// are included.
+func ActivityPairingDefinitions(pkg Package, tgs []*TypeGenerator) (defs []jen.Code, fn *codegen.Function) {
+	types := make(map[string]*TypeGenerator, len(tgs))
+	for _, tg := range tgs {
+		if tg.VocabName() == pairingVocabName {
+			types[tg.TypeName()] = tg
+		}
+	}
+	// Side effect categories
+	defs = append(defs, jen.Commentf("%s categorizes the side effects of an activity as described by the ActivityPub specification.", sideEffectCategoryName).Line().Type().Id(sideEffectCategoryName).String())
+	var consts []jen.Code
+	for _, c := range sideEffectCategories {
+		consts = append(consts, jen.Commentf("%s%s means %s.", sideEffectCategoryPrefix, c.name, c.comment).Line().Id(sideEffectCategoryPrefix+c.name).Id(sideEffectCategoryName).Op("=").Lit(c.value))
+	}
+	defs = append(defs, jen.Const().Defs(consts...))
+	// The pairing struct
+	defs = append(defs, jen.Commentf("%s describes the object types an activity conventionally wraps and the category of its side effects. Object types that are extended by other types, such as Object, include all of the types extending from them.", activityPairingName).Line().Type().Id(activityPairingName).Struct(
+		jen.Commentf("%s is the name of the activity type.", pairingActivityField).Line().Id(pairingActivityField).String(),
+		jen.Commentf("%s is the vocabulary of the activity type.", pairingVocabularyField).Line().Id(pairingVocabularyField).String(),
+		jen.Commentf("%s are the names of the object types conventionally wrapped, which is empty for intransitive activities.", pairingObjectsField).Line().Id(pairingObjectsField).Index().String(),
+		jen.Commentf("%s is the category of the side effects of the activity.", pairingSideEffectField).Line().Id(pairingSideEffectField).Id(sideEffectCategoryName),
+	))
+	// The table
+	var entries []jen.Code
+	for _, p := range activityPairings {
+		tg, ok := types[p.activity]
+		if !ok {
+			continue
+		}
+		var objs []jen.Code
+		for _, o := range p.objects {
+			if _, ok := types[o]; ok {
+				objs = append(objs, jen.Lit(o))
+			}
+		}
+		entries = append(entries, jen.Values(jen.Dict{
+			jen.Id(pairingActivityField):   jen.Lit(tg.TypeName()),
+			jen.Id(pairingVocabularyField): jen.Lit(tg.vocabURI.String()),
+			jen.Id(pairingObjectsField):    jen.Index().String().Values(objs...),
+			jen.Id(pairingSideEffectField): jen.Id(sideEffectCategoryPrefix + p.sideEffect),
+		}))
+	}
+	defs = append(defs, jen.Commentf("%s is the table of activities and their conventional pairings, ordered by activity name.", activityPairingsVar).Line().Var().Id(activityPairingsVar).Op("=").Index().Id(activityPairingName).Values(entries...))
+	// Lookup function
+	var typePkg string
+	if len(tgs) > 0 {
+		typePkg = tgs[0].PublicPackage().Path()
+	}
+	fn = codegen.NewCommentedFunction(
+		pkg.Path(),
+		activityPairingFnName,
+		[]jen.Code{jen.Id("t").Qual(typePkg, typeInterfaceName)},
+		[]jen.Code{jen.Id(activityPairingName), jen.Bool()},
+		[]jen.Code{
+			jen.For(jen.List(jen.Id("_"), jen.Id("p")).Op(":=").Range().Id(activityPairingsVar)).Block(
+				jen.If(
+					jen.Id("p").Dot(pairingActivityField).Op("==").Id("t").Dot(typeNameMethod).Call().Op("&&").Id("p").Dot(pairingVocabularyField).Op("==").Id("t").Dot(vocabURIMethod).Call(),
+				).Block(
+					jen.Return(jen.Id("p"), jen.True()),
+				),
+			),
+			jen.Return(jen.Id(activityPairingName).Values(), jen.False()),
+		},
+		fmt.Sprintf("%s returns the %s of the provided activity, and false if the type is not a known activity.", activityPairingFnName, activityPairingName))
+	return
+}
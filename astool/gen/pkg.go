@@ -238,7 +238,7 @@ func (t *PackageGenerator) InitDefinitions(pkg Package, tgs []*TypeGenerator, pg
 }
 
 // RootDefinitions creates functions needed at the root level of the package declarations.
-func (t *PackageGenerator) RootDefinitions(vocabName string, tgs []*TypeGenerator, pgs []*PropertyGenerator) (typeCtors, propCtors, ext, disj, extBy, isA []*codegen.Function) {
+func (t *PackageGenerator) RootDefinitions(vocabName string, tgs []*TypeGenerator, pgs []*PropertyGenerator) (typeCtors, propCtors, ext, disj, extBy, isA, to []*codegen.Function) {
 	return rootDefinitions(vocabName, t.m, tgs, pgs)
 }
 
@@ -337,7 +337,7 @@ func publicTypeDefinitions(tgs []*TypeGenerator) (typeI *codegen.Interface) {
 
 // rootDefinitions creates common functions needed at the root level of the
 // package declarations.
-func rootDefinitions(vocabName string, m *ManagerGenerator, tgs []*TypeGenerator, pgs []*PropertyGenerator) (typeCtors, propCtors, ext, disj, extBy, isA []*codegen.Function) {
+func rootDefinitions(vocabName string, m *ManagerGenerator, tgs []*TypeGenerator, pgs []*PropertyGenerator) (typeCtors, propCtors, ext, disj, extBy, isA, to []*codegen.Function) {
 	// Type constructors
 	for _, tg := range tgs {
 		typeCtors = append(typeCtors, codegen.NewCommentedFunction(
@@ -372,6 +372,33 @@ func rootDefinitions(vocabName string, m *ManagerGenerator, tgs []*TypeGenerator
 			},
 			fmt.Sprintf("%s returns true if the other provided type is the %s type or extends from the %s type.", name, tg.TypeName(), tg.TypeName())))
 	}
+	// To
+	for _, tg := range tgs {
+		name := fmt.Sprintf("%s%s%s", toMethod, vocabName, tg.TypeName())
+		to = append(to, codegen.NewCommentedFunction(
+			m.pkg.Path(),
+			name,
+			[]jen.Code{jen.Id("other").Qual(tg.PublicPackage().Path(), typeInterfaceName)},
+			[]jen.Code{jen.Qual(tg.PublicPackage().Path(), tg.InterfaceName()), jen.Error()},
+			[]jen.Code{
+				jen.If(
+					jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("other").Assert(jen.Qual(tg.PublicPackage().Path(), tg.InterfaceName())),
+					jen.Id("ok"),
+				).Block(
+					jen.Return(jen.Id("v"), jen.Nil()),
+				),
+				jen.Return(
+					jen.Nil(),
+					jen.Qual("fmt", "Errorf").Call(
+						jen.Lit("cannot convert %q type of vocabulary %q to %s"),
+						jen.Id("other").Dot(typeNameMethod).Call(),
+						jen.Id("other").Dot(vocabURIMethod).Call(),
+						jen.Lit(tg.InterfaceName()),
+					),
+				),
+			},
+			fmt.Sprintf("%s returns the other provided type as the %s type. Returns an error if the other type is not exactly the %s type; types extending from %s are not converted, see the %q variant to detect those.", name, tg.TypeName(), tg.TypeName(), tg.TypeName(), isAMethod)))
+	}
 	// Extends
 	for _, tg := range tgs {
 		f, _ := tg.extendsDefinition()
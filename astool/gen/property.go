@@ -41,10 +41,20 @@ const (
 	beginMethod               = "Begin"
 	endMethod                 = "End"
 	emptyMethod               = "Empty"
+	forEachMethod             = "ForEach"
+	stringMethod              = "String"
+	// Limits of the String representation
+	maxStringRunes  = 64
+	maxStringValues = 3
 	// Context string management
 	contextMethod = "JSONLDContext"
 	// Member names for generated code
 	unknownMemberName = "unknown"
+	// Deferred deserialization of types
+	rawMemberName         = "raw"
+	rawAliasMapMemberName = "rawAliasMap"
+	resolveOnceMemberName = "resolveOnce"
+	resolveDeferredMethod = "resolve"
 	// Reference to the rdf:langString member! Kludge: both of these must be
 	// kept in sync with the generated code.
 	langMapMember       = "rdfLangStringMember"
@@ -383,14 +393,29 @@ func (p *PropertyGenerator) clearMethodName() string {
 	return clearMethod
 }
 
+// defersTypes returns true if a value of this functional property or iterator
+// that may be a type is kept in its decoded form, and only deserialized when it
+// is first accessed.
+func (p *PropertyGenerator) defersTypes() bool {
+	return p.hasTypeKind()
+}
+
+// newReadMethod creates a method that does not modify the value of this
+// property. Its receiver is a pointer if this property defers deserializing its
+// types, so that a value deserialized when first accessed is kept.
+func (p *PropertyGenerator) newReadMethod(name string, params, ret, block []jen.Code, comment string) *codegen.Method {
+	if p.defersTypes() {
+		return codegen.NewCommentedPointerMethod(p.GetPrivatePackage().Path(), name, p.StructName(), params, ret, block, comment)
+	}
+	return codegen.NewCommentedValueMethod(p.GetPrivatePackage().Path(), name, p.StructName(), params, ret, block, comment)
+}
+
 // commonMethods returns methods common to every property.
 func (p *PropertyGenerator) commonMethods() (m []*codegen.Method) {
 	if p.asIterator {
 		// Next & Prev methods
-		m = append(m, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
+		m = append(m, p.newReadMethod(
 			nextMethod,
-			p.StructName(),
 			/*params=*/ nil,
 			[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
 			[]jen.Code{
@@ -405,10 +430,8 @@ func (p *PropertyGenerator) commonMethods() (m []*codegen.Method) {
 				),
 			},
 			fmt.Sprintf("%s returns the next iterator, or nil if there is no next iterator.", nextMethod)))
-		m = append(m, codegen.NewCommentedValueMethod(
-			p.GetPrivatePackage().Path(),
+		m = append(m, p.newReadMethod(
 			prevMethod,
-			p.StructName(),
 			/*params=*/ nil,
 			[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
 			[]jen.Code{
@@ -0,0 +1,284 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"strings"
+)
+
+const (
+	registryName                = "Registry"
+	registryConstructorName     = "NewRegistry"
+	registryTypeStructName      = "registeredType"
+	registryTypesMember         = "types"
+	registryToTypeMethod        = "ToType"
+	registryUnregisteredErrName = "ErrUnregisteredType"
+)
+
+// RegistryDefinitions creates the Registry, an alternative to the Manager that
+// only deserializes the types an Application registers with it.
+//
+// The Manager links against the implementation of every type and property, and
+// is injected into all of them when the root package is initialized. Instead,
+// the Registry only refers to the implementation of a type, and those of its
+// properties, from the method registering it. The linker is then free to drop
+// the implementations of the types never registered, as long as the root
+// package is not imported.
+func RegistryDefinitions(pkg Package, m *ManagerGenerator, typeProperty *PropertyGenerator) (defs []jen.Code, s *codegen.Struct, fns []*codegen.Function) {
+	typeFn := jen.Func().Params(
+		jen.Map(jen.String()).Interface(),
+		jen.Map(jen.String()).String(),
+	).Params(
+		registryTypeInterface(m),
+		jen.Error(),
+	)
+	defs = append(defs,
+		jen.Commentf("%s is returned when deserializing a value whose type is not registered with the %s.", registryUnregisteredErrName, registryName).Line().Var().Id(registryUnregisteredErrName).Op("=").Qual("errors", "New").Call(jen.Lit("type is not registered")),
+		jen.Commentf("%s is a type registered with the %s.", registryTypeStructName, registryName).Line().Type().Id(registryTypeStructName).Struct(
+			jen.Id("vocabURI").String(),
+			jen.Id("name").String(),
+			jen.Id("deserialize").Add(typeFn),
+		),
+	)
+	var members []jen.Code
+	var methods []*codegen.Method
+	for _, tg := range m.tg {
+		method := m.getDeserializationMethodForType(tg)
+		members = append(members, jen.Id(registryMemberName(method)).Add(deserializationFnType(tg.PublicPackage(), tg.InterfaceName())))
+		methods = append(methods,
+			registryDeserializationMethod(pkg, method, tg.PublicPackage(), tg.InterfaceName(), jen.Qual(pkg.Path(), registryUnregisteredErrName)),
+			registryRegisterMethod(pkg, m, tg, typeProperty))
+	}
+	var props []Property
+	for _, fp := range m.fp {
+		props = append(props, fp)
+	}
+	for _, nfp := range m.nfp {
+		props = append(props, nfp)
+	}
+	for _, prop := range props {
+		method := m.getDeserializationMethodForProperty(prop)
+		members = append(members, jen.Id(registryMemberName(method)).Add(deserializationFnType(prop.GetPublicPackage(), prop.InterfaceName())))
+		methods = append(methods,
+			registryDeserializationMethod(pkg, method, prop.GetPublicPackage(), prop.InterfaceName(), jen.Nil()),
+			registryRegisterPropertyMethod(pkg, method, prop))
+	}
+	members = append(members, jen.Id(registryTypesMember).Index().Id(registryTypeStructName))
+	methods = append(methods, registryToType(pkg, m))
+	s = codegen.NewStruct(
+		fmt.Sprintf("%s manages the deserialization of only the types registered with it, and of their properties. It is an alternative to the %s of the root package for Applications that handle few types, so that the implementations of the other types are neither initialized nor linked into the binary. Types are registered with its Register methods, which must be called before any value is deserialized or constructed, and not concurrently with other methods. The root package must not be imported by the Application, as it injects its own %s into every implementation when initialized.", registryName, managerName, managerName),
+		registryName,
+		methods,
+		[]*codegen.Function{
+			codegen.NewCommentedFunction(
+				pkg.Path(),
+				registryConstructorName,
+				/*params=*/ nil,
+				[]jen.Code{jen.Op("*").Qual(pkg.Path(), registryName)},
+				[]jen.Code{
+					jen.Return(jen.Op("&").Qual(pkg.Path(), registryName).Values()),
+				},
+				fmt.Sprintf("%s creates a %s with no types registered.", registryConstructorName, registryName)),
+		},
+		members)
+	fns = append(fns, toAliasFunction(pkg))
+	return
+}
+
+// registryMemberName returns the name of the Registry member holding the
+// deserialization function returned by the Manager method.
+func registryMemberName(method *codegen.Method) string {
+	return strings.ToLower(method.Name()[:1]) + method.Name()[1:]
+}
+
+// registryDeserializationMethod creates the Registry method returning the
+// registered deserialization function, or one returning the unregistered
+// value and error.
+func registryDeserializationMethod(pkg Package, method *codegen.Method, pubPkg Package, interfaceName string, unregisteredErr jen.Code) *codegen.Method {
+	member := registryMemberName(method)
+	return codegen.NewCommentedPointerMethod(
+		pkg.Path(),
+		method.Name(),
+		registryName,
+		/*params=*/ nil,
+		[]jen.Code{deserializationFnType(pubPkg, interfaceName)},
+		[]jen.Code{
+			jen.If(
+				jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Id(codegen.This()).Dot(member)),
+			),
+			jen.Return(
+				jen.Func().Params(
+					jen.Id("m").Map(jen.String()).Interface(),
+					jen.Id("aliasMap").Map(jen.String()).String(),
+				).Params(
+					jen.Qual(pubPkg.Path(), interfaceName),
+					jen.Error(),
+				).Block(
+					jen.Return(jen.Nil(), unregisteredErr),
+				),
+			),
+		},
+		fmt.Sprintf("%s returns the deserialization method for %q if it is registered.", method.Name(), interfaceName))
+}
+
+// registryRegisterMethod creates the Registry method registering the type and
+// all of its properties.
+func registryRegisterMethod(pkg Package, m *ManagerGenerator, tg *TypeGenerator, typeProperty *PropertyGenerator) *codegen.Method {
+	member := registryMemberName(m.getDeserializationMethodForType(tg))
+	vocabURI := ""
+	if tg.vocabURI != nil {
+		vocabURI = tg.vocabURI.String()
+	}
+	body := []jen.Code{
+		jen.If(
+			jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+		).Block(
+			jen.Return(),
+		),
+		jen.Id(codegen.This()).Dot(member).Op("=").Add(deserializationFn(tg.deserializationFnName(), tg.PublicPackage(), tg.PrivatePackage(), tg.InterfaceName())),
+		jen.Id(codegen.This()).Dot(registryTypesMember).Op("=").Append(
+			jen.Id(codegen.This()).Dot(registryTypesMember),
+			jen.Id(registryTypeStructName).Values(jen.Dict{
+				jen.Id("vocabURI"): jen.Lit(vocabURI),
+				jen.Id("name"):     jen.Lit(tg.TypeName()),
+				jen.Id("deserialize"): jen.Func().Params(
+					jen.Id("m").Map(jen.String()).Interface(),
+					jen.Id("aliasMap").Map(jen.String()).String(),
+				).Params(
+					registryTypeInterface(m),
+					jen.Error(),
+				).Block(
+					jen.Return(jen.Id(codegen.This()).Dot(member).Call(jen.Id("m"), jen.Id("aliasMap"))),
+				),
+			}),
+		),
+		jen.Qual(tg.PrivatePackage().Path(), setManagerFunctionName).Call(jen.Id(codegen.This())),
+		jen.Qual(tg.PrivatePackage().Path(), setTypePropertyConstructorName).Call(
+			jen.Func().Params().Qual(typeProperty.GetPublicPackage().Path(), typeProperty.InterfaceName()).Block(
+				jen.Return(typeProperty.ConstructorFn().Call()),
+			),
+		),
+	}
+	for _, prop := range tg.allProperties() {
+		body = append(body, jen.Id(codegen.This()).Dot(registryRegisterPropertyName(m.getDeserializationMethodForProperty(prop))).Call())
+	}
+	name := fmt.Sprintf("Register%s", tg.InterfaceName())
+	return codegen.NewCommentedPointerMethod(
+		pkg.Path(),
+		name,
+		registryName,
+		/*params=*/ nil,
+		/*ret=*/ nil,
+		body,
+		fmt.Sprintf("%s registers the %q type of the %s vocabulary, and its properties. Values of the other types are only deserialized as IRIs or unknown values of these properties.", name, tg.TypeName(), tg.VocabName()))
+}
+
+// registryTypeInterface returns the interface of every type.
+func registryTypeInterface(m *ManagerGenerator) *jen.Statement {
+	return jen.Qual(m.tg[0].PublicPackage().Path(), typeInterfaceName)
+}
+
+// registryRegisterPropertyName returns the name of the Registry method
+// registering the property deserialized by the Manager method.
+func registryRegisterPropertyName(method *codegen.Method) string {
+	return "register" + strings.TrimPrefix(method.Name(), "Deserialize")
+}
+
+// registryRegisterPropertyMethod creates the Registry method registering the
+// property, once for all the types having it.
+func registryRegisterPropertyMethod(pkg Package, method *codegen.Method, prop Property) *codegen.Method {
+	member := registryMemberName(method)
+	privPkg := propertyPrivatePackage(prop)
+	name := registryRegisterPropertyName(method)
+	return codegen.NewCommentedPointerMethod(
+		pkg.Path(),
+		name,
+		registryName,
+		/*params=*/ nil,
+		/*ret=*/ nil,
+		[]jen.Code{
+			jen.If(
+				jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+			).Block(
+				jen.Return(),
+			),
+			jen.Id(codegen.This()).Dot(member).Op("=").Add(deserializationFn(prop.DeserializeFnName(), prop.GetPublicPackage(), privPkg, prop.InterfaceName())),
+			jen.Qual(privPkg.Path(), setManagerFunctionName).Call(jen.Id(codegen.This())),
+		},
+		fmt.Sprintf("%s registers the %q property of the %s vocabulary.", name, prop.PropertyName(), prop.VocabName()))
+}
+
+// propertyPrivatePackage returns the private package of the property.
+func propertyPrivatePackage(p Property) Package {
+	switch v := p.(type) {
+	case *FunctionalPropertyGenerator:
+		return v.GetPrivatePackage()
+	case *NonFunctionalPropertyGenerator:
+		return v.GetPrivatePackage()
+	default:
+		panic("unknown property type")
+	}
+}
+
+// registryToType creates the Registry method deserializing a JSON-LD map into
+// the registered type it is.
+func registryToType(pkg Package, m *ManagerGenerator) *codegen.Method {
+	return codegen.NewCommentedPointerMethod(
+		pkg.Path(),
+		registryToTypeMethod,
+		registryName,
+		[]jen.Code{
+			jen.Id("c").Qual("context", "Context"),
+			jen.Id("m").Map(jen.String()).Interface(),
+		},
+		[]jen.Code{
+			registryTypeInterface(m),
+			jen.Error(),
+		},
+		[]jen.Code{
+			jen.Var().Id("typeStrings").Index().String(),
+			jen.Switch(jen.Id("v").Op(":=").Id("m").Index(jen.Lit("type")).Assert(jen.Type())).Block(
+				jen.Case(jen.String()).Block(
+					jen.Id("typeStrings").Op("=").Index().String().Values(jen.Id("v")),
+				),
+				jen.Case(jen.Index().Interface()).Block(
+					jen.For(
+						jen.List(jen.Id("_"), jen.Id("elem")).Op(":=").Range().Id("v"),
+					).Block(
+						jen.If(
+							jen.List(jen.Id("s"), jen.Id("ok")).Op(":=").Id("elem").Assert(jen.String()),
+							jen.Id("ok"),
+						).Block(
+							jen.Id("typeStrings").Op("=").Append(jen.Id("typeStrings"), jen.Id("s")),
+						),
+					),
+				),
+			),
+			jen.Id("aliasMap").Op(":=").Id(toAliasMapFnName).Call(jen.Id("m").Index(jen.Lit("@context"))),
+			jen.For(
+				jen.List(jen.Id("_"), jen.Id("typeString")).Op(":=").Range().Id("typeStrings"),
+			).Block(
+				jen.For(
+					jen.List(jen.Id("_"), jen.Id("t")).Op(":=").Range().Id(codegen.This()).Dot(registryTypesMember),
+				).Block(
+					jen.Id("prefix").Op(":=").Lit(""),
+					jen.If(
+						jen.Id("a").Op(":=").Id("aliasMap").Index(jen.Id("t").Dot("vocabURI")),
+						jen.Len(jen.Id("a")).Op(">").Lit(0),
+					).Block(
+						jen.Id("prefix").Op("=").Id("a").Op("+").Lit(":"),
+					),
+					jen.If(
+						jen.Id("typeString").Op("==").Id("prefix").Op("+").Id("t").Dot("name"),
+					).Block(
+						jen.Return(jen.Id("t").Dot("deserialize").Call(jen.Id("m"), jen.Id("aliasMap"))),
+					),
+				),
+			),
+			jen.Return(jen.Nil(), jen.Id(registryUnregisteredErrName)),
+		},
+		fmt.Sprintf("%s attempts to deserialize the generic JSON map into the registered type it is. Returns %s if its type is not registered.", registryToTypeMethod, registryUnregisteredErrName))
+}
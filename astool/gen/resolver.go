@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/dave/jennifer/jen"
 	"github.com/go-fed/activity/astool/codegen"
+	"strings"
 	"sync"
 )
 
@@ -325,8 +326,17 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 		}
 		// Fetch the identifier holding the alias for this vocabulary,
 		aliasId := aliasToId[vocabHttps.String()]
+		typeCond := jen.Id("typeString").Op("==").Id(aliasId).Op("+").Lit(t.TypeName())
+		for _, a := range t.Aliases() {
+			// Aliases already containing a prefix are matched literally.
+			if strings.Contains(a, ":") {
+				typeCond = typeCond.Op("||").Id("typeString").Op("==").Lit(a)
+			} else {
+				typeCond = typeCond.Op("||").Id("typeString").Op("==").Id(aliasId).Op("+").Lit(a)
+			}
+		}
 		impl = impl.If(
-			jen.Id("typeString").Op("==").Id(aliasId).Op("+").Lit(t.TypeName()),
+			typeCond,
 		).Block(
 			jen.List(
 				jen.Id("v"),
@@ -856,8 +866,13 @@ func (r *ResolverGenerator) resolverInterface() *codegen.Interface {
 
 // toAliasFunction returns the toAliasMap function
 func (r *ResolverGenerator) toAliasFunction() *codegen.Function {
+	return toAliasFunction(r.pkg)
+}
+
+// toAliasFunction returns the toAliasMap function in the package.
+func toAliasFunction(pkg Package) *codegen.Function {
 	return codegen.NewCommentedFunction(
-		r.pkg.Path(),
+		pkg.Path(),
 		toAliasMapFnName,
 		[]jen.Code{
 			jen.Id("i").Interface(),
@@ -18,6 +18,7 @@ const (
 	extendingMethod            = "IsExtending"
 	extendsMethod              = "Extends"
 	isAMethod                  = "IsOrExtends"
+	toMethod                   = "To"
 	disjointWithMethod         = "IsDisjointWith"
 	typeNameMethod             = "GetTypeName"
 	vocabURIMethod             = "VocabularyURI"
@@ -29,6 +30,15 @@ const (
 	aliasMember                = "alias"
 	getMethodFormat            = "Get%s"
 	constructorName            = "New"
+	iriAccessorSuffix          = "IRI"
+	typeAccessorSuffix         = "Type"
+	getPropertyMethod          = "GetProperty"
+	setPropertyMethod          = "SetProperty"
+	applyMapMethod             = "ApplyMap"
+	stringMethodName           = "String"
+	goStringMethod             = "GoString"
+	applyMapValueFn            = "applyMapValue"
+	coerceMapValueFn           = "coerceMapValue"
 )
 
 const (
@@ -144,9 +154,13 @@ type TypeGenerator struct {
 	extends           []*TypeGenerator
 	disjoint          []*TypeGenerator
 	extendedBy        []*TypeGenerator
+	aliases           []string
 	m                 *ManagerGenerator
 	cacheOnce         sync.Once
 	cachedStruct      *codegen.Struct
+	// ownContextOnly is true if the JSON-LD context of every value of
+	// this type is known to require only the type's own vocabulary.
+	ownContextOnly bool
 }
 
 // NewTypeGenerator creates a new generator for a specific ActivityStreams Core
@@ -227,6 +241,48 @@ func (t *TypeGenerator) AddRangeProperty(property Property) {
 	t.rangeProperties = append(t.rangeProperties, property)
 }
 
+// AddAlias adds an alternate spelling of this type's name, which is accepted
+// when deserializing. The canonical name is always used when serializing. Must
+// be called before Definition is called.
+func (t *TypeGenerator) AddAlias(name string) {
+	if name == t.typeName {
+		return
+	}
+	for _, a := range t.aliases {
+		if a == name {
+			return
+		}
+	}
+	t.aliases = append(t.aliases, name)
+}
+
+// Aliases returns the alternate spellings of this type's name.
+func (t *TypeGenerator) Aliases() []string {
+	return t.aliases
+}
+
+// typeNameMatches generates the condition that the name is either the type's
+// name or one of its aliases.
+func (t *TypeGenerator) typeNameMatches(name *jen.Statement) *jen.Statement {
+	if len(t.aliases) == 0 {
+		return name.Clone().Op("==").Lit(t.TypeName())
+	}
+	cond := jen.Add(name.Clone()).Op("==").Lit(t.TypeName())
+	for _, a := range t.aliases {
+		cond = cond.Op("||").Add(name.Clone()).Op("==").Lit(a)
+	}
+	return jen.Parens(cond)
+}
+
+// typeNameDiffers generates the condition that the name is neither the type's
+// name nor one of its aliases.
+func (t *TypeGenerator) typeNameDiffers(name *jen.Statement) *jen.Statement {
+	if len(t.aliases) == 0 {
+		return name.Clone().Op("!=").Lit(t.TypeName())
+	}
+	return jen.Op("!").Add(t.typeNameMatches(name))
+}
+
 // apply propagates the manager's functions referring to this type's
 // implementation as if this type were a Kind.
 //
@@ -374,6 +430,17 @@ func (t *TypeGenerator) InterfaceDefinition(pkg Package) *codegen.Interface {
 	return s.ToInterface(pkg.Path(), t.InterfaceName(), t.Comments())
 }
 
+// SetOwnContextOnly marks the JSON-LD context of every value of this type as
+// only requiring the type's own vocabulary, such as when its properties and
+// the types they hold are all in one vocabulary. Its JSONLDContext method then
+// returns the context known at code-generation time instead of merging those
+// of every property and child value on each call.
+//
+// Must be called before Definition.
+func (t *TypeGenerator) SetOwnContextOnly() {
+	t.ownContextOnly = true
+}
+
 // Definition generates the golang code for this ActivityStreams type.
 func (t *TypeGenerator) Definition() *codegen.Struct {
 	t.cacheOnce.Do(func() {
@@ -385,12 +452,16 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 		extendsFn, extendsMethod := t.extendsDefinition()
 		getters := t.allGetters()
 		setters := t.allSetters()
+		accessors := t.allAccessors()
+		byName := t.propertyByNameMethods()
+		applyMap, applyMapFns := t.applyMapDefinition()
+		str, goStr := t.stringMethods()
 		constructor := t.constructorFn()
 		ctxMethods := t.contextMethods()
 		t.cachedStruct = codegen.NewStruct(
 			t.Comments(),
 			t.StructName(),
-			append(append(append(
+			append(append(append(append(append(append(append(
 				[]*codegen.Method{
 					t.nameDefinition(),
 					t.vocabURIDefinition(),
@@ -401,9 +472,14 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 				},
 				ctxMethods...),
 				getters...),
-				setters...,
+				setters...),
+				accessors...),
+				byName...),
+				applyMap),
+				str,
+				goStr,
 			),
-			[]*codegen.Function{
+			append([]*codegen.Function{
 				constructor,
 				t.isATypeDefinition(),
 				t.extendedByDefinition(),
@@ -411,6 +487,7 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 				t.disjointWithDefinition(),
 				deser,
 			},
+				applyMapFns...),
 			members)
 	})
 	return t.cachedStruct
@@ -728,6 +805,45 @@ func (t *TypeGenerator) serializationMethod() (ser *codegen.Method) {
 			).Line())
 	}
 	serCode = serCode.Commentf("End: Serialize known properties").Line()
+	if len(t.aliases) > 0 {
+		aliasCond := jen.Empty()
+		for i, a := range t.aliases {
+			if i > 0 {
+				aliasCond = aliasCond.Op("||")
+			}
+			aliasCond = aliasCond.Id("s").Op("==").Lit(a)
+		}
+		serCode = serCode.Commentf("Begin: Serialize aliases of this type by its name").Line().Add(
+			jen.Id("aliasPrefix").Op(":=").Lit(""),
+			jen.Line(),
+			jen.If(
+				jen.Len(jen.Id(codegen.This()).Dot(aliasMember)).Op(">").Lit(0),
+			).Block(
+				jen.Id("aliasPrefix").Op("=").Id(codegen.This()).Dot(aliasMember).Op("+").Lit(":"),
+			),
+			jen.Line(),
+			jen.Id("isAlias").Op(":=").Func().Params(jen.Id("v").Interface()).Bool().Block(
+				jen.List(jen.Id("s"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.String()),
+				jen.Id("s").Op("=").Qual("strings", "TrimPrefix").Call(jen.Id("s"), jen.Id("aliasPrefix")),
+				jen.Return(jen.Id("ok").Op("&&").Parens(aliasCond)),
+			),
+			jen.Line(),
+			jen.If(
+				jen.Id("isAlias").Call(jen.Id("m").Index(jen.Lit("type"))),
+			).Block(
+				jen.Id("m").Index(jen.Lit("type")).Op("=").Id("typeName"),
+			).Else().If(
+				jen.List(jen.Id("arr"), jen.Id("ok")).Op(":=").Id("m").Index(jen.Lit("type")).Assert(jen.Index().Interface()),
+				jen.Id("ok"),
+			).Block(
+				jen.For(jen.Id("i").Op(":=").Range().Id("arr")).Block(
+					jen.If(jen.Id("isAlias").Call(jen.Id("arr").Index(jen.Id("i")))).Block(
+						jen.Id("arr").Index(jen.Id("i")).Op("=").Id("typeName"),
+					),
+				),
+			),
+		).Line().Commentf("End: Serialize aliases of this type by its name").Line()
+	}
 	unknownCode := jen.Commentf("Begin: Serialize unknown properties").Line().For(
 		jen.List(
 			jen.Id("k"),
@@ -946,7 +1062,7 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 					jen.Id("aliasPrefix"),
 				),
 				jen.If(
-					jen.Id("typeName").Op("!=").Lit(t.TypeName()),
+					t.typeNameDiffers(jen.Id("typeName")),
 				).Block(
 					jen.Return(
 						jen.Nil(),
@@ -973,10 +1089,10 @@ func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
 							jen.Id("typeString"),
 							jen.Id("ok"),
 						).Op(":=").Id("elemVal").Assert(jen.String()),
-						jen.Id("ok").Op("&&").Qual("strings", "TrimPrefix").Call(
+						jen.Id("ok").Op("&&").Add(t.typeNameMatches(jen.Qual("strings", "TrimPrefix").Call(
 							jen.Id("typeString"),
 							jen.Id("aliasPrefix"),
-						).Op("==").Lit(t.TypeName()),
+						))),
 					).Block(
 						jen.Id("found").Op("=").True(),
 						jen.Break(),
@@ -1061,6 +1177,447 @@ func (t *TypeGenerator) allSetters() (m []*codegen.Method) {
 	return
 }
 
+// accessor is a nil-safe accessor of a property, which returns its value of a
+// Kind.
+type accessor struct {
+	property Property
+	// suffix follows the property name in the accessor's name.
+	suffix string
+	// vocabSuffix replaces suffix when the name collides with another
+	// accessor of the type.
+	vocabSuffix string
+	ret         jen.Code
+	// is and get are the methods of the property, or of its iterators,
+	// determining whether it has the Kind and obtaining the value.
+	is, get string
+	// isNotNil determines whether the property has the Kind by its value
+	// not being nil, instead of by calling the is method.
+	isNotNil bool
+	// kindName names the Kind in documentation.
+	kindName string
+}
+
+// name returns the identifier of the accessor, which includes the vocabulary
+// names if it collides with another accessor.
+func (a accessor) name(t *TypeGenerator, collides bool) string {
+	if collides {
+		return fmt.Sprintf("%s%s", t.memberName(a.property), a.vocabSuffix)
+	}
+	return fmt.Sprintf("%s%s", strings.Title(a.property.PropertyName()), a.suffix)
+}
+
+// propertyAccessors returns the accessors of a property: one for each value
+// Kind, one for the IRI, and one for any type if the property may hold types.
+func propertyAccessors(property Property) (a []accessor) {
+	var pg *PropertyGenerator
+	switch p := property.(type) {
+	case *FunctionalPropertyGenerator:
+		pg = &p.PropertyGenerator
+	case *NonFunctionalPropertyGenerator:
+		pg = &p.PropertyGenerator
+	default:
+		return
+	}
+	for i, k := range pg.kinds {
+		if !k.isValue() {
+			continue
+		}
+		a = append(a, accessor{
+			property:    property,
+			suffix:      k.Name.CamelName,
+			vocabSuffix: fmt.Sprintf("%s%s", k.Vocab, k.Name.CamelName),
+			ret:         k.ConcreteKind.Clone(),
+			is:          pg.isMethodName(i),
+			get:         pg.getFnName(i),
+			kindName:    fmt.Sprintf("of type %q", k.Name.LowerName),
+		})
+	}
+	a = append(a, accessor{
+		property:    property,
+		suffix:      iriAccessorSuffix,
+		vocabSuffix: iriAccessorSuffix,
+		ret:         jen.Op("*").Qual("net/url", "URL"),
+		is:          isIRIMethod,
+		get:         getIRIMethod,
+		kindName:    "an IRI",
+	})
+	if pg.hasTypeKind() {
+		a = append(a, accessor{
+			property:    property,
+			suffix:      typeAccessorSuffix,
+			vocabSuffix: typeAccessorSuffix,
+			ret:         jen.Qual(pg.GetPublicPackage().Path(), typeInterfaceName),
+			get:         fmt.Sprintf("Get%s", typeInterfaceName),
+			isNotNil:    true,
+			kindName:    "an ActivityStreams type",
+		})
+	}
+	return
+}
+
+// allAccessors returns the nil-safe accessors of the values of every property
+// of this type, such as ContentString, which hide checking whether
+// the property is set, whether it has any values, and whether a value has the
+// Kind.
+func (t *TypeGenerator) allAccessors() (m []*codegen.Method) {
+	var all []accessor
+	names := make(map[string]int)
+	for _, property := range t.allProperties() {
+		for _, a := range propertyAccessors(property) {
+			all = append(all, a)
+			names[a.name(t, false)]++
+		}
+	}
+	for _, a := range all {
+		name := a.name(t, names[a.name(t, false)] > 1)
+		member := jen.Id(codegen.This()).Dot(t.memberName(a.property))
+		var body []jen.Code
+		var comment string
+		if _, ok := a.property.(*NonFunctionalPropertyGenerator); ok {
+			has := jen.Id("iter").Dot(a.is).Call()
+			if a.isNotNil {
+				has = jen.Id("iter").Dot(a.get).Call().Op("!=").Nil()
+			}
+			body = []jen.Code{
+				jen.If(member.Clone().Op("==").Nil()).Block(jen.Return()),
+				jen.For(
+					jen.Id("i").Op(":=").Lit(0),
+					jen.Id("i").Op("<").Add(member.Clone()).Dot(lenMethod).Call(),
+					jen.Id("i").Op("++"),
+				).Block(
+					jen.If(
+						jen.Id("iter").Op(":=").Add(member.Clone()).Dot(atMethodName).Call(jen.Id("i")),
+						has,
+					).Block(
+						jen.Return(jen.Id("iter").Dot(a.get).Call(), jen.True()),
+					),
+				),
+				jen.Return(),
+			}
+			comment = fmt.Sprintf("%s returns the first value of the %q property that is %s, and false if the property is not set or has no such value.", name, a.property.PropertyName(), a.kindName)
+		} else {
+			has := member.Clone().Dot(a.is).Call()
+			if a.isNotNil {
+				has = member.Clone().Dot(a.get).Call().Op("!=").Nil()
+			}
+			body = []jen.Code{
+				jen.If(member.Clone().Op("!=").Nil().Op("&&").Add(has)).Block(
+					jen.Return(member.Clone().Dot(a.get).Call(), jen.True()),
+				),
+				jen.Return(),
+			}
+			comment = fmt.Sprintf("%s returns the value of the %q property if it is %s, and false if the property is not set or has another value.", name, a.property.PropertyName(), a.kindName)
+		}
+		m = append(m, codegen.NewCommentedValueMethod(
+			t.PrivatePackage().Path(),
+			name,
+			t.StructName(),
+			/*params=*/ nil,
+			[]jen.Code{jen.Id("v").Add(a.ret), jen.Id("ok").Bool()},
+			body,
+			comment))
+	}
+	return
+}
+
+// propertyNames returns the name by which each property of this type is
+// obtained and set by GetProperty and SetProperty. It is the name of the
+// property, prefixed with its vocabulary name if another property of the type
+// has the same name.
+func (t *TypeGenerator) propertyNames() (props []Property, names []string) {
+	props = t.allProperties()
+	count := make(map[string]int, len(props))
+	for _, property := range props {
+		count[property.PropertyName()]++
+	}
+	for _, property := range props {
+		name := property.PropertyName()
+		if count[name] > 1 {
+			name = fmt.Sprintf("%s:%s", property.VocabName(), name)
+		}
+		names = append(names, name)
+	}
+	return
+}
+
+// propertyByNameMethods returns the GetProperty and SetProperty methods, which
+// dispatch on the name of a property to its member, without reflection.
+func (t *TypeGenerator) propertyByNameMethods() []*codegen.Method {
+	props, names := t.propertyNames()
+	var getCases, setCases []jen.Code
+	for i, property := range props {
+		member := jen.Id(codegen.This()).Dot(t.memberName(property))
+		getCases = append(getCases, jen.Case(jen.Lit(names[i])).Block(
+			jen.If(member.Clone().Op("==").Nil()).Block(
+				jen.Return(jen.Nil(), jen.True()),
+			),
+			jen.Return(member.Clone(), jen.True()),
+		))
+		setCases = append(setCases, jen.Case(jen.Lit(names[i])).Block(
+			jen.If(jen.Id("v").Op("==").Nil()).Block(
+				member.Clone().Op("=").Nil(),
+				jen.Return(jen.Nil()),
+			),
+			jen.List(jen.Id("p"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Qual(property.GetPublicPackage().Path(), property.InterfaceName())),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit("cannot set property %q of the "+t.TypeName()+" type to a %T"),
+					jen.Id("name"),
+					jen.Id("v"),
+				)),
+			),
+			member.Clone().Op("=").Id("p"),
+			jen.Return(jen.Nil()),
+		))
+	}
+	getCases = append(getCases, jen.Default().Block(
+		jen.Return(jen.Nil(), jen.False()),
+	))
+	setCases = append(setCases, jen.Default().Block(
+		jen.Return(jen.Qual("fmt", "Errorf").Call(
+			jen.Lit("the "+t.TypeName()+" type has no property %q"),
+			jen.Id("name"),
+		)),
+	))
+	get := codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		getPropertyMethod,
+		t.StructName(),
+		[]jen.Code{jen.Id("name").String()},
+		[]jen.Code{jen.Interface(), jen.Bool()},
+		[]jen.Code{
+			jen.Switch(jen.Id("name")).Block(getCases...),
+		},
+		fmt.Sprintf("%s returns the property with the name, such as %q, which is nil if it is not set. Returns false if this type has no such property. A property whose name is shared with another property of this type is named with its vocabulary as a prefix, such as \"ActivityStreams:name\". The value may be passed to %s on another value of this type.", getPropertyMethod, names[0], setPropertyMethod))
+	set := codegen.NewCommentedPointerMethod(
+		t.PrivatePackage().Path(),
+		setPropertyMethod,
+		t.StructName(),
+		[]jen.Code{jen.Id("name").String(), jen.Id("v").Interface()},
+		[]jen.Code{jen.Error()},
+		[]jen.Code{
+			jen.Switch(jen.Id("name")).Block(setCases...),
+		},
+		fmt.Sprintf("%s sets the property with the name, named as by %s, to the value, which must be that property's interface. A nil value clears the property. Returns an error if this type has no such property or the value is of another type.", setPropertyMethod, getPropertyMethod))
+	return []*codegen.Method{get, set}
+}
+
+// applyMapDefinition returns the ApplyMap method, which sets many properties
+// from a plain map of values, and the free functions coercing those values into
+// the forms the deserializers of the properties expect.
+func (t *TypeGenerator) applyMapDefinition() (*codegen.Method, []*codegen.Function) {
+	props, names := t.propertyNames()
+	var cases []jen.Code
+	for i, property := range props {
+		member := jen.Id(codegen.This()).Dot(t.memberName(property))
+		deser := t.m.getDeserializationMethodForProperty(property).On(managerInitName()).Call().Call(
+			jen.Map(jen.String()).Interface().Values(jen.Dict{
+				jen.Lit(property.PropertyName()): jen.Id("v"),
+			}),
+			jen.Nil(),
+		)
+		// A value the deserializer does not recognize is kept as unknown
+		// instead of being an error, so it is rejected here.
+		failed := jen.Id("e").Op("!=").Nil().Op("||").Id("p").Op("==").Nil()
+		var known []jen.Code
+		if _, ok := property.(*NonFunctionalPropertyGenerator); ok {
+			known = append(known, jen.If(failed).Block(
+				jen.Return(jen.False()),
+			), jen.For(
+				jen.Id("iter").Op(":=").Id("p").Dot(beginMethod).Call(),
+				jen.Id("iter").Op("!=").Id("p").Dot(endMethod).Call(),
+				jen.Id("iter").Op("=").Id("iter").Dot(nextMethod).Call(),
+			).Block(
+				jen.If(jen.Op("!").Id("iter").Dot(hasAnyMethod).Call()).Block(
+					jen.Return(jen.False()),
+				),
+			))
+		} else {
+			known = append(known, jen.If(failed.Op("||").Op("!").Id("p").Dot(hasAnyMethod).Call()).Block(
+				jen.Return(jen.False()),
+			))
+		}
+		cases = append(cases, jen.Case(jen.Lit(names[i])).Block(
+			jen.If(jen.Id("m").Index(jen.Id("k")).Op("==").Nil()).Block(
+				member.Clone().Op("=").Nil(),
+			).Else().Block(
+				jen.Err().Op("=").Id(applyMapValueFn).Call(
+					jen.Id("m").Index(jen.Id("k")),
+					jen.Func().Params(jen.Id("v").Interface()).Bool().Block(
+						append(append([]jen.Code{
+							jen.List(jen.Id("p"), jen.Id("e")).Op(":=").Add(deser),
+						}, known...),
+							member.Clone().Op("=").Id("p"),
+							jen.Return(jen.True()),
+						)...,
+					),
+				),
+			),
+		))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Err().Op("=").Qual("fmt", "Errorf").Call(jen.Lit("no such property")),
+	))
+	apply := codegen.NewCommentedPointerMethod(
+		t.PrivatePackage().Path(),
+		applyMapMethod,
+		t.StructName(),
+		[]jen.Code{jen.Id("m").Map(jen.String()).Interface()},
+		[]jen.Code{jen.Error()},
+		[]jen.Code{
+			jen.Id("keys").Op(":=").Make(jen.Index().String(), jen.Lit(0), jen.Len(jen.Id("m"))),
+			jen.For(jen.Id("k").Op(":=").Range().Id("m")).Block(
+				jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("k")),
+			),
+			jen.Qual("sort", "Strings").Call(jen.Id("keys")),
+			jen.Var().Id("errs").Index().String(),
+			jen.For(jen.List(jen.Id("_"), jen.Id("k")).Op(":=").Range().Id("keys")).Block(
+				jen.Var().Err().Error(),
+				jen.Switch(jen.Id("k")).Block(cases...),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Id("errs").Op("=").Append(jen.Id("errs"), jen.Qual("fmt", "Sprintf").Call(jen.Lit("%q: %s"), jen.Id("k"), jen.Err())),
+				),
+			),
+			jen.If(jen.Len(jen.Id("errs")).Op(">").Lit(0)).Block(
+				jen.Return(jen.Qual("fmt", "Errorf").Call(
+					jen.Lit("cannot apply %d of %d values to the "+t.TypeName()+" type: %s"),
+					jen.Len(jen.Id("errs")),
+					jen.Len(jen.Id("m")),
+					jen.Qual("strings", "Join").Call(jen.Id("errs"), jen.Lit("; ")),
+				)),
+			),
+			jen.Return(jen.Nil()),
+		},
+		fmt.Sprintf("%s sets the properties named by the keys of the map, named as by %s, to its values. A nil value clears the property. The values may be those of a map unmarshalled from JSON, or plain Go values such as strings, numbers, bools, time.Time, *url.URL, and slices of them. A string is also tried as a number or a bool if the property does not accept it as is, such as the values of a submitted form. Every value that can be applied is, and the returned error lists each key that could not be.", applyMapMethod, getPropertyMethod))
+	applyValue := codegen.NewCommentedFunction(
+		t.PrivatePackage().Path(),
+		applyMapValueFn,
+		[]jen.Code{jen.Id("v").Interface(), jen.Id("apply").Func().Params(jen.Interface()).Bool()},
+		[]jen.Code{jen.Error()},
+		[]jen.Code{
+			jen.For(jen.List(jen.Id("_"), jen.Id("c")).Op(":=").Range().Id(coerceMapValueFn).Call(jen.Id("v"))).Block(
+				jen.If(jen.Id("apply").Call(jen.Id("c"))).Block(
+					jen.Return(jen.Nil()),
+				),
+			),
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("cannot apply a value of type %T"), jen.Id("v"))),
+		},
+		fmt.Sprintf("%s calls apply with each form %s coerces the value into, until one is applied. Returns an error if none is.", applyMapValueFn, coerceMapValueFn))
+	elems := func(slice string, conv jen.Code) []jen.Code {
+		return []jen.Code{
+			jen.Id("s").Op(":=").Make(jen.Index().Interface(), jen.Len(jen.Id(slice))),
+			jen.For(jen.List(jen.Id("i"), jen.Id("e")).Op(":=").Range().Id(slice)).Block(
+				jen.Id("s").Index(jen.Id("i")).Op("=").Add(conv),
+			),
+			jen.Return(jen.Index().Interface().Values(jen.Id("s"))),
+		}
+	}
+	coerce := codegen.NewCommentedFunction(
+		t.PrivatePackage().Path(),
+		coerceMapValueFn,
+		[]jen.Code{jen.Id("v").Interface()},
+		[]jen.Code{jen.Index().Interface()},
+		[]jen.Code{
+			jen.Switch(jen.Id("t").Op(":=").Id("v").Assert(jen.Type())).Block(
+				jen.Case(jen.String()).Block(
+					jen.Id("c").Op(":=").Index().Interface().Values(jen.Id("t")),
+					jen.If(
+						jen.List(jen.Id("f"), jen.Err()).Op(":=").Qual("strconv", "ParseFloat").Call(jen.Id("t"), jen.Lit(64)),
+						jen.Err().Op("==").Nil(),
+					).Block(
+						jen.Id("c").Op("=").Append(jen.Id("c"), jen.Id("f")),
+					),
+					jen.If(
+						jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("strconv", "ParseBool").Call(jen.Id("t")),
+						jen.Err().Op("==").Nil(),
+					).Block(
+						jen.Id("c").Op("=").Append(jen.Id("c"), jen.Id("b")),
+					),
+					jen.Return(jen.Id("c")),
+				),
+				jen.Case(jen.Int()).Block(
+					jen.Return(jen.Index().Interface().Values(jen.Float64().Call(jen.Id("t")))),
+				),
+				jen.Case(jen.Int64()).Block(
+					jen.Return(jen.Index().Interface().Values(jen.Float64().Call(jen.Id("t")))),
+				),
+				jen.Case(jen.Float32()).Block(
+					jen.Return(jen.Index().Interface().Values(jen.Float64().Call(jen.Id("t")))),
+				),
+				jen.Case(jen.Op("*").Qual("net/url", "URL")).Block(
+					jen.Return(jen.Index().Interface().Values(jen.Id("t").Dot("String").Call())),
+				),
+				jen.Case(jen.Qual("time", "Time")).Block(
+					jen.Return(jen.Index().Interface().Values(jen.Id("t").Dot("Format").Call(jen.Qual("time", "RFC3339")))),
+				),
+				jen.Case(jen.Index().String()).Block(
+					elems("t", jen.Id("e"))...,
+				),
+				jen.Case(jen.Index().Op("*").Qual("net/url", "URL")).Block(
+					elems("t", jen.Id("e").Dot("String").Call())...,
+				),
+				jen.Case(jen.Index().Interface()).Block(
+					elems("t", jen.Id(coerceMapValueFn).Call(jen.Id("e")).Index(jen.Lit(0)))...,
+				),
+			),
+			jen.Return(jen.Index().Interface().Values(jen.Id("v"))),
+		},
+		fmt.Sprintf("%s returns the forms of a value unmarshalled from JSON that the value may be given as, in order of preference.", coerceMapValueFn))
+	return apply, []*codegen.Function{applyValue, coerce}
+}
+
+// stringMethods returns the String and GoString methods, which print the id
+// and the other set properties of a value compactly for logs and debugging.
+func (t *TypeGenerator) stringMethods() (str, goStr *codegen.Method) {
+	props, names := t.propertyNames()
+	var idCode jen.Code = jen.Empty()
+	var propCode []jen.Code
+	for i, property := range props {
+		member := jen.Id(codegen.This()).Dot(t.memberName(property))
+		code := jen.If(member.Clone().Op("!=").Nil()).Block(
+			jen.Id("s").Op("=").Append(jen.Id("s"), jen.Lit(names[i]+": ").Op("+").Add(member.Clone()).Dot(stringMethod).Call()),
+		)
+		switch property.PropertyName() {
+		case "type":
+			// The type is already the name of the representation.
+		case "id":
+			idCode = code
+		default:
+			propCode = append(propCode, code)
+		}
+	}
+	str = codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		stringMethodName,
+		t.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.String()},
+		append(append([]jen.Code{
+			jen.Var().Id("s").Index().String(),
+			idCode,
+		}, propCode...),
+			jen.If(jen.Len(jen.Id(codegen.This()).Dot(unknownMember)).Op(">").Lit(0)).Block(
+				jen.Id("s").Op("=").Append(jen.Id("s"), jen.Qual("fmt", "Sprintf").Call(
+					jen.Lit("+%d unknown"),
+					jen.Len(jen.Id(codegen.This()).Dot(unknownMember)),
+				)),
+			),
+			jen.Return(jen.Lit(t.TypeName()+"{").Op("+").Qual("strings", "Join").Call(jen.Id("s"), jen.Lit(", ")).Op("+").Lit("}")),
+		),
+		fmt.Sprintf("%s returns a compact, human-readable form of this %s for logs and debugging, such as %s{id: https://example.com/1, name: \"Example\"}. It lists the id first and then each other property that is set, with long values shortened. It is not a serialization; use %s for that.", stringMethodName, t.TypeName(), t.TypeName(), serializeMethodName))
+	goStr = codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		goStringMethod,
+		t.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.String()},
+		[]jen.Code{
+			jen.Return(jen.Id(codegen.This()).Dot(stringMethodName).Call()),
+		},
+		fmt.Sprintf("%s returns the same form as %s, so that printing this value with the %%#v verb or in a debugger is readable instead of a dump of its members.", goStringMethod, stringMethodName))
+	return
+}
+
 // getAllManagerMethods returns all the manager methods used by this type.
 func (t *TypeGenerator) getAllManagerMethods() (m []*codegen.Method) {
 	for _, prop := range t.allProperties() {
@@ -1097,6 +1654,24 @@ func (t *TypeGenerator) constructorFn() *codegen.Function {
 
 // contextMethod returns a map of the context's vocabulary
 func (t *TypeGenerator) contextMethods() []*codegen.Method {
+	if t.ownContextOnly {
+		ctxMethod := codegen.NewCommentedValueMethod(
+			t.PrivatePackage().Path(),
+			contextMethod,
+			t.StructName(),
+			/*params=*/ nil,
+			[]jen.Code{jen.Map(jen.String()).String()},
+			[]jen.Code{
+				jen.Commentf("Every property of this type, and every type they may\nhold, is in this type's vocabulary, so the context is\ndetermined at code-generation time."),
+				jen.Return(jen.Map(jen.String()).String().Values(
+					jen.Dict{
+						jen.Lit(t.vocabURI.String()): jen.Id(codegen.This()).Dot(aliasMember),
+					},
+				)),
+			},
+			fmt.Sprintf("%s returns the JSONLD URIs required in the context string for this type and the specific properties that are set. The value in the map is the alias used to import the type and its properties.", contextMethod))
+		return []*codegen.Method{ctxMethod}
+	}
 	helperName := fmt.Sprintf("helper%s", contextMethod)
 	helper := codegen.NewCommentedValueMethod(
 		t.PrivatePackage().Path(),
@@ -0,0 +1,166 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+)
+
+const (
+	shallowViewName          = "ShallowView"
+	shallowViewFnPrefix      = "To"
+	shallowViewIdField       = "Id"
+	shallowViewTypeField     = "Type"
+	shallowViewPublishedName = "published"
+	shallowViewPublishedKind = "dateTime"
+)
+
+// shallowViewIRIFields are the properties whose IRIs are captured by a
+// ShallowView, alongside the name of the field capturing them.
+//
+// Like the 'type' and 'id' kluges, these refer to specific properties by name.
+var shallowViewIRIFields = []struct {
+	property string
+	field    string
+}{
+	{"actor", "Actor"},
+	{"to", "To"},
+	{"bto", "Bto"},
+	{"cc", "Cc"},
+	{"bcc", "Bcc"},
+	{"audience", "Audience"},
+}
+
+// ShallowViewDefinition generates the ShallowView struct, a lightweight
+// projection of the id, type, actor, published, and audience values of any
+// ActivityStreams type. It is accompanied by a function that extracts the
+// projection from any of the provided types.
+func ShallowViewDefinition(pkg Package, tgs []*TypeGenerator) *codegen.Struct {
+	members := []jen.Code{
+		jen.Id(shallowViewIdField).Op("*").Qual("net/url", "URL"),
+		jen.Id(shallowViewTypeField).String(),
+		jen.Id("Published").Qual("time", "Time"),
+	}
+	for _, f := range shallowViewIRIFields {
+		members = append(members, jen.Id(f.field).Index().Op("*").Qual("net/url", "URL"))
+	}
+	cases := make([]jen.Code, 0, len(tgs)+1)
+	for _, tg := range tgs {
+		cases = append(cases, jen.Case(
+			jen.Qual(tg.PublicPackage().Path(), tg.InterfaceName()),
+		).Block(
+			jen.Return(jen.Id(shallowViewFnName(tg)).Call(jen.Id("v"))),
+		))
+	}
+	cases = append(cases, jen.Default().Block(
+		jen.Id("sv").Op(":=").Id(shallowViewName).Values(jen.Dict{
+			jen.Id(shallowViewTypeField): jen.Id("t").Dot(typeNameMethod).Call(),
+		}),
+		jen.If(
+			jen.Id("id").Op(":=").Id("t").Dot(getIdFunction).Call(),
+			jen.Id("id").Op("!=").Nil(),
+		).Block(
+			jen.Id("sv").Dot(shallowViewIdField).Op("=").Id("id").Dot(getMethod).Call(),
+		),
+		jen.Return(jen.Id("sv")),
+	))
+	var typePkg string
+	if len(tgs) > 0 {
+		typePkg = tgs[0].PublicPackage().Path()
+	}
+	toFn := codegen.NewCommentedFunction(
+		pkg.Path(),
+		shallowViewFnPrefix+shallowViewName,
+		[]jen.Code{jen.Id("t").Qual(typePkg, typeInterfaceName)},
+		[]jen.Code{jen.Id(shallowViewName)},
+		[]jen.Code{
+			jen.Switch(jen.Id("v").Op(":=").Id("t").Assert(jen.Type())).Block(cases...),
+		},
+		fmt.Sprintf("%s%s extracts the %s of any ActivityStreams type. Types not known to this package only have their id and type extracted.", shallowViewFnPrefix, shallowViewName, shallowViewName))
+	return codegen.NewStruct(
+		fmt.Sprintf("%s is a lightweight projection of an ActivityStreams type, capturing its id, type, actor, published, and audience values. It is intended for uses such as search indexing and timeline storage, where the full type is not needed. Embedded values are captured by their id.", shallowViewName),
+		shallowViewName,
+		/*methods=*/ nil,
+		[]*codegen.Function{toFn},
+		members)
+}
+
+// shallowViewFnName is the name of the function extracting a ShallowView from
+// the type.
+func shallowViewFnName(tg *TypeGenerator) string {
+	return fmt.Sprintf("%s%s%s", shallowViewFnPrefix, tg.InterfaceName(), shallowViewName)
+}
+
+// ShallowViewFunctions generates one function per type extracting its
+// ShallowView. Only the properties the type has are inspected, so no type
+// assertions are needed at runtime.
+func ShallowViewFunctions(pkg Package, tgs []*TypeGenerator) (fns []*codegen.Function) {
+	for _, tg := range tgs {
+		props := make(map[string]Property)
+		for _, p := range tg.allProperties() {
+			props[p.PropertyName()] = p
+		}
+		body := []jen.Code{
+			jen.Id("v").Op(":=").Id(shallowViewName).Values(jen.Dict{
+				jen.Id(shallowViewTypeField): jen.Id("t").Dot(typeNameMethod).Call(),
+			}),
+			jen.If(
+				jen.Id("id").Op(":=").Id("t").Dot(getIdFunction).Call(),
+				jen.Id("id").Op("!=").Nil(),
+			).Block(
+				jen.Id("v").Dot(shallowViewIdField).Op("=").Id("id").Dot(getMethod).Call(),
+			),
+		}
+		if p, ok := props[shallowViewPublishedName].(*FunctionalPropertyGenerator); ok && len(p.kinds) == 1 && p.kinds[0].Name.LowerName == shallowViewPublishedKind {
+			body = append(body, jen.If(
+				jen.Id("p").Op(":=").Id("t").Dot(fmt.Sprintf(getMethodFormat, tg.memberName(p))).Call(),
+				jen.Id("p").Op("!=").Nil().Op("&&").Id("p").Dot(p.isMethodName(0)).Call(),
+			).Block(
+				jen.Id("v").Dot("Published").Op("=").Id("p").Dot(getMethod).Call(),
+			))
+		}
+		for _, f := range shallowViewIRIFields {
+			p, ok := props[f.property].(*NonFunctionalPropertyGenerator)
+			if !ok {
+				continue
+			}
+			appendIRI := jen.If(
+				jen.Id("iter").Dot(isIRIMethod).Call(),
+			).Block(
+				jen.Id("v").Dot(f.field).Op("=").Append(jen.Id("v").Dot(f.field), jen.Id("iter").Dot(getIRIMethod).Call()),
+			)
+			if p.hasTypeKind() {
+				appendIRI = appendIRI.Else().If(
+					jen.Id("tv").Op(":=").Id("iter").Dot(fmt.Sprintf("Get%s", typeInterfaceName)).Call(),
+					jen.Id("tv").Op("!=").Nil(),
+				).Block(
+					jen.If(
+						jen.Id("id").Op(":=").Id("tv").Dot(getIdFunction).Call(),
+						jen.Id("id").Op("!=").Nil(),
+					).Block(
+						jen.Id("v").Dot(f.field).Op("=").Append(jen.Id("v").Dot(f.field), jen.Id("id").Dot(getMethod).Call()),
+					),
+				)
+			}
+			body = append(body, jen.If(
+				jen.Id("p").Op(":=").Id("t").Dot(fmt.Sprintf(getMethodFormat, tg.memberName(p))).Call(),
+				jen.Id("p").Op("!=").Nil(),
+			).Block(
+				jen.For(
+					jen.Id("iter").Op(":=").Id("p").Dot(beginMethod).Call(),
+					jen.Id("iter").Op("!=").Id("p").Dot(endMethod).Call(),
+					jen.Id("iter").Op("=").Id("iter").Dot(nextMethod).Call(),
+				).Block(appendIRI),
+			))
+		}
+		body = append(body, jen.Return(jen.Id("v")))
+		fns = append(fns, codegen.NewCommentedFunction(
+			pkg.Path(),
+			shallowViewFnName(tg),
+			[]jen.Code{jen.Id("t").Qual(tg.PublicPackage().Path(), tg.InterfaceName())},
+			[]jen.Code{jen.Id(shallowViewName)},
+			body,
+			fmt.Sprintf("%s extracts the %s of the %s type.", shallowViewFnName(tg), shallowViewName, tg.TypeName())))
+	}
+	return
+}
@@ -18,10 +18,11 @@ import (
 )
 
 const (
-	pathFlag = "path"
-	specFlag = "spec"
-	helpText = `
-Usage: astool [-spec=<file>] [-path=<gopath prefix>] <directory>
+	pathFlag    = "path"
+	specFlag    = "spec"
+	graphQLFlag = "graphql"
+	helpText    = `
+Usage: astool [-spec=<file>] [-path=<gopath prefix>] [-graphql] <directory>
 
 The ActivityStreams tool (astool) is used to generate ActivityStreams types,
 properties, and values from an OWL2 RDF specification. The tool generates the
@@ -77,6 +78,18 @@ given a particular specification for a <vocabulary>:
 	    - Constructors of properties in the specified vocabulary.
 	gen_pkg_<vocabulary>_type_constructors.go
 	    - Constructors of types in the specified vocabulary.
+	gen_pkg_<vocabulary>_to.go
+	    - Functions converting a generic type into a specific type in the
+	      specified vocabulary.
+	gen_pkg_<vocabulary>_shallow_views.go
+	    - Functions extracting a ShallowView of types in the specified
+	      vocabulary.
+	gen_activity_pairings.go
+	    - Table of the object types activities conventionally wrap and the
+	      category of their side effects.
+	gen_shallow_view.go
+	    - Definition of ShallowView, a lightweight projection of a type
+	      for indexing and storage.
 
 	resolver/
 	    gen_type_resolver.go
@@ -118,6 +131,21 @@ given a particular specification for a <vocabulary>:
 		- NOTE: Application developers should strongly prefer using the
 		  interfaces in "vocab" over these.
 
+	names/
+	    gen_names.go
+	        - Typed constants for the type names, property names, and
+		  context IRIs of all vocabularies.
+
+	registry/
+	    gen_registry.go
+	        - Definition of Registry, an alternative to the Manager that
+		  only initializes and links the types registered with it.
+
+	graphql/
+	    gen_graphql.go
+	        - GraphQL schema and resolver shims for the types. Only
+		  generated when the 'graphql' flag is set.
+
 This tool is geared for three kinds of developers:
 
 1) Application developers can use the tool to generate the native Go types
@@ -226,8 +254,9 @@ func (s settableString) IsSet() bool {
 // CommandLineFlags manages the flags defined by this tool.
 type CommandLineFlags struct {
 	// Flags
-	specs list
-	path  settableString
+	specs   list
+	path    settableString
+	graphQL bool
 	// Additional data
 	pathAutoDetected bool
 	// Destination on the file system for the code generation
@@ -244,6 +273,7 @@ func NewCommandLineFlags() (*CommandLineFlags, error) {
 		pathFlag,
 		"Package path to use for all generated package paths. If using GOPATH, this is automatically detected as $GOPATH/<path>/ when generating in a subdirectory. Cannot be explicitly set to be empty.")
 	flag.Var(&(c.specs), specFlag, "Input JSON-LD specification used to generate Go code.")
+	flag.BoolVar(&c.graphQL, graphQLFlag, false, "Additionally generate a GraphQL schema and resolver shims for the types.")
 	flag.Parse()
 	args := flag.Args()
 	if len(args) != 1 {
@@ -337,6 +367,11 @@ func (c *CommandLineFlags) Path() string {
 	return c.path.String()
 }
 
+// GraphQL returns the graphql flag.
+func (c *CommandLineFlags) GraphQL() bool {
+	return c.graphQL
+}
+
 // NewPackageManager creates the correct package manager for the flag inputs.
 func (c *CommandLineFlags) NewPackageManager() *gen.PackageManager {
 	g := gen.NewPackageManager(c.Path(), "")
@@ -392,8 +427,9 @@ func main() {
 	// Convert to generated code
 	fmt.Printf("Converting %d types, properties, and values...\n", p.Size())
 	c := &convert.Converter{
-		GenRoot:       cmd.NewPackageManager(),
-		PackagePolicy: convert.IndividualUnderRoot,
+		GenRoot:         cmd.NewPackageManager(),
+		PackagePolicy:   convert.IndividualUnderRoot,
+		GenerateGraphQL: cmd.GraphQL(),
 	}
 	f, err := c.Convert(p)
 	if err != nil {
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/astool/convert"
+	"github.com/go-fed/activity/astool/gen"
+	"github.com/go-fed/activity/astool/rdf"
+	"strings"
+	"testing"
+)
+
+func TestAliasedType(t *testing.T) {
+	cmd := &CommandLineFlags{specs: list{"activitystreams.jsonld", "testdata/alias_spec.jsonld"}}
+	j, err := cmd.ReadSpecs()
+	if err != nil {
+		t.Fatalf("ReadSpecs returned error: %s", err)
+	}
+	p, err := rdf.ParseVocabularies(registry, j)
+	if err != nil {
+		t.Fatalf("ParseVocabularies returned error: %s", err)
+	}
+	vt, ok := p.Vocab.Types["Hashtag"]
+	if !ok {
+		t.Fatalf("expected the Hashtag type to be parsed")
+	} else if len(vt.SameAs) != 1 || vt.SameAs[0].Name != "HashTag" {
+		t.Fatalf("unexpected owl:sameAs of the Hashtag type: %v", vt.SameAs)
+	}
+	c := &convert.Converter{
+		GenRoot:       gen.NewPackageManager("example.com/gen", ""),
+		PackagePolicy: convert.IndividualUnderRoot,
+	}
+	f, err := c.Convert(p)
+	if err != nil {
+		t.Fatalf("Convert returned error: %s", err)
+	}
+	var found bool
+	for _, file := range f {
+		if file.FileName != "gen_type_fakevocabulary_hashtag.go" {
+			continue
+		}
+		found = true
+		code := fmt.Sprintf("%#v", file.F)
+		if !strings.Contains(code, `typeName == "Hashtag" || typeName == "HashTag"`) {
+			t.Errorf("expected the HashTag spelling to be accepted when deserializing:\n%s", code)
+		}
+		if !strings.Contains(code, `return "Hashtag"`) || strings.Contains(code, `return "HashTag"`) {
+			t.Errorf("expected the Hashtag spelling to be serialized:\n%s", code)
+		}
+	}
+	if !found {
+		t.Fatalf("expected the Hashtag type to be generated")
+	}
+}
@@ -222,6 +222,7 @@ type VocabularyType struct {
 	URI               *url.URL
 	Notes             string
 	DisjointWith      []VocabularyReference
+	SameAs            []VocabularyReference
 	Extends           []VocabularyReference
 	Examples          []VocabularyExample
 	Properties        []VocabularyReference
@@ -230,7 +231,7 @@ type VocabularyType struct {
 
 // String returns a printable version of this type, for debugging.
 func (v VocabularyType) String() string {
-	return fmt.Sprintf("Type=%s,%s,%s\n\tDJW=%s\n\tExt=%s\n\tEx=%s", v.Name, v.URI, v.Notes, v.DisjointWith, v.Extends, v.Examples)
+	return fmt.Sprintf("Type=%s,%s,%s\n\tDJW=%s\n\tSame=%s\n\tExt=%s\n\tEx=%s", v.Name, v.URI, v.Notes, v.DisjointWith, v.SameAs, v.Extends, v.Examples)
 }
 
 // SetName sets the name of this type.
@@ -10,6 +10,7 @@ const (
 	owlSpec                = "http://www.w3.org/2002/07/owl#"
 	membersSpec            = "members"
 	disjointWithSpec       = "disjointWith"
+	sameAsSpec             = "sameAs"
 	unionOfSpec            = "unionOf"
 	importsSpec            = "imports"
 	ontologySpec           = "Ontology"
@@ -49,6 +50,12 @@ func (o *OWLOntology) LoadAsAlias(s string) ([]rdf.RDFNode, error) {
 			Name:     disjointWithSpec,
 			Delegate: &disjointWith{},
 		},
+		&rdf.AliasedDelegate{
+			Spec:     owlSpec,
+			Alias:    s,
+			Name:     sameAsSpec,
+			Delegate: &sameAs{},
+		},
 		&rdf.AliasedDelegate{
 			Spec:     owlSpec,
 			Alias:    s,
@@ -109,6 +116,15 @@ func (o *OWLOntology) LoadSpecificAsAlias(alias, name string) ([]rdf.RDFNode, er
 				Delegate: &disjointWith{},
 			},
 		}, nil
+	case sameAsSpec:
+		return []rdf.RDFNode{
+			&rdf.AliasedDelegate{
+				Spec:     "",
+				Alias:    "",
+				Name:     alias,
+				Delegate: &sameAs{},
+			},
+		}, nil
 	case unionOfSpec:
 		return []rdf.RDFNode{
 			&rdf.AliasedDelegate{
@@ -228,6 +244,8 @@ func (o *OWLOntology) GetByName(name string) (rdf.RDFNode, error) {
 		return &members{}, nil
 	case disjointWithSpec:
 		return &disjointWith{}, nil
+	case sameAsSpec:
+		return &sameAs{}, nil
 	case unionOfSpec:
 		return &unionOf{}, nil
 	case importsSpec:
@@ -332,6 +350,48 @@ func (d *disjointWith) Apply(key string, value interface{}, ctx *rdf.ParsingCont
 	return true, fmt.Errorf("owl disjointWith cannot be applied")
 }
 
+var _ rdf.RDFNode = &sameAs{}
+
+// sameAs represents owl:sameAs.
+//
+// It is used to declare alternate spellings of a type, which are accepted when
+// deserializing but never used when serializing.
+type sameAs struct{}
+
+// Enter ensures the Current is a Type, then pushes a Reference.
+func (s *sameAs) Enter(key string, ctx *rdf.ParsingContext) (bool, error) {
+	// Push the Current type aside, to build a Reference.
+	if ctx.Current == nil {
+		return true, fmt.Errorf("owl sameAs enter given a nil Current")
+	} else if _, ok := ctx.Current.(*rdf.VocabularyType); !ok {
+		return true, fmt.Errorf("owl sameAs enter not given a *rdf.VocabularyType")
+	}
+	ctx.Push()
+	ctx.Current = &rdf.VocabularyReference{}
+	return true, nil
+}
+
+// Exit pops the Reference and adds it to the Type's SameAs.
+func (s *sameAs) Exit(key string, ctx *rdf.ParsingContext) (bool, error) {
+	// Pop the Reference, put into the type.
+	ref, ok := ctx.Current.(*rdf.VocabularyReference)
+	if !ok {
+		return true, fmt.Errorf("owl sameAs exit not given a *rdf.VocabularyReference")
+	}
+	ctx.Pop()
+	vType, ok := ctx.Current.(*rdf.VocabularyType)
+	if !ok {
+		return true, fmt.Errorf("owl sameAs exit not given a *rdf.VocabularyType")
+	}
+	vType.SameAs = append(vType.SameAs, *ref)
+	return true, nil
+}
+
+// Apply returns an error.
+func (s *sameAs) Apply(key string, value interface{}, ctx *rdf.ParsingContext) (bool, error) {
+	return true, fmt.Errorf("owl sameAs cannot be applied")
+}
+
 var _ rdf.RDFNode = &unionOf{}
 
 // unionOf represents owl:unionOf.
@@ -0,0 +1,56 @@
+// Command benchgate compares two runs of benchmarks and exits with a non-zero
+// status if any benchmark regressed.
+//
+// Usage:
+//
+//	benchgate [-threshold 0.1] base.txt head.txt
+//
+// Both files contain the output of 'go test -bench'. Every benchmark in both
+// runs is reported, and those whose time or memory per operation grew by more
+// than the threshold, or which allocate more often, are marked as regressed.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/go-fed/activity/benchmarks"
+	"os"
+)
+
+var threshold = flag.Float64("threshold", 0.1, "Fraction by which the time or memory per operation of a benchmark may grow before it regressed")
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "usage: benchgate [-threshold 0.1] base.txt head.txt")
+		os.Exit(2)
+	}
+	base, err := parseFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	head, err := parseFile(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	deltas := benchmarks.Compare(base, head, *threshold)
+	for _, d := range deltas {
+		fmt.Println(d)
+	}
+	if r := benchmarks.Regressions(deltas); len(r) > 0 {
+		fmt.Printf("%d of %d benchmarks regressed\n", len(r), len(deltas))
+		os.Exit(1)
+	}
+}
+
+// parseFile parses the benchmark results in the file.
+func parseFile(name string) (benchmarks.Results, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return benchmarks.Parse(f)
+}
@@ -0,0 +1,179 @@
+package benchmarks
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Result is the measurement of a benchmark. When a benchmark was run more than
+// once, each value is the median of its runs.
+type Result struct {
+	// Name is the name of the benchmark, without its GOMAXPROCS suffix.
+	Name string
+	// NsPerOp is the time taken per operation, in nanoseconds.
+	NsPerOp float64
+	// BytesPerOp is the memory allocated per operation, in bytes. It is
+	// only measured with -benchmem or b.ReportAllocs.
+	BytesPerOp float64
+	// AllocsPerOp is the number of allocations per operation. It is only
+	// measured with -benchmem or b.ReportAllocs.
+	AllocsPerOp float64
+	// Runs is the number of runs the values are the median of.
+	Runs int
+}
+
+// Results are the Results of a run of benchmarks, by name.
+type Results map[string]Result
+
+// Parse reads the output of 'go test -bench' and returns the Results of its
+// benchmarks. Lines other than benchmark results are ignored.
+func Parse(r io.Reader) (Results, error) {
+	runs := make(map[string][]Result)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		res, ok, err := parseLine(s.Text())
+		if err != nil {
+			return nil, err
+		} else if ok {
+			runs[res.Name] = append(runs[res.Name], res)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	results := make(Results, len(runs))
+	for name, rs := range runs {
+		results[name] = median(name, rs)
+	}
+	return results, nil
+}
+
+// parseLine parses a benchmark result line, such as:
+//
+//	BenchmarkDeserialize-8   10000   123456 ns/op   2048 B/op   32 allocs/op
+//
+// Returns false if the line is not a benchmark result.
+func parseLine(line string) (r Result, ok bool, err error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return
+	} else if _, err := strconv.Atoi(fields[1]); err != nil {
+		return r, false, nil
+	}
+	r.Name = fields[0]
+	if i := strings.LastIndex(r.Name, "-"); i > 0 {
+		if _, err := strconv.Atoi(r.Name[i+1:]); err == nil {
+			r.Name = r.Name[:i]
+		}
+	}
+	r.Runs = 1
+	for i := 2; i+1 < len(fields); i += 2 {
+		var v float64
+		v, err = strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return r, false, fmt.Errorf("cannot parse %q of benchmark %s: %s", fields[i], r.Name, err)
+		}
+		switch fields[i+1] {
+		case "ns/op":
+			r.NsPerOp = v
+		case "B/op":
+			r.BytesPerOp = v
+		case "allocs/op":
+			r.AllocsPerOp = v
+		}
+	}
+	return r, true, nil
+}
+
+// median returns the Result of the median of each value of the runs.
+func median(name string, rs []Result) Result {
+	m := func(f func(r Result) float64) float64 {
+		v := make([]float64, len(rs))
+		for i, r := range rs {
+			v[i] = f(r)
+		}
+		sort.Float64s(v)
+		if len(v)%2 == 1 {
+			return v[len(v)/2]
+		}
+		return (v[len(v)/2-1] + v[len(v)/2]) / 2
+	}
+	return Result{
+		Name:        name,
+		NsPerOp:     m(func(r Result) float64 { return r.NsPerOp }),
+		BytesPerOp:  m(func(r Result) float64 { return r.BytesPerOp }),
+		AllocsPerOp: m(func(r Result) float64 { return r.AllocsPerOp }),
+		Runs:        len(rs),
+	}
+}
+
+// Delta is the change of a benchmark between two runs.
+type Delta struct {
+	Base Result
+	Head Result
+	// Regressed is true if the time or memory per operation of the head
+	// run grew beyond the threshold, or if it allocates more often.
+	Regressed bool
+}
+
+// Ratio is the time per operation of the head run relative to the base run,
+// which is below 1 if the benchmark sped up.
+func (d Delta) Ratio() float64 {
+	if d.Base.NsPerOp == 0 {
+		return 1
+	}
+	return d.Head.NsPerOp / d.Base.NsPerOp
+}
+
+// String formats the Delta as a line of a report.
+func (d Delta) String() string {
+	mark := ""
+	if d.Regressed {
+		mark = "  REGRESSED"
+	}
+	return fmt.Sprintf("%-50s %12.0f -> %12.0f ns/op (%+.1f%%) %10.0f -> %10.0f B/op %8.0f -> %8.0f allocs/op%s",
+		d.Head.Name,
+		d.Base.NsPerOp, d.Head.NsPerOp, (d.Ratio()-1)*100,
+		d.Base.BytesPerOp, d.Head.BytesPerOp,
+		d.Base.AllocsPerOp, d.Head.AllocsPerOp,
+		mark)
+}
+
+// Compare returns the Deltas of the benchmarks in both runs, sorted by name. A
+// benchmark regressed if its time or memory per operation grew by more than
+// the threshold, a fraction such as 0.1 for 10%, or if it allocates more
+// often at all.
+func Compare(base, head Results, threshold float64) []Delta {
+	var d []Delta
+	for name, h := range head {
+		b, ok := base[name]
+		if !ok {
+			continue
+		}
+		d = append(d, Delta{
+			Base: b,
+			Head: h,
+			Regressed: h.NsPerOp > b.NsPerOp*(1+threshold) ||
+				h.BytesPerOp > b.BytesPerOp*(1+threshold) ||
+				h.AllocsPerOp > b.AllocsPerOp,
+		})
+	}
+	sort.Slice(d, func(i, j int) bool {
+		return d[i].Head.Name < d[j].Head.Name
+	})
+	return d
+}
+
+// Regressions returns the Deltas that regressed.
+func Regressions(d []Delta) (r []Delta) {
+	for _, delta := range d {
+		if delta.Regressed {
+			r = append(r, delta)
+		}
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package benchmarks
+
+import (
+	"strings"
+	"testing"
+)
+
+const testBase = `goos: linux
+goarch: amd64
+pkg: github.com/go-fed/activity/benchmarks
+BenchmarkDeserialize/Actor-8         	   20000	     60000 ns/op	   1000 B/op	      40 allocs/op
+BenchmarkDeserialize/Actor-8         	   20000	     70000 ns/op	   1000 B/op	      40 allocs/op
+BenchmarkDeserialize/Actor-8         	   20000	     50000 ns/op	   1000 B/op	      40 allocs/op
+BenchmarkSerialize/Actor-8           	   50000	     30000 ns/op	    500 B/op	      10 allocs/op
+BenchmarkBatchDeliver/Goroutines/10-8	  100000	     10000 ns/op
+PASS
+ok  	github.com/go-fed/activity/benchmarks	12.345s
+`
+
+const testHead = `BenchmarkDeserialize/Actor-8         	   20000	     62000 ns/op	   1000 B/op	      40 allocs/op
+BenchmarkSerialize/Actor-8           	   50000	     30000 ns/op	    500 B/op	      11 allocs/op
+BenchmarkBatchDeliver/Goroutines/10-8	  100000	     20000 ns/op
+BenchmarkBatchDeliver/Goroutines/1000-8	    1000	   1000000 ns/op
+`
+
+func TestParse(t *testing.T) {
+	r, err := Parse(strings.NewReader(testBase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(r))
+	}
+	d := r["BenchmarkDeserialize/Actor"]
+	if d.NsPerOp != 60000 || d.BytesPerOp != 1000 || d.AllocsPerOp != 40 || d.Runs != 3 {
+		t.Errorf("unexpected median result: %+v", d)
+	}
+	if b := r["BenchmarkBatchDeliver/Goroutines/10"]; b.NsPerOp != 10000 || b.AllocsPerOp != 0 {
+		t.Errorf("unexpected result without memory: %+v", b)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base, err := Parse(strings.NewReader(testBase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := Parse(strings.NewReader(testHead))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := Compare(base, head, 0.1)
+	if len(d) != 3 {
+		t.Fatalf("expected 3 deltas, got %d", len(d))
+	}
+	r := Regressions(d)
+	if len(r) != 2 {
+		t.Fatalf("expected 2 regressions, got %d", len(r))
+	}
+	if r[0].Head.Name != "BenchmarkBatchDeliver/Goroutines/10" || r[0].Ratio() != 2 {
+		t.Errorf("expected the slower benchmark to regress: %s", r[0])
+	}
+	if r[1].Head.Name != "BenchmarkSerialize/Actor" {
+		t.Errorf("expected the benchmark allocating more to regress: %s", r[1])
+	}
+}
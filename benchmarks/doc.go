@@ -0,0 +1,17 @@
+// Package benchmarks contains benchmarks of the streams and pub packages on
+// representative payloads, and helpers comparing two runs of them.
+//
+// The benchmarks deserialize and serialize a Mastodon status, an actor
+// document, and a large collection page, and fan out deliveries to many
+// recipients. Run them before and after a change:
+//
+//	go test -run '^$' -bench . -benchmem -count 5 ./benchmarks > base.txt
+//	go test -run '^$' -bench . -benchmem -count 5 ./benchmarks > head.txt
+//
+// Then compare the runs with the benchgate command, which exits with a
+// non-zero status if any benchmark regressed beyond the threshold:
+//
+//	go run ./benchmarks/benchgate -threshold 0.1 base.txt head.txt
+//
+// Parse and Compare may also be used directly to build other gates.
+package benchmarks
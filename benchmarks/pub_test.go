@@ -0,0 +1,61 @@
+package benchmarks
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"net/url"
+	"testing"
+)
+
+// nopTransport is a Transport delivering nowhere, so that the benchmarks
+// measure the fan-out of deliveries rather than the network.
+type nopTransport struct{}
+
+func (nopTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	return nil, nil
+}
+
+func (nopTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	return nil
+}
+
+func (nopTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return nil
+}
+
+// recipients returns the inboxes of n followers, spread over hosts.
+func recipients(n int) []*url.URL {
+	r := make([]*url.URL, n)
+	for i := range r {
+		r[i] = &url.URL{
+			Scheme: "https",
+			Host:   fmt.Sprintf("instance%d.example", i%50),
+			Path:   fmt.Sprintf("/users/follower%d/inbox", i),
+		}
+	}
+	return r
+}
+
+func BenchmarkBatchDeliver(b *testing.B) {
+	payload := []byte(`{"type":"Create"}`)
+	for _, n := range []int{10, 1000} {
+		to := recipients(n)
+		b.Run(fmt.Sprintf("Goroutines/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				pub.BatchDeliverResults(context.Background(), nopTransport{}, nil, payload, to)
+			}
+		})
+		b.Run(fmt.Sprintf("DeliveryPool/%d", n), func(b *testing.B) {
+			p := pub.NewDeliveryPool(16)
+			defer p.Close()
+			c := pub.WithDeliveryPool(context.Background(), p)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pub.BatchDeliverResults(c, nopTransport{}, nil, payload, to)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package benchmarks
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// collectionPageItems is the number of activities in the collection page
+// payload.
+const collectionPageItems = 100
+
+// payloads returns the representative payloads, by name.
+func payloads(tb testing.TB) map[string][]byte {
+	status, err := ioutil.ReadFile(filepath.Join("testdata", "mastodon_status.json"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	actor, err := ioutil.ReadFile(filepath.Join("testdata", "actor.json"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return map[string][]byte{
+		"MastodonStatus": status,
+		"Actor":          actor,
+		"CollectionPage": collectionPage(tb, status),
+	}
+}
+
+// collectionPage returns an outbox page of copies of the status.
+func collectionPage(tb testing.TB, status []byte) []byte {
+	var activity map[string]interface{}
+	if err := json.Unmarshal(status, &activity); err != nil {
+		tb.Fatal(err)
+	}
+	delete(activity, "@context")
+	items := make([]interface{}, collectionPageItems)
+	for i := range items {
+		items[i] = activity
+	}
+	page, err := json.Marshal(map[string]interface{}{
+		"@context":     "https://www.w3.org/ns/activitystreams",
+		"id":           "https://mastodon.example/users/alice/outbox?page=true",
+		"type":         "OrderedCollectionPage",
+		"next":         "https://mastodon.example/users/alice/outbox?max_id=109372835683475624&page=true",
+		"prev":         "https://mastodon.example/users/alice/outbox?min_id=109372835683475626&page=true",
+		"partOf":       "https://mastodon.example/users/alice/outbox",
+		"orderedItems": items,
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return page
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	for name, payload := range payloads(b) {
+		payload := payload
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(payload)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var m map[string]interface{}
+				if err := json.Unmarshal(payload, &m); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := streams.ToType(context.Background(), m); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	for name, payload := range payloads(b) {
+		var m map[string]interface{}
+		if err := json.Unmarshal(payload, &m); err != nil {
+			b.Fatal(err)
+		}
+		t, err := streams.ToType(context.Background(), m)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				m, err := streams.Serialize(t)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, err := json.Marshal(m); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkToShallowView(b *testing.B) {
+	for name, payload := range payloads(b) {
+		var m map[string]interface{}
+		if err := json.Unmarshal(payload, &m); err != nil {
+			b.Fatal(err)
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				streams.ToShallowViewFromMap(m)
+			}
+		})
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	expected := map[string]string{
+		"MastodonStatus": "Create",
+		"Actor":          "Person",
+		"CollectionPage": "OrderedCollectionPage",
+	}
+	for name, payload := range payloads(t) {
+		var m map[string]interface{}
+		if err := json.Unmarshal(payload, &m); err != nil {
+			t.Fatal(err)
+		}
+		v, err := streams.ToType(context.Background(), m)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		} else if v.GetTypeName() != expected[name] {
+			t.Errorf("%s: expected a %s, got a %s", name, expected[name], v.GetTypeName())
+		}
+	}
+}
@@ -91,6 +91,30 @@ type Actor interface {
 	// serializing this OrderedCollection and responding with the correct
 	// headers and http.StatusOK.
 	GetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error)
+	// Close stops the Actor from accepting new work, so that POST requests
+	// are answered with http.StatusServiceUnavailable and Send returns
+	// ErrActorClosed. It then waits for the requests and deliveries in
+	// flight to complete, or for the context to be done, in which case
+	// the context's error is returned.
+	//
+	// Finally, the delivery and schedule queues of the application are
+	// flushed if they are QueueFlushers, so that the activities still
+	// pending are not lost when the application restarts.
+	Close(c context.Context) error
+	// DryRun runs the outbox pipeline on the value without persisting nor
+	// delivering it, such as to preview what a client is about to post:
+	//   - If t is not an Activity, it is wrapped in a Create activity.
+	//   - New IDs are generated for the activity and its new objects.
+	//   - The activity is validated, returning ErrObjectRequired or
+	//     ErrTargetRequired if it lacks a required property.
+	//   - If federating, its recipients are resolved and overridden as
+	//     they would be for delivery, and its "bto" and "bcc" are
+	//     stripped.
+	//
+	// The finalized activity and its recipients are returned. Side effects
+	// of the activity are not applied, and publishing it in the future
+	// has no bearing.
+	DryRun(c context.Context, outbox *url.URL, t vocab.Type) (Activity, []*url.URL, error)
 }
 
 // FederatingActor is an Actor that allows programmatically delivering an
@@ -0,0 +1,200 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+)
+
+// ActorDocument is what the document of an actor owned by this server is
+// assembled with, in addition to the actor stored in the Database. Any field
+// left nil keeps the stored value.
+type ActorDocument struct {
+	// Keys are published in the 'publicKey' property.
+	Keys *ActorKeys
+	// Inbox, Outbox, Followers, Following, and Liked are the IRIs of the
+	// actor's collections.
+	Inbox     *url.URL
+	Outbox    *url.URL
+	Followers *url.URL
+	Following *url.URL
+	Liked     *url.URL
+	// SharedInbox is the server-wide inbox published in the actor's
+	// 'endpoints'.
+	SharedInbox *url.URL
+	// UploadMedia is the endpoint to which the actor's clients upload
+	// media, published in the actor's 'endpoints'.
+	UploadMedia *url.URL
+	// OAuthAuthorizationEndpoint and OAuthTokenEndpoint are where the
+	// actor's clients obtain their OAuth 2.0 authorization and access
+	// tokens, published in the actor's 'endpoints'.
+	OAuthAuthorizationEndpoint *url.URL
+	OAuthTokenEndpoint         *url.URL
+	// ProxyUrl is the endpoint of the ProxyURL through which the actor's
+	// clients fetch remote objects, published in the actor's 'endpoints'.
+	ProxyUrl *url.URL
+}
+
+// ActorDocumentFunc returns the ActorDocument of the actor with the IRI, or nil
+// if it is not an actor of the application.
+type ActorDocumentFunc func(c context.Context, actorIRI *url.URL) (*ActorDocument, error)
+
+// NewActorHandler creates a HandlerFunc serving the documents of the actors
+// owned by this server, such as a Person or Service, assembled from the actor
+// stored in the Database and its ActorDocument.
+//
+// Only ActivityStreams GET requests for an IRI with an ActorDocument are
+// handled, so that the application may serve a profile page to other requests
+// for the same IRI.
+//
+// The authFn may be nil, in which case requests are not authenticated. Like
+// the NewActivityStreamsHandler, 'bto' and 'bcc' are stripped from the actor.
+func NewActorHandler(authFn AuthenticateFunc, db Database, clock Clock, fn ActorDocumentFunc) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if !isActivityPubGet(r) {
+			return
+		}
+		id := requestId(r)
+		doc, err := fn(c, id)
+		if err != nil || doc == nil {
+			return
+		}
+		isASRequest = true
+		if authFn != nil {
+			var shouldReturn bool
+			if shouldReturn, err = authFn(c, w, r); err != nil || shouldReturn {
+				return
+			}
+		}
+		t, err := getOwnedActor(c, db, id)
+		if err != nil {
+			return
+		}
+		if err = doc.apply(t); err != nil {
+			return
+		}
+		clearSensitiveFields(t)
+		m, err := streams.SerializeContext(c, t)
+		if err != nil {
+			return
+		}
+		buf, err := marshalJSON(m)
+		if err != nil {
+			return
+		}
+		defer putBuffer(buf)
+		raw := buf.Bytes()
+		addResponseHeaders(w.Header(), clock, raw)
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// getOwnedActor obtains the actor with the IRI from the Database, which must
+// own it.
+//
+// Acquires and releases the lock of the IRI.
+func getOwnedActor(c context.Context, db Database, iri *url.URL) (vocab.Type, error) {
+	if err := db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, iri)
+	if owns, err := db.Owns(c, iri); err != nil {
+		return nil, err
+	} else if !owns {
+		return nil, wrapErr(ErrNotOwned, "actor %s", iri)
+	}
+	return db.Get(c, iri)
+}
+
+// apply sets the collections, keys, and endpoints of the ActorDocument on the
+// actor.
+func (d *ActorDocument) apply(t vocab.Type) error {
+	a, ok := t.(actorDocumenter)
+	if !ok {
+		return fmt.Errorf("cannot serve %T as an actor", t)
+	}
+	if d.Inbox != nil {
+		inbox := streams.NewActivityStreamsInboxProperty()
+		inbox.SetIRI(d.Inbox)
+		a.SetActivityStreamsInbox(inbox)
+	}
+	if d.Outbox != nil {
+		outbox := streams.NewActivityStreamsOutboxProperty()
+		outbox.SetIRI(d.Outbox)
+		a.SetActivityStreamsOutbox(outbox)
+	}
+	if d.Followers != nil {
+		followers := streams.NewActivityStreamsFollowersProperty()
+		followers.SetIRI(d.Followers)
+		a.SetActivityStreamsFollowers(followers)
+	}
+	if d.Following != nil {
+		following := streams.NewActivityStreamsFollowingProperty()
+		following.SetIRI(d.Following)
+		a.SetActivityStreamsFollowing(following)
+	}
+	if d.Liked != nil {
+		liked := streams.NewActivityStreamsLikedProperty()
+		liked.SetIRI(d.Liked)
+		a.SetActivityStreamsLiked(liked)
+	}
+	if d.Keys != nil {
+		pk, err := d.Keys.PublicKeyProperty()
+		if err != nil {
+			return err
+		}
+		a.SetActivityStreamsPublicKey(pk)
+	}
+	if d.SharedInbox != nil {
+		if err := setEndpoint(t, sharedInboxProperty, d.SharedInbox); err != nil {
+			return err
+		}
+	}
+	if d.UploadMedia != nil {
+		if err := setEndpoint(t, uploadMediaProperty, d.UploadMedia); err != nil {
+			return err
+		}
+	}
+	if d.OAuthAuthorizationEndpoint != nil {
+		if err := setEndpoint(t, oauthAuthorizationEndpointProperty, d.OAuthAuthorizationEndpoint); err != nil {
+			return err
+		}
+	}
+	if d.OAuthTokenEndpoint != nil {
+		if err := setEndpoint(t, oauthTokenEndpointProperty, d.OAuthTokenEndpoint); err != nil {
+			return err
+		}
+	}
+	if d.ProxyUrl != nil {
+		if err := setEndpoint(t, proxyUrlProperty, d.ProxyUrl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setEndpoint sets the endpoint with the name in the actor's 'endpoints'.
+func setEndpoint(t vocab.Type, name string, iri *url.URL) error {
+	u, ok := t.(unknownPropertieser)
+	if !ok {
+		return fmt.Errorf("cannot set %s on type %T", endpointsProperty, t)
+	}
+	endpoints, ok := u.GetUnknownProperties()[endpointsProperty].(map[string]interface{})
+	if !ok {
+		endpoints = make(map[string]interface{}, 1)
+		u.GetUnknownProperties()[endpointsProperty] = endpoints
+	}
+	endpoints[name] = iri.String()
+	return nil
+}
@@ -0,0 +1,145 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestActorHandler(t *testing.T) {
+	ctx := context.Background()
+	actorIRI := mustParse(testMyActorIRI)
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := &ActorDocument{
+		Keys:               NewActorKeys(actorIRI, ActorKey{Id: mustParse(testMyActorIRI + "#main-key"), PrivateKey: privKey}),
+		Inbox:              mustParse(testMyInboxIRI),
+		Outbox:             mustParse(testMyOutboxIRI),
+		Followers:          mustParse(testMyActorIRI + "/followers"),
+		SharedInbox:        mustParse("https://example.com/inbox"),
+		UploadMedia:        mustParse(testMyActorIRI + "/uploads"),
+		OAuthTokenEndpoint: mustParse("https://example.com/oauth/token"),
+		ProxyUrl:           mustParse("https://example.com/proxy"),
+	}
+	docFn := func(c context.Context, iri *url.URL) (*ActorDocument, error) {
+		if iri.String() == testMyActorIRI {
+			return doc, nil
+		}
+		return nil, nil
+	}
+	newRequest := func(iri string) *http.Request {
+		req := httptest.NewRequest("GET", iri, nil)
+		req.Header.Set("Accept", acceptHeaderValue)
+		return req
+	}
+	t.Run("ServesAssembledActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		person := streams.NewActivityStreamsPerson()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(actorIRI)
+		person.SetActivityStreamsId(id)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Owns(ctx, actorIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, actorIRI).Return(person, nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+			cl.EXPECT().Now().Return(now()),
+		)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := NewActorHandler(nil, db, cl, docFn)(ctx, resp, newRequest(testMyActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &m), nil)
+		assertEqual(t, m["type"], "Person")
+		assertEqual(t, m["inbox"], testMyInboxIRI)
+		assertEqual(t, m["outbox"], testMyOutboxIRI)
+		assertEqual(t, m["followers"], testMyActorIRI+"/followers")
+		_, hasFollowing := m["following"]
+		assertEqual(t, hasFollowing, false)
+		assertEqual(t, m["endpoints"].(map[string]interface{})["sharedInbox"], "https://example.com/inbox")
+		assertEqual(t, m["endpoints"].(map[string]interface{})["uploadMedia"], testMyActorIRI+"/uploads")
+		assertEqual(t, m["endpoints"].(map[string]interface{})["oauthTokenEndpoint"], "https://example.com/oauth/token")
+		assertEqual(t, m["endpoints"].(map[string]interface{})["proxyUrl"], "https://example.com/proxy")
+		assertEqual(t, m["publicKey"].(map[string]interface{})["id"], testMyActorIRI+"#main-key")
+	})
+	t.Run("IgnoresOtherIRIs", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := NewActorHandler(nil, db, cl, docFn)(ctx, resp, newRequest(testNoteId1))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, false)
+	})
+	t.Run("IgnoresNonActivityStreamsRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		req := httptest.NewRequest("GET", testMyActorIRI, nil)
+		req.Header.Set("Accept", "text/html")
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := NewActorHandler(nil, db, cl, docFn)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, false)
+	})
+	t.Run("Authenticates", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return true, nil
+		}
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := NewActorHandler(authFn, db, cl, docFn)(ctx, resp, newRequest(testMyActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("ErrorIfNotOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Owns(ctx, actorIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActorHandler(nil, db, cl, docFn)(ctx, resp, newRequest(testMyActorIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,250 @@
+package pub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+	"net/url"
+)
+
+const (
+	// actorKeyBits is the size of generated RSA keys.
+	actorKeyBits = 2048
+	// pemPrivateKeyType and pemPublicKeyType are the PEM block types of
+	// encoded keys.
+	pemPrivateKeyType = "RSA PRIVATE KEY"
+	pemPublicKeyType  = "PUBLIC KEY"
+)
+
+// ActorKey is one of an actor's key pairs.
+type ActorKey struct {
+	// Id is the id of the public key, usually the actor's id with a
+	// fragment such as "#main-key".
+	Id *url.URL
+	// PrivateKey signs requests when the key is active.
+	PrivateKey *rsa.PrivateKey
+}
+
+// ActorKeys are the key pairs of an actor. The public keys of all of them are
+// published in the actor's 'publicKey' property, but only the active key signs
+// requests.
+//
+// Keeping several keys lets an actor rotate its active key while peers still
+// verify requests signed with the previous one, and lets a compromised key be
+// removed outright. After changing the keys, the actor should be re-announced
+// to peers with the Update returned by NewActorKeysUpdate.
+//
+// ActorKeys is not safe for concurrent use. The application is responsible
+// for persisting the keys.
+type ActorKeys struct {
+	owner  *url.URL
+	keys   []ActorKey
+	active int
+}
+
+// NewActorKeys creates the ActorKeys of the owning actor, with the given key
+// active.
+func NewActorKeys(owner *url.URL, active ActorKey) *ActorKeys {
+	return &ActorKeys{
+		owner: owner,
+		keys:  []ActorKey{active},
+	}
+}
+
+// GenerateActorKey generates a new RSA private key for an actor.
+func GenerateActorKey() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, actorKeyBits)
+}
+
+// MarshalActorKey encodes the private key as PEM.
+func MarshalActorKey(privKey *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  pemPrivateKeyType,
+		Bytes: x509.MarshalPKCS1PrivateKey(privKey),
+	})
+}
+
+// ParseActorKey decodes a private key encoded by MarshalActorKey.
+func ParseActorKey(b []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil || block.Type != pemPrivateKeyType {
+		return nil, errors.New("no RSA private key PEM block")
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+// Owner returns the id of the actor owning the keys.
+func (a *ActorKeys) Owner() *url.URL {
+	return a.owner
+}
+
+// Keys returns all of the keys.
+func (a *ActorKeys) Keys() []ActorKey {
+	return append([]ActorKey(nil), a.keys...)
+}
+
+// Active returns the key used to sign requests.
+func (a *ActorKeys) Active() ActorKey {
+	return a.keys[a.active]
+}
+
+// Add adds a key that is published but not yet active.
+func (a *ActorKeys) Add(k ActorKey) error {
+	if a.index(k.Id) >= 0 {
+		return fmt.Errorf("actor key %s already exists", k.Id)
+	}
+	a.keys = append(a.keys, k)
+	return nil
+}
+
+// SetActive makes the key with the given id the one used to sign requests.
+func (a *ActorKeys) SetActive(id *url.URL) error {
+	idx := a.index(id)
+	if idx < 0 {
+		return fmt.Errorf("no actor key %s", id)
+	}
+	a.active = idx
+	return nil
+}
+
+// Remove removes the key with the given id, so that it is no longer
+// published. The active key cannot be removed.
+func (a *ActorKeys) Remove(id *url.URL) error {
+	idx := a.index(id)
+	if idx < 0 {
+		return fmt.Errorf("no actor key %s", id)
+	} else if idx == a.active {
+		return fmt.Errorf("cannot remove active actor key %s", id)
+	}
+	a.keys = append(a.keys[:idx], a.keys[idx+1:]...)
+	if idx < a.active {
+		a.active--
+	}
+	return nil
+}
+
+// Rotate adds the new key and makes it active. If revoke is true, every other
+// key is removed, such as when they may have been compromised. Otherwise they
+// remain published so that requests they signed can still be verified.
+func (a *ActorKeys) Rotate(k ActorKey, revoke bool) error {
+	if err := a.Add(k); err != nil {
+		return err
+	}
+	if revoke {
+		a.keys = []ActorKey{k}
+		a.active = 0
+		return nil
+	}
+	a.active = len(a.keys) - 1
+	return nil
+}
+
+// PublicKeyProperty returns a 'publicKey' property publishing the public keys
+// of all of the keys.
+func (a *ActorKeys) PublicKeyProperty() (vocab.ActivityStreamsPublicKeyProperty, error) {
+	pk := streams.NewActivityStreamsPublicKeyProperty()
+	for _, k := range a.keys {
+		pemKey, err := publicKeyPEM(k.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		key := streams.NewActivityStreamsPublicKey()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(k.Id)
+		key.SetActivityStreamsId(id)
+		owner := streams.NewActivityStreamsOwnerProperty()
+		owner.Set(a.owner)
+		key.SetActivityStreamsOwner(owner)
+		keyPem := streams.NewActivityStreamsPublicKeyPemProperty()
+		keyPem.Set(pemKey)
+		key.SetActivityStreamsPublicKeyPem(keyPem)
+		pk.AppendActivityStreamsPublicKey(key)
+	}
+	return pk, nil
+}
+
+// NewTransport returns a Transport signing its requests with the active key.
+func (a *ActorKeys) NewTransport(client HttpClient, appAgent string, clock Clock) (*HttpSigTransport, error) {
+	return a.NewTransportWithOptions(client, appAgent, clock, SigningOptions{})
+}
+
+// NewTransportWithOptions returns a Transport signing its requests with the
+// active key as configured by the options.
+func (a *ActorKeys) NewTransportWithOptions(client HttpClient, appAgent string, clock Clock, opts SigningOptions) (*HttpSigTransport, error) {
+	getSigner, postSigner, err := opts.newSigners(httpsig.RSA_SHA256)
+	if err != nil {
+		return nil, err
+	}
+	active := a.Active()
+	h := NewHttpSigTransport(client, appAgent, clock, getSigner, postSigner, active.Id.String(), active.PrivateKey)
+	h.digest = opts.digestAlgorithm()
+	return h, nil
+}
+
+// index returns the index of the key with the given id, or -1.
+func (a *ActorKeys) index(id *url.URL) int {
+	for i, k := range a.keys {
+		if k.Id.String() == id.String() {
+			return i
+		}
+	}
+	return -1
+}
+
+// NewActorKeysUpdate sets the 'publicKey' property of the actor to publish the
+// ActorKeys, and returns an Update of the actor addressed to the public and
+// the actor's followers. Sending it with a FederatingActor's Send prompts
+// peers to refetch the actor's keys after a rotation.
+func NewActorKeysUpdate(actor vocab.Type, keys *ActorKeys) (vocab.ActivityStreamsUpdate, error) {
+	pker, ok := actor.(publicKeyer)
+	if !ok {
+		return nil, fmt.Errorf("actor %T has no publicKey property", actor)
+	}
+	pk, err := keys.PublicKeyProperty()
+	if err != nil {
+		return nil, err
+	}
+	pker.SetActivityStreamsPublicKey(pk)
+	update := streams.NewActivityStreamsUpdate()
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(keys.Owner())
+	update.SetActivityStreamsActor(actorProp)
+	obj := streams.NewActivityStreamsObjectProperty()
+	if err = obj.AppendType(actor); err != nil {
+		return nil, err
+	}
+	update.SetActivityStreamsObject(obj)
+	public, err := url.Parse(PublicActivityPubIRI)
+	if err != nil {
+		return nil, err
+	}
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(public)
+	update.SetActivityStreamsTo(to)
+	if f, ok := actor.(followerser); ok && f.GetActivityStreamsFollowers() != nil {
+		if followers, err := ToId(f.GetActivityStreamsFollowers()); err == nil {
+			cc := streams.NewActivityStreamsCcProperty()
+			cc.AppendIRI(followers)
+			update.SetActivityStreamsCc(cc)
+		}
+	}
+	return update, nil
+}
+
+// publicKeyPEM returns the public key of the private key encoded as PEM.
+func publicKeyPEM(privKey *rsa.PrivateKey) (string, error) {
+	b, err := x509.MarshalPKIXPublicKey(privKey.Public())
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  pemPublicKeyType,
+		Bytes: b,
+	})), nil
+}
@@ -0,0 +1,108 @@
+package pub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/activity/streams"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestActorKeys(t *testing.T) {
+	actorIRI := mustParse(testMyActorIRI)
+	newKey := func(fragment string) ActorKey {
+		privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := *actorIRI
+		id.Fragment = fragment
+		return ActorKey{Id: &id, PrivateKey: privKey}
+	}
+	keyIds := func(a *ActorKeys) string {
+		var ids []string
+		for _, k := range a.Keys() {
+			ids = append(ids, k.Id.String())
+		}
+		return strings.Join(ids, " ")
+	}
+	t.Run("RoundTripsKey", func(t *testing.T) {
+		// Setup
+		k := newKey("main-key")
+		// Run
+		privKey, err := ParseActorKey(MarshalActorKey(k.PrivateKey))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, privKey.D.Cmp(k.PrivateKey.D), 0)
+	})
+	t.Run("RotatesKeepingOldKeys", func(t *testing.T) {
+		// Setup
+		a := NewActorKeys(actorIRI, newKey("key-1"))
+		// Run
+		err := a.Rotate(newKey("key-2"), false)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, a.Active().Id.Fragment, "key-2")
+		assertEqual(t, keyIds(a), testMyActorIRI+"#key-1 "+testMyActorIRI+"#key-2")
+	})
+	t.Run("RotatesRevokingOldKeys", func(t *testing.T) {
+		// Setup
+		a := NewActorKeys(actorIRI, newKey("key-1"))
+		// Run
+		err := a.Rotate(newKey("key-2"), true)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, a.Active().Id.Fragment, "key-2")
+		assertEqual(t, keyIds(a), testMyActorIRI+"#key-2")
+	})
+	t.Run("DoesNotRemoveActiveKey", func(t *testing.T) {
+		// Setup
+		k := newKey("key-1")
+		a := NewActorKeys(actorIRI, k)
+		// Run
+		err := a.Remove(k.Id)
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, len(a.Keys()), 1)
+	})
+	t.Run("RemovesInactiveKey", func(t *testing.T) {
+		// Setup
+		old := newKey("key-1")
+		a := NewActorKeys(actorIRI, old)
+		if err := a.Rotate(newKey("key-2"), false); err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		err := a.Remove(old.Id)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, a.Active().Id.Fragment, "key-2")
+		assertEqual(t, keyIds(a), testMyActorIRI+"#key-2")
+	})
+	t.Run("UpdatePublishesAllKeys", func(t *testing.T) {
+		// Setup
+		a := NewActorKeys(actorIRI, newKey("key-1"))
+		if err := a.Add(newKey("key-2")); err != nil {
+			t.Fatal(err)
+		}
+		p := streams.NewActivityStreamsPerson()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(actorIRI)
+		p.SetActivityStreamsId(id)
+		followers := streams.NewActivityStreamsFollowersProperty()
+		followers.SetIRI(mustParse(testMyActorIRI + "/followers"))
+		p.SetActivityStreamsFollowers(followers)
+		// Run
+		update, err := NewActorKeysUpdate(p, a)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, p.GetActivityStreamsPublicKey().Len(), 2)
+		assertEqual(t, update.GetActivityStreamsObject().At(0).GetActivityStreamsPerson(), p)
+		assertEqual(t, update.GetActivityStreamsTo().At(0).GetIRI().String(), PublicActivityPubIRI)
+		assertEqual(t, update.GetActivityStreamsCc().At(0).GetIRI().String(), testMyActorIRI+"/followers")
+		k, err := findPublicKey(p, &url.URL{Scheme: "https", Host: "example.com", Path: "/addison", Fragment: "key-2"})
+		assertEqual(t, err, nil)
+		assertEqual(t, k.GetActivityStreamsOwner().Get().String(), testMyActorIRI)
+	})
+}
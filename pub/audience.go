@@ -0,0 +1,309 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// AudienceResolver resolves the addressing of an activity into the concrete
+// inbox IRIs to deliver it to.
+//
+// It is used by the library when delivering activities, and may be used by
+// applications needing the same resolution, such as for previewing who will
+// receive an activity or for custom delivery.
+type AudienceResolver struct {
+	// Transport dereferences the addressed actors and collections. It
+	// should carry the credentials of the actor on whose behalf the
+	// activity is delivered, as peers may restrict access to collections.
+	Transport Transport
+	// Database is optional. If set, actors and collections owned by this
+	// server, such as the actor's own followers collection, are obtained
+	// from it instead of being dereferenced.
+	Database Database
+	// MaxDepth limits how deeply nested collections are expanded. Zero or
+	// a negative number indicates infinite recursion.
+	MaxDepth int
+	// UseSharedInbox is optional. If set, it determines whether actors on
+	// the given host advertising a sharedInbox are delivered to through
+	// it. If nil, actors are always delivered to through their own inbox.
+	UseSharedInbox func(c context.Context, host string) bool
+	// Exclude lists the ids of actors that must not be delivered to, such
+	// as blocked actors.
+	Exclude []*url.URL
+	// CollectionPages configures how the pages of addressed collections
+	// are fetched as their members are resolved.
+	CollectionPages CollectionStreamOptions
+}
+
+// Recipients returns the IRIs addressed in the 'to', 'bto', 'cc', 'bcc', and
+// 'audience' properties of the activity.
+//
+// The Public collection is never a recipient, as it cannot be delivered to.
+func Recipients(activity Activity) (r []*url.URL, err error) {
+	if to := activity.GetActivityStreamsTo(); to != nil {
+		to.ForEach(func(idx int, iter vocab.ActivityStreamsToPropertyIterator) bool {
+			var val *url.URL
+			if val, err = ToId(iter); err != nil {
+				return false
+			}
+			r = append(r, val)
+			return true
+		})
+		if err != nil {
+			return
+		}
+	}
+	if bto := activity.GetActivityStreamsBto(); bto != nil {
+		bto.ForEach(func(idx int, iter vocab.ActivityStreamsBtoPropertyIterator) bool {
+			var val *url.URL
+			if val, err = ToId(iter); err != nil {
+				return false
+			}
+			r = append(r, val)
+			return true
+		})
+		if err != nil {
+			return
+		}
+	}
+	if cc := activity.GetActivityStreamsCc(); cc != nil {
+		cc.ForEach(func(idx int, iter vocab.ActivityStreamsCcPropertyIterator) bool {
+			var val *url.URL
+			if val, err = ToId(iter); err != nil {
+				return false
+			}
+			r = append(r, val)
+			return true
+		})
+		if err != nil {
+			return
+		}
+	}
+	if bcc := activity.GetActivityStreamsBcc(); bcc != nil {
+		bcc.ForEach(func(idx int, iter vocab.ActivityStreamsBccPropertyIterator) bool {
+			var val *url.URL
+			if val, err = ToId(iter); err != nil {
+				return false
+			}
+			r = append(r, val)
+			return true
+		})
+		if err != nil {
+			return
+		}
+	}
+	if audience := activity.GetActivityStreamsAudience(); audience != nil {
+		audience.ForEach(func(idx int, iter vocab.ActivityStreamsAudiencePropertyIterator) bool {
+			var val *url.URL
+			if val, err = ToId(iter); err != nil {
+				return false
+			}
+			r = append(r, val)
+			return true
+		})
+		if err != nil {
+			return
+		}
+	}
+	r = filterURLs(r, IsPublic)
+	return
+}
+
+// Resolve determines the deduplicated inbox IRIs to deliver the activity to.
+//
+// Addressed collections are expanded into their actors. The origin actor, who
+// is sending the activity, and any excluded actors are never delivered to. The
+// activity is not modified, so hidden recipients in 'bto' and 'bcc' must be
+// stripped separately before delivery.
+func (r AudienceResolver) Resolve(c context.Context, activity Activity, origin *url.URL) ([]*url.URL, error) {
+	recipients, err := Recipients(activity)
+	if err != nil {
+		return nil, err
+	}
+	return r.resolve(c, recipients, origin, hiddenRecipients(activity))
+}
+
+// ResolveIRIs determines the deduplicated inbox IRIs of the given actors and
+// the members of the given collections, in the same manner as Resolve.
+func (r AudienceResolver) ResolveIRIs(c context.Context, iris []*url.URL, origin *url.URL) ([]*url.URL, error) {
+	return r.resolve(c, filterURLs(iris, IsPublic), origin, nil)
+}
+
+// resolve expands the recipients into the inboxes to deliver to, excluding the
+// origin and excluded actors.
+func (r AudienceResolver) resolve(c context.Context, recipients []*url.URL, origin *url.URL, hidden []*url.URL) ([]*url.URL, error) {
+	excluded := make(map[string]bool, len(r.Exclude)+1)
+	for _, iri := range r.Exclude {
+		excluded[iri.String()] = true
+	}
+	if origin != nil {
+		excluded[origin.String()] = true
+	}
+	recipients = filterURLs(recipients, func(s string) bool { return excluded[s] })
+	actors, err := r.resolveActors(c, recipients, 0)
+	if err != nil {
+		return nil, err
+	}
+	actors, err = filterBlockedActors(actors, excluded)
+	if err != nil {
+		return nil, err
+	}
+	inboxes, err := r.inboxes(c, actors, hidden)
+	if err != nil {
+		return nil, err
+	}
+	return dedupeIRIs(inboxes, nil), nil
+}
+
+// inboxes determines the inbox to deliver to for each actor. When an actor
+// advertises a sharedInbox and its use is permitted for the host, the
+// sharedInbox is used instead of the actor's inbox. This lets many recipients
+// on the same peer be collapsed into a single delivery once the inboxes are
+// deduplicated.
+//
+// Actors whose ids are in the hidden list always use their own inbox.
+func (r AudienceResolver) inboxes(c context.Context, actors []vocab.Type, hidden []*url.URL) (u []*url.URL, err error) {
+	hiddenMap := make(map[string]bool, len(hidden))
+	for _, iri := range hidden {
+		hiddenMap[iri.String()] = true
+	}
+	useShared := make(map[string]bool)
+	for _, actor := range actors {
+		var inbox *url.URL
+		inbox, err = getInbox(actor)
+		if err != nil {
+			return
+		}
+		if id, idErr := GetId(actor); idErr == nil && hiddenMap[id.String()] {
+			u = append(u, inbox)
+			continue
+		}
+		shared := getSharedInbox(actor)
+		if shared == nil || r.UseSharedInbox == nil {
+			u = append(u, inbox)
+			continue
+		}
+		use, ok := useShared[shared.Host]
+		if !ok {
+			use = r.UseSharedInbox(c, shared.Host)
+			useShared[shared.Host] = use
+		}
+		if use {
+			u = append(u, shared)
+		} else {
+			u = append(u, inbox)
+		}
+	}
+	return
+}
+
+// resolveActors takes a list of Actor id URIs and returns them as concrete
+// instances of actorObject. It attempts to apply recursively when it encounters
+// a target that is a Collection or OrderedCollection.
+//
+// If a recipient is a Collection or OrderedCollection, then the server MUST
+// dereference the collection, WITH the user's credentials.
+//
+// Note that this also applies to CollectionPage and OrderedCollectionPage.
+func (r AudienceResolver) resolveActors(c context.Context, iris []*url.URL, depth int) (actors []vocab.Type, err error) {
+	if r.MaxDepth > 0 && depth >= r.MaxDepth {
+		return
+	}
+	for _, u := range iris {
+		var more []vocab.Type
+		// TODO: Determine if more logic is needed here for inaccessible
+		// collections owned by peer servers.
+		more, err = r.resolveActor(c, u, depth)
+		if err != nil {
+			return
+		}
+		actors = append(actors, more...)
+	}
+	return
+}
+
+// resolveActor obtains the actor at the IRI, or the actors that are members of
+// the collection at the IRI. The pages of the collection are streamed, so that
+// only a few are held in memory at once.
+func (r AudienceResolver) resolveActor(c context.Context, iri *url.URL, depth int) ([]vocab.Type, error) {
+	t, err := r.fetch(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	// Attempt to see if the 'actor' is really some sort of type that has
+	// an 'items' or 'orderedItems' property.
+	_, isCol := t.(itemser)
+	_, isOCol := t.(orderedItemser)
+	if !isCol && !isOCol {
+		return []vocab.Type{t}, nil
+	} else if r.MaxDepth > 0 && depth+1 >= r.MaxDepth {
+		// Its members would not be resolved.
+		return nil, nil
+	}
+	s := NewCollectionStream(c, t, r.fetch, r.CollectionPages)
+	defer s.Close()
+	var actors []vocab.Type
+	for s.Next() {
+		id, err := collectionItemId(s.Item())
+		if err != nil {
+			return nil, err
+		}
+		more, err := r.resolveActors(c, []*url.URL{id}, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		actors = append(actors, more...)
+	}
+	return actors, s.Err()
+}
+
+// fetch obtains the value at the IRI from the Database if it is set and owns
+// the IRI, and dereferences it with the Transport otherwise.
+func (r AudienceResolver) fetch(c context.Context, iri *url.URL) (vocab.Type, error) {
+	t, err := r.getOwned(c, iri)
+	if err != nil || t != nil {
+		return t, err
+	}
+	resp, err := r.Transport.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(resp, &m); err != nil {
+		return nil, err
+	}
+	return streams.DeserializeContext(c, m)
+}
+
+// collectionItemId returns the id of an item of a collection, which is either
+// an IRI or a value with an id.
+func collectionItemId(item CollectionItem) (*url.URL, error) {
+	if item.IRI != nil {
+		return item.IRI, nil
+	} else if item.Value == nil {
+		return nil, fmt.Errorf("collection item has no id")
+	}
+	return GetId(item.Value)
+}
+
+// getOwned returns the value from the Database if it is set and owns the IRI,
+// and nil otherwise.
+func (r AudienceResolver) getOwned(c context.Context, iri *url.URL) (vocab.Type, error) {
+	if r.Database == nil {
+		return nil, nil
+	}
+	if err := r.Database.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer r.Database.Unlock(c, iri)
+	if owns, err := r.Database.Owns(c, iri); err != nil {
+		return nil, err
+	} else if !owns {
+		return nil, nil
+	}
+	return r.Database.Get(c, iri)
+}
@@ -0,0 +1,202 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// TestAudienceResolverInboxes ensures actors advertising a sharedInbox are
+// collapsed into it when permitted.
+func TestAudienceResolverInboxes(t *testing.T) {
+	ctx := context.Background()
+	sharedIRI := mustParse("https://other.example.com/inbox")
+	mustActor := func(id string, shared bool) vocab.Type {
+		m := map[string]interface{}{
+			"@context": "https://www.w3.org/ns/activitystreams",
+			"type":     "Person",
+			"id":       id,
+			"inbox":    id + "/inbox",
+		}
+		if shared {
+			m["endpoints"] = map[string]interface{}{
+				"sharedInbox": sharedIRI.String(),
+			}
+		}
+		p, err := streams.ToType(ctx, m)
+		if err != nil {
+			panic(err)
+		}
+		return p
+	}
+	setupFn := func(use bool) AudienceResolver {
+		return AudienceResolver{
+			UseSharedInbox: func(c context.Context, host string) bool {
+				assertEqual(t, host, sharedIRI.Host)
+				return use
+			},
+		}
+	}
+	actors := []vocab.Type{
+		mustActor(testFederatedActorIRI, true),
+		mustActor(testFederatedActorIRI2, true),
+		mustActor(testFederatedActorIRI3, false),
+	}
+	t.Run("UsesSharedInbox", func(t *testing.T) {
+		// Setup
+		r := setupFn(true)
+		// Run
+		u, err := r.inboxes(ctx, actors, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(u), 3)
+		assertEqual(t, u[0].String(), sharedIRI.String())
+		assertEqual(t, u[1].String(), sharedIRI.String())
+		assertEqual(t, u[2].String(), testFederatedActorIRI3+"/inbox")
+	})
+	t.Run("PolicyDisablesSharedInbox", func(t *testing.T) {
+		// Setup
+		r := setupFn(false)
+		// Run
+		u, err := r.inboxes(ctx, actors, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, u[0].String(), testFederatedActorIRI+"/inbox")
+		assertEqual(t, u[1].String(), testFederatedActorIRI2+"/inbox")
+	})
+	t.Run("NilPolicyUsesInbox", func(t *testing.T) {
+		// Setup
+		r := AudienceResolver{}
+		// Run
+		u, err := r.inboxes(ctx, actors, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, u[0].String(), testFederatedActorIRI+"/inbox")
+		assertEqual(t, u[1].String(), testFederatedActorIRI2+"/inbox")
+	})
+	t.Run("HiddenRecipientsUseInbox", func(t *testing.T) {
+		// Setup
+		r := setupFn(true)
+		// Run
+		u, err := r.inboxes(ctx, actors, []*url.URL{mustParse(testFederatedActorIRI)})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, u[0].String(), testFederatedActorIRI+"/inbox")
+		assertEqual(t, u[1].String(), sharedIRI.String())
+	})
+}
+
+// TestAudienceResolverResolve ensures addressing is expanded into deduplicated
+// inboxes, excluding the Public collection, the origin, and excluded actors.
+func TestAudienceResolverResolve(t *testing.T) {
+	ctx := context.Background()
+	followersIRI := mustParse(testFederatedActorIRI4 + "/followers")
+	mustBytes := func(m map[string]interface{}) []byte {
+		b, err := json.Marshal(m)
+		if err != nil {
+			panic(err)
+		}
+		return b
+	}
+	mustActor := func(id string) []byte {
+		return mustBytes(map[string]interface{}{
+			"@context": "https://www.w3.org/ns/activitystreams",
+			"type":     "Person",
+			"id":       id,
+			"inbox":    id + "/inbox",
+		})
+	}
+	followers := mustBytes(map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type":     "OrderedCollection",
+		"id":       followersIRI.String(),
+		"orderedItems": []interface{}{
+			testFederatedActorIRI,
+			testFederatedActorIRI2,
+		},
+	})
+	newActivity := func() Activity {
+		act := streams.NewActivityStreamsCreate()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(PublicActivityPubIRI))
+		to.AppendIRI(followersIRI)
+		to.AppendIRI(mustParse(testFederatedActorIRI3))
+		act.SetActivityStreamsTo(to)
+		cc := streams.NewActivityStreamsCcProperty()
+		cc.AppendIRI(mustParse(testMyActorIRI))
+		act.SetActivityStreamsCc(cc)
+		bcc := streams.NewActivityStreamsBccProperty()
+		bcc.AppendIRI(mustParse(testFederatedActorIRI))
+		act.SetActivityStreamsBcc(bcc)
+		return act
+	}
+	t.Run("Recipients", func(t *testing.T) {
+		// Run
+		r, err := Recipients(newActivity())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(r), 4)
+		assertEqual(t, r[0].String(), followersIRI.String())
+		assertEqual(t, r[1].String(), testFederatedActorIRI3)
+		assertEqual(t, r[2].String(), testMyActorIRI)
+		assertEqual(t, r[3].String(), testFederatedActorIRI)
+	})
+	t.Run("ExpandsAndDeduplicates", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Dereference(ctx, followersIRI).Return(followers, nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(mustActor(testFederatedActorIRI), nil).Times(2)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI2)).Return(mustActor(testFederatedActorIRI2), nil)
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(mustActor(testFederatedActorIRI3), nil)
+		r := AudienceResolver{
+			Transport: tp,
+			Exclude:   []*url.URL{mustParse(testFederatedActorIRI2)},
+		}
+		// Run
+		u, err := r.Resolve(ctx, newActivity(), mustParse(testMyActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(u), 2)
+		assertEqual(t, u[0].String(), testFederatedActorIRI+"/inbox")
+		assertEqual(t, u[1].String(), testFederatedActorIRI3+"/inbox")
+	})
+	t.Run("UsesOwnedFromDatabase", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		actorIRI := mustParse(testFederatedActorIRI3)
+		person := streams.NewActivityStreamsPerson()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(actorIRI)
+		person.SetActivityStreamsId(id)
+		inbox := streams.NewActivityStreamsInboxProperty()
+		inbox.SetIRI(mustParse(testFederatedActorIRI3 + "/inbox"))
+		person.SetActivityStreamsInbox(inbox)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Owns(ctx, actorIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, actorIRI).Return(person, nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		act := streams.NewActivityStreamsCreate()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(actorIRI)
+		act.SetActivityStreamsTo(to)
+		r := AudienceResolver{
+			Database: db,
+		}
+		// Run
+		u, err := r.Resolve(ctx, act, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(u), 1)
+		assertEqual(t, u[0].String(), testFederatedActorIRI3+"/inbox")
+	})
+}
@@ -0,0 +1,244 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// signerContextKey is the context key under which AuthenticateGet stores the
+// IRI of the actor that signed a GET request.
+type signerContextKey struct{}
+
+// PublicKeyGetter obtains the public key with the given id, the algorithm
+// that signatures made with it use, and the IRI of the actor that owns it.
+//
+// Implementations typically look up a cached key, or dereference the key id
+// and read the 'publicKey' of the owning actor. The owner must be verified to
+// actually claim the key.
+type PublicKeyGetter func(c context.Context, keyId *url.URL) (pubKey crypto.PublicKey, algo httpsig.Algorithm, owner *url.URL, err error)
+
+// AuthorizedFetch requires GET requests for ActivityStreams data to be signed
+// with a HTTP Signature, as Mastodon does when running in secure mode.
+//
+// Its Authenticate method is an AuthenticateFunc for use with
+// NewActivityStreamsHandler, and its AuthenticateGet method may be called by
+// the CommonBehavior's AuthenticateGetInbox and AuthenticateGetOutbox.
+type AuthorizedFetch struct {
+	// GetPublicKey obtains the key that a request claims to be signed
+	// with. Required.
+	GetPublicKey PublicKeyGetter
+	// RefetchPublicKey obtains the key again, bypassing any cache, when
+	// a signature fails to verify with the key from GetPublicKey.
+	// Optional; if nil, the signature is rejected without refetching.
+	//
+	// As any request with a bad signature makes it be called, it should
+	// not fetch a key fetched recently, as the RefetchPublicKey of a
+	// PublicKeyCache does not.
+	RefetchPublicKey PublicKeyGetter
+	// AllowUnauthenticated decides per-resource whether an unsigned
+	// request may still fetch it, such as for public actor documents.
+	// Optional; if nil, every request must be signed.
+	//
+	// A request with an invalid signature is rejected regardless.
+	AllowUnauthenticated func(c context.Context, r *http.Request) (bool, error)
+	// Authorize decides whether the actor that signed the request may
+	// fetch the resource, such as to refuse actors or hosts that are
+	// blocked. Optional; if nil, every signer is authorized.
+	Authorize func(c context.Context, r *http.Request, signer *url.URL) (bool, error)
+	// Clock determines the current time against which signatures are
+	// dated. Optional; if nil, MaxClockSkew is ignored.
+	Clock Clock
+	// MaxClockSkew is how far the Date header and the 'created'
+	// parameter of a signature may be from the Clock's time, such as
+	// DefaultMaxClockSkew. If not positive, signatures are not checked to
+	// be recent.
+	MaxClockSkew time.Duration
+	// CheckSignature decides whether a verified signature is acceptable,
+	// such as to refuse weak algorithms or unpinned keys. Optional; if
+	// nil, every verified signature is.
+	CheckSignature SignatureCheck
+	// Digest decides how the digests of the bodies of requests verified
+	// by Middleware are checked, and whether signatures must cover them.
+	Digest DigestPolicy
+}
+
+// Verify verifies the HTTP Signature of the request and returns the IRI of
+// the actor that signed it.
+//
+// Returns a nil signer and nil error if the request is not signed.
+func (a *AuthorizedFetch) Verify(c context.Context, r *http.Request) (signer *url.URL, err error) {
+	res, err := a.VerifyResult(c, r)
+	if res != nil {
+		signer = res.Signer
+	}
+	return
+}
+
+// VerifyResult verifies the HTTP Signature of the request and describes it.
+// Unless the Digest policy ignores digests, the signature of a request with a
+// body must cover its digest.
+//
+// Returns a nil result and nil error if the request is not signed.
+func (a *AuthorizedFetch) VerifyResult(c context.Context, r *http.Request) (*SignatureResult, error) {
+	return verifySignatureResult(c, r, a.GetPublicKey, a.RefetchPublicKey, a.Clock, a.MaxClockSkew, a.Digest.Mode, a.CheckSignature)
+}
+
+// verifySignature verifies the HTTP Signature of the request with the key
+// obtained by getKey. If verification fails and refetchKey is not nil, the key
+// is obtained by refetchKey and verification is tried once more. If the clock
+// is not nil and the skew is positive, the request must have been signed
+// within the skew of the clock's time. The signature of a request with a body
+// must cover its digest.
+//
+// Returns a nil signer and nil error if the request is not signed.
+func verifySignature(c context.Context, r *http.Request, getKey, refetchKey PublicKeyGetter, clock Clock, skew time.Duration) (signer *url.URL, err error) {
+	res, err := verifySignatureResult(c, r, getKey, refetchKey, clock, skew, DigestVerify, nil)
+	if res != nil {
+		signer = res.Signer
+	}
+	return
+}
+
+// verifySignatureResult verifies the HTTP Signature of the request as
+// verifySignature does and describes it. Unless the mode is DigestIgnore, the
+// signature of a request with a body or a digest must cover a digest header.
+// If check is not nil, it must accept the verified signature.
+//
+// Returns a nil result and nil error if the request is not signed.
+func verifySignatureResult(c context.Context, r *http.Request, getKey, refetchKey PublicKeyGetter, clock Clock, skew time.Duration, digest DigestMode, check SignatureCheck) (res *SignatureResult, err error) {
+	if len(r.Header.Get("Signature")) == 0 && len(r.Header.Get("Authorization")) == 0 {
+		return
+	}
+	v, err := newVerifier(r)
+	if err != nil {
+		return
+	}
+	keyId, err := url.Parse(v.KeyId())
+	if err != nil {
+		return
+	}
+	c, span := startSpan(c, SpanVerifySignature,
+		SpanAttribute{Key: SpanKeyPeerHost, Value: keyId.Host},
+		SpanAttribute{Key: SpanKeyKeyId, Value: keyId.String()})
+	defer func() {
+		span.End(err)
+	}()
+	if clock != nil && skew > 0 {
+		if err = checkSignatureTime(r, clock.Now(), skew); err != nil {
+			logEntry(c, LogLevelInfo, "signature time refused",
+				remoteHostLogField(keyId),
+				LogField{Key: "key_id", Value: keyId},
+				errorLogField(err))
+			err = wrapErr(ErrBadSignature, "public key %s: %s", keyId, err)
+			return
+		}
+	}
+	pubKey, algo, owner, err := getKey(c, keyId)
+	if err != nil {
+		return
+	}
+	if err = verifyRequest(r, v, pubKey, algo); err != nil && refetchKey != nil {
+		// The peer may have rotated its key since it was obtained.
+		if pubKey, algo, owner, err = refetchKey(c, keyId); err != nil {
+			return
+		}
+		err = verifyRequest(r, v, pubKey, algo)
+	}
+	if err != nil {
+		MetricsFromContext(c).SignatureVerificationFailure(keyId.Host)
+		logEntry(c, LogLevelInfo, "signature verification failed",
+			remoteHostLogField(keyId),
+			LogField{Key: "key_id", Value: keyId},
+			errorLogField(err))
+		err = wrapErr(ErrBadSignature, "public key %s: %s", keyId, err)
+		return
+	} else if owner == nil {
+		err = wrapErr(ErrBadSignature, "no owner for public key %s", keyId)
+		return
+	}
+	res = newSignatureResult(r, keyId, pubKey, algo, owner)
+	if err = checkDigestSigned(r, res, digest); err != nil {
+		logEntry(c, LogLevelInfo, "signature refused",
+			remoteHostLogField(keyId),
+			LogField{Key: "key_id", Value: keyId},
+			errorLogField(err))
+		res = nil
+		return
+	}
+	if check != nil {
+		if err = check(c, res); err != nil {
+			logEntry(c, LogLevelInfo, "signature refused",
+				remoteHostLogField(keyId),
+				LogField{Key: "key_id", Value: keyId},
+				errorLogField(err))
+			if !IsErr(err, ErrBadSignature) {
+				err = wrapErr(ErrBadSignature, "public key %s: %s", keyId, err)
+			}
+			res = nil
+		}
+	}
+	return
+}
+
+// AuthenticateGet verifies the request, writing an Unauthorized or Forbidden
+// response if it fails. When authenticated, the returned context carries the
+// IRI of the signer, if any, which may be obtained with SignerFromContext.
+//
+// A request whose signature fails to verify is not an error: an Unauthorized
+// response is written instead. Errors from the callbacks are returned, in
+// which case nothing is written.
+func (a *AuthorizedFetch) AuthenticateGet(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error) {
+	out = c
+	signer, verr := a.Verify(c, r)
+	if verr != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if signer == nil {
+		allowed := false
+		if a.AllowUnauthenticated != nil {
+			if allowed, err = a.AllowUnauthenticated(c, r); err != nil {
+				return
+			}
+		}
+		if !allowed {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+		authenticated = allowed
+		return
+	}
+	if a.Authorize != nil {
+		var ok bool
+		if ok, err = a.Authorize(c, r, signer); err != nil {
+			return
+		} else if !ok {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}
+	out = context.WithValue(c, signerContextKey{}, signer)
+	authenticated = true
+	return
+}
+
+// Authenticate is an AuthenticateFunc that applies AuthenticateGet. As it
+// cannot return a context, the signer is stored in the context of the request
+// instead.
+func (a *AuthorizedFetch) Authenticate(c context.Context, w http.ResponseWriter, r *http.Request) (shouldReturn bool, err error) {
+	out, authenticated, err := a.AuthenticateGet(c, w, r)
+	if signer, ok := SignerFromContext(out); ok {
+		*r = *r.WithContext(context.WithValue(r.Context(), signerContextKey{}, signer))
+	}
+	return !authenticated, err
+}
+
+// SignerFromContext returns the IRI of the actor that signed the request, as
+// stored by AuthorizedFetch's AuthenticateGet.
+func SignerFromContext(c context.Context) (signer *url.URL, ok bool) {
+	signer, ok = c.Value(signerContextKey{}).(*url.URL)
+	return
+}
@@ -0,0 +1,118 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAuthorizedFetch(t *testing.T) {
+	ctx := context.Background()
+	keyId := testFederatedActorIRI + "#main-key"
+	actorIRI := mustParse(testFederatedActorIRI)
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRequest := func(sign bool) *http.Request {
+		if sign {
+			return mustSignedGetRequest(testNoteId1, keyId, privKey)
+		}
+		return mustSignedGetRequest(testNoteId1, keyId, nil)
+	}
+	newAuthorizedFetch := func(pubKey crypto.PublicKey) *AuthorizedFetch {
+		return &AuthorizedFetch{
+			GetPublicKey: func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+				assertEqual(t, k.String(), keyId)
+				return pubKey, httpsig.RSA_SHA256, actorIRI, nil
+			},
+		}
+	}
+	t.Run("AuthenticatesSigned", func(t *testing.T) {
+		// Setup
+		a := newAuthorizedFetch(privKey.Public())
+		resp := httptest.NewRecorder()
+		// Run
+		c, authenticated, err := a.AuthenticateGet(ctx, resp, newRequest(true))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		signer, ok := SignerFromContext(c)
+		assertEqual(t, ok, true)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsBadSignature", func(t *testing.T) {
+		// Setup
+		otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := newAuthorizedFetch(otherKey.Public())
+		resp := httptest.NewRecorder()
+		// Run
+		shouldReturn, err := a.Authenticate(ctx, resp, newRequest(true))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, shouldReturn, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("VerifyReturnsBadSignature", func(t *testing.T) {
+		// Setup
+		otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := newAuthorizedFetch(otherKey.Public())
+		// Run
+		_, err = a.Verify(ctx, newRequest(true))
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+		assertEqual(t, ErrorStatus(err), http.StatusUnauthorized)
+	})
+	t.Run("RejectsUnsigned", func(t *testing.T) {
+		// Setup
+		a := newAuthorizedFetch(privKey.Public())
+		resp := httptest.NewRecorder()
+		// Run
+		shouldReturn, err := a.Authenticate(ctx, resp, newRequest(false))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, shouldReturn, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("AllowsUnsignedIfPermitted", func(t *testing.T) {
+		// Setup
+		a := newAuthorizedFetch(privKey.Public())
+		a.AllowUnauthenticated = func(c context.Context, r *http.Request) (bool, error) {
+			return r.URL.String() == testNoteId1, nil
+		}
+		resp := httptest.NewRecorder()
+		// Run
+		c, authenticated, err := a.AuthenticateGet(ctx, resp, newRequest(false))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		_, ok := SignerFromContext(c)
+		assertEqual(t, ok, false)
+	})
+	t.Run("ForbidsUnauthorizedSigner", func(t *testing.T) {
+		// Setup
+		a := newAuthorizedFetch(privKey.Public())
+		a.Authorize = func(c context.Context, r *http.Request, signer *url.URL) (bool, error) {
+			return false, nil
+		}
+		resp := httptest.NewRecorder()
+		// Run
+		shouldReturn, err := a.Authenticate(ctx, resp, newRequest(true))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, shouldReturn, true)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 )
@@ -35,6 +34,9 @@ type baseActor struct {
 	enableFederatedProtocol bool
 	// clock simply tracks the current time.
 	clock Clock
+	// lifecycle tracks the requests and activities in flight, so that
+	// Close can wait for them.
+	lifecycle lifecycle
 }
 
 // baseActorFederating must satisfy the FederatingActor interface.
@@ -100,6 +102,7 @@ func NewFederatingActor(c CommonBehavior,
 				s2s:    s2s,
 				db:     db,
 				clock:  clock,
+				seen:   newSeenStore(db, clock),
 			},
 			enableFederatedProtocol: true,
 			clock:                   clock,
@@ -129,6 +132,7 @@ func NewActor(c CommonBehavior,
 				s2s:    s2s,
 				db:     db,
 				clock:  clock,
+				seen:   newSeenStore(db, clock),
 			},
 			enableSocialProtocol:    true,
 			enableFederatedProtocol: true,
@@ -173,78 +177,132 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
+	// Refuse new work once closed, so the peer retries later.
+	if !b.lifecycle.begin() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return true, nil
+	}
+	defer b.lifecycle.end()
 	// Check the peer request is authentic.
+	remoteHost := LogField{Key: LogKeyRemoteHost, Value: requestRemoteHost(r)}
 	c, authenticated, err := b.delegate.AuthenticatePostInbox(c, w, r)
 	if err != nil {
 		return true, err
 	} else if !authenticated {
+		logEntry(c, LogLevelInfo, "inbox request not authenticated", remoteHost)
 		return true, nil
 	}
 	// Begin processing the request, but have not yet applied
 	// authorization (ex: blocks). Obtain the activity reject unknown
 	// activities.
-	raw, err := ioutil.ReadAll(r.Body)
+	body, err := readAll(r.Body)
 	if err != nil {
 		return true, err
 	}
+	defer putBuffer(body)
+	raw := body.Bytes()
+	// Some relays deliver several activities at once.
+	if isJSONArray(raw) {
+		if batcher, ok := b.delegate.(InboxBatcher); ok {
+			if max := batcher.MaxInboxBatch(c); max > 0 {
+				return true, b.postInboxBatch(c, w, r, raw, max, remoteHost)
+			}
+		}
+	}
 	var m map[string]interface{}
 	if err = json.Unmarshal(raw, &m); err != nil {
 		return true, err
 	}
-	asValue, err := streams.ToType(c, m)
+	return true, b.postInboxValue(c, w, r, m, remoteHost)
+}
+
+// postInboxValue handles an activity POSTed to an actor's inbox by an
+// authenticated peer, writing the response unless an error is returned.
+func (b *baseActor) postInboxValue(c context.Context, w http.ResponseWriter, r *http.Request, m map[string]interface{}, remoteHost LogField) error {
+	// Let the application refuse the activity before paying to
+	// deserialize it.
+	if ok, err := b.preFilterInbox(c, w, m, remoteHost); err != nil || !ok {
+		return err
+	}
+	asValue, err := streams.DeserializeContext(c, m)
 	if err != nil && !streams.IsUnmatchedErr(err) {
-		return true, err
+		return err
 	} else if streams.IsUnmatchedErr(err) {
 		// Respond with bad request -- we do not understand the type.
+		logEntry(c, LogLevelInfo, "rejected activity of unknown type", remoteHost, errorLogField(err))
 		w.WriteHeader(http.StatusBadRequest)
-		return true, nil
+		return nil
 	}
 	activity, ok := asValue.(Activity)
 	if !ok {
-		return true, fmt.Errorf("activity streams value is not an Activity: %T", asValue)
+		return fmt.Errorf("activity streams value is not an Activity: %T", asValue)
 	}
 	if activity.GetActivityStreamsId() == nil {
+		logEntry(c, LogLevelInfo, "rejected activity without an id", remoteHost)
 		w.WriteHeader(http.StatusBadRequest)
-		return true, nil
+		return nil
 	}
+	MetricsFromContext(c).InboundActivity(activity.GetTypeName())
+	fields := append(activityLogFields(activity), remoteHost)
+	logEntry(c, LogLevelDebug, "received activity", fields...)
 	// Allow server implementations to set context data with a hook.
 	c, err = b.delegate.PostInboxRequestBodyHook(c, r, activity)
 	if err != nil {
-		return true, err
+		return err
 	}
 	// Check authorization of the activity.
 	authorized, err := b.delegate.AuthorizePostInbox(c, w, activity)
-	if err != nil {
-		return true, err
+	if status := ErrorStatus(err); err != nil && status < http.StatusInternalServerError {
+		// Send the rejection to the peer.
+		logEntry(c, LogLevelInfo, "rejected activity", append(fields, errorLogField(err))...)
+		w.WriteHeader(status)
+		return nil
+	} else if err != nil {
+		return err
 	} else if !authorized {
-		return true, nil
+		logEntry(c, LogLevelDebug, "activity not authorized", fields...)
+		return nil
 	}
 	// Post the activity to the actor's inbox and trigger side effects for
 	// that particular Activity type. It is up to the delegate to resolve
 	// the given map.
 	inboxId := requestId(r)
-	err = b.delegate.PostInbox(c, inboxId, activity)
+	sc, span := startActivitySpan(c, SpanInboxSideEffects, activity)
+	err = b.delegate.PostInbox(sc, inboxId, activity)
+	span.End(err)
 	if err != nil {
-		// Special case: We know it is a bad request if the object or
+		// Special case: We know it is the fault of the peer if the
+		// error has a client error status, such as when the object or
 		// target properties needed to be populated, but weren't.
 		//
 		// Send the rejection to the peer.
-		if err == ErrObjectRequired || err == ErrTargetRequired {
-			w.WriteHeader(http.StatusBadRequest)
-			return true, nil
+		//
+		// A duplicate is acknowledged without being forwarded again,
+		// and an activity from a blocked actor without being stored
+		// or forwarded, so that the peer does not learn of the block.
+		if IsErr(err, ErrDuplicateActivity) || IsErr(err, ErrBlocked) {
+			w.WriteHeader(http.StatusAccepted)
+			return nil
+		} else if status := ErrorStatus(err); status < http.StatusInternalServerError {
+			logEntry(c, LogLevelInfo, "rejected activity", append(fields, errorLogField(err))...)
+			w.WriteHeader(status)
+			return nil
 		}
-		return true, err
+		logEntry(c, LogLevelError, "inbox side effects failed", append(fields, errorLogField(err))...)
+		return err
 	}
 	// Our side effects are complete, now delegate determining whether to
 	// do inbox forwarding, as well as the action to do it.
 	if err := b.delegate.InboxForwarding(c, inboxId, activity); err != nil {
-		return true, err
+		logEntry(c, LogLevelError, "inbox forwarding failed", append(fields, errorLogField(err))...)
+		return err
 	}
 	// Request has been processed. Begin responding to the request.
 	//
 	// Simply respond with an OK status to the peer.
+	logEntry(c, LogLevelDebug, "accepted activity", fields...)
 	w.WriteHeader(http.StatusOK)
-	return true, nil
+	return nil
 }
 
 // GetInbox implements the generic algorithm for handling a GET request to an
@@ -275,14 +333,16 @@ func (b *baseActor) GetInbox(c context.Context, w http.ResponseWriter, r *http.R
 	// Request has been processed. Begin responding to the request.
 	//
 	// Serialize the OrderedCollection.
-	m, err := streams.Serialize(oc)
+	m, err := streams.SerializeContext(c, oc)
 	if err != nil {
 		return true, err
 	}
-	raw, err := json.Marshal(m)
+	buf, err := marshalJSON(m)
 	if err != nil {
 		return true, err
 	}
+	defer putBuffer(buf)
+	raw := buf.Bytes()
 	// Write the response.
 	addResponseHeaders(w.Header(), b.clock, raw)
 	w.WriteHeader(http.StatusOK)
@@ -308,6 +368,12 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return true, nil
 	}
+	// Refuse new work once closed, so the client retries later.
+	if !b.lifecycle.begin() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return true, nil
+	}
+	defer b.lifecycle.end()
 	// Delegate authenticating and authorizing the request.
 	c, authenticated, err := b.delegate.AuthenticatePostOutbox(c, w, r)
 	if err != nil {
@@ -316,10 +382,12 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		return true, nil
 	}
 	// Everything is good to begin processing the request.
-	raw, err := ioutil.ReadAll(r.Body)
+	body, err := readAll(r.Body)
 	if err != nil {
 		return true, err
 	}
+	defer putBuffer(body)
+	raw := body.Bytes()
 	var m map[string]interface{}
 	if err = json.Unmarshal(raw, &m); err != nil {
 		return true, err
@@ -328,7 +396,7 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	// not known to go-fed. This prevents accidentally wrapping an Activity
 	// type unknown to go-fed in a Create below. Instead,
 	// streams.ErrUnhandledType will be returned here.
-	asValue, err := streams.ToType(c, m)
+	asValue, err := streams.DeserializeContext(c, m)
 	if err != nil && !streams.IsUnmatchedErr(err) {
 		return true, err
 	} else if streams.IsUnmatchedErr(err) {
@@ -338,19 +406,24 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	}
 	// Allow server implementations to set context data with a hook.
 	c, err = b.delegate.PostOutboxRequestBodyHook(c, r, asValue)
-	if err != nil {
+	if IsErr(err, ErrInsufficientScope) {
+		// Tell the client its token does not allow the activity.
+		writeBearerChallenge(w, http.StatusForbidden, "insufficient_scope")
+		return true, nil
+	} else if err != nil {
 		return true, err
 	}
 	// The HTTP request steps are complete, complete the rest of the outbox
 	// and delivery process.
 	outboxId := requestId(r)
 	activity, err := b.deliver(c, outboxId, asValue, m)
-	// Special case: We know it is a bad request if the object or
-	// target properties needed to be populated, but weren't.
+	// Special case: We know it is the fault of the client if the error has
+	// a client error status, such as when the object or target properties
+	// needed to be populated, but weren't.
 	//
 	// Send the rejection to the client.
-	if err == ErrObjectRequired || err == ErrTargetRequired {
-		w.WriteHeader(http.StatusBadRequest)
+	if status := ErrorStatus(err); err != nil && status < http.StatusInternalServerError {
+		w.WriteHeader(status)
 		return true, nil
 	} else if err != nil {
 		return true, err
@@ -384,14 +457,16 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 	// Request has been processed. Begin responding to the request.
 	//
 	// Serialize the OrderedCollection.
-	m, err := streams.Serialize(oc)
+	m, err := streams.SerializeContext(c, oc)
 	if err != nil {
 		return true, err
 	}
-	raw, err := json.Marshal(m)
+	buf, err := marshalJSON(m)
 	if err != nil {
 		return true, err
 	}
+	defer putBuffer(buf)
+	raw := buf.Bytes()
 	// Write the response.
 	addResponseHeaders(w.Header(), b.clock, raw)
 	w.WriteHeader(http.StatusOK)
@@ -404,6 +479,27 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 	return true, nil
 }
 
+// publishScheduled applies the side effects of an activity held by a
+// ScheduleQueue and delivers it, keeping the ids it was given when scheduled.
+func (b *baseActor) publishScheduled(c context.Context, outbox *url.URL, activity Activity) error {
+	if !b.lifecycle.begin() {
+		return ErrActorClosed
+	}
+	defer b.lifecycle.end()
+	m, err := activity.Serialize()
+	if err != nil {
+		return err
+	}
+	deliverable, err := b.postOutbox(c, activity, outbox, m)
+	if err != nil {
+		return err
+	}
+	if b.enableFederatedProtocol && deliverable {
+		return b.delegate.Deliver(c, outbox, activity)
+	}
+	return nil
+}
+
 // deliver delegates all outbox handling steps and optionally will federate the
 // activity if the federated protocol is enabled.
 //
@@ -413,26 +509,7 @@ func (b *baseActor) GetOutbox(c context.Context, w http.ResponseWriter, r *http.
 //
 // Note: 'm' is nilable.
 func (b *baseActor) deliver(c context.Context, outbox *url.URL, asValue vocab.Type, m map[string]interface{}) (activity Activity, err error) {
-	// If the value is not an Activity or type extending from Activity, then
-	// we need to wrap it in a Create Activity.
-	if !streams.IsOrExtendsActivityStreamsActivity(asValue) {
-		asValue, err = b.delegate.WrapInCreate(c, asValue, outbox)
-		if err != nil {
-			return
-		}
-	}
-	// At this point, this should be a safe conversion. If this error is
-	// triggered, then there is either a bug in the delegation of
-	// WrapInCreate, behavior is not lining up in the generated ExtendedBy
-	// code, or something else is incorrect with the type system.
-	var ok bool
-	activity, ok = asValue.(Activity)
-	if !ok {
-		err = fmt.Errorf("activity streams value is not an Activity: %T", asValue)
-		return
-	}
-	// Delegate generating new IDs for the activity and all new objects.
-	if err = b.delegate.AddNewIds(c, activity); err != nil {
+	if activity, err = b.finalize(c, outbox, asValue); err != nil {
 		return
 	}
 	// Post the activity to the actor's outbox and trigger side effects for
@@ -441,13 +518,14 @@ func (b *baseActor) deliver(c context.Context, outbox *url.URL, asValue vocab.Ty
 	// Since 'm' is nil-able and side effects may need access to literal nil
 	// values, such as for Update activities, ensure 'm' is non-nil.
 	if m == nil {
-		m, err = asValue.Serialize()
+		m, err = activity.Serialize()
 		if err != nil {
 			return
 		}
 	}
-	deliverable, err := b.delegate.PostOutbox(c, activity, outbox, m)
+	deliverable, err := b.postOutbox(c, activity, outbox, m)
 	if err != nil {
+		logEntry(c, LogLevelError, "outbox side effects failed", append(activityLogFields(activity), errorLogField(err))...)
 		return
 	}
 	// Request has been processed and all side effects internal to this
@@ -464,7 +542,58 @@ func (b *baseActor) deliver(c context.Context, outbox *url.URL, asValue vocab.Ty
 	return
 }
 
+// postOutbox applies the side effects of the activity posted to the outbox,
+// tracing them with the Tracer carried by the context.
+func (b *baseActor) postOutbox(c context.Context, activity Activity, outbox *url.URL, m map[string]interface{}) (bool, error) {
+	sc, span := startActivitySpan(c, SpanOutboxSideEffects, activity)
+	deliverable, err := b.delegate.PostOutbox(sc, activity, outbox, m)
+	span.End(err)
+	return deliverable, err
+}
+
+// finalize wraps the value in a Create if it is not an Activity, and gives the
+// activity and its new objects their ids.
+func (b *baseActor) finalize(c context.Context, outbox *url.URL, asValue vocab.Type) (activity Activity, err error) {
+	// If the value is not an Activity or type extending from Activity, then
+	// we need to wrap it in a Create Activity.
+	if !streams.IsOrExtendsActivityStreamsActivity(asValue) {
+		asValue, err = b.delegate.WrapInCreate(c, asValue, outbox)
+		if err != nil {
+			return
+		}
+	}
+	// At this point, this should be a safe conversion. If this error is
+	// triggered, then there is either a bug in the delegation of
+	// WrapInCreate, behavior is not lining up in the generated ExtendedBy
+	// code, or something else is incorrect with the type system.
+	var ok bool
+	activity, ok = asValue.(Activity)
+	if !ok {
+		err = fmt.Errorf("activity streams value is not an Activity: %T", asValue)
+		return
+	}
+	// Delegate generating new IDs for the activity and all new objects.
+	err = b.delegate.AddNewIds(c, activity)
+	return
+}
+
 // Send is programmatically accessible if the federated protocol is enabled.
 func (b *baseActorFederating) Send(c context.Context, outbox *url.URL, t vocab.Type) (Activity, error) {
+	if !b.lifecycle.begin() {
+		return nil, ErrActorClosed
+	}
+	defer b.lifecycle.end()
 	return b.deliver(c, outbox, t, nil)
 }
+
+// Close stops accepting new work, waits for the work in flight, then flushes
+// the queues of the delegate.
+func (b *baseActor) Close(c context.Context) error {
+	err := b.lifecycle.close(c)
+	if f, ok := b.delegate.(queueFlusher); ok {
+		if ferr := f.flushQueues(c); err == nil {
+			err = ferr
+		}
+	}
+	return err
+}
@@ -186,13 +186,13 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
 		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+		delegate.EXPECT().AddNewIds(ctx, eqType(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
 			activity = withNewId(activity)
 			return nil
 		})
 		delegate.EXPECT().PostOutbox(
 			ctx,
-			withNewId(toDeserializedForm(testCreateNoId)),
+			eqType(withNewId(toDeserializedForm(testCreateNoId))),
 			mustParse(testMyOutboxIRI),
 			mustSerialize(testCreateNoId),
 		).Return(true, nil)
@@ -213,16 +213,16 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostOutboxRequest(testMyNote))
 		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().WrapInCreate(ctx, toDeserializedForm(testMyNote), mustParse(testMyOutboxIRI)).DoAndReturn(func(c context.Context, t vocab.Type, u *url.URL) (vocab.ActivityStreamsCreate, error) {
+		delegate.EXPECT().WrapInCreate(ctx, eqType(testMyNote), mustParse(testMyOutboxIRI)).DoAndReturn(func(c context.Context, t vocab.Type, u *url.URL) (vocab.ActivityStreamsCreate, error) {
 			return wrappedInCreate(t), nil
 		})
-		delegate.EXPECT().AddNewIds(ctx, wrappedInCreate(toDeserializedForm(testMyNote))).DoAndReturn(func(c context.Context, activity Activity) error {
+		delegate.EXPECT().AddNewIds(ctx, eqType(wrappedInCreate(toDeserializedForm(testMyNote)))).DoAndReturn(func(c context.Context, activity Activity) error {
 			activity = withNewId(activity)
 			return nil
 		})
 		delegate.EXPECT().PostOutbox(
 			ctx,
-			withNewId(wrappedInCreate(toDeserializedForm(testMyNote))),
+			eqType(withNewId(wrappedInCreate(toDeserializedForm(testMyNote)))),
 			mustParse(testMyOutboxIRI),
 			mustSerialize(toDeserializedForm(testMyNote)),
 		).Return(true, nil)
@@ -241,13 +241,13 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
 		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+		delegate.EXPECT().AddNewIds(ctx, eqType(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
 			activity = withNewId(activity)
 			return nil
 		})
 		delegate.EXPECT().PostOutbox(
 			ctx,
-			withNewId(toDeserializedForm(testCreateNoId)),
+			eqType(withNewId(toDeserializedForm(testCreateNoId))),
 			mustParse(testMyOutboxIRI),
 			mustSerialize(testCreateNoId),
 		).Return(true, ErrObjectRequired)
@@ -266,13 +266,13 @@ func TestBaseActorSocialProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
 		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+		delegate.EXPECT().AddNewIds(ctx, eqType(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
 			activity = withNewId(activity)
 			return nil
 		})
 		delegate.EXPECT().PostOutbox(
 			ctx,
-			withNewId(toDeserializedForm(testCreateNoId)),
+			eqType(withNewId(toDeserializedForm(testCreateNoId))),
 			mustParse(testMyOutboxIRI),
 			mustSerialize(testCreateNoId),
 		).Return(true, ErrTargetRequired)
@@ -428,7 +428,7 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostInboxRequest(testCreate))
 		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).DoAndReturn(func(ctx context.Context, resp http.ResponseWriter, activity Activity) (bool, error) {
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).DoAndReturn(func(ctx context.Context, resp http.ResponseWriter, activity Activity) (bool, error) {
 			resp.WriteHeader(http.StatusForbidden)
 			return false, nil
 		})
@@ -447,9 +447,9 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostInboxRequest(testCreate))
 		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).Return(true, nil)
-		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
-		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(nil)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(nil)
 		// Run the test
 		handled, err := a.PostInbox(ctx, resp, req)
 		// Verify results
@@ -465,8 +465,8 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostInboxRequest(testCreate))
 		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).Return(true, nil)
-		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(ErrObjectRequired)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(ErrObjectRequired)
 		// Run the test
 		handled, err := a.PostInbox(ctx, resp, req)
 		// Verify results
@@ -482,8 +482,8 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostInboxRequest(testCreate))
 		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).Return(true, nil)
-		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(ErrTargetRequired)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(ErrTargetRequired)
 		// Run the test
 		handled, err := a.PostInbox(ctx, resp, req)
 		// Verify results
@@ -491,6 +491,77 @@ func TestBaseActorFederatingProtocol(t *testing.T) {
 		assertEqual(t, handled, true)
 		assertEqual(t, resp.Code, http.StatusBadRequest)
 	})
+	t.Run("PostInboxBadRequestForErrActorRequired", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, eqType(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(false, wrapErr(ErrActorRequired, "actor at index 0 is missing an id"))
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("PostInboxForbiddenForErrNotOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, eqType(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(wrapErr(ErrNotOwned, "not the actor on that Follow"))
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("PostInboxAcceptsWithoutForwardingForErrBlocked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, eqType(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(ErrBlocked)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusAccepted)
+	})
+	t.Run("PostInboxAcceptsWrappedErrDuplicateActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, eqType(testCreate)).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(wrapErr(ErrDuplicateActivity, "storing %s", testNoteId1))
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusAccepted)
+	})
 	t.Run("GetInboxIgnoresNonActivityPubRequest", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -677,9 +748,9 @@ func TestBaseActor(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostInboxRequest(testCreate))
 		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AuthorizePostInbox(ctx, resp, toDeserializedForm(testCreate)).Return(true, nil)
-		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
-		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), toDeserializedForm(testCreate)).Return(nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, eqType(testCreate)).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(nil)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), eqType(testCreate)).Return(nil)
 		// Run the test
 		handled, err := a.PostInbox(ctx, resp, req)
 		// Verify results
@@ -695,13 +766,13 @@ func TestBaseActor(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
 		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+		delegate.EXPECT().AddNewIds(ctx, eqType(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
 			activity = withNewId(activity)
 			return nil
 		})
 		delegate.EXPECT().PostOutbox(
 			ctx,
-			withNewId(toDeserializedForm(testCreateNoId)),
+			eqType(withNewId(toDeserializedForm(testCreateNoId))),
 			mustParse(testMyOutboxIRI),
 			mustSerialize(testCreateNoId),
 		).Return(false, nil)
@@ -722,17 +793,17 @@ func TestBaseActor(t *testing.T) {
 		resp := httptest.NewRecorder()
 		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
 		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
-		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+		delegate.EXPECT().AddNewIds(ctx, eqType(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
 			activity = withNewId(activity)
 			return nil
 		})
 		delegate.EXPECT().PostOutbox(
 			ctx,
-			withNewId(toDeserializedForm(testCreateNoId)),
+			eqType(withNewId(toDeserializedForm(testCreateNoId))),
 			mustParse(testMyOutboxIRI),
 			mustSerialize(testCreateNoId),
 		).Return(true, nil)
-		delegate.EXPECT().Deliver(ctx, mustParse(testMyOutboxIRI), withNewId(toDeserializedForm(testCreateNoId))).Return(nil)
+		delegate.EXPECT().Deliver(ctx, mustParse(testMyOutboxIRI), eqType(withNewId(toDeserializedForm(testCreateNoId)))).Return(nil)
 		// Run the test
 		handled, err := a.PostOutbox(ctx, resp, req)
 		// Verify results
@@ -0,0 +1,146 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DeliveryResult is the outcome of delivering to a single recipient of a
+// batch.
+type DeliveryResult struct {
+	// Recipient is the inbox delivered to.
+	Recipient *url.URL
+	// StatusCode is the status code of the response, or zero if no
+	// response was received or it is not known.
+	StatusCode int
+	// Err is why the delivery failed, or nil if it succeeded.
+	Err error
+	// Duration is how long the delivery took, or zero if it was not
+	// measured.
+	Duration time.Duration
+}
+
+// BatchResultTransport is an optional extension of a Transport reporting the
+// outcome of delivering to each recipient of a batch, so that callers may
+// retry only the failed recipients or alert on specific hosts. The
+// BatchDeliverResults function delivers with any Transport.
+type BatchResultTransport interface {
+	// BatchDeliverResults sends an ActivityStreams object to multiple
+	// recipients, returning the result of each in the order of the
+	// recipients.
+	BatchDeliverResults(c context.Context, b []byte, recipients []*url.URL) []DeliveryResult
+}
+
+// BatchResultTransport must be implemented by HttpSigTransport.
+var _ BatchResultTransport = &HttpSigTransport{}
+
+// BatchDeliveryError is returned by BatchDeliver when delivering to any of the
+// recipients failed.
+type BatchDeliveryError struct {
+	// Results are the results of every recipient, in the order of the
+	// recipients.
+	Results []DeliveryResult
+}
+
+// Error describes the failed deliveries.
+func (e *BatchDeliveryError) Error() string {
+	errs := make([]string, 0, len(e.Results))
+	for _, r := range e.Results {
+		if r.Err != nil {
+			errs = append(errs, r.Err.Error())
+		}
+	}
+	return "batch deliver had at least one failure: " + strings.Join(errs, "; ")
+}
+
+// Failed returns the recipients whose delivery failed.
+func (e *BatchDeliveryError) Failed() []*url.URL {
+	var failed []*url.URL
+	for _, r := range e.Results {
+		if r.Err != nil {
+			failed = append(failed, r.Recipient)
+		}
+	}
+	return failed
+}
+
+// BatchDeliverResults sends the payload to each recipient with the Transport,
+// returning the result of each in the order of the recipients.
+//
+// A BatchResultTransport reports the results itself. Otherwise the payload is
+// concurrently delivered to each recipient with Deliver, timed by the Clock,
+// and the status codes of failures are taken from their HttpStatusError.
+func BatchDeliverResults(c context.Context, t Transport, clock Clock, b []byte, recipients []*url.URL) []DeliveryResult {
+	if bt, ok := t.(BatchResultTransport); ok {
+		return bt.BatchDeliverResults(c, b, recipients)
+	}
+	return deliverEach(c, clock, b, recipients, t.Deliver)
+}
+
+// batchDeliver concurrently calls deliver for each recipient. Returns a
+// BatchDeliveryError if any of the deliveries had an error.
+func batchDeliver(c context.Context, b []byte, recipients []*url.URL, deliver func(c context.Context, b []byte, to *url.URL) error) error {
+	return batchError(deliverEach(c, nil, b, recipients, deliver))
+}
+
+// deliverEach concurrently calls deliver for each recipient, timing each with
+// the clock unless it is nil.
+func deliverEach(c context.Context, clock Clock, b []byte, recipients []*url.URL, deliver func(c context.Context, b []byte, to *url.URL) error) []DeliveryResult {
+	return deliverAll(c, recipients, func(to *url.URL) DeliveryResult {
+		return timedDelivery(c, clock, b, to, deliver)
+	})
+}
+
+// timedDelivery calls deliver for the recipient, timing it with the clock
+// unless it is nil.
+func timedDelivery(c context.Context, clock Clock, b []byte, to *url.URL, deliver func(c context.Context, b []byte, to *url.URL) error) DeliveryResult {
+	var start time.Time
+	if clock != nil {
+		start = clock.Now()
+	}
+	r := DeliveryResult{Recipient: to, Err: deliver(c, b, to)}
+	if clock != nil {
+		r.Duration = clock.Now().Sub(start)
+	}
+	if se, ok := asHttpStatusError(r.Err); ok {
+		r.StatusCode = se.StatusCode
+	}
+	return r
+}
+
+// deliverAll concurrently calls deliver for each recipient, returning the
+// results in the order of the recipients. The calls are scheduled on the
+// DeliveryPool carried by the context, if any, and otherwise each run in
+// their own goroutine.
+func deliverAll(c context.Context, recipients []*url.URL, deliver func(to *url.URL) DeliveryResult) []DeliveryResult {
+	if p := DeliveryPoolFromContext(c); p != nil {
+		f := p.start(c, recipients, deliver)
+		<-f.Done()
+		return f.results
+	}
+	results := make([]DeliveryResult, len(recipients))
+	var wg sync.WaitGroup
+	for i, recipient := range recipients {
+		wg.Add(1)
+		go func(i int, to *url.URL) {
+			defer wg.Done()
+			results[i] = deliver(to)
+		}(i, recipient)
+	}
+	wg.Wait()
+	return results
+}
+
+// batchError returns a BatchDeliveryError of the results if any of them
+// failed, and nil otherwise.
+func batchError(results []DeliveryResult) error {
+	for _, r := range results {
+		if r.Err != nil {
+			return &BatchDeliveryError{Results: results}
+		}
+	}
+	return nil
+}
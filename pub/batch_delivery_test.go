@@ -0,0 +1,93 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBatchDeliverResults(t *testing.T) {
+	ctx := context.Background()
+	payload := []byte("{}")
+	recipient := mustParse(testFederatedActorIRI)
+	recipient2 := mustParse("https://down.example.org/inbox")
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := NewActorKeys(mustParse(testMyActorIRI), ActorKey{Id: mustParse(testMyActorIRI + "#main-key"), PrivateKey: privKey})
+	t.Run("ReportsEachRecipient", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client := NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp, err := keys.NewTransport(client, "test", cl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			status, text := http.StatusAccepted, "202 Accepted"
+			if r.URL.Host == recipient2.Host {
+				status, text = http.StatusServiceUnavailable, "503 Service Unavailable"
+			}
+			return &http.Response{StatusCode: status, Status: text, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+		}).Times(2)
+		// Run
+		results := BatchDeliverResults(ctx, tp, cl, payload, []*url.URL{recipient, recipient2})
+		// Verify
+		assertEqual(t, len(results), 2)
+		assertEqual(t, results[0].Recipient, recipient)
+		assertEqual(t, results[0].StatusCode, http.StatusAccepted)
+		assertEqual(t, results[0].Err, nil)
+		assertEqual(t, results[1].Recipient, recipient2)
+		assertEqual(t, results[1].StatusCode, http.StatusServiceUnavailable)
+		assertNotEqual(t, results[1].Err, nil)
+	})
+	t.Run("DeliversWithAnyTransport", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+		tp.EXPECT().Deliver(ctx, payload, recipient2).Return(&HttpStatusError{IRI: recipient2, StatusCode: http.StatusGone})
+		// Run
+		results := BatchDeliverResults(ctx, tp, cl, payload, []*url.URL{recipient, recipient2})
+		// Verify
+		assertEqual(t, len(results), 2)
+		assertEqual(t, results[0].Err, nil)
+		assertEqual(t, results[0].Duration, time.Duration(0))
+		assertEqual(t, results[1].StatusCode, http.StatusGone)
+	})
+	t.Run("ErrorListsFailedRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp := NewMockTransport(ctl)
+		lt := NewLimitedTransport(tp, NewHostLimiter(cl, HostLimit{}))
+		tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+		tp.EXPECT().Deliver(ctx, payload, recipient2).Return(errors.New("connection refused"))
+		// Run
+		err := lt.BatchDeliver(ctx, payload, []*url.URL{recipient, recipient2})
+		// Verify
+		be, ok := err.(*BatchDeliveryError)
+		assertEqual(t, ok, true)
+		failed := be.Failed()
+		assertEqual(t, len(failed), 1)
+		assertEqual(t, failed[0], recipient2)
+		assertEqual(t, be.Error(), "batch deliver had at least one failure: connection refused")
+	})
+}
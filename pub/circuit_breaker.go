@@ -0,0 +1,252 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultCircuitFailureThreshold is the number of consecutive failed
+	// requests to a host after which its circuit opens.
+	DefaultCircuitFailureThreshold = 5
+	// DefaultCircuitOpenDuration is how long an open circuit fails requests
+	// fast before a probe request is let through.
+	DefaultCircuitOpenDuration = time.Minute
+)
+
+// ErrCircuitOpen indicates that a request was not made because the circuit of
+// its host is open, as the host has been consistently failing.
+var ErrCircuitOpen = errors.New("circuit open")
+
+// CircuitState is the state of a single host's circuit.
+type CircuitState int
+
+const (
+	// CircuitClosed permits requests to the host.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails requests to the host without making them.
+	CircuitOpen
+	// CircuitHalfOpen permits a single probe request to the host, whose
+	// outcome closes or reopens the circuit.
+	CircuitHalfOpen
+)
+
+// String returns the name of the state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitBreaker tracks the failed requests to each peer host, and opens the
+// host's circuit once they consistently fail so that further requests fail
+// fast instead of tying up delivery workers until they time out. Once open
+// for the OpenDuration, a single probe request is let through: the circuit
+// closes if it succeeds, and opens again if it fails.
+//
+// Network errors, 5xx responses, and 429 Too Many Requests are failures. Any
+// other response shows the host is up, and resets its failures.
+//
+// A single CircuitBreaker should be shared by every Transport of an
+// application.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failed requests after
+	// which a host's circuit opens.
+	FailureThreshold int
+	// OpenDuration is how long a host's circuit stays open before a probe
+	// request is let through.
+	OpenDuration time.Duration
+
+	clock Clock
+	mu    sync.Mutex
+	hosts map[string]*circuit
+}
+
+// circuit is the failure state of a single host.
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewCircuitBreaker creates a CircuitBreaker using the default failure
+// threshold and open duration.
+func NewCircuitBreaker(clock Clock) *CircuitBreaker {
+	return &CircuitBreaker{
+		FailureThreshold: DefaultCircuitFailureThreshold,
+		OpenDuration:     DefaultCircuitOpenDuration,
+		clock:            clock,
+		hosts:            make(map[string]*circuit),
+	}
+}
+
+// open determines whether the circuit has reached the failure threshold.
+//
+// Must be called while holding the lock.
+func (b *CircuitBreaker) open(s *circuit) bool {
+	return s.failures >= b.FailureThreshold
+}
+
+// State returns the state of the host's circuit.
+func (b *CircuitBreaker) State(host string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.hosts[host]
+	if !ok || !b.open(s) {
+		return CircuitClosed
+	} else if s.probing || b.clock.Now().Before(s.openUntil) {
+		return CircuitOpen
+	}
+	return CircuitHalfOpen
+}
+
+// Reset closes the host's circuit and forgets its failures, such as when the
+// host is known to have recovered.
+func (b *CircuitBreaker) Reset(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.hosts, host)
+}
+
+// Allow determines whether a request to the host may be made, returning an
+// ErrCircuitOpen if not. On success, the returned done function must be
+// called with the outcome of the request once it completes.
+func (b *CircuitBreaker) Allow(host string) (done func(err error), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.hosts[host]
+	if !ok {
+		s = &circuit{}
+		b.hosts[host] = s
+	}
+	if b.open(s) {
+		if s.probing || b.clock.Now().Before(s.openUntil) {
+			return nil, wrapErr(ErrCircuitOpen, "%s", host)
+		}
+		s.probing = true
+	}
+	probe := s.probing
+	done = func(err error) {
+		b.record(host, s, probe, err)
+	}
+	return
+}
+
+// record updates the host's circuit with the outcome of a request.
+func (b *CircuitBreaker) record(host string, s *circuit, probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe {
+		s.probing = false
+	}
+	if b.hosts[host] != s {
+		// The circuit was reset while the request was in flight.
+		return
+	}
+	switch {
+	case isHostFailure(err):
+		s.failures++
+		if b.open(s) {
+			s.openUntil = b.clock.Now().Add(b.OpenDuration)
+		}
+	case !IsErr(err, context.Canceled):
+		delete(b.hosts, host)
+	}
+}
+
+// isHostFailure determines whether the error shows the host is down or
+// overloaded. Canceled requests are not failures of the host.
+func isHostFailure(err error) bool {
+	if err == nil || IsErr(err, context.Canceled) {
+		return false
+	} else if se, ok := asHttpStatusError(err); ok {
+		return se.StatusCode >= 500 || se.StatusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// asHttpStatusError returns the HttpStatusError that is or is wrapped by the
+// error, if any.
+func asHttpStatusError(err error) (e *HttpStatusError, ok bool) {
+	for err != nil {
+		if e, ok = err.(*HttpStatusError); ok {
+			return
+		}
+		u, isWrapper := err.(interface{ Unwrap() error })
+		if !isWrapper {
+			return
+		}
+		err = u.Unwrap()
+	}
+	return
+}
+
+// CircuitBreakingTransport is a Transport whose requests to consistently
+// failing hosts fail fast with an ErrCircuitOpen, as determined by a
+// CircuitBreaker. Requests are made by the wrapped Transport.
+type CircuitBreakingTransport struct {
+	t       Transport
+	breaker *CircuitBreaker
+}
+
+// CircuitBreakingTransport must satisfy the Transport interface.
+var _ Transport = &CircuitBreakingTransport{}
+
+// NewCircuitBreakingTransport wraps a Transport so its requests are guarded
+// by the CircuitBreaker.
+func NewCircuitBreakingTransport(t Transport, breaker *CircuitBreaker) *CircuitBreakingTransport {
+	return &CircuitBreakingTransport{
+		t:       t,
+		breaker: breaker,
+	}
+}
+
+// outcome is the error with which to record a request that failed with err,
+// which is not held against the host if the context was done.
+func outcome(c context.Context, err error) error {
+	if err != nil && c.Err() != nil {
+		return context.Canceled
+	}
+	return err
+}
+
+// Dereference fetches the IRI unless the host's circuit is open.
+func (cb *CircuitBreakingTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	done, err := cb.breaker.Allow(iri.Host)
+	if err != nil {
+		return nil, err
+	}
+	b, err := cb.t.Dereference(c, iri)
+	done(outcome(c, err))
+	return b, err
+}
+
+// Deliver sends the payload unless the host's circuit is open.
+func (cb *CircuitBreakingTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	done, err := cb.breaker.Allow(to.Host)
+	if err != nil {
+		return err
+	}
+	err = cb.t.Deliver(c, b, to)
+	done(outcome(c, err))
+	return err
+}
+
+// BatchDeliver concurrently sends the payload to each recipient whose host's
+// circuit is not open. Returns a BatchDeliveryError if any of the requests
+// had an error, including recipients skipped because of an open circuit.
+func (cb *CircuitBreakingTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return batchDeliver(c, b, recipients, cb.Deliver)
+}
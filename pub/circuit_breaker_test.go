@@ -0,0 +1,123 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	host := mustParse(testFederatedActorIRI).Host
+	testErr := errors.New("connection refused")
+	setupFn := func(ctl *gomock.Controller, current *time.Time) *CircuitBreaker {
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().DoAndReturn(func() time.Time { return *current }).AnyTimes()
+		b := NewCircuitBreaker(cl)
+		b.FailureThreshold = 2
+		return b
+	}
+	fail := func(t *testing.T, b *CircuitBreaker, err error) {
+		done, allowErr := b.Allow(host)
+		assertEqual(t, allowErr, nil)
+		done(err)
+	}
+	t.Run("OpensAfterConsecutiveFailures", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		current := now()
+		b := setupFn(ctl, &current)
+		// Run
+		fail(t, b, testErr)
+		stateAfterOne := b.State(host)
+		fail(t, b, &HttpStatusError{StatusCode: http.StatusServiceUnavailable})
+		_, err := b.Allow(host)
+		// Verify
+		assertEqual(t, stateAfterOne, CircuitClosed)
+		assertEqual(t, b.State(host), CircuitOpen)
+		assertEqual(t, IsErr(err, ErrCircuitOpen), true)
+		assertEqual(t, b.State("other.example.org"), CircuitClosed)
+	})
+	t.Run("ResetsOnResponse", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		current := now()
+		b := setupFn(ctl, &current)
+		// Run
+		fail(t, b, testErr)
+		fail(t, b, &HttpStatusError{StatusCode: http.StatusForbidden})
+		fail(t, b, testErr)
+		// Verify
+		assertEqual(t, b.State(host), CircuitClosed)
+	})
+	t.Run("IgnoresCanceledRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		current := now()
+		b := setupFn(ctl, &current)
+		// Run
+		fail(t, b, testErr)
+		fail(t, b, context.Canceled)
+		// Verify
+		assertEqual(t, b.State(host), CircuitClosed)
+	})
+	t.Run("ProbesWhenHalfOpen", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		current := now()
+		b := setupFn(ctl, &current)
+		fail(t, b, testErr)
+		fail(t, b, testErr)
+		current = current.Add(b.OpenDuration)
+		// Run
+		halfOpen := b.State(host)
+		done, probeErr := b.Allow(host)
+		_, concurrentErr := b.Allow(host)
+		done(testErr)
+		reopened := b.State(host)
+		current = current.Add(b.OpenDuration)
+		done, secondProbeErr := b.Allow(host)
+		done(nil)
+		// Verify
+		assertEqual(t, halfOpen, CircuitHalfOpen)
+		assertEqual(t, probeErr, nil)
+		assertEqual(t, IsErr(concurrentErr, ErrCircuitOpen), true)
+		assertEqual(t, reopened, CircuitOpen)
+		assertEqual(t, secondProbeErr, nil)
+		assertEqual(t, b.State(host), CircuitClosed)
+	})
+}
+
+func TestCircuitBreakingTransport(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("connection refused")
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	recipient2 := mustParse("https://other.example.org/actor")
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	tp := NewMockTransport(ctl)
+	b := NewCircuitBreaker(cl)
+	b.FailureThreshold = 1
+	cbt := NewCircuitBreakingTransport(tp, b)
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(testErr)
+	tp.EXPECT().Deliver(ctx, payload, recipient2).Return(nil)
+	// Run
+	err := cbt.Deliver(ctx, payload, recipient)
+	batchErr := cbt.BatchDeliver(ctx, payload, []*url.URL{recipient, recipient2})
+	_, derefErr := cbt.Dereference(ctx, mustParse(testFederatedActorIRI2))
+	// Verify
+	assertEqual(t, err, testErr)
+	assertNotEqual(t, batchErr, nil)
+	assertEqual(t, IsErr(derefErr, ErrCircuitOpen), true)
+}
@@ -9,3 +9,21 @@ type Clock interface {
 	// Now returns the current time.
 	Now() time.Time
 }
+
+// Timer is an optional extension of a Clock that also determines when waits
+// end, such as a fake clock advanced by tests. Otherwise waits last in real
+// time.
+type Timer interface {
+	// After returns a channel on which the time is sent once the duration
+	// has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// after waits for the duration on the Clock if it is a Timer, and in real time
+// otherwise.
+func after(clock Clock, d time.Duration) <-chan time.Time {
+	if t, ok := clock.(Timer); ok {
+		return t.After(d)
+	}
+	return time.After(d)
+}
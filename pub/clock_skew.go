@@ -0,0 +1,98 @@
+package pub
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxClockSkew is a tolerance for the drift between the clocks of this
+// server and of peers signing requests, suiting the MaxClockSkew fields.
+const DefaultMaxClockSkew = time.Hour
+
+// checkSignatureTime verifies that the request was signed within the skew of
+// the current time: its Date header and the 'created' parameter of its
+// signature, whichever are present, must be no further from now, and the
+// 'expires' parameter no earlier. A request with neither a Date header nor a
+// 'created' parameter is refused.
+func checkSignatureTime(r *http.Request, now time.Time, skew time.Duration) error {
+	dated := false
+	if v := r.Header.Get("Date"); len(v) > 0 {
+		date, err := http.ParseTime(v)
+		if err != nil {
+			return fmt.Errorf("cannot parse Date header %q: %s", v, err)
+		}
+		if err = checkSkew("Date header", date, now, skew); err != nil {
+			return err
+		}
+		dated = true
+	}
+	params := signatureParams(r)
+	if v, ok := params["created"]; ok {
+		created, err := parseUnixTime(v)
+		if err != nil {
+			return fmt.Errorf("cannot parse signature created %q: %s", v, err)
+		}
+		if err = checkSkew("signature created", created, now, skew); err != nil {
+			return err
+		}
+		dated = true
+	}
+	if v, ok := params["expires"]; ok {
+		expires, err := parseUnixTime(v)
+		if err != nil {
+			return fmt.Errorf("cannot parse signature expires %q: %s", v, err)
+		} else if expires.Add(skew).Before(now) {
+			return fmt.Errorf("signature expired at %s", expires)
+		}
+	}
+	if !dated {
+		return errors.New("signature is not dated")
+	}
+	return nil
+}
+
+// checkSkew returns an error if the time is further from now than the skew.
+func checkSkew(what string, t, now time.Time, skew time.Duration) error {
+	if d := now.Sub(t); d > skew || d < -skew {
+		return fmt.Errorf("%s %s is more than %s from %s", what, t.UTC(), skew, now.UTC())
+	}
+	return nil
+}
+
+// signatureParams returns the parameters of the HTTP Message Signature of the
+// request, if it has one, or of the HTTP Signature in its Signature or
+// Authorization header.
+func signatureParams(r *http.Request) map[string]string {
+	if isMessageSigned(r) {
+		if m, err := parseMessageSignature(r); err == nil {
+			return m.params
+		}
+		return make(map[string]string)
+	}
+	v := r.Header.Get("Signature")
+	if len(v) == 0 {
+		v = strings.TrimPrefix(r.Header.Get("Authorization"), "Signature ")
+	}
+	params := make(map[string]string)
+	for _, p := range strings.Split(v, ",") {
+		kv := strings.SplitN(strings.TrimSpace(p), "=", 2)
+		if len(kv) == 2 {
+			params[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+	return params
+}
+
+// parseUnixTime parses a number of seconds since the Unix epoch, which may
+// have a fractional part.
+func parseUnixTime(s string) (time.Time, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, int64(f*float64(time.Second))), nil
+}
@@ -0,0 +1,85 @@
+package pub
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckSignatureTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    string
+		params  string
+		wantErr bool
+	}{
+		{"DateWithinSkew", nowDateHeader(), "", false},
+		{"DateTooOld", now().Add(-2 * time.Hour).UTC().Format(http.TimeFormat), "", true},
+		{"DateInFuture", now().Add(2 * time.Hour).UTC().Format(http.TimeFormat), "", true},
+		{"CreatedWithinSkew", "", fmt.Sprintf(`created=%d`, now().Add(-time.Minute).Unix()), false},
+		{"CreatedTooOld", "", fmt.Sprintf(`created=%d`, now().Add(-2*time.Hour).Unix()), true},
+		{"Expired", nowDateHeader(), fmt.Sprintf(`expires=%d`, now().Add(-2*time.Hour).Unix()), true},
+		{"Undated", "", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Setup
+			req := httptest.NewRequest("GET", testNoteId1, nil)
+			if len(test.date) > 0 {
+				req.Header.Set("Date", test.date)
+			}
+			sig := `keyId="` + testMyActorIRI + `#main-key",algorithm="hs2019"`
+			if len(test.params) > 0 {
+				sig += "," + test.params
+			}
+			req.Header.Set("Signature", sig+`,signature="c2ln"`)
+			// Run
+			err := checkSignatureTime(req, now(), time.Hour)
+			// Verify
+			assertEqual(t, err != nil, test.wantErr)
+		})
+	}
+}
+
+func TestStampPublished(t *testing.T) {
+	t.Run("StampsActivityAndObjects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		a := &sideEffectActor{clock: cl}
+		create := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(streams.NewActivityStreamsNote())
+		create.SetActivityStreamsObject(op)
+		// Run
+		err := a.stampPublished(create)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, create.GetActivityStreamsPublished().Get().Equal(now()), true)
+		note := create.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+		assertEqual(t, note.GetActivityStreamsPublished().Get().Equal(now()), true)
+	})
+	t.Run("KeepsExistingPublished", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		a := &sideEffectActor{clock: cl}
+		create := streams.NewActivityStreamsCreate()
+		published := streams.NewActivityStreamsPublishedProperty()
+		published.Set(now().Add(-time.Hour))
+		create.SetActivityStreamsPublished(published)
+		// Run
+		err := a.stampPublished(create)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, create.GetActivityStreamsPublished().Get().Equal(now().Add(-time.Hour)), true)
+	})
+}
@@ -0,0 +1,251 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// DefaultCollectionPageSize is the number of items in a page served by
+	// a CollectionPager created without a page size.
+	DefaultCollectionPageSize = 20
+	// collectionPageQuery is the query parameter holding the page number
+	// of a paginated collection.
+	collectionPageQuery = "page"
+)
+
+// CollectionItem is an item of a paginated collection. Value is used if it is
+// set, otherwise the item is referenced by its IRI.
+type CollectionItem struct {
+	IRI   *url.URL
+	Value vocab.Type
+}
+
+// CollectionPage is a slice of a collection and the total number of items in
+// the whole collection.
+type CollectionPage struct {
+	Items      []CollectionItem
+	TotalItems int
+}
+
+// CollectionPageFunc fetches at most limit items of the collection beginning
+// at the zero-based offset, in the order they are to be served, such as newest
+// first for an inbox or outbox. When limit is zero only the total number of
+// items is needed.
+type CollectionPageFunc func(c context.Context, collectionIRI *url.URL, offset, limit int) (CollectionPage, error)
+
+// CollectionPager builds the OrderedCollection and OrderedCollectionPages of a
+// collection, such as an inbox, outbox, followers, following, or liked, from
+// the slices returned by a CollectionPageFunc.
+//
+// The collection is served at its IRI with 'first' and 'last' links to its
+// pages, which are served at the same IRI with a "page" query parameter
+// counting from 1.
+type CollectionPager struct {
+	fetch    CollectionPageFunc
+	pageSize int
+}
+
+// NewCollectionPager creates a CollectionPager serving pages of pageSize
+// items. If pageSize is not positive, DefaultCollectionPageSize is used.
+func NewCollectionPager(fetch CollectionPageFunc, pageSize int) *CollectionPager {
+	if pageSize <= 0 {
+		pageSize = DefaultCollectionPageSize
+	}
+	return &CollectionPager{
+		fetch:    fetch,
+		pageSize: pageSize,
+	}
+}
+
+// Collection builds the OrderedCollection at the IRI, linking to its first and
+// last pages.
+func (p *CollectionPager) Collection(c context.Context, collectionIRI *url.URL) (vocab.ActivityStreamsOrderedCollection, error) {
+	collectionIRI = collectionBaseIRI(collectionIRI)
+	page, err := p.fetch(c, collectionIRI, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	oc := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(collectionIRI)
+	oc.SetActivityStreamsId(id)
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(page.TotalItems)
+	oc.SetActivityStreamsTotalItems(total)
+	first := streams.NewActivityStreamsFirstProperty()
+	first.SetIRI(collectionPageIRI(collectionIRI, 1))
+	oc.SetActivityStreamsFirst(first)
+	last := streams.NewActivityStreamsLastProperty()
+	last.SetIRI(collectionPageIRI(collectionIRI, p.lastPage(page.TotalItems)))
+	oc.SetActivityStreamsLast(last)
+	return oc, nil
+}
+
+// Page builds the OrderedCollectionPage numbered n, counting from 1, of the
+// collection at the IRI, linking to its neighbouring pages.
+func (p *CollectionPager) Page(c context.Context, collectionIRI *url.URL, n int) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("invalid collection page number: %d", n)
+	}
+	collectionIRI = collectionBaseIRI(collectionIRI)
+	offset := (n - 1) * p.pageSize
+	page, err := p.fetch(c, collectionIRI, offset, p.pageSize)
+	if err != nil {
+		return nil, err
+	}
+	ocp := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(collectionPageIRI(collectionIRI, n))
+	ocp.SetActivityStreamsId(id)
+	partOf := streams.NewActivityStreamsPartOfProperty()
+	partOf.SetIRI(collectionIRI)
+	ocp.SetActivityStreamsPartOf(partOf)
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(page.TotalItems)
+	ocp.SetActivityStreamsTotalItems(total)
+	start := streams.NewActivityStreamsStartIndexProperty()
+	start.Set(offset)
+	ocp.SetActivityStreamsStartIndex(start)
+	items := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range page.Items {
+		if item.Value != nil {
+			if err = items.AppendType(item.Value); err != nil {
+				return nil, err
+			}
+		} else if item.IRI != nil {
+			items.AppendIRI(item.IRI)
+		}
+	}
+	ocp.SetActivityStreamsOrderedItems(items)
+	if n > 1 {
+		prev := streams.NewActivityStreamsPrevProperty()
+		prev.SetIRI(collectionPageIRI(collectionIRI, n-1))
+		ocp.SetActivityStreamsPrev(prev)
+	}
+	if offset+len(page.Items) < page.TotalItems {
+		next := streams.NewActivityStreamsNextProperty()
+		next.SetIRI(collectionPageIRI(collectionIRI, n+1))
+		ocp.SetActivityStreamsNext(next)
+	}
+	return ocp, nil
+}
+
+// PageForRequest builds the OrderedCollectionPage requested by the "page"
+// query parameter of the request, or the first page if there is none. It is
+// suitable for implementing the GetInbox and GetOutbox methods of the
+// protocols.
+func (p *CollectionPager) PageForRequest(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	n, _, err := collectionPageNumber(r)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		n = 1
+	}
+	return p.Page(c, requestId(r), n)
+}
+
+// NewHandler creates a HandlerFunc serving the paginated collection at the
+// request's IRI: the OrderedCollection when there is no "page" query
+// parameter, otherwise the requested OrderedCollectionPage.
+//
+// An invalid page number results in a Bad Request response without an error.
+func (p *CollectionPager) NewHandler(authFn AuthenticateFunc, clock Clock) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub GET request
+		if !isActivityPubGet(r) {
+			return
+		}
+		isASRequest = true
+		// Authenticate the request
+		var shouldReturn bool
+		if shouldReturn, err = authFn(c, w, r); err != nil {
+			return
+		} else if shouldReturn {
+			return
+		}
+		n, hasPage, err := collectionPageNumber(r)
+		if err != nil {
+			err = nil
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Build the collection or the requested page.
+		var t vocab.Type
+		if hasPage {
+			t, err = p.Page(c, requestId(r), n)
+		} else {
+			t, err = p.Collection(c, requestId(r))
+		}
+		if err != nil {
+			return
+		}
+		m, err := streams.SerializeContext(c, t)
+		if err != nil {
+			return
+		}
+		buf, err := marshalJSON(m)
+		if err != nil {
+			return
+		}
+		defer putBuffer(buf)
+		raw := buf.Bytes()
+		// Construct the response.
+		addResponseHeaders(w.Header(), clock, raw)
+		// Write the response.
+		w.WriteHeader(http.StatusOK)
+		written, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if written != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", written, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// lastPage returns the number of the last page of a collection with the total
+// number of items. An empty collection has one empty page.
+func (p *CollectionPager) lastPage(totalItems int) int {
+	if totalItems <= 0 {
+		return 1
+	}
+	return (totalItems + p.pageSize - 1) / p.pageSize
+}
+
+// collectionPageNumber parses the "page" query parameter of the request,
+// reporting whether it is present.
+func collectionPageNumber(r *http.Request) (n int, hasPage bool, err error) {
+	v := r.URL.Query().Get(collectionPageQuery)
+	if len(v) == 0 {
+		return
+	}
+	hasPage = true
+	n, err = strconv.Atoi(v)
+	if err == nil && n < 1 {
+		err = fmt.Errorf("invalid collection page number: %d", n)
+	}
+	return
+}
+
+// collectionBaseIRI returns a copy of the IRI without its query or fragment.
+func collectionBaseIRI(iri *url.URL) *url.URL {
+	u := *iri
+	u.RawQuery = ""
+	u.Fragment = ""
+	return &u
+}
+
+// collectionPageIRI returns the IRI of the page numbered n of the collection.
+func collectionPageIRI(collectionIRI *url.URL, n int) *url.URL {
+	u := *collectionIRI
+	u.RawQuery = url.Values{collectionPageQuery: []string{strconv.Itoa(n)}}.Encode()
+	return &u
+}
@@ -0,0 +1,143 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestCollectionPager(t *testing.T) {
+	ctx := context.Background()
+	collectionIRI := mustParse(testMyOutboxIRI)
+	// fetch serves a collection of five IRIs, recording the requests.
+	var offsets, limits []int
+	fetch := func(c context.Context, iri *url.URL, offset, limit int) (CollectionPage, error) {
+		assertEqual(t, iri.String(), testMyOutboxIRI)
+		offsets = append(offsets, offset)
+		limits = append(limits, limit)
+		var page CollectionPage
+		page.TotalItems = 5
+		for i := offset; i < offset+limit && i < page.TotalItems; i++ {
+			page.Items = append(page.Items, CollectionItem{IRI: mustParse(fmt.Sprintf("%s/%d", testNoteId1, i))})
+		}
+		return page, nil
+	}
+	t.Run("Collection", func(t *testing.T) {
+		// Setup
+		offsets, limits = nil, nil
+		p := NewCollectionPager(fetch, 2)
+		// Run
+		oc, err := p.Collection(ctx, collectionIRI)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, limits[0], 0)
+		assertEqual(t, oc.GetActivityStreamsTotalItems().Get(), 5)
+		assertEqual(t, oc.GetActivityStreamsFirst().GetIRI().String(), testMyOutboxIRI+"?page=1")
+		assertEqual(t, oc.GetActivityStreamsLast().GetIRI().String(), testMyOutboxIRI+"?page=3")
+	})
+	t.Run("MiddlePage", func(t *testing.T) {
+		// Setup
+		offsets, limits = nil, nil
+		p := NewCollectionPager(fetch, 2)
+		// Run
+		ocp, err := p.Page(ctx, collectionIRI, 2)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, offsets[0], 2)
+		assertEqual(t, limits[0], 2)
+		assertEqual(t, ocp.GetActivityStreamsId().Get().String(), testMyOutboxIRI+"?page=2")
+		assertEqual(t, ocp.GetActivityStreamsPartOf().GetIRI().String(), testMyOutboxIRI)
+		assertEqual(t, ocp.GetActivityStreamsStartIndex().Get(), 2)
+		assertEqual(t, ocp.GetActivityStreamsOrderedItems().Len(), 2)
+		assertEqual(t, ocp.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), testNoteId1+"/2")
+		assertEqual(t, ocp.GetActivityStreamsPrev().GetIRI().String(), testMyOutboxIRI+"?page=1")
+		assertEqual(t, ocp.GetActivityStreamsNext().GetIRI().String(), testMyOutboxIRI+"?page=3")
+	})
+	t.Run("LastPage", func(t *testing.T) {
+		// Setup
+		p := NewCollectionPager(fetch, 2)
+		// Run
+		ocp, err := p.Page(ctx, collectionIRI, 3)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, ocp.GetActivityStreamsOrderedItems().Len(), 1)
+		assertEqual(t, ocp.GetActivityStreamsNext(), nil)
+	})
+	t.Run("FirstPageHasNoPrev", func(t *testing.T) {
+		// Setup
+		p := NewCollectionPager(fetch, 0)
+		// Run
+		ocp, err := p.Page(ctx, collectionIRI, 1)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, ocp.GetActivityStreamsOrderedItems().Len(), 5)
+		assertEqual(t, ocp.GetActivityStreamsPrev(), nil)
+		assertEqual(t, ocp.GetActivityStreamsNext(), nil)
+	})
+	t.Run("RejectsInvalidPage", func(t *testing.T) {
+		// Setup
+		p := NewCollectionPager(fetch, 2)
+		// Run
+		_, err := p.Page(ctx, collectionIRI, 0)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("PageForRequestDefaultsToFirstPage", func(t *testing.T) {
+		// Setup
+		p := NewCollectionPager(fetch, 2)
+		req := toAPRequest(toGetOutboxRequest())
+		// Run
+		ocp, err := p.PageForRequest(ctx, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, ocp.GetActivityStreamsId().Get().String(), testMyOutboxIRI+"?page=1")
+	})
+	t.Run("HandlerServesPage", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		p := NewCollectionPager(fetch, 2)
+		authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			return false, nil
+		}
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI+"?page=3", nil))
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := p.NewHandler(authFn, cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		var m map[string]interface{}
+		err = json.Unmarshal(resp.Body.Bytes(), &m)
+		assertEqual(t, err, nil)
+		assertEqual(t, m["type"], "OrderedCollectionPage")
+		assertEqual(t, m["id"], testMyOutboxIRI+"?page=3")
+		assertEqual(t, m["prev"], testMyOutboxIRI+"?page=2")
+	})
+	t.Run("HandlerRejectsBadPage", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		p := NewCollectionPager(fetch, 2)
+		authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			return false, nil
+		}
+		req := toAPRequest(httptest.NewRequest("GET", testMyOutboxIRI+"?page=x", nil))
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := p.NewHandler(authFn, cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+}
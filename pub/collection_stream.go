@@ -0,0 +1,161 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// CollectionStreamOptions configure how a CollectionStream fetches the pages
+// of a collection.
+type CollectionStreamOptions struct {
+	// Prefetch is the number of fetched pages buffered ahead of the page
+	// being iterated. Once the buffer is full, no more pages are fetched
+	// until the items of a buffered page are reached. Zero or a negative
+	// number buffers none, so that at most one page is fetched ahead.
+	Prefetch int
+	// MaxPages limits the number of pages followed from the collection.
+	// Zero or a negative number means pages are followed until the last.
+	MaxPages int
+}
+
+// CollectionStream iterates over the items of a Collection, an
+// OrderedCollection, or one of their pages, following their 'first' and
+// 'next' pages as the items are consumed. Only a bounded number of pages is
+// held in memory at once, so that large collections, such as the followers
+// of popular actors, may be walked without loading them entirely.
+//
+// Iterate it like a bufio.Scanner:
+//
+//	s := NewCollectionStream(c, collection, fetch, CollectionStreamOptions{})
+//	defer s.Close()
+//	for s.Next() {
+//		item := s.Item()
+//		...
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+type CollectionStream struct {
+	pages  chan collectionStreamPage
+	cancel context.CancelFunc
+	items  []CollectionItem
+	item   CollectionItem
+	err    error
+}
+
+// collectionStreamPage is the items of a page fetched by a CollectionStream,
+// or the error fetching it.
+type collectionStreamPage struct {
+	items []CollectionItem
+	err   error
+}
+
+// NewCollectionStream begins streaming the items of the collection, fetching
+// its pages that are not embedded with the fetch function, such as one
+// dereferencing them with a Transport. The stream must be closed once no
+// longer used.
+func NewCollectionStream(c context.Context, t vocab.Type, fetch func(c context.Context, iri *url.URL) (vocab.Type, error), opts CollectionStreamOptions) *CollectionStream {
+	prefetch := opts.Prefetch
+	if prefetch < 0 {
+		prefetch = 0
+	}
+	c, cancel := context.WithCancel(c)
+	s := &CollectionStream{
+		pages:  make(chan collectionStreamPage, prefetch),
+		cancel: cancel,
+	}
+	go s.fetchPages(c, t, fetch, opts.MaxPages)
+	return s
+}
+
+// fetchPages sends the items of each page of the collection to the stream,
+// until the last page, the maximum number of pages, a page already seen, or a
+// failure to fetch one, or until the context is done.
+func (s *CollectionStream) fetchPages(c context.Context, t vocab.Type, fetch func(c context.Context, iri *url.URL) (vocab.Type, error), maxPages int) {
+	defer close(s.pages)
+	send := func(p collectionStreamPage) bool {
+		select {
+		case s.pages <- p:
+			return true
+		case <-c.Done():
+			return false
+		}
+	}
+	visited := make(map[string]bool)
+	page := t
+	for i := 0; ; i++ {
+		if items := pageItems(page); len(items) > 0 && !send(collectionStreamPage{items: items}) {
+			return
+		}
+		next := nextPage(page, i == 0)
+		if next == nil || (maxPages > 0 && i >= maxPages) {
+			return
+		} else if page = next.GetType(); page != nil {
+			continue
+		} else if !next.IsIRI() || visited[next.GetIRI().String()] {
+			return
+		}
+		visited[next.GetIRI().String()] = true
+		var err error
+		if page, err = fetch(c, next.GetIRI()); err != nil {
+			send(collectionStreamPage{err: err})
+			return
+		}
+	}
+}
+
+// nextPage returns the property referring to the page following the
+// collection or page, if any. The first page of a collection is its 'first'
+// page, if it has one.
+func nextPage(t vocab.Type, first bool) interface {
+	GetType() vocab.Type
+	IsIRI() bool
+	GetIRI() *url.URL
+} {
+	if fi, ok := t.(firster); first && ok && fi.GetActivityStreamsFirst() != nil {
+		return fi.GetActivityStreamsFirst()
+	} else if n, ok := t.(nexter); ok && n.GetActivityStreamsNext() != nil {
+		return n.GetActivityStreamsNext()
+	}
+	return nil
+}
+
+// Next advances the stream to the next item, fetching the next page if
+// needed. Returns false once there are no more items, or if fetching a page
+// failed, in which case Err returns why.
+func (s *CollectionStream) Next() bool {
+	for len(s.items) == 0 {
+		if s.err != nil {
+			return false
+		}
+		p, ok := <-s.pages
+		if !ok {
+			return false
+		} else if p.err != nil {
+			s.err = p.err
+			return false
+		}
+		s.items = p.items
+	}
+	s.item, s.items = s.items[0], s.items[1:]
+	return true
+}
+
+// Item returns the item the stream was advanced to by Next.
+func (s *CollectionStream) Item() CollectionItem {
+	return s.item
+}
+
+// Err returns the error fetching a page of the collection, if any.
+func (s *CollectionStream) Err() error {
+	return s.err
+}
+
+// Close stops fetching the pages of the collection, and waits for any page
+// being fetched.
+func (s *CollectionStream) Close() {
+	s.cancel()
+	for range s.pages {
+	}
+}
@@ -0,0 +1,169 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// testCollectionPages are an OrderedCollection and its pages, by id.
+var testCollectionPages = map[string]map[string]interface{}{
+	"https://other.example.com/followers": {
+		"type":         "OrderedCollection",
+		"id":           "https://other.example.com/followers",
+		"orderedItems": []interface{}{"https://other.example.com/a"},
+		"first":        "https://other.example.com/followers?page=1",
+	},
+	"https://other.example.com/followers?page=1": {
+		"type":         "OrderedCollectionPage",
+		"id":           "https://other.example.com/followers?page=1",
+		"orderedItems": []interface{}{"https://other.example.com/b", map[string]interface{}{"type": "Person", "id": "https://other.example.com/c"}},
+		"next":         "https://other.example.com/followers?page=2",
+	},
+	"https://other.example.com/followers?page=2": {
+		"type":         "OrderedCollectionPage",
+		"id":           "https://other.example.com/followers?page=2",
+		"orderedItems": []interface{}{"https://other.example.com/d"},
+		"next":         "https://other.example.com/followers?page=1",
+	},
+}
+
+// mustCollectionPage deserializes the value of testCollectionPages at the IRI.
+func mustCollectionPage(iri string) vocab.Type {
+	m := map[string]interface{}{"@context": "https://www.w3.org/ns/activitystreams"}
+	for k, v := range testCollectionPages[iri] {
+		m[k] = v
+	}
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// streamIds returns the ids of the items of the stream.
+func streamIds(s *CollectionStream) (ids []string) {
+	for s.Next() {
+		id, err := collectionItemId(s.Item())
+		if err != nil {
+			panic(err)
+		}
+		ids = append(ids, id.String())
+	}
+	return
+}
+
+func TestCollectionStream(t *testing.T) {
+	ctx := context.Background()
+	followers := "https://other.example.com/followers"
+	t.Run("FollowsPagesOnce", func(t *testing.T) {
+		// Setup
+		var fetched []string
+		fetch := func(c context.Context, iri *url.URL) (vocab.Type, error) {
+			fetched = append(fetched, iri.String())
+			return mustCollectionPage(iri.String()), nil
+		}
+		// Run
+		s := NewCollectionStream(ctx, mustCollectionPage(followers), fetch, CollectionStreamOptions{Prefetch: 1})
+		defer s.Close()
+		ids := streamIds(s)
+		// Verify
+		assertEqual(t, s.Err(), nil)
+		assertEqual(t, len(ids), 4)
+		assertEqual(t, ids[0], "https://other.example.com/a")
+		assertEqual(t, ids[1], "https://other.example.com/b")
+		assertEqual(t, ids[2], "https://other.example.com/c")
+		assertEqual(t, ids[3], "https://other.example.com/d")
+		assertEqual(t, len(fetched), 2)
+	})
+	t.Run("LimitsPages", func(t *testing.T) {
+		// Setup
+		fetch := func(c context.Context, iri *url.URL) (vocab.Type, error) {
+			return mustCollectionPage(iri.String()), nil
+		}
+		// Run
+		s := NewCollectionStream(ctx, mustCollectionPage(followers), fetch, CollectionStreamOptions{MaxPages: 1})
+		defer s.Close()
+		ids := streamIds(s)
+		// Verify
+		assertEqual(t, s.Err(), nil)
+		assertEqual(t, len(ids), 3)
+	})
+	t.Run("StopsAtFailedPage", func(t *testing.T) {
+		// Setup
+		testErr := errors.New("test error")
+		fetch := func(c context.Context, iri *url.URL) (vocab.Type, error) {
+			return nil, testErr
+		}
+		// Run
+		s := NewCollectionStream(ctx, mustCollectionPage(followers), fetch, CollectionStreamOptions{})
+		defer s.Close()
+		ids := streamIds(s)
+		// Verify
+		assertEqual(t, s.Err(), testErr)
+		assertEqual(t, len(ids), 1)
+	})
+	t.Run("FetchesLazily", func(t *testing.T) {
+		// Setup
+		var fetched []string
+		fetch := func(c context.Context, iri *url.URL) (vocab.Type, error) {
+			fetched = append(fetched, iri.String())
+			return mustCollectionPage(iri.String()), nil
+		}
+		s := NewCollectionStream(ctx, mustCollectionPage(followers), fetch, CollectionStreamOptions{})
+		// Run
+		next := s.Next()
+		s.Close()
+		// Verify
+		assertEqual(t, next, true)
+		assertEqual(t, len(fetched) <= 1, true)
+	})
+}
+
+func TestAudienceResolverStreamsCollectionPages(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	tp := NewMockTransport(ctl)
+	serialize := func(m map[string]interface{}) []byte {
+		v := map[string]interface{}{"@context": "https://www.w3.org/ns/activitystreams"}
+		for k, val := range m {
+			v[k] = val
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			panic(err)
+		}
+		return b
+	}
+	for iri := range testCollectionPages {
+		if iri != "https://other.example.com/followers?page=2" {
+			tp.EXPECT().Dereference(gomock.Any(), mustParse(iri)).Return(serialize(testCollectionPages[iri]), nil)
+		}
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		id := "https://other.example.com/" + name
+		tp.EXPECT().Dereference(ctx, mustParse(id)).Return(serialize(map[string]interface{}{
+			"type":  "Person",
+			"id":    id,
+			"inbox": id + "/inbox",
+		}), nil)
+	}
+	r := AudienceResolver{
+		Transport:       tp,
+		CollectionPages: CollectionStreamOptions{MaxPages: 1},
+	}
+	// Run
+	inboxes, err := r.ResolveIRIs(ctx, []*url.URL{mustParse("https://other.example.com/followers")}, nil)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, len(inboxes), 3)
+	assertEqual(t, inboxes[0].String(), "https://other.example.com/a/inbox")
+	assertEqual(t, inboxes[2].String(), "https://other.example.com/c/inbox")
+}
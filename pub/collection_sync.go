@@ -0,0 +1,527 @@
+package pub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// collectionSynchronizationHeader is the header of FEP-8fcf sent with
+	// deliveries addressed to an actor's followers.
+	collectionSynchronizationHeader = "Collection-Synchronization"
+	// followersSynchronizationPath is appended to the path of an actor's
+	// IRI to build the IRI of its partial followers collections, as done
+	// by Mastodon.
+	followersSynchronizationPath = "/followers_synchronization"
+)
+
+// collectionSynchronizationContextKey is the context key under which the
+// Collection-Synchronization header of a delivery is carried to the
+// HttpSigTransport.
+type collectionSynchronizationContextKey struct{}
+
+// withCollectionSynchronization returns a context carrying the value of the
+// Collection-Synchronization header of a delivery.
+func withCollectionSynchronization(c context.Context, v string) context.Context {
+	return context.WithValue(c, collectionSynchronizationContextKey{}, v)
+}
+
+// collectionSynchronizationFromContext returns the value of the
+// Collection-Synchronization header carried by the context, if any.
+func collectionSynchronizationFromContext(c context.Context) string {
+	v, _ := c.Value(collectionSynchronizationContextKey{}).(string)
+	return v
+}
+
+// CollectionSynchronization is the value of the Collection-Synchronization
+// header described by FEP-8fcf. It accompanies deliveries addressed to an
+// actor's followers, so that the recipient's server may check that both
+// servers agree on which of its actors follow the sender.
+type CollectionSynchronization struct {
+	// CollectionId is the id of the synchronized collection.
+	CollectionId *url.URL
+	// URL is the partial collection listing the items that are on the
+	// recipient's server.
+	URL *url.URL
+	// Digest is the FollowersDigest of the partial collection.
+	Digest string
+}
+
+// ParseCollectionSynchronization parses the value of a
+// Collection-Synchronization header.
+func ParseCollectionSynchronization(v string) (*CollectionSynchronization, error) {
+	params, err := parseHeaderParams(v)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"collectionId", "url", "digest"} {
+		if len(params[key]) == 0 {
+			return nil, fmt.Errorf("collection synchronization is missing %q", key)
+		}
+	}
+	s := &CollectionSynchronization{
+		Digest: strings.ToLower(params["digest"]),
+	}
+	if s.CollectionId, err = url.Parse(params["collectionId"]); err != nil {
+		return nil, err
+	}
+	if s.URL, err = url.Parse(params["url"]); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// String formats the value of the Collection-Synchronization header.
+func (s *CollectionSynchronization) String() string {
+	return fmt.Sprintf("collectionId=%q, url=%q, digest=%q", s.CollectionId, s.URL, s.Digest)
+}
+
+// parseHeaderParams parses comma separated key="value" parameters of a header.
+func parseHeaderParams(v string) (map[string]string, error) {
+	params := make(map[string]string)
+	for {
+		v = strings.TrimLeft(v, " \t,")
+		if len(v) == 0 {
+			return params, nil
+		}
+		eq := strings.IndexByte(v, '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("malformed header parameter: %q", v)
+		}
+		key := strings.TrimSpace(v[:eq])
+		v = strings.TrimLeft(v[eq+1:], " \t")
+		if strings.HasPrefix(v, `"`) {
+			end := strings.IndexByte(v[1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated header parameter %q", key)
+			}
+			params[key] = v[1 : end+1]
+			v = v[end+2:]
+		} else {
+			end := strings.IndexByte(v, ',')
+			if end < 0 {
+				end = len(v)
+			}
+			params[key] = strings.TrimSpace(v[:end])
+			v = v[end:]
+		}
+	}
+}
+
+// FollowersDigest computes the digest of a partial collection described by
+// FEP-8fcf: the hex encoded exclusive or of the SHA-256 hashes of its IRIs. It
+// does not depend on their order.
+func FollowersDigest(iris []*url.URL) string {
+	var digest [sha256.Size]byte
+	for _, iri := range iris {
+		h := sha256.Sum256([]byte(iri.String()))
+		for i := range digest {
+			digest[i] ^= h[i]
+		}
+	}
+	return hex.EncodeToString(digest[:])
+}
+
+// FollowersSyncStore is implemented by the application to reconcile which
+// remote actors its actors follow, when the remote actor's server reports a
+// different state.
+type FollowersSyncStore interface {
+	// LocalFollowing returns the local actors following the remote actor.
+	LocalFollowing(c context.Context, remoteActorIRI *url.URL) (localActorIRIs []*url.URL, err error)
+	// RemoveFollowing is called for a local actor following the remote
+	// actor according to this server, but not according to the remote
+	// actor's server. The remote actor should be removed from the local
+	// actor's 'following' collection.
+	RemoveFollowing(c context.Context, localActorIRI, remoteActorIRI *url.URL) error
+	// UnexpectedFollower is called for a local actor following the remote
+	// actor according to the remote actor's server, but not according to
+	// this server. The application should send an Undo of a Follow of
+	// the remote actor on behalf of the local actor.
+	UnexpectedFollower(c context.Context, localActorIRI, remoteActorIRI *url.URL) error
+}
+
+// FollowersSynchronization implements the synchronization of followers
+// collections described by FEP-8fcf and supported by Mastodon, so that the
+// follows known to this server and its peers converge after failed
+// deliveries.
+//
+// Deliveries addressed to an actor's followers carry a digest of the
+// followers on the recipient's server when sent through a
+// CollectionSyncTransport. The list of those followers is served by NewHandler
+// at the actor's IRI followed by "/followers_synchronization". Digests
+// received from peers are checked by Synchronize.
+type FollowersSynchronization struct {
+	db    Database
+	store FollowersSyncStore
+}
+
+// NewFollowersSynchronization creates a FollowersSynchronization reading the
+// followers of local actors from the Database. The FollowersSyncStore may be
+// nil if the digests received from peers are not checked.
+func NewFollowersSynchronization(db Database, store FollowersSyncStore) *FollowersSynchronization {
+	return &FollowersSynchronization{
+		db:    db,
+		store: store,
+	}
+}
+
+// Header returns the Collection-Synchronization header of the actor's
+// followers for a delivery to the host. It is nil if the actor's 'followers'
+// collection has no id.
+func (f *FollowersSynchronization) Header(c context.Context, actorIRI *url.URL, host string) (*CollectionSynchronization, error) {
+	followersIRI, followers, err := f.followers(c, actorIRI)
+	if err != nil || followersIRI == nil {
+		return nil, err
+	}
+	return f.header(actorIRI, followersIRI, followers, host), nil
+}
+
+// PartialFollowers builds the OrderedCollection of the actor's followers on
+// the host.
+func (f *FollowersSynchronization) PartialFollowers(c context.Context, actorIRI *url.URL, host string) (vocab.ActivityStreamsOrderedCollection, error) {
+	_, followers, err := f.followers(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	followers = filterHost(followers, host)
+	oc := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(followersSynchronizationIRI(actorIRI))
+	oc.SetActivityStreamsId(id)
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(len(followers))
+	oc.SetActivityStreamsTotalItems(total)
+	items := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, follower := range followers {
+		items.AppendIRI(follower)
+	}
+	oc.SetActivityStreamsOrderedItems(items)
+	return oc, nil
+}
+
+// NewHandler creates a HandlerFunc serving the partial followers collections
+// of local actors. Requests to other IRIs are not handled.
+//
+// The followers listed are those on the host of the key id of the request's
+// HTTP Signature, so the AuthenticateFunc must verify it, such as the
+// Authenticate method of an AuthorizedFetch. Unsigned requests result in an
+// Unauthorized response without an error.
+func (f *FollowersSynchronization) NewHandler(authFn AuthenticateFunc, clock Clock) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		// Do nothing if it is not an ActivityPub GET request for a
+		// partial followers collection
+		if !isActivityPubGet(r) || !strings.HasSuffix(r.URL.Path, followersSynchronizationPath) {
+			return
+		}
+		isASRequest = true
+		// Authenticate the request
+		var shouldReturn bool
+		if shouldReturn, err = authFn(c, w, r); err != nil {
+			return
+		} else if shouldReturn {
+			return
+		}
+		host := signingHost(r)
+		if len(host) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		actorIRI := collectionBaseIRI(requestId(r))
+		actorIRI.Path = strings.TrimSuffix(actorIRI.Path, followersSynchronizationPath)
+		oc, err := f.PartialFollowers(c, actorIRI, host)
+		if err != nil {
+			return
+		}
+		m, err := streams.SerializeContext(c, oc)
+		if err != nil {
+			return
+		}
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return
+		}
+		// Construct the response.
+		addResponseHeaders(w.Header(), clock, raw)
+		// Write the response.
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// Synchronize checks the Collection-Synchronization header of a request
+// posting the activity to a local inbox, if it has one. When the digest of
+// the local actors following the activity's actor differs, the partial
+// followers collection is fetched with the Transport and the
+// FollowersSyncStore is told of each difference.
+//
+// A header whose collection or partial collection is not on the host of the
+// activity's actor is ignored. It may be called from the FederatingProtocol's
+// PostInboxRequestBodyHook, in which case its error should be logged rather
+// than returned, so that the activity is still received.
+func (f *FollowersSynchronization) Synchronize(c context.Context, r *http.Request, activity Activity, tp Transport) error {
+	v := r.Header.Get(collectionSynchronizationHeader)
+	if len(v) == 0 || f.store == nil {
+		return nil
+	}
+	s, err := ParseCollectionSynchronization(v)
+	if err != nil {
+		return err
+	}
+	actors, err := getActorIds(activity)
+	if err != nil {
+		return err
+	} else if len(actors) != 1 {
+		return nil
+	}
+	actorIRI := actors[0]
+	if s.CollectionId.Host != actorIRI.Host || s.URL.Host != actorIRI.Host {
+		logEntry(c, LogLevelInfo, "ignored collection synchronization of another host",
+			LogField{Key: LogKeyActor, Value: actorIRI},
+			LogField{Key: "collection", Value: s.CollectionId})
+		return nil
+	}
+	local, err := f.store.LocalFollowing(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	if FollowersDigest(local) == s.Digest {
+		return nil
+	}
+	b, err := tp.Dereference(c, s.URL)
+	if err != nil {
+		return err
+	}
+	remote, err := collectionItemIRIs(c, b)
+	if err != nil {
+		return err
+	}
+	remote = filterHost(remote, r.Host)
+	logEntry(c, LogLevelInfo, "synchronizing followers",
+		LogField{Key: LogKeyActor, Value: actorIRI},
+		LogField{Key: "local", Value: len(local)},
+		LogField{Key: "remote", Value: len(remote)})
+	remoteSet := make(map[string]bool, len(remote))
+	for _, iri := range remote {
+		remoteSet[iri.String()] = true
+	}
+	localSet := make(map[string]bool, len(local))
+	for _, iri := range local {
+		localSet[iri.String()] = true
+		if !remoteSet[iri.String()] {
+			if err = f.store.RemoveFollowing(c, iri, actorIRI); err != nil {
+				return err
+			}
+		}
+	}
+	for _, iri := range remote {
+		if !localSet[iri.String()] {
+			if err = f.store.UnexpectedFollower(c, iri, actorIRI); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// followers returns the id of the actor's 'followers' collection and the ids
+// of its items.
+//
+// Acquires and releases the lock for the actor.
+func (f *FollowersSynchronization) followers(c context.Context, actorIRI *url.URL) (followersIRI *url.URL, followers []*url.URL, err error) {
+	if err = f.db.Lock(c, actorIRI); err != nil {
+		return
+	}
+	defer f.db.Unlock(c, actorIRI)
+	collection, err := f.db.Followers(c, actorIRI)
+	if err != nil {
+		return
+	}
+	if id := collection.GetActivityStreamsId(); id != nil {
+		followersIRI = id.Get()
+	}
+	items := collection.GetActivityStreamsItems()
+	if items == nil {
+		return
+	}
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		var id *url.URL
+		id, err = ToId(iter)
+		if err != nil {
+			return
+		}
+		followers = append(followers, id)
+	}
+	return
+}
+
+// header builds the Collection-Synchronization header of the actor's
+// followers for a delivery to the host.
+func (f *FollowersSynchronization) header(actorIRI, followersIRI *url.URL, followers []*url.URL, host string) *CollectionSynchronization {
+	return &CollectionSynchronization{
+		CollectionId: followersIRI,
+		URL:          followersSynchronizationIRI(actorIRI),
+		Digest:       FollowersDigest(filterHost(followers, host)),
+	}
+}
+
+// followersSynchronizationIRI returns the IRI of the partial followers
+// collections of the actor.
+func followersSynchronizationIRI(actorIRI *url.URL) *url.URL {
+	u := collectionBaseIRI(actorIRI)
+	u.Path = strings.TrimSuffix(u.Path, "/") + followersSynchronizationPath
+	return u
+}
+
+// filterHost returns the IRIs on the host.
+func filterHost(iris []*url.URL, host string) []*url.URL {
+	out := make([]*url.URL, 0, len(iris))
+	for _, iri := range iris {
+		if strings.EqualFold(iri.Host, host) {
+			out = append(out, iri)
+		}
+	}
+	return out
+}
+
+// collectionItemIRIs returns the ids of the items of a serialized Collection
+// or OrderedCollection.
+func collectionItemIRIs(c context.Context, b []byte) (iris []*url.URL, err error) {
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	t, err := streams.DeserializeContext(c, m)
+	if err != nil {
+		return
+	}
+	switch v := t.(type) {
+	case vocab.ActivityStreamsOrderedCollection:
+		if items := v.GetActivityStreamsOrderedItems(); items != nil {
+			for it := items.Begin(); it != items.End(); it = it.Next() {
+				var id *url.URL
+				if id, err = ToId(it); err != nil {
+					return
+				}
+				iris = append(iris, id)
+			}
+		}
+	case vocab.ActivityStreamsCollection:
+		if items := v.GetActivityStreamsItems(); items != nil {
+			for it := items.Begin(); it != items.End(); it = it.Next() {
+				var id *url.URL
+				if id, err = ToId(it); err != nil {
+					return
+				}
+				iris = append(iris, id)
+			}
+		}
+	default:
+		err = fmt.Errorf("partial collection is not a Collection or OrderedCollection: %T", t)
+	}
+	return
+}
+
+// addressesIRI returns whether the serialized activity is addressed to the
+// IRI in its 'to', 'cc', or 'audience' properties.
+func addressesIRI(b []byte, iri *url.URL) bool {
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+	s := iri.String()
+	for _, key := range []string{"to", "cc", "audience"} {
+		switch v := m[key].(type) {
+		case string:
+			if v == s {
+				return true
+			}
+		case []interface{}:
+			for _, e := range v {
+				if e == s {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// CollectionSyncTransport is a Transport adding the Collection-Synchronization
+// header of FEP-8fcf to deliveries addressed to an actor's followers.
+// Dereferencing is done by the wrapped Transport.
+//
+// The header is carried by the context to the HttpSigTransport, so the
+// wrapped Transport must send immediately and not be a QueuedTransport. The
+// header is only covered by the HTTP Signature if the HttpSigTransport's
+// signer includes it, which requires every delivery to carry it.
+type CollectionSyncTransport struct {
+	Transport
+	sync     *FollowersSynchronization
+	actorIRI *url.URL
+}
+
+// CollectionSyncTransport must satisfy the Transport interface.
+var _ Transport = &CollectionSyncTransport{}
+
+// NewCollectionSyncTransport wraps a Transport delivering on behalf of the
+// actor so that its deliveries to followers are synchronized.
+func NewCollectionSyncTransport(t Transport, sync *FollowersSynchronization, actorIRI *url.URL) *CollectionSyncTransport {
+	return &CollectionSyncTransport{
+		Transport: t,
+		sync:      sync,
+		actorIRI:  actorIRI,
+	}
+}
+
+// Deliver sends the payload, with the header if it is addressed to the
+// actor's followers.
+func (s *CollectionSyncTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	header, err := s.headers(c, b)
+	if err != nil {
+		return err
+	} else if header != nil {
+		c = withCollectionSynchronization(c, header(to.Host))
+	}
+	return s.Transport.Deliver(c, b, to)
+}
+
+// BatchDeliver sends the payload to each recipient, with the header of the
+// recipient's host if it is addressed to the actor's followers.
+func (s *CollectionSyncTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	header, err := s.headers(c, b)
+	if err != nil {
+		return err
+	} else if header == nil {
+		return s.Transport.BatchDeliver(c, b, recipients)
+	}
+	return batchDeliver(c, b, recipients, func(c context.Context, b []byte, to *url.URL) error {
+		return s.Transport.Deliver(withCollectionSynchronization(c, header(to.Host)), b, to)
+	})
+}
+
+// headers returns a function building the header for a recipient's host, or
+// nil if the payload is not addressed to the actor's followers.
+func (s *CollectionSyncTransport) headers(c context.Context, b []byte) (func(host string) string, error) {
+	followersIRI, followers, err := s.sync.followers(c, s.actorIRI)
+	if err != nil || followersIRI == nil || !addressesIRI(b, followersIRI) {
+		return nil, err
+	}
+	return func(host string) string {
+		return s.sync.header(s.actorIRI, followersIRI, followers, host).String()
+	}, nil
+}
@@ -0,0 +1,338 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const (
+	testMyFollowersIRI     = "https://example.com/addison/followers"
+	testMyFollowersSyncIRI = "https://example.com/addison/followers_synchronization"
+)
+
+// testFollowersSyncStore is a FollowersSyncStore recording the differences it
+// is told of.
+type testFollowersSyncStore struct {
+	local      []*url.URL
+	removed    []string
+	unexpected []string
+}
+
+func (t *testFollowersSyncStore) LocalFollowing(c context.Context, remoteActorIRI *url.URL) ([]*url.URL, error) {
+	return t.local, nil
+}
+
+func (t *testFollowersSyncStore) RemoveFollowing(c context.Context, localActorIRI, remoteActorIRI *url.URL) error {
+	t.removed = append(t.removed, localActorIRI.String())
+	return nil
+}
+
+func (t *testFollowersSyncStore) UnexpectedFollower(c context.Context, localActorIRI, remoteActorIRI *url.URL) error {
+	t.unexpected = append(t.unexpected, localActorIRI.String())
+	return nil
+}
+
+// testFollowersCollection returns the 'followers' collection of the local
+// actor, with followers on two hosts.
+func testFollowersCollection() vocab.ActivityStreamsCollection {
+	collection := streams.NewActivityStreamsCollection()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(testMyFollowersIRI))
+	collection.SetActivityStreamsId(id)
+	items := streams.NewActivityStreamsItemsProperty()
+	items.AppendIRI(mustParse(testFederatedActorIRI))
+	items.AppendIRI(mustParse(testPersonIRI))
+	items.AppendIRI(mustParse(testFederatedActorIRI2))
+	collection.SetActivityStreamsItems(items)
+	return collection
+}
+
+// expectFollowers expects the followers of the local actor to be read.
+func expectFollowers(db *MockDatabase) {
+	gomock.InOrder(
+		db.EXPECT().Lock(gomock.Any(), mustParse(testMyActorIRI)),
+		db.EXPECT().Followers(gomock.Any(), mustParse(testMyActorIRI)).Return(testFollowersCollection(), nil),
+		db.EXPECT().Unlock(gomock.Any(), mustParse(testMyActorIRI)),
+	)
+}
+
+func TestParseCollectionSynchronization(t *testing.T) {
+	t.Run("RoundTrips", func(t *testing.T) {
+		// Setup
+		s := &CollectionSynchronization{
+			CollectionId: mustParse(testMyFollowersIRI),
+			URL:          mustParse(testMyFollowersSyncIRI),
+			Digest:       FollowersDigest(nil),
+		}
+		// Run
+		parsed, err := ParseCollectionSynchronization(s.String())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, parsed.CollectionId.String(), testMyFollowersIRI)
+		assertEqual(t, parsed.URL.String(), testMyFollowersSyncIRI)
+		assertEqual(t, parsed.Digest, s.Digest)
+	})
+	t.Run("ParsesMastodonHeader", func(t *testing.T) {
+		// Setup
+		v := `collectionId="https://example.com/addison/followers", url="https://example.com/addison/followers_synchronization", digest="B08AB6951C7D6CC2B91E17EBD9557DA7FAE02489728E9332FCB3A97748244D50"`
+		// Run
+		parsed, err := ParseCollectionSynchronization(v)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, parsed.Digest, "b08ab6951c7d6cc2b91e17ebd9557da7fae02489728e9332fcb3a97748244d50")
+	})
+	t.Run("RejectsMissingDigest", func(t *testing.T) {
+		// Run
+		_, err := ParseCollectionSynchronization(`collectionId="https://example.com/addison/followers", url="https://example.com/addison/followers_synchronization"`)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RejectsUnterminatedValue", func(t *testing.T) {
+		// Run
+		_, err := ParseCollectionSynchronization(`collectionId="https://example.com`)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestFollowersDigest(t *testing.T) {
+	// Setup
+	a := mustParse(testFederatedActorIRI)
+	b := mustParse(testFederatedActorIRI2)
+	h := sha256.Sum256([]byte(testFederatedActorIRI))
+	// Run
+	empty := FollowersDigest(nil)
+	one := FollowersDigest([]*url.URL{a})
+	ab := FollowersDigest([]*url.URL{a, b})
+	ba := FollowersDigest([]*url.URL{b, a})
+	aa := FollowersDigest([]*url.URL{a, a})
+	// Verify
+	assertEqual(t, empty, strings.Repeat("0", 64))
+	assertEqual(t, one, hex.EncodeToString(h[:]))
+	assertEqual(t, ab, ba)
+	assertNotEqual(t, ab, one)
+	assertEqual(t, aa, empty)
+}
+
+func TestFollowersSynchronization(t *testing.T) {
+	ctx := context.Background()
+	otherHostFollowers := []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)}
+	authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+		return false, nil
+	}
+	t.Run("HeaderDigestsFollowersOnHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		expectFollowers(db)
+		f := NewFollowersSynchronization(db, nil)
+		// Run
+		s, err := f.Header(ctx, mustParse(testMyActorIRI), "other.example.com")
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, s.CollectionId.String(), testMyFollowersIRI)
+		assertEqual(t, s.URL.String(), testMyFollowersSyncIRI)
+		assertEqual(t, s.Digest, FollowersDigest(otherHostFollowers))
+	})
+	t.Run("HandlerServesPartialFollowers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		expectFollowers(db)
+		cl.EXPECT().Now().Return(now())
+		privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := NewFollowersSynchronization(db, nil)
+		req := mustSignedGetRequest(testMyFollowersSyncIRI, testFederatedActorIRI+"#main-key", privKey)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := f.NewHandler(authFn, cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		iris, err := collectionItemIRIs(ctx, resp.Body.Bytes())
+		assertEqual(t, err, nil)
+		assertEqual(t, len(iris), 2)
+		assertEqual(t, FollowersDigest(iris), FollowersDigest(otherHostFollowers))
+	})
+	t.Run("HandlerRejectsUnsignedRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		f := NewFollowersSynchronization(db, nil)
+		req := mustSignedGetRequest(testMyFollowersSyncIRI, "", nil)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := f.NewHandler(authFn, cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("HandlerIgnoresOtherIRIs", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		f := NewFollowersSynchronization(db, nil)
+		req := mustSignedGetRequest(testMyFollowersIRI, "", nil)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := f.NewHandler(authFn, cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, false)
+	})
+	// remoteSync returns a request posting to the local inbox with the
+	// remote actor's Collection-Synchronization header, and an activity of
+	// the remote actor.
+	remoteSync := func(digest string) (*http.Request, Activity) {
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		req.Header.Set(collectionSynchronizationHeader, (&CollectionSynchronization{
+			CollectionId: mustParse(testFederatedActorIRI + "/followers"),
+			URL:          mustParse(testFederatedActorIRI + followersSynchronizationPath),
+			Digest:       digest,
+		}).String())
+		return req, testCreate
+	}
+	t.Run("SynchronizeReportsDifferences", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		tp := NewMockTransport(ctl)
+		store := &testFollowersSyncStore{
+			local: []*url.URL{mustParse(testMyActorIRI), mustParse(testNoteId1)},
+		}
+		remote := []*url.URL{mustParse(testMyActorIRI), mustParse(testNoteId2), mustParse(testPersonIRI)}
+		partial := streams.NewActivityStreamsOrderedCollection()
+		items := streams.NewActivityStreamsOrderedItemsProperty()
+		for _, iri := range remote {
+			items.AppendIRI(iri)
+		}
+		partial.SetActivityStreamsOrderedItems(items)
+		b, err := json.Marshal(mustSerialize(partial))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI+followersSynchronizationPath)).Return(b, nil)
+		f := NewFollowersSynchronization(nil, store)
+		req, activity := remoteSync(FollowersDigest(remote))
+		// Run
+		err = f.Synchronize(ctx, req, activity, tp)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(store.removed), 1)
+		assertEqual(t, store.removed[0], testNoteId1)
+		assertEqual(t, len(store.unexpected), 1)
+		assertEqual(t, store.unexpected[0], testNoteId2)
+	})
+	t.Run("SynchronizeSkipsMatchingDigest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		tp := NewMockTransport(ctl)
+		local := []*url.URL{mustParse(testMyActorIRI), mustParse(testNoteId1)}
+		store := &testFollowersSyncStore{local: local}
+		f := NewFollowersSynchronization(nil, store)
+		req, activity := remoteSync(FollowersDigest(local))
+		// Run
+		err := f.Synchronize(ctx, req, activity, tp)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(store.removed), 0)
+		assertEqual(t, len(store.unexpected), 0)
+	})
+	t.Run("SynchronizeIgnoresCollectionOfAnotherHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		tp := NewMockTransport(ctl)
+		store := &testFollowersSyncStore{local: []*url.URL{mustParse(testMyActorIRI)}}
+		f := NewFollowersSynchronization(nil, store)
+		req, activity := remoteSync(FollowersDigest(nil))
+		req.Header.Set(collectionSynchronizationHeader, (&CollectionSynchronization{
+			CollectionId: mustParse(testMyFollowersIRI),
+			URL:          mustParse(testMyFollowersSyncIRI),
+			Digest:       FollowersDigest(nil),
+		}).String())
+		// Run
+		err := f.Synchronize(ctx, req, activity, tp)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(store.removed), 0)
+	})
+}
+
+func TestCollectionSyncTransport(t *testing.T) {
+	ctx := context.Background()
+	otherHostFollowers := []*url.URL{mustParse(testFederatedActorIRI), mustParse(testFederatedActorIRI2)}
+	toFollowers := []byte(`{"type":"Create","to":["` + testMyFollowersIRI + `"]}`)
+	t.Run("AddsHeaderToDeliveriesToFollowers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		expectFollowers(db)
+		var headers []string
+		record := func(c context.Context, b []byte, to *url.URL) error {
+			headers = append(headers, collectionSynchronizationFromContext(c))
+			return nil
+		}
+		tp.EXPECT().Deliver(gomock.Any(), toFollowers, gomock.Any()).DoAndReturn(record).Times(2)
+		st := NewCollectionSyncTransport(tp, NewFollowersSynchronization(db, nil), mustParse(testMyActorIRI))
+		// Run
+		err := st.BatchDeliver(ctx, toFollowers, []*url.URL{mustParse(testFederatedActorIRI + "/inbox"), mustParse(testPersonIRI + "/inbox")})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(headers), 2)
+		digests := make(map[string]bool)
+		for _, h := range headers {
+			s, err := ParseCollectionSynchronization(h)
+			assertEqual(t, err, nil)
+			digests[s.Digest] = true
+		}
+		assertEqual(t, digests[FollowersDigest(otherHostFollowers)], true)
+		assertEqual(t, digests[FollowersDigest([]*url.URL{mustParse(testPersonIRI)})], true)
+	})
+	t.Run("DoesNotAddHeaderToOtherDeliveries", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		expectFollowers(db)
+		b := []byte(`{"type":"Create","to":"` + testToIRI + `"}`)
+		to := mustParse(testFederatedActorIRI + "/inbox")
+		tp.EXPECT().Deliver(ctx, b, to)
+		st := NewCollectionSyncTransport(tp, NewFollowersSynchronization(db, nil), mustParse(testMyActorIRI))
+		// Run
+		err := st.Deliver(ctx, b, to)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
@@ -87,3 +87,20 @@ type CommonBehavior interface {
 	// garbage collected.
 	NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
 }
+
+// RecipientsOverrider is an optional extension of the CommonBehavior allowing
+// the application to change the inboxes an activity is delivered to, such as
+// to also deliver to a moderation mirror or to drop recipients failing a local
+// policy.
+type RecipientsOverrider interface {
+	// OverrideRecipients is called just before the activity is delivered or
+	// forwarded, with the inboxes resolved from its addressing. The
+	// activity is delivered to the returned inboxes instead, which are
+	// deduplicated. Returning no inboxes skips the delivery.
+	//
+	// The boxIRI is the outbox delivering the activity, or the inbox
+	// forwarding it.
+	//
+	// Returning an error aborts the delivery.
+	OverrideRecipients(c context.Context, boxIRI *url.URL, activity Activity, inboxes []*url.URL) ([]*url.URL, error)
+}
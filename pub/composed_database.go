@@ -0,0 +1,304 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sync"
+)
+
+// ErrNotImplemented is returned by a ComposedDatabase when the library needs a
+// capability the application did not provide.
+var ErrNotImplemented = errors.New("database capability not implemented")
+
+// MemoryLocker is a Locker holding one mutex per id in memory, suitable for an
+// application served by a single process.
+type MemoryLocker struct {
+	mu    sync.Mutex
+	locks map[string]*memoryLock
+}
+
+// memoryLock is the mutex of an id and the number of callers holding or
+// waiting on it.
+type memoryLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// MemoryLocker must satisfy the Locker interface.
+var _ Locker = &MemoryLocker{}
+
+// NewMemoryLocker creates a MemoryLocker.
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{
+		locks: make(map[string]*memoryLock),
+	}
+}
+
+// Lock blocks until the lock for the id is taken.
+func (m *MemoryLocker) Lock(c context.Context, id *url.URL) error {
+	key := id.String()
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &memoryLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+	l.mu.Lock()
+	return nil
+}
+
+// Unlock releases the lock for the id, forgetting it once no caller waits on
+// it.
+func (m *MemoryLocker) Unlock(c context.Context, id *url.URL) error {
+	key := id.String()
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("unlock of %s that is not locked", id)
+	}
+	l.refs--
+	if l.refs == 0 {
+		delete(m.locks, key)
+	}
+	m.mu.Unlock()
+	l.mu.Unlock()
+	return nil
+}
+
+// ComposedDatabase is a Database built from separately implemented
+// capabilities, so that a simple application only implements those its
+// handlers need. Methods of a capability that was not provided return
+// ErrNotImplemented.
+type ComposedDatabase struct {
+	locker      Locker
+	ownership   OwnershipChecker
+	objects     ObjectStore
+	collections CollectionStore
+	minter      IdMinter
+}
+
+// ComposedDatabase must satisfy the Database, Transactor, and BlocksStore
+// interfaces.
+var _ Database = &ComposedDatabase{}
+var _ Transactor = &ComposedDatabase{}
+var _ BlocksStore = &ComposedDatabase{}
+
+// NewComposedDatabase combines the capabilities into a Database. Any of them
+// may be nil. If the Locker is nil, a MemoryLocker is used.
+func NewComposedDatabase(locker Locker, ownership OwnershipChecker, objects ObjectStore, collections CollectionStore, minter IdMinter) *ComposedDatabase {
+	if locker == nil {
+		locker = NewMemoryLocker()
+	}
+	return &ComposedDatabase{
+		locker:      locker,
+		ownership:   ownership,
+		objects:     objects,
+		collections: collections,
+		minter:      minter,
+	}
+}
+
+// transactor returns the first capability that is a Transactor, or nil.
+func (d *ComposedDatabase) transactor() Transactor {
+	for _, v := range []interface{}{d.objects, d.collections, d.ownership, d.locker} {
+		if t, ok := v.(Transactor); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+// Begin defers to the first capability that is a Transactor, checking the
+// ObjectStore, CollectionStore, OwnershipChecker, and Locker in that order.
+// If none is, it does nothing.
+func (d *ComposedDatabase) Begin(c context.Context) (context.Context, error) {
+	if t := d.transactor(); t != nil {
+		return t.Begin(c)
+	}
+	return c, nil
+}
+
+// Commit defers to the Transactor that began the transaction, if any.
+func (d *ComposedDatabase) Commit(c context.Context) error {
+	if t := d.transactor(); t != nil {
+		return t.Commit(c)
+	}
+	return nil
+}
+
+// Rollback defers to the Transactor that began the transaction, if any.
+func (d *ComposedDatabase) Rollback(c context.Context) error {
+	if t := d.transactor(); t != nil {
+		return t.Rollback(c)
+	}
+	return nil
+}
+
+// Lock defers to the Locker.
+func (d *ComposedDatabase) Lock(c context.Context, id *url.URL) error {
+	return d.locker.Lock(c, id)
+}
+
+// Unlock defers to the Locker.
+func (d *ComposedDatabase) Unlock(c context.Context, id *url.URL) error {
+	return d.locker.Unlock(c, id)
+}
+
+// Owns defers to the OwnershipChecker.
+func (d *ComposedDatabase) Owns(c context.Context, id *url.URL) (bool, error) {
+	if d.ownership == nil {
+		return false, ErrNotImplemented
+	}
+	return d.ownership.Owns(c, id)
+}
+
+// ActorForOutbox defers to the OwnershipChecker.
+func (d *ComposedDatabase) ActorForOutbox(c context.Context, outboxIRI *url.URL) (*url.URL, error) {
+	if d.ownership == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.ownership.ActorForOutbox(c, outboxIRI)
+}
+
+// ActorForInbox defers to the OwnershipChecker.
+func (d *ComposedDatabase) ActorForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	if d.ownership == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.ownership.ActorForInbox(c, inboxIRI)
+}
+
+// OutboxForInbox defers to the OwnershipChecker.
+func (d *ComposedDatabase) OutboxForInbox(c context.Context, inboxIRI *url.URL) (*url.URL, error) {
+	if d.ownership == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.ownership.OutboxForInbox(c, inboxIRI)
+}
+
+// Exists defers to the ObjectStore.
+func (d *ComposedDatabase) Exists(c context.Context, id *url.URL) (bool, error) {
+	if d.objects == nil {
+		return false, ErrNotImplemented
+	}
+	return d.objects.Exists(c, id)
+}
+
+// Get defers to the ObjectStore.
+func (d *ComposedDatabase) Get(c context.Context, id *url.URL) (vocab.Type, error) {
+	if d.objects == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.objects.Get(c, id)
+}
+
+// Create defers to the ObjectStore.
+func (d *ComposedDatabase) Create(c context.Context, asType vocab.Type) error {
+	if d.objects == nil {
+		return ErrNotImplemented
+	}
+	return d.objects.Create(c, asType)
+}
+
+// Update defers to the ObjectStore.
+func (d *ComposedDatabase) Update(c context.Context, asType vocab.Type) error {
+	if d.objects == nil {
+		return ErrNotImplemented
+	}
+	return d.objects.Update(c, asType)
+}
+
+// Delete defers to the ObjectStore.
+func (d *ComposedDatabase) Delete(c context.Context, id *url.URL) error {
+	if d.objects == nil {
+		return ErrNotImplemented
+	}
+	return d.objects.Delete(c, id)
+}
+
+// NewId defers to the IdMinter.
+func (d *ComposedDatabase) NewId(c context.Context, t vocab.Type) (*url.URL, error) {
+	if d.minter == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.minter.NewId(c, t)
+}
+
+// InboxContains defers to the CollectionStore.
+func (d *ComposedDatabase) InboxContains(c context.Context, inbox, id *url.URL) (bool, error) {
+	if d.collections == nil {
+		return false, ErrNotImplemented
+	}
+	return d.collections.InboxContains(c, inbox, id)
+}
+
+// GetInbox defers to the CollectionStore.
+func (d *ComposedDatabase) GetInbox(c context.Context, inboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	if d.collections == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.collections.GetInbox(c, inboxIRI)
+}
+
+// SetInbox defers to the CollectionStore.
+func (d *ComposedDatabase) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	if d.collections == nil {
+		return ErrNotImplemented
+	}
+	return d.collections.SetInbox(c, inbox)
+}
+
+// GetOutbox defers to the CollectionStore.
+func (d *ComposedDatabase) GetOutbox(c context.Context, outboxIRI *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	if d.collections == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.collections.GetOutbox(c, outboxIRI)
+}
+
+// SetOutbox defers to the CollectionStore.
+func (d *ComposedDatabase) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	if d.collections == nil {
+		return ErrNotImplemented
+	}
+	return d.collections.SetOutbox(c, outbox)
+}
+
+// Followers defers to the CollectionStore.
+func (d *ComposedDatabase) Followers(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	if d.collections == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.collections.Followers(c, actorIRI)
+}
+
+// Following defers to the CollectionStore.
+func (d *ComposedDatabase) Following(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	if d.collections == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.collections.Following(c, actorIRI)
+}
+
+// Liked defers to the CollectionStore.
+func (d *ComposedDatabase) Liked(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	if d.collections == nil {
+		return nil, ErrNotImplemented
+	}
+	return d.collections.Liked(c, actorIRI)
+}
+
+// Blocks defers to the CollectionStore if it is a BlocksStore.
+func (d *ComposedDatabase) Blocks(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	b, ok := d.collections.(BlocksStore)
+	if !ok {
+		return nil, ErrNotImplemented
+	}
+	return b.Blocks(c, actorIRI)
+}
@@ -0,0 +1,85 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestMemoryLocker(t *testing.T) {
+	ctx := context.Background()
+	id := mustParse(testNoteId1)
+	// Setup
+	l := NewMemoryLocker()
+	err := l.Lock(ctx, id)
+	assertEqual(t, err, nil)
+	locked := make(chan struct{})
+	// Run
+	go func() {
+		l.Lock(ctx, id)
+		close(locked)
+	}()
+	// Verify
+	select {
+	case <-locked:
+		t.Fatal("second Lock did not block")
+	case <-time.After(10 * time.Millisecond):
+	}
+	err = l.Unlock(ctx, id)
+	assertEqual(t, err, nil)
+	<-locked
+	err = l.Unlock(ctx, id)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(l.locks), 0)
+	assertNotEqual(t, l.Unlock(ctx, id), nil)
+}
+
+func TestComposedDatabase(t *testing.T) {
+	ctx := context.Background()
+	t.Run("DefersToCapabilities", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDb := NewMockDatabase(ctl)
+		db := NewComposedDatabase(mockDb, mockDb, mockDb, mockDb, mockDb)
+		gomock.InOrder(
+			mockDb.EXPECT().Lock(ctx, mustParse(testMyInboxIRI)),
+			mockDb.EXPECT().ActorForInbox(ctx, mustParse(testMyInboxIRI)).Return(mustParse(testMyActorIRI), nil),
+			mockDb.EXPECT().Exists(ctx, mustParse(testNoteId1)).Return(true, nil),
+			mockDb.EXPECT().InboxContains(ctx, mustParse(testMyInboxIRI), mustParse(testNoteId1)).Return(true, nil),
+			mockDb.EXPECT().Unlock(ctx, mustParse(testMyInboxIRI)),
+		)
+		// Run
+		lockErr := db.Lock(ctx, mustParse(testMyInboxIRI))
+		actor, actorErr := db.ActorForInbox(ctx, mustParse(testMyInboxIRI))
+		exists, existsErr := db.Exists(ctx, mustParse(testNoteId1))
+		contains, containsErr := db.InboxContains(ctx, mustParse(testMyInboxIRI), mustParse(testNoteId1))
+		unlockErr := db.Unlock(ctx, mustParse(testMyInboxIRI))
+		// Verify
+		assertEqual(t, lockErr, nil)
+		assertEqual(t, actorErr, nil)
+		assertEqual(t, actor.String(), testMyActorIRI)
+		assertEqual(t, existsErr, nil)
+		assertEqual(t, exists, true)
+		assertEqual(t, containsErr, nil)
+		assertEqual(t, contains, true)
+		assertEqual(t, unlockErr, nil)
+	})
+	t.Run("MissingCapabilities", func(t *testing.T) {
+		// Setup
+		db := NewComposedDatabase(nil, nil, nil, nil, nil)
+		// Run
+		lockErr := db.Lock(ctx, mustParse(testNoteId1))
+		unlockErr := db.Unlock(ctx, mustParse(testNoteId1))
+		_, ownsErr := db.Owns(ctx, mustParse(testNoteId1))
+		createErr := db.Create(ctx, testMyNote)
+		_, followersErr := db.Followers(ctx, mustParse(testMyActorIRI))
+		// Verify
+		assertEqual(t, lockErr, nil)
+		assertEqual(t, unlockErr, nil)
+		assertEqual(t, ownsErr, ErrNotImplemented)
+		assertEqual(t, createErr, ErrNotImplemented)
+		assertEqual(t, followersErr, ErrNotImplemented)
+	})
+}
@@ -0,0 +1,107 @@
+package pub
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxIdleConns is the number of idle connections kept open to
+	// all hosts by the transport of ConnectionOptions.
+	DefaultMaxIdleConns = 100
+	// DefaultMaxIdleConnsPerHost is the number of idle connections kept
+	// open to each host by the transport of ConnectionOptions, so that
+	// concurrent deliveries to a host reuse connections. The standard
+	// library keeps only two.
+	DefaultMaxIdleConnsPerHost = 16
+	// DefaultIdleConnTimeout is how long an idle connection is kept open
+	// by the transport of ConnectionOptions.
+	DefaultIdleConnTimeout = 90 * time.Second
+	// DefaultTLSHandshakeTimeout bounds the TLS handshakes of the
+	// transport of ConnectionOptions.
+	DefaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+// ConnectionOptions configure the connection pool of an http.Transport, such
+// as to fan out deliveries to many peers without exhausting ephemeral ports
+// or reconnecting for each request. Zero values use the defaults.
+type ConnectionOptions struct {
+	// MaxIdleConns is the number of idle connections kept open to all
+	// hosts. Zero means DefaultMaxIdleConns, and a negative number means
+	// no limit.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the number of idle connections kept open to
+	// each host. Zero means DefaultMaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost bounds the connections to each host, including those
+	// in use. Zero means no limit.
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept open. Zero
+	// means DefaultIdleConnTimeout.
+	IdleConnTimeout time.Duration
+	// TLSHandshakeTimeout bounds each TLS handshake. Zero means
+	// DefaultTLSHandshakeTimeout.
+	TLSHandshakeTimeout time.Duration
+	// TLSConfig configures TLS connections, such as their root
+	// certificates. Optional.
+	TLSConfig *tls.Config
+	// DisableHTTP2 makes connections use HTTP/1.1 only. Otherwise HTTP/2
+	// is negotiated with peers supporting it, multiplexing requests to a
+	// host over a single connection.
+	DisableHTTP2 bool
+	// DisableKeepAlives closes every connection after a single request.
+	DisableKeepAlives bool
+}
+
+// NewHttpTransport creates an http.Transport configured by the options,
+// which may be used by an http.Client passed to a Transport as its
+// HttpClient. Proxies configured in the environment are used.
+func NewHttpTransport(opts ConnectionOptions) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	t := opts.transport(dialer.DialContext)
+	t.Proxy = http.ProxyFromEnvironment
+	return t
+}
+
+// transport creates an http.Transport dialing with the function, configured
+// by the options.
+func (o ConnectionOptions) transport(dial DialFunc) *http.Transport {
+	t := &http.Transport{
+		DialContext:           dial,
+		MaxIdleConns:          o.MaxIdleConns,
+		MaxIdleConnsPerHost:   o.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       o.MaxConnsPerHost,
+		IdleConnTimeout:       o.IdleConnTimeout,
+		TLSHandshakeTimeout:   o.TLSHandshakeTimeout,
+		ExpectContinueTimeout: time.Second,
+		DisableKeepAlives:     o.DisableKeepAlives,
+		ForceAttemptHTTP2:     !o.DisableHTTP2,
+	}
+	if t.MaxIdleConns == 0 {
+		t.MaxIdleConns = DefaultMaxIdleConns
+	} else if t.MaxIdleConns < 0 {
+		t.MaxIdleConns = 0
+	}
+	if t.MaxIdleConnsPerHost == 0 {
+		t.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if t.IdleConnTimeout == 0 {
+		t.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	if t.TLSHandshakeTimeout == 0 {
+		t.TLSHandshakeTimeout = DefaultTLSHandshakeTimeout
+	}
+	if o.TLSConfig != nil {
+		t.TLSClientConfig = o.TLSConfig.Clone()
+	}
+	if o.DisableHTTP2 {
+		// A non-nil map prevents HTTP/2 from being negotiated.
+		t.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+	return t
+}
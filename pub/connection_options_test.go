@@ -0,0 +1,71 @@
+package pub
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestConnectionOptions(t *testing.T) {
+	t.Run("UsesDefaults", func(t *testing.T) {
+		// Run
+		tp := NewHttpTransport(ConnectionOptions{})
+		// Verify
+		assertEqual(t, tp.MaxIdleConns, DefaultMaxIdleConns)
+		assertEqual(t, tp.MaxIdleConnsPerHost, DefaultMaxIdleConnsPerHost)
+		assertEqual(t, tp.IdleConnTimeout, DefaultIdleConnTimeout)
+		assertEqual(t, tp.TLSHandshakeTimeout, DefaultTLSHandshakeTimeout)
+		assertEqual(t, tp.ForceAttemptHTTP2, true)
+		assertEqual(t, tp.TLSNextProto == nil, true)
+	})
+	t.Run("AppliesOptions", func(t *testing.T) {
+		// Setup
+		cfg := &tls.Config{ServerName: "example.com"}
+		// Run
+		tp := NewHttpTransport(ConnectionOptions{
+			MaxIdleConns:        -1,
+			MaxIdleConnsPerHost: 64,
+			MaxConnsPerHost:     128,
+			IdleConnTimeout:     time.Minute,
+			TLSConfig:           cfg,
+			DisableHTTP2:        true,
+		})
+		// Verify
+		assertEqual(t, tp.MaxIdleConns, 0)
+		assertEqual(t, tp.MaxIdleConnsPerHost, 64)
+		assertEqual(t, tp.MaxConnsPerHost, 128)
+		assertEqual(t, tp.IdleConnTimeout, time.Minute)
+		assertEqual(t, tp.TLSClientConfig.ServerName, "example.com")
+		assertNotEqual(t, tp.TLSClientConfig, cfg)
+		assertEqual(t, tp.ForceAttemptHTTP2, false)
+		assertEqual(t, len(tp.TLSNextProto), 0)
+		assertEqual(t, tp.TLSNextProto != nil, true)
+	})
+	t.Run("NegotiatesHTTP2", func(t *testing.T) {
+		// Setup
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(r.Proto))
+		}))
+		server.EnableHTTP2 = true
+		server.StartTLS()
+		defer server.Close()
+		cfg := server.Client().Transport.(*http.Transport).TLSClientConfig
+		client := &http.Client{Transport: NewHttpTransport(ConnectionOptions{TLSConfig: cfg})}
+		// Run
+		resp, err := client.Get(server.URL)
+		// Verify
+		assertEqual(t, err, nil)
+		resp.Body.Close()
+		assertEqual(t, resp.ProtoMajor, 2)
+	})
+	t.Run("GuardUsesOptions", func(t *testing.T) {
+		// Run
+		c := NewGuardedHttpClient(RequestGuard{Connections: ConnectionOptions{MaxIdleConnsPerHost: 32}})
+		// Verify
+		tp := c.(*guardedClient).client.Transport.(*http.Transport)
+		assertEqual(t, tp.MaxIdleConnsPerHost, 32)
+		assertEqual(t, tp.Proxy == nil, true)
+	})
+}
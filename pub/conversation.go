@@ -0,0 +1,162 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// ConversationStore is implemented by a Database tracking conversations, the
+// 'context' shared by an object and the replies to it.
+//
+// When the Database is a ConversationStore, an object created in an outbox
+// without a 'context' inherits the one of the object it is in reply to, or is
+// given a new one minted by NewId. Objects created in an outbox or received in
+// an inbox are then recorded as part of their conversation. A received object
+// without a 'context' is recorded in the one of the object it is in reply to,
+// if known, but is not modified.
+type ConversationStore interface {
+	// AddToConversation records the object as part of the conversation.
+	AddToConversation(c context.Context, conversation, object *url.URL) error
+	// Conversation returns the ids of the objects recorded as part of the
+	// conversation.
+	Conversation(c context.Context, conversation *url.URL) (objects []*url.URL, err error)
+}
+
+// FetchConversation returns the conversation of the object stored in the
+// Database, and all the objects known to be part of it, such as to assemble a
+// thread. The Database must be a ConversationStore.
+//
+// Objects that are no longer in the Database are omitted. If the object is
+// not part of a known conversation, it is the only object returned.
+func FetchConversation(c context.Context, db Database, object *url.URL) (conversation *url.URL, objects []vocab.Type, err error) {
+	store, ok := db.(ConversationStore)
+	if !ok {
+		return nil, nil, fmt.Errorf("database %T is not a ConversationStore", db)
+	}
+	t, err := getIfExists(c, db, object)
+	if err != nil {
+		return nil, nil, err
+	} else if t == nil {
+		return nil, nil, ErrNotFound
+	}
+	if conversation, err = conversationOf(c, db, t); err != nil {
+		return nil, nil, err
+	} else if conversation == nil {
+		return nil, []vocab.Type{t}, nil
+	}
+	ids, err := store.Conversation(c, conversation)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, id := range ids {
+		var v vocab.Type
+		if v, err = getIfExists(c, db, id); err != nil {
+			return nil, nil, err
+		} else if v != nil {
+			objects = append(objects, v)
+		}
+	}
+	return conversation, objects, nil
+}
+
+// setConversation sets the 'context' of an object created in an outbox, if it
+// has none, to the one of the object it is in reply to or to a new one, then
+// records it as part of that conversation. Nothing is done if the Database is
+// not a ConversationStore.
+func setConversation(c context.Context, db Database, t vocab.Type) error {
+	store, ok := db.(ConversationStore)
+	if !ok {
+		return nil
+	}
+	contexted, ok := t.(contexter)
+	if !ok {
+		return nil
+	}
+	conversation, err := conversationOf(c, db, t)
+	if err != nil {
+		return err
+	}
+	if conversation == nil {
+		if conversation, err = db.NewId(c, streams.NewActivityStreamsCollection()); err != nil {
+			return err
+		}
+	}
+	if p := contexted.GetActivityStreamsContext(); p == nil || p.Len() == 0 {
+		p = streams.NewActivityStreamsContextProperty()
+		p.AppendIRI(conversation)
+		contexted.SetActivityStreamsContext(p)
+	}
+	id, err := GetId(t)
+	if err != nil {
+		return err
+	}
+	return store.AddToConversation(c, conversation, id)
+}
+
+// addToConversation records an object received in an inbox as part of its
+// conversation, if it is known. Nothing is done if the Database is not a
+// ConversationStore.
+func addToConversation(c context.Context, db Database, t vocab.Type) error {
+	store, ok := db.(ConversationStore)
+	if !ok {
+		return nil
+	}
+	conversation, err := conversationOf(c, db, t)
+	if err != nil || conversation == nil {
+		return err
+	}
+	id, err := GetId(t)
+	if err != nil {
+		return err
+	}
+	return store.AddToConversation(c, conversation, id)
+}
+
+// conversationOf returns the 'context' of the object, or the one of the first
+// object it is in reply to which is in the Database and has one. It is nil if
+// neither is known.
+func conversationOf(c context.Context, db Database, t vocab.Type) (*url.URL, error) {
+	if id := contextId(t); id != nil {
+		return id, nil
+	}
+	irt, ok := t.(inReplyToer)
+	if !ok || irt.GetActivityStreamsInReplyTo() == nil {
+		return nil, nil
+	}
+	inReplyTo := irt.GetActivityStreamsInReplyTo()
+	for iter := inReplyTo.Begin(); iter != inReplyTo.End(); iter = iter.Next() {
+		parentIRI, err := ToId(iter)
+		if err != nil {
+			return nil, err
+		}
+		parent, err := getIfExists(c, db, parentIRI)
+		if err != nil {
+			return nil, err
+		} else if parent == nil {
+			continue
+		}
+		if id := contextId(parent); id != nil {
+			return id, nil
+		}
+	}
+	return nil, nil
+}
+
+// contextId returns the id of the first value of the 'context' of the object,
+// if any.
+func contextId(t vocab.Type) *url.URL {
+	contexted, ok := t.(contexter)
+	if !ok || contexted.GetActivityStreamsContext() == nil {
+		return nil
+	}
+	p := contexted.GetActivityStreamsContext()
+	for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+		if id, err := ToId(iter); err == nil {
+			return id
+		}
+	}
+	return nil
+}
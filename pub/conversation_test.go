@@ -0,0 +1,140 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// testConversationDatabase is a Database recording conversations in memory.
+type testConversationDatabase struct {
+	*MockDatabase
+	conversations map[string][]*url.URL
+}
+
+func (d *testConversationDatabase) AddToConversation(c context.Context, conversation, object *url.URL) error {
+	d.conversations[conversation.String()] = append(d.conversations[conversation.String()], object)
+	return nil
+}
+
+func (d *testConversationDatabase) Conversation(c context.Context, conversation *url.URL) ([]*url.URL, error) {
+	return d.conversations[conversation.String()], nil
+}
+
+func TestConversation(t *testing.T) {
+	ctx := context.Background()
+	conversationIRI := mustParse("https://example.com/conversation/1")
+	parentIRI := mustParse(testNoteId1)
+	replyIRI := mustParse(testNoteId2)
+	newNote := func(id *url.URL, inReplyTo *url.URL, conversation *url.URL) vocab.ActivityStreamsNote {
+		note := streams.NewActivityStreamsNote()
+		idProp := streams.NewActivityStreamsIdProperty()
+		idProp.Set(id)
+		note.SetActivityStreamsId(idProp)
+		if inReplyTo != nil {
+			irt := streams.NewActivityStreamsInReplyToProperty()
+			irt.AppendIRI(inReplyTo)
+			note.SetActivityStreamsInReplyTo(irt)
+		}
+		if conversation != nil {
+			cp := streams.NewActivityStreamsContextProperty()
+			cp.AppendIRI(conversation)
+			note.SetActivityStreamsContext(cp)
+		}
+		return note
+	}
+	expectGet := func(mockDB *MockDatabase, id *url.URL, t vocab.Type) {
+		mockDB.EXPECT().Lock(ctx, id)
+		mockDB.EXPECT().Exists(ctx, id).Return(t != nil, nil)
+		if t != nil {
+			mockDB.EXPECT().Get(ctx, id).Return(t, nil)
+		}
+		mockDB.EXPECT().Unlock(ctx, id)
+	}
+	setupFn := func(ctl *gomock.Controller) (mockDB *MockDatabase, db *testConversationDatabase) {
+		mockDB = NewMockDatabase(ctl)
+		db = &testConversationDatabase{MockDatabase: mockDB, conversations: make(map[string][]*url.URL)}
+		return
+	}
+	t.Run("InheritsFromParent", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDB, db := setupFn(ctl)
+		reply := newNote(replyIRI, parentIRI, nil)
+		expectGet(mockDB, parentIRI, newNote(parentIRI, nil, conversationIRI))
+		// Run
+		err := setConversation(ctx, db, reply)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, contextId(reply).String(), conversationIRI.String())
+		assertEqual(t, len(db.conversations[conversationIRI.String()]), 1)
+	})
+	t.Run("StartsConversationIfAbsent", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDB, db := setupFn(ctl)
+		note := newNote(parentIRI, nil, nil)
+		mockDB.EXPECT().NewId(ctx, gomock.Any()).Return(conversationIRI, nil)
+		// Run
+		err := setConversation(ctx, db, note)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, contextId(note).String(), conversationIRI.String())
+		assertEqual(t, db.conversations[conversationIRI.String()][0].String(), parentIRI.String())
+	})
+	t.Run("DoesNotModifyReceivedObjects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDB, db := setupFn(ctl)
+		reply := newNote(replyIRI, parentIRI, nil)
+		expectGet(mockDB, parentIRI, newNote(parentIRI, nil, conversationIRI))
+		// Run
+		err := addToConversation(ctx, db, reply)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, contextId(reply) == nil, true)
+		assertEqual(t, db.conversations[conversationIRI.String()][0].String(), replyIRI.String())
+	})
+	t.Run("DoesNothingWithoutStore", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDB, _ := setupFn(ctl)
+		note := newNote(parentIRI, nil, nil)
+		// Run
+		err := setConversation(ctx, mockDB, note)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, contextId(note) == nil, true)
+	})
+	t.Run("FetchesConversation", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		mockDB, db := setupFn(ctl)
+		db.conversations[conversationIRI.String()] = []*url.URL{parentIRI, replyIRI, mustParse("https://example.com/note/3")}
+		parent := newNote(parentIRI, nil, conversationIRI)
+		reply := newNote(replyIRI, parentIRI, conversationIRI)
+		expectGet(mockDB, replyIRI, reply)
+		expectGet(mockDB, parentIRI, parent)
+		expectGet(mockDB, replyIRI, reply)
+		expectGet(mockDB, mustParse("https://example.com/note/3"), nil)
+		// Run
+		conversation, objects, err := FetchConversation(ctx, db, replyIRI)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, conversation.String(), conversationIRI.String())
+		var ids []string
+		for _, o := range objects {
+			ids = append(ids, o.GetActivityStreamsId().Get().String())
+		}
+		assertEqual(t, strings.Join(ids, " "), parentIRI.String()+" "+replyIRI.String())
+	})
+}
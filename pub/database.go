@@ -6,7 +6,23 @@ import (
 	"net/url"
 )
 
+// Database is the data store abstraction of the library, not tied to the
+// database/sql package.
+//
+// It is composed of the Locker, OwnershipChecker, ObjectStore,
+// CollectionStore, and IdMinter capabilities. An application may implement
+// them separately and combine them with a ComposedDatabase.
 type Database interface {
+	Locker
+	OwnershipChecker
+	ObjectStore
+	CollectionStore
+	IdMinter
+}
+
+// Locker takes and releases the locks the library holds while reading and
+// modifying entries.
+type Locker interface {
 	// Lock takes a lock for the object at the specified id. If an error
 	// is returned, the lock must not have been taken.
 	//
@@ -24,22 +40,11 @@ type Database interface {
 	//
 	// Used to ensure race conditions in multiple requests do not occur.
 	Unlock(c context.Context, id *url.URL) error
-	// InboxContains returns true if the OrderedCollection at 'inbox'
-	// contains the specified 'id'.
-	//
-	// The library makes this call only after acquiring a lock first.
-	InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error)
-	// GetInbox returns the first ordered collection page of the outbox at
-	// the specified IRI, for prepending new items.
-	//
-	// The library makes this call only after acquiring a lock first.
-	GetInbox(c context.Context, inboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error)
-	// SetInbox saves the inbox value given from GetInbox, with new items
-	// prepended. Note that the new items must not be added as independent
-	// database entries. Separate calls to Create will do that.
-	//
-	// The library makes this call only after acquiring a lock first.
-	SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error
+}
+
+// OwnershipChecker determines which IRIs are owned by this server and
+// relates its actors to their inboxes and outboxes.
+type OwnershipChecker interface {
 	// Owns returns true if the database has an entry for the IRI and it
 	// exists in the database.
 	//
@@ -58,6 +63,11 @@ type Database interface {
 	//
 	// The library makes this call only after acquiring a lock first.
 	OutboxForInbox(c context.Context, inboxIRI *url.URL) (outboxIRI *url.URL, err error)
+}
+
+// ObjectStore holds the ActivityStreams values known to this server, keyed by
+// their ids.
+type ObjectStore interface {
 	// Exists returns true if the database has an entry for the specified
 	// id. It may not be owned by this application instance.
 	//
@@ -97,6 +107,27 @@ type Database interface {
 	//
 	// The library makes this call only after acquiring a lock first.
 	Delete(c context.Context, id *url.URL) error
+}
+
+// CollectionStore holds the inboxes, outboxes, and other collections of this
+// server's actors.
+type CollectionStore interface {
+	// InboxContains returns true if the OrderedCollection at 'inbox'
+	// contains the specified 'id'.
+	//
+	// The library makes this call only after acquiring a lock first.
+	InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error)
+	// GetInbox returns the first ordered collection page of the outbox at
+	// the specified IRI, for prepending new items.
+	//
+	// The library makes this call only after acquiring a lock first.
+	GetInbox(c context.Context, inboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error)
+	// SetInbox saves the inbox value given from GetInbox, with new items
+	// prepended. Note that the new items must not be added as independent
+	// database entries. Separate calls to Create will do that.
+	//
+	// The library makes this call only after acquiring a lock first.
+	SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error
 	// GetOutbox returns the first ordered collection page of the outbox
 	// at the specified IRI, for prepending new items.
 	//
@@ -108,13 +139,6 @@ type Database interface {
 	//
 	// The library makes this call only after acquiring a lock first.
 	SetOutbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error
-	// NewId creates a new IRI id for the provided activity or object. The
-	// implementation does not need to set the 'id' property and simply
-	// needs to determine the value.
-	//
-	// The go-fed library will handle setting the 'id' property on the
-	// activity or object provided with the value returned.
-	NewId(c context.Context, t vocab.Type) (id *url.URL, err error)
 	// Followers obtains the Followers Collection for an actor with the
 	// given id.
 	//
@@ -137,3 +161,39 @@ type Database interface {
 	// The library makes this call only after acquiring a lock first.
 	Liked(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error)
 }
+
+// BlocksStore is implemented by a Database holding the actors blocked by this
+// server's actors. If the Database is not a BlocksStore, blocks are not
+// enforced, and a Block sent through the Social Protocol is only kept from
+// being delivered.
+//
+// A ComposedDatabase is one if its CollectionStore is.
+type BlocksStore interface {
+	// Blocks obtains the Collection of actors blocked by the actor with
+	// the given id.
+	//
+	// The library adds to it when the actor sends a Block through the
+	// Social Protocol, and removes from it when the Block is undone. Any
+	// activity delivered to the actor's inbox by a blocked actor is
+	// dropped, and no activities are delivered to blocked actors.
+	//
+	// If modified, the library will then call Update.
+	//
+	// The library makes this call only after acquiring a lock first.
+	Blocks(c context.Context, actorIRI *url.URL) (blocks vocab.ActivityStreamsCollection, err error)
+}
+
+// IdMinter assigns ids to the activities and objects submitted to an outbox,
+// and to the activities the library creates on an actor's behalf.
+//
+// A Database's NewId may defer to a TemplateIdMinter, which builds ids from
+// per-type URL templates and one of the provided IdTokenFuncs.
+type IdMinter interface {
+	// NewId creates a new IRI id for the provided activity or object. The
+	// implementation does not need to set the 'id' property and simply
+	// needs to determine the value.
+	//
+	// The go-fed library will handle setting the 'id' property on the
+	// activity or object provided with the value returned.
+	NewId(c context.Context, t vocab.Type) (id *url.URL, err error)
+}
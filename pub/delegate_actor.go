@@ -104,7 +104,9 @@ type DelegateActor interface {
 	// If an error is returned, it is passed back to the caller of
 	// PostInbox. In this case, the implementation must not write a
 	// response to the ResponseWriter as is expected that the client will
-	// do so when handling the error. The 'authorized' is ignored.
+	// do so when handling the error. The 'authorized' is ignored. An error
+	// for which ErrorStatus is a client error status, such as
+	// ErrActorRequired, is instead responded to with that status.
 	//
 	// If no error is returned, but authorization fails, then authorized
 	// must be false and error nil. It is expected that the implementation
@@ -124,8 +126,12 @@ type DelegateActor interface {
 	// later) must decide whether it has seen this activity before in order
 	// to determine whether to do the forwarding algorithm.
 	//
-	// If the error is ErrObjectRequired or ErrTargetRequired, then a Bad
-	// Request status is sent in the response.
+	// If ErrorStatus of the error is a client error status, such as the
+	// Bad Request of ErrObjectRequired or ErrTargetRequired, then it is
+	// sent in the response. If the error is ErrDuplicateActivity, then an
+	// Accepted status is sent in the response and InboxForwarding is not
+	// called. The same goes for ErrBlocked, so that an activity from a
+	// blocked actor is neither stored nor forwarded.
 	PostInbox(c context.Context, inboxIRI *url.URL, activity Activity) error
 	// InboxForwarding delegates inbox forwarding logic when a POST request
 	// is received in the Actor's inbox.
@@ -157,8 +163,9 @@ type DelegateActor interface {
 	// general storage for independent retrieval, and not just within the
 	// actor's outbox.
 	//
-	// If the error is ErrObjectRequired or ErrTargetRequired, then a Bad
-	// Request status is sent in the response.
+	// If ErrorStatus of the error is a client error status, such as the
+	// Bad Request of ErrObjectRequired or ErrTargetRequired, then it is
+	// sent in the response.
 	//
 	// Note that 'rawJSON' is an unfortunate consequence where an 'Update'
 	// Activity is the only one that explicitly cares about 'null' values in
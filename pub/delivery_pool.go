@@ -0,0 +1,185 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+)
+
+// ErrDeliveryPoolClosed is the error of deliveries that could not be attempted
+// because their DeliveryPool was closed.
+var ErrDeliveryPoolClosed = errors.New("delivery pool is closed")
+
+// deliveryPoolContextKey is the context key under which WithDeliveryPool
+// stores the DeliveryPool.
+type deliveryPoolContextKey struct{}
+
+// DeliveryPool is a fixed number of workers delivering activities, shared by
+// every activity fanned out to its recipients. Its size bounds the deliveries
+// in flight at once, trading the throughput of large fan-outs, such as to the
+// followers of popular actors, against the goroutines, connections, and
+// memory they use.
+//
+// The pool is carried by the context given to the library, so that every
+// Transport's BatchDeliver, the transports wrapping them, and
+// ProcessDeliveries schedule their deliveries on it. Use WithDeliveryPool to
+// add it to the contexts of requests and of calls to ProcessDeliveries.
+// Without it, each recipient of a batch is delivered to by its own goroutine,
+// and queued deliveries are attempted one after another.
+//
+// Scheduling blocks while every worker is busy, applying backpressure to the
+// callers fanning out. Deliveries scheduled on a pool must therefore not wait
+// on other deliveries scheduled on the same pool.
+type DeliveryPool struct {
+	jobs      chan func()
+	closed    chan struct{}
+	closeOnce sync.Once
+	workers   sync.WaitGroup
+}
+
+// NewDeliveryPool creates a DeliveryPool of the number of workers, at least
+// one, and starts them. They run until the pool is closed.
+func NewDeliveryPool(workers int) *DeliveryPool {
+	if workers < 1 {
+		workers = 1
+	}
+	p := &DeliveryPool{
+		jobs:   make(chan func()),
+		closed: make(chan struct{}),
+	}
+	p.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// work runs the jobs scheduled on the pool until it is closed.
+func (p *DeliveryPool) work() {
+	defer p.workers.Done()
+	for {
+		select {
+		case job := <-p.jobs:
+			job()
+		case <-p.closed:
+			return
+		}
+	}
+}
+
+// Close stops the pool from scheduling deliveries and waits for those in
+// progress to complete. Deliveries not yet scheduled fail with an
+// ErrDeliveryPoolClosed.
+func (p *DeliveryPool) Close() {
+	p.closeOnce.Do(func() {
+		close(p.closed)
+	})
+	p.workers.Wait()
+}
+
+// schedule runs the job on a worker once one is free. Returns an error without
+// running it if the pool is closed or the context is done first.
+func (p *DeliveryPool) schedule(c context.Context, job func()) error {
+	select {
+	case <-p.closed:
+		return ErrDeliveryPoolClosed
+	default:
+	}
+	select {
+	case p.jobs <- job:
+		return nil
+	case <-p.closed:
+		return ErrDeliveryPoolClosed
+	case <-c.Done():
+		return c.Err()
+	}
+}
+
+// each schedules fn for each index below n, and returns a channel closed once
+// every call returned. The indexes that could not be scheduled are passed to
+// skip with the reason instead.
+func (p *DeliveryPool) each(c context.Context, n int, fn func(i int), skip func(i int, err error)) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			i := i
+			wg.Add(1)
+			err := p.schedule(c, func() {
+				defer wg.Done()
+				fn(i)
+			})
+			if err != nil {
+				wg.Done()
+				skip(i, err)
+			}
+		}
+		wg.Wait()
+	}()
+	return done
+}
+
+// BatchDeliver starts delivering the payload to each recipient with the
+// Transport, and returns the DeliveryFuture of the results without waiting for
+// them. Deliveries are timed by the Clock, as with BatchDeliverResults.
+func (p *DeliveryPool) BatchDeliver(c context.Context, t Transport, clock Clock, b []byte, recipients []*url.URL) *DeliveryFuture {
+	return p.start(c, recipients, func(to *url.URL) DeliveryResult {
+		return timedDelivery(c, clock, b, to, t.Deliver)
+	})
+}
+
+// start starts calling deliver for each recipient on the pool.
+func (p *DeliveryPool) start(c context.Context, recipients []*url.URL, deliver func(to *url.URL) DeliveryResult) *DeliveryFuture {
+	f := &DeliveryFuture{results: make([]DeliveryResult, len(recipients))}
+	f.done = p.each(c, len(recipients), func(i int) {
+		f.results[i] = deliver(recipients[i])
+	}, func(i int, err error) {
+		f.results[i] = DeliveryResult{Recipient: recipients[i], Err: err}
+	})
+	return f
+}
+
+// DeliveryFuture is the completion of an activity's deliveries scheduled on a
+// DeliveryPool.
+type DeliveryFuture struct {
+	done    <-chan struct{}
+	results []DeliveryResult
+}
+
+// Done returns a channel closed once every delivery completed.
+func (f *DeliveryFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait waits for every delivery to complete and returns their results, in the
+// order of the recipients. Returns the context's error if it is done first;
+// the deliveries continue regardless.
+func (f *DeliveryFuture) Wait(c context.Context) ([]DeliveryResult, error) {
+	select {
+	case <-f.done:
+		return f.results, nil
+	case <-c.Done():
+		return nil, c.Err()
+	}
+}
+
+// Err waits for every delivery to complete, and returns a BatchDeliveryError
+// if any of them failed.
+func (f *DeliveryFuture) Err() error {
+	<-f.done
+	return batchError(f.results)
+}
+
+// WithDeliveryPool returns a copy of the context carrying the DeliveryPool.
+func WithDeliveryPool(c context.Context, p *DeliveryPool) context.Context {
+	return context.WithValue(c, deliveryPoolContextKey{}, p)
+}
+
+// DeliveryPoolFromContext returns the DeliveryPool carried by the context, or
+// nil if there is none.
+func DeliveryPoolFromContext(c context.Context) *DeliveryPool {
+	p, _ := c.Value(deliveryPoolContextKey{}).(*DeliveryPool)
+	return p
+}
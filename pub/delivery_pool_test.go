@@ -0,0 +1,124 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDeliveryPool(t *testing.T) {
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	recipient2 := mustParse(testFederatedActorIRI2)
+	t.Run("BoundsConcurrentDeliveries", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p := NewDeliveryPool(2)
+		defer p.Close()
+		ctx := WithDeliveryPool(context.Background(), p)
+		var recipients []*url.URL
+		for i := 0; i < 8; i++ {
+			recipients = append(recipients, recipient)
+		}
+		var mu sync.Mutex
+		inFlight, maxInFlight := 0, 0
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Deliver(ctx, payload, recipient).DoAndReturn(func(c context.Context, b []byte, to *url.URL) error {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		}).Times(len(recipients))
+		// Run
+		results := BatchDeliverResults(ctx, tp, nil, payload, recipients)
+		// Verify
+		assertEqual(t, len(results), len(recipients))
+		for _, r := range results {
+			assertEqual(t, r.Err, nil)
+		}
+		assertEqual(t, maxInFlight <= 2, true)
+	})
+	t.Run("ReturnsFutureOfActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		ctx := context.Background()
+		testErr := errors.New("test error")
+		p := NewDeliveryPool(4)
+		defer p.Close()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+		tp.EXPECT().Deliver(ctx, payload, recipient2).Return(testErr)
+		// Run
+		f := p.BatchDeliver(ctx, tp, nil, payload, []*url.URL{recipient, recipient2})
+		results, err := f.Wait(ctx)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(results), 2)
+		assertEqual(t, results[0].Err, nil)
+		assertEqual(t, results[1].Err, testErr)
+		be, ok := f.Err().(*BatchDeliveryError)
+		assertEqual(t, ok, true)
+		assertEqual(t, len(be.Failed()), 1)
+		assertEqual(t, be.Failed()[0], recipient2)
+	})
+	t.Run("FailsOnceClosed", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		ctx := context.Background()
+		p := NewDeliveryPool(1)
+		p.Close()
+		tp := NewMockTransport(ctl)
+		// Run
+		f := p.BatchDeliver(ctx, tp, nil, payload, []*url.URL{recipient})
+		results, err := f.Wait(ctx)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, results[0].Recipient, recipient)
+		assertEqual(t, results[0].Err, ErrDeliveryPoolClosed)
+	})
+}
+
+func TestProcessDeliveriesWithDeliveryPool(t *testing.T) {
+	testErr := errors.New("test error")
+	payload := []byte("payload")
+	boxIRI := mustParse(testMyOutboxIRI)
+	recipient := mustParse(testFederatedActorIRI)
+	recipient2 := mustParse(testFederatedActorIRI2)
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	p := NewDeliveryPool(2)
+	defer p.Close()
+	ctx := WithDeliveryPool(context.Background(), p)
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	q := NewMemoryDeliveryQueue(cl)
+	tp := NewMockTransport(ctl)
+	qt := NewQueuedTransport(tp, q, boxIRI, cl)
+	err := qt.BatchDeliver(ctx, payload, []*url.URL{recipient, recipient2})
+	assertEqual(t, err, nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient2).Return(testErr)
+	// Run
+	n, err := ProcessDeliveries(ctx, q, 10, cl, func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+		return tp, nil
+	}, nil)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, n, 1)
+	assertEqual(t, q.Len(), 1)
+}
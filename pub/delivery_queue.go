@@ -0,0 +1,527 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDeliveryMaxAttempts is the default number of times a delivery
+	// is attempted before it is abandoned.
+	DefaultDeliveryMaxAttempts = 8
+	// DefaultDeliveryMinBackoff is the default amount of time waited after
+	// the first failed delivery attempt.
+	DefaultDeliveryMinBackoff = 30 * time.Second
+	// DefaultDeliveryMaxBackoff is the default upper bound on the amount
+	// of time waited between delivery attempts.
+	DefaultDeliveryMaxBackoff = 6 * time.Hour
+	// DefaultDeliveryLeaseDuration is the default amount of time a leased
+	// delivery is held before it is eligible to be leased again.
+	DefaultDeliveryLeaseDuration = 5 * time.Minute
+)
+
+// Delivery is a single federated message pending delivery to one recipient.
+type Delivery struct {
+	// Id uniquely identifies the delivery within its DeliveryQueue. It is
+	// set by the DeliveryQueue when enqueued.
+	Id string
+	// ActorBoxIRI is the inbox or outbox of the actor on whose behalf the
+	// delivery is made, and is used to create the Transport.
+	ActorBoxIRI *url.URL
+	// Recipient is the inbox IRI to deliver to.
+	Recipient *url.URL
+	// Payload is the serialized activity.
+	Payload []byte
+	// Enqueued is when the delivery was first added to the queue. It is set
+	// by the DeliveryQueue when enqueued.
+	Enqueued time.Time
+	// Attempts is the number of failed attempts made so far.
+	Attempts int
+	// NextAttempt is the earliest time the delivery may be attempted.
+	NextAttempt time.Time
+	// LastError is the error of the most recent failed attempt.
+	LastError string
+	// LastStatusCode is the HTTP status code of the most recent failed
+	// attempt, or zero if no response was received.
+	LastStatusCode int
+}
+
+// DeliveryFailure describes a delivery that permanently failed.
+type DeliveryFailure struct {
+	// Delivery is the delivery that was abandoned.
+	Delivery *Delivery
+	// StatusCode is the HTTP status code of the last attempt, or zero if
+	// no response was received.
+	StatusCode int
+	// Attempts is the number of attempts made.
+	Attempts int
+	// Duration is the time elapsed between enqueueing the delivery and it
+	// being abandoned.
+	Duration time.Duration
+	// Err is the error of the last attempt.
+	Err error
+}
+
+// DeliveryQueue durably holds federated deliveries so that transient failures
+// are retried instead of silently dropped.
+//
+// The library provides an in-memory implementation with
+// NewMemoryDeliveryQueue. Applications needing deliveries to survive restarts
+// may implement this interface with a persistent backend such as Redis or a
+// SQL database.
+//
+// Implementations must be safe for concurrent use.
+type DeliveryQueue interface {
+	// Enqueue adds a new delivery to the queue, assigning it an Id. It must
+	// be eligible for leasing no earlier than its NextAttempt.
+	Enqueue(c context.Context, d *Delivery) error
+	// Lease returns up to max deliveries that are ready to be attempted.
+	// A leased delivery must not be returned by another call to Lease
+	// until it is failed or its lease expires. A delivery whose
+	// destination host is backing off must not be leased.
+	Lease(c context.Context, max int) (leased []*Delivery, err error)
+	// Ack removes a successfully delivered delivery from the queue and
+	// clears any retry state of its destination host.
+	Ack(c context.Context, d *Delivery) error
+	// Fail records a failed attempt of a leased delivery. The delivery is
+	// scheduled for another attempt with a backoff, unless it has reached
+	// the maximum number of attempts or cannot succeed, in which case it
+	// is moved to the dead letters and retrying is false. A failure that
+	// cannot succeed, such as a 404 Not Found, must not back off other
+	// deliveries to the destination host.
+	Fail(c context.Context, d *Delivery, cause error) (retrying bool, err error)
+	// DeadLetters returns the deliveries that permanently failed.
+	DeadLetters(c context.Context) (dead []*Delivery, err error)
+	// Requeue moves the dead-lettered delivery with the given id back into
+	// the queue, resetting its attempts so that it is retried immediately.
+	Requeue(c context.Context, id string) error
+}
+
+// ExponentialBackoff determines how long to wait after the given number of
+// failed attempts. The wait doubles with each attempt, beginning at min and
+// never exceeding max.
+func ExponentialBackoff(min, max time.Duration, attempts int) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+	d := min
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= max || d <= 0 {
+			return max
+		}
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// DestinationState is the retry state of a single destination host.
+type DestinationState struct {
+	// Failures is the number of consecutive failed deliveries to the host.
+	Failures int
+	// RetryAfter is the earliest time any delivery to the host may be
+	// attempted.
+	RetryAfter time.Time
+}
+
+// MemoryDeliveryQueue is an in-memory DeliveryQueue. Its contents are lost
+// when the process exits.
+type MemoryDeliveryQueue struct {
+	// MaxAttempts is the number of attempts before a delivery is
+	// abandoned.
+	MaxAttempts int
+	// MinBackoff is the wait after the first failed attempt.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound on the wait between attempts.
+	MaxBackoff time.Duration
+	// LeaseDuration is how long a leased delivery is held before it may be
+	// leased again.
+	LeaseDuration time.Duration
+	// Policy, if set, decides whether and when failed deliveries are
+	// retried, instead of MaxAttempts, MinBackoff, and MaxBackoff.
+	Policy RetryPolicy
+
+	clock      Clock
+	mu         sync.Mutex
+	nextId     uint64
+	deliveries map[string]*memoryDelivery
+	dead       map[string]*Delivery
+	hosts      map[string]*DestinationState
+}
+
+// memoryDelivery is a delivery held by the MemoryDeliveryQueue.
+type memoryDelivery struct {
+	d            *Delivery
+	leaseExpires time.Time
+}
+
+// MemoryDeliveryQueue must satisfy the DeliveryQueue interface.
+var _ DeliveryQueue = &MemoryDeliveryQueue{}
+
+// NewMemoryDeliveryQueue creates a new in-memory DeliveryQueue using the
+// default attempts, backoff, and lease duration.
+func NewMemoryDeliveryQueue(clock Clock) *MemoryDeliveryQueue {
+	return &MemoryDeliveryQueue{
+		MaxAttempts:   DefaultDeliveryMaxAttempts,
+		MinBackoff:    DefaultDeliveryMinBackoff,
+		MaxBackoff:    DefaultDeliveryMaxBackoff,
+		LeaseDuration: DefaultDeliveryLeaseDuration,
+		clock:         clock,
+		deliveries:    make(map[string]*memoryDelivery),
+		dead:          make(map[string]*Delivery),
+		hosts:         make(map[string]*DestinationState),
+	}
+}
+
+// Enqueue adds a new delivery to the queue.
+func (m *MemoryDeliveryQueue) Enqueue(c context.Context, d *Delivery) error {
+	if d.Recipient == nil {
+		return fmt.Errorf("cannot enqueue delivery without a recipient")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextId++
+	d.Id = strconv.FormatUint(m.nextId, 10)
+	d.Enqueued = m.clock.Now()
+	m.deliveries[d.Id] = &memoryDelivery{d: d}
+	return nil
+}
+
+// Lease returns up to max deliveries ready to be attempted, oldest first.
+func (m *MemoryDeliveryQueue) Lease(c context.Context, max int) (leased []*Delivery, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.clock.Now()
+	var ready []*memoryDelivery
+	for _, md := range m.deliveries {
+		if md.d.NextAttempt.After(now) || md.leaseExpires.After(now) {
+			continue
+		} else if h, ok := m.hosts[md.d.Recipient.Host]; ok && h.RetryAfter.After(now) {
+			continue
+		}
+		ready = append(ready, md)
+	}
+	sort.Slice(ready, func(i, j int) bool {
+		if !ready[i].d.NextAttempt.Equal(ready[j].d.NextAttempt) {
+			return ready[i].d.NextAttempt.Before(ready[j].d.NextAttempt)
+		}
+		return ready[i].d.Id < ready[j].d.Id
+	})
+	for _, md := range ready {
+		if len(leased) >= max {
+			break
+		}
+		md.leaseExpires = now.Add(m.LeaseDuration)
+		leased = append(leased, md.d)
+	}
+	return
+}
+
+// Ack removes a delivered delivery and resets its destination's retry state.
+func (m *MemoryDeliveryQueue) Ack(c context.Context, d *Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.deliveries[d.Id]; !ok {
+		return fmt.Errorf("no delivery with id %q", d.Id)
+	}
+	delete(m.deliveries, d.Id)
+	delete(m.hosts, d.Recipient.Host)
+	return nil
+}
+
+// Fail records a failed attempt, rescheduling or abandoning the delivery.
+func (m *MemoryDeliveryQueue) Fail(c context.Context, d *Delivery, cause error) (retrying bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	md, ok := m.deliveries[d.Id]
+	if !ok {
+		err = fmt.Errorf("no delivery with id %q", d.Id)
+		return
+	}
+	now := m.clock.Now()
+	se, isStatusErr := asHttpStatusError(cause)
+	// A peer refusing the delivery is responding normally, so only the
+	// delivery is abandoned and other deliveries to it are not held back.
+	permanent := permanentDeliveryFailure(cause)
+	if !permanent {
+		h, ok := m.hosts[d.Recipient.Host]
+		if !ok {
+			h = &DestinationState{}
+			m.hosts[d.Recipient.Host] = h
+		}
+		h.Failures++
+		backoffUntil := now.Add(ExponentialBackoff(m.MinBackoff, m.MaxBackoff, h.Failures))
+		// A wait asked for by the peer holds back every delivery to
+		// it, and is not cut short by later failures.
+		if isStatusErr && now.Add(se.RetryAfter).After(backoffUntil) {
+			backoffUntil = now.Add(se.RetryAfter)
+		}
+		if backoffUntil.After(h.RetryAfter) {
+			h.RetryAfter = backoffUntil
+		}
+	}
+	md.d.Attempts++
+	md.d.LastStatusCode = 0
+	md.d.LastError = ""
+	if cause != nil {
+		md.d.LastError = cause.Error()
+		if isStatusErr {
+			md.d.LastStatusCode = se.StatusCode
+		}
+	}
+	wait, retry := ExponentialBackoff(m.MinBackoff, m.MaxBackoff, md.d.Attempts), md.d.Attempts < m.MaxAttempts
+	if m.Policy != nil {
+		wait, retry = m.Policy.Retry(d.Recipient.Host, md.d.Attempts, cause)
+	} else if isStatusErr && se.RetryAfter > wait {
+		wait = se.RetryAfter
+	}
+	if permanent || !retry {
+		delete(m.deliveries, d.Id)
+		m.dead[d.Id] = md.d
+		return
+	}
+	md.d.NextAttempt = now.Add(wait)
+	md.leaseExpires = time.Time{}
+	retrying = true
+	return
+}
+
+// permanentDeliveryFailure determines whether a delivery which failed with the
+// error cannot succeed if attempted again: the recipient does not exist, or the
+// peer refused it with a client error status that is not retryable.
+func permanentDeliveryFailure(err error) bool {
+	if IsErr(err, ErrNotFound) {
+		return true
+	}
+	se, ok := asHttpStatusError(err)
+	if !ok || se.StatusCode < http.StatusBadRequest || se.StatusCode >= http.StatusInternalServerError {
+		return false
+	}
+	for _, s := range DefaultRetryableStatuses {
+		if se.StatusCode == s {
+			return false
+		}
+	}
+	return true
+}
+
+// DeadLetters returns the deliveries that permanently failed, oldest first.
+func (m *MemoryDeliveryQueue) DeadLetters(c context.Context) (dead []*Delivery, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dead = make([]*Delivery, 0, len(m.dead))
+	for _, d := range m.dead {
+		dead = append(dead, d)
+	}
+	sort.Slice(dead, func(i, j int) bool {
+		if !dead[i].Enqueued.Equal(dead[j].Enqueued) {
+			return dead[i].Enqueued.Before(dead[j].Enqueued)
+		}
+		return dead[i].Id < dead[j].Id
+	})
+	return
+}
+
+// Requeue moves a dead-lettered delivery back into the queue.
+func (m *MemoryDeliveryQueue) Requeue(c context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, ok := m.dead[id]
+	if !ok {
+		return fmt.Errorf("no dead-lettered delivery with id %q", id)
+	}
+	delete(m.dead, id)
+	d.Attempts = 0
+	d.NextAttempt = m.clock.Now()
+	m.deliveries[id] = &memoryDelivery{d: d}
+	return nil
+}
+
+// DestinationState returns the retry state of the destination host, and false
+// if deliveries to the host are not failing.
+func (m *MemoryDeliveryQueue) DestinationState(host string) (DestinationState, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if h, ok := m.hosts[host]; ok {
+		return *h, true
+	}
+	return DestinationState{}, false
+}
+
+// Len returns the number of deliveries in the queue, including leased ones.
+func (m *MemoryDeliveryQueue) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.deliveries)
+}
+
+// QueuedTransport is a Transport that enqueues deliveries onto a DeliveryQueue
+// instead of sending them immediately. Dereferencing is done by the wrapped
+// Transport.
+//
+// An application may return a QueuedTransport from NewTransport in its
+// CommonBehavior to have all federated deliveries retried. The queued
+// deliveries must then be sent by periodically calling ProcessDeliveries.
+type QueuedTransport struct {
+	Transport
+	queue       DeliveryQueue
+	actorBoxIRI *url.URL
+	clock       Clock
+}
+
+// QueuedTransport must satisfy the Transport interface.
+var _ Transport = &QueuedTransport{}
+
+// NewQueuedTransport wraps a Transport for the actor's inbox or outbox so that
+// its deliveries are enqueued onto the DeliveryQueue.
+func NewQueuedTransport(t Transport, queue DeliveryQueue, actorBoxIRI *url.URL, clock Clock) *QueuedTransport {
+	return &QueuedTransport{
+		Transport:   t,
+		queue:       queue,
+		actorBoxIRI: actorBoxIRI,
+		clock:       clock,
+	}
+}
+
+// Deliver enqueues the payload for delivery to the recipient.
+func (q *QueuedTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	return q.queue.Enqueue(c, &Delivery{
+		ActorBoxIRI: q.actorBoxIRI,
+		Recipient:   to,
+		Payload:     b,
+		NextAttempt: q.clock.Now(),
+	})
+}
+
+// BatchDeliver enqueues the payload for delivery to each recipient.
+func (q *QueuedTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	for _, r := range recipients {
+		if err := q.Deliver(c, b, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lener is a DeliveryQueue able to report its depth.
+type lener interface {
+	Len() int
+}
+
+// ProcessDeliveries leases up to max deliveries from the queue and attempts
+// them, acknowledging successes and failing the rest so they are retried.
+//
+// The newTransport function is called once per delivery with its ActorBoxIRI.
+// It must return a Transport that sends immediately, and not a
+// QueuedTransport.
+//
+// The onFailure function is called for every delivery that permanently fails
+// and is moved to the dead letters. It may be nil.
+//
+// The deliveries are attempted concurrently on the DeliveryPool carried by the
+// context, if any, and otherwise one after another.
+//
+// If the queue has a Len method, as MemoryDeliveryQueue does, its depth is
+// reported to the Metrics carried by the context after the deliveries are
+// attempted.
+//
+// Returns the number of successful deliveries. An error is only returned if
+// the queue itself fails; delivery errors are recorded in the queue.
+func ProcessDeliveries(c context.Context, queue DeliveryQueue, max int, clock Clock, newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error), onFailure func(c context.Context, f DeliveryFailure)) (delivered int, err error) {
+	var leased []*Delivery
+	leased, err = queue.Lease(c, max)
+	if err != nil {
+		return
+	}
+	if l, ok := queue.(lener); ok {
+		defer func() {
+			MetricsFromContext(c).QueueDepth(l.Len())
+		}()
+	}
+	if p := DeliveryPoolFromContext(c); p != nil {
+		return processConcurrently(c, p, queue, leased, clock, newTransport, onFailure)
+	}
+	for _, d := range leased {
+		var ok bool
+		if ok, err = processDelivery(c, queue, d, clock, newTransport, onFailure); err != nil {
+			return
+		} else if ok {
+			delivered++
+		}
+	}
+	return
+}
+
+// processConcurrently attempts the leased deliveries on the DeliveryPool,
+// returning the number of successful ones and the first error of the queue.
+// Deliveries that could not be scheduled remain leased, and are attempted
+// again once their lease expires.
+func processConcurrently(c context.Context, p *DeliveryPool, queue DeliveryQueue, leased []*Delivery, clock Clock, newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error), onFailure func(c context.Context, f DeliveryFailure)) (delivered int, err error) {
+	var mu sync.Mutex
+	<-p.each(c, len(leased), func(i int) {
+		ok, qErr := processDelivery(c, queue, leased[i], clock, newTransport, onFailure)
+		mu.Lock()
+		defer mu.Unlock()
+		if ok {
+			delivered++
+		} else if qErr != nil && err == nil {
+			err = qErr
+		}
+	}, func(i int, cause error) {
+		logEntry(c, LogLevelWarn, "queued delivery not attempted",
+			remoteHostLogField(leased[i].Recipient),
+			LogField{Key: "inbox", Value: leased[i].Recipient},
+			errorLogField(cause))
+	})
+	return
+}
+
+// processDelivery attempts a leased delivery, and acknowledges it or records
+// its failure in the queue. Returns true if it succeeded, and an error only if
+// the queue itself fails.
+func processDelivery(c context.Context, queue DeliveryQueue, d *Delivery, clock Clock, newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error), onFailure func(c context.Context, f DeliveryFailure)) (bool, error) {
+	t, err := newTransport(c, d.ActorBoxIRI, goFedUserAgent())
+	if err == nil {
+		err = t.Deliver(c, d.Payload, d.Recipient)
+	}
+	if err != nil {
+		cause := err
+		retrying, err := queue.Fail(c, d, cause)
+		if err != nil {
+			return false, err
+		}
+		fields := []LogField{
+			remoteHostLogField(d.Recipient),
+			{Key: "inbox", Value: d.Recipient},
+			{Key: "attempts", Value: d.Attempts},
+			errorLogField(cause),
+		}
+		if retrying {
+			logEntry(c, LogLevelWarn, "queued delivery failed, will retry", fields...)
+			return false, nil
+		}
+		logEntry(c, LogLevelError, "queued delivery permanently failed", fields...)
+		if onFailure != nil {
+			onFailure(c, DeliveryFailure{
+				Delivery:   d,
+				StatusCode: d.LastStatusCode,
+				Attempts:   d.Attempts,
+				Duration:   clock.Now().Sub(d.Enqueued),
+				Err:        cause,
+			})
+		}
+		return false, nil
+	}
+	if err = queue.Ack(c, d); err != nil {
+		return false, err
+	}
+	return true, nil
+}
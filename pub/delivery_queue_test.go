@@ -0,0 +1,196 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	assertEqual(t, ExponentialBackoff(time.Second, time.Minute, 0), time.Duration(0))
+	assertEqual(t, ExponentialBackoff(time.Second, time.Minute, 1), time.Second)
+	assertEqual(t, ExponentialBackoff(time.Second, time.Minute, 3), 4*time.Second)
+	assertEqual(t, ExponentialBackoff(time.Second, time.Minute, 7), time.Minute)
+	assertEqual(t, ExponentialBackoff(time.Second, time.Minute, 100), time.Minute)
+}
+
+func TestMemoryDeliveryQueue(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	recipient := mustParse(testFederatedActorIRI)
+	setupFn := func(ctl *gomock.Controller) (cl *MockClock, q *MemoryDeliveryQueue) {
+		cl = NewMockClock(ctl)
+		q = NewMemoryDeliveryQueue(cl)
+		q.MaxAttempts = 2
+		q.MinBackoff = time.Minute
+		q.MaxBackoff = time.Hour
+		return
+	}
+	t.Run("LeasesAndAcks", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, q := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		err := q.Enqueue(ctx, &Delivery{Recipient: recipient, NextAttempt: now()})
+		assertEqual(t, err, nil)
+		// Run
+		l1, err := q.Lease(ctx, 10)
+		assertEqual(t, err, nil)
+		l2, _ := q.Lease(ctx, 10)
+		err = q.Ack(ctx, l1[0])
+		// Verify
+		assertEqual(t, len(l1), 1)
+		assertEqual(t, len(l2), 0)
+		assertEqual(t, err, nil)
+		assertEqual(t, q.Len(), 0)
+	})
+	t.Run("FailBacksOffDestination", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, q := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).Times(3)
+		cl.EXPECT().Now().Return(now().Add(30 * time.Second))
+		cl.EXPECT().Now().Return(now().Add(time.Minute))
+		q.Enqueue(ctx, &Delivery{Recipient: recipient, NextAttempt: now()})
+		l, _ := q.Lease(ctx, 1)
+		// Run
+		retrying, err := q.Fail(ctx, l[0], testErr)
+		early, _ := q.Lease(ctx, 1)
+		later, _ := q.Lease(ctx, 1)
+		// Verify
+		assertEqual(t, retrying, true)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(early), 0)
+		assertEqual(t, len(later), 1)
+		assertEqual(t, later[0].Attempts, 1)
+		assertEqual(t, later[0].LastError, testErr.Error())
+		state, ok := q.DestinationState(recipient.Host)
+		assertEqual(t, ok, true)
+		assertEqual(t, state.Failures, 1)
+	})
+	t.Run("FailAbandonsAfterMaxAttempts", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, q := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		d := &Delivery{Recipient: recipient, NextAttempt: now()}
+		q.Enqueue(ctx, d)
+		// Run
+		r1, _ := q.Fail(ctx, d, testErr)
+		r2, _ := q.Fail(ctx, d, testErr)
+		// Verify
+		assertEqual(t, r1, true)
+		assertEqual(t, r2, false)
+		assertEqual(t, q.Len(), 0)
+	})
+	t.Run("FailAbandonsRefusedWithoutBackingOff", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, q := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		gone := &Delivery{Recipient: recipient, NextAttempt: now()}
+		forbidden := &Delivery{Recipient: recipient, NextAttempt: now()}
+		q.Enqueue(ctx, gone)
+		q.Enqueue(ctx, forbidden)
+		// Run
+		r1, err1 := q.Fail(ctx, gone, &HttpStatusError{IRI: recipient, StatusCode: http.StatusGone})
+		r2, err2 := q.Fail(ctx, forbidden, &HttpStatusError{IRI: recipient, StatusCode: http.StatusForbidden})
+		// Verify
+		assertEqual(t, err1, nil)
+		assertEqual(t, err2, nil)
+		assertEqual(t, r1, false)
+		assertEqual(t, r2, false)
+		assertEqual(t, q.Len(), 0)
+		_, ok := q.DestinationState(recipient.Host)
+		assertEqual(t, ok, false)
+		dead, _ := q.DeadLetters(ctx)
+		assertEqual(t, len(dead), 2)
+		assertEqual(t, dead[1].LastStatusCode, http.StatusForbidden)
+	})
+}
+
+func TestProcessDeliveries(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	payload := []byte("payload")
+	boxIRI := mustParse(testMyOutboxIRI)
+	recipient := mustParse(testFederatedActorIRI)
+	recipient2 := mustParse(testFederatedActorIRI2)
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	q := NewMemoryDeliveryQueue(cl)
+	tp := NewMockTransport(ctl)
+	qt := NewQueuedTransport(tp, q, boxIRI, cl)
+	err := qt.BatchDeliver(ctx, payload, []*url.URL{recipient, recipient2})
+	assertEqual(t, err, nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient2).Return(testErr)
+	// Run
+	n, err := ProcessDeliveries(ctx, q, 10, cl, func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+		assertEqual(t, actorBoxIRI, boxIRI)
+		return tp, nil
+	}, nil)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, n, 1)
+	assertEqual(t, q.Len(), 1)
+}
+
+func TestProcessDeliveriesDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	statusErr := &HttpStatusError{
+		Method:     "POST",
+		IRI:        recipient,
+		StatusCode: 410,
+		Status:     "410 Gone",
+	}
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).Times(3)
+	cl.EXPECT().Now().Return(now().Add(time.Minute)).AnyTimes()
+	q := NewMemoryDeliveryQueue(cl)
+	q.MaxAttempts = 1
+	tp := NewMockTransport(ctl)
+	q.Enqueue(ctx, &Delivery{Recipient: recipient, Payload: payload, NextAttempt: now()})
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(statusErr)
+	var failures []DeliveryFailure
+	// Run
+	n, err := ProcessDeliveries(ctx, q, 10, cl, func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+		return tp, nil
+	}, func(c context.Context, f DeliveryFailure) {
+		failures = append(failures, f)
+	})
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, n, 0)
+	assertEqual(t, q.Len(), 0)
+	assertEqual(t, len(failures), 1)
+	assertEqual(t, failures[0].StatusCode, 410)
+	assertEqual(t, failures[0].Attempts, 1)
+	assertEqual(t, failures[0].Duration, time.Minute)
+	assertEqual(t, failures[0].Err, error(statusErr))
+	dead, err := q.DeadLetters(ctx)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(dead), 1)
+	// Requeue
+	err = q.Requeue(ctx, dead[0].Id)
+	assertEqual(t, err, nil)
+	dead, _ = q.DeadLetters(ctx)
+	assertEqual(t, len(dead), 0)
+	assertEqual(t, q.Len(), 1)
+}
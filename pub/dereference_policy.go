@@ -0,0 +1,128 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DereferencePolicy controls when IRIs found in received activities are
+// dereferenced by the federating handlers, such as when following the
+// 'inReplyTo', 'object', 'target', and 'tag' chains to determine whether
+// inbox forwarding applies, or when fetching the object of a Create that is
+// only given by IRI.
+type DereferencePolicy interface {
+	// MaxDepth determines how deeply values nested within an activity are
+	// examined, whether embedded or dereferenced.
+	//
+	// Zero or negative numbers indicate infinite recursion.
+	MaxDepth(c context.Context) int
+	// ShouldDereference determines whether the IRI may be dereferenced.
+	// If not, it is treated as a value that could not be obtained.
+	ShouldDereference(c context.Context, iri *url.URL) bool
+	// Dereference obtains the IRI using the Transport. Implementations
+	// may return a copy cached from a previous call instead.
+	Dereference(c context.Context, t Transport, iri *url.URL) ([]byte, error)
+}
+
+// HostDereferencePolicy is a DereferencePolicy that limits depth, allows or
+// denies dereferencing by host, and optionally caches the fetched values in
+// memory.
+//
+// It must be created with NewHostDereferencePolicy.
+type HostDereferencePolicy struct {
+	// Depth is the maximum depth. Zero or a negative number indicates
+	// infinite recursion.
+	Depth int
+	// AllowedHosts, if not empty, lists the only hosts whose IRIs may be
+	// dereferenced.
+	AllowedHosts []string
+	// DeniedHosts lists hosts whose IRIs are never dereferenced. It takes
+	// precedence over AllowedHosts.
+	DeniedHosts []string
+	// CacheDuration is how long fetched values are cached. Zero or a
+	// negative number disables caching.
+	CacheDuration time.Duration
+	clock         Clock
+	mu            sync.Mutex
+	cache         map[string]cachedDereference
+}
+
+// cachedDereference is a value previously fetched by a HostDereferencePolicy.
+type cachedDereference struct {
+	b       []byte
+	expires time.Time
+}
+
+// HostDereferencePolicy must satisfy the DereferencePolicy interface.
+var _ DereferencePolicy = &HostDereferencePolicy{}
+
+// NewHostDereferencePolicy creates a HostDereferencePolicy with the maximum
+// depth, permitting every host and not caching.
+func NewHostDereferencePolicy(clock Clock, depth int) *HostDereferencePolicy {
+	return &HostDereferencePolicy{
+		Depth: depth,
+		clock: clock,
+		cache: make(map[string]cachedDereference),
+	}
+}
+
+// MaxDepth returns the maximum depth.
+func (h *HostDereferencePolicy) MaxDepth(c context.Context) int {
+	return h.Depth
+}
+
+// ShouldDereference permits IRIs whose host is allowed and not denied.
+func (h *HostDereferencePolicy) ShouldDereference(c context.Context, iri *url.URL) bool {
+	for _, host := range h.DeniedHosts {
+		if iri.Host == host {
+			return false
+		}
+	}
+	if len(h.AllowedHosts) == 0 {
+		return true
+	}
+	for _, host := range h.AllowedHosts {
+		if iri.Host == host {
+			return true
+		}
+	}
+	return false
+}
+
+// Dereference fetches the IRI, returning the cached value instead if one has
+// not yet expired.
+func (h *HostDereferencePolicy) Dereference(c context.Context, t Transport, iri *url.URL) ([]byte, error) {
+	if h.CacheDuration <= 0 {
+		return t.Dereference(c, iri)
+	}
+	key := iri.String()
+	h.mu.Lock()
+	cached, ok := h.cache[key]
+	now := h.clock.Now()
+	h.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.b, nil
+	}
+	b, err := t.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.cache == nil {
+		h.cache = make(map[string]cachedDereference)
+	}
+	// Drop expired entries so the cache does not grow without bound.
+	for k, v := range h.cache {
+		if !now.Before(v.expires) {
+			delete(h.cache, k)
+		}
+	}
+	h.cache[key] = cachedDereference{
+		b:       b,
+		expires: now.Add(h.CacheDuration),
+	}
+	return b, nil
+}
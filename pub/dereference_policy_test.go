@@ -0,0 +1,48 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestHostDereferencePolicy(t *testing.T) {
+	ctx := context.Background()
+	iri := mustParse(testFederatedActorIRI)
+	t.Run("AllowsAndDeniesHosts", func(t *testing.T) {
+		// Setup
+		p := NewHostDereferencePolicy(nil, 0)
+		p.AllowedHosts = []string{iri.Host, "example.com"}
+		p.DeniedHosts = []string{"example.com"}
+		// Verify
+		assertEqual(t, p.ShouldDereference(ctx, iri), true)
+		assertEqual(t, p.ShouldDereference(ctx, mustParse(testNoteId1)), false)
+		assertEqual(t, p.ShouldDereference(ctx, mustParse(testPersonIRI)), false)
+	})
+	t.Run("CachesUntilExpired", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		current := now()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().DoAndReturn(func() time.Time { return current }).AnyTimes()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Dereference(ctx, iri).Return([]byte("first"), nil)
+		tp.EXPECT().Dereference(ctx, iri).Return([]byte("second"), nil)
+		p := NewHostDereferencePolicy(cl, 0)
+		p.CacheDuration = time.Minute
+		// Run
+		b1, err1 := p.Dereference(ctx, tp, iri)
+		b2, err2 := p.Dereference(ctx, tp, iri)
+		current = current.Add(time.Minute)
+		b3, err3 := p.Dereference(ctx, tp, iri)
+		// Verify
+		assertEqual(t, err1, nil)
+		assertEqual(t, err2, nil)
+		assertEqual(t, err3, nil)
+		assertEqual(t, string(b1), "first")
+		assertEqual(t, string(b2), "first")
+		assertEqual(t, string(b3), "second")
+	})
+}
@@ -0,0 +1,189 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// socks5Version is the version of the SOCKS protocol spoken.
+	socks5Version = 5
+	// socks5NoAuth and socks5UserPass are the authentication methods
+	// offered to a SOCKS5 proxy.
+	socks5NoAuth   = 0
+	socks5UserPass = 2
+	// socks5NoAcceptable is the method chosen by a proxy refusing every
+	// offered method.
+	socks5NoAcceptable = 0xff
+	// socks5Connect is the command opening a TCP stream.
+	socks5Connect = 1
+	// socks5IPv4, socks5Domain, and socks5IPv6 are the types of addresses.
+	socks5IPv4   = 1
+	socks5Domain = 3
+	socks5IPv6   = 4
+)
+
+// DialFunc dials a connection to the address on the named network, as the
+// DialContext method of a net.Dialer does.
+type DialFunc func(c context.Context, network, address string) (net.Conn, error)
+
+// DialRoute dials the connections to the hosts matching its pattern with its
+// own DialFunc, such as one through a proxy.
+type DialRoute struct {
+	// Pattern matches the hosts that are routed, as do the patterns of a
+	// DomainPolicy, so "onion" matches every onion service.
+	Pattern string
+	// Dial dials the connections to the matching hosts.
+	Dial DialFunc
+}
+
+// NewRoutingDialer returns a DialFunc dialing each connection with the first
+// of the routes whose pattern matches its host, and with the fallback if none
+// does. It is used as the DialContext of an http.Transport, or in the Routes
+// of a RequestGuard.
+//
+// Connections to onion services that no route matches are refused with an
+// ErrRequestRefused, rather than resolving their names over DNS and leaking
+// them.
+func NewRoutingDialer(routes []DialRoute, fallback DialFunc) DialFunc {
+	return func(c context.Context, network, address string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		host = strings.ToLower(strings.TrimSuffix(host, "."))
+		for _, r := range routes {
+			if matchesDomain(r.Pattern, host) {
+				return r.Dial(c, network, address)
+			}
+		}
+		if strings.HasSuffix(host, ".onion") {
+			return nil, wrapErr(ErrRequestRefused, "no route to onion service %s", host)
+		}
+		return fallback(c, network, address)
+	}
+}
+
+// NewSOCKS5Dialer returns a DialFunc connecting through the SOCKS5 proxy at
+// the address, such as "127.0.0.1:9050" for Tor, which is dialed with forward.
+// Host names are resolved by the proxy, as onion services require.
+//
+// If the username is not empty, the proxy may authenticate with it and the
+// password, which Tor uses to isolate streams from one another.
+func NewSOCKS5Dialer(proxyAddress, username, password string, forward DialFunc) DialFunc {
+	return func(c context.Context, network, address string) (net.Conn, error) {
+		if network != "tcp" && network != "tcp4" && network != "tcp6" {
+			return nil, fmt.Errorf("cannot dial network %q through a SOCKS5 proxy", network)
+		}
+		host, portStr, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		port, err := strconv.ParseUint(portStr, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port in %q: %s", address, err)
+		}
+		conn, err := forward(c, "tcp", proxyAddress)
+		if err != nil {
+			return nil, err
+		}
+		if deadline, ok := c.Deadline(); ok {
+			conn.SetDeadline(deadline)
+			defer conn.SetDeadline(time.Time{})
+		}
+		if err = socks5Handshake(conn, host, uint16(port), username, password); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("SOCKS5 proxy %s failed to connect to %s: %s", proxyAddress, address, err)
+		}
+		return conn, nil
+	}
+}
+
+// socks5Handshake authenticates with the SOCKS5 proxy over the connection, and
+// asks it to connect to the host and port.
+func socks5Handshake(conn io.ReadWriter, host string, port uint16, username, password string) error {
+	methods := []byte{socks5NoAuth}
+	if len(username) > 0 {
+		methods = append(methods, socks5UserPass)
+	}
+	if _, err := conn.Write(append([]byte{socks5Version, byte(len(methods))}, methods...)); err != nil {
+		return err
+	}
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return err
+	} else if reply[0] != socks5Version {
+		return fmt.Errorf("unexpected version %d", reply[0])
+	}
+	switch reply[1] {
+	case socks5NoAuth:
+	case socks5UserPass:
+		if len(username) == 0 {
+			return fmt.Errorf("proxy requires a username and password")
+		} else if len(username) > 255 || len(password) > 255 {
+			return fmt.Errorf("username or password longer than 255 bytes")
+		}
+		req := []byte{1, byte(len(username))}
+		req = append(req, username...)
+		req = append(req, byte(len(password)))
+		req = append(req, password...)
+		if _, err := conn.Write(req); err != nil {
+			return err
+		} else if _, err := io.ReadFull(conn, reply); err != nil {
+			return err
+		} else if reply[1] != 0 {
+			return fmt.Errorf("authentication failed")
+		}
+	case socks5NoAcceptable:
+		return fmt.Errorf("no acceptable authentication method")
+	default:
+		return fmt.Errorf("unsupported authentication method %d", reply[1])
+	}
+	req := []byte{socks5Version, socks5Connect, 0}
+	if ip := net.ParseIP(host); ip == nil {
+		if len(host) > 255 {
+			return fmt.Errorf("host name longer than 255 bytes")
+		}
+		req = append(req, socks5Domain, byte(len(host)))
+		req = append(req, host...)
+	} else if ip4 := ip.To4(); ip4 != nil {
+		req = append(req, socks5IPv4)
+		req = append(req, ip4...)
+	} else {
+		req = append(req, socks5IPv6)
+		req = append(req, ip.To16()...)
+	}
+	req = append(req, byte(port>>8), byte(port))
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return err
+	} else if header[1] != 0 {
+		return fmt.Errorf("connection refused by proxy with code %d", header[1])
+	}
+	// Discard the address the proxy bound, and its port.
+	var skip int
+	switch header[3] {
+	case socks5IPv4:
+		skip = net.IPv4len + 2
+	case socks5IPv6:
+		skip = net.IPv6len + 2
+	case socks5Domain:
+		var n [1]byte
+		if _, err := io.ReadFull(conn, n[:]); err != nil {
+			return err
+		}
+		skip = int(n[0]) + 2
+	default:
+		return fmt.Errorf("unsupported address type %d", header[3])
+	}
+	_, err := io.ReadFull(conn, make([]byte, skip))
+	return err
+}
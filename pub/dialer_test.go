@@ -0,0 +1,125 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+)
+
+// serveSOCKS5 answers the SOCKS5 handshake on the connection, requiring the
+// username and password if not empty, and sends the requested address back
+// once connected.
+func serveSOCKS5(conn net.Conn, username, password string) {
+	defer conn.Close()
+	buf := make([]byte, 2)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return
+	}
+	methods := make([]byte, buf[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return
+	}
+	if len(username) == 0 {
+		conn.Write([]byte{socks5Version, socks5NoAuth})
+	} else if !bytes.Contains(methods, []byte{socks5UserPass}) {
+		conn.Write([]byte{socks5Version, socks5NoAcceptable})
+		return
+	} else {
+		conn.Write([]byte{socks5Version, socks5UserPass})
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		u := make([]byte, buf[1])
+		io.ReadFull(conn, u)
+		io.ReadFull(conn, buf[:1])
+		p := make([]byte, buf[0])
+		io.ReadFull(conn, p)
+		if string(u) != username || string(p) != password {
+			conn.Write([]byte{1, 1})
+			return
+		}
+		conn.Write([]byte{1, 0})
+	}
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return
+	}
+	host := make([]byte, header[4]+2)
+	if _, err := io.ReadFull(conn, host); err != nil {
+		return
+	}
+	conn.Write([]byte{socks5Version, 0, 0, socks5IPv4, 127, 0, 0, 1, 0, 80})
+	conn.Write(host[:len(host)-2])
+}
+
+func TestSOCKS5Dialer(t *testing.T) {
+	ctx := context.Background()
+	onion := "duskgytldkxiuqc6.onion:80"
+	forwardTo := func(t *testing.T, username, password string) DialFunc {
+		return func(c context.Context, network, address string) (net.Conn, error) {
+			assertEqual(t, address, "127.0.0.1:9050")
+			client, server := net.Pipe()
+			go serveSOCKS5(server, username, password)
+			return client, nil
+		}
+	}
+	t.Run("ConnectsByHostName", func(t *testing.T) {
+		// Setup
+		d := NewSOCKS5Dialer("127.0.0.1:9050", "", "", forwardTo(t, "", ""))
+		// Run
+		conn, err := d(ctx, "tcp", onion)
+		// Verify
+		assertEqual(t, err, nil)
+		b := make([]byte, len("duskgytldkxiuqc6.onion"))
+		_, err = io.ReadFull(conn, b)
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), "duskgytldkxiuqc6.onion")
+		conn.Close()
+	})
+	t.Run("Authenticates", func(t *testing.T) {
+		// Setup
+		d := NewSOCKS5Dialer("127.0.0.1:9050", "isolated", "secret", forwardTo(t, "isolated", "secret"))
+		// Run
+		conn, err := d(ctx, "tcp", onion)
+		// Verify
+		assertEqual(t, err, nil)
+		conn.Close()
+	})
+	t.Run("FailsWithWrongPassword", func(t *testing.T) {
+		// Setup
+		d := NewSOCKS5Dialer("127.0.0.1:9050", "isolated", "wrong", forwardTo(t, "isolated", "secret"))
+		// Run
+		_, err := d(ctx, "tcp", onion)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestRoutingDialer(t *testing.T) {
+	ctx := context.Background()
+	var dialed []string
+	dialWith := func(name string) DialFunc {
+		return func(c context.Context, network, address string) (net.Conn, error) {
+			dialed = append(dialed, name+" "+address)
+			return nil, nil
+		}
+	}
+	// Setup
+	d := NewRoutingDialer([]DialRoute{
+		{Pattern: "example.onion", Dial: dialWith("isolated")},
+		{Pattern: "onion", Dial: dialWith("tor")},
+	}, dialWith("direct"))
+	// Run
+	d(ctx, "tcp", "social.example.onion:80")
+	d(ctx, "tcp", "duskgytldkxiuqc6.onion:80")
+	d(ctx, "tcp", "example.com:443")
+	// Verify
+	assertEqual(t, len(dialed), 3)
+	assertEqual(t, dialed[0], "isolated social.example.onion:80")
+	assertEqual(t, dialed[1], "tor duskgytldkxiuqc6.onion:80")
+	assertEqual(t, dialed[2], "direct example.com:443")
+	_, err := NewRoutingDialer(nil, dialWith("direct"))(ctx, "tcp", "duskgytldkxiuqc6.onion:80")
+	assertEqual(t, IsErr(err, ErrRequestRefused), true)
+}
@@ -0,0 +1,243 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// DigestMode is how a DigestPolicy checks the digests of request bodies.
+type DigestMode int
+
+const (
+	// DigestVerify checks the digests of requests having one.
+	DigestVerify DigestMode = iota
+	// DigestRequire checks the digests of requests, and refuses requests
+	// with a body but no digest.
+	DigestRequire
+	// DigestIgnore does not check digests, such as when a reverse proxy is
+	// known to alter request bodies.
+	DigestIgnore
+)
+
+// ErrBodyTooLarge indicates that the body of a request exceeded the limit of a
+// DigestPolicy.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// DigestMismatchError is returned when the digest of a request does not match
+// its body. It wraps ErrBadSignature.
+type DigestMismatchError struct {
+	// Header is the header carrying the digest, Digest or Content-Digest.
+	Header string
+	// Algorithm is the algorithm of the digest.
+	Algorithm DigestAlgorithm
+	// Received is the base64 encoded digest of the request.
+	Received string
+	// Computed is the base64 encoded digest of the body received.
+	Computed string
+	// BodyLength is the number of bytes of the body received.
+	BodyLength int
+}
+
+// Error describes the received and computed digests.
+func (e *DigestMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s header %s digest %s does not match %s computed over %d bytes",
+		ErrBadSignature, e.Header, e.Algorithm, e.Received, e.Computed, e.BodyLength)
+}
+
+// Unwrap returns ErrBadSignature.
+func (e *DigestMismatchError) Unwrap() error {
+	return ErrBadSignature
+}
+
+// AsDigestMismatch returns the DigestMismatchError that is or is wrapped by the
+// error, if any.
+func AsDigestMismatch(err error) (e *DigestMismatchError, ok bool) {
+	for err != nil {
+		if e, ok = err.(*DigestMismatchError); ok {
+			return
+		}
+		u, isWrapper := err.(interface{ Unwrap() error })
+		if !isWrapper {
+			return
+		}
+		err = u.Unwrap()
+	}
+	return
+}
+
+// DigestPolicy checks the Digest and Content-Digest headers of requests
+// against their bodies, so that a signed request cannot be replayed with a
+// different body.
+//
+// A digest only binds the body to the signature if the signature covers it.
+// Unless the Mode is DigestIgnore, signatures of requests with a body or a
+// digest are refused when verified if they do not cover a digest header.
+//
+// Digests are computed over the exact bytes of the body received, after any
+// chunked transfer coding is removed. Digests with unsupported algorithms are
+// ignored, but a request with a digest must have at least one supported.
+type DigestPolicy struct {
+	// Mode is how digests are checked.
+	Mode DigestMode
+	// OnMismatch decides whether a digest not matching the body is
+	// tolerated, such as for the requests of peers behind a load balancer
+	// rewriting their bodies. The request is refused with the returned
+	// error, or accepted if it is nil. Optional; if nil, every mismatch is
+	// refused.
+	OnMismatch func(c context.Context, r *http.Request, e *DigestMismatchError) error
+	// MaxBodyBytes is the size of the largest body read to check its
+	// digest, beyond which the request is refused with an ErrBodyTooLarge.
+	// Zero means DefaultMaxResponseBytes, as for a RequestGuard, and a
+	// negative number means no limit.
+	MaxBodyBytes int64
+}
+
+// receivedDigest is a digest of a request's body.
+type receivedDigest struct {
+	header string
+	algo   DigestAlgorithm
+	value  string
+}
+
+// Verify checks the digests of the request against its body. The body is read,
+// and replaced so it may be read again. As this happens before the signature
+// is verified, a body larger than MaxBodyBytes is refused without being read
+// in full.
+func (p DigestPolicy) Verify(c context.Context, r *http.Request) error {
+	if p.Mode == DigestIgnore {
+		return nil
+	}
+	hasDigest := len(r.Header.Get(digestHeader)) > 0 || len(r.Header.Get(contentDigestHeader)) > 0
+	if !hasDigest && p.Mode != DigestRequire {
+		return nil
+	}
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = p.readBody(r); err != nil {
+			return err
+		}
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	if !hasDigest {
+		if len(body) > 0 {
+			return wrapErr(ErrBadSignature, "no digest of the body")
+		}
+		return nil
+	}
+	digests := requestDigests(r.Header)
+	if len(digests) == 0 {
+		return wrapErr(ErrBadSignature, "unsupported digest %q", r.Header.Get(digestHeader)+r.Header.Get(contentDigestHeader))
+	}
+	for _, d := range digests {
+		computed, err := digest(d.algo, body)
+		if err != nil {
+			return err
+		}
+		computed = strings.TrimPrefix(computed, string(d.algo)+digestDelimiter)
+		if computed == d.value {
+			continue
+		}
+		e := &DigestMismatchError{
+			Header:     d.header,
+			Algorithm:  d.algo,
+			Received:   d.value,
+			Computed:   computed,
+			BodyLength: len(body),
+		}
+		if p.OnMismatch == nil {
+			return e
+		} else if err = p.OnMismatch(c, r, e); err != nil {
+			return err
+		}
+		logEntry(c, LogLevelInfo, "digest mismatch tolerated",
+			LogField{Key: LogKeyRemoteHost, Value: requestRemoteHost(r)},
+			errorLogField(e))
+	}
+	return nil
+}
+
+// readBody reads the body of the request up to the MaxBodyBytes.
+func (p DigestPolicy) readBody(r *http.Request) ([]byte, error) {
+	max := p.MaxBodyBytes
+	if max == 0 {
+		max = DefaultMaxResponseBytes
+	} else if max < 0 {
+		return ioutil.ReadAll(r.Body)
+	}
+	if r.ContentLength > max {
+		return nil, wrapErr(ErrBodyTooLarge, "declared %d bytes", r.ContentLength)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, max+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(body)) > max {
+		return nil, wrapErr(ErrBodyTooLarge, "more than %d bytes", max)
+	}
+	return body, nil
+}
+
+// checkDigestSigned returns an error wrapping ErrBadSignature if the request has
+// a body or a digest but its verified signature covers none of its digest
+// headers. Nothing is checked under DigestIgnore.
+func checkDigestSigned(r *http.Request, res *SignatureResult, mode DigestMode) error {
+	if mode == DigestIgnore {
+		return nil
+	}
+	var present []string
+	for _, h := range []string{digestHeader, contentDigestHeader} {
+		if len(r.Header.Get(h)) == 0 {
+			continue
+		} else if containsHeader(res.Headers, h) {
+			return nil
+		}
+		present = append(present, h)
+	}
+	if len(present) > 0 {
+		return wrapErr(ErrBadSignature, "signature does not cover the %s header", strings.Join(present, " or "))
+	} else if r.Body != nil && r.Body != http.NoBody && r.ContentLength != 0 {
+		return wrapErr(ErrBadSignature, "signature does not cover a digest of the body")
+	}
+	return nil
+}
+
+// requestDigests returns the digests with supported algorithms in the Digest
+// header of RFC 3230 and the Content-Digest header of RFC 9530.
+func requestDigests(h http.Header) []receivedDigest {
+	var digests []receivedDigest
+	for _, v := range h[http.CanonicalHeaderKey(digestHeader)] {
+		for _, d := range strings.Split(v, ",") {
+			kv := strings.SplitN(strings.TrimSpace(d), digestDelimiter, 2)
+			if len(kv) != 2 {
+				continue
+			}
+			if algo, ok := supportedDigest(kv[0]); ok {
+				digests = append(digests, receivedDigest{header: digestHeader, algo: algo, value: kv[1]})
+			}
+		}
+	}
+	for _, member := range splitDictionary(strings.Join(h[http.CanonicalHeaderKey(contentDigestHeader)], ",")) {
+		key, value := splitMember(member)
+		if algo, ok := supportedDigest(key); ok {
+			digests = append(digests, receivedDigest{header: contentDigestHeader, algo: algo, value: strings.Trim(value, ":")})
+		}
+	}
+	return digests
+}
+
+// supportedDigest returns the DigestAlgorithm named case-insensitively, if it
+// is supported.
+func supportedDigest(name string) (DigestAlgorithm, bool) {
+	switch algo := DigestAlgorithm(strings.ToUpper(name)); algo {
+	case DigestSHA256, DigestSHA512:
+		return algo, true
+	}
+	return "", false
+}
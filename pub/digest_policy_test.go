@@ -0,0 +1,102 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDigestPolicy(t *testing.T) {
+	ctx := context.Background()
+	const sum = "RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o="
+	newRequest := func(body string, header, value string) *http.Request {
+		r := httptest.NewRequest("POST", testMyInboxIRI, strings.NewReader(body))
+		if len(header) > 0 {
+			r.Header.Set(header, value)
+		}
+		return r
+	}
+	t.Run("VerifiesDigest", func(t *testing.T) {
+		// Setup
+		r := newRequest("{}", "Digest", "SHA-256="+sum)
+		// Run
+		err := DigestPolicy{}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("VerifiesContentDigestOfChunkedBody", func(t *testing.T) {
+		// Setup
+		r := newRequest("{}", "Content-Digest", "sha-256=:"+sum+":")
+		r.ContentLength = -1
+		r.TransferEncoding = []string{"chunked"}
+		// Run
+		err := DigestPolicy{}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReportsMismatch", func(t *testing.T) {
+		// Setup
+		r := newRequest(`{"a":1}`, "Digest", "SHA-256="+sum)
+		// Run
+		err := DigestPolicy{}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+		e, ok := AsDigestMismatch(err)
+		assertEqual(t, ok, true)
+		assertEqual(t, e.Header, "Digest")
+		assertEqual(t, e.Algorithm, DigestSHA256)
+		assertEqual(t, e.Received, sum)
+		assertEqual(t, e.BodyLength, 7)
+	})
+	t.Run("ToleratesMismatch", func(t *testing.T) {
+		// Setup
+		r := newRequest(`{"a":1}`, "Digest", "SHA-256="+sum)
+		var seen *DigestMismatchError
+		p := DigestPolicy{OnMismatch: func(c context.Context, r *http.Request, e *DigestMismatchError) error {
+			seen = e
+			return nil
+		}}
+		// Run
+		err := p.Verify(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+		assertNotEqual(t, seen, (*DigestMismatchError)(nil))
+	})
+	t.Run("RequiresDigest", func(t *testing.T) {
+		// Setup
+		r := newRequest("{}", "", "")
+		// Run
+		err := DigestPolicy{Mode: DigestRequire}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+		assertEqual(t, DigestPolicy{}.Verify(ctx, newRequest("{}", "", "")), nil)
+	})
+	t.Run("IgnoresDigest", func(t *testing.T) {
+		// Setup
+		r := newRequest(`{"a":1}`, "Digest", "SHA-256="+sum)
+		// Run
+		err := DigestPolicy{Mode: DigestIgnore}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("RefusesUnsupportedDigest", func(t *testing.T) {
+		// Setup
+		r := newRequest("{}", "Digest", "MD5=mZFLkyvTelC5g8XnyQrpOw==")
+		// Run
+		err := DigestPolicy{}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+	})
+	t.Run("RefusesLargeBody", func(t *testing.T) {
+		// Setup
+		r := newRequest(`{"a":1}`, "Digest", "SHA-256="+sum)
+		r.ContentLength = -1
+		// Run
+		err := DigestPolicy{MaxBodyBytes: 4}.Verify(ctx, r)
+		// Verify
+		assertEqual(t, IsErr(err, ErrBodyTooLarge), true)
+		assertEqual(t, IsErr(DigestPolicy{MaxBodyBytes: 7}.Verify(ctx, newRequest(`{"a":1}`, "Digest", "SHA-256="+sum)), ErrBodyTooLarge), false)
+	})
+}
@@ -0,0 +1,253 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// DomainStore holds the domain patterns a DomainPolicy allows and blocks.
+//
+// The patterns are read on every check, so a store backed by the
+// application's database lets an administrator edit them while the server
+// runs. Implementations must be safe for concurrent use.
+type DomainStore interface {
+	// Allowed returns the patterns of the only domains to federate with.
+	// If empty, every domain not blocked is federated with.
+	Allowed(c context.Context) ([]string, error)
+	// Blocked returns the patterns of domains never federated with.
+	Blocked(c context.Context) ([]string, error)
+}
+
+// MemoryDomainStore is a DomainStore held in memory.
+type MemoryDomainStore struct {
+	mu      sync.Mutex
+	allowed []string
+	blocked []string
+}
+
+// MemoryDomainStore must satisfy the DomainStore interface.
+var _ DomainStore = &MemoryDomainStore{}
+
+// NewMemoryDomainStore creates a MemoryDomainStore with the given patterns.
+func NewMemoryDomainStore(allowed, blocked []string) *MemoryDomainStore {
+	return &MemoryDomainStore{
+		allowed: append([]string(nil), allowed...),
+		blocked: append([]string(nil), blocked...),
+	}
+}
+
+// Allowed returns the allowed patterns.
+func (m *MemoryDomainStore) Allowed(c context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.allowed...), nil
+}
+
+// Blocked returns the blocked patterns.
+func (m *MemoryDomainStore) Blocked(c context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.blocked...), nil
+}
+
+// Allow adds the pattern to the allowed patterns.
+func (m *MemoryDomainStore) Allow(pattern string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.allowed = appendPattern(m.allowed, pattern)
+}
+
+// Disallow removes the pattern from the allowed patterns.
+func (m *MemoryDomainStore) Disallow(pattern string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.allowed = removePattern(m.allowed, pattern)
+}
+
+// Block adds the pattern to the blocked patterns.
+func (m *MemoryDomainStore) Block(pattern string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blocked = appendPattern(m.blocked, pattern)
+}
+
+// Unblock removes the pattern from the blocked patterns.
+func (m *MemoryDomainStore) Unblock(pattern string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blocked = removePattern(m.blocked, pattern)
+}
+
+// appendPattern appends the pattern if it is not already present.
+func appendPattern(patterns []string, pattern string) []string {
+	for _, p := range patterns {
+		if p == pattern {
+			return patterns
+		}
+	}
+	return append(patterns, pattern)
+}
+
+// removePattern removes every occurrence of the pattern.
+func removePattern(patterns []string, pattern string) []string {
+	out := patterns[:0]
+	for _, p := range patterns {
+		if p != pattern {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// DomainPolicy decides which peer domains this server federates with, using
+// the allowed and blocked patterns of a DomainStore.
+//
+// A pattern matches a host that is equal to it or is one of its subdomains, so
+// "example.com" also matches "social.example.com". Patterns may also contain
+// the wildcards of path.Match, such as "*.example.com". Blocked patterns take
+// precedence over allowed ones, and ports are ignored.
+//
+// Inbound, its Blocked method suits FederatingProtocol's Blocked, which is
+// consulted before any side effects, and PermitsRequest may be called in
+// AuthenticatePostInbox before fetching the key of the request's signature.
+// Outbound, a DomainPolicyTransport suppresses deliveries to and fetches from
+// domains that are not permitted.
+type DomainPolicy struct {
+	store DomainStore
+}
+
+// NewDomainPolicy creates a DomainPolicy reading its patterns from the store.
+func NewDomainPolicy(store DomainStore) *DomainPolicy {
+	return &DomainPolicy{
+		store: store,
+	}
+}
+
+// IsPermitted determines whether the IRI's host may be federated with.
+func (d *DomainPolicy) IsPermitted(c context.Context, iri *url.URL) (bool, error) {
+	host := strings.ToLower(iri.Hostname())
+	blocked, err := d.store.Blocked(c)
+	if err != nil {
+		return false, err
+	}
+	for _, pattern := range blocked {
+		if matchesDomain(pattern, host) {
+			return false, nil
+		}
+	}
+	allowed, err := d.store.Allowed(c)
+	if err != nil {
+		return false, err
+	} else if len(allowed) == 0 {
+		return true, nil
+	}
+	for _, pattern := range allowed {
+		if matchesDomain(pattern, host) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Blocked determines whether any of the actors is on a domain that is not
+// permitted.
+func (d *DomainPolicy) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	for _, iri := range actorIRIs {
+		if ok, err := d.IsPermitted(c, iri); err != nil {
+			return false, err
+		} else if !ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PermitsRequest determines whether the key id of the request's HTTP
+// Signature is on a permitted domain, without fetching the key. Requests that
+// are not signed are permitted, leaving their authentication to the caller.
+func (d *DomainPolicy) PermitsRequest(c context.Context, r *http.Request) (bool, error) {
+	if len(r.Header.Get("Signature")) == 0 && len(r.Header.Get("Authorization")) == 0 {
+		return true, nil
+	}
+	v, err := newVerifier(r)
+	if err != nil {
+		return false, err
+	}
+	keyId, err := url.Parse(v.KeyId())
+	if err != nil {
+		return false, err
+	}
+	return d.IsPermitted(c, keyId)
+}
+
+// matchesDomain determines whether the lowercase host matches the pattern.
+func matchesDomain(pattern, host string) bool {
+	pattern = strings.ToLower(pattern)
+	if host == pattern || strings.HasSuffix(host, "."+pattern) {
+		return true
+	}
+	ok, err := path.Match(pattern, host)
+	return err == nil && ok
+}
+
+// DomainPolicyTransport is a Transport that refuses to fetch from, and
+// silently drops deliveries to, domains not permitted by a DomainPolicy.
+// Permitted requests are made by the wrapped Transport.
+type DomainPolicyTransport struct {
+	t      Transport
+	policy *DomainPolicy
+}
+
+// DomainPolicyTransport must satisfy the Transport interface.
+var _ Transport = &DomainPolicyTransport{}
+
+// NewDomainPolicyTransport wraps a Transport so its requests are subject to
+// the DomainPolicy.
+func NewDomainPolicyTransport(t Transport, policy *DomainPolicy) *DomainPolicyTransport {
+	return &DomainPolicyTransport{
+		t:      t,
+		policy: policy,
+	}
+}
+
+// Dereference fetches the IRI if its domain is permitted, and returns an
+// error otherwise.
+func (d *DomainPolicyTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	if ok, err := d.policy.IsPermitted(c, iri); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf("domain of %s is not permitted", iri)
+	}
+	return d.t.Dereference(c, iri)
+}
+
+// Deliver sends the payload if the domain is permitted, and does nothing
+// otherwise.
+func (d *DomainPolicyTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	if ok, err := d.policy.IsPermitted(c, to); err != nil || !ok {
+		return err
+	}
+	return d.t.Deliver(c, b, to)
+}
+
+// BatchDeliver sends the payload to the recipients whose domains are
+// permitted.
+func (d *DomainPolicyTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	permitted := make([]*url.URL, 0, len(recipients))
+	for _, r := range recipients {
+		if ok, err := d.policy.IsPermitted(c, r); err != nil {
+			return err
+		} else if ok {
+			permitted = append(permitted, r)
+		}
+	}
+	if len(permitted) == 0 {
+		return nil
+	}
+	return d.t.BatchDeliver(c, b, permitted)
+}
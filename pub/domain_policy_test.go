@@ -0,0 +1,96 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+func TestDomainPolicy(t *testing.T) {
+	ctx := context.Background()
+	t.Run("MatchesPatterns", func(t *testing.T) {
+		// Setup
+		p := NewDomainPolicy(NewMemoryDomainStore(nil, []string{"other.example.com", "*.spam.test"}))
+		// Verify
+		tests := map[string]bool{
+			testFederatedActorIRI:              false,
+			"https://sub.other.example.com/a":  false,
+			"https://OTHER.example.com:443/a":  false,
+			"https://a.spam.test/b":            false,
+			"https://spam.test.example.com/b":  true,
+			testNoteId1:                        true,
+			"https://notother.example.com/foo": true,
+		}
+		for iri, expected := range tests {
+			ok, err := p.IsPermitted(ctx, mustParse(iri))
+			assertEqual(t, err, nil)
+			if ok != expected {
+				t.Errorf("%s: expected %v, got %v", iri, expected, ok)
+			}
+		}
+	})
+	t.Run("AllowsOnlyAllowlist", func(t *testing.T) {
+		// Setup
+		store := NewMemoryDomainStore([]string{"example.com"}, nil)
+		p := NewDomainPolicy(store)
+		// Run
+		blocked, err := p.Blocked(ctx, []*url.URL{mustParse(testNoteId1), mustParse(testPersonIRI)})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, blocked, false)
+		// Run
+		store.Block("maybe.example.com")
+		blocked, err = p.Blocked(ctx, []*url.URL{mustParse(testNoteId1), mustParse(testPersonIRI)})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, blocked, true)
+		// Run
+		store.Unblock("maybe.example.com")
+		store.Disallow("example.com")
+		store.Allow("test")
+		blocked, err = p.Blocked(ctx, []*url.URL{mustParse(testNoteId1)})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, blocked, true)
+	})
+	t.Run("ChecksSignatureKeyDomain", func(t *testing.T) {
+		// Setup
+		privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := NewDomainPolicy(NewMemoryDomainStore(nil, []string{"other.example.com"}))
+		// Run
+		signedOk, signedErr := p.PermitsRequest(ctx, mustSignedGetRequest(testMyInboxIRI, testFederatedActorIRI+"#main-key", privKey))
+		unsignedOk, unsignedErr := p.PermitsRequest(ctx, mustSignedGetRequest(testMyInboxIRI, "", nil))
+		// Verify
+		assertEqual(t, signedErr, nil)
+		assertEqual(t, signedOk, false)
+		assertEqual(t, unsignedErr, nil)
+		assertEqual(t, unsignedOk, true)
+	})
+	t.Run("TransportSuppressesBlocked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		p := NewDomainPolicy(NewMemoryDomainStore(nil, []string{"other.example.com"}))
+		dt := NewDomainPolicyTransport(tp, p)
+		b := []byte("activity")
+		tp.EXPECT().BatchDeliver(ctx, b, []*url.URL{mustParse(testToIRI)})
+		tp.EXPECT().Dereference(ctx, mustParse(testNoteId1)).Return(b, nil)
+		// Run
+		batchErr := dt.BatchDeliver(ctx, b, []*url.URL{mustParse(testFederatedActorIRI), mustParse(testToIRI)})
+		deliverErr := dt.Deliver(ctx, b, mustParse(testFederatedActorIRI))
+		_, blockedErr := dt.Dereference(ctx, mustParse(testFederatedActorIRI))
+		_, okErr := dt.Dereference(ctx, mustParse(testNoteId1))
+		// Verify
+		assertEqual(t, batchErr, nil)
+		assertEqual(t, deliverErr, nil)
+		assertNotEqual(t, blockedErr, nil)
+		assertEqual(t, okErr, nil)
+	})
+}
@@ -0,0 +1,72 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// dryRunner is a DelegateActor able to compute the recipients of an activity
+// without delivering it.
+type dryRunner interface {
+	dryRunRecipients(c context.Context, outboxIRI *url.URL, activity Activity) ([]*url.URL, error)
+}
+
+// DryRun runs the outbox pipeline on the value without persisting nor
+// delivering it.
+func (b *baseActor) DryRun(c context.Context, outbox *url.URL, t vocab.Type) (activity Activity, recipients []*url.URL, err error) {
+	if activity, err = b.finalize(c, outbox, t); err != nil {
+		return
+	}
+	if err = validateOutbox(activity); err != nil {
+		return
+	}
+	// Blocks are never delivered, and neither is anything if not
+	// federating.
+	if !b.enableFederatedProtocol || streams.IsOrExtendsActivityStreamsBlock(activity) {
+		return
+	}
+	if d, ok := b.delegate.(dryRunner); ok {
+		recipients, err = d.dryRunRecipients(c, outbox, activity)
+	}
+	return
+}
+
+// dryRunRecipients prepares the activity and returns its recipients, once the
+// CommonBehavior has overridden them if it is a RecipientsOverrider, without
+// delivering to them.
+func (a *sideEffectActor) dryRunRecipients(c context.Context, outboxIRI *url.URL, activity Activity) ([]*url.URL, error) {
+	recipients, err := a.prepare(c, outboxIRI, activity)
+	if err != nil {
+		return nil, err
+	}
+	if o, ok := a.common.(RecipientsOverrider); ok {
+		overridden, err := o.OverrideRecipients(c, outboxIRI, activity, recipients)
+		if err != nil {
+			return nil, err
+		}
+		recipients = dedupeIRIs(overridden, nil)
+	}
+	return recipients, nil
+}
+
+// validateOutbox returns ErrObjectRequired or ErrTargetRequired if the activity
+// lacks a property its side effects in the outbox would need.
+func validateOutbox(activity Activity) error {
+	switch activity.GetTypeName() {
+	case "Create", "Update", "Delete", "Follow", "Add", "Remove", "Like", "Undo", "Block":
+		if op := activity.GetActivityStreamsObject(); op == nil || op.Len() == 0 {
+			return ErrObjectRequired
+		}
+	}
+	switch activity.GetTypeName() {
+	case "Add", "Remove":
+		if t, ok := activity.(targeter); ok {
+			if tp := t.GetActivityStreamsTarget(); tp == nil || tp.Len() == 0 {
+				return ErrTargetRequired
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// testDryRunningDelegate is a DelegateActor resolving the recipients of dry
+// runs to fixed ones.
+type testDryRunningDelegate struct {
+	*MockDelegateActor
+	recipients []*url.URL
+}
+
+func (d *testDryRunningDelegate) dryRunRecipients(c context.Context, outboxIRI *url.URL, activity Activity) ([]*url.URL, error) {
+	return d.recipients, nil
+}
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	recipients := []*url.URL{mustParse(testFederatedActorIRI)}
+	setupFn := func(ctl *gomock.Controller, s2s bool) (delegate *MockDelegateActor, a Actor) {
+		setupData()
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(&testDryRunningDelegate{MockDelegateActor: delegate, recipients: recipients}, true, s2s, NewMockClock(ctl))
+		return
+	}
+	t.Run("FinalizesWithoutPersistingNorDelivering", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, true)
+		delegate.EXPECT().WrapInCreate(ctx, testMyNote, mustParse(testMyOutboxIRI)).DoAndReturn(func(c context.Context, t vocab.Type, u *url.URL) (vocab.ActivityStreamsCreate, error) {
+			return wrappedInCreate(t), nil
+		})
+		delegate.EXPECT().AddNewIds(ctx, wrappedInCreate(testMyNote)).DoAndReturn(func(c context.Context, activity Activity) error {
+			withNewId(activity)
+			return nil
+		})
+		// Run
+		activity, got, err := a.DryRun(ctx, mustParse(testMyOutboxIRI), testMyNote)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, activity.GetTypeName(), "Create")
+		assertEqual(t, activity.GetActivityStreamsId().Get().String(), testNewActivityIRI)
+		assertEqual(t, len(got), 1)
+		assertEqual(t, got[0].String(), testFederatedActorIRI)
+	})
+	t.Run("ErrorIfObjectRequired", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, true)
+		like := streams.NewActivityStreamsLike()
+		delegate.EXPECT().AddNewIds(ctx, like).Return(nil)
+		// Run
+		_, got, err := a.DryRun(ctx, mustParse(testMyOutboxIRI), like)
+		// Verify
+		assertEqual(t, err, ErrObjectRequired)
+		assertEqual(t, len(got), 0)
+	})
+	t.Run("NoRecipientsIfNotFederating", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, false)
+		delegate.EXPECT().AddNewIds(ctx, testMyListen).Return(nil)
+		// Run
+		activity, got, err := a.DryRun(ctx, mustParse(testMyOutboxIRI), testMyListen)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, activity, Activity(testMyListen))
+		assertEqual(t, len(got), 0)
+	})
+}
+
+func TestValidateOutbox(t *testing.T) {
+	object := streams.NewActivityStreamsObjectProperty()
+	object.AppendIRI(mustParse(testNoteId1))
+	add := streams.NewActivityStreamsAdd()
+	assertEqual(t, validateOutbox(add), ErrObjectRequired)
+	add.SetActivityStreamsObject(object)
+	assertEqual(t, validateOutbox(add), ErrTargetRequired)
+	target := streams.NewActivityStreamsTargetProperty()
+	target.AppendIRI(mustParse(testAudienceIRI))
+	add.SetActivityStreamsTarget(target)
+	assertEqual(t, validateOutbox(add), nil)
+	assertEqual(t, validateOutbox(streams.NewActivityStreamsAccept()), nil)
+}
@@ -0,0 +1,126 @@
+package pub
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var (
+	// ErrNotFound indicates that a value does not exist, such as when a
+	// peer responds to a fetch with a 404 Not Found or 410 Gone.
+	ErrNotFound = errors.New("not found")
+	// ErrNotOwned indicates that a value which must be owned by this
+	// server is not.
+	ErrNotOwned = errors.New("not owned by this server")
+	// ErrBadSignature indicates that the HTTP Signature of a request failed
+	// to verify, or that its key could not be attributed to an actor.
+	ErrBadSignature = errors.New("bad HTTP signature")
+)
+
+// wrappedError adds the details of where it occurred to an error of this
+// package, which is still obtained with its Unwrap method.
+type wrappedError struct {
+	err     error
+	details string
+}
+
+// wrapErr wraps the error of this package with the formatted details.
+func wrapErr(err error, format string, a ...interface{}) error {
+	return &wrappedError{err: err, details: fmt.Sprintf(format, a...)}
+}
+
+// Error describes the error followed by its details.
+func (e *wrappedError) Error() string {
+	return e.err.Error() + ": " + e.details
+}
+
+// Unwrap returns the wrapped error.
+func (e *wrappedError) Unwrap() error {
+	return e.err
+}
+
+// ErrUnresolvable is returned when an IRI needed to handle an activity could
+// not be dereferenced.
+type ErrUnresolvable struct {
+	// IRI is the IRI that could not be dereferenced.
+	IRI *url.URL
+	// Err is why dereferencing failed, such as an HttpStatusError.
+	Err error
+}
+
+// Error describes the IRI and why it could not be dereferenced.
+func (e *ErrUnresolvable) Error() string {
+	return fmt.Sprintf("cannot resolve %s: %s", e.IRI, e.Err)
+}
+
+// Unwrap returns why the IRI could not be dereferenced.
+func (e *ErrUnresolvable) Unwrap() error {
+	return e.Err
+}
+
+// Temporary determines whether dereferencing the IRI may succeed later, which
+// it does not if the value is not found.
+func (e *ErrUnresolvable) Temporary() bool {
+	return !IsErr(e.Err, ErrNotFound)
+}
+
+// IsErr determines whether the error is the target, or wraps it through the
+// Unwrap methods of the errors of this package, in the manner of the errors.Is
+// function of later versions of Go.
+func IsErr(err, target error) bool {
+	for err != nil {
+		if err == target {
+			return true
+		}
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return false
+		}
+		err = u.Unwrap()
+	}
+	return false
+}
+
+// AsUnresolvable returns the ErrUnresolvable that is or is wrapped by the
+// error, if any.
+func AsUnresolvable(err error) (e *ErrUnresolvable, ok bool) {
+	for err != nil {
+		if e, ok = err.(*ErrUnresolvable); ok {
+			return
+		}
+		u, isWrapper := err.(interface{ Unwrap() error })
+		if !isWrapper {
+			return
+		}
+		err = u.Unwrap()
+	}
+	return
+}
+
+// ErrorStatus returns the HTTP status code with which to respond to a request
+// whose handling failed with the error, such as one returned by the Actor.
+//
+// An IRI that could not be dereferenced results in a 502 Bad Gateway if it may
+// be resolved later, so that a peer retries its delivery, and in a 400 Bad
+// Request otherwise. Unknown errors result in a 500 Internal Server Error.
+func ErrorStatus(err error) int {
+	if u, ok := AsUnresolvable(err); ok {
+		if u.Temporary() {
+			return http.StatusBadGateway
+		}
+		return http.StatusBadRequest
+	}
+	switch {
+	case IsErr(err, ErrObjectRequired), IsErr(err, ErrTargetRequired), IsErr(err, ErrActorRequired):
+		return http.StatusBadRequest
+	case IsErr(err, ErrBadSignature):
+		return http.StatusUnauthorized
+	case IsErr(err, ErrNotOwned):
+		return http.StatusForbidden
+	case IsErr(err, ErrNotFound):
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
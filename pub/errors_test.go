@@ -0,0 +1,53 @@
+package pub
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestIsErr(t *testing.T) {
+	notFound := &HttpStatusError{Method: "GET", IRI: mustParse(testNoteId1), StatusCode: http.StatusGone, Status: "410 Gone"}
+	unresolvable := &ErrUnresolvable{IRI: mustParse(testNoteId1), Err: notFound}
+	assertEqual(t, IsErr(unresolvable, ErrNotFound), true)
+	assertEqual(t, IsErr(unresolvable, ErrNotOwned), false)
+	assertEqual(t, IsErr(wrapErr(ErrNotOwned, "actor %s", testMyActorIRI), ErrNotOwned), true)
+	assertEqual(t, IsErr(errors.New("not found"), ErrNotFound), false)
+	assertEqual(t, IsErr(nil, ErrNotFound), false)
+	u, ok := AsUnresolvable(wrapErr(ErrNotFound, "wrapping %s", unresolvable))
+	assertEqual(t, ok, false)
+	assertEqual(t, u == nil, true)
+	u, ok = AsUnresolvable(unresolvable)
+	assertEqual(t, ok, true)
+	assertEqual(t, u.IRI.String(), testNoteId1)
+}
+
+func TestErrUnresolvableTemporary(t *testing.T) {
+	serverErr := &HttpStatusError{Method: "GET", IRI: mustParse(testNoteId1), StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}
+	notFound := &HttpStatusError{Method: "GET", IRI: mustParse(testNoteId1), StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	assertEqual(t, (&ErrUnresolvable{IRI: mustParse(testNoteId1), Err: serverErr}).Temporary(), true)
+	assertEqual(t, (&ErrUnresolvable{IRI: mustParse(testNoteId1), Err: testErr}).Temporary(), true)
+	assertEqual(t, (&ErrUnresolvable{IRI: mustParse(testNoteId1), Err: notFound}).Temporary(), false)
+}
+
+func TestErrorStatus(t *testing.T) {
+	notFound := &HttpStatusError{Method: "GET", IRI: mustParse(testNoteId1), StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"ObjectRequired", ErrObjectRequired, http.StatusBadRequest},
+		{"BadSignature", wrapErr(ErrBadSignature, "no owner for public key %s", testNoteId1), http.StatusUnauthorized},
+		{"NotOwned", wrapErr(ErrNotOwned, "actor %s", testMyActorIRI), http.StatusForbidden},
+		{"NotFound", ErrNotFound, http.StatusNotFound},
+		{"TemporarilyUnresolvable", &ErrUnresolvable{IRI: mustParse(testNoteId1), Err: testErr}, http.StatusBadGateway},
+		{"PermanentlyUnresolvable", &ErrUnresolvable{IRI: mustParse(testNoteId1), Err: notFound}, http.StatusBadRequest},
+		{"Unknown", testErr, http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assertEqual(t, ErrorStatus(test.err), test.expected)
+		})
+	}
+}
@@ -93,11 +93,13 @@ type FederatingProtocol interface {
 	// type and extension, so the unhandled ones are passed to
 	// DefaultCallback.
 	DefaultCallback(c context.Context, activity Activity) error
-	// MaxInboxForwardingRecursionDepth determines how deep to search within
-	// an activity to determine if inbox forwarding needs to occur.
+	// DereferencePolicy determines when IRIs in received activities are
+	// dereferenced, including how deep to search within an activity to
+	// determine if inbox forwarding needs to occur.
 	//
-	// Zero or negative numbers indicate infinite recursion.
-	MaxInboxForwardingRecursionDepth(c context.Context) int
+	// NewHostDereferencePolicy provides a policy limiting depth, hosts,
+	// and caching.
+	DereferencePolicy(c context.Context) DereferencePolicy
 	// MaxDeliveryRecursionDepth determines how deep to search within
 	// collections owned by peers when they are targeted to receive a
 	// delivery.
@@ -111,6 +113,8 @@ type FederatingProtocol interface {
 	//
 	// The activity is provided as a reference for more intelligent
 	// logic to be used, but the implementation must not modify it.
+	//
+	// Returning no Collections vetoes forwarding the activity entirely.
 	FilterForwarding(c context.Context, potentialRecipients []*url.URL, a Activity) (filteredRecipients []*url.URL, err error)
 	// GetInbox returns the OrderedCollection inbox of the actor for this
 	// context. It is up to the implementation to provide the correct
@@ -122,3 +126,20 @@ type FederatingProtocol interface {
 	// API is enabled.
 	GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error)
 }
+
+// SharedInboxPolicy is an optional extension of the FederatingProtocol
+// deciding whether deliveries are collapsed into the sharedInbox endpoints
+// that actors advertise. If the FederatingProtocol is not a SharedInboxPolicy,
+// they are for every host.
+type SharedInboxPolicy interface {
+	// UseSharedInbox determines whether deliveries to actors on the given
+	// host may be collapsed into a single delivery to the sharedInbox
+	// endpoint the actors advertise.
+	//
+	// Returning false delivers to each actor's inbox individually, which
+	// may be needed for peers with broken sharedInbox support.
+	//
+	// Actors addressed directly by 'bto' or 'bcc' are always delivered to
+	// individually, as their addressing is stripped before delivery.
+	UseSharedInbox(c context.Context, host string) bool
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/helpers"
 	"github.com/go-fed/activity/streams/vocab"
 	"net/url"
 )
@@ -25,6 +26,21 @@ const (
 	OnFollowAutomaticallyReject
 )
 
+// FollowDecision is the outcome of a FollowPolicy for a received Follow.
+type FollowDecision int
+
+const (
+	// FollowPending leaves the Follow unanswered, such as when the
+	// followed actor must manually approve followers. Neither a response
+	// is sent nor the followers collection updated.
+	FollowPending FollowDecision = iota
+	// FollowAccept sends an Accept of the Follow in response and adds the
+	// following actors to the followers collection.
+	FollowAccept
+	// FollowReject sends a Reject of the Follow in response.
+	FollowReject
+)
+
 // FederatingWrappedCallbacks lists the callback functions that already have
 // some side effect behavior provided by the pub library.
 //
@@ -34,7 +50,12 @@ type FederatingWrappedCallbacks struct {
 	// type, specific to the application using go-fed.
 	//
 	// The wrapping callback for the Federating Protocol ensures the
-	// 'object' property is created in the database.
+	// 'object' property is created in the database. Objects that are
+	// poll votes on a Question owned by this server, in the manner of
+	// Mastodon, are also counted on the Question. Other objects in reply
+	// to an object owned by this server are added to its 'replies'
+	// collection. Objects given only by IRI are dereferenced if the
+	// DereferencePolicy permits it.
 	//
 	// Create calls Create for each object in the federated Activity.
 	Create func(context.Context, vocab.ActivityStreamsCreate) error
@@ -50,8 +71,17 @@ type FederatingWrappedCallbacks struct {
 	// Delete handles additional side effects for the Delete ActivityStreams
 	// type, specific to the application using go-fed.
 	//
-	// Delete removes the federated entry from the database.
+	// Delete removes the federated entry from the database, or replaces
+	// it with a Tombstone if TombstoneOnDelete is set.
 	Delete func(context.Context, vocab.ActivityStreamsDelete) error
+	// TombstoneOnDelete determines whether a federated Delete replaces
+	// the stored entry with a Tombstone, instead of removing it from the
+	// database. The Tombstone keeps the id, the type in 'formerType', and
+	// the time of deletion in 'deleted'.
+	//
+	// Keeping a Tombstone lets NewActivityStreamsHandler serve it with a
+	// 410 Gone status, like objects deleted through the Social Protocol.
+	TombstoneOnDelete bool
 	// Follow handles additional side effects for the Follow ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -60,7 +90,21 @@ type FederatingWrappedCallbacks struct {
 	Follow func(context.Context, vocab.ActivityStreamsFollow) error
 	// OnFollow determines what action to take for this particular callback
 	// if a Follow Activity is handled.
+	//
+	// It is ignored if FollowPolicy is set.
 	OnFollow OnFollowBehavior
+	// FollowPolicy decides whether a Follow of the actor owning this inbox
+	// is accepted, rejected, or left pending. It allows the decision to
+	// be made per Follow, such as for actors that manually approve
+	// followers or that block certain peers.
+	//
+	// On FollowAccept, an Accept is created, delivered to the following
+	// actors, and they are added to the followers collection. On
+	// FollowReject, a Reject is created and delivered. On FollowPending,
+	// no default side effects occur.
+	//
+	// If nil, the OnFollow behavior is used instead.
+	FollowPolicy func(context.Context, vocab.ActivityStreamsFollow) (FollowDecision, error)
 	// Accept handles additional side effects for the Accept ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -96,13 +140,27 @@ type FederatingWrappedCallbacks struct {
 	// type, specific to the application using go-fed.
 	//
 	// The wrapping function will add the activity to the "likes" collection
-	// on all 'object' targets owned by this server.
+	// on all 'object' targets owned by this server, once, and update its
+	// 'totalItems'. A new "likes" collection is identified by the object's
+	// IRI followed by "/likes", which LikesPageFunc can serve.
 	Like func(context.Context, vocab.ActivityStreamsLike) error
+	// Reaction handles additional side effects for a Like sent as an emoji
+	// reaction, as Misskey and Pleroma do, specific to the application using
+	// go-fed. It is called with the reaction instead of Like, which still
+	// handles the plain Likes.
+	//
+	// The wrapping function applies the same side effects as for a Like, so
+	// a reaction is counted in the "likes" collection of its objects, as
+	// software without reactions does.
+	Reaction func(context.Context, vocab.ActivityStreamsLike, helpers.Reaction) error
 	// Announce handles additional side effects for the Announce
 	// ActivityStreams type, specific to the application using go-fed.
 	//
 	// The wrapping function will add the activity to the "shares"
-	// collection on all 'object' targets owned by this server.
+	// collection on all 'object' targets owned by this server, once, and
+	// update its 'totalItems'. A new "shares" collection is identified by
+	// the object's IRI followed by "/shares", which SharesPageFunc can
+	// serve.
 	Announce func(context.Context, vocab.ActivityStreamsAnnounce) error
 	// Undo handles additional side effects for the Undo ActivityStreams
 	// type, specific to the application using go-fed.
@@ -112,8 +170,16 @@ type FederatingWrappedCallbacks struct {
 	// It enforces that the actors on the Undo must correspond to all of the
 	// 'object' actors in some manner.
 	//
-	// It is expected that the application will implement the proper
-	// reversal of activities that are being undone.
+	// The wrapping function reverses the default side effects of undone
+	// Like, Announce, and Follow activities owned by or addressed to this
+	// server: the Like or Announce is removed from the 'likes' or 'shares'
+	// collection of its objects, updating its 'totalItems', and the actors
+	// of a Follow are removed from the followers collection. An undone
+	// activity is matched by its id, or by its type and actors if it has no
+	// id.
+	//
+	// It is expected that the application will implement any other
+	// cleanup and the reversal of other activities being undone.
 	Undo func(context.Context, vocab.ActivityStreamsUndo) error
 	// Block handles additional side effects for the Block ActivityStreams
 	// type, specific to the application using go-fed.
@@ -123,6 +189,95 @@ type FederatingWrappedCallbacks struct {
 	// received from a federated peer, as delivering Blocks explicitly
 	// deviates from the original ActivityPub specification.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Move handles additional side effects for the Move ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function handles the account migration used by
+	// Mastodon: an actor moving itself, its single 'object', to the single
+	// 'target' actor. The Move is rejected unless the target lists the
+	// origin in its 'alsoKnownAs' and the origin's 'movedTo' is the target,
+	// both being dereferenced to be checked. The RefollowOnMove and
+	// MigrateAccount side effects then apply.
+	Move func(context.Context, vocab.ActivityStreamsMove) error
+	// RefollowOnMove makes the actor owning this inbox follow the target
+	// of a verified Move if it follows the origin: the origin is removed
+	// from its 'following' collection and a Follow of the target is
+	// delivered. The target is added once it Accepts.
+	RefollowOnMove bool
+	// MigrateAccount is called with the origin and target actors of a
+	// verified Move, before Move, so that the application migrates its own
+	// data about the origin, such as mutes or lists, to the target.
+	MigrateAccount func(c context.Context, origin, target *url.URL) error
+	// Flag handles additional side effects for the Flag ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function requires an 'object' property. If the
+	// ModerationReporter is set, the Flag must also have an 'actor' and
+	// flag at least one account or object owned by this server, and the
+	// report is given to the ModerationReporter with the flagged objects
+	// obtained.
+	Flag func(context.Context, vocab.ActivityStreamsFlag) error
+	// ModerationReporter receives the reports made by Flag activities,
+	// once per reporter and set of flagged IRIs, so that a report sent
+	// again in a new Flag is not reported twice.
+	ModerationReporter ModerationReporter
+	// View handles additional side effects for the View ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function requires an 'object' property. It provides no
+	// other default side effects.
+	View func(context.Context, vocab.ActivityStreamsView) error
+	// Listen handles additional side effects for the Listen ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function requires an 'object' property. It provides no
+	// other default side effects.
+	Listen func(context.Context, vocab.ActivityStreamsListen) error
+	// Read handles additional side effects for the Read ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function requires an 'object' property. It provides no
+	// other default side effects.
+	Read func(context.Context, vocab.ActivityStreamsRead) error
+	// TentativeAccept handles additional side effects for the
+	// TentativeAccept ActivityStreams type, specific to the application
+	// using go-fed.
+	//
+	// The wrapping function requires an 'object' property. It provides no
+	// other default side effects. Unlike an Accept, a TentativeAccept of a
+	// Follow does not add the 'actor' to the 'following' collection.
+	TentativeAccept func(context.Context, vocab.ActivityStreamsTentativeAccept) error
+	// TentativeReject handles additional side effects for the
+	// TentativeReject ActivityStreams type, specific to the application
+	// using go-fed.
+	//
+	// The wrapping function requires an 'object' property. It provides no
+	// other default side effects.
+	TentativeReject func(context.Context, vocab.ActivityStreamsTentativeReject) error
+	// Travel handles additional side effects for the Travel ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function provides no default side effects, as Travel is
+	// an intransitive activity.
+	Travel func(context.Context, vocab.ActivityStreamsTravel) error
+	// Arrive handles additional side effects for the Arrive ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function provides no default side effects, as Arrive is
+	// an intransitive activity.
+	Arrive func(context.Context, vocab.ActivityStreamsArrive) error
+	// Question handles additional side effects for the Question
+	// ActivityStreams type, specific to the application using go-fed.
+	//
+	// The wrapping function provides no default side effects. It simply
+	// calls the wrapped function.
+	Question func(context.Context, vocab.ActivityStreamsQuestion) error
+	// Invite handles additional side effects for the Invite ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function requires an 'object' property. It provides no
+	// other default side effects.
+	Invite func(context.Context, vocab.ActivityStreamsInvite) error
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -138,6 +293,12 @@ type FederatingWrappedCallbacks struct {
 	deliver func(c context.Context, outboxIRI *url.URL, activity Activity) error
 	// newTransport creates a new Transport.
 	newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
+	// clock is the server's clock.
+	clock Clock
+	// dereferencePolicy obtains the DereferencePolicy.
+	dereferencePolicy func(c context.Context) DereferencePolicy
+	// seen records the inbound activities whose side effects were applied.
+	seen SeenStore
 }
 
 // callbacks returns the WrappedCallbacks members into a single interface slice
@@ -158,6 +319,17 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	enableAnnounce := true
 	enableUndo := true
 	enableBlock := true
+	enableMove := true
+	enableFlag := true
+	enableView := true
+	enableListen := true
+	enableRead := true
+	enableTentativeAccept := true
+	enableTentativeReject := true
+	enableTravel := true
+	enableArrive := true
+	enableQuestion := true
+	enableInvite := true
 	for _, fn := range fns {
 		switch fn.(type) {
 		default:
@@ -186,6 +358,28 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 			enableUndo = false
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			enableBlock = false
+		case func(context.Context, vocab.ActivityStreamsMove) error:
+			enableMove = false
+		case func(context.Context, vocab.ActivityStreamsFlag) error:
+			enableFlag = false
+		case func(context.Context, vocab.ActivityStreamsView) error:
+			enableView = false
+		case func(context.Context, vocab.ActivityStreamsListen) error:
+			enableListen = false
+		case func(context.Context, vocab.ActivityStreamsRead) error:
+			enableRead = false
+		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
+			enableTentativeAccept = false
+		case func(context.Context, vocab.ActivityStreamsTentativeReject) error:
+			enableTentativeReject = false
+		case func(context.Context, vocab.ActivityStreamsTravel) error:
+			enableTravel = false
+		case func(context.Context, vocab.ActivityStreamsArrive) error:
+			enableArrive = false
+		case func(context.Context, vocab.ActivityStreamsQuestion) error:
+			enableQuestion = false
+		case func(context.Context, vocab.ActivityStreamsInvite) error:
+			enableInvite = false
 		}
 	}
 	if enableCreate {
@@ -224,6 +418,39 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	if enableBlock {
 		fns = append(fns, w.block)
 	}
+	if enableMove {
+		fns = append(fns, w.move)
+	}
+	if enableFlag {
+		fns = append(fns, w.flag)
+	}
+	if enableView {
+		fns = append(fns, w.view)
+	}
+	if enableListen {
+		fns = append(fns, w.listen)
+	}
+	if enableRead {
+		fns = append(fns, w.read)
+	}
+	if enableTentativeAccept {
+		fns = append(fns, w.tentativeAccept)
+	}
+	if enableTentativeReject {
+		fns = append(fns, w.tentativeReject)
+	}
+	if enableTravel {
+		fns = append(fns, w.travel)
+	}
+	if enableArrive {
+		fns = append(fns, w.arrive)
+	}
+	if enableQuestion {
+		fns = append(fns, w.question)
+	}
+	if enableInvite {
+		fns = append(fns, w.invite)
+	}
 	return fns
 }
 
@@ -233,25 +460,33 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
+	actors, err := getActorIds(a)
+	if err != nil {
+		return err
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
 		t := iter.GetType()
 		if t == nil && iter.IsIRI() {
-			// Attempt to dereference the IRI instead
+			// Attempt to dereference the IRI instead, if permitted.
+			policy := w.dereferencePolicy(c)
+			if !policy.ShouldDereference(c, iter.GetIRI()) {
+				return nil
+			}
 			tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
 			if err != nil {
 				return err
 			}
-			b, err := tport.Dereference(c, iter.GetIRI())
+			b, err := policy.Dereference(c, tport, iter.GetIRI())
 			if err != nil {
-				return err
+				return &ErrUnresolvable{IRI: iter.GetIRI(), Err: err}
 			}
 			var m map[string]interface{}
 			if err = json.Unmarshal(b, &m); err != nil {
 				return err
 			}
-			t, err = streams.ToType(c, m)
+			t, err = streams.DeserializeContext(c, m)
 			if err != nil {
 				return err
 			}
@@ -270,7 +505,18 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 		if err := w.db.Create(c, t); err != nil {
 			return err
 		}
-		return nil
+		// Add the object to the replies of the objects it is in reply
+		// to that are owned by this server.
+		if err := addReply(c, w.db, t); err != nil {
+			return err
+		}
+		// Record the object as part of its conversation, if known.
+		if err := addToConversation(c, w.db, t); err != nil {
+			return err
+		}
+		// Count the object if it is a vote on a Question owned by this
+		// server.
+		return countVote(c, w.db, w.clock, actors, t)
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		if err := loopFn(iter); err != nil {
@@ -345,10 +591,22 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 			return err
 		}
 		defer w.db.Unlock(c, id)
-		if err := w.db.Delete(c, id); err != nil {
+		if !w.TombstoneOnDelete {
+			return w.db.Delete(c, id)
+		}
+		if exists, err := w.db.Exists(c, id); err != nil {
 			return err
+		} else if !exists {
+			return nil
 		}
-		return nil
+		t, err := w.db.Get(c, id)
+		if err != nil {
+			return err
+		}
+		if streams.IsOrExtendsActivityStreamsTombstone(t) {
+			return nil
+		}
+		return w.db.Update(c, toTombstone(t, id, w.clock.Now()))
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		if err := loopFn(iter); err != nil {
@@ -384,7 +642,7 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 	w.db.Unlock(c, w.inboxIRI)
 	// Unlock must be called by now and every branch above.
 	isMe := false
-	if w.OnFollow != OnFollowDoNothing {
+	if w.FollowPolicy != nil || w.OnFollow != OnFollowDoNothing {
 		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 			id, err := ToId(iter)
 			if err != nil {
@@ -396,15 +654,22 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 			}
 		}
 	}
+	decision := FollowPending
 	if isMe {
+		decision, err = w.followDecision(c, a)
+		if err != nil {
+			return err
+		}
+	}
+	if decision != FollowPending {
 		// Prepare the response.
 		var response Activity
-		if w.OnFollow == OnFollowAutomaticallyAccept {
+		if decision == FollowAccept {
 			response = streams.NewActivityStreamsAccept()
-		} else if w.OnFollow == OnFollowAutomaticallyReject {
+		} else if decision == FollowReject {
 			response = streams.NewActivityStreamsReject()
 		} else {
-			return fmt.Errorf("unknown OnFollowBehavior: %d", w.OnFollow)
+			return fmt.Errorf("unknown FollowDecision: %d", decision)
 		}
 		// Set us as the 'actor'.
 		me := streams.NewActivityStreamsActorProperty()
@@ -427,12 +692,12 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 			to.AppendIRI(id)
 			recipients = append(recipients, id)
 		}
-		if w.OnFollow == OnFollowAutomaticallyAccept {
-			// If automatically accepting, then also update our
-			// followers collection with the new actors.
+		if decision == FollowAccept {
+			// If accepting, then also update our followers
+			// collection with the new actors.
 			//
-			// If automatically rejecting, do not update the
-			// followers collection.
+			// If rejecting, do not update the followers
+			// collection.
 			if err := w.db.Lock(c, actorIRI); err != nil {
 				return err
 			}
@@ -443,8 +708,21 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 				return err
 			}
 			items := followers.GetActivityStreamsItems()
+			if items == nil {
+				items = streams.NewActivityStreamsItemsProperty()
+				followers.SetActivityStreamsItems(items)
+			}
+			existing := make(map[string]bool, items.Len())
+			for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					existing[id.String()] = true
+				}
+			}
 			for _, elem := range recipients {
-				items.PrependIRI(elem)
+				if !existing[elem.String()] {
+					items.PrependIRI(elem)
+					existing[elem.String()] = true
+				}
 			}
 			if err = w.db.Update(c, followers); err != nil {
 				w.db.Unlock(c, actorIRI)
@@ -474,6 +752,25 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 	return nil
 }
 
+// followDecision determines how to respond to a Follow of the actor owning
+// the inbox, using the FollowPolicy if set and the OnFollow behavior
+// otherwise.
+func (w FederatingWrappedCallbacks) followDecision(c context.Context, a vocab.ActivityStreamsFollow) (FollowDecision, error) {
+	if w.FollowPolicy != nil {
+		return w.FollowPolicy(c, a)
+	}
+	switch w.OnFollow {
+	case OnFollowDoNothing:
+		return FollowPending, nil
+	case OnFollowAutomaticallyAccept:
+		return FollowAccept, nil
+	case OnFollowAutomaticallyReject:
+		return FollowReject, nil
+	default:
+		return FollowPending, fmt.Errorf("unknown OnFollowBehavior: %d", w.OnFollow)
+	}
+}
+
 // accept implements the federating Accept activity side effects.
 func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivityStreamsAccept) error {
 	op := a.GetActivityStreamsObject()
@@ -505,13 +802,13 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 				}
 				b, err := tport.Dereference(c, iter.GetIRI())
 				if err != nil {
-					return err
+					return &ErrUnresolvable{IRI: iter.GetIRI(), Err: err}
 				}
 				var m map[string]interface{}
 				if err = json.Unmarshal(b, &m); err != nil {
 					return err
 				}
-				t, err = streams.ToType(c, m)
+				t, err = streams.DeserializeContext(c, m)
 				if err != nil {
 					return err
 				}
@@ -592,7 +889,7 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 					}
 				}
 				if !ok {
-					return fmt.Errorf("peer gave an Accept wrapping a Follow but we are not the actor on that Follow")
+					return wrapErr(ErrNotOwned, "peer gave an Accept wrapping a Follow but we are not the actor on that Follow")
 				}
 				// Build map of original Accept actors
 				acceptActors := make(map[string]bool)
@@ -616,7 +913,7 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 				}
 				for _, found := range acceptActors {
 					if !found {
-						return fmt.Errorf("peer gave an Accept wrapping a Follow but was not an object in the original Follow")
+						return wrapErr(ErrNotOwned, "peer gave an Accept wrapping a Follow but was not an object in the original Follow")
 					}
 				}
 				return nil
@@ -744,43 +1041,25 @@ func (w FederatingWrappedCallbacks) like(c context.Context, a vocab.ActivityStre
 			likes = streams.NewActivityStreamsLikesProperty()
 			l.SetActivityStreamsLikes(likes)
 		}
-		// Get 'likes' value, defaulting to a collection.
-		likesT := likes.GetType()
-		if likesT == nil {
-			col := streams.NewActivityStreamsCollection()
-			likesT = col
-			likes.SetActivityStreamsCollection(col)
-		}
 		// Prepend the activity's 'id' on the 'likes' Collection or
-		// OrderedCollection.
-		if col, ok := likesT.(itemser); ok {
-			items := col.GetActivityStreamsItems()
-			if items == nil {
-				items = streams.NewActivityStreamsItemsProperty()
-				col.SetActivityStreamsItems(items)
-			}
-			items.PrependIRI(id)
-		} else if oCol, ok := likesT.(orderedItemser); ok {
-			oItems := oCol.GetActivityStreamsOrderedItems()
-			if oItems == nil {
-				oItems = streams.NewActivityStreamsOrderedItemsProperty()
-				oCol.SetActivityStreamsOrderedItems(oItems)
-			}
-			oItems.PrependIRI(id)
-		} else {
-			return fmt.Errorf("likes type is neither a Collection nor an OrderedCollection: %T", likesT)
-		}
-		err = w.db.Update(c, t)
-		if err != nil {
+		// OrderedCollection, defaulting to a collection.
+		if update, err := addToObjectCollection(c, w.db, objId, likes, likesPath, id); err != nil {
 			return err
+		} else if !update {
+			return nil
 		}
-		return nil
+		return w.db.Update(c, t)
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		if err := loopFn(iter); err != nil {
 			return err
 		}
 	}
+	if w.Reaction != nil {
+		if r, ok := helpers.GetReaction(a); ok {
+			return w.Reaction(c, a, r)
+		}
+	}
 	if w.Like != nil {
 		return w.Like(c, a)
 	}
@@ -825,37 +1104,14 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 			shares = streams.NewActivityStreamsSharesProperty()
 			s.SetActivityStreamsShares(shares)
 		}
-		// Get 'shares' value, defaulting to a collection.
-		sharesT := shares.GetType()
-		if sharesT == nil {
-			col := streams.NewActivityStreamsCollection()
-			sharesT = col
-			shares.SetActivityStreamsCollection(col)
-		}
 		// Prepend the activity's 'id' on the 'shares' Collection or
-		// OrderedCollection.
-		if col, ok := sharesT.(itemser); ok {
-			items := col.GetActivityStreamsItems()
-			if items == nil {
-				items = streams.NewActivityStreamsItemsProperty()
-				col.SetActivityStreamsItems(items)
-			}
-			items.PrependIRI(id)
-		} else if oCol, ok := sharesT.(orderedItemser); ok {
-			oItems := oCol.GetActivityStreamsOrderedItems()
-			if oItems == nil {
-				oItems = streams.NewActivityStreamsOrderedItemsProperty()
-				oCol.SetActivityStreamsOrderedItems(oItems)
-			}
-			oItems.PrependIRI(id)
-		} else {
-			return fmt.Errorf("shares type is neither a Collection nor an OrderedCollection: %T", sharesT)
-		}
-		err = w.db.Update(c, t)
-		if err != nil {
+		// OrderedCollection, defaulting to a collection.
+		if update, err := addToObjectCollection(c, w.db, objId, shares, sharesPath, id); err != nil {
 			return err
+		} else if !update {
+			return nil
 		}
-		return nil
+		return w.db.Update(c, t)
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		if err := loopFn(iter); err != nil {
@@ -878,12 +1134,202 @@ func (w FederatingWrappedCallbacks) undo(c context.Context, a vocab.ActivityStre
 	if err := mustHaveActivityActorsMatchObjectActors(c, actors, op, w.newTransport, w.inboxIRI); err != nil {
 		return err
 	}
+	actorIds, err := getActorIds(a)
+	if err != nil {
+		return err
+	}
+	// Reverse the side effects of the undone activities. Those referenced
+	// only by IRI are looked up in the database.
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil && iter.IsIRI() {
+			if t, err = getIfExists(c, w.db, iter.GetIRI()); err != nil {
+				return err
+			}
+		}
+		if t == nil {
+			continue
+		}
+		if err := w.undoSideEffects(c, t, actorIds); err != nil {
+			return err
+		}
+	}
 	if w.Undo != nil {
 		return w.Undo(c, a)
 	}
 	return nil
 }
 
+// undoSideEffects reverses the default side effects of an undone Like,
+// Announce, or Follow. The side effects of other types are left to the
+// application.
+func (w FederatingWrappedCallbacks) undoSideEffects(c context.Context, t vocab.Type, actors []*url.URL) error {
+	if streams.IsOrExtendsActivityStreamsLike(t) {
+		return w.removeUndoneFromObjects(c, t, actors, func(o vocab.Type) collectionProperty {
+			if l, ok := o.(likeser); ok && l.GetActivityStreamsLikes() != nil {
+				return l.GetActivityStreamsLikes()
+			}
+			return nil
+		})
+	} else if streams.IsOrExtendsActivityStreamsAnnounce(t) {
+		return w.removeUndoneFromObjects(c, t, actors, func(o vocab.Type) collectionProperty {
+			if s, ok := o.(shareser); ok && s.GetActivityStreamsShares() != nil {
+				return s.GetActivityStreamsShares()
+			}
+			return nil
+		})
+	} else if streams.IsOrExtendsActivityStreamsFollow(t) {
+		return w.removeUndoneFollowers(c, t, actors)
+	}
+	return nil
+}
+
+// removeUndoneFromObjects removes an undone Like or Announce from the 'likes'
+// or 'shares' collection, as returned by collectionOf, of each of its objects
+// owned by this server.
+//
+// The undone activity is matched by its id. If it has no id, it is instead
+// matched by its type and actors, as some peers refer to the activity being
+// undone this way. Either way, the matched activity as stored by this server
+// must have the same type and one of the actors, as the undone activity
+// embedded in the Undo is the sender's copy and could claim the id of another
+// actor's activity.
+func (w FederatingWrappedCallbacks) removeUndoneFromObjects(c context.Context, undone vocab.Type, actors []*url.URL, collectionOf func(vocab.Type) collectionProperty) error {
+	o, ok := undone.(objecter)
+	if !ok {
+		return nil
+	}
+	op := o.GetActivityStreamsObject()
+	if op == nil {
+		return nil
+	}
+	undoneId, _ := GetId(undone)
+	actorMap := make(map[string]bool, len(actors))
+	for _, actor := range actors {
+		actorMap[actor.String()] = true
+	}
+	matches := func(item IdProperty) (bool, error) {
+		if undoneId != nil {
+			if id, err := ToId(item); err != nil || id.String() != undoneId.String() {
+				return false, nil
+			}
+		}
+		t := item.GetType()
+		if t == nil && item.IsIRI() {
+			var err error
+			if t, err = getIfExists(c, w.db, item.GetIRI()); err != nil {
+				return false, err
+			}
+		}
+		if t == nil || t.GetTypeName() != undone.GetTypeName() {
+			return false, nil
+		}
+		if a, ok := t.(actorer); ok && a.GetActivityStreamsActor() != nil {
+			for iter := a.GetActivityStreamsActor().Begin(); iter != a.GetActivityStreamsActor().End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil && actorMap[id.String()] {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+	// Create anonymous loop function to be able to properly scope the defer
+	// for the database lock at each iteration.
+	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
+		objId, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		if err := w.db.Lock(c, objId); err != nil {
+			return err
+		}
+		defer w.db.Unlock(c, objId)
+		if owns, err := w.db.Owns(c, objId); err != nil {
+			return err
+		} else if !owns {
+			return nil
+		}
+		t, err := w.db.Get(c, objId)
+		if err != nil {
+			return err
+		}
+		prop := collectionOf(t)
+		if prop == nil {
+			return nil
+		} else if prop.IsIRI() {
+			return removeFromCollection(c, w.db, prop.GetIRI(), matches)
+		}
+		if removed, err := removeCollectionItems(prop.GetType(), matches); err != nil || !removed {
+			return err
+		}
+		return w.db.Update(c, t)
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if err := loopFn(iter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeUndoneFollowers removes the actors of an undone Follow of the actor
+// owning this inbox from its followers collection.
+func (w FederatingWrappedCallbacks) removeUndoneFollowers(c context.Context, undone vocab.Type, actors []*url.URL) error {
+	o, ok := undone.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return nil
+	}
+	if err := w.db.Lock(c, w.inboxIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := w.db.ActorForInbox(c, w.inboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.inboxIRI)
+		return err
+	}
+	w.db.Unlock(c, w.inboxIRI)
+	// Unlock must be called by now and every branch above.
+	isMe := false
+	op := o.GetActivityStreamsObject()
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if id, err := ToId(iter); err == nil && id.String() == actorIRI.String() {
+			isMe = true
+			break
+		}
+	}
+	if !isMe {
+		return nil
+	}
+	remove := make(map[string]bool, len(actors))
+	for _, actor := range actors {
+		remove[actor.String()] = true
+	}
+	if err := w.db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer w.db.Unlock(c, actorIRI)
+	followers, err := w.db.Followers(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	items := followers.GetActivityStreamsItems()
+	if items == nil {
+		return nil
+	}
+	removed := false
+	for i := items.Len() - 1; i >= 0; i-- {
+		if id, err := ToId(items.At(i)); err == nil && remove[id.String()] {
+			items.Remove(i)
+			removed = true
+		}
+	}
+	if !removed {
+		return nil
+	}
+	return w.db.Update(c, followers)
+}
+
 // block implements the federating Block activity side effects.
 func (w FederatingWrappedCallbacks) block(c context.Context, a vocab.ActivityStreamsBlock) error {
 	op := a.GetActivityStreamsObject()
@@ -895,3 +1341,246 @@ func (w FederatingWrappedCallbacks) block(c context.Context, a vocab.ActivityStr
 	}
 	return nil
 }
+
+// move implements the federating Move activity side effects.
+func (w FederatingWrappedCallbacks) move(c context.Context, a vocab.ActivityStreamsMove) error {
+	origin, target, err := getMove(a)
+	if err != nil {
+		return err
+	}
+	tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	if err := verifyMove(c, tport, origin, target); err != nil {
+		return err
+	}
+	if w.RefollowOnMove {
+		if err := w.refollowMoved(c, origin, target); err != nil {
+			return err
+		}
+	}
+	if w.MigrateAccount != nil {
+		if err := w.MigrateAccount(c, origin, target); err != nil {
+			return err
+		}
+	}
+	if w.Move != nil {
+		return w.Move(c, a)
+	}
+	return nil
+}
+
+// refollowMoved replaces the origin of a Move with its target for the actor
+// owning this inbox, if it follows the origin. The origin is removed from its
+// 'following' collection and a Follow of the target is delivered, unless the
+// target is already followed.
+func (w FederatingWrappedCallbacks) refollowMoved(c context.Context, origin, target *url.URL) error {
+	if err := w.db.Lock(c, w.inboxIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := w.db.ActorForInbox(c, w.inboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.inboxIRI)
+		return err
+	}
+	outboxIRI, err := w.db.OutboxForInbox(c, w.inboxIRI)
+	if err != nil {
+		w.db.Unlock(c, w.inboxIRI)
+		return err
+	}
+	w.db.Unlock(c, w.inboxIRI)
+	// Unlock must be called by now and every branch above.
+	if err := w.db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	following, err := w.db.Following(c, actorIRI)
+	if err != nil {
+		w.db.Unlock(c, actorIRI)
+		return err
+	}
+	items := following.GetActivityStreamsItems()
+	removed := false
+	isFollowingTarget := false
+	for i := 0; items != nil && i < items.Len(); {
+		if id, err := ToId(items.At(i)); err == nil && id.String() == origin.String() {
+			items.Remove(i)
+			removed = true
+			continue
+		} else if err == nil && id.String() == target.String() {
+			isFollowingTarget = true
+		}
+		i++
+	}
+	if !removed {
+		w.db.Unlock(c, actorIRI)
+		return nil
+	}
+	if err := w.db.Update(c, following); err != nil {
+		w.db.Unlock(c, actorIRI)
+		return err
+	}
+	w.db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	if isFollowingTarget {
+		return nil
+	}
+	follow := streams.NewActivityStreamsFollow()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(actorIRI)
+	follow.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(target)
+	follow.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(target)
+	follow.SetActivityStreamsTo(to)
+	if err := w.addNewIds(c, follow); err != nil {
+		return err
+	}
+	return w.deliver(c, outboxIRI, follow)
+}
+
+// flag implements the federating Flag activity side effects.
+func (w FederatingWrappedCallbacks) flag(c context.Context, a vocab.ActivityStreamsFlag) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.ModerationReporter != nil {
+		if err := w.report(c, a); err != nil {
+			return err
+		}
+	}
+	if w.Flag != nil {
+		return w.Flag(c, a)
+	}
+	return nil
+}
+
+// report gives the report made by a Flag to the ModerationReporter, unless the
+// same report was already received.
+func (w FederatingWrappedCallbacks) report(c context.Context, a vocab.ActivityStreamsFlag) error {
+	tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	r, err := getFlagReport(c, w.db, tport, a)
+	if err != nil {
+		return err
+	}
+	if w.seen != nil {
+		if seen, err := w.seen.MarkSeen(c, reportId(r)); err != nil {
+			return err
+		} else if seen {
+			logEntry(c, LogLevelDebug, "ignored report already received", activityLogFields(a)...)
+			return nil
+		}
+	}
+	if err = w.ModerationReporter.Report(c, r); err != nil && w.seen != nil {
+		if uErr := w.seen.UnmarkSeen(c, reportId(r)); uErr != nil {
+			logEntry(c, LogLevelError, "forgetting report failed", append(activityLogFields(a), errorLogField(uErr))...)
+		}
+	}
+	return err
+}
+
+// view implements the federating View activity side effects.
+func (w FederatingWrappedCallbacks) view(c context.Context, a vocab.ActivityStreamsView) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.View != nil {
+		return w.View(c, a)
+	}
+	return nil
+}
+
+// listen implements the federating Listen activity side effects.
+func (w FederatingWrappedCallbacks) listen(c context.Context, a vocab.ActivityStreamsListen) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Listen != nil {
+		return w.Listen(c, a)
+	}
+	return nil
+}
+
+// read implements the federating Read activity side effects.
+func (w FederatingWrappedCallbacks) read(c context.Context, a vocab.ActivityStreamsRead) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Read != nil {
+		return w.Read(c, a)
+	}
+	return nil
+}
+
+// tentativeAccept implements the federating TentativeAccept activity side
+// effects.
+func (w FederatingWrappedCallbacks) tentativeAccept(c context.Context, a vocab.ActivityStreamsTentativeAccept) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.TentativeAccept != nil {
+		return w.TentativeAccept(c, a)
+	}
+	return nil
+}
+
+// tentativeReject implements the federating TentativeReject activity side
+// effects.
+func (w FederatingWrappedCallbacks) tentativeReject(c context.Context, a vocab.ActivityStreamsTentativeReject) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.TentativeReject != nil {
+		return w.TentativeReject(c, a)
+	}
+	return nil
+}
+
+// travel implements the federating Travel activity side effects.
+func (w FederatingWrappedCallbacks) travel(c context.Context, a vocab.ActivityStreamsTravel) error {
+	if w.Travel != nil {
+		return w.Travel(c, a)
+	}
+	return nil
+}
+
+// arrive implements the federating Arrive activity side effects.
+func (w FederatingWrappedCallbacks) arrive(c context.Context, a vocab.ActivityStreamsArrive) error {
+	if w.Arrive != nil {
+		return w.Arrive(c, a)
+	}
+	return nil
+}
+
+// question implements the federating Question activity side effects.
+func (w FederatingWrappedCallbacks) question(c context.Context, a vocab.ActivityStreamsQuestion) error {
+	if w.Question != nil {
+		return w.Question(c, a)
+	}
+	return nil
+}
+
+// invite implements the federating Invite activity side effects.
+func (w FederatingWrappedCallbacks) invite(c context.Context, a vocab.ActivityStreamsInvite) error {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return ErrObjectRequired
+	}
+	if w.Invite != nil {
+		return w.Invite(c, a)
+	}
+	return nil
+}
@@ -1,6 +1,12 @@
 package pub
 
 import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/helpers"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
 	"testing"
 )
 
@@ -113,6 +119,74 @@ func TestFederatedDelete(t *testing.T) {
 	})
 }
 
+func TestFederatedDeleteTombstone(t *testing.T) {
+	ctx := context.Background()
+	objIRI := mustParse(testFederatedActorIRI + "/note/1")
+	newDelete := func() vocab.ActivityStreamsDelete {
+		del := streams.NewActivityStreamsDelete()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		del.SetActivityStreamsId(id)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(objIRI)
+		del.SetActivityStreamsObject(op)
+		return del
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, cl *MockClock, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		w = FederatingWrappedCallbacks{
+			TombstoneOnDelete: true,
+			db:                db,
+			clock:             cl,
+		}
+		return
+	}
+	t.Run("ReplacesWithTombstone", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		var tomb vocab.Type
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, objIRI),
+			db.EXPECT().Exists(ctx, objIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, objIRI).Return(note, nil),
+			cl.EXPECT().Now().Return(now()),
+			db.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(c context.Context, t vocab.Type) error {
+				tomb = t
+				return nil
+			}),
+			db.EXPECT().Unlock(ctx, objIRI),
+		)
+		// Run
+		err := w.deleteFn(ctx, newDelete())
+		// Verify
+		assertEqual(t, err, nil)
+		ts, ok := tomb.(vocab.ActivityStreamsTombstone)
+		assertEqual(t, ok, true)
+		assertEqual(t, ts.GetActivityStreamsId().Get().String(), objIRI.String())
+		assertEqual(t, ts.GetActivityStreamsFormerType().At(0).GetXMLSchemaString(), "Note")
+		assertEqual(t, ts.GetActivityStreamsDeleted().Get().Equal(now()), true)
+	})
+	t.Run("IgnoresUnknownObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, w := setupFn(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, objIRI),
+			db.EXPECT().Exists(ctx, objIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, objIRI),
+		)
+		// Run
+		err := w.deleteFn(ctx, newDelete())
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
+
 func TestFederatedFollow(t *testing.T) {
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
 		t.Errorf("Not yet implemented.")
@@ -228,6 +302,26 @@ func TestFederatedRemove(t *testing.T) {
 }
 
 func TestFederatedLike(t *testing.T) {
+	ctx := context.Background()
+	noteIRI := mustParse(testNoteId1)
+	likeIRI := mustParse(testFederatedActivityIRI)
+	newLike := func() vocab.ActivityStreamsLike {
+		like := streams.NewActivityStreamsLike()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(likeIRI)
+		like.SetActivityStreamsId(id)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(noteIRI)
+		like.SetActivityStreamsObject(op)
+		return like
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		w = FederatingWrappedCallbacks{
+			db: db,
+		}
+		return
+	}
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
 		t.Errorf("Not yet implemented.")
 	})
@@ -235,34 +329,296 @@ func TestFederatedLike(t *testing.T) {
 		t.Errorf("Not yet implemented.")
 	})
 	t.Run("SkipsUnownedObjects", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, newLike())
+		// Verify
+		assertEqual(t, err, nil)
 	})
 	t.Run("AddsToNewLikesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, newLike())
+		// Verify
+		assertEqual(t, err, nil)
+		col := note.GetActivityStreamsLikes().GetActivityStreamsCollection()
+		assertEqual(t, col.GetActivityStreamsId().Get().String(), testNoteId1+"/likes")
+		assertEqual(t, col.GetActivityStreamsItems().Len(), 1)
+		assertEqual(t, col.GetActivityStreamsItems().At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
 	})
 	t.Run("AddsToExistingLikesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActivityIRI2))
+		col.SetActivityStreamsItems(items)
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetActivityStreamsCollection(col)
+		note.SetActivityStreamsLikes(likes)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, newLike())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 2)
+		assertEqual(t, items.At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 2)
 	})
 	t.Run("AddsToExistingLikesOrderedCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsOrderedCollection()
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetActivityStreamsOrderedCollection(col)
+		note.SetActivityStreamsLikes(likes)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, newLike())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, col.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
+	})
+	t.Run("IgnoresDuplicateLike", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(likeIRI)
+		col.SetActivityStreamsItems(items)
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetActivityStreamsCollection(col)
+		note.SetActivityStreamsLikes(likes)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, newLike())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 1)
+	})
+	t.Run("AddsToStoredLikesCollection", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		likesIRI := mustParse(testNoteId1 + "/likes")
+		note := streams.NewActivityStreamsNote()
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetIRI(likesIRI)
+		note.SetActivityStreamsLikes(likes)
+		col := streams.NewActivityStreamsCollection()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Lock(ctx, likesIRI),
+			db.EXPECT().Owns(ctx, likesIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, likesIRI).Return(col, nil),
+			db.EXPECT().Update(ctx, col),
+			db.EXPECT().Unlock(ctx, likesIRI),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, newLike())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, col.GetActivityStreamsItems().At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
 	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
 		t.Errorf("Not yet implemented.")
 	})
+	t.Run("CallsReactionCallback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		var gotLike, gotReaction bool
+		var reaction helpers.Reaction
+		w.Like = func(context.Context, vocab.ActivityStreamsLike) error {
+			gotLike = true
+			return nil
+		}
+		w.Reaction = func(_ context.Context, _ vocab.ActivityStreamsLike, r helpers.Reaction) error {
+			gotReaction = true
+			reaction = r
+			return nil
+		}
+		like := newLike()
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("🎉")
+		like.SetActivityStreamsContent(content)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.like(ctx, like)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, gotLike, false)
+		assertEqual(t, gotReaction, true)
+		assertEqual(t, reaction.Content, "🎉")
+	})
 }
 
 func TestFederatedAnnounce(t *testing.T) {
+	ctx := context.Background()
+	noteIRI := mustParse(testNoteId1)
+	announceIRI := mustParse(testFederatedActivityIRI)
+	newAnnounce := func() vocab.ActivityStreamsAnnounce {
+		announce := streams.NewActivityStreamsAnnounce()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(announceIRI)
+		announce.SetActivityStreamsId(id)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(noteIRI)
+		announce.SetActivityStreamsObject(op)
+		return announce
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		w = FederatingWrappedCallbacks{
+			db: db,
+		}
+		return
+	}
 	t.Run("SkipsUnownedObjects", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
 	})
 	t.Run("AddsToNewSharesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		col := note.GetActivityStreamsShares().GetActivityStreamsCollection()
+		assertEqual(t, col.GetActivityStreamsId().Get().String(), testNoteId1+"/shares")
+		assertEqual(t, col.GetActivityStreamsItems().At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
 	})
 	t.Run("AddsToExistingSharesCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActivityIRI2))
+		col.SetActivityStreamsItems(items)
+		shares := streams.NewActivityStreamsSharesProperty()
+		shares.SetActivityStreamsCollection(col)
+		note.SetActivityStreamsShares(shares)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 2)
+		assertEqual(t, items.At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 2)
 	})
 	t.Run("AddsToExistingSharesOrderedCollection", func(t *testing.T) {
-		t.Errorf("Not yet implemented.")
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsOrderedCollection()
+		shares := streams.NewActivityStreamsSharesProperty()
+		shares.SetActivityStreamsOrderedCollection(col)
+		note.SetActivityStreamsShares(shares)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounce())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, col.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
 	})
 	t.Run("CallsCustomCallback", func(t *testing.T) {
 		t.Errorf("Not yet implemented.")
@@ -284,6 +640,170 @@ func TestFederatedUndo(t *testing.T) {
 	})
 }
 
+func TestFederatedUndoSideEffects(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	actorIRI := mustParse(testMyActorIRI)
+	noteIRI := mustParse(testNoteId1)
+	undoerIRI := mustParse(testFederatedActorIRI)
+	newActors := func(iri *url.URL) vocab.ActivityStreamsActorProperty {
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(iri)
+		return actor
+	}
+	newLike := func(id string, actor *url.URL) vocab.ActivityStreamsLike {
+		like := streams.NewActivityStreamsLike()
+		if id != "" {
+			idp := streams.NewActivityStreamsIdProperty()
+			idp.Set(mustParse(id))
+			like.SetActivityStreamsId(idp)
+		}
+		like.SetActivityStreamsActor(newActors(actor))
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(noteIRI)
+		like.SetActivityStreamsObject(op)
+		return like
+	}
+	newUndo := func(t vocab.Type) vocab.ActivityStreamsUndo {
+		undo := streams.NewActivityStreamsUndo()
+		undo.SetActivityStreamsActor(newActors(undoerIRI))
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendType(t)
+		undo.SetActivityStreamsObject(op)
+		return undo
+	}
+	newLikedNote := func() vocab.ActivityStreamsNote {
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActivityIRI))
+		items.AppendIRI(mustParse(testFederatedActivityIRI2))
+		col.SetActivityStreamsItems(items)
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetActivityStreamsCollection(col)
+		note.SetActivityStreamsLikes(likes)
+		return note
+	}
+	likesLen := func(note vocab.ActivityStreamsNote) int {
+		return note.GetActivityStreamsLikes().GetActivityStreamsCollection().GetActivityStreamsItems().Len()
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		w = FederatingWrappedCallbacks{
+			db:       db,
+			inboxIRI: inboxIRI,
+		}
+		return
+	}
+	t.Run("RemovesLikeById", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := newLikedNote()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(true, nil),
+			db.EXPECT().Get(ctx, mustParse(testFederatedActivityIRI)).Return(newLike(testFederatedActivityIRI, undoerIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.undo(ctx, newUndo(newLike(testFederatedActivityIRI, undoerIRI)))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, likesLen(note), 1)
+		assertEqual(t, note.GetActivityStreamsLikes().GetActivityStreamsCollection().GetActivityStreamsTotalItems().Get(), 1)
+	})
+	t.Run("DoesNotRemoveLikeOfAnotherActorById", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := newLikedNote()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(true, nil),
+			db.EXPECT().Get(ctx, mustParse(testFederatedActivityIRI)).Return(newLike(testFederatedActivityIRI, mustParse(testFederatedActorIRI2)), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run: the Undo embeds a Like by its own actor, but claims the id
+		// of the Like of another actor.
+		err := w.undo(ctx, newUndo(newLike(testFederatedActivityIRI, undoerIRI)))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, likesLen(note), 2)
+	})
+	t.Run("RemovesLikeByTypeAndActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		note := newLikedNote()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(true, nil),
+			db.EXPECT().Get(ctx, mustParse(testFederatedActivityIRI)).Return(newLike(testFederatedActivityIRI, mustParse(testFederatedActorIRI2)), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI2)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI2)).Return(true, nil),
+			db.EXPECT().Get(ctx, mustParse(testFederatedActivityIRI2)).Return(newLike(testFederatedActivityIRI2, undoerIRI), nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI2)),
+			db.EXPECT().Update(ctx, note),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		err := w.undo(ctx, newUndo(newLike("", undoerIRI)))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, likesLen(note), 1)
+		id, _ := ToId(note.GetActivityStreamsLikes().GetActivityStreamsCollection().GetActivityStreamsItems().At(0))
+		assertEqual(t, id.String(), testFederatedActivityIRI)
+	})
+	t.Run("RemovesFollower", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w := setupFn(ctl)
+		follow := streams.NewActivityStreamsFollow()
+		follow.SetActivityStreamsActor(newActors(undoerIRI))
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(actorIRI)
+		follow.SetActivityStreamsObject(op)
+		followers := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(undoerIRI)
+		items.AppendIRI(mustParse(testFederatedActorIRI2))
+		followers.SetActivityStreamsItems(items)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Followers(ctx, actorIRI).Return(followers, nil),
+			db.EXPECT().Update(ctx, followers),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := w.undo(ctx, newUndo(follow))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 1)
+		assertEqual(t, items.At(0).GetIRI().String(), testFederatedActorIRI2)
+	})
+}
+
 func TestFederatedBlock(t *testing.T) {
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
 		t.Errorf("Not yet implemented.")
@@ -295,3 +815,436 @@ func TestFederatedBlock(t *testing.T) {
 		t.Errorf("Not yet implemented.")
 	})
 }
+
+func TestFederatedMove(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	outboxIRI := mustParse(testMyOutboxIRI)
+	actorIRI := mustParse(testMyActorIRI)
+	originIRI := mustParse(testFederatedActorIRI)
+	targetIRI := mustParse(testFederatedActorIRI2)
+	newActorBytes := func(id *url.URL, alsoKnownAs []*url.URL, movedTo *url.URL) []byte {
+		p := streams.NewActivityStreamsPerson()
+		idProp := streams.NewActivityStreamsIdProperty()
+		idProp.Set(id)
+		p.SetActivityStreamsId(idProp)
+		if alsoKnownAs != nil {
+			SetAlsoKnownAs(p, alsoKnownAs)
+		}
+		if movedTo != nil {
+			SetMovedTo(p, movedTo)
+		}
+		return mustSerializeToBytes(p)
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, tp *MockTransport, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		w = FederatingWrappedCallbacks{
+			db:       db,
+			inboxIRI: inboxIRI,
+			newTransport: func(c context.Context, a *url.URL, s string) (Transport, error) {
+				return tp, nil
+			},
+		}
+		return
+	}
+	expectVerified := func(tp *MockTransport) {
+		tp.EXPECT().Dereference(ctx, targetIRI).Return(newActorBytes(targetIRI, []*url.URL{originIRI}, nil), nil)
+		tp.EXPECT().Dereference(ctx, originIRI).Return(newActorBytes(originIRI, nil, targetIRI), nil)
+	}
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		w := FederatingWrappedCallbacks{}
+		err := w.move(ctx, streams.NewActivityStreamsMove())
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("ErrorIfObjectLengthZero", func(t *testing.T) {
+		w := FederatingWrappedCallbacks{}
+		move := streams.NewActivityStreamsMove()
+		move.SetActivityStreamsObject(streams.NewActivityStreamsObjectProperty())
+		err := w.move(ctx, move)
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("ErrorIfNoTarget", func(t *testing.T) {
+		w := FederatingWrappedCallbacks{}
+		move := NewMove(originIRI, targetIRI, nil)
+		move.SetActivityStreamsTarget(nil)
+		err := w.move(ctx, move)
+		assertEqual(t, err, ErrTargetRequired)
+	})
+	t.Run("ErrorIfActorIsNotOrigin", func(t *testing.T) {
+		w := FederatingWrappedCallbacks{}
+		move := NewMove(originIRI, targetIRI, nil)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(targetIRI)
+		move.SetActivityStreamsActor(actor)
+		err := w.move(ctx, move)
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfTargetNotAlias", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		tp.EXPECT().Dereference(ctx, targetIRI).Return(newActorBytes(targetIRI, nil, nil), nil)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfOriginHasAnotherId", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		// The document served at the origin claims to be another actor.
+		tp.EXPECT().Dereference(ctx, targetIRI).Return(newActorBytes(targetIRI, []*url.URL{originIRI}, nil), nil)
+		tp.EXPECT().Dereference(ctx, originIRI).Return(newActorBytes(mustParse(testFederatedActorIRI3), nil, targetIRI), nil)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfOriginNotMoved", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		tp.EXPECT().Dereference(ctx, targetIRI).Return(newActorBytes(targetIRI, []*url.URL{originIRI}, nil), nil)
+		tp.EXPECT().Dereference(ctx, originIRI).Return(newActorBytes(originIRI, nil, nil), nil)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RefollowsTarget", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w := setupFn(ctl)
+		w.RefollowOnMove = true
+		var delivered Activity
+		w.addNewIds = func(c context.Context, a Activity) error {
+			return nil
+		}
+		w.deliver = func(c context.Context, o *url.URL, a Activity) error {
+			assertEqual(t, o.String(), testMyOutboxIRI)
+			delivered = a
+			return nil
+		}
+		following := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI3))
+		items.AppendIRI(originIRI)
+		following.SetActivityStreamsItems(items)
+		expectVerified(tp)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(outboxIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Following(ctx, actorIRI).Return(following, nil),
+			db.EXPECT().Update(ctx, following),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 1)
+		assertEqual(t, items.At(0).GetIRI().String(), testFederatedActorIRI3)
+		follow, ok := delivered.(vocab.ActivityStreamsFollow)
+		assertEqual(t, ok, true)
+		assertEqual(t, follow.GetActivityStreamsActor().At(0).GetIRI().String(), testMyActorIRI)
+		assertEqual(t, follow.GetActivityStreamsObject().At(0).GetIRI().String(), testFederatedActorIRI2)
+	})
+	t.Run("DoesNotRefollowIfNotFollowingOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w := setupFn(ctl)
+		w.RefollowOnMove = true
+		expectVerified(tp)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(outboxIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Following(ctx, actorIRI).Return(streams.NewActivityStreamsCollection(), nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("CallsMigrateAccount", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		var gotOrigin, gotTarget *url.URL
+		w.MigrateAccount = func(c context.Context, origin, target *url.URL) error {
+			gotOrigin, gotTarget = origin, target
+			return nil
+		}
+		expectVerified(tp)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, gotOrigin.String(), testFederatedActorIRI)
+		assertEqual(t, gotTarget.String(), testFederatedActorIRI2)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		called := false
+		w.Move = func(c context.Context, a vocab.ActivityStreamsMove) error {
+			called = true
+			return nil
+		}
+		expectVerified(tp)
+		// Run
+		err := w.move(ctx, NewMove(originIRI, targetIRI, nil))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, called, true)
+	})
+}
+
+func TestFederatedFlag(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	reporterIRI := mustParse(testFederatedActorIRI)
+	noteIRI := mustParse(testNoteId1)
+	note := streams.NewActivityStreamsNote()
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, r *testModerationReporter, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		r = &testModerationReporter{}
+		w = FederatingWrappedCallbacks{
+			ModerationReporter: r,
+			db:                 db,
+			inboxIRI:           inboxIRI,
+			newTransport: func(c context.Context, a *url.URL, s string) (Transport, error) {
+				return NewMockTransport(ctl), nil
+			},
+			seen: NewMemorySeenStore(cl, DefaultSeenTTL),
+		}
+		return
+	}
+	expectOwnedNote := func(db *MockDatabase) {
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Exists(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+	}
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		w := FederatingWrappedCallbacks{}
+		err := w.flag(ctx, streams.NewActivityStreamsFlag())
+		assertEqual(t, err, ErrObjectRequired)
+	})
+	t.Run("ReportsToModerationReporter", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, r, w := setupFn(ctl)
+		expectOwnedNote(db)
+		// Run
+		err := w.flag(ctx, newTestFlag(testNewActivityIRI, reporterIRI, "spam", noteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(r.reports), 1)
+		assertEqual(t, r.reports[0].Comment, "spam")
+		assertEqual(t, r.reports[0].Objects[0], note)
+	})
+	t.Run("IgnoresRepeatedReport", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, r, w := setupFn(ctl)
+		expectOwnedNote(db)
+		expectOwnedNote(db)
+		// Run
+		err := w.flag(ctx, newTestFlag(testNewActivityIRI, reporterIRI, "spam", noteIRI))
+		assertEqual(t, err, nil)
+		err = w.flag(ctx, newTestFlag(testFederatedActivityIRI, reporterIRI, "spam", noteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(r.reports), 1)
+	})
+	t.Run("CallsCustomCallback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, w := setupFn(ctl)
+		called := false
+		w.Flag = func(c context.Context, a vocab.ActivityStreamsFlag) error {
+			called = true
+			return nil
+		}
+		expectOwnedNote(db)
+		// Run
+		err := w.flag(ctx, newTestFlag(testNewActivityIRI, reporterIRI, "", noteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, called, true)
+	})
+}
+
+func TestFederatedTravel(t *testing.T) {
+	ctx := context.Background()
+	t.Run("CallsCustomCallbackWithoutObject", func(t *testing.T) {
+		called := false
+		w := FederatingWrappedCallbacks{
+			Travel: func(c context.Context, a vocab.ActivityStreamsTravel) error {
+				called = true
+				return nil
+			},
+		}
+		err := w.travel(ctx, streams.NewActivityStreamsTravel())
+		assertEqual(t, err, nil)
+		assertEqual(t, called, true)
+	})
+}
+
+func TestFederatedVocabularyCallbacks(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ResolvesWrappedCallback", func(t *testing.T) {
+		called := false
+		w := FederatingWrappedCallbacks{
+			Question: func(c context.Context, a vocab.ActivityStreamsQuestion) error {
+				called = true
+				return nil
+			},
+		}
+		res, err := streams.NewTypeResolver(w.callbacks(nil)...)
+		assertEqual(t, err, nil)
+		err = res.Resolve(ctx, streams.NewActivityStreamsQuestion())
+		assertEqual(t, err, nil)
+		assertEqual(t, called, true)
+	})
+	t.Run("OtherOverridesDefault", func(t *testing.T) {
+		called := false
+		w := FederatingWrappedCallbacks{}
+		other := func(c context.Context, a vocab.ActivityStreamsFlag) error {
+			called = true
+			return nil
+		}
+		res, err := streams.NewTypeResolver(w.callbacks([]interface{}{other})...)
+		assertEqual(t, err, nil)
+		err = res.Resolve(ctx, streams.NewActivityStreamsFlag())
+		assertEqual(t, err, nil)
+		assertEqual(t, called, true)
+	})
+}
+
+func TestFederatedFollowPolicy(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	outboxIRI := mustParse(testMyOutboxIRI)
+	actorIRI := mustParse(testMyActorIRI)
+	followerIRI := mustParse(testFederatedActorIRI)
+	newFollow := func() vocab.ActivityStreamsFollow {
+		follow := streams.NewActivityStreamsFollow()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(followerIRI)
+		follow.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(actorIRI)
+		follow.SetActivityStreamsObject(op)
+		return follow
+	}
+	setupFn := func(ctl *gomock.Controller, decision FollowDecision) (db *MockDatabase, w FederatingWrappedCallbacks, delivered *[]Activity) {
+		db = NewMockDatabase(ctl)
+		delivered = &[]Activity{}
+		w = FederatingWrappedCallbacks{
+			OnFollow: OnFollowAutomaticallyReject,
+			FollowPolicy: func(c context.Context, f vocab.ActivityStreamsFollow) (FollowDecision, error) {
+				return decision, nil
+			},
+			db:        db,
+			inboxIRI:  inboxIRI,
+			addNewIds: func(c context.Context, a Activity) error { return nil },
+			deliver: func(c context.Context, o *url.URL, a Activity) error {
+				assertEqual(t, o, outboxIRI)
+				*delivered = append(*delivered, a)
+				return nil
+			},
+		}
+		return
+	}
+	t.Run("PendingDoesNothing", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, delivered := setupFn(ctl, FollowPending)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := w.follow(ctx, newFollow())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*delivered), 0)
+	})
+	t.Run("AcceptUpdatesFollowersAndDelivers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, delivered := setupFn(ctl, FollowAccept)
+		followers := streams.NewActivityStreamsCollection()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Followers(ctx, actorIRI).Return(followers, nil),
+			db.EXPECT().Update(ctx, followers).Return(nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(outboxIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := w.follow(ctx, newFollow())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*delivered), 1)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsAccept((*delivered)[0]), true)
+		assertEqual(t, followers.GetActivityStreamsItems().Len(), 1)
+		assertEqual(t, followers.GetActivityStreamsItems().At(0).GetIRI().String(), followerIRI.String())
+	})
+	t.Run("RejectDelivers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, w, delivered := setupFn(ctl, FollowReject)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(outboxIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := w.follow(ctx, newFollow())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(*delivered), 1)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsReject((*delivered)[0]), true)
+	})
+}
@@ -0,0 +1,105 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// FilterAction is the action a Filter decides to take on an inbound activity.
+type FilterAction int
+
+const (
+	// FilterActionAccept processes the activity normally.
+	FilterActionAccept FilterAction = iota
+	// FilterActionDrop responds to the peer as if the activity was
+	// accepted, but neither adds it to the inbox nor applies its side
+	// effects.
+	FilterActionDrop
+	// FilterActionReject responds to the peer with the verdict's status
+	// code, without adding the activity to the inbox or applying its side
+	// effects.
+	FilterActionReject
+)
+
+// String returns the lowercase name of the action.
+func (f FilterAction) String() string {
+	switch f {
+	case FilterActionAccept:
+		return "accept"
+	case FilterActionDrop:
+		return "drop"
+	case FilterActionReject:
+		return "reject"
+	default:
+		return fmt.Sprintf("action(%d)", int(f))
+	}
+}
+
+// Verdict is the decision of a Filter about an inbound activity.
+type Verdict struct {
+	// Action is what to do with the activity.
+	Action FilterAction
+	// Status is the HTTP status code written when rejecting. If zero,
+	// http.StatusForbidden is used.
+	Status int
+	// Reason explains the verdict for the application's own records, such
+	// as moderation logs. It is not sent to the peer.
+	Reason string
+}
+
+// FilterAccept returns a Verdict accepting the activity.
+func FilterAccept() Verdict {
+	return Verdict{Action: FilterActionAccept}
+}
+
+// FilterDrop returns a Verdict silently dropping the activity.
+func FilterDrop(reason string) Verdict {
+	return Verdict{Action: FilterActionDrop, Reason: reason}
+}
+
+// FilterReject returns a Verdict rejecting the activity with the HTTP status
+// code.
+func FilterReject(status int, reason string) Verdict {
+	return Verdict{Action: FilterActionReject, Status: status, Reason: reason}
+}
+
+// Filter inspects every inbound activity after the request is authenticated
+// and its actors are found not to be blocked, but before it is added to the
+// inbox or has any side effects. It is the integration point for spam and
+// abuse tooling.
+type Filter interface {
+	// FilterInbox decides what to do with the activity.
+	//
+	// If an error is returned, it is passed back to the caller of
+	// PostInbox and nothing is written to the response.
+	FilterInbox(c context.Context, activity Activity) (Verdict, error)
+}
+
+// InboxFilterer is an optional extension of the FederatingProtocol applying a
+// Filter to every inbound activity. If the FederatingProtocol is not an
+// InboxFilterer, every activity is accepted.
+type InboxFilterer interface {
+	// InboxFilter returns the Filter applied to every inbound activity
+	// before it has any side effects, or nil to apply none.
+	InboxFilter(c context.Context) Filter
+}
+
+// applyVerdict writes the response for a verdict that is not an accept.
+// Returns true if the activity is to be processed.
+func applyVerdict(w http.ResponseWriter, v Verdict) bool {
+	switch v.Action {
+	case FilterActionDrop:
+		w.WriteHeader(http.StatusOK)
+		return false
+	case FilterActionReject:
+		status := v.Status
+		if status == 0 {
+			status = http.StatusForbidden
+		}
+		w.WriteHeader(status)
+		return false
+	default:
+		return true
+	}
+}
@@ -2,7 +2,6 @@ package pub
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"net/http"
@@ -44,6 +43,11 @@ type AuthenticateFunc func(c context.Context, w http.ResponseWriter, r *http.Req
 // Strips retrieved ActivityStreams values of sensitive fields ('bto' and 'bcc')
 // before responding with them. Sets the appropriate HTTP status code for
 // Tombstone Activities as well.
+//
+// Values that are not public are only served to the actor that signed the
+// request, as stored in the context by AuthorizedFetch, if it owns them, is
+// addressed by them, or follows an owner whose followers they address.
+// Otherwise, http.StatusNotFound is written.
 func NewActivityStreamsHandler(authFn AuthenticateFunc, db Database, clock Clock) HandlerFunc {
 	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
 		// Do nothing if it is not an ActivityPub GET request
@@ -74,17 +78,32 @@ func NewActivityStreamsHandler(authFn AuthenticateFunc, db Database, clock Clock
 		// Unlock must have been called by this point and in every
 		// branch above
 		//
+		// Refuse values that are not public to fetchers they are not
+		// visible to, as if they did not exist.
+		viewer, _ := SignerFromContext(c)
+		if viewer == nil {
+			viewer, _ = SignerFromContext(r.Context())
+		}
+		var visible bool
+		if visible, err = mayView(c, db, t, viewer, id.Host); err != nil {
+			return
+		} else if !visible {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
 		// Remove sensitive fields.
 		clearSensitiveFields(t)
 		// Serialize the fetched value.
-		m, err := streams.Serialize(t)
+		m, err := streams.SerializeContext(c, t)
 		if err != nil {
 			return
 		}
-		raw, err := json.Marshal(m)
+		buf, err := marshalJSON(m)
 		if err != nil {
 			return
 		}
+		defer putBuffer(buf)
+		raw := buf.Bytes()
 		// Construct the response.
 		addResponseHeaders(w.Header(), clock, raw)
 		// Write the response.
@@ -0,0 +1,90 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestHook observes or changes a request of an HttpSigTransport before it
+// is signed and sent, such as to add tracing headers or a request id. The
+// request is canceled if an error is returned.
+type RequestHook func(c context.Context, r *http.Request) error
+
+// ResponseHook observes or changes the response to a request of an
+// HttpSigTransport before it is handled, such as for audit logging or to
+// record it for tests. A hook reading the body must replace it for the
+// transport to read. The response is discarded if an error is returned, which
+// is returned in its place.
+type ResponseHook func(c context.Context, r *http.Request, resp *http.Response) error
+
+// WithRequestHook returns a copy of the transport calling the hook on every
+// request, after the hooks it already calls. When double-knocking, the hooks
+// are called for every signature variant tried.
+func (h HttpSigTransport) WithRequestHook(hook RequestHook) *HttpSigTransport {
+	n := len(h.requestHooks)
+	h.requestHooks = append(h.requestHooks[:n:n], hook)
+	return &h
+}
+
+// WithResponseHook returns a copy of the transport calling the hook on every
+// response, after the hooks it already calls.
+func (h HttpSigTransport) WithResponseHook(hook ResponseHook) *HttpSigTransport {
+	n := len(h.responseHooks)
+	h.responseHooks = append(h.responseHooks[:n:n], hook)
+	return &h
+}
+
+// hookRequests returns a function making the requests of newRequest and
+// passing them to the request hooks.
+func (h HttpSigTransport) hookRequests(c context.Context, newRequest func() (*http.Request, error)) func() (*http.Request, error) {
+	if len(h.requestHooks) == 0 {
+		return newRequest
+	}
+	return func() (*http.Request, error) {
+		r, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		for _, hook := range h.requestHooks {
+			if err = hook(c, r); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	}
+}
+
+// hookResponses returns the client of the transport, passing its responses to
+// the response hooks.
+func (h HttpSigTransport) hookResponses(c context.Context) HttpClient {
+	if len(h.responseHooks) == 0 {
+		return h.client
+	}
+	return &hookedClient{
+		client: h.client,
+		c:      c,
+		hooks:  h.responseHooks,
+	}
+}
+
+// hookedClient is an HttpClient passing its responses to response hooks.
+type hookedClient struct {
+	client HttpClient
+	c      context.Context
+	hooks  []ResponseHook
+}
+
+// Do sends the request, and passes its response to the hooks.
+func (h *hookedClient) Do(r *http.Request) (*http.Response, error) {
+	resp, err := h.client.Do(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range h.hooks {
+		if err = hook(h.c, r, resp); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+	return resp, nil
+}
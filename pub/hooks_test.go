@@ -0,0 +1,100 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTransportHooks(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := NewActorKeys(mustParse(testMyActorIRI), ActorKey{Id: mustParse(testMyActorIRI + "#main-key"), PrivateKey: privKey})
+	setupFn := func(ctl *gomock.Controller) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp, err := keys.NewTransportWithOptions(client, "test", cl, SigningOptions{
+			GetHeaders: []string{"(request-target)", "date", "x-request-id"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	addRequestId := func(c context.Context, r *http.Request) error {
+		r.Header.Set("X-Request-Id", "42")
+		return nil
+	}
+	t.Run("ChangesRequestBeforeSigning", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		tp = tp.WithRequestHook(addRequestId)
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("X-Request-Id"), "42")
+			assertEqual(t, signatureParams(r)["headers"], "(request-target) date x-request-id")
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+		// Run
+		b, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), "{}")
+	})
+	t.Run("CancelsRequest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp := setupFn(ctl)
+		tp = tp.WithRequestHook(func(c context.Context, r *http.Request) error {
+			return testErr
+		})
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+	t.Run("ObservesResponses", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		var observed []string
+		tp = tp.WithRequestHook(addRequestId).WithResponseHook(func(c context.Context, r *http.Request, resp *http.Response) error {
+			observed = append(observed, r.Method+" "+resp.Status)
+			return nil
+		})
+		client.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusAccepted, Status: "202 Accepted", Body: ioutil.NopCloser(strings.NewReader(""))}, nil)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, strings.Join(observed, ","), "POST 202 Accepted")
+	})
+	t.Run("RejectsResponse", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		tp = tp.WithRequestHook(addRequestId).WithResponseHook(func(c context.Context, r *http.Request, resp *http.Response) error {
+			return testErr
+		})
+		client.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil)
+		// Run
+		_, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+}
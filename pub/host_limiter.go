@@ -0,0 +1,182 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// HostLimit limits the outbound requests made to a single peer host.
+type HostLimit struct {
+	// RequestsPerSecond is the sustained rate of requests permitted. Zero
+	// or a negative number means the rate is not limited.
+	RequestsPerSecond float64
+	// Burst is the number of requests permitted in excess of the sustained
+	// rate. Values below one are treated as one.
+	Burst int
+	// MaxConcurrent is the maximum number of requests in flight at once.
+	// Zero or a negative number means concurrency is not limited.
+	MaxConcurrent int
+}
+
+// HostLimiter applies a HostLimit to each peer host independently, so that a
+// large fan-out of deliveries does not overwhelm a single small peer or trip
+// its rate limiters.
+//
+// A single HostLimiter should be shared by every Transport of an application.
+type HostLimiter struct {
+	clock        Clock
+	defaultLimit HostLimit
+	mu           sync.Mutex
+	limits       map[string]HostLimit
+	hosts        map[string]*hostState
+}
+
+// hostState is the current usage of a single host's limits.
+type hostState struct {
+	limit  HostLimit
+	tokens float64
+	last   time.Time
+	sem    chan struct{}
+}
+
+// NewHostLimiter creates a HostLimiter applying the default limit to every
+// host without its own limit.
+func NewHostLimiter(clock Clock, defaultLimit HostLimit) *HostLimiter {
+	return &HostLimiter{
+		clock:        clock,
+		defaultLimit: defaultLimit,
+		limits:       make(map[string]HostLimit),
+		hosts:        make(map[string]*hostState),
+	}
+}
+
+// SetLimit overrides the default limit for the host. It takes effect for
+// requests acquired after it is set.
+func (h *HostLimiter) SetLimit(host string, l HostLimit) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.limits[host] = l
+	delete(h.hosts, host)
+}
+
+// state returns the usage state of the host, creating it if needed.
+//
+// Must be called while holding the lock.
+func (h *HostLimiter) state(host string) *hostState {
+	if s, ok := h.hosts[host]; ok {
+		return s
+	}
+	l, ok := h.limits[host]
+	if !ok {
+		l = h.defaultLimit
+	}
+	if l.Burst < 1 {
+		l.Burst = 1
+	}
+	s := &hostState{
+		limit:  l,
+		tokens: float64(l.Burst),
+		last:   h.clock.Now(),
+	}
+	if l.MaxConcurrent > 0 {
+		s.sem = make(chan struct{}, l.MaxConcurrent)
+	}
+	h.hosts[host] = s
+	return s
+}
+
+// Acquire blocks until a request to the host is permitted by its limit, or
+// the context is done. On success, the returned release function must be
+// called once the request completes.
+func (h *HostLimiter) Acquire(c context.Context, host string) (release func(), err error) {
+	h.mu.Lock()
+	s := h.state(host)
+	h.mu.Unlock()
+	release = func() {}
+	if s.sem != nil {
+		// Prefer a free slot over a done context.
+		select {
+		case s.sem <- struct{}{}:
+		default:
+			select {
+			case s.sem <- struct{}{}:
+			case <-c.Done():
+				return nil, c.Err()
+			}
+		}
+		release = func() { <-s.sem }
+	}
+	if s.limit.RequestsPerSecond <= 0 {
+		return
+	}
+	for {
+		h.mu.Lock()
+		now := h.clock.Now()
+		s.tokens += now.Sub(s.last).Seconds() * s.limit.RequestsPerSecond
+		if max := float64(s.limit.Burst); s.tokens > max {
+			s.tokens = max
+		}
+		s.last = now
+		if s.tokens >= 1 {
+			s.tokens--
+			h.mu.Unlock()
+			return
+		}
+		wait := time.Duration((1 - s.tokens) / s.limit.RequestsPerSecond * float64(time.Second))
+		h.mu.Unlock()
+		select {
+		case <-after(h.clock, wait):
+		case <-c.Done():
+			release()
+			return nil, c.Err()
+		}
+	}
+}
+
+// LimitedTransport is a Transport whose requests are limited per peer host by
+// a HostLimiter. Requests are made by the wrapped Transport.
+type LimitedTransport struct {
+	t       Transport
+	limiter *HostLimiter
+}
+
+// LimitedTransport must satisfy the Transport interface.
+var _ Transport = &LimitedTransport{}
+
+// NewLimitedTransport wraps a Transport so its requests are limited by the
+// HostLimiter.
+func NewLimitedTransport(t Transport, limiter *HostLimiter) *LimitedTransport {
+	return &LimitedTransport{
+		t:       t,
+		limiter: limiter,
+	}
+}
+
+// Dereference fetches the IRI once permitted by the host's limit.
+func (l *LimitedTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	release, err := l.limiter.Acquire(c, iri.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return l.t.Dereference(c, iri)
+}
+
+// Deliver sends the payload once permitted by the host's limit.
+func (l *LimitedTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	release, err := l.limiter.Acquire(c, to.Host)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return l.t.Deliver(c, b, to)
+}
+
+// BatchDeliver concurrently sends the payload to each recipient, with each
+// request waiting until permitted by its host's limit. Returns a
+// BatchDeliveryError if any of the requests had an error.
+func (l *LimitedTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return batchDeliver(c, b, recipients, l.Deliver)
+}
@@ -0,0 +1,80 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestHostLimiter(t *testing.T) {
+	host := mustParse(testFederatedActorIRI).Host
+	t.Run("LimitsConcurrency", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		l := NewHostLimiter(cl, HostLimit{MaxConcurrent: 1})
+		canceled, cancel := context.WithCancel(context.Background())
+		cancel()
+		// Run
+		release, err := l.Acquire(context.Background(), host)
+		_, blockedErr := l.Acquire(canceled, host)
+		_, otherErr := l.Acquire(canceled, "other.example.org")
+		release()
+		release2, afterErr := l.Acquire(canceled, host)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, blockedErr, context.Canceled)
+		assertEqual(t, otherErr, nil)
+		assertEqual(t, afterErr, nil)
+		release2()
+	})
+	t.Run("LimitsRate", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		current := now()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().DoAndReturn(func() time.Time { return current }).AnyTimes()
+		l := NewHostLimiter(cl, HostLimit{})
+		l.SetLimit(host, HostLimit{RequestsPerSecond: 1, Burst: 1})
+		canceled, cancel := context.WithCancel(context.Background())
+		cancel()
+		// Run
+		_, err := l.Acquire(canceled, host)
+		_, limitedErr := l.Acquire(canceled, host)
+		_, unlimitedErr := l.Acquire(canceled, "other.example.org")
+		current = current.Add(time.Second)
+		_, afterErr := l.Acquire(canceled, host)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, limitedErr, context.Canceled)
+		assertEqual(t, unlimitedErr, nil)
+		assertEqual(t, afterErr, nil)
+	})
+}
+
+func TestLimitedTransport(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	recipient2 := mustParse(testFederatedActorIRI2)
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	tp := NewMockTransport(ctl)
+	lt := NewLimitedTransport(tp, NewHostLimiter(cl, HostLimit{MaxConcurrent: 1}))
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient2).Return(testErr)
+	// Run
+	err := lt.BatchDeliver(ctx, payload, []*url.URL{recipient, recipient2})
+	// Verify
+	assertNotEqual(t, err, nil)
+}
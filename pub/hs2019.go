@@ -0,0 +1,269 @@
+package pub
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/go-fed/httpsig"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HS2019 is the algorithm label of HTTP Signatures whose algorithm is
+	// determined by the key rather than by the label. It may be returned
+	// by a PublicKeyGetter, and is used for signatures so labelled
+	// regardless of the algorithm returned.
+	//
+	// The algorithm is resolved from the key: RSASSA-PKCS1-v1_5 with
+	// SHA-256 for RSA keys, and ECDSA with SHA-256 for ECDSA keys, as
+	// the fediverse signs with.
+	HS2019 httpsig.Algorithm = "hs2019"
+	// Created is the '(created)' pseudo-header, the time at which an
+	// hs2019 signature was created.
+	Created = "(created)"
+	// Expires is the '(expires)' pseudo-header, the time at which an
+	// hs2019 signature expires.
+	Expires = "(expires)"
+)
+
+// hs2019Signer produces hs2019 signatures.
+type hs2019Signer struct {
+	clock     Clock
+	headers   []string
+	expiresIn time.Duration
+	scheme    httpsig.SignatureScheme
+}
+
+// NewHS2019Signer returns a Signer producing hs2019 signatures covering the
+// headers, which may include the RequestTarget, Created, and Expires
+// pseudo-headers. If no headers are given, the RequestTarget and Created are
+// signed. It is used like a Signer returned by httpsig.NewSigner, such as with
+// NewHttpSigTransport.
+//
+// Signatures are created at the time of the Clock and carry the 'created'
+// parameter. If expiresIn is positive, they also carry the 'expires'
+// parameter, which is required to sign the Expires pseudo-header. If the
+// headers include "digest" and a request has a body but no Digest header,
+// its SHA-256 digest is added.
+//
+// The private key must be an *rsa.PrivateKey, an *ecdsa.PrivateKey, or a
+// crypto.Signer whose public key is either, such as a key held in a hardware
+// security module.
+func NewHS2019Signer(clock Clock, headers []string, expiresIn time.Duration, scheme httpsig.SignatureScheme) httpsig.Signer {
+	if len(headers) == 0 {
+		headers = []string{httpsig.RequestTarget, Created}
+	}
+	return &hs2019Signer{
+		clock:     clock,
+		headers:   headers,
+		expiresIn: expiresIn,
+		scheme:    scheme,
+	}
+}
+
+// SignRequest signs the request with the private key.
+func (s *hs2019Signer) SignRequest(pKey crypto.PrivateKey, pubKeyId string, r *http.Request, body []byte) error {
+	if body != nil && len(r.Header.Get("Digest")) == 0 && containsHeader(s.headers, "digest") {
+		sum := sha256.Sum256(body)
+		r.Header.Set("Digest", "SHA-256="+base64.StdEncoding.EncodeToString(sum[:]))
+	}
+	return s.sign(pKey, pubKeyId, r.Header, r)
+}
+
+// SignResponse signs the response with the private key. The RequestTarget
+// pseudo-header cannot be signed in a response.
+func (s *hs2019Signer) SignResponse(pKey crypto.PrivateKey, pubKeyId string, w http.ResponseWriter) error {
+	return s.sign(pKey, pubKeyId, w.Header(), nil)
+}
+
+// sign sets the signature header in h, signing the headers of the request or,
+// if r is nil, of a response.
+func (s *hs2019Signer) sign(pKey crypto.PrivateKey, pubKeyId string, h http.Header, r *http.Request) error {
+	now := s.clock.Now()
+	params := map[string]string{
+		"created": strconv.FormatInt(now.Unix(), 10),
+	}
+	if s.expiresIn > 0 {
+		params["expires"] = strconv.FormatInt(now.Add(s.expiresIn).Unix(), 10)
+	}
+	str, err := hs2019SigningString(h, r, s.headers, params)
+	if err != nil {
+		return err
+	}
+	sig, err := signWithKey(pKey, str)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "keyId=%q,algorithm=%q,created=%s", pubKeyId, HS2019, params["created"])
+	if v, ok := params["expires"]; ok {
+		fmt.Fprintf(&b, ",expires=%s", v)
+	}
+	fmt.Fprintf(&b, ",headers=%q,signature=%q", strings.ToLower(strings.Join(s.headers, " ")), base64.StdEncoding.EncodeToString(sig))
+	v := b.String()
+	if s.scheme == httpsig.Authorization {
+		v = "Signature " + v
+	}
+	h.Add(string(s.scheme), v)
+	return nil
+}
+
+// isHS2019 determines whether the signature of the request is labelled hs2019
+// or covers the Created or Expires pseudo-headers, which httpsig cannot
+// verify.
+func isHS2019(r *http.Request) bool {
+	params := signatureParams(r)
+	if params["algorithm"] == string(HS2019) {
+		return true
+	}
+	headers := strings.Fields(params["headers"])
+	return containsHeader(headers, Created) || containsHeader(headers, Expires)
+}
+
+// verifyRequest verifies the signature of the request with the public key:
+// an HTTP Message Signature with the algorithm of the key, an HTTP Signature
+// as hs2019 if the signature or algorithm is, and with the algorithm
+// otherwise.
+func verifyRequest(r *http.Request, v httpsig.Verifier, pubKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	if _, ok := v.(*messageVerifier); ok {
+		return v.Verify(pubKey, algo)
+	} else if algo == HS2019 || isHS2019(r) {
+		return verifyHS2019(r, pubKey)
+	}
+	return v.Verify(pubKey, algo)
+}
+
+// verifyHS2019 verifies the hs2019 signature of the request with the public
+// key, resolving the algorithm from the key.
+//
+// Whether the signature is recent is not checked; see checkSignatureTime.
+func verifyHS2019(r *http.Request, pubKey crypto.PublicKey) error {
+	params := signatureParams(r)
+	headers := strings.Fields(params["headers"])
+	if len(headers) == 0 {
+		headers = []string{Created}
+	}
+	str, err := hs2019SigningString(r.Header, r, headers, params)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil {
+		return err
+	}
+	return verifyWithKey(pubKey, str, sig)
+}
+
+// verifyWithKey verifies the signature of the string with the public key,
+// resolving the algorithm from the key. ECDSA signatures are ASN.1 encoded.
+func verifyWithKey(pubKey crypto.PublicKey, str string, sig []byte) error {
+	digest := sha256.Sum256([]byte(str))
+	switch k := pubKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+	case *ecdsa.PublicKey:
+		var es struct{ R, S *big.Int }
+		if rest, err := asn1.Unmarshal(sig, &es); err != nil {
+			return err
+		} else if len(rest) > 0 {
+			return errors.New("trailing data after ECDSA signature")
+		}
+		if !ecdsa.Verify(k, digest[:], es.R, es.S) {
+			return errors.New("invalid http signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot verify signature with key of type %T", pubKey)
+	}
+}
+
+// signWithKey signs the string with the private key, resolving the algorithm
+// from the key. ECDSA signatures are ASN.1 encoded.
+func signWithKey(pKey crypto.PrivateKey, str string) ([]byte, error) {
+	signer, ok := pKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("cannot sign with key of type %T", pKey)
+	}
+	switch signer.Public().(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		digest := sha256.Sum256([]byte(str))
+		return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("cannot sign with public key of type %T", signer.Public())
+	}
+}
+
+// hs2019SigningString returns the string signed for the headers, with the
+// pseudo-headers taken from the request, which is nil for a response, and
+// from the 'created' and 'expires' signature parameters.
+func hs2019SigningString(h http.Header, r *http.Request, headers []string, params map[string]string) (string, error) {
+	lines := make([]string, 0, len(headers))
+	for _, name := range headers {
+		name = strings.ToLower(name)
+		var value string
+		switch name {
+		case httpsig.RequestTarget:
+			if r == nil {
+				return "", fmt.Errorf("cannot sign %q on anything other than a request", name)
+			}
+			value = strings.ToLower(r.Method) + " " + r.URL.RequestURI()
+		case Created, Expires:
+			v, ok := params[strings.Trim(name, "()")]
+			if !ok {
+				return "", fmt.Errorf("missing %q signature parameter for %s", strings.Trim(name, "()"), name)
+			}
+			value = v
+		default:
+			v, err := fieldValue(h, r, name)
+			if err != nil {
+				return "", err
+			}
+			value = v
+		}
+		lines = append(lines, name+": "+value)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// fieldValue returns the values of the header of the request, which is nil
+// for a response, or of the response header h, trimmed and joined by commas.
+// The host of a request is taken from the request itself when it has no Host
+// header, as is the case of outgoing requests.
+func fieldValue(h http.Header, r *http.Request, name string) (string, error) {
+	values, ok := h[http.CanonicalHeaderKey(name)]
+	if !ok && strings.EqualFold(name, "host") && r != nil {
+		if len(r.Host) > 0 {
+			return r.Host, nil
+		}
+		return r.URL.Host, nil
+	} else if !ok {
+		return "", fmt.Errorf("missing header %q", name)
+	}
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+	}
+	return strings.Join(trimmed, ", "), nil
+}
+
+// containsHeader determines whether the header, compared case-insensitively,
+// is in the list.
+func containsHeader(headers []string, header string) bool {
+	for _, h := range headers {
+		if strings.EqualFold(h, header) {
+			return true
+		}
+	}
+	return false
+}
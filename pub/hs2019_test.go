@@ -0,0 +1,118 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHS2019(t *testing.T) {
+	ctx := context.Background()
+	keyId := testFederatedActorIRI + "#main-key"
+	actorIRI := mustParse(testFederatedActorIRI)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newSignedRequest := func(ctl *gomock.Controller, privKey crypto.PrivateKey, headers []string, expiresIn time.Duration) *http.Request {
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		r := httptest.NewRequest("POST", testMyInboxIRI, strings.NewReader("{}"))
+		r.Header.Set("Date", nowDateHeader())
+		s := NewHS2019Signer(cl, headers, expiresIn, httpsig.Signature)
+		if err := s.SignRequest(privKey, keyId, r, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	getKey := func(pubKey crypto.PublicKey) PublicKeyGetter {
+		return func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+			return pubKey, httpsig.RSA_SHA256, actorIRI, nil
+		}
+	}
+	t.Run("SignsWithCreatedAndExpires", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		// Run
+		r := newSignedRequest(ctl, rsaKey, []string{httpsig.RequestTarget, Created, Expires, "host", "digest"}, time.Minute)
+		// Verify
+		params := signatureParams(r)
+		assertEqual(t, params["algorithm"], string(HS2019))
+		assertEqual(t, params["keyId"], keyId)
+		assertEqual(t, params["headers"], "(request-target) (created) (expires) host digest")
+		assertNotEqual(t, params["created"], "")
+		assertNotEqual(t, params["expires"], "")
+		assertEqual(t, r.Header.Get("Digest"), "SHA-256=RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o=")
+	})
+	t.Run("VerifiesRSA", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, rsaKey, []string{httpsig.RequestTarget, Created, Expires, "host", "digest"}, time.Minute)
+		// Run
+		signer, err := verifySignature(ctx, r, getKey(rsaKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("VerifiesECDSA", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, ecKey, []string{httpsig.RequestTarget, Created, "digest"}, 0)
+		// Run
+		signer, err := verifySignature(ctx, r, getKey(ecKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsTamperedHeader", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, rsaKey, []string{httpsig.RequestTarget, Created, "date"}, 0)
+		r.Header.Set("Date", now().Add(time.Hour).UTC().Format(http.TimeFormat))
+		// Run
+		_, err := verifySignature(ctx, r, getKey(rsaKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+	})
+	t.Run("RejectsExpiresWithoutParameter", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		r := httptest.NewRequest("GET", testNoteId1, nil)
+		s := NewHS2019Signer(cl, []string{httpsig.RequestTarget, Expires}, 0, httpsig.Signature)
+		// Run
+		err := s.SignRequest(rsaKey, keyId, r, nil)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("VerifiesLegacySignatures", func(t *testing.T) {
+		// Setup
+		r := mustSignedGetRequest(testNoteId1, keyId, rsaKey)
+		// Run
+		signer, err := verifySignature(ctx, r, getKey(rsaKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+}
@@ -0,0 +1,151 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// idTemplateType is replaced in a TemplateIdMinter's templates with the
+	// lowercase type name of the value.
+	idTemplateType = "{type}"
+	// idTemplateToken is replaced in a TemplateIdMinter's templates with
+	// the token of the value.
+	idTemplateToken = "{id}"
+	// crockfordBase32 is the alphabet of ULIDs.
+	crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// IdMinterFunc is an IdMinter implemented by a function.
+type IdMinterFunc func(c context.Context, t vocab.Type) (*url.URL, error)
+
+// IdMinterFunc must satisfy the IdMinter interface.
+var _ IdMinter = IdMinterFunc(nil)
+
+// NewId calls the function.
+func (f IdMinterFunc) NewId(c context.Context, t vocab.Type) (*url.URL, error) {
+	return f(c, t)
+}
+
+// IdTokenFunc generates the unique part of a new id for the value.
+type IdTokenFunc func(c context.Context, t vocab.Type) (string, error)
+
+// SequentialIdTokens returns an IdTokenFunc generating increasing decimal
+// numbers beginning at start. The counter is held in memory, so the
+// application must persist the last number and pass the next one here when it
+// restarts.
+func SequentialIdTokens(start uint64) IdTokenFunc {
+	next := start - 1
+	return func(c context.Context, t vocab.Type) (string, error) {
+		return strconv.FormatUint(atomic.AddUint64(&next, 1), 10), nil
+	}
+}
+
+// ULIDIdTokens returns an IdTokenFunc generating ULIDs, which sort by the
+// clock's time of their creation.
+func ULIDIdTokens(clock Clock) IdTokenFunc {
+	return func(c context.Context, t vocab.Type) (string, error) {
+		var b [16]byte
+		ms := uint64(clock.Now().UnixNano() / 1e6)
+		for i := 0; i < 6; i++ {
+			b[i] = byte(ms >> uint(40-8*i))
+		}
+		if _, err := rand.Read(b[6:]); err != nil {
+			return "", err
+		}
+		return encodeULID(b), nil
+	}
+}
+
+// encodeULID encodes the 128 bits as 26 characters of Crockford's base32.
+func encodeULID(b [16]byte) string {
+	out := make([]byte, 26)
+	// The first character holds the 3 most significant bits, followed by
+	// 25 characters of 5 bits each. Characters are filled from the least
+	// significant bits.
+	var acc uint
+	var bits uint
+	i := 25
+	for j := 15; j >= 0; j-- {
+		acc |= uint(b[j]) << bits
+		bits += 8
+		for bits >= 5 && i >= 0 {
+			out[i] = crockfordBase32[acc&31]
+			acc >>= 5
+			bits -= 5
+			i--
+		}
+	}
+	out[0] = crockfordBase32[acc&31]
+	return string(out)
+}
+
+// ContentHashIdTokens returns an IdTokenFunc generating the hex encoded
+// SHA-256 hash of the value's serialization, without its id. Identical values
+// receive identical tokens, so it suits content-addressed storage, and values
+// meant to be distinct must differ, such as by their published time.
+func ContentHashIdTokens() IdTokenFunc {
+	return func(c context.Context, t vocab.Type) (string, error) {
+		m, err := t.Serialize()
+		if err != nil {
+			return "", err
+		}
+		delete(m, "id")
+		b, err := json.Marshal(m)
+		if err != nil {
+			return "", err
+		}
+		h := sha256.Sum256(b)
+		return hex.EncodeToString(h[:]), nil
+	}
+}
+
+// TemplateIdMinter is an IdMinter building ids from URL templates chosen by
+// the value's type, such as "https://example.com/notes/{id}". In a template,
+// "{id}" is replaced with a token and "{type}" with the lowercase type name.
+type TemplateIdMinter struct {
+	fallback  string
+	templates map[string]string
+	token     IdTokenFunc
+}
+
+// TemplateIdMinter must satisfy the IdMinter interface.
+var _ IdMinter = &TemplateIdMinter{}
+
+// NewTemplateIdMinter creates a TemplateIdMinter using the templates keyed by
+// ActivityStreams type name, the fallback template for other types, and the
+// IdTokenFunc.
+func NewTemplateIdMinter(fallback string, templates map[string]string, token IdTokenFunc) *TemplateIdMinter {
+	return &TemplateIdMinter{
+		fallback:  fallback,
+		templates: templates,
+		token:     token,
+	}
+}
+
+// NewId builds the id of the value from its type's template.
+func (m *TemplateIdMinter) NewId(c context.Context, t vocab.Type) (*url.URL, error) {
+	tmpl, ok := m.templates[t.GetTypeName()]
+	if !ok {
+		tmpl = m.fallback
+	}
+	if len(tmpl) == 0 {
+		return nil, fmt.Errorf("no id template for type %s", t.GetTypeName())
+	}
+	token, err := m.token(c, t)
+	if err != nil {
+		return nil, err
+	}
+	s := strings.Replace(tmpl, idTemplateType, url.PathEscape(strings.ToLower(t.GetTypeName())), -1)
+	s = strings.Replace(s, idTemplateToken, url.PathEscape(token), -1)
+	return url.Parse(s)
+}
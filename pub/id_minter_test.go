@@ -0,0 +1,85 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSequentialIdTokens(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	tokens := SequentialIdTokens(41)
+	// Run
+	first, firstErr := tokens(ctx, streams.NewActivityStreamsNote())
+	second, secondErr := tokens(ctx, streams.NewActivityStreamsNote())
+	// Verify
+	assertEqual(t, firstErr, nil)
+	assertEqual(t, first, "41")
+	assertEqual(t, secondErr, nil)
+	assertEqual(t, second, "42")
+}
+
+func TestULIDIdTokens(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	gomock.InOrder(
+		cl.EXPECT().Now().Return(time.Unix(1469918176, 385000000)),
+		cl.EXPECT().Now().Return(time.Unix(1469918177, 0)),
+	)
+	tokens := ULIDIdTokens(cl)
+	// Run
+	first, firstErr := tokens(ctx, streams.NewActivityStreamsNote())
+	second, secondErr := tokens(ctx, streams.NewActivityStreamsNote())
+	// Verify
+	assertEqual(t, firstErr, nil)
+	assertEqual(t, secondErr, nil)
+	if !regexp.MustCompile("^[0-7][0-9A-HJKMNP-TV-Z]{25}$").MatchString(first) {
+		t.Fatalf("not a ULID: %s", first)
+	}
+	// The timestamp of the ULID specification's example.
+	assertEqual(t, first[:10], "01ARYZ6S41")
+	if first >= second {
+		t.Fatalf("expected %s < %s", first, second)
+	}
+}
+
+func TestContentHashIdTokens(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	setupData()
+	tokens := ContentHashIdTokens()
+	// Run
+	withId, withIdErr := tokens(ctx, testMyNote)
+	withoutId, withoutIdErr := tokens(ctx, testMyNoteNoId)
+	other, otherErr := tokens(ctx, testFederatedNote)
+	// Verify
+	assertEqual(t, withIdErr, nil)
+	assertEqual(t, withoutIdErr, nil)
+	assertEqual(t, otherErr, nil)
+	assertEqual(t, len(withId), 64)
+	assertEqual(t, withId, withoutId)
+	assertNotEqual(t, withId, other)
+}
+
+func TestTemplateIdMinter(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	m := NewTemplateIdMinter("https://example.com/{type}/{id}", map[string]string{
+		"Note": "https://example.com/notes/{id}",
+	}, SequentialIdTokens(1))
+	// Run
+	note, noteErr := m.NewId(ctx, streams.NewActivityStreamsNote())
+	create, createErr := m.NewId(ctx, streams.NewActivityStreamsCreate())
+	// Verify
+	assertEqual(t, noteErr, nil)
+	assertEqual(t, note.String(), "https://example.com/notes/1")
+	assertEqual(t, createErr, nil)
+	assertEqual(t, create.String(), "https://example.com/create/2")
+}
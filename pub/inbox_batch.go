@@ -0,0 +1,132 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// InboxBatcher is an optional extension of the FederatingProtocol, or of a
+// DelegateActor, accepting POSTs to the inbox whose body is a JSON array of
+// activities, as some relays deliver.
+//
+// Each activity of a batch is authorized, has its side effects applied, and is
+// forwarded independently, as if POSTed on its own with the same HTTP
+// Signature. The response lists the InboxBatchResult of each activity, with an
+// http.StatusOK if every activity was accepted and an http.StatusMultiStatus
+// otherwise.
+type InboxBatcher interface {
+	// MaxInboxBatch returns the largest number of activities accepted in a
+	// batch. Larger batches are rejected with an
+	// http.StatusRequestEntityTooLarge. If not positive, batches are not
+	// accepted.
+	MaxInboxBatch(c context.Context) int
+}
+
+// InboxBatchResult is the outcome of one activity of a batch POSTed to an
+// inbox.
+type InboxBatchResult struct {
+	// Id is the id of the activity, if it has one.
+	Id string `json:"id,omitempty"`
+	// Status is the status code with which the activity would have been
+	// answered had it been POSTed on its own.
+	Status int `json:"status"`
+	// Error describes why the activity could not be handled, if the
+	// application failed to.
+	Error string `json:"error,omitempty"`
+}
+
+// MaxInboxBatch defers to the FederatingProtocol if it is an InboxBatcher, and
+// otherwise does not accept batches.
+func (a *sideEffectActor) MaxInboxBatch(c context.Context) int {
+	if b, ok := a.s2s.(InboxBatcher); ok {
+		return b.MaxInboxBatch(c)
+	}
+	return 0
+}
+
+// postInboxBatch handles a batch of activities POSTed to an actor's inbox by an
+// authenticated peer. An error is only returned if the response cannot be
+// written.
+func (b *baseActor) postInboxBatch(c context.Context, w http.ResponseWriter, r *http.Request, raw []byte, max int, remoteHost LogField) error {
+	var batch []interface{}
+	if err := json.Unmarshal(raw, &batch); err != nil || len(batch) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil
+	} else if len(batch) > max {
+		logEntry(c, LogLevelInfo, "rejected oversized inbox batch", remoteHost,
+			LogField{Key: "size", Value: len(batch)})
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return nil
+	}
+	results := make([]InboxBatchResult, len(batch))
+	status := http.StatusOK
+	for i, v := range batch {
+		res := &results[i]
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			res.Status = http.StatusBadRequest
+			status = http.StatusMultiStatus
+			continue
+		}
+		res.Id, _ = m[idProperty].(string)
+		rec := &statusRecorder{header: make(http.Header)}
+		if err := b.postInboxValue(c, rec, r, m, remoteHost); err != nil {
+			res.Status = ErrorStatus(err)
+			res.Error = err.Error()
+		} else {
+			res.Status = rec.status
+		}
+		if res.Status != http.StatusOK && res.Status != http.StatusAccepted {
+			status = http.StatusMultiStatus
+		}
+	}
+	out, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	w.Header().Set(contentTypeHeader, "application/json")
+	w.WriteHeader(status)
+	n, err := w.Write(out)
+	if err != nil {
+		return err
+	} else if n != len(out) {
+		return fmt.Errorf("only wrote %d of %d bytes", n, len(out))
+	}
+	return nil
+}
+
+// isJSONArray determines whether the JSON document is an array.
+func isJSONArray(raw []byte) bool {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// statusRecorder is a ResponseWriter recording the status written for one
+// activity of a batch, and discarding its body.
+type statusRecorder struct {
+	header http.Header
+	status int
+}
+
+// Header returns the headers, which are discarded.
+func (s *statusRecorder) Header() http.Header {
+	return s.header
+}
+
+// Write discards the body, writing an http.StatusOK if no status was.
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return len(b), nil
+}
+
+// WriteHeader records the status, unless one already was.
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+}
@@ -0,0 +1,151 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testInboxBatchingDelegate is a DelegateActor accepting inbox batches.
+type testInboxBatchingDelegate struct {
+	*MockDelegateActor
+	max int
+}
+
+func (d *testInboxBatchingDelegate) MaxInboxBatch(c context.Context) int {
+	return d.max
+}
+
+// toPostInboxBatchRequest creates a POST request to the inbox with the JSON
+// array of the values.
+func toPostInboxBatchRequest(values ...interface{}) *http.Request {
+	b, err := json.Marshal(values)
+	if err != nil {
+		panic(err)
+	}
+	return toAPRequest(httptest.NewRequest("POST", testMyInboxIRI, bytes.NewBuffer(b)))
+}
+
+func TestPostInboxBatch(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller, max int) (delegate *MockDelegateActor, a FederatingActor) {
+		setupData()
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(&testInboxBatchingDelegate{MockDelegateActor: delegate, max: max}, false, true, NewMockClock(ctl))
+		return
+	}
+	serialize := func(id string, v vocab.Type) map[string]interface{} {
+		m, err := streams.Serialize(v)
+		if err != nil {
+			panic(err)
+		}
+		m[idProperty] = id
+		return m
+	}
+	t.Run("HandlesEachActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, 10)
+		req := toPostInboxBatchRequest(
+			serialize(testFederatedActivityIRI, testCreate),
+			serialize(testFederatedActivityIRI2, testListen),
+			"https://other.example.com/not/an/activity")
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil).Times(2)
+		delegate.EXPECT().AuthorizePostInbox(ctx, gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+		gomock.InOrder(
+			delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil),
+			delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(ErrObjectRequired),
+		)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil)
+		// Run
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusMultiStatus)
+		var results []InboxBatchResult
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &results), nil)
+		assertEqual(t, len(results), 3)
+		assertEqual(t, results[0], InboxBatchResult{Id: testFederatedActivityIRI, Status: http.StatusOK})
+		assertEqual(t, results[1], InboxBatchResult{Id: testFederatedActivityIRI2, Status: http.StatusBadRequest})
+		assertEqual(t, results[2], InboxBatchResult{Status: http.StatusBadRequest})
+	})
+	t.Run("ReportsApplicationErrors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, 10)
+		req := toPostInboxBatchRequest(serialize(testFederatedActivityIRI, testCreate))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(testErr)
+		// Run
+		_, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusMultiStatus)
+		var results []InboxBatchResult
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &results), nil)
+		assertEqual(t, results[0].Status, http.StatusInternalServerError)
+		assertEqual(t, results[0].Error, testErr.Error())
+	})
+	t.Run("RejectsOversizedBatch", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, 1)
+		req := toPostInboxBatchRequest(
+			serialize(testFederatedActivityIRI, testCreate),
+			serialize(testFederatedActivityIRI2, testListen))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run
+		_, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusRequestEntityTooLarge)
+	})
+	t.Run("ErrorIfBatchesNotAccepted", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, 0)
+		req := toPostInboxBatchRequest(serialize(testFederatedActivityIRI, testCreate))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run
+		_, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestSideEffectActorMaxInboxBatch(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	a := &sideEffectActor{s2s: NewMockFederatingProtocol(ctl)}
+	assertEqual(t, a.MaxInboxBatch(context.Background()), 0)
+	a.s2s = &testBatchingFederatingProtocol{MockFederatingProtocol: NewMockFederatingProtocol(ctl)}
+	assertEqual(t, a.MaxInboxBatch(context.Background()), 20)
+}
+
+// testBatchingFederatingProtocol is a FederatingProtocol accepting batches of
+// up to 20 activities.
+type testBatchingFederatingProtocol struct {
+	*MockFederatingProtocol
+}
+
+func (p *testBatchingFederatingProtocol) MaxInboxBatch(c context.Context) int {
+	return 20
+}
@@ -0,0 +1,266 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// publicKeyFragment is the fragment of an actor's id identifying its
+	// public key.
+	publicKeyFragment = "main-key"
+)
+
+// InstanceActor is a Service actor representing the server itself rather than
+// any one of its users.
+//
+// Peers requiring signed fetches, such as Mastodon in secure mode, refuse to
+// serve even an actor's public key to an unsigned request. The instance actor
+// signs the requests made when no user is involved, such as when fetching the
+// key needed to verify an incoming HTTP Signature, and serves its own actor
+// document without requiring a signature so that peers can verify it in turn.
+type InstanceActor struct {
+	id                *url.URL
+	inbox             *url.URL
+	outbox            *url.URL
+	preferredUsername string
+	keys              *ActorKeys
+}
+
+// NewInstanceActor creates an InstanceActor with the given id, inbox, and
+// outbox, signing with the private key. Its public key id is the actor's id
+// with a "#main-key" fragment.
+//
+// The private key must be persisted by the application, as peers cache the
+// public key. GenerateActorKey creates a new one, and MarshalActorKey and
+// ParseActorKey encode it for storage.
+func NewInstanceActor(id, inbox, outbox *url.URL, preferredUsername string, privKey *rsa.PrivateKey) *InstanceActor {
+	keyId := *id
+	keyId.Fragment = publicKeyFragment
+	return &InstanceActor{
+		id:                id,
+		inbox:             inbox,
+		outbox:            outbox,
+		preferredUsername: preferredUsername,
+		keys:              NewActorKeys(id, ActorKey{Id: &keyId, PrivateKey: privKey}),
+	}
+}
+
+// Id returns the id of the instance actor.
+func (i *InstanceActor) Id() *url.URL {
+	return i.id
+}
+
+// Keys returns the keys of the instance actor, such as to rotate them.
+func (i *InstanceActor) Keys() *ActorKeys {
+	return i.keys
+}
+
+// PublicKeyId returns the id of the instance actor's active public key.
+func (i *InstanceActor) PublicKeyId() *url.URL {
+	return i.keys.Active().Id
+}
+
+// ActivityStreams returns the actor document of the instance actor.
+func (i *InstanceActor) ActivityStreams() (vocab.ActivityStreamsService, error) {
+	pk, err := i.keys.PublicKeyProperty()
+	if err != nil {
+		return nil, err
+	}
+	s := streams.NewActivityStreamsService()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(i.id)
+	s.SetActivityStreamsId(id)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(i.inbox)
+	s.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(i.outbox)
+	s.SetActivityStreamsOutbox(outbox)
+	if len(i.preferredUsername) > 0 {
+		pu := streams.NewActivityStreamsPreferredUsernameProperty()
+		pu.SetXMLSchemaString(i.preferredUsername)
+		s.SetActivityStreamsPreferredUsername(pu)
+	}
+	s.SetActivityStreamsPublicKey(pk)
+	return s, nil
+}
+
+// NewTransport returns a Transport signing its requests as the instance
+// actor.
+func (i *InstanceActor) NewTransport(client HttpClient, appAgent string, clock Clock) (*HttpSigTransport, error) {
+	return i.keys.NewTransport(client, appAgent, clock)
+}
+
+// NewTransportWithOptions returns a Transport signing its requests as the
+// instance actor as configured by the options.
+func (i *InstanceActor) NewTransportWithOptions(client HttpClient, appAgent string, clock Clock, opts SigningOptions) (*HttpSigTransport, error) {
+	return i.keys.NewTransportWithOptions(client, appAgent, clock, opts)
+}
+
+// NewHandler creates a HandlerFunc serving the actor document of the instance
+// actor. Requests for any other IRI are not handled.
+//
+// No authentication is applied, as peers must be able to fetch the document
+// to verify requests signed by the instance actor.
+func (i *InstanceActor) NewHandler(clock Clock) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if !isActivityPubGet(r) || requestId(r).String() != i.id.String() {
+			return
+		}
+		isASRequest = true
+		s, err := i.ActivityStreams()
+		if err != nil {
+			return
+		}
+		m, err := streams.SerializeContext(c, s)
+		if err != nil {
+			return
+		}
+		buf, err := marshalJSON(m)
+		if err != nil {
+			return
+		}
+		defer putBuffer(buf)
+		raw := buf.Bytes()
+		addResponseHeaders(w.Header(), clock, raw)
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// NewPublicKeyGetter returns a PublicKeyGetter that dereferences key ids
+// using the Transport, which is typically one returned by an InstanceActor's
+// NewTransport.
+//
+// The key id may identify either the key itself or the actor owning it. The
+// owning actor must list the key in its 'publicKey' property. Keys are
+// assumed to be RSA keys signing with RSA_SHA256.
+func NewPublicKeyGetter(t Transport) PublicKeyGetter {
+	return func(c context.Context, keyId *url.URL) (pubKey crypto.PublicKey, algo httpsig.Algorithm, owner *url.URL, err error) {
+		docIRI := *keyId
+		docIRI.Fragment = ""
+		v, err := dereferenceType(c, t, &docIRI)
+		if err != nil {
+			return
+		}
+		key, err := findPublicKey(v, keyId)
+		if err != nil {
+			return
+		}
+		pkOwner := key.GetActivityStreamsOwner()
+		if pkOwner == nil || !pkOwner.IsXMLSchemaAnyURI() {
+			err = fmt.Errorf("public key %s has no owner", keyId)
+			return
+		}
+		owner = pkOwner.Get()
+		if _, isKey := v.(vocab.ActivityStreamsPublicKey); isKey {
+			// The owner must claim a key that was fetched on its own.
+			var ownerValue vocab.Type
+			if ownerValue, err = dereferenceType(c, t, owner); err != nil {
+				return
+			} else if _, err = findPublicKey(ownerValue, keyId); err != nil {
+				return
+			}
+		} else if actorId, idErr := GetId(v); idErr != nil {
+			err = idErr
+			return
+		} else if actorId.String() != owner.String() {
+			err = fmt.Errorf("public key %s is not owned by %s", keyId, actorId)
+			return
+		}
+		pkPem := key.GetActivityStreamsPublicKeyPem()
+		if pkPem == nil || !pkPem.IsXMLSchemaString() {
+			err = fmt.Errorf("public key %s has no publicKeyPem", keyId)
+			return
+		}
+		if pubKey, err = parsePublicKeyPEM(pkPem.Get()); err != nil {
+			return
+		}
+		algo = httpsig.RSA_SHA256
+		return
+	}
+}
+
+// dereferenceType fetches the IRI with the Transport and deserializes it.
+//
+// The value must have the IRI as its id, so that a server cannot serve a value
+// claiming to be one of another server, such as an actor with the key of its
+// own.
+func dereferenceType(c context.Context, t Transport, iri *url.URL) (vocab.Type, error) {
+	b, err := t.Dereference(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	v, err := streams.DeserializeContext(c, m)
+	if err != nil {
+		return nil, err
+	}
+	if id, err := GetId(v); err != nil {
+		return nil, err
+	} else if id.String() != iri.String() {
+		return nil, fmt.Errorf("value fetched from %s has id %s", iri, id)
+	}
+	return v, nil
+}
+
+// findPublicKey returns the public key with the given id, which is either the
+// value itself or one of the values of its 'publicKey' property.
+func findPublicKey(t vocab.Type, keyId *url.URL) (vocab.ActivityStreamsPublicKey, error) {
+	if key, ok := t.(vocab.ActivityStreamsPublicKey); ok {
+		if id, err := GetId(key); err != nil {
+			return nil, err
+		} else if id.String() != keyId.String() {
+			return nil, fmt.Errorf("publicKey %s does not match %s", id, keyId)
+		}
+		return key, nil
+	}
+	pk, ok := t.(publicKeyer)
+	if !ok || pk.GetActivityStreamsPublicKey() == nil {
+		return nil, fmt.Errorf("%s has no publicKey", keyId)
+	}
+	for iter := pk.GetActivityStreamsPublicKey().Begin(); iter != pk.GetActivityStreamsPublicKey().End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsPublicKey() {
+			continue
+		}
+		key := iter.Get()
+		if id, err := GetId(key); err == nil && id.String() == keyId.String() {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%s has no publicKey %s", t.GetTypeName(), keyId)
+}
+
+// parsePublicKeyPEM decodes a PKIX or PKCS1 encoded public key.
+func parsePublicKeyPEM(s string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("no public key PEM block")
+	}
+	if pk, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return pk, nil
+	}
+	return x509.ParsePKCS1PublicKey(block.Bytes)
+}
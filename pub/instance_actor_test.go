@@ -0,0 +1,115 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestInstanceActor(t *testing.T) {
+	ctx := context.Background()
+	actorIRI := mustParse(testMyActorIRI)
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newInstanceActor := func() *InstanceActor {
+		return NewInstanceActor(actorIRI, mustParse(testMyInboxIRI), mustParse(testMyOutboxIRI), "instance", privKey)
+	}
+	serialize := func(i *InstanceActor) []byte {
+		s, err := i.ActivityStreams()
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := streams.Serialize(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	t.Run("ServesActorDocument", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		i := newInstanceActor()
+		req := httptest.NewRequest("GET", actorIRI.String(), nil)
+		req.Header.Set("Accept", acceptHeaderValue)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := i.NewHandler(cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertByteEqual(t, resp.Body.Bytes(), serialize(i))
+	})
+	t.Run("IgnoresOtherIRIs", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set("Accept", acceptHeaderValue)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := newInstanceActor().NewHandler(cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, false)
+	})
+	t.Run("PublicKeyGetterVerifiesOwner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		i := newInstanceActor()
+		tp := NewMockTransport(ctl)
+		tp.EXPECT().Dereference(ctx, actorIRI).Return(serialize(i), nil)
+		// Run
+		pubKey, algo, owner, err := NewPublicKeyGetter(tp)(ctx, i.PublicKeyId())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, algo, httpsig.RSA_SHA256)
+		assertEqual(t, owner.String(), actorIRI.String())
+		assertEqual(t, pubKey.(*rsa.PublicKey).N.Cmp(privKey.N), 0)
+	})
+	t.Run("PublicKeyGetterRejectsMismatchedId", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		i := newInstanceActor()
+		tp := NewMockTransport(ctl)
+		// Another server's document claims the id of the instance actor,
+		// and lists its own key as owned by it.
+		docIRI := mustParse("https://evil.example.com/actor")
+		keyId := mustParse("https://evil.example.com/actor#main-key")
+		var m map[string]interface{}
+		if err := json.Unmarshal(serialize(i), &m); err != nil {
+			t.Fatal(err)
+		}
+		m["publicKey"].(map[string]interface{})["id"] = keyId.String()
+		b, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tp.EXPECT().Dereference(ctx, docIRI).Return(b, nil)
+		// Run
+		_, _, owner, err := NewPublicKeyGetter(tp)(ctx, keyId)
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, owner, (*url.URL)(nil))
+	})
+}
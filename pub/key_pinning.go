@@ -0,0 +1,191 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// KeyPin is a key pinned for an actor, the first one its requests were seen
+// signed with under the key id.
+type KeyPin struct {
+	// KeyId is the id of the key.
+	KeyId *url.URL
+	// Fingerprint is the KeyFingerprint of the public key.
+	Fingerprint string
+	// Pinned is when the key was pinned.
+	Pinned time.Time
+}
+
+// KeyPinStore holds the keys pinned for actors, one for each key id an actor
+// signs with.
+//
+// Implementations must be safe for concurrent use.
+type KeyPinStore interface {
+	// Get returns the key pinned for the actor under the key id, if any.
+	Get(c context.Context, actor, keyId *url.URL) (pin KeyPin, found bool, err error)
+	// Put pins the key for the actor under its KeyId, replacing any
+	// existing pin for that key id.
+	Put(c context.Context, actor *url.URL, pin KeyPin) error
+}
+
+// MemoryKeyPinStore is a KeyPinStore held in memory.
+//
+// It is not suitable when the pins must be shared between processes or
+// survive restarts, as keys would be trusted again on first use.
+type MemoryKeyPinStore struct {
+	mu   sync.Mutex
+	pins map[string]KeyPin
+}
+
+// MemoryKeyPinStore must satisfy the KeyPinStore interface.
+var _ KeyPinStore = &MemoryKeyPinStore{}
+
+// NewMemoryKeyPinStore creates an empty MemoryKeyPinStore.
+func NewMemoryKeyPinStore() *MemoryKeyPinStore {
+	return &MemoryKeyPinStore{
+		pins: make(map[string]KeyPin),
+	}
+}
+
+// Get returns the key pinned for the actor under the key id.
+func (m *MemoryKeyPinStore) Get(c context.Context, actor, keyId *url.URL) (pin KeyPin, found bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pin, found = m.pins[keyPinKey(actor, keyId)]
+	return
+}
+
+// Put pins the key for the actor under its KeyId.
+func (m *MemoryKeyPinStore) Put(c context.Context, actor *url.URL, pin KeyPin) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pins[keyPinKey(actor, pin.KeyId)] = pin
+	return nil
+}
+
+// keyPinKey returns the key of the map of a MemoryKeyPinStore for the actor
+// and key id.
+func keyPinKey(actor, keyId *url.URL) string {
+	return actor.String() + " " + keyId.String()
+}
+
+// KeyChangedError is returned when an actor signed a request with a key other
+// than the one pinned for it under the same key id. It wraps ErrBadSignature.
+type KeyChangedError struct {
+	// Actor is the actor whose key changed.
+	Actor *url.URL
+	// Pinned is the key pinned for the actor.
+	Pinned KeyPin
+	// Seen is the key the request was signed with.
+	Seen KeyPin
+}
+
+// Error describes the pinned and seen keys.
+func (e *KeyChangedError) Error() string {
+	return fmt.Sprintf("%s: key of %s changed from %s (%s) to %s (%s)",
+		ErrBadSignature, e.Actor, e.Pinned.KeyId, e.Pinned.Fingerprint, e.Seen.KeyId, e.Seen.Fingerprint)
+}
+
+// Unwrap returns ErrBadSignature.
+func (e *KeyChangedError) Unwrap() error {
+	return ErrBadSignature
+}
+
+// AsKeyChanged returns the KeyChangedError that is or is wrapped by the
+// error, if any.
+func AsKeyChanged(err error) (e *KeyChangedError, ok bool) {
+	for err != nil {
+		if e, ok = err.(*KeyChangedError); ok {
+			return
+		}
+		u, isWrapper := err.(interface{ Unwrap() error })
+		if !isWrapper {
+			return
+		}
+		err = u.Unwrap()
+	}
+	return
+}
+
+// KeyFingerprint returns the hex encoded SHA-256 hash of the PKIX encoding of
+// the public key.
+func KeyFingerprint(pubKey crypto.PublicKey) (string, error) {
+	b, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// KeyPinner pins the first key each actor is seen signing requests with under
+// each key id, and refuses requests signed with any other key under that key
+// id with a KeyChangedError. This trust on first use mitigates a peer silently
+// swapping the key of an actor, such as to forge activities relayed without a
+// Linked Data Signature, while actors listing several keys may sign with any
+// of them.
+//
+// Its Check method is a SignatureCheck, to be set as the CheckSignature of an
+// AuthorizedFetch or a PublicKeyCache.
+type KeyPinner struct {
+	// Store holds the pinned keys.
+	Store KeyPinStore
+	// Clock determines when keys are pinned.
+	Clock Clock
+	// AllowChange decides whether the key of the actor may change, such as
+	// after the actor announced a rotation with an Update activity, in
+	// which case the seen key is pinned instead. Optional; if nil, every
+	// change is refused.
+	AllowChange func(c context.Context, e *KeyChangedError) (bool, error)
+}
+
+// NewKeyPinner creates a KeyPinner refusing every change of a pinned key.
+func NewKeyPinner(store KeyPinStore, clock Clock) *KeyPinner {
+	return &KeyPinner{
+		Store: store,
+		Clock: clock,
+	}
+}
+
+// Check pins the key of the verified signature if its signer has none under its
+// key id, and otherwise returns a KeyChangedError if it differs from the pinned
+// key, unless AllowChange permits the change.
+func (k *KeyPinner) Check(c context.Context, r *SignatureResult) error {
+	fingerprint, err := KeyFingerprint(r.PublicKey)
+	if err != nil {
+		return err
+	}
+	seen := KeyPin{
+		KeyId:       r.KeyId,
+		Fingerprint: fingerprint,
+		Pinned:      k.Clock.Now(),
+	}
+	pinned, found, err := k.Store.Get(c, r.Signer, r.KeyId)
+	if err != nil {
+		return err
+	} else if !found {
+		return k.Store.Put(c, r.Signer, seen)
+	} else if pinned.Fingerprint == fingerprint {
+		return nil
+	}
+	e := &KeyChangedError{
+		Actor:  r.Signer,
+		Pinned: pinned,
+		Seen:   seen,
+	}
+	if k.AllowChange == nil {
+		return e
+	} else if ok, err := k.AllowChange(c, e); err != nil {
+		return err
+	} else if !ok {
+		return e
+	}
+	return k.Store.Put(c, r.Signer, seen)
+}
@@ -0,0 +1,128 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+func TestKeyPinner(t *testing.T) {
+	ctx := context.Background()
+	actor := mustParse(testFederatedActorIRI)
+	keyId := mustParse(testFederatedActorIRI + "#main-key")
+	key1, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key2, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller) *KeyPinner {
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		return NewKeyPinner(NewMemoryKeyPinStore(), cl)
+	}
+	result := func(pubKey crypto.PublicKey) *SignatureResult {
+		return &SignatureResult{Signer: actor, KeyId: keyId, PublicKey: pubKey}
+	}
+	t.Run("PinsFirstKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		k := setupFn(ctl)
+		// Run
+		err := k.Check(ctx, result(key1.Public()))
+		// Verify
+		assertEqual(t, err, nil)
+		pin, found, err := k.Store.Get(ctx, actor, keyId)
+		assertEqual(t, err, nil)
+		assertEqual(t, found, true)
+		assertEqual(t, pin.KeyId, keyId)
+		assertEqual(t, pin.Pinned.Equal(now()), true)
+		fingerprint, err := KeyFingerprint(key1.Public())
+		assertEqual(t, err, nil)
+		assertEqual(t, pin.Fingerprint, fingerprint)
+		assertEqual(t, k.Check(ctx, result(key1.Public())), nil)
+	})
+	t.Run("RefusesChangedKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		k := setupFn(ctl)
+		if err := k.Check(ctx, result(key1.Public())); err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		err := k.Check(ctx, result(key2.Public()))
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+		e, ok := AsKeyChanged(err)
+		assertEqual(t, ok, true)
+		assertEqual(t, e.Actor, actor)
+		assertNotEqual(t, e.Pinned.Fingerprint, e.Seen.Fingerprint)
+	})
+	t.Run("PinsEachKeyId", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		k := setupFn(ctl)
+		if err := k.Check(ctx, result(key1.Public())); err != nil {
+			t.Fatal(err)
+		}
+		other := result(key2.Public())
+		other.KeyId = mustParse(testFederatedActorIRI + "#ed25519-key")
+		// Run
+		err := k.Check(ctx, other)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, k.Check(ctx, result(key1.Public())), nil)
+		_, found, err := k.Store.Get(ctx, actor, other.KeyId)
+		assertEqual(t, err, nil)
+		assertEqual(t, found, true)
+	})
+	t.Run("RepinsAllowedChange", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		k := setupFn(ctl)
+		k.AllowChange = func(c context.Context, e *KeyChangedError) (bool, error) {
+			return true, nil
+		}
+		if err := k.Check(ctx, result(key1.Public())); err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		err := k.Check(ctx, result(key2.Public()))
+		// Verify
+		assertEqual(t, err, nil)
+		pin, _, _ := k.Store.Get(ctx, actor, keyId)
+		fingerprint, _ := KeyFingerprint(key2.Public())
+		assertEqual(t, pin.Fingerprint, fingerprint)
+	})
+	t.Run("SurfacesErrorFromVerification", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		k := setupFn(ctl)
+		if err := k.Check(ctx, result(key1.Public())); err != nil {
+			t.Fatal(err)
+		}
+		a := &AuthorizedFetch{
+			GetPublicKey: func(c context.Context, id *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+				return key2.Public(), httpsig.RSA_SHA256, actor, nil
+			},
+			CheckSignature: k.Check,
+		}
+		// Run
+		_, err := a.Verify(ctx, mustSignedGetRequest(testNoteId1, keyId.String(), key2))
+		// Verify
+		_, ok := AsKeyChanged(err)
+		assertEqual(t, ok, true)
+	})
+}
@@ -0,0 +1,35 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"net/url"
+)
+
+// ErrNoSigningKey is returned by a KeySelector when it has no key to sign a
+// request with.
+var ErrNoSigningKey = errors.New("no signing key")
+
+// KeySelector chooses the key signing a request sent to the host on behalf of
+// the actor, returning the id of its public key and the private key.
+//
+// It allows, for example, signing with a different key per peer domain, or as
+// the InstanceActor when the actor has no key of its own.
+type KeySelector func(c context.Context, host string, actor *url.URL) (pubKeyId string, privKey crypto.PrivateKey, err error)
+
+// KeySelector returns a KeySelector deferring to the given one, and signing as
+// the instance actor when it returns ErrNoSigningKey. If the given one is nil,
+// requests are always signed as the instance actor.
+func (i *InstanceActor) KeySelector(s KeySelector) KeySelector {
+	return func(c context.Context, host string, actor *url.URL) (string, crypto.PrivateKey, error) {
+		if s != nil {
+			pubKeyId, privKey, err := s(c, host, actor)
+			if err != ErrNoSigningKey {
+				return pubKeyId, privKey, err
+			}
+		}
+		active := i.keys.Active()
+		return active.Id.String(), active.PrivateKey, nil
+	}
+}
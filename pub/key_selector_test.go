@@ -0,0 +1,86 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestKeySelectingTransport(t *testing.T) {
+	ctx := context.Background()
+	actorIRI := mustParse(testMyActorIRI)
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance := NewInstanceActor(mustParse("https://example.com/actor"), mustParse(testMyInboxIRI), mustParse(testMyOutboxIRI), "instance", privKey)
+	// The actor has a key for other.example.com only.
+	perDomain := func(c context.Context, host string, actor *url.URL) (string, crypto.PrivateKey, error) {
+		if host != "other.example.com" {
+			return "", nil, ErrNoSigningKey
+		}
+		return actor.String() + "#other-key", privKey, nil
+	}
+	setupFn := func(ctl *gomock.Controller, sel KeySelector) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		algs := []httpsig.Algorithm{httpsig.RSA_SHA256}
+		getSigner, _, err := httpsig.NewSigner(algs, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postSigner, _, err := httpsig.NewSigner(algs, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tp = NewKeySelectingTransport(client, "test", cl, getSigner, postSigner, actorIRI, sel)
+		return
+	}
+	expectKeyId := func(client *MockHttpClient, keyId string) {
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, strings.Contains(r.Header.Get("Signature"), `keyId="`+keyId+`"`), true)
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+	}
+	t.Run("SignsWithSelectedKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl, perDomain)
+		expectKeyId(client, testMyActorIRI+"#other-key")
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("ErrorIfNoKeySelected", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp := setupFn(ctl, perDomain)
+		// Run
+		_, err := tp.Dereference(ctx, mustParse("https://maybe.example.com/note/1"))
+		// Verify
+		assertEqual(t, err, ErrNoSigningKey)
+	})
+	t.Run("FallsBackToInstanceActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl, instance.KeySelector(perDomain))
+		expectKeyId(client, instance.PublicKeyId().String())
+		// Run
+		_, err := tp.Dereference(ctx, mustParse("https://maybe.example.com/note/1"))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
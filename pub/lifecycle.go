@@ -0,0 +1,109 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrActorClosed is returned when an Actor is asked to send or publish an
+// activity after it was closed.
+var ErrActorClosed = errors.New("actor is closed")
+
+// QueueFlusher is a DeliveryQueue or ScheduleQueue that persists its pending
+// state on request, such as one buffering writes to durable storage.
+type QueueFlusher interface {
+	// Flush persists the pending state of the queue, including the
+	// deliveries or activities still leased, so that they are attempted
+	// once the application restarts.
+	Flush(c context.Context) error
+}
+
+// DeliveryQueuer is an optional extension of the CommonBehavior providing the
+// DeliveryQueue its Transports enqueue deliveries onto. If the queue is a
+// QueueFlusher, it is flushed when the Actor is closed.
+type DeliveryQueuer interface {
+	// DeliveryQueue returns the queue of outbound deliveries.
+	DeliveryQueue(c context.Context) (DeliveryQueue, error)
+}
+
+// queueFlusher is a DelegateActor able to flush the queues of the application.
+type queueFlusher interface {
+	flushQueues(c context.Context) error
+}
+
+// lifecycle tracks the work in flight in an Actor, so that closing it stops
+// new work from beginning and waits for the rest to complete.
+//
+// Its zero value is open.
+type lifecycle struct {
+	mu       sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+// begin registers new work, returning false without doing so if closed. The
+// work must call end once complete.
+func (l *lifecycle) begin() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return false
+	}
+	l.inFlight.Add(1)
+	return true
+}
+
+// end marks work registered by begin as complete.
+func (l *lifecycle) end() {
+	l.inFlight.Done()
+}
+
+// close stops new work from beginning, and waits for the work in flight to
+// complete or for the context to be done, in which case its error is
+// returned.
+func (l *lifecycle) close(c context.Context) error {
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		l.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-c.Done():
+		return c.Err()
+	}
+}
+
+// flushQueues flushes the DeliveryQueue of a DeliveryQueuer CommonBehavior and
+// the ScheduleQueue of a PublishScheduler SocialProtocol, if they are
+// QueueFlushers.
+func (a *sideEffectActor) flushQueues(c context.Context) error {
+	var queues []interface{}
+	if d, ok := a.common.(DeliveryQueuer); ok {
+		q, err := d.DeliveryQueue(c)
+		if err != nil {
+			return err
+		}
+		queues = append(queues, q)
+	}
+	if p, ok := a.c2s.(PublishScheduler); ok {
+		q, err := p.ScheduleQueue(c)
+		if err != nil {
+			return err
+		}
+		queues = append(queues, q)
+	}
+	for _, q := range queues {
+		if f, ok := q.(QueueFlusher); ok {
+			if err := f.Flush(c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
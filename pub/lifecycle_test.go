@@ -0,0 +1,117 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testFlushingDeliveryQueue is a DeliveryQueue counting its flushes.
+type testFlushingDeliveryQueue struct {
+	*MemoryDeliveryQueue
+	flushed int
+}
+
+func (q *testFlushingDeliveryQueue) Flush(c context.Context) error {
+	q.flushed++
+	return nil
+}
+
+// testFlushingScheduleQueue is a ScheduleQueue counting its flushes.
+type testFlushingScheduleQueue struct {
+	*MemoryScheduleQueue
+	flushed int
+}
+
+func (q *testFlushingScheduleQueue) Flush(c context.Context) error {
+	q.flushed++
+	return nil
+}
+
+// testDeliveryQueuer is a CommonBehavior implementing DeliveryQueuer.
+type testDeliveryQueuer struct {
+	*MockCommonBehavior
+	queue DeliveryQueue
+}
+
+func (d *testDeliveryQueuer) DeliveryQueue(c context.Context) (DeliveryQueue, error) {
+	return d.queue, nil
+}
+
+func TestLifecycle(t *testing.T) {
+	t.Run("WaitsForWorkInFlight", func(t *testing.T) {
+		// Setup
+		var l lifecycle
+		assertEqual(t, l.begin(), true)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			l.end()
+		}()
+		// Run
+		err := l.close(context.Background())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, l.begin(), false)
+	})
+	t.Run("StopsWaitingWhenContextDone", func(t *testing.T) {
+		// Setup
+		var l lifecycle
+		assertEqual(t, l.begin(), true)
+		defer l.end()
+		c, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		// Run
+		err := l.close(c)
+		// Verify
+		assertEqual(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestActorClose(t *testing.T) {
+	ctx := context.Background()
+	t.Run("RefusesNewWork", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		delegate := NewMockDelegateActor(ctl)
+		a := NewCustomActor(delegate, true, true, NewMockClock(ctl))
+		// Run
+		err := a.Close(ctx)
+		inboxResp := httptest.NewRecorder()
+		_, inboxErr := a.PostInbox(ctx, inboxResp, toAPRequest(toPostInboxRequest(testCreate)))
+		outboxResp := httptest.NewRecorder()
+		_, outboxErr := a.PostOutbox(ctx, outboxResp, toAPRequest(toPostOutboxRequest(testMyCreate)))
+		_, sendErr := a.Send(ctx, mustParse(testMyOutboxIRI), testMyNote)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, inboxErr, nil)
+		assertEqual(t, inboxResp.Code, http.StatusServiceUnavailable)
+		assertEqual(t, outboxErr, nil)
+		assertEqual(t, outboxResp.Code, http.StatusServiceUnavailable)
+		assertEqual(t, sendErr, ErrActorClosed)
+	})
+	t.Run("FlushesQueues", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		deliveries := &testFlushingDeliveryQueue{MemoryDeliveryQueue: NewMemoryDeliveryQueue(cl)}
+		scheduled := &testFlushingScheduleQueue{MemoryScheduleQueue: NewMemoryScheduleQueue(cl)}
+		a := NewActor(
+			&testDeliveryQueuer{MockCommonBehavior: NewMockCommonBehavior(ctl), queue: deliveries},
+			&testSchedulingSocialProtocol{MockSocialProtocol: NewMockSocialProtocol(ctl), queue: scheduled},
+			NewMockFederatingProtocol(ctl),
+			NewMockDatabase(ctl),
+			cl)
+		// Run
+		err := a.Close(ctx)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, deliveries.flushed, 1)
+		assertEqual(t, scheduled.flushed, 1)
+	})
+}
@@ -0,0 +1,221 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// The keys of the fields logged by this library, so that an application's
+// Logger may index or filter on them consistently.
+const (
+	// LogKeyActivityId is the id of the activity being handled.
+	LogKeyActivityId = "activity_id"
+	// LogKeyActivityType is the ActivityStreams type of the activity.
+	LogKeyActivityType = "activity_type"
+	// LogKeyActor is the IRIs of the activity's actors.
+	LogKeyActor = "actor"
+	// LogKeyRemoteHost is the host of the peer server.
+	LogKeyRemoteHost = "remote_host"
+	// LogKeyError is the error that occurred.
+	LogKeyError = "error"
+)
+
+// logContextKey is the context key under which WithLogger stores the Logger.
+type logContextKey struct{}
+
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+const (
+	// LogLevelDebug is the routine handling of activities and deliveries.
+	LogLevelDebug LogLevel = iota
+	// LogLevelInfo is a peer's request being refused or dropped.
+	LogLevelInfo
+	// LogLevelWarn is a failure that will be retried or that is the peer's
+	// fault, such as a delivery attempt failing.
+	LogLevelWarn
+	// LogLevelError is a failure that is not retried.
+	LogLevelError
+)
+
+// String returns the lowercase name of the level.
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	default:
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+}
+
+// LogField is a key and value attached to a log entry.
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// Logger receives the structured log entries of the library, so that
+// federation issues may be debugged.
+//
+// The Logger is carried by the context given to the library, so that the
+// Actor, side effect handlers, transports, and delivery queue all log to it
+// without it being passed to each. Use WithLogger to add it to the contexts of
+// requests and of calls to ProcessDeliveries. Without it, nothing is logged.
+//
+// Entries about an activity carry the LogKeyActivityId, LogKeyActivityType,
+// and LogKeyActor fields, and entries about a peer carry LogKeyRemoteHost.
+//
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	// Log records an entry with the fields, which are ordered from the
+	// most general to the most specific.
+	Log(level LogLevel, msg string, fields ...LogField)
+}
+
+// NoopLogger is a Logger that discards every entry.
+type NoopLogger struct{}
+
+// NoopLogger must satisfy the Logger interface.
+var _ Logger = NoopLogger{}
+
+// Log does nothing.
+func (NoopLogger) Log(level LogLevel, msg string, fields ...LogField) {}
+
+// StdLogger is a Logger writing entries in the logfmt style to a log.Logger,
+// such as:
+//
+//	level=warn msg="delivery failed" remote_host=example.com error="..."
+type StdLogger struct {
+	l   *log.Logger
+	min LogLevel
+}
+
+// StdLogger must satisfy the Logger interface.
+var _ Logger = &StdLogger{}
+
+// NewStdLogger creates a StdLogger writing entries of at least the minimum
+// level to the log.Logger.
+func NewStdLogger(l *log.Logger, min LogLevel) *StdLogger {
+	return &StdLogger{
+		l:   l,
+		min: min,
+	}
+}
+
+// Log writes the entry if its level is at least the minimum.
+func (s *StdLogger) Log(level LogLevel, msg string, fields ...LogField) {
+	if level < s.min {
+		return
+	}
+	var b strings.Builder
+	b.WriteString("level=")
+	b.WriteString(level.String())
+	b.WriteString(" msg=")
+	b.WriteString(logfmtValue(msg))
+	for _, f := range fields {
+		b.WriteString(" ")
+		b.WriteString(f.Key)
+		b.WriteString("=")
+		b.WriteString(logfmtValue(fmt.Sprint(f.Value)))
+	}
+	s.l.Print(b.String())
+}
+
+// logfmtValue quotes the value if it is empty or contains spaces, quotes, or
+// equals signs.
+func logfmtValue(s string) string {
+	if len(s) == 0 || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// WithLogger returns a copy of the context carrying the Logger.
+func WithLogger(c context.Context, l Logger) context.Context {
+	return context.WithValue(c, logContextKey{}, l)
+}
+
+// LoggerFromContext returns the Logger carried by the context, or NoopLogger
+// if there is none.
+func LoggerFromContext(c context.Context) Logger {
+	if l, ok := c.Value(logContextKey{}).(Logger); ok && l != nil {
+		return l
+	}
+	return NoopLogger{}
+}
+
+// logEntry logs to the Logger carried by the context.
+func logEntry(c context.Context, level LogLevel, msg string, fields ...LogField) {
+	LoggerFromContext(c).Log(level, msg, fields...)
+}
+
+// activityLogFields returns the fields identifying the activity.
+func activityLogFields(activity Activity) []LogField {
+	fields := []LogField{{Key: LogKeyActivityType, Value: activity.GetTypeName()}}
+	if id := activity.GetActivityStreamsId(); id != nil && id.Get() != nil {
+		fields = append([]LogField{{Key: LogKeyActivityId, Value: id.Get().String()}}, fields...)
+	}
+	if actor := activity.GetActivityStreamsActor(); actor != nil {
+		var actors []string
+		for iter := actor.Begin(); iter != actor.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				actors = append(actors, id.String())
+			}
+		}
+		if len(actors) > 0 {
+			fields = append(fields, LogField{Key: LogKeyActor, Value: strings.Join(actors, ",")})
+		}
+	}
+	return fields
+}
+
+// remoteHostLogField returns the field of the IRI's host.
+func remoteHostLogField(iri *url.URL) LogField {
+	return LogField{Key: LogKeyRemoteHost, Value: iri.Host}
+}
+
+// errorLogField returns the field of the error.
+func errorLogField(err error) LogField {
+	return LogField{Key: LogKeyError, Value: err}
+}
+
+// requestRemoteHost returns the host of the key id of the request's HTTP
+// Signature, or the host of its remote address if it is not signed.
+func requestRemoteHost(r *http.Request) string {
+	if host := signingHost(r); len(host) > 0 {
+		return host
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// signingHost returns the host of the key id of the request's HTTP Signature,
+// or an empty string if it is not signed.
+func signingHost(r *http.Request) string {
+	if len(r.Header.Get("Signature")) == 0 && len(r.Header.Get("Authorization")) == 0 {
+		return ""
+	}
+	v, err := newVerifier(r)
+	if err != nil {
+		return ""
+	}
+	keyId, err := url.Parse(v.KeyId())
+	if err != nil {
+		return ""
+	}
+	return keyId.Host
+}
@@ -0,0 +1,114 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// testLogEntry is an entry recorded by a testLogger.
+type testLogEntry struct {
+	level  LogLevel
+	msg    string
+	fields map[string]string
+}
+
+// testLogger records the entries logged to it.
+type testLogger struct {
+	mu      sync.Mutex
+	entries []testLogEntry
+}
+
+func (l *testLogger) Log(level LogLevel, msg string, fields ...LogField) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e := testLogEntry{level: level, msg: msg, fields: make(map[string]string)}
+	for _, f := range fields {
+		e.fields[f.Key] = fmt.Sprint(f.Value)
+	}
+	l.entries = append(l.entries, e)
+}
+
+func TestStdLogger(t *testing.T) {
+	// Setup
+	var b bytes.Buffer
+	l := NewStdLogger(log.New(&b, "", 0), LogLevelInfo)
+	// Run
+	l.Log(LogLevelDebug, "ignored")
+	l.Log(LogLevelWarn, "delivery failed",
+		LogField{Key: LogKeyRemoteHost, Value: "example.com"},
+		LogField{Key: LogKeyError, Value: errors.New("410 Gone")},
+		LogField{Key: "empty", Value: ""})
+	// Verify
+	assertEqual(t, b.String(), "level=warn msg=\"delivery failed\" remote_host=example.com error=\"410 Gone\" empty=\"\"\n")
+}
+
+func TestLoggerFromContext(t *testing.T) {
+	// Setup
+	l := &testLogger{}
+	// Run
+	none := LoggerFromContext(context.Background())
+	some := LoggerFromContext(WithLogger(context.Background(), l))
+	// Verify
+	assertEqual(t, none, NoopLogger{})
+	assertEqual(t, some, l)
+}
+
+func TestActivityLogFields(t *testing.T) {
+	// Setup
+	setupData()
+	l := &testLogger{}
+	// Run
+	logEntry(WithLogger(context.Background(), l), LogLevelDebug, "received activity", activityLogFields(testCreate)...)
+	// Verify
+	assertEqual(t, len(l.entries), 1)
+	assertEqual(t, l.entries[0].fields[LogKeyActivityId], testFederatedActivityIRI)
+	assertEqual(t, l.entries[0].fields[LogKeyActivityType], "Create")
+	assertEqual(t, l.entries[0].fields[LogKeyActor], testFederatedActorIRI)
+}
+
+func TestRequestRemoteHost(t *testing.T) {
+	// Setup
+	r := httptest.NewRequest("POST", testMyInboxIRI, nil)
+	r.RemoteAddr = "192.0.2.1:1234"
+	// Run
+	host := requestRemoteHost(r)
+	// Verify
+	assertEqual(t, host, "192.0.2.1")
+}
+
+func TestProcessDeliveriesLogsFailures(t *testing.T) {
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	l := &testLogger{}
+	ctx := WithLogger(context.Background(), l)
+	testErr := errors.New("test error")
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	q := NewMemoryDeliveryQueue(cl)
+	tp := NewMockTransport(ctl)
+	qt := NewQueuedTransport(tp, q, mustParse(testMyOutboxIRI), cl)
+	err := qt.Deliver(ctx, payload, recipient)
+	assertEqual(t, err, nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(testErr)
+	// Run
+	_, err = ProcessDeliveries(ctx, q, 1, cl, func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+		return tp, nil
+	}, nil)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, len(l.entries), 1)
+	assertEqual(t, l.entries[0].level, LogLevelWarn)
+	assertEqual(t, l.entries[0].fields[LogKeyRemoteHost], recipient.Host)
+	assertEqual(t, l.entries[0].fields[LogKeyError], testErr.Error())
+}
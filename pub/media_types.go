@@ -0,0 +1,104 @@
+package pub
+
+import (
+	"sync"
+)
+
+const (
+	// ActivityStreamsMediaType is the media type of ActivityStreams
+	// documents with the profile parameter, as the ActivityPub
+	// specification requires.
+	ActivityStreamsMediaType = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+	// ActivityJSONMediaType is the media type of ActivityStreams documents
+	// without a profile parameter.
+	ActivityJSONMediaType = "application/activity+json"
+	// JSONMediaType is the media type of JSON documents, accepted as a
+	// fallback by peers serving ActivityStreams documents as plain JSON.
+	JSONMediaType = "application/json"
+)
+
+// MediaTypes are the values of the Accept header of the GET requests, and of
+// the Content-Type header of the POST requests, of an HttpSigTransport.
+type MediaTypes struct {
+	// Accept is the Accept header of GET requests for ActivityStreams
+	// documents.
+	Accept string
+	// ContentType is the Content-Type header of delivered activities.
+	ContentType string
+}
+
+// DefaultMediaTypes are the media types of requests to hosts without their
+// own, using the profile parameter.
+var DefaultMediaTypes = MediaTypes{
+	Accept:      acceptHeaderValue,
+	ContentType: contentTypeHeaderValue,
+}
+
+// NewMediaTypes returns the MediaTypes of ActivityStreams documents, with the
+// profile parameter if profile is true and as application/activity+json
+// otherwise. If jsonFallback is true, plain JSON is also accepted at a lower
+// preference.
+func NewMediaTypes(profile, jsonFallback bool) MediaTypes {
+	mediaType := ActivityJSONMediaType
+	if profile {
+		mediaType = ActivityStreamsMediaType
+	}
+	m := MediaTypes{
+		Accept:      mediaType,
+		ContentType: mediaType,
+	}
+	if jsonFallback {
+		m.Accept += ", " + JSONMediaType + "; q=0.9"
+	}
+	return m
+}
+
+// HostMediaTypes are the MediaTypes of requests to each peer host, for the few
+// implementations responding incorrectly to the default ones.
+type HostMediaTypes struct {
+	defaultTypes MediaTypes
+	mu           sync.RWMutex
+	types        map[string]MediaTypes
+}
+
+// NewHostMediaTypes creates a HostMediaTypes using the default media types
+// for every host without its own.
+func NewHostMediaTypes(defaultTypes MediaTypes) *HostMediaTypes {
+	return &HostMediaTypes{
+		defaultTypes: defaultTypes,
+		types:        make(map[string]MediaTypes),
+	}
+}
+
+// Set overrides the default media types for the host.
+func (h *HostMediaTypes) Set(host string, m MediaTypes) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.types[host] = m
+}
+
+// MediaTypes returns the media types of the host.
+func (h *HostMediaTypes) MediaTypes(host string) MediaTypes {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if m, ok := h.types[host]; ok {
+		return m
+	}
+	return h.defaultTypes
+}
+
+// WithMediaTypes returns a copy of the transport using the media types of
+// each host in the Accept headers of its GET requests for ActivityStreams
+// documents and the Content-Type headers of its deliveries.
+func (h HttpSigTransport) WithMediaTypes(m *HostMediaTypes) *HttpSigTransport {
+	h.mediaTypes = m
+	return &h
+}
+
+// mediaTypesOf returns the media types of requests to the host.
+func (h HttpSigTransport) mediaTypesOf(host string) MediaTypes {
+	if h.mediaTypes == nil {
+		return DefaultMediaTypes
+	}
+	return h.mediaTypes.MediaTypes(host)
+}
@@ -0,0 +1,75 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMediaTypes(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := NewActorKeys(mustParse(testMyActorIRI), ActorKey{Id: mustParse(testMyActorIRI + "#main-key"), PrivateKey: privKey})
+	setupFn := func(ctl *gomock.Controller) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp, err := keys.NewTransport(client, "test", cl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		types := NewHostMediaTypes(DefaultMediaTypes)
+		types.Set("other.example.com", NewMediaTypes(false, true))
+		return client, tp.WithMediaTypes(types)
+	}
+	t.Run("BuildsMediaTypes", func(t *testing.T) {
+		// Run
+		m := NewMediaTypes(false, true)
+		// Verify
+		assertEqual(t, m.Accept, "application/activity+json, application/json; q=0.9")
+		assertEqual(t, m.ContentType, "application/activity+json")
+		assertEqual(t, NewMediaTypes(true, false), DefaultMediaTypes)
+	})
+	t.Run("UsesMediaTypesOfHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("Accept"), "application/activity+json, application/json; q=0.9")
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("Content-Type"), "application/activity+json")
+			return &http.Response{StatusCode: http.StatusAccepted, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+		})
+		// Run
+		_, err := tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		err = tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI2))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("UsesDefaultsForOtherHosts", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("Accept"), ActivityStreamsMediaType)
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+		// Run
+		_, err := tp.Dereference(ctx, mustParse(testNoteId1))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
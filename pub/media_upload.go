@@ -0,0 +1,256 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// DefaultMaxMediaSize is the largest media upload accepted by default,
+	// in bytes.
+	DefaultMaxMediaSize int64 = 40 << 20
+	// uploadMediaProperty is the endpoint to which an actor's clients
+	// upload media.
+	uploadMediaProperty = "uploadMedia"
+	// mediaFileField is the multipart form field holding the uploaded media.
+	mediaFileField = "file"
+	// mediaObjectField is the multipart form field holding the shell object
+	// describing the uploaded media.
+	mediaObjectField = "object"
+	// multipartMediaType is the media type of media upload requests.
+	multipartMediaType = "multipart/form-data"
+	// mediaUploadMemory is the number of bytes of an upload kept in memory,
+	// the remainder being stored in temporary files.
+	mediaUploadMemory = 8 << 20
+	// sniffLen is the number of bytes read to detect the media type of an
+	// upload sent without one.
+	sniffLen = 512
+)
+
+// MediaUpload is a file uploaded by a client.
+type MediaUpload struct {
+	// Filename is the name of the file on the client.
+	Filename string
+	// MediaType is the media type of the file, as sent by the client or
+	// otherwise detected from its content.
+	MediaType string
+	// Size is the length of the file in bytes.
+	Size int64
+	// Content reads the file.
+	Content io.Reader
+}
+
+// MediaStore stores the media uploaded by clients.
+type MediaStore interface {
+	// StoreMedia saves the media uploaded by the actor, returning the IRI
+	// at which it is served.
+	//
+	// The object is the shell object describing the media, as sent by the
+	// client. It has no 'id' yet, and may be modified.
+	StoreMedia(c context.Context, actorIRI *url.URL, object vocab.Type, m *MediaUpload) (*url.URL, error)
+}
+
+// UploadActorFunc returns the IRI of the actor whose 'uploadMedia' endpoint is
+// at the IRI, or nil if it is not an upload endpoint of the application.
+type UploadActorFunc func(c context.Context, endpointIRI *url.URL) (*url.URL, error)
+
+// MediaUploads handles the media uploaded by clients to the 'uploadMedia'
+// endpoints of the actors of the application.
+//
+// An upload is a multipart POST request with the media in its "file" field and
+// a shell ActivityStreams object, such as an Image, in its "object" field. The
+// media is stored with the MediaStore, and the object is given a new 'id' and
+// the media's IRI as its 'url' before it is created in the Database. It is then
+// served in a 201 Created response with its IRI in the Location header, so
+// that the client may attach it to the activities it later posts to the
+// outbox.
+type MediaUploads struct {
+	// MaxSize is the largest request accepted, in bytes. Larger ones are
+	// rejected with a 400 Bad Request. It defaults to the
+	// DefaultMaxMediaSize.
+	MaxSize int64
+
+	authFn  AuthenticateFunc
+	db      Database
+	clock   Clock
+	store   MediaStore
+	actorFn UploadActorFunc
+}
+
+// NewMediaUploads creates the MediaUploads of the actors of the upload
+// endpoints determined by the function.
+//
+// The authFn must authenticate the request as one of a client of the actor,
+// as it does for a POST to the actor's outbox.
+func NewMediaUploads(authFn AuthenticateFunc, db Database, clock Clock, store MediaStore, actorFn UploadActorFunc) *MediaUploads {
+	return &MediaUploads{
+		MaxSize: DefaultMaxMediaSize,
+		authFn:  authFn,
+		db:      db,
+		clock:   clock,
+		store:   store,
+		actorFn: actorFn,
+	}
+}
+
+// NewHandler creates a HandlerFunc handling the uploads to the endpoints.
+//
+// Only multipart POST requests to an upload endpoint are handled.
+func (u *MediaUploads) NewHandler() HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if r.Method != "POST" || !isMultipart(r) {
+			return
+		}
+		actorIRI, err := u.actorFn(c, requestId(r))
+		if err != nil || actorIRI == nil {
+			return
+		}
+		isASRequest = true
+		var shouldReturn bool
+		if shouldReturn, err = u.authFn(c, w, r); err != nil || shouldReturn {
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, u.MaxSize)
+		if err = r.ParseMultipartForm(mediaUploadMemory); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			err = nil
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+		object, err := uploadedObject(c, r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			err = nil
+			return
+		}
+		f, header, err := r.FormFile(mediaFileField)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			err = nil
+			return
+		}
+		defer f.Close()
+		m, err := newMediaUpload(f, header)
+		if err != nil {
+			return
+		}
+		if err = u.create(c, actorIRI, object, m); err != nil {
+			return
+		}
+		raw, err := serializeJSON(object)
+		if err != nil {
+			return
+		}
+		addResponseHeaders(w.Header(), u.clock, raw)
+		w.Header().Set(locationHeader, object.GetActivityStreamsId().Get().String())
+		w.WriteHeader(http.StatusCreated)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// create stores the media of the actor, and creates the object describing it
+// in the Database.
+func (u *MediaUploads) create(c context.Context, actorIRI *url.URL, object vocab.Type, m *MediaUpload) error {
+	iri, err := u.store.StoreMedia(c, actorIRI, object, m)
+	if err != nil {
+		return err
+	}
+	mo := object.(mediaObjecter)
+	urlProp := mo.GetActivityStreamsUrl()
+	if urlProp == nil {
+		urlProp = streams.NewActivityStreamsUrlProperty()
+		mo.SetActivityStreamsUrl(urlProp)
+	}
+	urlProp.AppendIRI(iri)
+	if mo.GetActivityStreamsMediaType() == nil && len(m.MediaType) > 0 {
+		mediaType := streams.NewActivityStreamsMediaTypeProperty()
+		mediaType.Set(m.MediaType)
+		mo.SetActivityStreamsMediaType(mediaType)
+	}
+	if a, ok := object.(attributedToer); ok && a.GetActivityStreamsAttributedTo() == nil {
+		attrTo := streams.NewActivityStreamsAttributedToProperty()
+		attrTo.AppendIRI(actorIRI)
+		a.SetActivityStreamsAttributedTo(attrTo)
+	}
+	id, err := u.db.NewId(c, object)
+	if err != nil {
+		return err
+	}
+	idProp := streams.NewActivityStreamsIdProperty()
+	idProp.Set(id)
+	object.SetActivityStreamsId(idProp)
+	if err = u.db.Lock(c, id); err != nil {
+		return err
+	}
+	defer u.db.Unlock(c, id)
+	return u.db.Create(c, object)
+}
+
+// isMultipart determines whether the content of the request is a multipart
+// form.
+func isMultipart(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(contentTypeHeader))
+	return err == nil && mediaType == multipartMediaType
+}
+
+// uploadedObject deserializes the shell object of an upload, which must be an
+// object with a 'url' and not an activity.
+func uploadedObject(c context.Context, r *http.Request) (vocab.Type, error) {
+	raw := r.FormValue(mediaObjectField)
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("no %q in media upload", mediaObjectField)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		return nil, err
+	}
+	t, err := streams.DeserializeContext(c, m)
+	if err != nil {
+		return nil, err
+	}
+	if streams.IsOrExtendsActivityStreamsActivity(t) {
+		return nil, fmt.Errorf("cannot upload media as activity %s", t.GetTypeName())
+	} else if _, ok := t.(mediaObjecter); !ok {
+		return nil, fmt.Errorf("cannot upload media as %s", t.GetTypeName())
+	}
+	return t, nil
+}
+
+// newMediaUpload creates the MediaUpload of the file, detecting its media type
+// from its content if the client sent none.
+func newMediaUpload(f multipart.File, header *multipart.FileHeader) (*MediaUpload, error) {
+	m := &MediaUpload{
+		Filename:  header.Filename,
+		MediaType: header.Header.Get(contentTypeHeader),
+		Size:      header.Size,
+		Content:   f,
+	}
+	if len(m.MediaType) > 0 {
+		return m, nil
+	}
+	b := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, b)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	m.MediaType = http.DetectContentType(b[:n])
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
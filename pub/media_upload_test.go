@@ -0,0 +1,230 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// testMediaStore is a MediaStore keeping the last upload it stored.
+type testMediaStore struct {
+	iri      *url.URL
+	err      error
+	actorIRI *url.URL
+	upload   *MediaUpload
+	content  []byte
+}
+
+func (s *testMediaStore) StoreMedia(c context.Context, actorIRI *url.URL, object vocab.Type, m *MediaUpload) (*url.URL, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.actorIRI = actorIRI
+	s.upload = m
+	var err error
+	if s.content, err = ioutil.ReadAll(m.Content); err != nil {
+		return nil, err
+	}
+	return s.iri, nil
+}
+
+// newTestUploadRequest creates a multipart POST request to the upload
+// endpoint with the object and file fields, each omitted if empty.
+func newTestUploadRequest(t *testing.T, object, file, mediaType string) *http.Request {
+	var b bytes.Buffer
+	mw := multipart.NewWriter(&b)
+	if len(object) > 0 {
+		if err := mw.WriteField(mediaObjectField, object); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(file) > 0 {
+		h := make(map[string][]string)
+		h["Content-Disposition"] = []string{`form-data; name="file"; filename="cat.png"`}
+		if len(mediaType) > 0 {
+			h[contentTypeHeader] = []string{mediaType}
+		}
+		fw, err := mw.CreatePart(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = fw.Write([]byte(file)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", testMyActorIRI+"/uploads", &b)
+	req.Header.Set(contentTypeHeader, mw.FormDataContentType())
+	return req
+}
+
+func TestMediaUploads(t *testing.T) {
+	ctx := context.Background()
+	actorIRI := mustParse(testMyActorIRI)
+	mediaIRI := mustParse("https://example.com/media/cat.png")
+	imageIRI := mustParse(testNoteId1)
+	const shell = `{"@context":"https://www.w3.org/ns/activitystreams","type":"Image","name":"A cat"}`
+	actorFn := func(c context.Context, iri *url.URL) (*url.URL, error) {
+		if iri.String() == testMyActorIRI+"/uploads" {
+			return actorIRI, nil
+		}
+		return nil, nil
+	}
+	authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+		return false, nil
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, cl *MockClock, s *testMediaStore, u *MediaUploads) {
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		s = &testMediaStore{iri: mediaIRI}
+		u = NewMediaUploads(authFn, db, cl, s, actorFn)
+		return
+	}
+	t.Run("CreatesObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl, s, u := setupFn(ctl)
+		gomock.InOrder(
+			db.EXPECT().NewId(ctx, gomock.Any()).Return(imageIRI, nil),
+			db.EXPECT().Lock(ctx, imageIRI),
+			db.EXPECT().Create(ctx, gomock.Any()),
+			db.EXPECT().Unlock(ctx, imageIRI),
+			cl.EXPECT().Now().Return(now()),
+		)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, shell, "meow", "image/png"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+		assertEqual(t, resp.Header().Get(locationHeader), testNoteId1)
+		assertEqual(t, s.actorIRI, actorIRI)
+		assertEqual(t, s.upload.Filename, "cat.png")
+		assertEqual(t, s.upload.MediaType, "image/png")
+		assertEqual(t, string(s.content), "meow")
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &m), nil)
+		assertEqual(t, m["type"], "Image")
+		assertEqual(t, m["id"], testNoteId1)
+		assertEqual(t, m["url"], mediaIRI.String())
+		assertEqual(t, m["mediaType"], "image/png")
+		assertEqual(t, m["attributedTo"], testMyActorIRI)
+	})
+	t.Run("DetectsMediaType", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl, s, u := setupFn(ctl)
+		db.EXPECT().NewId(ctx, gomock.Any()).Return(imageIRI, nil)
+		db.EXPECT().Lock(ctx, imageIRI)
+		db.EXPECT().Create(ctx, gomock.Any())
+		db.EXPECT().Unlock(ctx, imageIRI)
+		cl.EXPECT().Now().Return(now())
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, shell, "\x89PNG\x0d\x0a\x1a\x0a", ""))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusCreated)
+		assertEqual(t, s.upload.MediaType, "image/png")
+		assertEqual(t, string(s.content), "\x89PNG\x0d\x0a\x1a\x0a")
+	})
+	t.Run("RejectsMissingFile", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, u := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, shell, "", ""))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("RejectsActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, u := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		object := `{"@context":"https://www.w3.org/ns/activitystreams","type":"Create"}`
+		// Run
+		_, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, object, "meow", "image/png"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("RejectsLargeUploads", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, u := setupFn(ctl)
+		u.MaxSize = 64
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, shell, string(make([]byte, 128)), "image/png"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("Authenticates", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cl := NewMockClock(ctl)
+		authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return true, nil
+		}
+		u := NewMediaUploads(authFn, db, cl, &testMediaStore{iri: mediaIRI}, actorFn)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, shell, "meow", "image/png"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, true)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("IgnoresOtherIRIs", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, u := setupFn(ctl)
+		req := newTestUploadRequest(t, shell, "meow", "image/png")
+		req.URL = mustParse(testMyOutboxIRI)
+		resp := httptest.NewRecorder()
+		// Run
+		isAS, err := u.NewHandler()(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isAS, false)
+	})
+	t.Run("ErrorIfStoreFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, s, u := setupFn(ctl)
+		testErr := errors.New("test error")
+		s.err = testErr
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := u.NewHandler()(ctx, resp, newTestUploadRequest(t, shell, "meow", "image/png"))
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+}
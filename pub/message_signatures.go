@@ -0,0 +1,386 @@
+package pub
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/go-fed/httpsig"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// signatureInputHeader is the header of RFC 9421 HTTP Message
+	// Signatures listing the covered components and the signature
+	// parameters.
+	signatureInputHeader = "Signature-Input"
+	// contentDigestHeader is the RFC 9530 header with the digest of the
+	// content of a message.
+	contentDigestHeader = "Content-Digest"
+	// messageSignatureLabel labels the HTTP Message Signatures created by
+	// a MessageSigner.
+	messageSignatureLabel = "sig1"
+)
+
+// messageSigner produces RFC 9421 HTTP Message Signatures.
+type messageSigner struct {
+	clock      Clock
+	components []string
+	expiresIn  time.Duration
+}
+
+// NewMessageSigner returns a Signer producing RFC 9421 HTTP Message Signatures
+// covering the components, in the Signature-Input and Signature headers. The
+// components are lowercase header names and the derived components "@method",
+// "@target-uri", "@authority", "@scheme", "@request-target", "@path", and
+// "@query". If none are given, the "@method" and "@target-uri" are signed. It
+// is used like a Signer returned by httpsig.NewSigner, such as with
+// NewHttpSigTransport or as the preferred signers of NewNegotiatingTransport.
+//
+// Signatures are created at the time of the Clock and carry the 'created'
+// and 'keyid' parameters, and the 'expires' parameter if expiresIn is
+// positive. The algorithm is not labelled but resolved from the key, as for
+// NewHS2019Signer. If the components include "content-digest" and a request
+// has a body but no Content-Digest header, its SHA-256 digest is added.
+func NewMessageSigner(clock Clock, components []string, expiresIn time.Duration) httpsig.Signer {
+	if len(components) == 0 {
+		components = []string{"@method", "@target-uri"}
+	}
+	return &messageSigner{
+		clock:      clock,
+		components: components,
+		expiresIn:  expiresIn,
+	}
+}
+
+// SignRequest signs the request with the private key.
+func (m *messageSigner) SignRequest(pKey crypto.PrivateKey, pubKeyId string, r *http.Request, body []byte) error {
+	if body != nil && len(r.Header.Get(contentDigestHeader)) == 0 && containsHeader(m.components, "content-digest") {
+		sum := sha256.Sum256(body)
+		r.Header.Set(contentDigestHeader, "sha-256=:"+base64.StdEncoding.EncodeToString(sum[:])+":")
+	}
+	return m.sign(pKey, pubKeyId, r.Header, r)
+}
+
+// SignResponse signs the response with the private key. Only header fields
+// may be covered in a response.
+func (m *messageSigner) SignResponse(pKey crypto.PrivateKey, pubKeyId string, w http.ResponseWriter) error {
+	return m.sign(pKey, pubKeyId, w.Header(), nil)
+}
+
+// sign sets the Signature-Input and Signature headers in h, signing the
+// components of the request or, if r is nil, of a response.
+func (m *messageSigner) sign(pKey crypto.PrivateKey, pubKeyId string, h http.Header, r *http.Request) error {
+	now := m.clock.Now()
+	var b bytes.Buffer
+	b.WriteString("(")
+	for i, c := range m.components {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(strconv.Quote(strings.ToLower(c)))
+	}
+	fmt.Fprintf(&b, ");created=%d", now.Unix())
+	if m.expiresIn > 0 {
+		fmt.Fprintf(&b, ";expires=%d", now.Add(m.expiresIn).Unix())
+	}
+	fmt.Fprintf(&b, ";keyid=%s", strconv.Quote(pubKeyId))
+	input := b.String()
+	base, err := messageSignatureBase(h, r, m.components, input)
+	if err != nil {
+		return err
+	}
+	sig, err := signWithKey(pKey, base)
+	if err != nil {
+		return err
+	}
+	if k, ok := pKey.(crypto.Signer); ok {
+		if pub, ok := k.Public().(*ecdsa.PublicKey); ok {
+			if sig, err = ecdsaASN1ToRaw(sig, pub); err != nil {
+				return err
+			}
+		}
+	}
+	h.Set(signatureInputHeader, messageSignatureLabel+"="+input)
+	h.Set("Signature", messageSignatureLabel+"=:"+base64.StdEncoding.EncodeToString(sig)+":")
+	return nil
+}
+
+// messageSignature is an RFC 9421 HTTP Message Signature of a request.
+type messageSignature struct {
+	// components are the covered component identifiers.
+	components []string
+	// params are the signature parameters, with strings unquoted.
+	params map[string]string
+	// input is the covered components and parameters as serialized in the
+	// Signature-Input header, which is the signed '@signature-params'.
+	input string
+	// signature is the decoded signature.
+	signature []byte
+}
+
+// isMessageSigned determines whether the request carries an RFC 9421 HTTP
+// Message Signature rather than a cavage draft HTTP Signature.
+func isMessageSigned(r *http.Request) bool {
+	return len(r.Header.Get(signatureInputHeader)) > 0
+}
+
+// parseMessageSignature returns the first HTTP Message Signature of the
+// request that has a 'keyid' parameter and a value in the Signature header.
+func parseMessageSignature(r *http.Request) (*messageSignature, error) {
+	sigs := make(map[string][]byte)
+	for _, member := range splitDictionary(strings.Join(r.Header[http.CanonicalHeaderKey("Signature")], ",")) {
+		label, value := splitMember(member)
+		if len(value) < 2 || value[0] != ':' || value[len(value)-1] != ':' {
+			continue
+		}
+		b, err := base64.StdEncoding.DecodeString(value[1 : len(value)-1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode signature %q: %s", label, err)
+		}
+		sigs[label] = b
+	}
+	for _, member := range splitDictionary(strings.Join(r.Header[http.CanonicalHeaderKey(signatureInputHeader)], ",")) {
+		label, value := splitMember(member)
+		sig, ok := sigs[label]
+		if !ok {
+			continue
+		}
+		m, err := parseSignatureInput(value)
+		if err != nil {
+			return nil, fmt.Errorf("signature input %q: %s", label, err)
+		} else if len(m.params["keyid"]) == 0 {
+			continue
+		}
+		m.signature = sig
+		return m, nil
+	}
+	return nil, errors.New("no signature with a keyid in Signature-Input")
+}
+
+// parseSignatureInput parses the inner list of covered components and the
+// parameters of a member of the Signature-Input header.
+func parseSignatureInput(s string) (*messageSignature, error) {
+	if !strings.HasPrefix(s, "(") {
+		return nil, errors.New("covered components are not an inner list")
+	}
+	end := strings.Index(s, ")")
+	if end < 0 {
+		return nil, errors.New("unterminated inner list")
+	}
+	m := &messageSignature{params: make(map[string]string), input: s}
+	for _, c := range strings.Fields(s[1:end]) {
+		if !strings.HasPrefix(c, `"`) || !strings.HasSuffix(c, `"`) || len(c) < 2 {
+			return nil, fmt.Errorf("unsupported component identifier %s", c)
+		}
+		m.components = append(m.components, c[1:len(c)-1])
+	}
+	for _, p := range splitOutsideQuotes(s[end+1:], ';') {
+		if len(p) == 0 {
+			continue
+		}
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			m.params[kv[0]] = ""
+			continue
+		}
+		v := kv[1]
+		if strings.HasPrefix(v, `"`) {
+			var err error
+			if v, err = strconv.Unquote(v); err != nil {
+				return nil, fmt.Errorf("malformed parameter %q: %s", kv[0], err)
+			}
+		}
+		m.params[kv[0]] = v
+	}
+	return m, nil
+}
+
+// splitDictionary splits a structured field dictionary into its members.
+func splitDictionary(s string) []string {
+	var members []string
+	for _, m := range splitOutsideQuotes(s, ',') {
+		if m = strings.TrimSpace(m); len(m) > 0 {
+			members = append(members, m)
+		}
+	}
+	return members
+}
+
+// splitMember splits a dictionary member into its key and value.
+func splitMember(s string) (key, value string) {
+	kv := strings.SplitN(s, "=", 2)
+	if len(kv) != 2 {
+		return kv[0], ""
+	}
+	return strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+}
+
+// splitOutsideQuotes splits the string on the separator where it is outside a
+// quoted string and an inner list.
+func splitOutsideQuotes(s string, sep rune) []string {
+	var parts []string
+	var quoted, escaped bool
+	depth, start := 0, 0
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == '(':
+			depth++
+		case !quoted && c == ')':
+			depth--
+		case !quoted && depth == 0 && c == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// messageSignatureBase returns the signature base of the components, taking
+// derived components from the request, which is nil for a response, and
+// field values from h.
+func messageSignatureBase(h http.Header, r *http.Request, components []string, input string) (string, error) {
+	var b bytes.Buffer
+	for _, c := range components {
+		c = strings.ToLower(c)
+		var value string
+		if strings.HasPrefix(c, "@") {
+			if r == nil {
+				return "", fmt.Errorf("cannot sign %q on anything other than a request", c)
+			}
+			var err error
+			if value, err = derivedComponent(r, c); err != nil {
+				return "", err
+			}
+		} else {
+			var err error
+			if value, err = fieldValue(h, r, c); err != nil {
+				return "", err
+			}
+		}
+		fmt.Fprintf(&b, "%q: %s\n", c, value)
+	}
+	fmt.Fprintf(&b, "%q: %s", "@signature-params", input)
+	return b.String(), nil
+}
+
+// derivedComponent returns the value of the derived component of the request.
+//
+// The scheme of a request received without TLS nor an absolute URL is taken to
+// be https, as servers commonly sit behind a proxy terminating TLS.
+func derivedComponent(r *http.Request, c string) (string, error) {
+	scheme := "https"
+	if len(r.URL.Scheme) > 0 {
+		scheme = strings.ToLower(r.URL.Scheme)
+	}
+	authority := r.Host
+	if len(authority) == 0 {
+		authority = r.URL.Host
+	}
+	authority = strings.ToLower(authority)
+	switch c {
+	case "@method":
+		return r.Method, nil
+	case "@target-uri":
+		return scheme + "://" + authority + r.URL.RequestURI(), nil
+	case "@authority":
+		return authority, nil
+	case "@scheme":
+		return scheme, nil
+	case "@request-target":
+		return r.URL.RequestURI(), nil
+	case "@path":
+		if p := r.URL.EscapedPath(); len(p) > 0 {
+			return p, nil
+		}
+		return "/", nil
+	case "@query":
+		return "?" + r.URL.RawQuery, nil
+	default:
+		return "", fmt.Errorf("unsupported derived component %q", c)
+	}
+}
+
+// messageVerifier is an httpsig.Verifier of RFC 9421 HTTP Message Signatures.
+type messageVerifier struct {
+	r   *http.Request
+	sig *messageSignature
+}
+
+// newVerifier returns a Verifier of the HTTP Message Signature of the request,
+// if it has one, and of its cavage draft HTTP Signature otherwise.
+func newVerifier(r *http.Request) (httpsig.Verifier, error) {
+	if !isMessageSigned(r) {
+		return httpsig.NewVerifier(r)
+	}
+	sig, err := parseMessageSignature(r)
+	if err != nil {
+		return nil, err
+	}
+	return &messageVerifier{r: r, sig: sig}, nil
+}
+
+// KeyId returns the 'keyid' parameter of the signature.
+func (v *messageVerifier) KeyId() string {
+	return v.sig.params["keyid"]
+}
+
+// Verify verifies the signature with the public key, resolving the algorithm
+// from the key rather than from the algorithm given.
+//
+// Whether the signature is recent is not checked; see checkSignatureTime.
+func (v *messageVerifier) Verify(pKey crypto.PublicKey, algo httpsig.Algorithm) error {
+	base, err := messageSignatureBase(v.r.Header, v.r, v.sig.components, v.sig.input)
+	if err != nil {
+		return err
+	}
+	sig := v.sig.signature
+	if pub, ok := pKey.(*ecdsa.PublicKey); ok {
+		if sig, err = ecdsaRawToASN1(sig, pub); err != nil {
+			return err
+		}
+	}
+	return verifyWithKey(pKey, base, sig)
+}
+
+// ecdsaASN1ToRaw converts an ASN.1 encoded ECDSA signature to the
+// concatenation of its fixed size r and s values, as RFC 9421 encodes them.
+func ecdsaASN1ToRaw(sig []byte, pub *ecdsa.PublicKey) ([]byte, error) {
+	var es struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(sig, &es); err != nil {
+		return nil, err
+	}
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	raw := make([]byte, 2*size)
+	rb, sb := es.R.Bytes(), es.S.Bytes()
+	copy(raw[size-len(rb):size], rb)
+	copy(raw[2*size-len(sb):], sb)
+	return raw, nil
+}
+
+// ecdsaRawToASN1 converts an ECDSA signature encoded as the concatenation of
+// its fixed size r and s values to ASN.1.
+func ecdsaRawToASN1(sig []byte, pub *ecdsa.PublicKey) ([]byte, error) {
+	size := (pub.Curve.Params().BitSize + 7) / 8
+	if len(sig) != 2*size {
+		return nil, fmt.Errorf("ECDSA signature has %d bytes instead of %d", len(sig), 2*size)
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(sig[:size]),
+		S: new(big.Int).SetBytes(sig[size:]),
+	})
+}
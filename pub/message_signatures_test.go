@@ -0,0 +1,213 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessageSignatures(t *testing.T) {
+	ctx := context.Background()
+	keyId := testFederatedActorIRI + "#main-key"
+	actorIRI := mustParse(testFederatedActorIRI)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newSignedRequest := func(ctl *gomock.Controller, privKey crypto.PrivateKey, components []string) *http.Request {
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		r := httptest.NewRequest("POST", testMyInboxIRI+"?page=1", strings.NewReader("{}"))
+		r.Header.Set("Date", nowDateHeader())
+		s := NewMessageSigner(cl, components, time.Minute)
+		if err := s.SignRequest(privKey, keyId, r, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	getKey := func(pubKey crypto.PublicKey) PublicKeyGetter {
+		return func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+			assertEqual(t, k.String(), keyId)
+			return pubKey, httpsig.RSA_SHA256, actorIRI, nil
+		}
+	}
+	t.Run("SignsSignatureBase", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		// Run
+		r := newSignedRequest(ctl, rsaKey, []string{"@method", "@target-uri", "@path", "@query", "content-digest"})
+		// Verify
+		m, err := parseMessageSignature(r)
+		assertEqual(t, err, nil)
+		assertEqual(t, m.params["keyid"], keyId)
+		base, err := messageSignatureBase(r.Header, r, m.components, m.input)
+		assertEqual(t, err, nil)
+		expected := `"@method": POST
+"@target-uri": https://example.com/addison/inbox?page=1
+"@path": /addison/inbox
+"@query": ?page=1
+"content-digest": sha-256=:RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o=:
+"@signature-params": ("@method" "@target-uri" "@path" "@query" "content-digest");created=949568706;expires=949568766;keyid="` + keyId + `"`
+		assertEqual(t, base, expected)
+	})
+	t.Run("VerifiesRSA", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, rsaKey, []string{"@method", "@target-uri", "date", "content-digest"})
+		// Run
+		signer, err := verifySignature(ctx, r, getKey(rsaKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("VerifiesECDSA", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, ecKey, []string{"@method", "@target-uri", "content-digest"})
+		// Run
+		signer, err := verifySignature(ctx, r, getKey(ecKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsTamperedComponent", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, rsaKey, []string{"@method", "@target-uri", "date"})
+		r.Method = "PUT"
+		// Run
+		_, err := verifySignature(ctx, r, getKey(rsaKey.Public()), nil, nil, 0)
+		// Verify
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+	})
+	t.Run("ChecksCreatedTime", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, rsaKey, nil)
+		r.Header.Del("Date")
+		// Run
+		err := checkSignatureTime(r, now().Add(2*time.Hour), time.Hour)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ResolvesKeyIdForDomainPolicy", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r := newSignedRequest(ctl, rsaKey, nil)
+		// Run
+		v, err := newVerifier(r)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, v.KeyId(), keyId)
+	})
+}
+
+func TestNegotiatingTransport(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		getSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tp = NewNegotiatingTransport(client, "test", cl,
+			NewMessageSigner(cl, nil, 0), NewMessageSigner(cl, []string{"@method", "@target-uri", "content-digest"}, 0),
+			getSigner, postSigner,
+			testMyActorIRI+"#main-key", privKey)
+		return
+	}
+	// expectDo expects a request, and responds Unauthorized to RFC 9421
+	// HTTP Message Signatures if the peer only accepts cavage draft ones.
+	expectDo := func(client *MockHttpClient, cavageOnly bool, messageSigned bool) {
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, isMessageSigned(r), messageSigned)
+			status := http.StatusOK
+			if cavageOnly && isMessageSigned(r) {
+				status = http.StatusUnauthorized
+			}
+			return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+	}
+	t.Run("SignsWithPreferredSigner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		expectDo(client, false, true)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("FallsBackAndRemembers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		gomock.InOrder(
+			client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+				assertEqual(t, isMessageSigned(r), true)
+				assertEqual(t, len(r.Header.Get(contentDigestHeader)) > 0, true)
+				return &http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+			}),
+			client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+				assertEqual(t, isMessageSigned(r), false)
+				b, err := ioutil.ReadAll(r.Body)
+				assertEqual(t, err, nil)
+				assertEqual(t, string(b), "{}")
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+			}),
+		)
+		expectDo(client, true, false)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("ReturnsUnauthorizedIfBothRefused", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		client.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusUnauthorized, Body: ioutil.NopCloser(strings.NewReader(""))}, nil).Times(2)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, err.(*HttpStatusError).StatusCode, http.StatusUnauthorized)
+	})
+}
@@ -0,0 +1,254 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// metricsContextKey is the context key under which WithMetrics stores the
+// Metrics.
+type metricsContextKey struct{}
+
+// Metrics receives measurements of the library's federation traffic, for
+// export to a monitoring system.
+//
+// The Metrics is carried by the context given to the library, so that the
+// Actor, handlers, transports, and delivery queue all report to it without it
+// being passed to each. Use WithMetrics to add it to the contexts of requests
+// and of calls to ProcessDeliveries. Without it, measurements are discarded.
+//
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// InboundActivity counts an activity received in an inbox, by its
+	// ActivityStreams type.
+	InboundActivity(activityType string)
+	// DeliveryAttempt records an attempt to deliver to the host, its
+	// latency, and its error, if it failed.
+	DeliveryAttempt(host string, latency time.Duration, err error)
+	// SignatureVerificationFailure counts a request whose HTTP Signature
+	// failed to verify, by the host of its key id.
+	SignatureVerificationFailure(host string)
+	// QueueDepth records the number of deliveries in a DeliveryQueue.
+	QueueDepth(depth int)
+}
+
+// NoopMetrics is a Metrics that discards every measurement.
+type NoopMetrics struct{}
+
+// NoopMetrics must satisfy the Metrics interface.
+var _ Metrics = NoopMetrics{}
+
+// InboundActivity does nothing.
+func (NoopMetrics) InboundActivity(activityType string) {}
+
+// DeliveryAttempt does nothing.
+func (NoopMetrics) DeliveryAttempt(host string, latency time.Duration, err error) {}
+
+// SignatureVerificationFailure does nothing.
+func (NoopMetrics) SignatureVerificationFailure(host string) {}
+
+// QueueDepth does nothing.
+func (NoopMetrics) QueueDepth(depth int) {}
+
+// WithMetrics returns a copy of the context carrying the Metrics.
+func WithMetrics(c context.Context, m Metrics) context.Context {
+	return context.WithValue(c, metricsContextKey{}, m)
+}
+
+// MetricsFromContext returns the Metrics carried by the context, or
+// NoopMetrics if there is none.
+func MetricsFromContext(c context.Context) Metrics {
+	if m, ok := c.Value(metricsContextKey{}).(Metrics); ok && m != nil {
+		return m
+	}
+	return NoopMetrics{}
+}
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the delivery
+// latency histogram of a PrometheusMetrics.
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// PrometheusMetrics is a Metrics that aggregates the measurements in memory and
+// serves them in the Prometheus text exposition format, so that a Prometheus
+// server can scrape them without the application depending on a client
+// library.
+//
+// It is an http.Handler, typically served at "/metrics". The metrics are:
+//
+//	gofed_inbound_activities_total{type}
+//	gofed_delivery_attempts_total{host,result}
+//	gofed_delivery_duration_seconds{host} (histogram)
+//	gofed_signature_verification_failures_total{host}
+//	gofed_delivery_queue_depth
+type PrometheusMetrics struct {
+	buckets       []float64
+	mu            sync.Mutex
+	inbound       map[string]uint64
+	attempts      map[[2]string]uint64
+	latencies     map[string]*histogram
+	sigFailures   map[string]uint64
+	queueDepth    int
+	hasQueueDepth bool
+}
+
+// histogram is the cumulative bucket counts, sum, and count of observations.
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// PrometheusMetrics must satisfy the Metrics interface.
+var _ Metrics = &PrometheusMetrics{}
+
+// NewPrometheusMetrics creates an empty PrometheusMetrics using the given
+// latency buckets, or DefaultLatencyBuckets if none are given.
+func NewPrometheusMetrics(buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &PrometheusMetrics{
+		buckets:     buckets,
+		inbound:     make(map[string]uint64),
+		attempts:    make(map[[2]string]uint64),
+		latencies:   make(map[string]*histogram),
+		sigFailures: make(map[string]uint64),
+	}
+}
+
+// InboundActivity increments the inbound activity counter of the type.
+func (p *PrometheusMetrics) InboundActivity(activityType string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inbound[activityType]++
+}
+
+// DeliveryAttempt increments the attempt counter of the host and result, and
+// observes the latency.
+func (p *PrometheusMetrics) DeliveryAttempt(host string, latency time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.attempts[[2]string{host, result}]++
+	h, ok := p.latencies[host]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(p.buckets))}
+		p.latencies[host] = h
+	}
+	s := latency.Seconds()
+	for i, le := range p.buckets {
+		if s <= le {
+			h.counts[i]++
+		}
+	}
+	h.sum += s
+	h.count++
+}
+
+// SignatureVerificationFailure increments the failure counter of the host.
+func (p *PrometheusMetrics) SignatureVerificationFailure(host string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sigFailures[host]++
+}
+
+// QueueDepth sets the queue depth gauge.
+func (p *PrometheusMetrics) QueueDepth(depth int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.queueDepth = depth
+	p.hasQueueDepth = true
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (p *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	w.WriteHeader(http.StatusOK)
+	p.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format.
+func (p *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var b strings.Builder
+	b.WriteString("# HELP gofed_inbound_activities_total Activities received in inboxes.\n")
+	b.WriteString("# TYPE gofed_inbound_activities_total counter\n")
+	for _, k := range sortedKeys(p.inbound) {
+		fmt.Fprintf(&b, "gofed_inbound_activities_total{type=%s} %d\n", quoteLabel(k), p.inbound[k])
+	}
+	b.WriteString("# HELP gofed_delivery_attempts_total Delivery attempts by host and result.\n")
+	b.WriteString("# TYPE gofed_delivery_attempts_total counter\n")
+	attempts := make([][2]string, 0, len(p.attempts))
+	for k := range p.attempts {
+		attempts = append(attempts, k)
+	}
+	sort.Slice(attempts, func(i, j int) bool {
+		if attempts[i][0] != attempts[j][0] {
+			return attempts[i][0] < attempts[j][0]
+		}
+		return attempts[i][1] < attempts[j][1]
+	})
+	for _, k := range attempts {
+		fmt.Fprintf(&b, "gofed_delivery_attempts_total{host=%s,result=%s} %d\n", quoteLabel(k[0]), quoteLabel(k[1]), p.attempts[k])
+	}
+	b.WriteString("# HELP gofed_delivery_duration_seconds Delivery latency by host.\n")
+	b.WriteString("# TYPE gofed_delivery_duration_seconds histogram\n")
+	hosts := make([]string, 0, len(p.latencies))
+	for k := range p.latencies {
+		hosts = append(hosts, k)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		h := p.latencies[host]
+		for i, le := range p.buckets {
+			fmt.Fprintf(&b, "gofed_delivery_duration_seconds_bucket{host=%s,le=%q} %d\n", quoteLabel(host), strconv.FormatFloat(le, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(&b, "gofed_delivery_duration_seconds_bucket{host=%s,le=\"+Inf\"} %d\n", quoteLabel(host), h.count)
+		fmt.Fprintf(&b, "gofed_delivery_duration_seconds_sum{host=%s} %s\n", quoteLabel(host), strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "gofed_delivery_duration_seconds_count{host=%s} %d\n", quoteLabel(host), h.count)
+	}
+	b.WriteString("# HELP gofed_signature_verification_failures_total HTTP Signatures that failed to verify, by key host.\n")
+	b.WriteString("# TYPE gofed_signature_verification_failures_total counter\n")
+	for _, k := range sortedKeys(p.sigFailures) {
+		fmt.Fprintf(&b, "gofed_signature_verification_failures_total{host=%s} %d\n", quoteLabel(k), p.sigFailures[k])
+	}
+	if p.hasQueueDepth {
+		b.WriteString("# HELP gofed_delivery_queue_depth Deliveries waiting in the delivery queue.\n")
+		b.WriteString("# TYPE gofed_delivery_queue_depth gauge\n")
+		fmt.Fprintf(&b, "gofed_delivery_queue_depth %d\n", p.queueDepth)
+	}
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// sortedKeys returns the keys of the counters in order.
+func sortedKeys(m map[string]uint64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// quoteLabel quotes a label value, escaping it as the exposition format
+// requires.
+func quoteLabel(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
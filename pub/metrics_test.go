@@ -0,0 +1,87 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetricsFromContext(t *testing.T) {
+	// Setup
+	m := NewPrometheusMetrics()
+	// Run
+	none := MetricsFromContext(context.Background())
+	some := MetricsFromContext(WithMetrics(context.Background(), m))
+	// Verify
+	assertEqual(t, none, NoopMetrics{})
+	assertEqual(t, some, m)
+}
+
+func TestPrometheusMetrics(t *testing.T) {
+	// Setup
+	m := NewPrometheusMetrics(1, 0.1)
+	m.InboundActivity("Create")
+	m.InboundActivity("Create")
+	m.InboundActivity("Follow")
+	m.DeliveryAttempt("example.com", 50*time.Millisecond, nil)
+	m.DeliveryAttempt("example.com", 2*time.Second, errors.New("test error"))
+	m.SignatureVerificationFailure("other.example.com")
+	m.QueueDepth(3)
+	w := httptest.NewRecorder()
+	// Run
+	m.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	// Verify
+	body := w.Body.String()
+	for _, line := range []string{
+		`gofed_inbound_activities_total{type="Create"} 2`,
+		`gofed_inbound_activities_total{type="Follow"} 1`,
+		`gofed_delivery_attempts_total{host="example.com",result="failure"} 1`,
+		`gofed_delivery_attempts_total{host="example.com",result="success"} 1`,
+		`gofed_delivery_duration_seconds_bucket{host="example.com",le="0.1"} 1`,
+		`gofed_delivery_duration_seconds_bucket{host="example.com",le="1"} 1`,
+		`gofed_delivery_duration_seconds_bucket{host="example.com",le="+Inf"} 2`,
+		`gofed_delivery_duration_seconds_sum{host="example.com"} 2.05`,
+		`gofed_delivery_duration_seconds_count{host="example.com"} 2`,
+		`gofed_signature_verification_failures_total{host="other.example.com"} 1`,
+		`gofed_delivery_queue_depth 3`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("missing %q in:\n%s", line, body)
+		}
+	}
+}
+
+func TestProcessDeliveriesReportsQueueDepth(t *testing.T) {
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	m := NewPrometheusMetrics()
+	ctx := WithMetrics(context.Background(), m)
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	q := NewMemoryDeliveryQueue(cl)
+	tp := NewMockTransport(ctl)
+	qt := NewQueuedTransport(tp, q, mustParse(testMyOutboxIRI), cl)
+	err := qt.BatchDeliver(ctx, payload, []*url.URL{recipient, mustParse(testFederatedActorIRI2)})
+	assertEqual(t, err, nil)
+	tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil)
+	// Run
+	_, err = ProcessDeliveries(ctx, q, 1, cl, func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+		return tp, nil
+	}, nil)
+	// Verify
+	assertEqual(t, err, nil)
+	var b bytes.Buffer
+	m.WriteTo(&b)
+	if !strings.Contains(b.String(), "gofed_delivery_queue_depth 1\n") {
+		t.Errorf("missing queue depth in:\n%s", b.String())
+	}
+}
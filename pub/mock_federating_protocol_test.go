@@ -112,18 +112,18 @@ func (mr *MockFederatingProtocolMockRecorder) DefaultCallback(c, activity interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCallback", reflect.TypeOf((*MockFederatingProtocol)(nil).DefaultCallback), c, activity)
 }
 
-// MaxInboxForwardingRecursionDepth mocks base method
-func (m *MockFederatingProtocol) MaxInboxForwardingRecursionDepth(c context.Context) int {
+// DereferencePolicy mocks base method
+func (m *MockFederatingProtocol) DereferencePolicy(c context.Context) DereferencePolicy {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MaxInboxForwardingRecursionDepth", c)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "DereferencePolicy", c)
+	ret0, _ := ret[0].(DereferencePolicy)
 	return ret0
 }
 
-// MaxInboxForwardingRecursionDepth indicates an expected call of MaxInboxForwardingRecursionDepth
-func (mr *MockFederatingProtocolMockRecorder) MaxInboxForwardingRecursionDepth(c interface{}) *gomock.Call {
+// DereferencePolicy indicates an expected call of DereferencePolicy
+func (mr *MockFederatingProtocolMockRecorder) DereferencePolicy(c interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxInboxForwardingRecursionDepth", reflect.TypeOf((*MockFederatingProtocol)(nil).MaxInboxForwardingRecursionDepth), c)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DereferencePolicy", reflect.TypeOf((*MockFederatingProtocol)(nil).DereferencePolicy), c)
 }
 
 // MaxDeliveryRecursionDepth mocks base method
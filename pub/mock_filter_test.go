@@ -0,0 +1,48 @@
+package pub
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: filter.go
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockFilter is a mock of Filter interface
+type MockFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilterMockRecorder
+}
+
+// MockFilterMockRecorder is the mock recorder for MockFilter
+type MockFilterMockRecorder struct {
+	mock *MockFilter
+}
+
+// NewMockFilter creates a new mock instance
+func NewMockFilter(ctrl *gomock.Controller) *MockFilter {
+	mock := &MockFilter{ctrl: ctrl}
+	mock.recorder = &MockFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFilter) EXPECT() *MockFilterMockRecorder {
+	return m.recorder
+}
+
+// FilterInbox mocks base method
+func (m *MockFilter) FilterInbox(c context.Context, activity Activity) (Verdict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterInbox", c, activity)
+	ret0, _ := ret[0].(Verdict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterInbox indicates an expected call of FilterInbox
+func (mr *MockFilterMockRecorder) FilterInbox(c, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterInbox", reflect.TypeOf((*MockFilter)(nil).FilterInbox), c, activity)
+}
@@ -0,0 +1,155 @@
+package pub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	// flagReportScheme is the scheme of the ids recorded in a SeenStore for
+	// the reports made by Flag activities.
+	flagReportScheme = "urn"
+	// flagReportPrefix prefixes the ids recorded in a SeenStore for the
+	// reports made by Flag activities.
+	flagReportPrefix = "go-fed:flag:"
+)
+
+// Report is a report made by a Flag activity, as sent by Mastodon when a user
+// reports content of another server to its moderators.
+type Report struct {
+	// Flag is the activity making the report.
+	Flag vocab.ActivityStreamsFlag
+	// Reporter is the actor of the Flag, which is often the instance actor
+	// of the reporting server rather than the reporting user.
+	Reporter *url.URL
+	// Comment is the 'content' of the Flag explaining the report. It may be
+	// empty.
+	Comment string
+	// Flagged are the IRIs of the flagged accounts and objects, without
+	// duplicates.
+	Flagged []*url.URL
+	// Objects are the flagged accounts and objects that could be obtained,
+	// from the Database if owned by this server and dereferenced otherwise.
+	Objects []vocab.Type
+	// Unresolved are the IRIs of the flagged accounts and objects that could
+	// not be obtained.
+	Unresolved []*url.URL
+}
+
+// ModerationReporter receives the reports made by Flag activities, so that
+// they reach the moderators of the application.
+type ModerationReporter interface {
+	// Report is called once for each distinct report received in an inbox.
+	//
+	// Returning an error fails the handling of the Flag.
+	Report(c context.Context, r *Report) error
+}
+
+// getFlagReport validates a Flag and obtains its flagged objects. It must have
+// an actor and flag at least one account or object owned by this server.
+// Flagged IRIs are deduplicated.
+func getFlagReport(c context.Context, db Database, tp Transport, a vocab.ActivityStreamsFlag) (*Report, error) {
+	actors, err := getActorIds(a)
+	if err != nil {
+		return nil, err
+	} else if len(actors) == 0 {
+		return nil, fmt.Errorf("flag has no actor")
+	}
+	r := &Report{
+		Flag:     a,
+		Reporter: actors[0],
+		Comment:  getFlagComment(a),
+	}
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return nil, ErrObjectRequired
+	}
+	flagsOwned := false
+	seen := make(map[string]bool, op.Len())
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			return nil, err
+		}
+		if seen[id.String()] {
+			continue
+		}
+		seen[id.String()] = true
+		r.Flagged = append(r.Flagged, id)
+		owns, err := isOwned(c, db, id)
+		if err != nil {
+			return nil, err
+		}
+		var t vocab.Type
+		if owns {
+			flagsOwned = true
+			if t, err = getIfExists(c, db, id); err != nil {
+				return nil, err
+			}
+		} else if t, err = dereferenceType(c, tp, id); err != nil {
+			logEntry(c, LogLevelDebug, "fetching flagged object failed",
+				LogField{Key: "iri", Value: id}, errorLogField(err))
+			t = nil
+		}
+		if t == nil {
+			r.Unresolved = append(r.Unresolved, id)
+			continue
+		}
+		r.Objects = append(r.Objects, t)
+	}
+	if !flagsOwned {
+		return nil, fmt.Errorf("flag does not report anything owned by this server")
+	}
+	return r, nil
+}
+
+// getFlagComment returns the first string 'content' of a Flag.
+func getFlagComment(a vocab.ActivityStreamsFlag) string {
+	content := a.GetActivityStreamsContent()
+	if content == nil {
+		return ""
+	}
+	for iter := content.Begin(); iter != content.End(); iter = iter.Next() {
+		if iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString()
+		} else if iter.IsRDFLangString() {
+			for _, v := range iter.GetRDFLangString() {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// isOwned determines whether the IRI is owned by this server.
+//
+// Acquires and releases the lock of the IRI.
+func isOwned(c context.Context, db Database, iri *url.URL) (bool, error) {
+	if err := db.Lock(c, iri); err != nil {
+		return false, err
+	}
+	defer db.Unlock(c, iri)
+	return db.Owns(c, iri)
+}
+
+// reportId returns an id identifying the report by its reporter and flagged
+// IRIs, so that a report sent again as a new Flag, such as when forwarded, is
+// recorded in a SeenStore as already seen.
+func reportId(r *Report) *url.URL {
+	iris := make([]string, len(r.Flagged))
+	for i, id := range r.Flagged {
+		iris[i] = id.String()
+	}
+	sort.Strings(iris)
+	h := sha256.Sum256([]byte(r.Reporter.String() + "\n" + strings.Join(iris, "\n")))
+	return &url.URL{
+		Scheme: flagReportScheme,
+		Opaque: flagReportPrefix + hex.EncodeToString(h[:]),
+	}
+}
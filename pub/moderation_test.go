@@ -0,0 +1,131 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// testModerationReporter records the reports it receives.
+type testModerationReporter struct {
+	reports []*Report
+}
+
+func (r *testModerationReporter) Report(c context.Context, report *Report) error {
+	r.reports = append(r.reports, report)
+	return nil
+}
+
+// newTestFlag creates a Flag by the actor of the objects, with the comment as
+// its content.
+func newTestFlag(id string, actor *url.URL, comment string, objects ...*url.URL) vocab.ActivityStreamsFlag {
+	flag := streams.NewActivityStreamsFlag()
+	idp := streams.NewActivityStreamsIdProperty()
+	idp.Set(mustParse(id))
+	flag.SetActivityStreamsId(idp)
+	if actor != nil {
+		ap := streams.NewActivityStreamsActorProperty()
+		ap.AppendIRI(actor)
+		flag.SetActivityStreamsActor(ap)
+	}
+	op := streams.NewActivityStreamsObjectProperty()
+	for _, o := range objects {
+		op.AppendIRI(o)
+	}
+	flag.SetActivityStreamsObject(op)
+	if comment != "" {
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString(comment)
+		flag.SetActivityStreamsContent(content)
+	}
+	return flag
+}
+
+func TestGetFlagReport(t *testing.T) {
+	ctx := context.Background()
+	reporterIRI := mustParse(testFederatedActorIRI)
+	noteIRI := mustParse(testNoteId1)
+	remoteIRI := mustParse(testFederatedActivityIRI)
+	testErr := errors.New("test error")
+	t.Run("ObtainsFlaggedObjects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		note := streams.NewActivityStreamsNote()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Exists(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+			db.EXPECT().Lock(ctx, remoteIRI),
+			db.EXPECT().Owns(ctx, remoteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, remoteIRI),
+			tp.EXPECT().Dereference(ctx, remoteIRI).Return(nil, testErr),
+		)
+		// Run
+		r, err := getFlagReport(ctx, db, tp, newTestFlag(testNewActivityIRI, reporterIRI, "spam", noteIRI, remoteIRI, noteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, r.Reporter.String(), testFederatedActorIRI)
+		assertEqual(t, r.Comment, "spam")
+		assertEqual(t, len(r.Flagged), 2)
+		assertEqual(t, len(r.Objects), 1)
+		assertEqual(t, r.Objects[0], note)
+		assertEqual(t, len(r.Unresolved), 1)
+		assertEqual(t, r.Unresolved[0].String(), testFederatedActivityIRI)
+	})
+	t.Run("ErrorIfNothingOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, remoteIRI),
+			db.EXPECT().Owns(ctx, remoteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, remoteIRI),
+			tp.EXPECT().Dereference(ctx, remoteIRI).Return(nil, testErr),
+		)
+		// Run
+		_, err := getFlagReport(ctx, db, tp, newTestFlag(testNewActivityIRI, reporterIRI, "", remoteIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfNoActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		// Run
+		_, err := getFlagReport(ctx, db, tp, newTestFlag(testNewActivityIRI, nil, "", noteIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
+
+func TestReportId(t *testing.T) {
+	a := &Report{
+		Reporter: mustParse(testFederatedActorIRI),
+		Flagged:  []*url.URL{mustParse(testNoteId1), mustParse(testNoteId2)},
+	}
+	b := &Report{
+		Reporter: mustParse(testFederatedActorIRI),
+		Flagged:  []*url.URL{mustParse(testNoteId2), mustParse(testNoteId1)},
+	}
+	c := &Report{
+		Reporter: mustParse(testFederatedActorIRI2),
+		Flagged:  []*url.URL{mustParse(testNoteId1), mustParse(testNoteId2)},
+	}
+	assertEqual(t, reportId(a).String(), reportId(b).String())
+	assertNotEqual(t, reportId(a).String(), reportId(c).String())
+}
@@ -0,0 +1,172 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+const (
+	// alsoKnownAsProperty is the actor property listing the other actors
+	// that are aliases of the actor, as used for account migration.
+	alsoKnownAsProperty = "alsoKnownAs"
+	// movedToProperty is the Mastodon extension property on an actor that
+	// has moved, referring to the actor it moved to.
+	movedToProperty = "movedTo"
+)
+
+// AlsoKnownAs returns the IRIs in the 'alsoKnownAs' property of an actor, which
+// lists the actors it is an alias of.
+func AlsoKnownAs(t vocab.Type) (iris []*url.URL) {
+	a, ok := t.(alsoKnownAser)
+	if !ok || a.GetActivityStreamsAlsoKnownAs() == nil {
+		return
+	}
+	a.GetActivityStreamsAlsoKnownAs().ForEach(func(_ int, it vocab.ActivityStreamsAlsoKnownAsPropertyIterator) bool {
+		if iri := it.Get(); iri != nil && iri.IsAbs() {
+			iris = append(iris, iri)
+		}
+		return true
+	})
+	return
+}
+
+// SetAlsoKnownAs sets the 'alsoKnownAs' property of an actor. An actor lists
+// the actor it moves from as an alias before that actor sends its Move.
+func SetAlsoKnownAs(t vocab.Type, iris []*url.URL) error {
+	a, ok := t.(alsoKnownAser)
+	if !ok {
+		return fmt.Errorf("cannot set %s on type %T", alsoKnownAsProperty, t)
+	}
+	p := streams.NewActivityStreamsAlsoKnownAsProperty()
+	for _, iri := range iris {
+		p.AppendXMLSchemaAnyURI(iri)
+	}
+	a.SetActivityStreamsAlsoKnownAs(p)
+	return nil
+}
+
+// MovedTo returns the IRI in the 'movedTo' property of an actor, or nil if it
+// has not moved.
+//
+// The 'movedTo' property is not part of the ActivityStreams vocabulary, so it
+// is obtained from the actor's unknown properties.
+func MovedTo(t vocab.Type) *url.URL {
+	u, ok := t.(unknownPropertieser)
+	if !ok {
+		return nil
+	}
+	s, ok := u.GetUnknownProperties()[movedToProperty].(string)
+	if !ok {
+		return nil
+	}
+	iri, err := url.Parse(s)
+	if err != nil || !iri.IsAbs() {
+		return nil
+	}
+	return iri
+}
+
+// SetMovedTo sets the 'movedTo' property of an actor to the actor it moves
+// to, before it sends its Move.
+func SetMovedTo(t vocab.Type, iri *url.URL) error {
+	u, ok := t.(unknownPropertieser)
+	if !ok {
+		return fmt.Errorf("cannot set %s on type %T", movedToProperty, t)
+	}
+	u.GetUnknownProperties()[movedToProperty] = iri.String()
+	return nil
+}
+
+// NewMove creates a Move of the origin actor to the target actor, addressed to
+// the origin's followers, in the manner of Mastodon's account migration.
+//
+// Peers only accept it if the target's 'alsoKnownAs' lists the origin, and the
+// origin's 'movedTo' is the target.
+func NewMove(origin, target, followers *url.URL) vocab.ActivityStreamsMove {
+	move := streams.NewActivityStreamsMove()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(origin)
+	move.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(origin)
+	move.SetActivityStreamsObject(op)
+	tp := streams.NewActivityStreamsTargetProperty()
+	tp.AppendIRI(target)
+	move.SetActivityStreamsTarget(tp)
+	if followers != nil {
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(followers)
+		move.SetActivityStreamsTo(to)
+	}
+	return move
+}
+
+// getMove returns the origin and target actors of a Move, which must be moving
+// its own actor to a single target.
+func getMove(a vocab.ActivityStreamsMove) (origin, target *url.URL, err error) {
+	op := a.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		err = ErrObjectRequired
+		return
+	} else if op.Len() != 1 {
+		err = fmt.Errorf("move must have exactly one object, got %d", op.Len())
+		return
+	}
+	tp := a.GetActivityStreamsTarget()
+	if tp == nil || tp.Len() == 0 {
+		err = ErrTargetRequired
+		return
+	} else if tp.Len() != 1 {
+		err = fmt.Errorf("move must have exactly one target, got %d", tp.Len())
+		return
+	}
+	if origin, err = ToId(op.At(0)); err != nil {
+		return
+	}
+	if target, err = ToId(tp.At(0)); err != nil {
+		return
+	}
+	actors, err := getActorIds(a)
+	if err != nil {
+		return
+	}
+	for _, actor := range actors {
+		if actor.String() == origin.String() {
+			return
+		}
+	}
+	err = fmt.Errorf("move of %s is not by that actor", origin)
+	return
+}
+
+// verifyMove checks that the origin and target actors of a Move refer to each
+// other: the target lists the origin in its 'alsoKnownAs' and the origin's
+// 'movedTo' is the target. Both are dereferenced, so that the check does not
+// rely on copies of the actors sent by a peer.
+func verifyMove(c context.Context, tp Transport, origin, target *url.URL) error {
+	targetActor, err := dereferenceType(c, tp, target)
+	if err != nil {
+		return err
+	}
+	isAlias := false
+	for _, iri := range AlsoKnownAs(targetActor) {
+		if iri.String() == origin.String() {
+			isAlias = true
+			break
+		}
+	}
+	if !isAlias {
+		return fmt.Errorf("move target %s does not list %s in %s", target, origin, alsoKnownAsProperty)
+	}
+	originActor, err := dereferenceType(c, tp, origin)
+	if err != nil {
+		return err
+	}
+	if movedTo := MovedTo(originActor); movedTo == nil || movedTo.String() != target.String() {
+		return fmt.Errorf("move origin %s has not %s %s", origin, movedToProperty, target)
+	}
+	return nil
+}
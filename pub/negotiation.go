@@ -0,0 +1,165 @@
+package pub
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// activityJSONMediaType is the ActivityStreams media type.
+	activityJSONMediaType = "application/activity+json"
+	// jsonLDMediaType is the JSON-LD media type, which is an ActivityStreams
+	// one with the activityStreamsProfile.
+	jsonLDMediaType = "application/ld+json"
+	// activityStreamsProfile is the profile of the JSON-LD media type
+	// identifying ActivityStreams.
+	activityStreamsProfile = "https://www.w3.org/ns/activitystreams"
+	// profileParam is the media type parameter holding profiles.
+	profileParam = "profile"
+	// qualityParam is the media type parameter holding the quality of an
+	// Accept entry.
+	qualityParam = "q"
+)
+
+// htmlMediaTypes are the media types of web pages.
+var htmlMediaTypes = []string{"text/html", "application/xhtml+xml"}
+
+// DefaultNegotiator determines which requests are ActivityPub ones for the
+// Actors and handlers of this package. It may be replaced or configured before
+// they are used.
+var DefaultNegotiator = &Negotiator{PreferHTML: true}
+
+// Negotiator determines whether HTTP requests are ActivityPub ones from their
+// Accept and Content-Type headers.
+//
+// Headers are parsed as lists of media types, so that parameters, quality
+// values, and several entries or header lines are handled. The
+// "application/activity+json" media type is ActivityStreams, as is
+// "application/ld+json" with the ActivityStreams profile among its profiles.
+type Negotiator struct {
+	// MediaTypes are additional media types treated as ActivityStreams,
+	// such as "application/ld+json" without a profile or
+	// "application/json", as sent by some peers.
+	MediaTypes []string
+	// PreferHTML, if true, does not treat a GET request as an ActivityPub
+	// one when it accepts HTML with a higher quality than ActivityStreams,
+	// so that the application serves a web page to it instead.
+	PreferHTML bool
+}
+
+// IsActivityPubRequest determines whether the request is an ActivityPub GET or
+// POST request according to the DefaultNegotiator.
+func IsActivityPubRequest(r *http.Request) bool {
+	return DefaultNegotiator.IsActivityPubGet(r) || DefaultNegotiator.IsActivityPubPost(r)
+}
+
+// IsActivityPubGet determines whether the request is a GET request accepting
+// ActivityStreams.
+func (n *Negotiator) IsActivityPubGet(r *http.Request) bool {
+	return r.Method == "GET" && n.AcceptsActivityStreams(headerValues(r.Header, acceptHeader))
+}
+
+// IsActivityPubPost determines whether the request is a POST request whose
+// content is ActivityStreams.
+func (n *Negotiator) IsActivityPubPost(r *http.Request) bool {
+	return r.Method == "POST" && n.IsActivityStreams(headerValues(r.Header, contentTypeHeader))
+}
+
+// AcceptsActivityStreams determines whether the Accept header accepts
+// ActivityStreams, and, if PreferHTML is set, does not prefer HTML.
+func (n *Negotiator) AcceptsActivityStreams(accept string) bool {
+	as, html := n.qualities(accept)
+	if as <= 0 {
+		return false
+	}
+	return !n.PreferHTML || as >= html
+}
+
+// IsActivityStreams determines whether any of the comma separated media types
+// is an ActivityStreams one that is not refused with a zero quality.
+func (n *Negotiator) IsActivityStreams(mediaTypes string) bool {
+	as, _ := n.qualities(mediaTypes)
+	return as > 0
+}
+
+// qualities returns the highest qualities of the ActivityStreams and HTML media
+// types in the list, or zero if they are absent.
+func (n *Negotiator) qualities(list string) (as, html float64) {
+	for _, entry := range strings.Split(list, ",") {
+		if len(strings.TrimSpace(entry)) == 0 {
+			continue
+		}
+		mediaType, params := parseMediaType(entry)
+		q := 1.0
+		if s, ok := params[qualityParam]; ok {
+			var err error
+			if q, err = strconv.ParseFloat(s, 64); err != nil {
+				continue
+			}
+		}
+		if n.isActivityStreams(mediaType, params) {
+			if q > as {
+				as = q
+			}
+		} else if isHTML(mediaType) && q > html {
+			html = q
+		}
+	}
+	return
+}
+
+// parseMediaType returns the lowercase media type and the parameters of an
+// entry of a media type list.
+//
+// Unlike mime.ParseMediaType, parameter values such as profile IRIs are
+// accepted without quotes, as peers send them.
+func parseMediaType(entry string) (mediaType string, params map[string]string) {
+	parts := strings.Split(entry, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(parts[0]))
+	params = make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		i := strings.Index(p, "=")
+		if i < 0 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(p[:i]))
+		params[key] = strings.Trim(strings.TrimSpace(p[i+1:]), "\"")
+	}
+	return
+}
+
+// isActivityStreams determines whether the lowercase media type and its
+// parameters are ActivityStreams.
+func (n *Negotiator) isActivityStreams(mediaType string, params map[string]string) bool {
+	if mediaType == activityJSONMediaType {
+		return true
+	} else if mediaType == jsonLDMediaType {
+		for _, p := range strings.Fields(params[profileParam]) {
+			if p == activityStreamsProfile {
+				return true
+			}
+		}
+	}
+	for _, m := range n.MediaTypes {
+		if strings.EqualFold(m, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHTML determines whether the lowercase media type is one of a web page.
+func isHTML(mediaType string) bool {
+	for _, m := range htmlMediaTypes {
+		if m == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// headerValues joins the values of all of the lines of the header.
+func headerValues(h http.Header, key string) string {
+	return strings.Join(h[http.CanonicalHeaderKey(key)], ",")
+}
@@ -0,0 +1,99 @@
+package pub
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNegotiatorAcceptsActivityStreams(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			"Mastodon Accept Header",
+			"application/activity+json, application/ld+json",
+			true,
+		},
+		{
+			"Uppercase",
+			"Application/Activity+JSON",
+			true,
+		},
+		{
+			"Profile After Other Parameters",
+			"application/ld+json; charset=utf-8; profile=\"https://www.w3.org/ns/activitystreams\"",
+			true,
+		},
+		{
+			"Several Profiles",
+			"application/ld+json; profile=\"https://example.com/profile https://www.w3.org/ns/activitystreams\"",
+			true,
+		},
+		{
+			"Other Profile",
+			"application/ld+json; profile=\"https://example.com/profile\"",
+			false,
+		},
+		{
+			"Refused",
+			"application/activity+json;q=0, text/html",
+			false,
+		},
+		{
+			"Prefers HTML",
+			"text/html, application/activity+json;q=0.9",
+			false,
+		},
+		{
+			"Equally Accepts HTML",
+			"text/html, application/activity+json",
+			true,
+		},
+		{
+			"Prefers ActivityStreams",
+			"application/activity+json, text/html;q=0.1",
+			true,
+		},
+		{
+			"Browser",
+			"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			false,
+		},
+	}
+	n := &Negotiator{PreferHTML: true}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := n.AcceptsActivityStreams(test.input); actual != test.expected {
+				t.Fatalf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNegotiator(t *testing.T) {
+	t.Run("AcceptsAdditionalMediaTypes", func(t *testing.T) {
+		n := &Negotiator{MediaTypes: []string{"application/ld+json"}}
+		assertEqual(t, n.IsActivityStreams("application/ld+json"), true)
+		assertEqual(t, DefaultNegotiator.IsActivityStreams("application/ld+json"), false)
+	})
+	t.Run("IgnoresHTMLUnlessPreferred", func(t *testing.T) {
+		n := &Negotiator{}
+		assertEqual(t, n.AcceptsActivityStreams("text/html, application/activity+json;q=0.9"), true)
+	})
+	t.Run("ReadsAllAcceptHeaderLines", func(t *testing.T) {
+		r := httptest.NewRequest("GET", testMyActorIRI, nil)
+		r.Header.Add(acceptHeader, "application/json")
+		r.Header.Add(acceptHeader, "application/activity+json")
+		assertEqual(t, DefaultNegotiator.IsActivityPubGet(r), true)
+		assertEqual(t, DefaultNegotiator.IsActivityPubPost(r), false)
+		assertEqual(t, IsActivityPubRequest(r), true)
+	})
+	t.Run("ChecksPostContentType", func(t *testing.T) {
+		r := httptest.NewRequest("POST", testMyInboxIRI, nil)
+		r.Header.Set(contentTypeHeader, "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\"")
+		assertEqual(t, DefaultNegotiator.IsActivityPubPost(r), true)
+		assertEqual(t, DefaultNegotiator.IsActivityPubGet(r), false)
+	})
+}
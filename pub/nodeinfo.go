@@ -0,0 +1,184 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+const (
+	// NodeInfoWellKnownPath is the path of the document through which
+	// crawlers discover the NodeInfo documents of a server.
+	NodeInfoWellKnownPath = "/.well-known/nodeinfo"
+	// nodeInfoPath is the path prefix of the NodeInfo documents, which are
+	// followed by their version.
+	nodeInfoPath = "/nodeinfo/"
+	// nodeInfoSchema is the prefix of the rel of a NodeInfo version and the
+	// profile of its media type, which are followed by the version.
+	nodeInfoSchema = "http://nodeinfo.diaspora.software/ns/schema/"
+	// nodeInfoProtocol is the protocol listed when the NodeInfo of the
+	// application lists none.
+	nodeInfoProtocol = "activitypub"
+)
+
+// nodeInfoVersions are the NodeInfo versions served, in the order they are
+// listed in the discovery document.
+var nodeInfoVersions = []string{"2.0", "2.1"}
+
+// NodeInfoSoftware describes the software of a server in its NodeInfo.
+type NodeInfoSoftware struct {
+	// Name is the canonical name of the software, in lowercase letters,
+	// digits, and hyphens.
+	Name string `json:"name"`
+	// Version is the version of the software.
+	Version string `json:"version"`
+	// Repository is the URL of the source code repository. It is only
+	// served in NodeInfo 2.1.
+	Repository string `json:"repository,omitempty"`
+	// Homepage is the URL of the homepage of the software. It is only
+	// served in NodeInfo 2.1.
+	Homepage string `json:"homepage,omitempty"`
+}
+
+// NodeInfoServices lists the third party sites a server can import from and
+// publish to.
+type NodeInfoServices struct {
+	Inbound  []string `json:"inbound"`
+	Outbound []string `json:"outbound"`
+}
+
+// NodeInfoUsers counts the users of a server.
+type NodeInfoUsers struct {
+	Total          int `json:"total"`
+	ActiveHalfyear int `json:"activeHalfyear"`
+	ActiveMonth    int `json:"activeMonth"`
+}
+
+// NodeInfoUsage holds the usage statistics of a server.
+type NodeInfoUsage struct {
+	Users         NodeInfoUsers `json:"users"`
+	LocalPosts    int           `json:"localPosts"`
+	LocalComments int           `json:"localComments"`
+}
+
+// NodeInfo is the NodeInfo document describing a server, as read by fediverse
+// crawlers and by peers displaying the software they federate with.
+type NodeInfo struct {
+	// Version is the NodeInfo version of the document. It is set when
+	// served.
+	Version  string           `json:"version"`
+	Software NodeInfoSoftware `json:"software"`
+	// Protocols are the protocols supported by the server. If empty,
+	// "activitypub" is served.
+	Protocols         []string         `json:"protocols"`
+	Services          NodeInfoServices `json:"services"`
+	OpenRegistrations bool             `json:"openRegistrations"`
+	Usage             NodeInfoUsage    `json:"usage"`
+	// Metadata is free form information about the server, such as its
+	// name.
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// NodeInfoFunc supplies the NodeInfo of the server, with up to date usage
+// statistics and metadata.
+type NodeInfoFunc func(c context.Context) (NodeInfo, error)
+
+// nodeInfoLink is a link of the NodeInfo discovery document.
+type nodeInfoLink struct {
+	Rel  string `json:"rel"`
+	Href string `json:"href"`
+}
+
+// nodeInfoDiscovery is the NodeInfo discovery document.
+type nodeInfoDiscovery struct {
+	Links []nodeInfoLink `json:"links"`
+}
+
+// NewNodeInfoHandler creates a HandlerFunc serving the NodeInfo discovery
+// document at the NodeInfoWellKnownPath, and the NodeInfo 2.0 and 2.1
+// documents supplied by the function at "/nodeinfo/2.0" and "/nodeinfo/2.1" of
+// the host of the base IRI. Requests to any other path are not handled.
+func NewNodeInfoHandler(base *url.URL, fn NodeInfoFunc) HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if r.Method != "GET" {
+			return
+		}
+		var v interface{}
+		contentType := "application/json"
+		if r.URL.Path == NodeInfoWellKnownPath {
+			d := nodeInfoDiscovery{}
+			for _, version := range nodeInfoVersions {
+				href := *base
+				href.Path = nodeInfoPath + version
+				href.RawQuery = ""
+				d.Links = append(d.Links, nodeInfoLink{
+					Rel:  nodeInfoSchema + version,
+					Href: href.String(),
+				})
+			}
+			v = d
+		} else if dir, version := path.Split(r.URL.Path); dir == nodeInfoPath && isNodeInfoVersion(version) {
+			isASRequest = true
+			var ni NodeInfo
+			if ni, err = fn(c); err != nil {
+				return
+			}
+			v = ni.forVersion(version)
+			contentType = fmt.Sprintf("application/json; profile=\"%s%s#\"", nodeInfoSchema, version)
+		} else {
+			return
+		}
+		isASRequest = true
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return
+		}
+		w.Header().Set(contentTypeHeader, contentType)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// isNodeInfoVersion determines whether the NodeInfo version is served.
+func isNodeInfoVersion(version string) bool {
+	for _, v := range nodeInfoVersions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// forVersion returns a copy of the NodeInfo as served for the version, with
+// the defaults of its required fields set.
+func (n NodeInfo) forVersion(version string) NodeInfo {
+	n.Version = version
+	if version == "2.0" {
+		n.Software.Repository = ""
+		n.Software.Homepage = ""
+	}
+	if len(n.Protocols) == 0 {
+		n.Protocols = []string{nodeInfoProtocol}
+	}
+	if n.Services.Inbound == nil {
+		n.Services.Inbound = []string{}
+	}
+	if n.Services.Outbound == nil {
+		n.Services.Outbound = []string{}
+	}
+	if n.Metadata == nil {
+		n.Metadata = map[string]interface{}{}
+	}
+	return n
+}
@@ -0,0 +1,104 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNodeInfoHandler(t *testing.T) {
+	ctx := context.Background()
+	base := mustParse("https://example.com")
+	info := NodeInfo{
+		Software: NodeInfoSoftware{
+			Name:       "example",
+			Version:    "1.0.0",
+			Repository: "https://example.com/source",
+		},
+		OpenRegistrations: true,
+		Usage: NodeInfoUsage{
+			Users:      NodeInfoUsers{Total: 3, ActiveMonth: 2},
+			LocalPosts: 42,
+		},
+	}
+	fn := func(c context.Context) (NodeInfo, error) {
+		return info, nil
+	}
+	t.Run("ServesDiscovery", func(t *testing.T) {
+		// Setup
+		req := httptest.NewRequest("GET", "https://example.com"+NodeInfoWellKnownPath, nil)
+		resp := httptest.NewRecorder()
+		// Run
+		handled, err := NewNodeInfoHandler(base, fn)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		var d nodeInfoDiscovery
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &d), nil)
+		assertEqual(t, len(d.Links), 2)
+		assertEqual(t, d.Links[0].Rel, "http://nodeinfo.diaspora.software/ns/schema/2.0")
+		assertEqual(t, d.Links[0].Href, "https://example.com/nodeinfo/2.0")
+		assertEqual(t, d.Links[1].Rel, "http://nodeinfo.diaspora.software/ns/schema/2.1")
+		assertEqual(t, d.Links[1].Href, "https://example.com/nodeinfo/2.1")
+	})
+	t.Run("Serves21", func(t *testing.T) {
+		// Setup
+		req := httptest.NewRequest("GET", "https://example.com/nodeinfo/2.1", nil)
+		resp := httptest.NewRecorder()
+		// Run
+		handled, err := NewNodeInfoHandler(base, fn)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Header().Get(contentTypeHeader), "application/json; profile=\"http://nodeinfo.diaspora.software/ns/schema/2.1#\"")
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &m), nil)
+		assertEqual(t, m["version"], "2.1")
+		assertEqual(t, m["openRegistrations"], true)
+		assertEqual(t, m["software"].(map[string]interface{})["repository"], "https://example.com/source")
+		assertEqual(t, m["protocols"].([]interface{})[0], "activitypub")
+		assertEqual(t, len(m["services"].(map[string]interface{})["inbound"].([]interface{})), 0)
+		assertEqual(t, m["usage"].(map[string]interface{})["localPosts"], float64(42))
+	})
+	t.Run("Serves20WithoutRepository", func(t *testing.T) {
+		// Setup
+		req := httptest.NewRequest("GET", "https://example.com/nodeinfo/2.0", nil)
+		resp := httptest.NewRecorder()
+		// Run
+		handled, err := NewNodeInfoHandler(base, fn)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(resp.Body.Bytes(), &m), nil)
+		assertEqual(t, m["version"], "2.0")
+		_, ok := m["software"].(map[string]interface{})["repository"]
+		assertEqual(t, ok, false)
+	})
+	t.Run("IgnoresOtherPaths", func(t *testing.T) {
+		// Setup
+		req := httptest.NewRequest("GET", "https://example.com/nodeinfo/1.0", nil)
+		resp := httptest.NewRecorder()
+		// Run
+		handled, err := NewNodeInfoHandler(base, fn)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, false)
+	})
+	t.Run("ReturnsFuncError", func(t *testing.T) {
+		// Setup
+		testErr := errors.New("test error")
+		req := httptest.NewRequest("GET", "https://example.com/nodeinfo/2.1", nil)
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewNodeInfoHandler(base, func(c context.Context) (NodeInfo, error) {
+			return NodeInfo{}, testErr
+		})(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+}
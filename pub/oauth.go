@@ -0,0 +1,162 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ScopeWrite is the OAuth 2.0 scope allowing a client to post any
+	// activity to the outbox of its actor.
+	ScopeWrite = "write"
+	// authorizationHeader is the header in which clients send their
+	// credentials.
+	authorizationHeader = "Authorization"
+	// wwwAuthenticateHeader is the header challenging a client to
+	// authenticate.
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	// bearerScheme is the authentication scheme of OAuth 2.0 bearer tokens.
+	bearerScheme = "Bearer"
+	// oauthAuthorizationEndpointProperty is the endpoint at which a client
+	// obtains the authorization of the actor.
+	oauthAuthorizationEndpointProperty = "oauthAuthorizationEndpoint"
+	// oauthTokenEndpointProperty is the endpoint at which a client obtains
+	// its access token.
+	oauthTokenEndpointProperty = "oauthTokenEndpoint"
+)
+
+// ErrInsufficientScope indicates that the access token of a client does not
+// grant a scope required for the activity it posted. Returned by the
+// PostOutboxRequestBodyHook so a Forbidden response is set.
+var ErrInsufficientScope = errors.New("access token lacks the scope required for the activity")
+
+// oauthTokenContextKey is the context key under which the OAuthToken of an
+// authenticated client is stored.
+type oauthTokenContextKey struct{}
+
+// OAuthToken is what the authorization server knows of an OAuth 2.0 access
+// token, as returned by token introspection.
+type OAuthToken struct {
+	// Actor is the IRI of the actor on whose behalf the client acts.
+	Actor *url.URL
+	// Scopes are the scopes granted to the token.
+	Scopes []string
+	// Expiry is when the token expires. If zero, it does not expire.
+	Expiry time.Time
+}
+
+// HasScope determines whether the token grants any of the scopes.
+func (t *OAuthToken) HasScope(scopes ...string) bool {
+	for _, s := range scopes {
+		for _, granted := range t.Scopes {
+			if s == granted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// C2SAuthenticator authenticates clients posting to the outbox with OAuth 2.0
+// bearer tokens.
+//
+// It is an optional extension of the SocialProtocol which, if implemented,
+// replaces its AuthenticatePostOutbox. A request must then carry a bearer
+// token of the actor owning the outbox, or is rejected with a 401
+// Unauthorized or 403 Forbidden. Once the activity is read, the token must
+// grant one of its ActivityScopes, or the request is rejected with a 403
+// Forbidden. The token is available to the callbacks with
+// OAuthTokenFromContext.
+type C2SAuthenticator interface {
+	// IntrospectToken returns the OAuthToken of the access token sent by a
+	// client, or nil if it is not an active token.
+	IntrospectToken(c context.Context, token string) (*OAuthToken, error)
+	// ActivityScopes returns the scopes of which a token must grant one to
+	// post the activity. DefaultActivityScopes may be used.
+	ActivityScopes(c context.Context, activity vocab.Type) []string
+}
+
+// DefaultActivityScopes returns the ScopeWrite and the scope for only the type
+// of the activity, which is "write:" followed by the lowercase type name, such
+// as "write:follow".
+func DefaultActivityScopes(activity vocab.Type) []string {
+	return []string{ScopeWrite, ScopeWrite + ":" + strings.ToLower(activity.GetTypeName())}
+}
+
+// OAuthTokenFromContext returns the OAuthToken of the client that posted to the
+// outbox, as stored when a C2SAuthenticator authenticated it.
+func OAuthTokenFromContext(c context.Context) (token *OAuthToken, ok bool) {
+	token, ok = c.Value(oauthTokenContextKey{}).(*OAuthToken)
+	return
+}
+
+// bearerToken returns the bearer token in the Authorization header of the
+// request.
+func bearerToken(r *http.Request) (token string, ok bool) {
+	h := r.Header.Get(authorizationHeader)
+	if len(h) <= len(bearerScheme) || !strings.EqualFold(h[:len(bearerScheme)], bearerScheme) || h[len(bearerScheme)] != ' ' {
+		return "", false
+	}
+	token = strings.TrimSpace(h[len(bearerScheme):])
+	return token, len(token) > 0
+}
+
+// writeBearerChallenge writes the status with a challenge to authenticate with
+// a bearer token, reporting the OAuth 2.0 error code if not empty.
+func writeBearerChallenge(w http.ResponseWriter, status int, code string) {
+	challenge := bearerScheme
+	if len(code) > 0 {
+		challenge = fmt.Sprintf("%s error=%q", bearerScheme, code)
+	}
+	w.Header().Set(wwwAuthenticateHeader, challenge)
+	w.WriteHeader(status)
+}
+
+// authenticateBearer authenticates a POST to the outbox with the bearer token
+// of the request, which must be one of the actor owning the outbox.
+func (a *sideEffectActor) authenticateBearer(c context.Context, w http.ResponseWriter, r *http.Request, auth C2SAuthenticator) (out context.Context, authenticated bool, err error) {
+	out = c
+	raw, ok := bearerToken(r)
+	if !ok {
+		writeBearerChallenge(w, http.StatusUnauthorized, "")
+		return
+	}
+	token, err := auth.IntrospectToken(c, raw)
+	if err != nil {
+		return
+	} else if token == nil || (!token.Expiry.IsZero() && !a.clock.Now().Before(token.Expiry)) {
+		writeBearerChallenge(w, http.StatusUnauthorized, "invalid_token")
+		return
+	}
+	outboxIRI := requestId(r)
+	if err = a.db.Lock(c, outboxIRI); err != nil {
+		return
+	}
+	actorIRI, err := a.db.ActorForOutbox(c, outboxIRI)
+	a.db.Unlock(c, outboxIRI)
+	if err != nil {
+		return
+	} else if token.Actor == nil || token.Actor.String() != actorIRI.String() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	out = context.WithValue(c, oauthTokenContextKey{}, token)
+	authenticated = true
+	return
+}
+
+// checkScopes determines whether the token authenticated by the
+// C2SAuthenticator grants a scope required to post the activity.
+func checkScopes(c context.Context, auth C2SAuthenticator, activity vocab.Type) error {
+	token, ok := OAuthTokenFromContext(c)
+	if !ok || !token.HasScope(auth.ActivityScopes(c, activity)...) {
+		return ErrInsufficientScope
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testC2SAuthenticator is a SocialProtocol authenticating clients with the
+// bearer tokens it knows.
+type testC2SAuthenticator struct {
+	*MockSocialProtocol
+	tokens map[string]*OAuthToken
+}
+
+func (a *testC2SAuthenticator) IntrospectToken(c context.Context, token string) (*OAuthToken, error) {
+	return a.tokens[token], nil
+}
+
+func (a *testC2SAuthenticator) ActivityScopes(c context.Context, activity vocab.Type) []string {
+	return DefaultActivityScopes(activity)
+}
+
+func TestDefaultActivityScopes(t *testing.T) {
+	setupData()
+	assertEqual(t, strings.Join(DefaultActivityScopes(testMyListen), " "), "write write:listen")
+}
+
+func TestC2SAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	outboxIRI := mustParse(testMyOutboxIRI)
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, cl *MockClock, a *sideEffectActor) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		a = &sideEffectActor{
+			c2s: &testC2SAuthenticator{
+				MockSocialProtocol: NewMockSocialProtocol(ctl),
+				tokens: map[string]*OAuthToken{
+					"listen": {Actor: mustParse(testMyActorIRI), Scopes: []string{"read", "write:listen"}},
+					"other":  {Actor: mustParse(testFederatedActorIRI), Scopes: []string{ScopeWrite}},
+					"old":    {Actor: mustParse(testMyActorIRI), Scopes: []string{ScopeWrite}, Expiry: now()},
+				},
+			},
+			db:    db,
+			clock: cl,
+		}
+		return
+	}
+	newRequest := func(authorization string) *http.Request {
+		req := toPostOutboxRequest(testMyCreate)
+		if len(authorization) > 0 {
+			req.Header.Set(authorizationHeader, authorization)
+		}
+		return req
+	}
+	t.Run("AuthenticatesActorToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, a := setupFn(ctl)
+		db.EXPECT().Lock(ctx, outboxIRI)
+		db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(mustParse(testMyActorIRI), nil)
+		db.EXPECT().Unlock(ctx, outboxIRI)
+		resp := httptest.NewRecorder()
+		// Run
+		c, authenticated, err := a.AuthenticatePostOutbox(ctx, resp, newRequest("Bearer listen"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, true)
+		token, ok := OAuthTokenFromContext(c)
+		assertEqual(t, ok, true)
+		assertEqual(t, strings.Join(token.Scopes, " "), "read write:listen")
+	})
+	t.Run("ChallengesMissingToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		// Run
+		_, authenticated, err := a.AuthenticatePostOutbox(ctx, resp, newRequest("Basic Zm9vOmJhcg=="))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, resp.Header().Get(wwwAuthenticateHeader), "Bearer")
+	})
+	t.Run("RejectsUnknownToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		// Run
+		_, authenticated, err := a.AuthenticatePostOutbox(ctx, resp, newRequest("Bearer unknown"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, resp.Header().Get(wwwAuthenticateHeader), `Bearer error="invalid_token"`)
+	})
+	t.Run("RejectsExpiredToken", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, cl, a := setupFn(ctl)
+		cl.EXPECT().Now().Return(now().Add(time.Second))
+		resp := httptest.NewRecorder()
+		// Run
+		_, authenticated, err := a.AuthenticatePostOutbox(ctx, resp, newRequest("Bearer old"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("ForbidsTokenOfOtherActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, a := setupFn(ctl)
+		db.EXPECT().Lock(ctx, outboxIRI)
+		db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(mustParse(testMyActorIRI), nil)
+		db.EXPECT().Unlock(ctx, outboxIRI)
+		resp := httptest.NewRecorder()
+		// Run
+		_, authenticated, err := a.AuthenticatePostOutbox(ctx, resp, newRequest("Bearer other"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, authenticated, false)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("ChecksScopesOfActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl)
+		sp := a.c2s.(*testC2SAuthenticator).MockSocialProtocol
+		c := context.WithValue(ctx, oauthTokenContextKey{}, a.c2s.(*testC2SAuthenticator).tokens["listen"])
+		req := newRequest("Bearer listen")
+		sp.EXPECT().PostOutboxRequestBodyHook(c, req, testMyListen).Return(c, nil)
+		// Run
+		_, listenErr := a.PostOutboxRequestBodyHook(c, req, testMyListen)
+		_, createErr := a.PostOutboxRequestBodyHook(c, req, testMyCreate)
+		// Verify
+		assertEqual(t, listenErr, nil)
+		assertEqual(t, createErr, ErrInsufficientScope)
+	})
+}
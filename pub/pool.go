@@ -0,0 +1,59 @@
+package pub
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// maxPooledBufferSize is the capacity beyond which a buffer is not returned to
+// the pool, so that a single large document does not keep its memory in use.
+const maxPooledBufferSize = 64 << 10
+
+// bufferPool holds the buffers that inbound bodies are read into and outbound
+// documents are encoded into, to reduce the garbage of busy servers.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer obtains an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	b := bufferPool.Get().(*bytes.Buffer)
+	b.Reset()
+	return b
+}
+
+// putBuffer returns the buffer to the pool. Its bytes must no longer be used.
+func putBuffer(b *bytes.Buffer) {
+	if b.Cap() > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(b)
+}
+
+// marshalJSON encodes the value as json.Marshal does into a pooled buffer,
+// which must be returned with putBuffer once its bytes are no longer used.
+func marshalJSON(v interface{}) (*bytes.Buffer, error) {
+	b := getBuffer()
+	if err := json.NewEncoder(b).Encode(v); err != nil {
+		putBuffer(b)
+		return nil, err
+	}
+	// Encode terminates the value with a newline, which Marshal does not.
+	b.Truncate(b.Len() - 1)
+	return b, nil
+}
+
+// readAll reads the reader until EOF into a pooled buffer, which must be
+// returned with putBuffer once its bytes are no longer used.
+func readAll(r io.Reader) (*bytes.Buffer, error) {
+	b := getBuffer()
+	if _, err := b.ReadFrom(r); err != nil {
+		putBuffer(b)
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func TestBufferPool(t *testing.T) {
+	setupData()
+	t.Run("MarshalsAsJSONMarshal", func(t *testing.T) {
+		// Setup
+		m := mustSerialize(testMyNote)
+		m["content"] = "<p>a & b</p>"
+		expect, err := json.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		b, err := marshalJSON(m)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, b.String(), string(expect))
+		putBuffer(b)
+	})
+	t.Run("ReadsAll", func(t *testing.T) {
+		// Setup
+		dirty := getBuffer()
+		dirty.WriteString("leftover")
+		putBuffer(dirty)
+		// Run
+		b, err := readAll(bytes.NewReader([]byte("{}")))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, b.String(), "{}")
+		putBuffer(b)
+	})
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	setupData()
+	m := mustSerialize(testMyNote)
+	b.Run("Pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, err := marshalJSON(m)
+			if err != nil {
+				b.Fatal(err)
+			}
+			putBuffer(buf)
+		}
+	})
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(m); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkReadBody(b *testing.B) {
+	body := bytes.Repeat([]byte(`{"type":"Note","content":"hello"},`), 256)
+	b.Run("Pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, err := readAll(bytes.NewReader(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+			putBuffer(buf)
+		}
+	})
+	b.Run("ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := ioutil.ReadAll(bytes.NewReader(body)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
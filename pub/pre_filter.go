@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"net/http"
+	"strings"
+)
+
+// PreFilter inspects every inbound activity after the request is authenticated
+// but before the activity is deserialized, using only the ShallowView of its
+// decoded JSON. Deciding to drop or reject an activity here spares the cost of
+// deserializing the large attachments and reply chains it may embed, which is
+// wasted for the spam and abuse it refuses.
+//
+// Activities accepted by the PreFilter are then deserialized, authorized, and
+// given to the Filter as usual.
+type PreFilter interface {
+	// PreFilterInbox decides what to do with the activity, from its id,
+	// type, actors, and audience.
+	//
+	// If an error is returned, it is passed back to the caller of
+	// PostInbox and nothing is written to the response.
+	PreFilterInbox(c context.Context, v streams.ShallowView) (Verdict, error)
+}
+
+// InboxPreFilterer is an optional extension of the FederatingProtocol, or of a
+// DelegateActor, applying a PreFilter to every inbound activity.
+type InboxPreFilterer interface {
+	// InboxPreFilter returns the PreFilter applied to every inbound
+	// activity before it is deserialized, or nil to apply none.
+	InboxPreFilter(c context.Context) PreFilter
+}
+
+// InboxPreFilter defers to the FederatingProtocol if it is an
+// InboxPreFilterer, and otherwise applies no PreFilter.
+func (a *sideEffectActor) InboxPreFilter(c context.Context) PreFilter {
+	if f, ok := a.s2s.(InboxPreFilterer); ok {
+		return f.InboxPreFilter(c)
+	}
+	return nil
+}
+
+// preFilterInbox applies the delegate's PreFilter, if any, to the decoded
+// activity. Returns true if the activity is to be processed, and otherwise
+// writes the response for the verdict.
+func (b *baseActor) preFilterInbox(c context.Context, w http.ResponseWriter, m map[string]interface{}, remoteHost LogField) (bool, error) {
+	pf, ok := b.delegate.(InboxPreFilterer)
+	if !ok {
+		return true, nil
+	}
+	filter := pf.InboxPreFilter(c)
+	if filter == nil {
+		return true, nil
+	}
+	view := streams.ToShallowViewFromMap(m)
+	v, err := filter.PreFilterInbox(c, view)
+	if err != nil {
+		return false, err
+	} else if applyVerdict(w, v) {
+		return true, nil
+	}
+	logEntry(c, LogLevelInfo, "pre-filtered activity", append(shallowViewLogFields(view), remoteHost,
+		LogField{Key: "action", Value: v.Action},
+		LogField{Key: "reason", Value: v.Reason})...)
+	return false, nil
+}
+
+// shallowViewLogFields returns the fields describing an activity from its
+// ShallowView, as activityLogFields does from the activity.
+func shallowViewLogFields(v streams.ShallowView) []LogField {
+	fields := []LogField{{Key: LogKeyActivityType, Value: v.Type}}
+	if v.Id != nil {
+		fields = append([]LogField{{Key: LogKeyActivityId, Value: v.Id.String()}}, fields...)
+	}
+	if len(v.Actor) > 0 {
+		actors := make([]string, len(v.Actor))
+		for i, a := range v.Actor {
+			actors[i] = a.String()
+		}
+		fields = append(fields, LogField{Key: LogKeyActor, Value: strings.Join(actors, ",")})
+	}
+	return fields
+}
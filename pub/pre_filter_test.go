@@ -0,0 +1,138 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// preFilterFunc is a PreFilter calling the function.
+type preFilterFunc func(c context.Context, v streams.ShallowView) (Verdict, error)
+
+func (f preFilterFunc) PreFilterInbox(c context.Context, v streams.ShallowView) (Verdict, error) {
+	return f(c, v)
+}
+
+// testPreFilteringDelegate is a DelegateActor applying a PreFilter.
+type testPreFilteringDelegate struct {
+	*MockDelegateActor
+	filter PreFilter
+}
+
+func (d *testPreFilteringDelegate) InboxPreFilter(c context.Context) PreFilter {
+	return d.filter
+}
+
+func TestPostInboxPreFilter(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller, filter PreFilter) (delegate *MockDelegateActor, a FederatingActor) {
+		setupData()
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(&testPreFilteringDelegate{MockDelegateActor: delegate, filter: filter}, false, true, NewMockClock(ctl))
+		return
+	}
+	t.Run("DropsWithoutDeserializing", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var got streams.ShallowView
+		delegate, a := setupFn(ctl, preFilterFunc(func(c context.Context, v streams.ShallowView) (Verdict, error) {
+			got = v
+			return FilterDrop("spam"), nil
+		}))
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, got.Type, "Create")
+		assertEqual(t, len(got.Actor), 1)
+		assertEqual(t, got.Actor[0].String(), testFederatedActorIRI)
+	})
+	t.Run("Rejects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, preFilterFunc(func(c context.Context, v streams.ShallowView) (Verdict, error) {
+			return FilterReject(http.StatusUnprocessableEntity, "too large"), nil
+		}))
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusUnprocessableEntity)
+	})
+	t.Run("ReturnsError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		testErr := errors.New("test error")
+		delegate, a := setupFn(ctl, preFilterFunc(func(c context.Context, v streams.ShallowView) (Verdict, error) {
+			return Verdict{}, testErr
+		}))
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, testErr)
+		assertEqual(t, handled, true)
+	})
+	t.Run("AcceptsAndDeserializes", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, preFilterFunc(func(c context.Context, v streams.ShallowView) (Verdict, error) {
+			return FilterAccept(), nil
+		}))
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		resp := httptest.NewRecorder()
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, gomock.Any()).Return(true, nil)
+		delegate.EXPECT().PostInbox(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil)
+		delegate.EXPECT().InboxForwarding(ctx, mustParse(testMyInboxIRI), gomock.Any()).Return(nil)
+		// Run
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+}
+
+func TestSideEffectActorInboxPreFilter(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	a := &sideEffectActor{s2s: NewMockFederatingProtocol(ctl)}
+	assertEqual(t, a.InboxPreFilter(context.Background()), nil)
+	filter := preFilterFunc(func(c context.Context, v streams.ShallowView) (Verdict, error) {
+		return FilterAccept(), nil
+	})
+	a.s2s = &testPreFilteringFederatingProtocol{MockFederatingProtocol: NewMockFederatingProtocol(ctl), filter: filter}
+	assertNotEqual(t, a.InboxPreFilter(context.Background()), nil)
+}
+
+// testPreFilteringFederatingProtocol is a FederatingProtocol applying a
+// PreFilter.
+type testPreFilteringFederatingProtocol struct {
+	*MockFederatingProtocol
+	filter PreFilter
+}
+
+func (p *testPreFilteringFederatingProtocol) InboxPreFilter(c context.Context) PreFilter {
+	return p.filter
+}
@@ -15,6 +15,11 @@ type objecter interface {
 	GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty
 }
 
+// attachmenter is an ActivityStreams type with an 'attachment' property
+type attachmenter interface {
+	GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty
+}
+
 // targeter is an ActivityStreams type with a 'target' property
 type targeter interface {
 	GetActivityStreamsTarget() vocab.ActivityStreamsTargetProperty
@@ -45,6 +50,7 @@ type orderedItemser interface {
 // publisheder is an ActivityStreams type with a 'published' property
 type publisheder interface {
 	GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty
+	SetActivityStreamsPublished(vocab.ActivityStreamsPublishedProperty)
 }
 
 // updateder is an ActivityStreams type with an 'updateder' property
@@ -87,6 +93,12 @@ type inboxer interface {
 	GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
 }
 
+// unknownPropertieser is an ActivityStreams type that retains properties not
+// known to this library, such as 'endpoints'.
+type unknownPropertieser interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
 // attributedToer is an ActivityStreams type with an 'attributedTo' property
 type attributedToer interface {
 	GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty
@@ -111,7 +123,89 @@ type actorer interface {
 	SetActivityStreamsActor(i vocab.ActivityStreamsActorProperty)
 }
 
+// namer is an ActivityStreams type with a 'name' property
+type namer interface {
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+}
+
+// replieser is an ActivityStreams type with a 'replies' property
+type replieser interface {
+	GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty
+	SetActivityStreamsReplies(i vocab.ActivityStreamsRepliesProperty)
+}
+
 // appendIRIer is an ActivityStreams type that can Append IRIs.
 type appendIRIer interface {
 	AppendIRI(v *url.URL)
 }
+
+// publicKeyer is an ActivityStreams type with a 'publicKey' property
+type publicKeyer interface {
+	GetActivityStreamsPublicKey() vocab.ActivityStreamsPublicKeyProperty
+	SetActivityStreamsPublicKey(i vocab.ActivityStreamsPublicKeyProperty)
+}
+
+// followerser is an ActivityStreams type with a 'followers' property
+type followerser interface {
+	GetActivityStreamsFollowers() vocab.ActivityStreamsFollowersProperty
+}
+
+// totalItemser is an ActivityStreams type with a 'totalItems' property
+type totalItemser interface {
+	GetActivityStreamsTotalItems() vocab.ActivityStreamsTotalItemsProperty
+	SetActivityStreamsTotalItems(vocab.ActivityStreamsTotalItemsProperty)
+}
+
+// firster is an ActivityStreams type with a 'first' property
+type firster interface {
+	GetActivityStreamsFirst() vocab.ActivityStreamsFirstProperty
+}
+
+// nexter is an ActivityStreams type with a 'next' property
+type nexter interface {
+	GetActivityStreamsNext() vocab.ActivityStreamsNextProperty
+}
+
+// urler is an ActivityStreams type with a 'url' property
+type urler interface {
+	GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+}
+
+// preferredUsernamer is an ActivityStreams type with a 'preferredUsername'
+// property
+type preferredUsernamer interface {
+	GetActivityStreamsPreferredUsername() vocab.ActivityStreamsPreferredUsernameProperty
+}
+
+// actorDocumenter is an ActivityStreams actor type whose collections and keys
+// may be set
+type actorDocumenter interface {
+	SetActivityStreamsInbox(vocab.ActivityStreamsInboxProperty)
+	SetActivityStreamsOutbox(vocab.ActivityStreamsOutboxProperty)
+	SetActivityStreamsFollowers(vocab.ActivityStreamsFollowersProperty)
+	SetActivityStreamsFollowing(vocab.ActivityStreamsFollowingProperty)
+	SetActivityStreamsLiked(vocab.ActivityStreamsLikedProperty)
+	SetActivityStreamsPublicKey(vocab.ActivityStreamsPublicKeyProperty)
+}
+
+// mediaObjecter is an ActivityStreams type whose 'url' and 'mediaType' may be
+// set
+type mediaObjecter interface {
+	GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+	SetActivityStreamsUrl(vocab.ActivityStreamsUrlProperty)
+	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
+	SetActivityStreamsMediaType(vocab.ActivityStreamsMediaTypeProperty)
+}
+
+// contexter is an ActivityStreams type with a 'context' property
+type contexter interface {
+	GetActivityStreamsContext() vocab.ActivityStreamsContextProperty
+	SetActivityStreamsContext(vocab.ActivityStreamsContextProperty)
+}
+
+// alsoKnownAser is an ActivityStreams actor type with an 'alsoKnownAs'
+// property
+type alsoKnownAser interface {
+	GetActivityStreamsAlsoKnownAs() vocab.ActivityStreamsAlsoKnownAsProperty
+	SetActivityStreamsAlsoKnownAs(vocab.ActivityStreamsAlsoKnownAsProperty)
+}
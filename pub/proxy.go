@@ -0,0 +1,216 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+)
+
+const (
+	// proxyUrlProperty is the endpoint through which an actor's clients
+	// fetch remote objects.
+	proxyUrlProperty = "proxyUrl"
+	// proxyIdField is the form field holding the IRI to fetch.
+	proxyIdField = "id"
+	// formMediaType is the media type of proxy requests.
+	formMediaType = "application/x-www-form-urlencoded"
+)
+
+// nonPublicNetworks are the networks, other than loopback, link-local, and
+// multicast ones, whose addresses are not reachable from the public internet.
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"fc00::/7",
+)
+
+// ProxyURL lets the logged-in clients of the actors fetch remote objects
+// through the server, signed with the server's identity, as published in the
+// 'proxyUrl' of the actors' 'endpoints'. Clients that cannot fetch the objects
+// themselves, because of CORS or peers requiring signed requests, can then
+// read them.
+//
+// A proxy request is a POST to the endpoint with the IRI of the object in the
+// "id" field of a form. To keep clients from reaching the server's internal
+// network, the IRI must be an https one without credentials, and its host
+// must only resolve to public addresses. As redirects and changing DNS
+// answers could still lead the Transport elsewhere, its HttpClient should also
+// dial with the PublicAddressControl, as one from NewGuardedHttpClient does.
+type ProxyURL struct {
+	// LookupIP resolves the host of an IRI. It defaults to the
+	// net.DefaultResolver.
+	LookupIP func(c context.Context, host string) ([]net.IPAddr, error)
+
+	endpoint *url.URL
+	authFn   AuthenticateFunc
+	tp       Transport
+	clock    Clock
+}
+
+// NewProxyURL creates the ProxyURL at the endpoint, fetching objects with the
+// Transport, such as that of the InstanceActor.
+//
+// The authFn must authenticate the request as one of a client of an actor of
+// the server, as anyone would otherwise be able to use the server's identity.
+func NewProxyURL(endpoint *url.URL, authFn AuthenticateFunc, tp Transport, clock Clock) *ProxyURL {
+	return &ProxyURL{
+		LookupIP: net.DefaultResolver.LookupIPAddr,
+		endpoint: endpoint,
+		authFn:   authFn,
+		tp:       tp,
+		clock:    clock,
+	}
+}
+
+// NewHandler creates a HandlerFunc handling the proxy requests to the
+// endpoint. Requests to any other IRI are not handled.
+//
+// An IRI which may not be fetched results in a 400 Bad Request, and one which
+// cannot be fetched or is not JSON in a 502 Bad Gateway.
+func (p *ProxyURL) NewHandler() HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if r.Method != "POST" || requestId(r).String() != p.endpoint.String() {
+			return
+		}
+		isASRequest = true
+		var shouldReturn bool
+		if shouldReturn, err = p.authFn(c, w, r); err != nil || shouldReturn {
+			return
+		}
+		if mediaType, _, perr := mime.ParseMediaType(r.Header.Get(contentTypeHeader)); perr != nil || mediaType != formMediaType {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		if err = r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			err = nil
+			return
+		}
+		iri, perr := url.Parse(r.PostForm.Get(proxyIdField))
+		if perr != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if perr = p.checkIRI(c, iri); perr != nil {
+			logEntry(c, LogLevelInfo, "refused to proxy IRI",
+				LogField{Key: "iri", Value: iri.String()},
+				LogField{Key: "reason", Value: perr.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		raw, ferr := p.tp.Dereference(c, iri)
+		var m map[string]interface{}
+		if ferr == nil {
+			ferr = json.Unmarshal(raw, &m)
+		}
+		if ferr != nil {
+			logEntry(c, LogLevelInfo, "failed to proxy IRI",
+				LogField{Key: "iri", Value: iri.String()},
+				LogField{Key: "reason", Value: ferr.Error()})
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		addResponseHeaders(w.Header(), p.clock, raw)
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// checkIRI determines whether the IRI may be fetched on behalf of a client.
+func (p *ProxyURL) checkIRI(c context.Context, iri *url.URL) error {
+	if iri.Scheme != "https" {
+		return fmt.Errorf("scheme %q is not https", iri.Scheme)
+	} else if iri.User != nil {
+		return fmt.Errorf("IRI has credentials")
+	}
+	host := iri.Hostname()
+	if len(host) == 0 {
+		return fmt.Errorf("IRI has no host")
+	}
+	var addrs []net.IPAddr
+	if ip := net.ParseIP(host); ip != nil {
+		addrs = []net.IPAddr{{IP: ip}}
+	} else {
+		var err error
+		if addrs, err = p.LookupIP(c, host); err != nil {
+			return err
+		} else if len(addrs) == 0 {
+			return fmt.Errorf("host %s has no addresses", host)
+		}
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return fmt.Errorf("host %s resolves to non-public address %s", host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// PublicAddressControl refuses connections to addresses that are not reachable
+// from the public internet, such as loopback and private network ones.
+//
+// It is a net.Dialer Control function, which is called with the resolved
+// address of each connection, including those of redirects. It is meant for the
+// HttpClient of a Transport fetching IRIs chosen by clients, as for the
+// ProxyURL.
+func PublicAddressControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("refusing to connect to non-public address %s", host)
+	}
+	return nil
+}
+
+// isPublicIP determines whether the address is reachable from the public
+// internet.
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() {
+		return false
+	}
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// mustParseCIDRs parses the CIDR notations of networks, panicking if any is
+// invalid.
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = n
+	}
+	return networks
+}
@@ -3,14 +3,18 @@ package pub
 import (
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -18,6 +22,7 @@ import (
 const (
 	testMyInboxIRI            = "https://example.com/addison/inbox"
 	testMyOutboxIRI           = "https://example.com/addison/outbox"
+	testMyActorIRI            = "https://example.com/addison"
 	testFederatedActivityIRI  = "https://other.example.com/activity/1"
 	testFederatedActivityIRI2 = "https://other.example.com/activity/2"
 	testFederatedActorIRI     = "https://other.example.com/dakota"
@@ -399,6 +404,38 @@ func mustSerializeToBytes(t vocab.Type) []byte {
 	return b
 }
 
+// mustActorBytes serializes a Person with the id and an inbox at id + "/inbox"
+// to bytes or panics.
+func mustActorBytes(id string) []byte {
+	p := streams.NewActivityStreamsPerson()
+	idProp := streams.NewActivityStreamsIdProperty()
+	idProp.Set(mustParse(id))
+	p.SetActivityStreamsId(idProp)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(mustParse(id + "/inbox"))
+	p.SetActivityStreamsInbox(inbox)
+	return mustSerializeToBytes(p)
+}
+
+// mustSignedGetRequest creates an ActivityStreams GET request for the IRI,
+// signed with the private key if it is not nil, or panics.
+func mustSignedGetRequest(iri, keyId string, privKey *rsa.PrivateKey) *http.Request {
+	r := httptest.NewRequest("GET", iri, nil)
+	r.Header.Set("Accept", acceptHeaderValue)
+	r.Header.Set("Date", nowDateHeader())
+	if privKey == nil {
+		return r
+	}
+	s, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+	if err != nil {
+		panic(err)
+	}
+	if err = s.SignRequest(privKey, keyId, r, nil); err != nil {
+		panic(err)
+	}
+	return r
+}
+
 // mustSerialize serializes a type or panics.
 func mustSerialize(t vocab.Type) map[string]interface{} {
 	m, err := streams.Serialize(t)
@@ -419,6 +456,26 @@ func toDeserializedForm(t vocab.Type) vocab.Type {
 	return asValue
 }
 
+// typeMatcher matches a value that serializes the same as a type, no matter
+// which of their nested values have been deserialized so far.
+type typeMatcher struct {
+	t vocab.Type
+}
+
+// eqType returns a mock expectation for the deserialized form of a type.
+func eqType(t vocab.Type) gomock.Matcher {
+	return typeMatcher{t: toDeserializedForm(t)}
+}
+
+func (m typeMatcher) Matches(x interface{}) bool {
+	t, ok := x.(vocab.Type)
+	return ok && reflect.DeepEqual(mustSerialize(t), mustSerialize(m.t))
+}
+
+func (m typeMatcher) String() string {
+	return fmt.Sprintf("is equal to %v", m.t)
+}
+
 // withNewId sets a new id property on the activity
 func withNewId(t vocab.Type) Activity {
 	a, ok := t.(Activity)
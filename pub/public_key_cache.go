@@ -0,0 +1,237 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// CachedPublicKey is a remote public key held by a PublicKeyStore, or a record
+// that fetching it failed.
+type CachedPublicKey struct {
+	// PublicKey, Algorithm, and Owner are as returned by a
+	// PublicKeyGetter. They are unset if Missing is true.
+	PublicKey crypto.PublicKey
+	Algorithm httpsig.Algorithm
+	Owner     *url.URL
+	// Missing is true if fetching the key failed, so that it is not
+	// fetched again until the entry expires.
+	Missing bool
+	// Expires is when the entry must be fetched again.
+	Expires time.Time
+	// Fetched is when the key was fetched, or fetching it failed.
+	Fetched time.Time
+}
+
+// DefaultMinRefetchInterval is how long a PublicKeyCache waits after fetching
+// a key before it fetches the key again when a signature fails to verify.
+const DefaultMinRefetchInterval = time.Minute
+
+// PublicKeyStore persists the remote public keys cached by a PublicKeyCache.
+//
+// Implementations must be safe for concurrent use.
+type PublicKeyStore interface {
+	// Get returns the entry for the key id. Expired entries may be
+	// returned, and are ignored by the PublicKeyCache.
+	Get(c context.Context, keyId *url.URL) (entry CachedPublicKey, found bool, err error)
+	// Put stores the entry for the key id, replacing any existing one.
+	Put(c context.Context, keyId *url.URL, entry CachedPublicKey) error
+	// Delete removes the entry for the key id, if any.
+	Delete(c context.Context, keyId *url.URL) error
+}
+
+// MemoryPublicKeyStore is a PublicKeyStore held in memory.
+//
+// It is not suitable when the cache must be shared between processes or
+// survive restarts.
+type MemoryPublicKeyStore struct {
+	mu      sync.Mutex
+	entries map[string]CachedPublicKey
+}
+
+// MemoryPublicKeyStore must satisfy the PublicKeyStore interface.
+var _ PublicKeyStore = &MemoryPublicKeyStore{}
+
+// NewMemoryPublicKeyStore creates an empty MemoryPublicKeyStore.
+func NewMemoryPublicKeyStore() *MemoryPublicKeyStore {
+	return &MemoryPublicKeyStore{
+		entries: make(map[string]CachedPublicKey),
+	}
+}
+
+// Get returns the entry for the key id.
+func (m *MemoryPublicKeyStore) Get(c context.Context, keyId *url.URL) (entry CachedPublicKey, found bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, found = m.entries[keyId.String()]
+	return
+}
+
+// Put stores the entry for the key id.
+func (m *MemoryPublicKeyStore) Put(c context.Context, keyId *url.URL, entry CachedPublicKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[keyId.String()] = entry
+	return nil
+}
+
+// Delete removes the entry for the key id.
+func (m *MemoryPublicKeyStore) Delete(c context.Context, keyId *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, keyId.String())
+	return nil
+}
+
+// PublicKeyCache caches the remote public keys obtained by a PublicKeyGetter,
+// so that the actor document of a peer is not fetched on every request it
+// signs.
+//
+// Failures to fetch a key are cached too, for a shorter duration, so that an
+// unreachable or deleted actor is not fetched repeatedly.
+//
+// Its GetPublicKey method is a PublicKeyGetter, and RefetchPublicKey suits the
+// field of the same name on AuthorizedFetch. VerifyRequest applies both to
+// verify any signed request, such as a POST to an inbox.
+type PublicKeyCache struct {
+	// Fetch obtains a key that is not cached, such as the PublicKeyGetter
+	// returned by NewPublicKeyGetter.
+	Fetch PublicKeyGetter
+	// Store holds the cached keys.
+	Store PublicKeyStore
+	// Clock determines when entries expire.
+	Clock Clock
+	// TTL is how long a fetched key is cached.
+	TTL time.Duration
+	// NegativeTTL is how long a failure to fetch a key is cached. Zero or
+	// a negative number disables caching failures.
+	NegativeTTL time.Duration
+	// MinRefetchInterval is how long after a key is fetched that
+	// RefetchPublicKey returns the cached key instead of fetching it
+	// again, so that requests with bad signatures cannot make the actor
+	// document of a peer be fetched for each of them. Zero means
+	// DefaultMinRefetchInterval, and a negative number means no limit.
+	MinRefetchInterval time.Duration
+	// MaxClockSkew is how far the Date header and the 'created'
+	// parameter of a signature verified by VerifyRequest may be from the
+	// Clock's time, such as DefaultMaxClockSkew. If not positive,
+	// signatures are not checked to be recent.
+	MaxClockSkew time.Duration
+	// CheckSignature decides whether a signature verified by
+	// VerifyRequest is acceptable. Optional; if nil, every verified
+	// signature is.
+	CheckSignature SignatureCheck
+	// DigestMode is the Mode of the DigestPolicy checking the bodies of
+	// the requests verified by VerifyRequest. Unless it is DigestIgnore,
+	// the signature of a request with a body must cover its digest.
+	DigestMode DigestMode
+}
+
+// NewPublicKeyCache creates a PublicKeyCache.
+func NewPublicKeyCache(fetch PublicKeyGetter, store PublicKeyStore, clock Clock, ttl, negativeTTL time.Duration) *PublicKeyCache {
+	return &PublicKeyCache{
+		Fetch:       fetch,
+		Store:       store,
+		Clock:       clock,
+		TTL:         ttl,
+		NegativeTTL: negativeTTL,
+	}
+}
+
+// GetPublicKey returns the cached key, fetching and caching it if it is not
+// cached or has expired.
+func (p *PublicKeyCache) GetPublicKey(c context.Context, keyId *url.URL) (pubKey crypto.PublicKey, algo httpsig.Algorithm, owner *url.URL, err error) {
+	entry, found, err := p.Store.Get(c, keyId)
+	if err != nil {
+		return
+	}
+	if !found || !p.Clock.Now().Before(entry.Expires) {
+		return p.RefetchPublicKey(c, keyId)
+	}
+	if entry.Missing {
+		err = fmt.Errorf("public key %s could not be fetched", keyId)
+		return
+	}
+	return entry.PublicKey, entry.Algorithm, entry.Owner, nil
+}
+
+// RefetchPublicKey fetches the key regardless of whether it is cached, and
+// caches the result. It is used when a signature fails to verify with a
+// cached key, as the peer may have rotated its keys.
+//
+// A key fetched within the MinRefetchInterval is returned from the cache
+// instead.
+func (p *PublicKeyCache) RefetchPublicKey(c context.Context, keyId *url.URL) (pubKey crypto.PublicKey, algo httpsig.Algorithm, owner *url.URL, err error) {
+	now := p.Clock.Now()
+	if entry, found, getErr := p.Store.Get(c, keyId); getErr != nil {
+		err = getErr
+		return
+	} else if found && p.fetchedRecently(entry, now) {
+		if entry.Missing {
+			err = fmt.Errorf("public key %s could not be fetched", keyId)
+			return
+		}
+		return entry.PublicKey, entry.Algorithm, entry.Owner, nil
+	}
+	pubKey, algo, owner, err = p.Fetch(c, keyId)
+	if err != nil {
+		if p.NegativeTTL > 0 {
+			p.Store.Put(c, keyId, CachedPublicKey{
+				Missing: true,
+				Expires: now.Add(p.NegativeTTL),
+				Fetched: now,
+			})
+		}
+		return
+	}
+	err = p.Store.Put(c, keyId, CachedPublicKey{
+		PublicKey: pubKey,
+		Algorithm: algo,
+		Owner:     owner,
+		Expires:   now.Add(p.TTL),
+		Fetched:   now,
+	})
+	return
+}
+
+// fetchedRecently determines whether the entry was fetched within the
+// MinRefetchInterval of the time, and has not expired.
+func (p *PublicKeyCache) fetchedRecently(entry CachedPublicKey, now time.Time) bool {
+	interval := p.MinRefetchInterval
+	if interval == 0 {
+		interval = DefaultMinRefetchInterval
+	} else if interval < 0 {
+		return false
+	}
+	return now.Before(entry.Expires) && now.Sub(entry.Fetched) < interval
+}
+
+// Invalidate removes the key from the cache, such as when its owner has been
+// deleted or announced new keys.
+func (p *PublicKeyCache) Invalidate(c context.Context, keyId *url.URL) error {
+	return p.Store.Delete(c, keyId)
+}
+
+// VerifyRequest verifies the HTTP Signature of the request using cached keys,
+// refetching the key once if the signature fails to verify. Returns the IRI
+// of the actor that signed it, or a nil signer and nil error if the request is
+// not signed.
+func (p *PublicKeyCache) VerifyRequest(c context.Context, r *http.Request) (signer *url.URL, err error) {
+	res, err := p.VerifyRequestResult(c, r)
+	if res != nil {
+		signer = res.Signer
+	}
+	return
+}
+
+// VerifyRequestResult verifies the HTTP Signature of the request as
+// VerifyRequest does and describes it. Returns a nil result and nil error if
+// the request is not signed.
+func (p *PublicKeyCache) VerifyRequestResult(c context.Context, r *http.Request) (*SignatureResult, error) {
+	return verifySignatureResult(c, r, p.GetPublicKey, p.RefetchPublicKey, p.Clock, p.MaxClockSkew, p.DigestMode, p.CheckSignature)
+}
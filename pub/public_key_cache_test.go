@@ -0,0 +1,147 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestPublicKeyCache(t *testing.T) {
+	ctx := context.Background()
+	keyId := testFederatedActorIRI + "#main-key"
+	actorIRI := mustParse(testFederatedActorIRI)
+	oldKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// setupFn returns a cache whose fetches return the given keys in
+	// order, or an error once they run out, and counts the fetches.
+	setupFn := func(ctl *gomock.Controller, keys ...*rsa.PrivateKey) (p *PublicKeyCache, cl *MockClock, fetches *int) {
+		fetches = new(int)
+		fetch := func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+			assertEqual(t, k.String(), keyId)
+			*fetches++
+			if *fetches > len(keys) {
+				return nil, "", nil, errors.New("fetch failed")
+			}
+			return keys[*fetches-1].Public(), httpsig.RSA_SHA256, actorIRI, nil
+		}
+		cl = NewMockClock(ctl)
+		p = NewPublicKeyCache(fetch, NewMemoryPublicKeyStore(), cl, time.Hour, time.Minute)
+		return
+	}
+	t.Run("CachesKeyUntilExpired", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p, cl, fetches := setupFn(ctl, oldKey, newKey)
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now().Add(30*time.Minute)),
+			cl.EXPECT().Now().Return(now().Add(time.Hour)),
+			cl.EXPECT().Now().Return(now().Add(time.Hour)),
+		)
+		// Run
+		_, _, _, err1 := p.GetPublicKey(ctx, mustParse(keyId))
+		_, _, _, err2 := p.GetPublicKey(ctx, mustParse(keyId))
+		pubKey, _, owner, err3 := p.GetPublicKey(ctx, mustParse(keyId))
+		// Verify
+		assertEqual(t, err1, nil)
+		assertEqual(t, err2, nil)
+		assertEqual(t, err3, nil)
+		assertEqual(t, *fetches, 2)
+		assertEqual(t, owner.String(), testFederatedActorIRI)
+		assertEqual(t, pubKey.(*rsa.PublicKey).N.Cmp(newKey.N), 0)
+	})
+	t.Run("CachesFetchFailure", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p, cl, fetches := setupFn(ctl)
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			cl.EXPECT().Now().Return(now()),
+		)
+		// Run
+		_, _, _, err1 := p.GetPublicKey(ctx, mustParse(keyId))
+		_, _, _, err2 := p.GetPublicKey(ctx, mustParse(keyId))
+		// Verify
+		assertNotEqual(t, err1, nil)
+		assertNotEqual(t, err2, nil)
+		assertEqual(t, *fetches, 1)
+	})
+	t.Run("RefetchesRotatedKeyOnce", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p, cl, fetches := setupFn(ctl, oldKey, newKey)
+		cl.EXPECT().Now().Return(now())
+		cl.EXPECT().Now().Return(now().Add(DefaultMinRefetchInterval)).AnyTimes()
+		if _, _, _, err := p.GetPublicKey(ctx, mustParse(keyId)); err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		signer, err := p.VerifyRequest(ctx, mustSignedGetRequest(testNoteId1, keyId, newKey))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+		assertEqual(t, *fetches, 2)
+	})
+	t.Run("RejectsAfterSingleRefetch", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p, cl, fetches := setupFn(ctl, oldKey, oldKey)
+		cl.EXPECT().Now().Return(now())
+		cl.EXPECT().Now().Return(now().Add(DefaultMinRefetchInterval)).AnyTimes()
+		// Run
+		signer, err := p.VerifyRequest(ctx, mustSignedGetRequest(testNoteId1, keyId, newKey))
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, signer, (*url.URL)(nil))
+		assertEqual(t, *fetches, 2)
+	})
+	t.Run("DoesNotRefetchRecentKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p, cl, fetches := setupFn(ctl, oldKey, newKey)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		// Run
+		_, err1 := p.VerifyRequest(ctx, mustSignedGetRequest(testNoteId1, keyId, newKey))
+		_, err2 := p.VerifyRequest(ctx, mustSignedGetRequest(testNoteId1, keyId, newKey))
+		// Verify
+		assertNotEqual(t, err1, nil)
+		assertNotEqual(t, err2, nil)
+		assertEqual(t, *fetches, 1)
+	})
+	t.Run("Invalidates", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		p, cl, fetches := setupFn(ctl, oldKey, newKey)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		if _, _, _, err := p.GetPublicKey(ctx, mustParse(keyId)); err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		err := p.Invalidate(ctx, mustParse(keyId))
+		pubKey, _, _, getErr := p.GetPublicKey(ctx, mustParse(keyId))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, getErr, nil)
+		assertEqual(t, *fetches, 2)
+		assertEqual(t, pubKey.(*rsa.PublicKey).N.Cmp(newKey.N), 0)
+	})
+}
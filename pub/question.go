@@ -0,0 +1,319 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"time"
+)
+
+const (
+	// votersCountProperty is the Mastodon extension property on a Question
+	// counting the distinct actors that have voted.
+	votersCountProperty = "votersCount"
+)
+
+// getVote determines whether the value is a poll vote in the manner of
+// Mastodon: a Note with a 'name' and no 'content' that is in reply to exactly
+// one object. Returns the names voted for, the IRI of the voter, and the IRI
+// of the Question replied to.
+func getVote(t vocab.Type) (names []string, voterIRI, questionIRI *url.URL, ok bool) {
+	note, isNote := t.(vocab.ActivityStreamsNote)
+	if !isNote {
+		return
+	}
+	if content := note.GetActivityStreamsContent(); content != nil && content.Len() > 0 {
+		return
+	}
+	names = getNames(note)
+	if len(names) == 0 {
+		return
+	}
+	irt := note.GetActivityStreamsInReplyTo()
+	if irt == nil || irt.Len() != 1 {
+		return
+	}
+	attr := note.GetActivityStreamsAttributedTo()
+	if attr == nil || attr.Len() != 1 {
+		return
+	}
+	var err error
+	if questionIRI, err = ToId(irt.At(0)); err != nil {
+		return
+	}
+	if voterIRI, err = ToId(attr.At(0)); err != nil {
+		return
+	}
+	ok = true
+	return
+}
+
+// getNames returns all plain and language-tagged values of the 'name'
+// property.
+func getNames(n namer) (names []string) {
+	name := n.GetActivityStreamsName()
+	if name == nil {
+		return
+	}
+	for iter := name.Begin(); iter != name.End(); iter = iter.Next() {
+		if iter.IsXMLSchemaString() {
+			names = append(names, iter.GetXMLSchemaString())
+		} else if iter.IsRDFLangString() {
+			for _, v := range iter.GetRDFLangString() {
+				names = append(names, v)
+			}
+		}
+	}
+	return
+}
+
+// hasName determines whether any of the names are values of the 'name'
+// property.
+func hasName(n namer, names []string) bool {
+	for _, a := range getNames(n) {
+		for _, b := range names {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getQuestionOptions returns the options of a Question, and whether multiple
+// options may be voted for by the same actor.
+func getQuestionOptions(q vocab.ActivityStreamsQuestion) (options []vocab.Type, multiple bool) {
+	if oneOf := q.GetActivityStreamsOneOf(); oneOf != nil && oneOf.Len() > 0 {
+		for iter := oneOf.Begin(); iter != oneOf.End(); iter = iter.Next() {
+			if t := iter.GetType(); t != nil {
+				options = append(options, t)
+			}
+		}
+		return
+	}
+	if anyOf := q.GetActivityStreamsAnyOf(); anyOf != nil {
+		for iter := anyOf.Begin(); iter != anyOf.End(); iter = iter.Next() {
+			if t := iter.GetType(); t != nil {
+				options = append(options, t)
+			}
+		}
+	}
+	return options, true
+}
+
+// getOptionVoters returns the ids of the actors that voted for an option, as
+// recorded in its 'replies' collection.
+func getOptionVoters(o replieser) map[string]bool {
+	voters := make(map[string]bool)
+	r := o.GetActivityStreamsReplies()
+	if r == nil || !r.IsActivityStreamsCollection() {
+		return voters
+	}
+	items := r.GetActivityStreamsCollection().GetActivityStreamsItems()
+	if items == nil {
+		return voters
+	}
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		a, ok := iter.GetType().(attributedToer)
+		if !ok {
+			continue
+		}
+		if attr := a.GetActivityStreamsAttributedTo(); attr != nil {
+			for aIter := attr.Begin(); aIter != attr.End(); aIter = aIter.Next() {
+				if id, err := ToId(aIter); err == nil {
+					voters[id.String()] = true
+				}
+			}
+		}
+	}
+	return voters
+}
+
+// addOptionVote records the vote in the 'replies' collection of the option,
+// creating the collection if needed, and increments its 'totalItems'.
+//
+// Only the id and voter of the vote are recorded. As this reveals who voted
+// for the option, applications should omit the 'items' when serving the
+// Question to others.
+func addOptionVote(o replieser, voteIRI, voterIRI *url.URL) {
+	r := o.GetActivityStreamsReplies()
+	var col vocab.ActivityStreamsCollection
+	if r != nil && r.IsActivityStreamsCollection() {
+		col = r.GetActivityStreamsCollection()
+	} else {
+		col = streams.NewActivityStreamsCollection()
+		r = streams.NewActivityStreamsRepliesProperty()
+		r.SetActivityStreamsCollection(col)
+		o.SetActivityStreamsReplies(r)
+	}
+	items := col.GetActivityStreamsItems()
+	if items == nil {
+		items = streams.NewActivityStreamsItemsProperty()
+		col.SetActivityStreamsItems(items)
+	}
+	vote := streams.NewActivityStreamsNote()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(voteIRI)
+	vote.SetActivityStreamsId(id)
+	attr := streams.NewActivityStreamsAttributedToProperty()
+	attr.AppendIRI(voterIRI)
+	vote.SetActivityStreamsAttributedTo(attr)
+	items.AppendActivityStreamsNote(vote)
+	total := col.GetActivityStreamsTotalItems()
+	if total == nil {
+		total = streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(0)
+		col.SetActivityStreamsTotalItems(total)
+	}
+	total.Set(total.Get() + 1)
+}
+
+// incrementVotersCount increments the Mastodon 'votersCount' extension
+// property of the Question.
+func incrementVotersCount(q vocab.ActivityStreamsQuestion) {
+	u := q.GetUnknownProperties()
+	var n int
+	switch v := u[votersCountProperty].(type) {
+	case float64:
+		n = int(v)
+	case int:
+		n = v
+	}
+	u[votersCountProperty] = n + 1
+}
+
+// isQuestionEnded determines if the Question is closed, or its 'endTime' has
+// passed.
+func isQuestionEnded(q vocab.ActivityStreamsQuestion, now time.Time) bool {
+	if closed := q.GetActivityStreamsClosed(); closed != nil && closed.Len() > 0 {
+		return true
+	}
+	end := q.GetActivityStreamsEndTime()
+	return end != nil && end.IsXMLSchemaDateTime() && !now.Before(end.Get())
+}
+
+// closeQuestion sets the 'closed' property of the Question to its 'endTime'.
+// Returns false if it was already closed.
+func closeQuestion(q vocab.ActivityStreamsQuestion, now time.Time) bool {
+	if closed := q.GetActivityStreamsClosed(); closed != nil && closed.Len() > 0 {
+		return false
+	}
+	at := now
+	if end := q.GetActivityStreamsEndTime(); end != nil && end.IsXMLSchemaDateTime() {
+		at = end.Get()
+	}
+	closed := streams.NewActivityStreamsClosedProperty()
+	closed.AppendXMLSchemaDateTime(at)
+	q.SetActivityStreamsClosed(closed)
+	return true
+}
+
+// countVote records a poll vote on the Question it replies to, if the
+// Question is owned by this server and is still open. The voter must be one of
+// the actors of the activity delivering the vote.
+//
+// Votes for unknown options, repeated votes for the same option, and votes for
+// a second option of a 'oneOf' Question are ignored. A vote received after the
+// Question's 'endTime' closes the Question instead.
+func countVote(c context.Context, db Database, clock Clock, actors []*url.URL, vote vocab.Type) error {
+	names, voterIRI, questionIRI, ok := getVote(vote)
+	if !ok {
+		return nil
+	}
+	isActor := false
+	for _, actor := range actors {
+		if actor.String() == voterIRI.String() {
+			isActor = true
+			break
+		}
+	}
+	if !isActor {
+		return nil
+	}
+	voteIRI, err := GetId(vote)
+	if err != nil {
+		return err
+	}
+	err = db.Lock(c, questionIRI)
+	if err != nil {
+		return err
+	}
+	defer db.Unlock(c, questionIRI)
+	if owns, err := db.Owns(c, questionIRI); err != nil {
+		return err
+	} else if !owns {
+		return nil
+	}
+	t, err := db.Get(c, questionIRI)
+	if err != nil {
+		return err
+	}
+	q, ok := t.(vocab.ActivityStreamsQuestion)
+	if !ok {
+		return nil
+	}
+	now := clock.Now()
+	if isQuestionEnded(q, now) {
+		if closeQuestion(q, now) {
+			return db.Update(c, q)
+		}
+		return nil
+	}
+	options, multiple := getQuestionOptions(q)
+	var chosen replieser
+	votedAny := false
+	for _, option := range options {
+		r, isReplieser := option.(replieser)
+		n, isNamer := option.(namer)
+		if !isReplieser || !isNamer {
+			continue
+		}
+		matches := chosen == nil && hasName(n, names)
+		if getOptionVoters(r)[voterIRI.String()] {
+			if matches {
+				// Already voted for this option.
+				return nil
+			}
+			votedAny = true
+		}
+		if matches {
+			chosen = r
+		}
+	}
+	if chosen == nil || (votedAny && !multiple) {
+		return nil
+	}
+	addOptionVote(chosen, voteIRI, voterIRI)
+	if !votedAny {
+		incrementVotersCount(q)
+	}
+	return db.Update(c, q)
+}
+
+// CloseQuestionIfEnded closes the Question with the given id if its 'endTime'
+// has passed, by setting its 'closed' property. Returns true if the Question
+// was closed by this call.
+//
+// Applications may schedule this at a Question's 'endTime' so that it is
+// closed without waiting for a late vote to arrive.
+func CloseQuestionIfEnded(c context.Context, db Database, clock Clock, questionIRI *url.URL) (bool, error) {
+	err := db.Lock(c, questionIRI)
+	if err != nil {
+		return false, err
+	}
+	defer db.Unlock(c, questionIRI)
+	t, err := db.Get(c, questionIRI)
+	if err != nil {
+		return false, err
+	}
+	q, ok := t.(vocab.ActivityStreamsQuestion)
+	if !ok {
+		return false, nil
+	}
+	now := clock.Now()
+	if !isQuestionEnded(q, now) || !closeQuestion(q, now) {
+		return false, nil
+	}
+	return true, db.Update(c, q)
+}
@@ -0,0 +1,171 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCountVote(t *testing.T) {
+	ctx := context.Background()
+	questionIRI := mustParse(testNoteId1)
+	voterIRI := mustParse(testFederatedActorIRI)
+	newOption := func(name string) vocab.ActivityStreamsNote {
+		n := streams.NewActivityStreamsNote()
+		np := streams.NewActivityStreamsNameProperty()
+		np.AppendXMLSchemaString(name)
+		n.SetActivityStreamsName(np)
+		return n
+	}
+	newQuestion := func() vocab.ActivityStreamsQuestion {
+		q := streams.NewActivityStreamsQuestion()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(questionIRI)
+		q.SetActivityStreamsId(id)
+		oneOf := streams.NewActivityStreamsOneOfProperty()
+		oneOf.AppendActivityStreamsNote(newOption("Yes"))
+		oneOf.AppendActivityStreamsNote(newOption("No"))
+		q.SetActivityStreamsOneOf(oneOf)
+		end := streams.NewActivityStreamsEndTimeProperty()
+		end.Set(now().Add(time.Hour))
+		q.SetActivityStreamsEndTime(end)
+		return q
+	}
+	newVote := func(id, name string) vocab.ActivityStreamsNote {
+		n := newOption(name)
+		idp := streams.NewActivityStreamsIdProperty()
+		idp.Set(mustParse(id))
+		n.SetActivityStreamsId(idp)
+		irt := streams.NewActivityStreamsInReplyToProperty()
+		irt.AppendIRI(questionIRI)
+		n.SetActivityStreamsInReplyTo(irt)
+		attr := streams.NewActivityStreamsAttributedToProperty()
+		attr.AppendIRI(voterIRI)
+		n.SetActivityStreamsAttributedTo(attr)
+		return n
+	}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, cl *MockClock) {
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		return
+	}
+	votes := func(q vocab.ActivityStreamsQuestion, idx int) int {
+		r := q.GetActivityStreamsOneOf().At(idx).GetActivityStreamsNote().GetActivityStreamsReplies()
+		if r == nil {
+			return 0
+		}
+		return r.GetActivityStreamsCollection().GetActivityStreamsTotalItems().Get()
+	}
+	t.Run("CountsVote", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl)
+		q := newQuestion()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, questionIRI),
+			db.EXPECT().Owns(ctx, questionIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, questionIRI).Return(q, nil),
+			cl.EXPECT().Now().Return(now()),
+			db.EXPECT().Update(ctx, q),
+			db.EXPECT().Unlock(ctx, questionIRI),
+		)
+		// Run
+		err := countVote(ctx, db, cl, []*url.URL{voterIRI}, newVote(testFederatedActivityIRI, "Yes"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, votes(q, 0), 1)
+		assertEqual(t, votes(q, 1), 0)
+		assertEqual(t, q.GetUnknownProperties()[votersCountProperty], 1)
+	})
+	t.Run("IgnoresSecondOneOfVote", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl)
+		q := newQuestion()
+		addOptionVote(q.GetActivityStreamsOneOf().At(0).GetActivityStreamsNote(), mustParse(testFederatedActivityIRI), voterIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, questionIRI),
+			db.EXPECT().Owns(ctx, questionIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, questionIRI).Return(q, nil),
+			cl.EXPECT().Now().Return(now()),
+			db.EXPECT().Unlock(ctx, questionIRI),
+		)
+		// Run
+		err := countVote(ctx, db, cl, []*url.URL{voterIRI}, newVote(testFederatedActivityIRI2, "No"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, votes(q, 0), 1)
+		assertEqual(t, votes(q, 1), 0)
+	})
+	t.Run("ClosesAfterEndTime", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl)
+		q := newQuestion()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, questionIRI),
+			db.EXPECT().Owns(ctx, questionIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, questionIRI).Return(q, nil),
+			cl.EXPECT().Now().Return(now().Add(2*time.Hour)),
+			db.EXPECT().Update(ctx, q),
+			db.EXPECT().Unlock(ctx, questionIRI),
+		)
+		// Run
+		err := countVote(ctx, db, cl, []*url.URL{voterIRI}, newVote(testFederatedActivityIRI, "Yes"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, votes(q, 0), 0)
+		assertEqual(t, q.GetActivityStreamsClosed().Len(), 1)
+		assertEqual(t, q.GetActivityStreamsClosed().At(0).GetXMLSchemaDateTime(), q.GetActivityStreamsEndTime().Get())
+	})
+	t.Run("IgnoresVoterNotActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl)
+		// Run
+		err := countVote(ctx, db, cl, []*url.URL{mustParse(testFederatedActorIRI2)}, newVote(testFederatedActivityIRI, "Yes"))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
+
+func TestCloseQuestionIfEnded(t *testing.T) {
+	ctx := context.Background()
+	questionIRI := mustParse(testNoteId1)
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	db := NewMockDatabase(ctl)
+	cl := NewMockClock(ctl)
+	q := streams.NewActivityStreamsQuestion()
+	end := streams.NewActivityStreamsEndTimeProperty()
+	end.Set(now())
+	q.SetActivityStreamsEndTime(end)
+	gomock.InOrder(
+		db.EXPECT().Lock(ctx, questionIRI),
+		db.EXPECT().Get(ctx, questionIRI).Return(q, nil),
+		cl.EXPECT().Now().Return(now()),
+		db.EXPECT().Update(ctx, q),
+		db.EXPECT().Unlock(ctx, questionIRI),
+		db.EXPECT().Lock(ctx, questionIRI),
+		db.EXPECT().Get(ctx, questionIRI).Return(q, nil),
+		cl.EXPECT().Now().Return(now()),
+		db.EXPECT().Unlock(ctx, questionIRI),
+	)
+	// Run
+	closed, err := CloseQuestionIfEnded(ctx, db, cl, questionIRI)
+	again, againErr := CloseQuestionIfEnded(ctx, db, cl, questionIRI)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, closed, true)
+	assertEqual(t, againErr, nil)
+	assertEqual(t, again, false)
+}
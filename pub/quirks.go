@@ -0,0 +1,256 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ContextForm is how the @context of a delivered activity is written.
+type ContextForm int
+
+const (
+	// ContextAsSerialized leaves the @context as it was serialized.
+	ContextAsSerialized ContextForm = iota
+	// ContextString writes a @context of a single IRI as a string instead
+	// of an array.
+	ContextString
+	// ContextArray writes a @context of a single IRI as an array with it.
+	ContextArray
+)
+
+// Quirks are the adjustments made to the requests sent to the peers running a
+// software, for the few implementations that do not accept the defaults.
+type Quirks struct {
+	// Context is how the @context of delivered activities is written.
+	Context ContextForm
+	// SignatureVariant is the Name of the SignatureVariant a
+	// double-knocking HttpSigTransport first signs requests with, unless
+	// the host is known to accept another. If empty, the most preferred
+	// variant is used.
+	SignatureVariant string
+	// SensitiveSummary marks the delivered activity and its embedded
+	// objects as "sensitive" if they have a "summary", for software only
+	// showing the summary as a content warning of sensitive objects.
+	SensitiveSummary bool
+	// DefaultSummary is the "summary" given to the delivered activity and
+	// its embedded objects if they are "sensitive" without one, for
+	// software only showing a content warning if there is a summary. If
+	// empty, they are left as they are.
+	DefaultSummary string
+	// Adjust makes any other change to a delivered activity, after the
+	// others. It may be nil.
+	Adjust func(c context.Context, m map[string]interface{}) error
+}
+
+// changesBody determines whether the quirks change the delivered activities.
+func (q Quirks) changesBody() bool {
+	return q.Context != ContextAsSerialized || q.SensitiveSummary || len(q.DefaultSummary) > 0 || q.Adjust != nil
+}
+
+// Apply adjusts the serialized activity delivered to a peer running the
+// software.
+func (q Quirks) Apply(c context.Context, m map[string]interface{}) error {
+	switch v := m["@context"].(type) {
+	case string:
+		if q.Context == ContextArray {
+			m["@context"] = []interface{}{v}
+		}
+	case []interface{}:
+		if len(v) != 1 || q.Context != ContextString {
+			break
+		} else if s, ok := v[0].(string); ok {
+			m["@context"] = s
+		}
+	}
+	q.applySensitive(m)
+	switch o := m["object"].(type) {
+	case map[string]interface{}:
+		q.applySensitive(o)
+	case []interface{}:
+		for _, e := range o {
+			if em, ok := e.(map[string]interface{}); ok {
+				q.applySensitive(em)
+			}
+		}
+	}
+	if q.Adjust != nil {
+		return q.Adjust(c, m)
+	}
+	return nil
+}
+
+// applySensitive applies the conventions of sensitive objects to a value.
+func (q Quirks) applySensitive(m map[string]interface{}) {
+	summary, _ := m["summary"].(string)
+	if q.SensitiveSummary && len(summary) > 0 {
+		m["sensitive"] = true
+	}
+	if sensitive, _ := m["sensitive"].(bool); sensitive && len(summary) == 0 && len(q.DefaultSummary) > 0 {
+		m["summary"] = q.DefaultSummary
+	}
+}
+
+// QuirksRegistry holds the Quirks of each software, and the software each peer
+// host is detected to run, so that the hacks for each implementation are kept
+// in one place.
+//
+// A single QuirksRegistry should be shared by every Transport of an
+// application.
+type QuirksRegistry struct {
+	mu       sync.RWMutex
+	software map[string]Quirks
+	hosts    map[string]string
+}
+
+// NewQuirksRegistry creates a QuirksRegistry without any Quirks.
+func NewQuirksRegistry() *QuirksRegistry {
+	return &QuirksRegistry{
+		software: make(map[string]Quirks),
+		hosts:    make(map[string]string),
+	}
+}
+
+// Register sets the Quirks of the software, by its NodeInfo name such as
+// "mastodon" or "misskey".
+func (r *QuirksRegistry) Register(software string, q Quirks) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.software[strings.ToLower(software)] = q
+}
+
+// SetSoftware records the software a host runs, by its NodeInfo name.
+func (r *QuirksRegistry) SetSoftware(host, software string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hosts[host] = strings.ToLower(software)
+}
+
+// Software returns the software the host was detected to run, and whether it
+// was detected.
+func (r *QuirksRegistry) Software(host string) (software string, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	software, ok = r.hosts[host]
+	return
+}
+
+// Quirks returns the Quirks of the software the host runs, and whether it was
+// detected to run a software with Quirks.
+func (r *QuirksRegistry) Quirks(host string) (q Quirks, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	software, ok := r.hosts[host]
+	if !ok {
+		return
+	}
+	q, ok = r.software[software]
+	return
+}
+
+// DetectUserAgent records the software of the host from the User-Agent of a
+// request it sent, such as to an inbox, if it names a software with Quirks.
+// Returns the software, or an empty string if none is named.
+//
+// The software named first in the User-Agent is chosen, so that the names in
+// the URL of the server that usually follows are ignored.
+func (r *QuirksRegistry) DetectUserAgent(host, userAgent string) string {
+	tokens := strings.FieldsFunc(strings.ToLower(userAgent), func(c rune) bool {
+		return !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-')
+	})
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, t := range tokens {
+		if _, ok := r.software[t]; ok {
+			r.hosts[host] = t
+			return t
+		}
+	}
+	return ""
+}
+
+// DetectNodeInfo records the software of the host from its NodeInfo, fetched
+// with the Transport, and returns it. The newest NodeInfo version listed in
+// the discovery document of the host is fetched.
+func (r *QuirksRegistry) DetectNodeInfo(c context.Context, t Transport, host string) (string, error) {
+	wellKnown := &url.URL{Scheme: "https", Host: host, Path: NodeInfoWellKnownPath}
+	b, err := t.Dereference(c, wellKnown)
+	if err != nil {
+		return "", err
+	}
+	var d nodeInfoDiscovery
+	if err := json.Unmarshal(b, &d); err != nil {
+		return "", err
+	}
+	var href, version string
+	for _, l := range d.Links {
+		if v := strings.TrimPrefix(l.Rel, nodeInfoSchema); v != l.Rel && v > version {
+			href, version = l.Href, v
+		}
+	}
+	if len(href) == 0 {
+		return "", fmt.Errorf("no NodeInfo listed by %s", host)
+	}
+	iri, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	if b, err = t.Dereference(c, iri); err != nil {
+		return "", err
+	}
+	var ni NodeInfo
+	if err := json.Unmarshal(b, &ni); err != nil {
+		return "", err
+	} else if len(ni.Software.Name) == 0 {
+		return "", fmt.Errorf("no software named in the NodeInfo of %s", host)
+	}
+	r.SetSoftware(host, ni.Software.Name)
+	return strings.ToLower(ni.Software.Name), nil
+}
+
+// adjust returns the activity delivered to the host, adjusted by the Quirks of
+// its software.
+func (r *QuirksRegistry) adjust(c context.Context, host string, b []byte) ([]byte, error) {
+	q, ok := r.Quirks(host)
+	if !ok || !q.changesBody() {
+		return b, nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if err := q.Apply(c, m); err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// WithQuirks returns a copy of the transport adjusting the activities it
+// delivers to each host, and the signature variant it first signs requests to
+// each host with when double-knocking, by the Quirks of the software the host
+// runs.
+func (h HttpSigTransport) WithQuirks(r *QuirksRegistry) *HttpSigTransport {
+	h.quirks = r
+	return &h
+}
+
+// quirkVariant returns the index of the signature variant the Quirks of the
+// host's software sign with first, or of the most preferred one.
+func (h HttpSigTransport) quirkVariant(host string) int {
+	if h.quirks == nil {
+		return 0
+	}
+	q, ok := h.quirks.Quirks(host)
+	if !ok || len(q.SignatureVariant) == 0 {
+		return 0
+	}
+	for i, v := range h.variants.variants {
+		if v.Name == q.SignatureVariant {
+			return i
+		}
+	}
+	return 0
+}
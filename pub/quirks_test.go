@@ -0,0 +1,127 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestQuirks(t *testing.T) {
+	ctx := context.Background()
+	newRegistry := func() *QuirksRegistry {
+		r := NewQuirksRegistry()
+		r.Register("Misskey", Quirks{
+			Context:          ContextArray,
+			SignatureVariant: "rsa-sha256",
+			DefaultSummary:   "Sensitive",
+		})
+		r.Register("pleroma", Quirks{SensitiveSummary: true})
+		return r
+	}
+	t.Run("AppliesContextForm", func(t *testing.T) {
+		// Setup
+		m := map[string]interface{}{"@context": []interface{}{"https://www.w3.org/ns/activitystreams"}}
+		// Run
+		err := Quirks{Context: ContextString}.Apply(ctx, m)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, m["@context"], "https://www.w3.org/ns/activitystreams")
+		err = Quirks{Context: ContextArray}.Apply(ctx, m)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(m["@context"].([]interface{})), 1)
+	})
+	t.Run("AppliesSensitiveConventions", func(t *testing.T) {
+		// Setup
+		note := map[string]interface{}{"type": "Note", "summary": "Spoilers"}
+		image := map[string]interface{}{"type": "Image", "sensitive": true}
+		m := map[string]interface{}{"type": "Create", "object": []interface{}{note, image}}
+		// Run
+		err := Quirks{SensitiveSummary: true, DefaultSummary: "Sensitive"}.Apply(ctx, m)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, note["sensitive"], true)
+		assertEqual(t, note["summary"], "Spoilers")
+		assertEqual(t, image["summary"], "Sensitive")
+		_, ok := m["sensitive"]
+		assertEqual(t, ok, false)
+	})
+	t.Run("DetectsUserAgent", func(t *testing.T) {
+		// Setup
+		r := newRegistry()
+		// Run
+		software := r.DetectUserAgent("example.com", "Pleroma 2.5.0; https://misskey.example.com <admin@example.com>")
+		// Verify
+		assertEqual(t, software, "pleroma")
+		q, ok := r.Quirks("example.com")
+		assertEqual(t, ok, true)
+		assertEqual(t, q.SensitiveSummary, true)
+		assertEqual(t, r.DetectUserAgent("example.net", "http.rb/5.1.1 (Mastodon/4.2.0; +https://example.net/)"), "")
+		_, ok = r.Software("example.net")
+		assertEqual(t, ok, false)
+	})
+	t.Run("DetectsNodeInfo", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		r := newRegistry()
+		gomock.InOrder(
+			tp.EXPECT().Dereference(ctx, mustParse("https://example.com/.well-known/nodeinfo")).Return([]byte(`{"links": [
+				{"rel": "http://nodeinfo.diaspora.software/ns/schema/2.1", "href": "https://example.com/nodeinfo/2.1"},
+				{"rel": "http://nodeinfo.diaspora.software/ns/schema/2.0", "href": "https://example.com/nodeinfo/2.0"}
+			]}`), nil),
+			tp.EXPECT().Dereference(ctx, mustParse("https://example.com/nodeinfo/2.1")).Return([]byte(`{"version": "2.1", "software": {"name": "misskey", "version": "13.14.2"}}`), nil),
+		)
+		// Run
+		software, err := r.DetectNodeInfo(ctx, tp, "example.com")
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, software, "misskey")
+		q, ok := r.Quirks("example.com")
+		assertEqual(t, ok, true)
+		assertEqual(t, q.Context, ContextArray)
+	})
+	t.Run("AdjustsDeliveriesToHost", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		rsaSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hs2019Signer := NewHS2019Signer(cl, []string{httpsig.RequestTarget, Created}, 0, httpsig.Signature)
+		r := newRegistry()
+		r.SetSoftware(mustParse(testFederatedActorIRI).Host, "misskey")
+		tp := NewDoubleKnockingTransport(client, "test", cl, []SignatureVariant{
+			{Name: "hs2019", GetSigner: hs2019Signer, PostSigner: hs2019Signer},
+			{Name: "rsa-sha256", GetSigner: rsaSigner, PostSigner: rsaSigner},
+		}, testMyActorIRI+"#main-key", privKey).WithQuirks(r)
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			assertEqual(t, signatureParams(req)["algorithm"], "rsa-sha256")
+			b, err := ioutil.ReadAll(req.Body)
+			assertEqual(t, err, nil)
+			var m map[string]interface{}
+			assertEqual(t, json.Unmarshal(b, &m), nil)
+			assertEqual(t, len(m["@context"].([]interface{})), 1)
+			return &http.Response{StatusCode: http.StatusAccepted, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+		})
+		// Run
+		err = tp.Deliver(ctx, []byte(`{"@context": "https://www.w3.org/ns/activitystreams", "type": "Create"}`), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,210 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+)
+
+const (
+	// likesPath is appended to the path of an object to identify the
+	// 'likes' collection created for it.
+	likesPath = "/likes"
+	// sharesPath is appended to the path of an object to identify the
+	// 'shares' collection created for it.
+	sharesPath = "/shares"
+)
+
+// collectionProperty is a property whose value is a collection, such as the
+// 'likes' or 'shares' of an object.
+type collectionProperty interface {
+	GetType() vocab.Type
+	IsIRI() bool
+	GetIRI() *url.URL
+	SetActivityStreamsCollection(v vocab.ActivityStreamsCollection)
+}
+
+// LikesPageFunc returns a CollectionPageFunc fetching the 'likes' collection of
+// an object owned by this server, at the object's IRI followed by "/likes".
+//
+// This is the IRI of the 'likes' collection the FederatingProtocol creates on
+// an object when it is first liked, so a CollectionPager's handler may serve
+// it to peers fetching the object's likes.
+func LikesPageFunc(db Database) CollectionPageFunc {
+	return objectCollectionPageFunc(db, likesPath, func(t vocab.Type) collectionProperty {
+		if l, ok := t.(likeser); ok && l.GetActivityStreamsLikes() != nil {
+			return l.GetActivityStreamsLikes()
+		}
+		return nil
+	})
+}
+
+// SharesPageFunc returns a CollectionPageFunc fetching the 'shares' collection
+// of an object owned by this server, at the object's IRI followed by
+// "/shares".
+//
+// This is the IRI of the 'shares' collection the FederatingProtocol creates on
+// an object when it is first announced, so a CollectionPager's handler may
+// serve it to peers fetching the object's shares.
+func SharesPageFunc(db Database) CollectionPageFunc {
+	return objectCollectionPageFunc(db, sharesPath, func(t vocab.Type) collectionProperty {
+		if s, ok := t.(shareser); ok && s.GetActivityStreamsShares() != nil {
+			return s.GetActivityStreamsShares()
+		}
+		return nil
+	})
+}
+
+// objectCollectionPageFunc returns a CollectionPageFunc fetching the collection
+// in an object's property, at the object's IRI followed by the path.
+func objectCollectionPageFunc(db Database, path string, propOf func(vocab.Type) collectionProperty) CollectionPageFunc {
+	return func(c context.Context, collectionIRI *url.URL, offset, limit int) (page CollectionPage, err error) {
+		if !strings.HasSuffix(collectionIRI.Path, path) {
+			err = fmt.Errorf("collection IRI does not end with %q: %s", path, collectionIRI)
+			return
+		}
+		objectIRI := &url.URL{}
+		*objectIRI = *collectionIRI
+		objectIRI.Path = strings.TrimSuffix(objectIRI.Path, path)
+		col, err := getObjectCollection(c, db, objectIRI, propOf)
+		if err != nil {
+			return
+		}
+		items := pageItems(col)
+		page.TotalItems = len(items)
+		if offset < len(items) {
+			end := offset + limit
+			if end > len(items) {
+				end = len(items)
+			}
+			page.Items = items[offset:end]
+		}
+		return
+	}
+}
+
+// getObjectCollection obtains the collection in a property of an object owned
+// by this server. A collection only referenced by its IRI is obtained from the
+// Database. Returns nil if the object has no such collection.
+//
+// Acquires and releases the lock of the object.
+func getObjectCollection(c context.Context, db Database, objectIRI *url.URL, propOf func(vocab.Type) collectionProperty) (vocab.Type, error) {
+	if err := db.Lock(c, objectIRI); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, objectIRI)
+	if owns, err := db.Owns(c, objectIRI); err != nil {
+		return nil, err
+	} else if !owns {
+		return nil, wrapErr(ErrNotOwned, "cannot get collection of object %s", objectIRI)
+	}
+	t, err := db.Get(c, objectIRI)
+	if err != nil {
+		return nil, err
+	}
+	prop := propOf(t)
+	if prop == nil {
+		return nil, nil
+	} else if !prop.IsIRI() {
+		return prop.GetType(), nil
+	}
+	if err := db.Lock(c, prop.GetIRI()); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, prop.GetIRI())
+	return db.Get(c, prop.GetIRI())
+}
+
+// addToObjectCollection prepends the activity to the collection in a property
+// of an object, creating a Collection identified by the object's IRI followed
+// by the path if there is none. A collection only referenced by its IRI is
+// updated in the Database if it is owned by this server. Returns whether the
+// object itself must be updated.
+func addToObjectCollection(c context.Context, db Database, objectIRI *url.URL, prop collectionProperty, path string, activityIRI *url.URL) (update bool, err error) {
+	if prop.IsIRI() {
+		return false, addToCollection(c, db, prop.GetIRI(), activityIRI)
+	}
+	if prop.GetType() == nil {
+		col := streams.NewActivityStreamsCollection()
+		id := streams.NewActivityStreamsIdProperty()
+		colIRI := &url.URL{}
+		*colIRI = *objectIRI
+		colIRI.Path = strings.TrimSuffix(colIRI.Path, "/") + path
+		id.Set(colIRI)
+		col.SetActivityStreamsId(id)
+		prop.SetActivityStreamsCollection(col)
+	}
+	return prependCollectionItem(prop.GetType(), activityIRI)
+}
+
+// removeCollectionItems removes the matching items of a Collection or
+// OrderedCollection and updates its 'totalItems'. Returns whether any item
+// was removed.
+func removeCollectionItems(t vocab.Type, matches func(IdProperty) (bool, error)) (removed bool, err error) {
+	var n int
+	switch col := t.(type) {
+	case itemser:
+		items := col.GetActivityStreamsItems()
+		for i := 0; items != nil && i < items.Len(); {
+			if m, err := matches(items.At(i)); err != nil {
+				return false, err
+			} else if m {
+				items.Remove(i)
+				removed = true
+			} else {
+				i++
+			}
+		}
+		if items != nil {
+			n = items.Len()
+		}
+	case orderedItemser:
+		oItems := col.GetActivityStreamsOrderedItems()
+		for i := 0; oItems != nil && i < oItems.Len(); {
+			if m, err := matches(oItems.At(i)); err != nil {
+				return false, err
+			} else if m {
+				oItems.Remove(i)
+				removed = true
+			} else {
+				i++
+			}
+		}
+		if oItems != nil {
+			n = oItems.Len()
+		}
+	}
+	if ti, ok := t.(totalItemser); ok && removed {
+		total := streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(n)
+		ti.SetActivityStreamsTotalItems(total)
+	}
+	return
+}
+
+// removeFromCollection removes the matching items of the Collection or
+// OrderedCollection stored at the IRI, if it is owned by this server.
+//
+// Acquires and releases the lock of the collection.
+func removeFromCollection(c context.Context, db Database, collectionIRI *url.URL, matches func(IdProperty) (bool, error)) error {
+	if err := db.Lock(c, collectionIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, collectionIRI)
+	if owns, err := db.Owns(c, collectionIRI); err != nil {
+		return err
+	} else if !owns {
+		return nil
+	}
+	t, err := db.Get(c, collectionIRI)
+	if err != nil {
+		return err
+	}
+	if removed, err := removeCollectionItems(t, matches); err != nil || !removed {
+		return err
+	}
+	return db.Update(c, t)
+}
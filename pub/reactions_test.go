@@ -0,0 +1,104 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+func TestLikesPageFunc(t *testing.T) {
+	ctx := context.Background()
+	noteIRI := mustParse(testNoteId1)
+	likesIRI := mustParse(testNoteId1 + "/likes")
+	t.Run("PagesEmbeddedCollection", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		note := streams.NewActivityStreamsNote()
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActivityIRI))
+		items.AppendIRI(mustParse(testFederatedActivityIRI2))
+		col.SetActivityStreamsItems(items)
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetActivityStreamsCollection(col)
+		note.SetActivityStreamsLikes(likes)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		page, err := LikesPageFunc(db)(ctx, likesIRI, 1, 20)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, page.TotalItems, 2)
+		assertEqual(t, len(page.Items), 1)
+		assertEqual(t, page.Items[0].IRI.String(), testFederatedActivityIRI2)
+	})
+	t.Run("GetsStoredCollection", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		note := streams.NewActivityStreamsNote()
+		likes := streams.NewActivityStreamsLikesProperty()
+		likes.SetIRI(likesIRI)
+		note.SetActivityStreamsLikes(likes)
+		col := streams.NewActivityStreamsOrderedCollection()
+		oItems := streams.NewActivityStreamsOrderedItemsProperty()
+		oItems.AppendIRI(mustParse(testFederatedActivityIRI))
+		col.SetActivityStreamsOrderedItems(oItems)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(note, nil),
+			db.EXPECT().Lock(ctx, likesIRI),
+			db.EXPECT().Get(ctx, likesIRI).Return(col, nil),
+			db.EXPECT().Unlock(ctx, likesIRI),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		page, err := LikesPageFunc(db)(ctx, likesIRI, 0, 20)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, page.TotalItems, 1)
+		assertEqual(t, page.Items[0].IRI.String(), testFederatedActivityIRI)
+	})
+	t.Run("EmptyWithoutLikes", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, noteIRI).Return(streams.NewActivityStreamsNote(), nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		page, err := LikesPageFunc(db)(ctx, likesIRI, 0, 20)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, page.TotalItems, 0)
+		assertEqual(t, len(page.Items), 0)
+	})
+	t.Run("ErrorIfNotOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteIRI),
+			db.EXPECT().Owns(ctx, noteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteIRI),
+		)
+		// Run
+		_, err := LikesPageFunc(db)(ctx, likesIRI, 0, 20)
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,475 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// RequestVerifier verifies the HTTP Signature of a request and returns the IRI
+// of the actor that signed it, or a nil signer if it is not signed. The
+// VerifyRequest method of a PublicKeyCache and the Verify method of an
+// AuthorizedFetch are RequestVerifiers.
+type RequestVerifier func(c context.Context, r *http.Request) (signer *url.URL, err error)
+
+// RelaySubscriber is a server subscribed to a Relay, identified by the actor
+// that followed the relay.
+type RelaySubscriber struct {
+	// Actor is the actor that followed the relay.
+	Actor *url.URL
+	// Inbox receives the activities relayed to the subscriber. It is the
+	// actor's shared inbox if it has one.
+	Inbox *url.URL
+}
+
+// RelaySubscriberStore persists the subscribers of a Relay.
+//
+// Implementations must be safe for concurrent use.
+type RelaySubscriberStore interface {
+	// AddSubscriber adds the subscriber, replacing any existing one with
+	// the same actor.
+	AddSubscriber(c context.Context, s RelaySubscriber) error
+	// RemoveSubscriber removes the subscriber with the actor, if any.
+	RemoveSubscriber(c context.Context, actorIRI *url.URL) error
+	// IsSubscriber returns whether the actor is a subscriber.
+	IsSubscriber(c context.Context, actorIRI *url.URL) (bool, error)
+	// Subscribers returns every subscriber.
+	Subscribers(c context.Context) ([]RelaySubscriber, error)
+}
+
+// MemoryRelaySubscriberStore is a RelaySubscriberStore held in memory.
+//
+// It is not suitable when the subscribers must survive restarts.
+type MemoryRelaySubscriberStore struct {
+	mu          sync.Mutex
+	subscribers map[string]RelaySubscriber
+}
+
+// MemoryRelaySubscriberStore must satisfy the RelaySubscriberStore interface.
+var _ RelaySubscriberStore = &MemoryRelaySubscriberStore{}
+
+// NewMemoryRelaySubscriberStore creates an empty MemoryRelaySubscriberStore.
+func NewMemoryRelaySubscriberStore() *MemoryRelaySubscriberStore {
+	return &MemoryRelaySubscriberStore{
+		subscribers: make(map[string]RelaySubscriber),
+	}
+}
+
+// AddSubscriber adds the subscriber.
+func (m *MemoryRelaySubscriberStore) AddSubscriber(c context.Context, s RelaySubscriber) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribers[s.Actor.String()] = s
+	return nil
+}
+
+// RemoveSubscriber removes the subscriber with the actor.
+func (m *MemoryRelaySubscriberStore) RemoveSubscriber(c context.Context, actorIRI *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.subscribers, actorIRI.String())
+	return nil
+}
+
+// IsSubscriber returns whether the actor is a subscriber.
+func (m *MemoryRelaySubscriberStore) IsSubscriber(c context.Context, actorIRI *url.URL) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.subscribers[actorIRI.String()]
+	return ok, nil
+}
+
+// Subscribers returns every subscriber.
+func (m *MemoryRelaySubscriberStore) Subscribers(c context.Context) ([]RelaySubscriber, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]RelaySubscriber, 0, len(m.subscribers))
+	for _, s := range m.subscribers {
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// Relay is a LitePub style relay, as followed by Mastodon and Pleroma servers
+// to receive public activities from servers they are not yet federating with.
+//
+// A server subscribes by sending a Follow of the Public collection, or of the
+// relay's actor, to the relay's inbox, and unsubscribes with an Undo of it.
+// The public activities its subscribers post to the relay's inbox are
+// re-announced to every other subscriber: a Create or an Announce results in
+// an Announce of its object by the relay, while an Update or a Delete is
+// forwarded as is. Activities are only relayed once, are never sent back to
+// the host they came from, and those of the relay itself are ignored, so that
+// relays following each other do not loop.
+//
+// The relay's actor is an InstanceActor, whose NewHandler serves its actor
+// document.
+type Relay struct {
+	actor  *InstanceActor
+	store  RelaySubscriberStore
+	verify RequestVerifier
+	tp     Transport
+	minter IdMinter
+	seen   SeenStore
+}
+
+// NewRelay creates a Relay acting as the InstanceActor and delivering with the
+// Transport, which must sign its requests as that actor, such as one returned
+// by its NewTransport. Incoming requests are verified by the RequestVerifier,
+// and the ids of the relay's own activities are minted by the IdMinter.
+func NewRelay(actor *InstanceActor, store RelaySubscriberStore, verify RequestVerifier, tp Transport, minter IdMinter, clock Clock) *Relay {
+	return &Relay{
+		actor:  actor,
+		store:  store,
+		verify: verify,
+		tp:     tp,
+		minter: minter,
+		seen:   NewMemorySeenStore(clock, DefaultSeenTTL),
+	}
+}
+
+// PostInbox handles a POST request to the relay's inbox. It follows the
+// conventions of an Actor's PostInbox: it returns false without writing to
+// the ResponseWriter if the request is not an ActivityPub POST, and the caller
+// is responsible for writing a response if an error is returned.
+//
+// Requests whose signer is not the activity's actor are refused with
+// Unauthorized, and activities from actors that are not subscribers with
+// Forbidden. Other activities are acknowledged with Accepted.
+func (r *Relay) PostInbox(c context.Context, w http.ResponseWriter, req *http.Request) (bool, error) {
+	if !isActivityPubPost(req) {
+		return false, nil
+	}
+	signer, err := r.verify(c, req)
+	if err != nil || signer == nil {
+		logEntry(c, LogLevelInfo, "relay request not authenticated",
+			LogField{Key: LogKeyRemoteHost, Value: requestRemoteHost(req)})
+		w.WriteHeader(http.StatusUnauthorized)
+		return true, nil
+	}
+	raw, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return true, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(raw, &m); err != nil {
+		return true, err
+	}
+	t, err := streams.DeserializeContext(c, m)
+	if err != nil && !streams.IsUnmatchedErr(err) {
+		return true, err
+	}
+	activity, ok := t.(Activity)
+	if err != nil || !ok || activity.GetActivityStreamsId() == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return true, nil
+	}
+	actors, err := getActorIds(activity)
+	if err != nil {
+		return true, err
+	} else if len(actors) != 1 || actors[0].String() != signer.String() {
+		w.WriteHeader(http.StatusUnauthorized)
+		return true, nil
+	}
+	actorIRI := actors[0]
+	if actorIRI.String() == r.actor.Id().String() {
+		w.WriteHeader(http.StatusAccepted)
+		return true, nil
+	}
+	fields := activityLogFields(activity)
+	switch v := activity.(type) {
+	case vocab.ActivityStreamsFollow:
+		if !r.followsRelay(v) {
+			break
+		}
+		if err = r.subscribe(c, actorIRI, v); err != nil {
+			return true, err
+		}
+		logEntry(c, LogLevelInfo, "relay subscribed", fields...)
+	case vocab.ActivityStreamsUndo:
+		if !r.undoesFollow(v) {
+			break
+		}
+		if err = r.store.RemoveSubscriber(c, actorIRI); err != nil {
+			return true, err
+		}
+		logEntry(c, LogLevelInfo, "relay unsubscribed", fields...)
+	case vocab.ActivityStreamsCreate, vocab.ActivityStreamsAnnounce, vocab.ActivityStreamsUpdate, vocab.ActivityStreamsDelete:
+		if subscribed, err := r.store.IsSubscriber(c, actorIRI); err != nil {
+			return true, err
+		} else if !subscribed {
+			logEntry(c, LogLevelInfo, "relay refused activity of a non-subscriber", fields...)
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
+		}
+		if err = r.relay(c, actorIRI, activity, raw); err != nil {
+			return true, err
+		}
+	}
+	w.WriteHeader(http.StatusAccepted)
+	return true, nil
+}
+
+// followsRelay returns whether the Follow is of the Public collection or of
+// the relay's actor.
+func (r *Relay) followsRelay(follow vocab.ActivityStreamsFollow) bool {
+	op := follow.GetActivityStreamsObject()
+	if op == nil {
+		return false
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			continue
+		}
+		if IsPublic(id.String()) || id.String() == r.actor.Id().String() {
+			return true
+		}
+	}
+	return false
+}
+
+// undoesFollow returns whether the Undo is of a Follow of the relay. A Follow
+// only referenced by its IRI is assumed to be one, as an actor only follows a
+// relay once.
+func (r *Relay) undoesFollow(undo vocab.ActivityStreamsUndo) bool {
+	op := undo.GetActivityStreamsObject()
+	if op == nil {
+		return false
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if iter.IsIRI() {
+			return true
+		} else if follow, ok := iter.GetType().(vocab.ActivityStreamsFollow); ok && r.followsRelay(follow) {
+			return true
+		}
+	}
+	return false
+}
+
+// subscribe adds the actor as a subscriber and sends it an Accept of its
+// Follow.
+func (r *Relay) subscribe(c context.Context, actorIRI *url.URL, follow vocab.ActivityStreamsFollow) error {
+	actor, err := dereferenceType(c, r.tp, actorIRI)
+	if err != nil {
+		return err
+	}
+	inbox := getSharedInbox(actor)
+	if inbox == nil {
+		if inbox, err = getInbox(actor); err != nil {
+			return err
+		}
+	}
+	if err = r.store.AddSubscriber(c, RelaySubscriber{Actor: actorIRI, Inbox: inbox}); err != nil {
+		return err
+	}
+	accept := streams.NewActivityStreamsAccept()
+	if err = r.setActorAndId(c, accept); err != nil {
+		return err
+	}
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsFollow(follow)
+	accept.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(actorIRI)
+	accept.SetActivityStreamsTo(to)
+	b, err := serializeJSON(accept)
+	if err != nil {
+		return err
+	}
+	return r.tp.Deliver(c, b, inbox)
+}
+
+// relay sends the public activity of the subscriber to every other
+// subscriber, unless it was already relayed.
+func (r *Relay) relay(c context.Context, actorIRI *url.URL, activity Activity, raw []byte) error {
+	if !isPublicActivity(activity) {
+		return nil
+	}
+	b := raw
+	relayed := activity.GetActivityStreamsId().Get()
+	_, isCreate := activity.(vocab.ActivityStreamsCreate)
+	_, isAnnounce := activity.(vocab.ActivityStreamsAnnounce)
+	reannounce := isCreate || isAnnounce
+	if reannounce {
+		op := activity.GetActivityStreamsObject()
+		if op == nil || op.Len() == 0 {
+			return nil
+		}
+		object, err := ToId(op.At(0))
+		if err != nil {
+			return err
+		}
+		relayed = object
+	}
+	if seen, err := r.seen.MarkSeen(c, relayed); err != nil {
+		return err
+	} else if seen {
+		return nil
+	}
+	if reannounce {
+		announce, err := r.announce(c, relayed)
+		if err != nil {
+			return err
+		}
+		if b, err = serializeJSON(announce); err != nil {
+			return err
+		}
+	}
+	subscribers, err := r.store.Subscribers(c)
+	if err != nil {
+		return err
+	}
+	inboxes := make([]*url.URL, 0, len(subscribers))
+	for _, s := range subscribers {
+		if s.Inbox.Host != actorIRI.Host {
+			inboxes = append(inboxes, s.Inbox)
+		}
+	}
+	inboxes = dedupeIRIs(inboxes, nil)
+	if len(inboxes) == 0 {
+		return nil
+	}
+	logEntry(c, LogLevelDebug, "relaying activity",
+		append(activityLogFields(activity), LogField{Key: "recipients", Value: len(inboxes)})...)
+	return r.tp.BatchDeliver(c, b, inboxes)
+}
+
+// announce builds a public Announce of the object by the relay.
+func (r *Relay) announce(c context.Context, object *url.URL) (vocab.ActivityStreamsAnnounce, error) {
+	announce := streams.NewActivityStreamsAnnounce()
+	if err := r.setActorAndId(c, announce); err != nil {
+		return nil, err
+	}
+	public, err := url.Parse(PublicActivityPubIRI)
+	if err != nil {
+		return nil, err
+	}
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(object)
+	announce.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(public)
+	announce.SetActivityStreamsTo(to)
+	return announce, nil
+}
+
+// setActorAndId sets the relay's actor as the actor of the activity and mints
+// its id.
+func (r *Relay) setActorAndId(c context.Context, activity Activity) error {
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(r.actor.Id())
+	activity.SetActivityStreamsActor(actor)
+	iri, err := r.minter.NewId(c, activity)
+	if err != nil {
+		return err
+	}
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(iri)
+	activity.SetActivityStreamsId(id)
+	return nil
+}
+
+// RelayClient subscribes the server to relays, acting as its InstanceActor.
+// Activities relayed to the server arrive in the InstanceActor's inbox as
+// Announces of the relay.
+type RelayClient struct {
+	actor  *InstanceActor
+	tp     Transport
+	minter IdMinter
+}
+
+// NewRelayClient creates a RelayClient acting as the InstanceActor and
+// delivering with the Transport, which must sign its requests as that actor.
+// The ids of its activities are minted by the IdMinter.
+func NewRelayClient(actor *InstanceActor, tp Transport, minter IdMinter) *RelayClient {
+	return &RelayClient{
+		actor:  actor,
+		tp:     tp,
+		minter: minter,
+	}
+}
+
+// Subscribe sends a Follow of the Public collection to the relay's inbox. The
+// Follow must be persisted by the application to later Unsubscribe, and the
+// subscription is only effective once the relay has sent an Accept of it.
+func (r *RelayClient) Subscribe(c context.Context, relayInbox *url.URL) (vocab.ActivityStreamsFollow, error) {
+	public, err := url.Parse(PublicActivityPubIRI)
+	if err != nil {
+		return nil, err
+	}
+	follow := streams.NewActivityStreamsFollow()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(r.actor.Id())
+	follow.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(public)
+	follow.SetActivityStreamsObject(op)
+	if err = r.send(c, relayInbox, follow); err != nil {
+		return nil, err
+	}
+	return follow, nil
+}
+
+// Unsubscribe sends an Undo of the Follow returned by Subscribe to the relay's
+// inbox.
+func (r *RelayClient) Unsubscribe(c context.Context, relayInbox *url.URL, follow vocab.ActivityStreamsFollow) error {
+	undo := streams.NewActivityStreamsUndo()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(r.actor.Id())
+	undo.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsFollow(follow)
+	undo.SetActivityStreamsObject(op)
+	return r.send(c, relayInbox, undo)
+}
+
+// send mints the id of the activity and delivers it to the relay's inbox.
+func (r *RelayClient) send(c context.Context, relayInbox *url.URL, activity Activity) error {
+	iri, err := r.minter.NewId(c, activity)
+	if err != nil {
+		return err
+	}
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(iri)
+	activity.SetActivityStreamsId(id)
+	b, err := serializeJSON(activity)
+	if err != nil {
+		return err
+	}
+	return r.tp.Deliver(c, b, relayInbox)
+}
+
+// isPublicActivity returns whether the activity is addressed to the Public
+// collection in its 'to' or 'cc' properties.
+func isPublicActivity(activity Activity) bool {
+	if to := activity.GetActivityStreamsTo(); to != nil {
+		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && IsPublic(id.String()) {
+				return true
+			}
+		}
+	}
+	if cc := activity.GetActivityStreamsCc(); cc != nil {
+		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && IsPublic(id.String()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// serializeJSON serializes the value as JSON.
+func serializeJSON(t vocab.Type) ([]byte, error) {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
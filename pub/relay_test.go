@@ -0,0 +1,276 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const (
+	testRelayIRI = "https://example.com/relay"
+)
+
+// testRelayActor returns the actor document of a remote actor with an inbox.
+func testRelayActor(actorIRI string) []byte {
+	person := streams.NewActivityStreamsPerson()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(actorIRI))
+	person.SetActivityStreamsId(id)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(mustParse(actorIRI + "/inbox"))
+	person.SetActivityStreamsInbox(inbox)
+	b, err := json.Marshal(mustSerialize(person))
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// testPublicCreate returns a public Create of a note by the actor.
+func testPublicCreate(actorIRI, activityIRI string) vocab.ActivityStreamsCreate {
+	create := streams.NewActivityStreamsCreate()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(activityIRI))
+	create.SetActivityStreamsId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(mustParse(actorIRI))
+	create.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(testNoteId1))
+	create.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(mustParse(PublicActivityPubIRI))
+	create.SetActivityStreamsTo(to)
+	return create
+}
+
+// testRelayFollow returns a Follow of the Public collection by the actor.
+func testRelayFollow(actorIRI string) vocab.ActivityStreamsFollow {
+	follow := streams.NewActivityStreamsFollow()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI))
+	follow.SetActivityStreamsId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(mustParse(actorIRI))
+	follow.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(PublicActivityPubIRI))
+	follow.SetActivityStreamsObject(op)
+	return follow
+}
+
+func TestMemoryRelaySubscriberStore(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	s := NewMemoryRelaySubscriberStore()
+	sub := RelaySubscriber{Actor: mustParse(testFederatedActorIRI), Inbox: mustParse(testFederatedActorIRI + "/inbox")}
+	// Run
+	addErr := s.AddSubscriber(ctx, sub)
+	subscribed, _ := s.IsSubscriber(ctx, sub.Actor)
+	subs, _ := s.Subscribers(ctx)
+	removeErr := s.RemoveSubscriber(ctx, sub.Actor)
+	unsubscribed, _ := s.IsSubscriber(ctx, sub.Actor)
+	// Verify
+	assertEqual(t, addErr, nil)
+	assertEqual(t, subscribed, true)
+	assertEqual(t, len(subs), 1)
+	assertEqual(t, subs[0].Inbox.String(), testFederatedActorIRI+"/inbox")
+	assertEqual(t, removeErr, nil)
+	assertEqual(t, unsubscribed, false)
+}
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	relayActor := NewInstanceActor(mustParse(testRelayIRI), mustParse(testRelayIRI+"/inbox"), mustParse(testRelayIRI+"/outbox"), "relay", privKey)
+	minter := IdMinterFunc(func(c context.Context, t vocab.Type) (*url.URL, error) {
+		return mustParse(testNewActivityIRI), nil
+	})
+	signedBy := func(actorIRI string) RequestVerifier {
+		return func(c context.Context, r *http.Request) (*url.URL, error) {
+			if len(actorIRI) == 0 {
+				return nil, nil
+			}
+			return mustParse(actorIRI), nil
+		}
+	}
+	newRelay := func(ctl *gomock.Controller, signer string, store RelaySubscriberStore) (*Relay, *MockTransport) {
+		tp := NewMockTransport(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		return NewRelay(relayActor, store, signedBy(signer), tp, minter, cl), tp
+	}
+	t.Run("SubscribesFollowers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		store := NewMemoryRelaySubscriberStore()
+		r, tp := newRelay(ctl, testFederatedActorIRI, store)
+		var accepted []byte
+		gomock.InOrder(
+			tp.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI)).Return(testRelayActor(testFederatedActorIRI), nil),
+			tp.EXPECT().Deliver(ctx, gomock.Any(), mustParse(testFederatedActorIRI+"/inbox")).DoAndReturn(func(c context.Context, b []byte, to *url.URL) error {
+				accepted = b
+				return nil
+			}),
+		)
+		req := toAPRequest(toPostInboxRequest(testRelayFollow(testFederatedActorIRI)))
+		resp := httptest.NewRecorder()
+		// Run
+		handled, err := r.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusAccepted)
+		subscribed, _ := store.IsSubscriber(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, subscribed, true)
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(accepted, &m), nil)
+		assertEqual(t, m["type"], "Accept")
+		assertEqual(t, m["actor"], testRelayIRI)
+	})
+	t.Run("UnsubscribesOnUndo", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		store := NewMemoryRelaySubscriberStore()
+		store.AddSubscriber(ctx, RelaySubscriber{Actor: mustParse(testFederatedActorIRI), Inbox: mustParse(testFederatedActorIRI + "/inbox")})
+		r, _ := newRelay(ctl, testFederatedActorIRI, store)
+		undo := streams.NewActivityStreamsUndo()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI2))
+		undo.SetActivityStreamsId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		undo.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsFollow(testRelayFollow(testFederatedActorIRI))
+		undo.SetActivityStreamsObject(op)
+		req := toAPRequest(toPostInboxRequest(undo))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := r.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusAccepted)
+		subscribed, _ := store.IsSubscriber(ctx, mustParse(testFederatedActorIRI))
+		assertEqual(t, subscribed, false)
+	})
+	t.Run("AnnouncesToOtherHostsOnce", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		store := NewMemoryRelaySubscriberStore()
+		store.AddSubscriber(ctx, RelaySubscriber{Actor: mustParse(testFederatedActorIRI), Inbox: mustParse(testFederatedActorIRI + "/inbox")})
+		store.AddSubscriber(ctx, RelaySubscriber{Actor: mustParse(testPersonIRI), Inbox: mustParse(testPersonIRI + "/inbox")})
+		r, tp := newRelay(ctl, testFederatedActorIRI, store)
+		var announced []byte
+		tp.EXPECT().BatchDeliver(ctx, gomock.Any(), []*url.URL{mustParse(testPersonIRI + "/inbox")}).DoAndReturn(func(c context.Context, b []byte, recipients []*url.URL) error {
+			announced = b
+			return nil
+		})
+		create := testPublicCreate(testFederatedActorIRI, testFederatedActivityIRI)
+		// Run
+		resp := httptest.NewRecorder()
+		_, err := r.PostInbox(ctx, resp, toAPRequest(toPostInboxRequest(create)))
+		againResp := httptest.NewRecorder()
+		_, againErr := r.PostInbox(ctx, againResp, toAPRequest(toPostInboxRequest(create)))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusAccepted)
+		assertEqual(t, againErr, nil)
+		assertEqual(t, againResp.Code, http.StatusAccepted)
+		var m map[string]interface{}
+		assertEqual(t, json.Unmarshal(announced, &m), nil)
+		assertEqual(t, m["type"], "Announce")
+		assertEqual(t, m["actor"], testRelayIRI)
+		assertEqual(t, m["object"], testNoteId1)
+	})
+	t.Run("RefusesNonSubscribers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, _ := newRelay(ctl, testFederatedActorIRI, NewMemoryRelaySubscriberStore())
+		req := toAPRequest(toPostInboxRequest(testPublicCreate(testFederatedActorIRI, testFederatedActivityIRI)))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := r.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("RefusesUnsignedRequests", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, _ := newRelay(ctl, "", NewMemoryRelaySubscriberStore())
+		req := toAPRequest(toPostInboxRequest(testRelayFollow(testFederatedActorIRI)))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := r.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+	t.Run("RefusesActivitiesOfAnotherSigner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		r, _ := newRelay(ctl, testFederatedActorIRI2, NewMemoryRelaySubscriberStore())
+		req := toAPRequest(toPostInboxRequest(testRelayFollow(testFederatedActorIRI)))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := r.PostInbox(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+	})
+}
+
+func TestRelayClient(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance := NewInstanceActor(mustParse(testMyActorIRI), mustParse(testMyInboxIRI), mustParse(testMyOutboxIRI), "instance", privKey)
+	minter := IdMinterFunc(func(c context.Context, t vocab.Type) (*url.URL, error) {
+		return mustParse(testNewActivityIRI), nil
+	})
+	tp := NewMockTransport(ctl)
+	relayInbox := mustParse(testRelayIRI + "/inbox")
+	var sent []map[string]interface{}
+	tp.EXPECT().Deliver(ctx, gomock.Any(), relayInbox).DoAndReturn(func(c context.Context, b []byte, to *url.URL) error {
+		var m map[string]interface{}
+		err := json.Unmarshal(b, &m)
+		sent = append(sent, m)
+		return err
+	}).Times(2)
+	rc := NewRelayClient(instance, tp, minter)
+	// Run
+	follow, subErr := rc.Subscribe(ctx, relayInbox)
+	unsubErr := rc.Unsubscribe(ctx, relayInbox, follow)
+	// Verify
+	assertEqual(t, subErr, nil)
+	assertEqual(t, unsubErr, nil)
+	assertEqual(t, len(sent), 2)
+	assertEqual(t, sent[0]["type"], "Follow")
+	assertEqual(t, sent[0]["object"], PublicActivityPubIRI)
+	assertEqual(t, sent[0]["id"], testNewActivityIRI)
+	assertEqual(t, sent[1]["type"], "Undo")
+	assertEqual(t, sent[1]["actor"], testMyActorIRI)
+}
@@ -0,0 +1,277 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+const (
+	// maxReplyPages is the number of pages of a 'replies' collection
+	// followed by FetchReplyTree.
+	maxReplyPages = 10
+)
+
+// addReply adds the object to the 'replies' collection of each object it is
+// in reply to that is owned by this server. Poll votes are not replies.
+//
+// Acquires and releases the lock of each object replied to.
+func addReply(c context.Context, db Database, t vocab.Type) error {
+	if _, _, _, isVote := getVote(t); isVote {
+		return nil
+	}
+	irt, ok := t.(inReplyToer)
+	if !ok || irt.GetActivityStreamsInReplyTo() == nil {
+		return nil
+	}
+	replyIRI, err := GetId(t)
+	if err != nil {
+		return err
+	}
+	inReplyTo := irt.GetActivityStreamsInReplyTo()
+	for iter := inReplyTo.Begin(); iter != inReplyTo.End(); iter = iter.Next() {
+		parentIRI, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		if err = addToReplies(c, db, parentIRI, replyIRI); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addToReplies prepends the reply to the 'replies' collection of the parent,
+// if the parent is owned by this server. A collection only referenced by its
+// IRI is updated if it is owned by this server too, otherwise an embedded
+// Collection is created.
+//
+// Acquires and releases the lock of the parent.
+func addToReplies(c context.Context, db Database, parentIRI, replyIRI *url.URL) error {
+	if err := db.Lock(c, parentIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, parentIRI)
+	if owns, err := db.Owns(c, parentIRI); err != nil {
+		return err
+	} else if !owns {
+		return nil
+	}
+	parent, err := db.Get(c, parentIRI)
+	if err != nil {
+		return err
+	}
+	r, ok := parent.(replieser)
+	if !ok {
+		return nil
+	}
+	replies := r.GetActivityStreamsReplies()
+	if replies != nil && replies.IsIRI() {
+		return addToCollection(c, db, replies.GetIRI(), replyIRI)
+	}
+	if replies == nil || replies.GetType() == nil {
+		replies = streams.NewActivityStreamsRepliesProperty()
+		replies.SetActivityStreamsCollection(streams.NewActivityStreamsCollection())
+		r.SetActivityStreamsReplies(replies)
+	}
+	if added, err := prependCollectionItem(replies.GetType(), replyIRI); err != nil || !added {
+		return err
+	}
+	return db.Update(c, parent)
+}
+
+// addToCollection prepends the item to the Collection or OrderedCollection
+// stored at the IRI, if it is owned by this server.
+//
+// Acquires and releases the lock of the collection.
+func addToCollection(c context.Context, db Database, collectionIRI, itemIRI *url.URL) error {
+	if err := db.Lock(c, collectionIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, collectionIRI)
+	if owns, err := db.Owns(c, collectionIRI); err != nil {
+		return err
+	} else if !owns {
+		return nil
+	}
+	t, err := db.Get(c, collectionIRI)
+	if err != nil {
+		return err
+	}
+	if added, err := prependCollectionItem(t, itemIRI); err != nil || !added {
+		return err
+	}
+	return db.Update(c, t)
+}
+
+// prependCollectionItem prepends the IRI to the items of a Collection or
+// OrderedCollection, unless it is already one of them, and updates its
+// 'totalItems'. Returns whether the IRI was added.
+func prependCollectionItem(t vocab.Type, iri *url.URL) (added bool, err error) {
+	var n int
+	if col, ok := t.(itemser); ok {
+		items := col.GetActivityStreamsItems()
+		if items == nil {
+			items = streams.NewActivityStreamsItemsProperty()
+			col.SetActivityStreamsItems(items)
+		}
+		for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == iri.String() {
+				return false, nil
+			}
+		}
+		items.PrependIRI(iri)
+		n = items.Len()
+	} else if oCol, ok := t.(orderedItemser); ok {
+		oItems := oCol.GetActivityStreamsOrderedItems()
+		if oItems == nil {
+			oItems = streams.NewActivityStreamsOrderedItemsProperty()
+			oCol.SetActivityStreamsOrderedItems(oItems)
+		}
+		for iter := oItems.Begin(); iter != oItems.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil && id.String() == iri.String() {
+				return false, nil
+			}
+		}
+		oItems.PrependIRI(iri)
+		n = oItems.Len()
+	} else {
+		return false, fmt.Errorf("type is neither a Collection nor an OrderedCollection: %T", t)
+	}
+	if ti, ok := t.(totalItemser); ok {
+		total := streams.NewActivityStreamsTotalItemsProperty()
+		total.Set(n)
+		ti.SetActivityStreamsTotalItems(total)
+	}
+	return true, nil
+}
+
+// ReplyTree is an object and the tree of its replies.
+type ReplyTree struct {
+	// Object is the object, or nil if it could not be fetched.
+	Object vocab.Type
+	// Id is the id of the object.
+	Id *url.URL
+	// Replies are the trees of the object's replies, in the order of its
+	// 'replies' collection.
+	Replies []*ReplyTree
+}
+
+// FetchReplyTree builds the tree of the replies to the object, up to maxDepth
+// levels of replies below it. Objects and collections owned by this server are
+// obtained from the Database, while others are dereferenced with the
+// Transport, which is typically one returned by an InstanceActor's
+// NewTransport.
+//
+// Only the root object must be obtained, a reply that cannot be fetched is
+// left without its Object or replies. Each object appears in the tree once,
+// and at most 10 pages of each 'replies' collection are followed.
+func FetchReplyTree(c context.Context, db Database, tp Transport, root *url.URL, maxDepth int) (*ReplyTree, error) {
+	f := &replyTreeFetcher{
+		db:      db,
+		tp:      tp,
+		visited: make(map[string]bool),
+	}
+	t, err := f.fetch(c, root)
+	if err != nil {
+		return nil, err
+	}
+	tree := &ReplyTree{Object: t, Id: root}
+	f.visited[root.String()] = true
+	f.fetchReplies(c, tree, maxDepth)
+	return tree, nil
+}
+
+// replyTreeFetcher holds the state of a FetchReplyTree call.
+type replyTreeFetcher struct {
+	db      Database
+	tp      Transport
+	visited map[string]bool
+}
+
+// fetchReplies fills in the replies of the tree's object, recursing until the
+// depth is exhausted.
+func (f *replyTreeFetcher) fetchReplies(c context.Context, tree *ReplyTree, depth int) {
+	if depth <= 0 || tree.Object == nil {
+		return
+	}
+	r, ok := tree.Object.(replieser)
+	if !ok || r.GetActivityStreamsReplies() == nil {
+		return
+	}
+	replies := r.GetActivityStreamsReplies()
+	collection := replies.GetType()
+	if collection == nil && replies.IsIRI() {
+		var err error
+		if collection, err = f.fetch(c, replies.GetIRI()); err != nil {
+			logEntry(c, LogLevelDebug, "fetching replies failed",
+				LogField{Key: "iri", Value: replies.GetIRI()}, errorLogField(err))
+			return
+		}
+	}
+	for _, item := range f.collectionItems(c, collection) {
+		id := item.IRI
+		if item.Value != nil {
+			var err error
+			if id, err = GetId(item.Value); err != nil {
+				continue
+			}
+		}
+		if f.visited[id.String()] {
+			continue
+		}
+		f.visited[id.String()] = true
+		child := &ReplyTree{Object: item.Value, Id: id}
+		if child.Object == nil {
+			var err error
+			if child.Object, err = f.fetch(c, id); err != nil {
+				logEntry(c, LogLevelDebug, "fetching reply failed",
+					LogField{Key: "iri", Value: id}, errorLogField(err))
+			}
+		}
+		f.fetchReplies(c, child, depth-1)
+		tree.Replies = append(tree.Replies, child)
+	}
+}
+
+// collectionItems returns the items of a Collection or OrderedCollection,
+// following its pages from its 'first' page.
+func (f *replyTreeFetcher) collectionItems(c context.Context, t vocab.Type) (items []CollectionItem) {
+	s := NewCollectionStream(c, t, f.fetch, CollectionStreamOptions{MaxPages: maxReplyPages})
+	defer s.Close()
+	for s.Next() {
+		items = append(items, s.Item())
+	}
+	if err := s.Err(); err != nil {
+		logEntry(c, LogLevelDebug, "fetching replies page failed", errorLogField(err))
+	}
+	return
+}
+
+// pageItems returns the items of a Collection, OrderedCollection, or one of
+// their pages.
+func pageItems(t vocab.Type) (items []CollectionItem) {
+	add := func(iri *url.URL, v vocab.Type) {
+		if iri != nil || v != nil {
+			items = append(items, CollectionItem{IRI: iri, Value: v})
+		}
+	}
+	if col, ok := t.(itemser); ok && col.GetActivityStreamsItems() != nil {
+		for iter := col.GetActivityStreamsItems().Begin(); iter != col.GetActivityStreamsItems().End(); iter = iter.Next() {
+			add(iter.GetIRI(), iter.GetType())
+		}
+	} else if oCol, ok := t.(orderedItemser); ok && oCol.GetActivityStreamsOrderedItems() != nil {
+		for iter := oCol.GetActivityStreamsOrderedItems().Begin(); iter != oCol.GetActivityStreamsOrderedItems().End(); iter = iter.Next() {
+			add(iter.GetIRI(), iter.GetType())
+		}
+	}
+	return
+}
+
+// fetch obtains the value at the IRI from the Database if it is owned by this
+// server, and dereferences it with the Transport otherwise.
+func (f *replyTreeFetcher) fetch(c context.Context, iri *url.URL) (vocab.Type, error) {
+	return getOrDereference(c, f.db, f.tp, iri)
+}
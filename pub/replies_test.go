@@ -0,0 +1,206 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+func TestAddReply(t *testing.T) {
+	ctx := context.Background()
+	parentIRI := mustParse(testNoteId1)
+	replyIRI := mustParse(testNoteId2)
+	repliesIRI := mustParse(testNoteId1 + "/replies")
+	newNote := func(id *url.URL) vocab.ActivityStreamsNote {
+		n := streams.NewActivityStreamsNote()
+		idp := streams.NewActivityStreamsIdProperty()
+		idp.Set(id)
+		n.SetActivityStreamsId(idp)
+		return n
+	}
+	newReply := func() vocab.ActivityStreamsNote {
+		n := newNote(replyIRI)
+		irt := streams.NewActivityStreamsInReplyToProperty()
+		irt.AppendIRI(parentIRI)
+		n.SetActivityStreamsInReplyTo(irt)
+		return n
+	}
+	t.Run("AddsToEmbeddedReplies", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		parent := newNote(parentIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, parentIRI),
+			db.EXPECT().Owns(ctx, parentIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, parentIRI).Return(parent, nil),
+			db.EXPECT().Update(ctx, parent),
+			db.EXPECT().Unlock(ctx, parentIRI),
+		)
+		// Run
+		err := addReply(ctx, db, newReply())
+		// Verify
+		assertEqual(t, err, nil)
+		replies := parent.GetActivityStreamsReplies().GetActivityStreamsCollection()
+		assertEqual(t, replies.GetActivityStreamsItems().Len(), 1)
+		assertEqual(t, replies.GetActivityStreamsItems().At(0).GetIRI().String(), testNoteId2)
+		assertEqual(t, replies.GetActivityStreamsTotalItems().Get(), 1)
+	})
+	t.Run("IgnoresDuplicateReply", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		parent := newNote(parentIRI)
+		col := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(replyIRI)
+		col.SetActivityStreamsItems(items)
+		replies := streams.NewActivityStreamsRepliesProperty()
+		replies.SetActivityStreamsCollection(col)
+		parent.SetActivityStreamsReplies(replies)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, parentIRI),
+			db.EXPECT().Owns(ctx, parentIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, parentIRI).Return(parent, nil),
+			db.EXPECT().Unlock(ctx, parentIRI),
+		)
+		// Run
+		err := addReply(ctx, db, newReply())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 1)
+	})
+	t.Run("AddsToStoredReplies", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		parent := newNote(parentIRI)
+		replies := streams.NewActivityStreamsRepliesProperty()
+		replies.SetIRI(repliesIRI)
+		parent.SetActivityStreamsReplies(replies)
+		col := streams.NewActivityStreamsOrderedCollection()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, parentIRI),
+			db.EXPECT().Owns(ctx, parentIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, parentIRI).Return(parent, nil),
+			db.EXPECT().Lock(ctx, repliesIRI),
+			db.EXPECT().Owns(ctx, repliesIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, repliesIRI).Return(col, nil),
+			db.EXPECT().Update(ctx, col),
+			db.EXPECT().Unlock(ctx, repliesIRI),
+			db.EXPECT().Unlock(ctx, parentIRI),
+		)
+		// Run
+		err := addReply(ctx, db, newReply())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, col.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), testNoteId2)
+		assertEqual(t, col.GetActivityStreamsTotalItems().Get(), 1)
+	})
+	t.Run("IgnoresParentNotOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, parentIRI),
+			db.EXPECT().Owns(ctx, parentIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, parentIRI),
+		)
+		// Run
+		err := addReply(ctx, db, newReply())
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("IgnoresNonReply", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		// Run
+		err := addReply(ctx, db, newNote(replyIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
+
+func TestFetchReplyTree(t *testing.T) {
+	ctx := context.Background()
+	rootIRI := mustParse(testNoteId1)
+	localIRI := mustParse(testNoteId2)
+	remoteIRI := mustParse(testFederatedActivityIRI)
+	newNote := func(id *url.URL, replyIds ...*url.URL) vocab.ActivityStreamsNote {
+		n := streams.NewActivityStreamsNote()
+		idp := streams.NewActivityStreamsIdProperty()
+		idp.Set(id)
+		n.SetActivityStreamsId(idp)
+		if len(replyIds) > 0 {
+			col := streams.NewActivityStreamsCollection()
+			items := streams.NewActivityStreamsItemsProperty()
+			for _, r := range replyIds {
+				items.AppendIRI(r)
+			}
+			col.SetActivityStreamsItems(items)
+			replies := streams.NewActivityStreamsRepliesProperty()
+			replies.SetActivityStreamsCollection(col)
+			n.SetActivityStreamsReplies(replies)
+		}
+		return n
+	}
+	t.Run("FetchesLocalAndRemoteReplies", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, rootIRI),
+			db.EXPECT().Owns(ctx, rootIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, rootIRI).Return(newNote(rootIRI, localIRI, remoteIRI), nil),
+			db.EXPECT().Unlock(ctx, rootIRI),
+			db.EXPECT().Lock(ctx, localIRI),
+			db.EXPECT().Owns(ctx, localIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, localIRI).Return(newNote(localIRI), nil),
+			db.EXPECT().Unlock(ctx, localIRI),
+			db.EXPECT().Lock(ctx, remoteIRI),
+			db.EXPECT().Owns(ctx, remoteIRI).Return(false, nil),
+			db.EXPECT().Unlock(ctx, remoteIRI),
+			tp.EXPECT().Dereference(ctx, remoteIRI).Return(mustSerializeToBytes(newNote(remoteIRI, rootIRI)), nil),
+		)
+		// Run
+		tree, err := FetchReplyTree(ctx, db, tp, rootIRI, 2)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, tree.Id.String(), testNoteId1)
+		assertEqual(t, len(tree.Replies), 2)
+		assertEqual(t, tree.Replies[0].Id.String(), testNoteId2)
+		assertEqual(t, len(tree.Replies[0].Replies), 0)
+		assertEqual(t, tree.Replies[1].Id.String(), testFederatedActivityIRI)
+		assertNotEqual(t, tree.Replies[1].Object, nil)
+		assertEqual(t, len(tree.Replies[1].Replies), 0)
+	})
+	t.Run("StopsAtMaxDepth", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, rootIRI),
+			db.EXPECT().Owns(ctx, rootIRI).Return(true, nil),
+			db.EXPECT().Get(ctx, rootIRI).Return(newNote(rootIRI, localIRI), nil),
+			db.EXPECT().Unlock(ctx, rootIRI),
+		)
+		// Run
+		tree, err := FetchReplyTree(ctx, db, tp, rootIRI, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(tree.Replies), 0)
+	})
+}
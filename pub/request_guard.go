@@ -0,0 +1,163 @@
+package pub
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultMaxRedirects is the number of redirects a guarded HttpClient
+	// follows for a single request.
+	DefaultMaxRedirects = 5
+	// DefaultMaxResponseBytes is the size of the largest response body a
+	// guarded HttpClient reads.
+	DefaultMaxResponseBytes = 1 << 20
+)
+
+var (
+	// ErrRequestRefused indicates that a guarded HttpClient refused to
+	// make a request, such as one to a non-public address.
+	ErrRequestRefused = errors.New("request refused")
+	// ErrResponseTooLarge indicates that a response body exceeded the
+	// limit of a guarded HttpClient.
+	ErrResponseTooLarge = errors.New("response body too large")
+)
+
+// RequestGuard configures the protections of an HttpClient created with
+// NewGuardedHttpClient against requests forged by peers, which choose the
+// IRIs that are dereferenced and delivered to.
+type RequestGuard struct {
+	// AllowInsecure permits http IRIs and redirects. Otherwise only https
+	// ones are requested.
+	AllowInsecure bool
+	// MaxRedirects is the number of redirects followed for a request. Zero
+	// means DefaultMaxRedirects, and a negative number means none are
+	// followed.
+	MaxRedirects int
+	// MaxResponseBytes is the size of the largest response body read,
+	// beyond which reading fails with an ErrResponseTooLarge. Zero means
+	// DefaultMaxResponseBytes, and a negative number means no limit.
+	MaxResponseBytes int64
+	// Timeout bounds each request, including its redirects and reading its
+	// body. Zero means no timeout.
+	Timeout time.Duration
+	// Routes dial the connections to the hosts they match, such as through
+	// a SOCKS5 proxy to Tor, instead of connecting directly. As the proxy
+	// resolves the hosts, their addresses are not checked.
+	Routes []DialRoute
+	// Connections configure the connection pool.
+	Connections ConnectionOptions
+}
+
+// guardedClient is an HttpClient enforcing a RequestGuard.
+type guardedClient struct {
+	client   *http.Client
+	guard    RequestGuard
+	maxBytes int64
+}
+
+// guardedClient must satisfy the HttpClient interface.
+var _ HttpClient = &guardedClient{}
+
+// NewGuardedHttpClient creates an HttpClient for a Transport, such as an
+// HttpSigTransport, that only connects to addresses reachable from the public
+// internet, and enforces the RequestGuard.
+//
+// Addresses are checked with the PublicAddressControl as each connection is
+// dialed, after DNS resolution, so that neither redirects nor DNS answers that
+// change between a check and the request can reach loopback, link-local, or
+// private network addresses. For the same reason, proxies configured in the
+// environment are not used.
+func NewGuardedHttpClient(guard RequestGuard) HttpClient {
+	if guard.MaxRedirects == 0 {
+		guard.MaxRedirects = DefaultMaxRedirects
+	}
+	maxBytes := guard.MaxResponseBytes
+	if maxBytes == 0 {
+		maxBytes = DefaultMaxResponseBytes
+	}
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   guardedControl,
+	}
+	g := &guardedClient{
+		guard:    guard,
+		maxBytes: maxBytes,
+	}
+	g.client = &http.Client{
+		Transport:     guard.Connections.transport(NewRoutingDialer(guard.Routes, dialer.DialContext)),
+		CheckRedirect: g.checkRedirect,
+		Timeout:       guard.Timeout,
+	}
+	return g
+}
+
+// guardedControl refuses connections to non-public addresses with an
+// ErrRequestRefused.
+func guardedControl(network, address string, c syscall.RawConn) error {
+	if err := PublicAddressControl(network, address, c); err != nil {
+		return wrapErr(ErrRequestRefused, "%s", err)
+	}
+	return nil
+}
+
+// checkScheme refuses requests to IRIs of schemes that are not permitted.
+func (g *guardedClient) checkScheme(r *http.Request) error {
+	if r.URL.Scheme == "https" || (g.guard.AllowInsecure && r.URL.Scheme == "http") {
+		return nil
+	}
+	return wrapErr(ErrRequestRefused, "scheme %q of %s is not permitted", r.URL.Scheme, r.URL)
+}
+
+// checkRedirect refuses redirects beyond the limit, or to IRIs of schemes that
+// are not permitted.
+func (g *guardedClient) checkRedirect(r *http.Request, via []*http.Request) error {
+	if len(via) > g.guard.MaxRedirects {
+		return wrapErr(ErrRequestRefused, "stopped after %d redirects", len(via)-1)
+	}
+	return g.checkScheme(r)
+}
+
+// Do sends the request if it is permitted, and limits the size of the body of
+// the response.
+func (g *guardedClient) Do(r *http.Request) (*http.Response, error) {
+	if err := g.checkScheme(r); err != nil {
+		return nil, err
+	}
+	resp, err := g.client.Do(r)
+	if err != nil || g.maxBytes < 0 {
+		return resp, err
+	}
+	if resp.ContentLength > g.maxBytes {
+		resp.Body.Close()
+		return nil, wrapErr(ErrResponseTooLarge, "%s declared %d bytes", r.URL, resp.ContentLength)
+	}
+	resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: g.maxBytes}
+	return resp, nil
+}
+
+// limitedBody is a response body which fails to read beyond its limit.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+// Read reads from the body, failing with an ErrResponseTooLarge once more than
+// the limit has been read.
+func (l *limitedBody) Read(p []byte) (n int, err error) {
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err = l.ReadCloser.Read(p)
+	if int64(n) > l.remaining {
+		n, l.remaining = int(l.remaining), 0
+		return n, ErrResponseTooLarge
+	}
+	l.remaining -= int64(n)
+	return
+}
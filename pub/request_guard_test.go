@@ -0,0 +1,85 @@
+package pub
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGuardedHttpClient(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/object", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("a", 8)))
+		w.(http.Flusher).Flush()
+		w.Write([]byte(strings.Repeat("a", 8)))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/redirect", http.StatusFound)
+	})
+	mux.HandleFunc("/insecure", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://example.com/object", http.StatusFound)
+	})
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+	// newClient creates a guarded client dialing without the address
+	// control, as the test server listens on a loopback address.
+	newClient := func(guard RequestGuard) HttpClient {
+		c := NewGuardedHttpClient(guard)
+		c.(*guardedClient).client.Transport = server.Client().Transport
+		return c
+	}
+	get := func(t *testing.T, c HttpClient, iri string) ([]byte, error) {
+		r, err := http.NewRequest("GET", iri, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Do(r)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		return ioutil.ReadAll(resp.Body)
+	}
+	t.Run("RefusesNonPublicAddresses", func(t *testing.T) {
+		// Setup
+		c := NewGuardedHttpClient(RequestGuard{})
+		// Run
+		_, err := get(t, c, server.URL+"/object")
+		// Verify
+		assertEqual(t, IsErr(err, ErrRequestRefused), true)
+	})
+	t.Run("RefusesInsecureScheme", func(t *testing.T) {
+		// Setup
+		c := newClient(RequestGuard{})
+		// Run
+		_, err := get(t, c, "http://example.com/object")
+		_, redirectErr := get(t, c, server.URL+"/insecure")
+		// Verify
+		assertEqual(t, IsErr(err, ErrRequestRefused), true)
+		assertEqual(t, IsErr(redirectErr, ErrRequestRefused), true)
+	})
+	t.Run("LimitsRedirects", func(t *testing.T) {
+		// Setup
+		c := newClient(RequestGuard{MaxRedirects: 2})
+		// Run
+		_, err := get(t, c, server.URL+"/redirect")
+		// Verify
+		assertEqual(t, IsErr(err, ErrRequestRefused), true)
+	})
+	t.Run("LimitsResponseSize", func(t *testing.T) {
+		// Setup
+		c := newClient(RequestGuard{MaxResponseBytes: 10})
+		// Run
+		b, err := get(t, c, server.URL+"/object")
+		_, largeErr := get(t, c, server.URL+"/large")
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), "{}")
+		assertEqual(t, IsErr(largeErr, ErrResponseTooLarge), true)
+	})
+}
@@ -0,0 +1,199 @@
+package pub
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a response to a Dereference call held by a
+// ResponseCacheStore.
+type CachedResponse struct {
+	// Body is the body of the response.
+	Body []byte
+	// ETag and LastModified are the validators of the response, with
+	// which it is revalidated once stale. Either may be empty.
+	ETag         string
+	LastModified string
+	// Expires is when the response becomes stale.
+	Expires time.Time
+}
+
+// ResponseCacheStore persists the responses cached by an HttpSigTransport.
+//
+// Implementations must be safe for concurrent use.
+type ResponseCacheStore interface {
+	// Get returns the response for the IRI. Stale responses may be
+	// returned, and are revalidated by the HttpSigTransport.
+	Get(c context.Context, iri *url.URL) (entry CachedResponse, found bool, err error)
+	// Put stores the response for the IRI, replacing any existing one.
+	Put(c context.Context, iri *url.URL, entry CachedResponse) error
+	// Delete removes the response for the IRI, if any.
+	Delete(c context.Context, iri *url.URL) error
+}
+
+// MemoryResponseCacheStore is a ResponseCacheStore held in memory.
+//
+// It is not suitable when the cache must be shared between processes or
+// survive restarts.
+type MemoryResponseCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]CachedResponse
+}
+
+// MemoryResponseCacheStore must satisfy the ResponseCacheStore interface.
+var _ ResponseCacheStore = &MemoryResponseCacheStore{}
+
+// NewMemoryResponseCacheStore creates an empty MemoryResponseCacheStore.
+func NewMemoryResponseCacheStore() *MemoryResponseCacheStore {
+	return &MemoryResponseCacheStore{
+		entries: make(map[string]CachedResponse),
+	}
+}
+
+// Get returns the response for the IRI.
+func (m *MemoryResponseCacheStore) Get(c context.Context, iri *url.URL) (entry CachedResponse, found bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, found = m.entries[iri.String()]
+	return
+}
+
+// Put stores the response for the IRI.
+func (m *MemoryResponseCacheStore) Put(c context.Context, iri *url.URL, entry CachedResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[iri.String()] = entry
+	return nil
+}
+
+// Delete removes the response for the IRI.
+func (m *MemoryResponseCacheStore) Delete(c context.Context, iri *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, iri.String())
+	return nil
+}
+
+// WithResponseCache returns a copy of the transport caching the responses to
+// its Dereference calls in the store, as a private HTTP cache would.
+//
+// Responses are fresh for the 'max-age' of their Cache-Control header, or
+// until their Expires header. Stale responses are revalidated with their ETag
+// or Last-Modified header, so that an unchanged value is not transferred
+// again. Responses marked 'no-store' or 'private', varying on any header, or
+// neither fresh nor with a validator are not cached. Since the store may be
+// shared between actors, 'private' responses, which may depend on the actor
+// signing the request, are never stored.
+func (h HttpSigTransport) WithResponseCache(store ResponseCacheStore) *HttpSigTransport {
+	h.cache = store
+	return &h
+}
+
+// cachedGet dereferences the IRI, serving the cached response while it is
+// fresh and revalidating it once stale.
+func (h HttpSigTransport) cachedGet(c context.Context, iri *url.URL) ([]byte, error) {
+	now := h.clock.Now()
+	entry, found, err := h.cache.Get(c, iri)
+	if err != nil {
+		return nil, err
+	} else if found && now.Before(entry.Expires) {
+		return entry.Body, nil
+	}
+	header := make(http.Header)
+	if found && len(entry.ETag) > 0 {
+		header.Set("If-None-Match", entry.ETag)
+	}
+	if found && len(entry.LastModified) > 0 {
+		header.Set("If-Modified-Since", entry.LastModified)
+	}
+	resp, err := h.sendGet(c, iri, h.mediaTypesOf(iri.Host).Accept, header)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case found && resp.StatusCode == http.StatusNotModified:
+		if v := resp.Header.Get("ETag"); len(v) > 0 {
+			entry.ETag = v
+		}
+		if v := resp.Header.Get("Last-Modified"); len(v) > 0 {
+			entry.LastModified = v
+		}
+	case resp.StatusCode == http.StatusOK:
+		var b []byte
+		if b, err = ioutil.ReadAll(resp.Body); err != nil {
+			return nil, err
+		}
+		entry = CachedResponse{
+			Body:         b,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		}
+	default:
+		return nil, newHttpStatusError("GET", iri, resp, now)
+	}
+	var cacheable bool
+	entry.Expires, cacheable = freshUntil(resp.Header, now)
+	cacheable = cacheable && (len(entry.ETag) > 0 || len(entry.LastModified) > 0 || entry.Expires.After(now))
+	if cacheable {
+		err = h.cache.Put(c, iri, entry)
+	} else if found {
+		err = h.cache.Delete(c, iri)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry.Body, nil
+}
+
+// freshUntil returns when a response with the headers, received at the time,
+// becomes stale, and whether it may be cached at all.
+func freshUntil(h http.Header, now time.Time) (expires time.Time, cacheable bool) {
+	if strings.TrimSpace(h.Get("Vary")) == "*" {
+		return now, false
+	}
+	directives := make(map[string]string)
+	for _, v := range h[http.CanonicalHeaderKey("Cache-Control")] {
+		for _, d := range strings.Split(v, ",") {
+			kv := strings.SplitN(strings.TrimSpace(d), "=", 2)
+			if len(kv) == 2 {
+				directives[strings.ToLower(kv[0])] = strings.Trim(kv[1], `"`)
+			} else {
+				directives[strings.ToLower(kv[0])] = ""
+			}
+		}
+	}
+	if _, ok := directives["no-store"]; ok {
+		return now, false
+	} else if _, ok := directives["private"]; ok {
+		return now, false
+	} else if _, ok := directives["no-cache"]; ok {
+		return now, true
+	}
+	if v, ok := directives["max-age"]; ok {
+		maxAge, err := strconv.Atoi(v)
+		if err != nil {
+			return now, true
+		}
+		age, _ := strconv.Atoi(h.Get("Age"))
+		return now.Add(time.Duration(maxAge-age) * time.Second), true
+	}
+	if v := h.Get("Expires"); len(v) > 0 {
+		t, err := http.ParseTime(v)
+		if err != nil {
+			return now, true
+		}
+		// Measure the freshness against the peer's clock if possible.
+		if date, err := http.ParseTime(h.Get("Date")); err == nil {
+			return now.Add(t.Sub(date)), true
+		}
+		return t, true
+	}
+	return now, true
+}
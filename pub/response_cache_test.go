@@ -0,0 +1,137 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	ctx := context.Background()
+	iri := mustParse(testNoteId1)
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller) (client *MockHttpClient, cl *MockClock, store *MemoryResponseCacheStore, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl = NewMockClock(ctl)
+		signer, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		store = NewMemoryResponseCacheStore()
+		tp = NewHttpSigTransport(client, "test", cl, signer, signer, testMyActorIRI+"#main-key", privKey).WithResponseCache(store)
+		return
+	}
+	respond := func(status int, body string, header map[string]string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: make(http.Header), Body: ioutil.NopCloser(strings.NewReader(body))}
+		for k, v := range header {
+			resp.Header.Set(k, v)
+		}
+		return resp
+	}
+	t.Run("ServesFreshResponse", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, cl, _, tp := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		client.EXPECT().Do(gomock.Any()).Return(respond(http.StatusOK, "{}", map[string]string{"Cache-Control": "public, max-age=60"}), nil)
+		// Run
+		_, err := tp.Dereference(ctx, iri)
+		assertEqual(t, err, nil)
+		b, err := tp.Dereference(ctx, iri)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), "{}")
+	})
+	t.Run("RevalidatesStaleResponse", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, cl, store, tp := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		store.Put(ctx, iri, CachedResponse{Body: []byte("{}"), ETag: `"v1"`, Expires: now().Add(-time.Second)})
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("If-None-Match"), `"v1"`)
+			return respond(http.StatusNotModified, "", map[string]string{"Cache-Control": "max-age=60"}), nil
+		})
+		// Run
+		b, err := tp.Dereference(ctx, iri)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), "{}")
+		entry, found, _ := store.Get(ctx, iri)
+		assertEqual(t, found, true)
+		assertEqual(t, entry.Expires.Equal(now().Add(time.Minute)), true)
+	})
+	t.Run("ReplacesChangedResponse", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, cl, store, tp := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		store.Put(ctx, iri, CachedResponse{Body: []byte("{}"), LastModified: nowDateHeader(), Expires: now()})
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("If-Modified-Since"), nowDateHeader())
+			return respond(http.StatusOK, `{"a":1}`, map[string]string{"ETag": `"v2"`}), nil
+		})
+		// Run
+		b, err := tp.Dereference(ctx, iri)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, string(b), `{"a":1}`)
+		entry, _, _ := store.Get(ctx, iri)
+		assertEqual(t, entry.ETag, `"v2"`)
+	})
+	t.Run("DoesNotStorePrivateResponse", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, cl, store, tp := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		client.EXPECT().Do(gomock.Any()).Return(respond(http.StatusOK, "{}", map[string]string{"Cache-Control": "max-age=60, private", "ETag": `"v1"`}), nil)
+		// Run
+		_, err := tp.Dereference(ctx, iri)
+		// Verify
+		assertEqual(t, err, nil)
+		_, found, _ := store.Get(ctx, iri)
+		assertEqual(t, found, false)
+	})
+}
+
+func TestFreshUntil(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    map[string]string
+		fresh     time.Duration
+		cacheable bool
+	}{
+		{"MaxAge", map[string]string{"Cache-Control": "max-age=60"}, time.Minute, true},
+		{"MaxAgeLessAge", map[string]string{"Cache-Control": "max-age=60", "Age": "20"}, 40 * time.Second, true},
+		{"Expires", map[string]string{"Date": nowDateHeader(), "Expires": now().Add(time.Hour).UTC().Format(http.TimeFormat)}, time.Hour, true},
+		{"NoCache", map[string]string{"Cache-Control": "no-cache, max-age=60"}, 0, true},
+		{"NoStore", map[string]string{"Cache-Control": "no-store"}, 0, false},
+		{"VaryAll", map[string]string{"Vary": "*"}, 0, false},
+		{"NoDirectives", nil, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := make(http.Header)
+			for k, v := range test.header {
+				h.Set(k, v)
+			}
+			expires, cacheable := freshUntil(h, now())
+			assertEqual(t, expires.Sub(now()), test.fresh)
+			assertEqual(t, cacheable, test.cacheable)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package pub
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRetryableStatuses are the status codes of responses retried by a
+// BackoffPolicy whose RetryableStatuses are not set.
+var DefaultRetryableStatuses = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooEarly,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy decides how long requests to a peer host may take, and whether
+// and when failed ones are retried.
+//
+// Implementations must be safe for concurrent use.
+type RetryPolicy interface {
+	// Timeout returns how long a single attempt of a request to the host
+	// may take. Zero means attempts are not bounded.
+	Timeout(host string) time.Duration
+	// Retry determines whether a request to the host which failed with
+	// the error after the number of attempts is attempted again, and how
+	// long to wait before doing so.
+	Retry(host string, attempts int, err error) (wait time.Duration, retry bool)
+}
+
+// BackoffPolicy is a RetryPolicy retrying with an exponential backoff, which
+// is jittered so that the retries of many requests failing at once are spread
+// out. A longer wait asked for by the Retry-After header of a response, as
+// sent with 429 Too Many Requests and 503 Service Unavailable, is honored.
+//
+// Requests are not retried once their caller canceled them, nor when they
+// were refused by a guarded HttpClient.
+type BackoffPolicy struct {
+	// MaxAttempts is the number of attempts before a request is abandoned.
+	MaxAttempts int
+	// MinBackoff is the wait after the first failed attempt.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound on the wait between attempts, unless
+	// the peer asks for a longer one.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of each wait, between zero and one, by which
+	// it is randomly shortened.
+	Jitter float64
+	// AttemptTimeout is how long a single attempt may take. Zero means
+	// attempts are not bounded.
+	AttemptTimeout time.Duration
+	// RetryableStatuses are the status codes of the responses retried. If
+	// nil, the DefaultRetryableStatuses are. Errors without a response,
+	// such as network errors, are always retried.
+	RetryableStatuses []int
+}
+
+// BackoffPolicy must satisfy the RetryPolicy interface.
+var _ RetryPolicy = &BackoffPolicy{}
+
+// NewBackoffPolicy creates a BackoffPolicy using the default attempts and
+// backoff of deliveries, with the waits shortened by up to a tenth.
+func NewBackoffPolicy() *BackoffPolicy {
+	return &BackoffPolicy{
+		MaxAttempts: DefaultDeliveryMaxAttempts,
+		MinBackoff:  DefaultDeliveryMinBackoff,
+		MaxBackoff:  DefaultDeliveryMaxBackoff,
+		Jitter:      0.1,
+	}
+}
+
+// Timeout returns the AttemptTimeout.
+func (b *BackoffPolicy) Timeout(host string) time.Duration {
+	return b.AttemptTimeout
+}
+
+// Retry retries retryable errors until the MaxAttempts, after a jittered
+// exponential backoff or the wait asked for by the peer, whichever is longer.
+func (b *BackoffPolicy) Retry(host string, attempts int, err error) (wait time.Duration, retry bool) {
+	if attempts >= b.MaxAttempts || !b.retryable(err) {
+		return 0, false
+	}
+	wait = ExponentialBackoff(b.MinBackoff, b.MaxBackoff, attempts)
+	if b.Jitter > 0 {
+		wait -= time.Duration(rand.Float64() * b.Jitter * float64(wait))
+	}
+	if se, ok := asHttpStatusError(err); ok && se.RetryAfter > wait {
+		wait = se.RetryAfter
+	}
+	return wait, true
+}
+
+// retryable determines whether a request which failed with the error may
+// succeed if attempted again.
+func (b *BackoffPolicy) retryable(err error) bool {
+	if IsErr(err, context.Canceled) || IsErr(err, ErrRequestRefused) || IsErr(err, ErrResponseTooLarge) {
+		return false
+	}
+	se, ok := asHttpStatusError(err)
+	if !ok {
+		return true
+	}
+	statuses := b.RetryableStatuses
+	if statuses == nil {
+		statuses = DefaultRetryableStatuses
+	}
+	for _, s := range statuses {
+		if se.StatusCode == s {
+			return true
+		}
+	}
+	return false
+}
+
+// HostRetryPolicy applies a RetryPolicy to each peer host, using a default
+// one for hosts without their own.
+type HostRetryPolicy struct {
+	defaultPolicy RetryPolicy
+	mu            sync.RWMutex
+	policies      map[string]RetryPolicy
+}
+
+// HostRetryPolicy must satisfy the RetryPolicy interface.
+var _ RetryPolicy = &HostRetryPolicy{}
+
+// NewHostRetryPolicy creates a HostRetryPolicy applying the default policy to
+// every host without its own policy.
+func NewHostRetryPolicy(defaultPolicy RetryPolicy) *HostRetryPolicy {
+	return &HostRetryPolicy{
+		defaultPolicy: defaultPolicy,
+		policies:      make(map[string]RetryPolicy),
+	}
+}
+
+// SetPolicy overrides the default policy for the host.
+func (h *HostRetryPolicy) SetPolicy(host string, p RetryPolicy) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.policies[host] = p
+}
+
+// policy returns the policy of the host.
+func (h *HostRetryPolicy) policy(host string) RetryPolicy {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if p, ok := h.policies[host]; ok {
+		return p
+	}
+	return h.defaultPolicy
+}
+
+// Timeout returns the timeout of the host's policy.
+func (h *HostRetryPolicy) Timeout(host string) time.Duration {
+	return h.policy(host).Timeout(host)
+}
+
+// Retry decides with the host's policy.
+func (h *HostRetryPolicy) Retry(host string, attempts int, err error) (time.Duration, bool) {
+	return h.policy(host).Retry(host, attempts, err)
+}
+
+// retryAfter returns the wait asked for by the Retry-After header of a
+// response received at the time, or zero if there is none. A date is measured
+// against the response's Date header if possible, as the peer's clock may
+// differ from ours.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	v := strings.TrimSpace(h.Get("Retry-After"))
+	if len(v) == 0 {
+		return 0
+	} else if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0
+	}
+	if date, err := http.ParseTime(h.Get("Date")); err == nil {
+		now = date
+	}
+	if d := t.Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// RetryingTransport is a Transport whose requests are bounded and retried by
+// a RetryPolicy. Requests are made by the wrapped Transport, and the waits
+// between attempts are measured by the Clock.
+//
+// It suits fetches and deliveries sent immediately. Queued deliveries are
+// retried by their DeliveryQueue instead, though the transport sending them
+// may still bound each attempt with a policy making a single attempt.
+type RetryingTransport struct {
+	t      Transport
+	policy RetryPolicy
+	clock  Clock
+}
+
+// RetryingTransport must satisfy the Transport interface.
+var _ Transport = &RetryingTransport{}
+
+// NewRetryingTransport wraps a Transport so its requests are bounded and
+// retried by the policy.
+func NewRetryingTransport(t Transport, policy RetryPolicy, clock Clock) *RetryingTransport {
+	return &RetryingTransport{
+		t:      t,
+		policy: policy,
+		clock:  clock,
+	}
+}
+
+// do attempts the request to the host until it succeeds, the policy gives up
+// on it, or the context is done. Returns the error of the last attempt.
+func (r *RetryingTransport) do(c context.Context, host string, request func(c context.Context) error) error {
+	for attempts := 1; ; attempts++ {
+		err := r.attempt(c, host, request)
+		if err == nil {
+			return nil
+		}
+		wait, retry := r.policy.Retry(host, attempts, err)
+		if !retry || c.Err() != nil {
+			return err
+		}
+		select {
+		case <-after(r.clock, wait):
+		case <-c.Done():
+			return err
+		}
+	}
+}
+
+// attempt makes the request once, bounded by the timeout of the host.
+func (r *RetryingTransport) attempt(c context.Context, host string, request func(c context.Context) error) error {
+	if d := r.policy.Timeout(host); d > 0 {
+		var cancel context.CancelFunc
+		c, cancel = context.WithTimeout(c, d)
+		defer cancel()
+	}
+	return request(c)
+}
+
+// Dereference fetches the IRI, retrying per the policy.
+func (r *RetryingTransport) Dereference(c context.Context, iri *url.URL) (b []byte, err error) {
+	err = r.do(c, iri.Host, func(c context.Context) (err error) {
+		b, err = r.t.Dereference(c, iri)
+		return
+	})
+	return
+}
+
+// Deliver sends the payload, retrying per the policy.
+func (r *RetryingTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	return r.do(c, to.Host, func(c context.Context) error {
+		return r.t.Deliver(c, b, to)
+	})
+}
+
+// BatchDeliver concurrently sends the payload to each recipient, retrying
+// each per the policy. Returns a BatchDeliveryError if any of the requests had
+// an error.
+func (r *RetryingTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return batchDeliver(c, b, recipients, r.Deliver)
+}
@@ -0,0 +1,177 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoffPolicy(t *testing.T) {
+	host := mustParse(testFederatedActorIRI).Host
+	testErr := errors.New("connection reset")
+	statusErr := func(code int, retryAfter time.Duration) error {
+		return &HttpStatusError{StatusCode: code, RetryAfter: retryAfter}
+	}
+	p := &BackoffPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Second,
+		MaxBackoff:  time.Minute,
+	}
+	tests := []struct {
+		name     string
+		attempts int
+		err      error
+		wait     time.Duration
+		retry    bool
+	}{
+		{"NetworkError", 1, testErr, time.Second, true},
+		{"BacksOff", 2, testErr, 2 * time.Second, true},
+		{"GivesUpAfterMaxAttempts", 3, testErr, 0, false},
+		{"ServerError", 1, statusErr(http.StatusBadGateway, 0), time.Second, true},
+		{"ClientError", 1, statusErr(http.StatusBadRequest, 0), 0, false},
+		{"HonorsRetryAfter", 1, statusErr(http.StatusTooManyRequests, 2*time.Hour), 2 * time.Hour, true},
+		{"Canceled", 1, context.Canceled, 0, false},
+		{"Refused", 1, wrapErr(ErrRequestRefused, "test"), 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Run
+			wait, retry := p.Retry(host, test.attempts, test.err)
+			// Verify
+			assertEqual(t, wait, test.wait)
+			assertEqual(t, retry, test.retry)
+		})
+	}
+	t.Run("Jitters", func(t *testing.T) {
+		// Setup
+		jittered := &BackoffPolicy{MaxAttempts: 2, MinBackoff: time.Second, MaxBackoff: time.Minute, Jitter: 0.5}
+		// Run
+		wait, retry := jittered.Retry(host, 1, testErr)
+		// Verify
+		assertEqual(t, retry, true)
+		assertEqual(t, wait > time.Second/2 && wait <= time.Second, true)
+	})
+	t.Run("PerHost", func(t *testing.T) {
+		// Setup
+		hp := NewHostRetryPolicy(p)
+		hp.SetPolicy("slow.example.com", &BackoffPolicy{MaxAttempts: 1, AttemptTimeout: time.Minute})
+		// Run
+		_, retry := hp.Retry("slow.example.com", 1, testErr)
+		_, defaultRetry := hp.Retry(host, 1, testErr)
+		// Verify
+		assertEqual(t, retry, false)
+		assertEqual(t, defaultRetry, true)
+		assertEqual(t, hp.Timeout("slow.example.com"), time.Minute)
+		assertEqual(t, hp.Timeout(host), time.Duration(0))
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	date := now().UTC()
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected time.Duration
+	}{
+		{"None", http.Header{}, 0},
+		{"Seconds", http.Header{"Retry-After": []string{"120"}}, 2 * time.Minute},
+		{"Date", http.Header{
+			"Retry-After": []string{date.Add(time.Hour).Format(http.TimeFormat)},
+			"Date":        []string{date.Format(http.TimeFormat)},
+		}, time.Hour},
+		{"PastDate", http.Header{"Retry-After": []string{date.Add(-time.Hour).Format(http.TimeFormat)}}, 0},
+		{"Invalid", http.Header{"Retry-After": []string{"soon"}}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Run
+			d := retryAfter(test.header, now())
+			// Verify
+			assertEqual(t, d, test.expected)
+		})
+	}
+}
+
+func TestRetryingTransport(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("connection reset")
+	payload := []byte("payload")
+	recipient := mustParse(testFederatedActorIRI)
+	p := &BackoffPolicy{MaxAttempts: 3}
+	t.Run("RetriesUntilSuccess", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		rt := NewRetryingTransport(tp, p, NewMockClock(ctl))
+		gomock.InOrder(
+			tp.EXPECT().Deliver(ctx, payload, recipient).Return(testErr),
+			tp.EXPECT().Deliver(ctx, payload, recipient).Return(nil),
+		)
+		// Run
+		err := rt.Deliver(ctx, payload, recipient)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("StopsOnPermanentFailure", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		rt := NewRetryingTransport(tp, p, NewMockClock(ctl))
+		statusErr := &HttpStatusError{StatusCode: http.StatusGone}
+		tp.EXPECT().Dereference(ctx, recipient).Return(nil, statusErr)
+		// Run
+		_, err := rt.Dereference(ctx, recipient)
+		// Verify
+		assertEqual(t, err, error(statusErr))
+	})
+	t.Run("BoundsAttempts", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		tp := NewMockTransport(ctl)
+		rt := NewRetryingTransport(tp, &BackoffPolicy{MaxAttempts: 1, AttemptTimeout: time.Minute}, NewMockClock(ctl))
+		tp.EXPECT().Deliver(gomock.Any(), payload, recipient).DoAndReturn(func(c context.Context, b []byte, to interface{}) error {
+			_, hasDeadline := c.Deadline()
+			assertEqual(t, hasDeadline, true)
+			return testErr
+		})
+		// Run
+		err := rt.Deliver(ctx, payload, recipient)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+}
+
+func TestMemoryDeliveryQueueRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	recipient := mustParse(testFederatedActorIRI)
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	cl.EXPECT().Now().Return(now()).AnyTimes()
+	q := NewMemoryDeliveryQueue(cl)
+	q.Policy = &BackoffPolicy{MaxAttempts: 5, MinBackoff: time.Second, MaxBackoff: time.Minute}
+	q.Enqueue(ctx, &Delivery{Recipient: recipient, Payload: []byte("a"), NextAttempt: now()})
+	q.Enqueue(ctx, &Delivery{Recipient: recipient, Payload: []byte("b"), NextAttempt: now()})
+	leased, err := q.Lease(ctx, 2)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(leased), 2)
+	// Run
+	limited, err := q.Fail(ctx, leased[0], &HttpStatusError{IRI: recipient, StatusCode: http.StatusTooManyRequests, RetryAfter: time.Hour})
+	assertEqual(t, err, nil)
+	rejected, err := q.Fail(ctx, leased[1], &HttpStatusError{IRI: recipient, StatusCode: http.StatusUnprocessableEntity})
+	assertEqual(t, err, nil)
+	// Verify
+	assertEqual(t, limited, true)
+	assertEqual(t, leased[0].NextAttempt.Equal(now().Add(time.Hour)), true)
+	assertEqual(t, rejected, false)
+	state, ok := q.DestinationState(recipient.Host)
+	assertEqual(t, ok, true)
+	assertEqual(t, state.RetryAfter.Equal(now().Add(time.Hour)), true)
+}
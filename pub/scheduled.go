@@ -0,0 +1,242 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ScheduledActivity is an activity posted to an outbox that is held until its
+// publish time.
+type ScheduledActivity struct {
+	// Id uniquely identifies the scheduled activity within its
+	// ScheduleQueue. It is set by the ScheduleQueue when scheduled.
+	Id string
+	// OutboxIRI is the outbox the activity was posted to.
+	OutboxIRI *url.URL
+	// Payload is the serialized activity, with the ids it was given when
+	// posted.
+	Payload []byte
+	// PublishAt is when the activity is to be published.
+	PublishAt time.Time
+}
+
+// ScheduleQueue holds the activities posted to outboxes that are to be
+// published in the future.
+//
+// The library provides an in-memory implementation with
+// NewMemoryScheduleQueue. Applications needing scheduled activities to survive
+// restarts may implement this interface with a persistent backend.
+//
+// Implementations must be safe for concurrent use.
+type ScheduleQueue interface {
+	// Schedule adds an activity to the queue, assigning it an Id.
+	Schedule(c context.Context, s *ScheduledActivity) error
+	// Due returns up to max scheduled activities whose PublishAt is not in
+	// the future, earliest first.
+	Due(c context.Context, max int) (due []*ScheduledActivity, err error)
+	// Remove removes the scheduled activity with the given id, either once
+	// published or to cancel it.
+	Remove(c context.Context, id string) error
+	// Scheduled returns the activities scheduled for the outbox, earliest
+	// first.
+	Scheduled(c context.Context, outboxIRI *url.URL) (scheduled []*ScheduledActivity, err error)
+}
+
+// PublishScheduler is an optional extension of the SocialProtocol allowing
+// clients to schedule posts.
+//
+// If the SocialProtocol implements it, an activity posted to an outbox with a
+// 'published' time in the future is given its ids and then held in the
+// ScheduleQueue instead of having its side effects applied and being
+// delivered. It is published once due by calling ProcessScheduled.
+type PublishScheduler interface {
+	// ScheduleQueue returns the queue holding the scheduled activities.
+	ScheduleQueue(c context.Context) (ScheduleQueue, error)
+}
+
+// MemoryScheduleQueue is an in-memory ScheduleQueue. Its contents are lost
+// when the process exits.
+type MemoryScheduleQueue struct {
+	clock     Clock
+	mu        sync.Mutex
+	nextId    uint64
+	scheduled map[string]*ScheduledActivity
+}
+
+// MemoryScheduleQueue must satisfy the ScheduleQueue interface.
+var _ ScheduleQueue = &MemoryScheduleQueue{}
+
+// NewMemoryScheduleQueue creates a new in-memory ScheduleQueue.
+func NewMemoryScheduleQueue(clock Clock) *MemoryScheduleQueue {
+	return &MemoryScheduleQueue{
+		clock:     clock,
+		scheduled: make(map[string]*ScheduledActivity),
+	}
+}
+
+// Schedule adds an activity to the queue.
+func (m *MemoryScheduleQueue) Schedule(c context.Context, s *ScheduledActivity) error {
+	if s.OutboxIRI == nil {
+		return fmt.Errorf("cannot schedule activity without an outbox")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextId++
+	s.Id = strconv.FormatUint(m.nextId, 10)
+	m.scheduled[s.Id] = s
+	return nil
+}
+
+// Due returns up to max activities due to be published, earliest first.
+func (m *MemoryScheduleQueue) Due(c context.Context, max int) (due []*ScheduledActivity, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.clock.Now()
+	for _, s := range m.scheduled {
+		if !s.PublishAt.After(now) {
+			due = append(due, s)
+		}
+	}
+	sortScheduled(due)
+	if len(due) > max {
+		due = due[:max]
+	}
+	return
+}
+
+// Remove removes a scheduled activity.
+func (m *MemoryScheduleQueue) Remove(c context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.scheduled[id]; !ok {
+		return fmt.Errorf("no scheduled activity with id %q", id)
+	}
+	delete(m.scheduled, id)
+	return nil
+}
+
+// Scheduled returns the activities scheduled for the outbox, earliest first.
+func (m *MemoryScheduleQueue) Scheduled(c context.Context, outboxIRI *url.URL) (scheduled []*ScheduledActivity, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.scheduled {
+		if s.OutboxIRI.String() == outboxIRI.String() {
+			scheduled = append(scheduled, s)
+		}
+	}
+	sortScheduled(scheduled)
+	return
+}
+
+// Len returns the number of scheduled activities in the queue.
+func (m *MemoryScheduleQueue) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.scheduled)
+}
+
+// sortScheduled sorts scheduled activities by their publish time.
+func sortScheduled(s []*ScheduledActivity) {
+	sort.Slice(s, func(i, j int) bool {
+		if !s[i].PublishAt.Equal(s[j].PublishAt) {
+			return s[i].PublishAt.Before(s[j].PublishAt)
+		}
+		return s[i].Id < s[j].Id
+	})
+}
+
+// publishTime returns the 'published' time of the activity, and false if it
+// has none.
+func publishTime(activity Activity) (time.Time, bool) {
+	p, ok := activity.(publisheder)
+	if !ok {
+		return time.Time{}, false
+	}
+	published := p.GetActivityStreamsPublished()
+	if published == nil || !published.IsXMLSchemaDateTime() {
+		return time.Time{}, false
+	}
+	return published.Get(), true
+}
+
+// scheduledPublisher is an Actor able to publish the activities held by a
+// ScheduleQueue.
+type scheduledPublisher interface {
+	publishScheduled(c context.Context, outboxIRI *url.URL, activity Activity) error
+}
+
+// ProcessScheduled publishes up to max scheduled activities that are due,
+// applying their side effects and delivering them as if they had just been
+// posted to the outbox, but keeping the ids they were given when scheduled.
+//
+// The actor must be one created by this library for the outboxes whose
+// activities are scheduled in the queue. Since there is no client request,
+// the context must carry whatever the application's SocialProtocol callbacks
+// need.
+//
+// A scheduled activity failing to be published is removed from the queue and
+// the onFailure function is called with it, so that the application may
+// notify the client or schedule it again. It may be nil.
+//
+// Returns the number of published activities. An error is only returned if
+// the queue itself fails, or ErrActorClosed if the actor was closed, in which
+// case the activity being published is scheduled again.
+func ProcessScheduled(c context.Context, queue ScheduleQueue, actor Actor, max int, onFailure func(c context.Context, s *ScheduledActivity, err error)) (published int, err error) {
+	p, ok := actor.(scheduledPublisher)
+	if !ok {
+		err = fmt.Errorf("actor of type %T cannot publish scheduled activities", actor)
+		return
+	}
+	var due []*ScheduledActivity
+	due, err = queue.Due(c, max)
+	if err != nil {
+		return
+	}
+	for _, s := range due {
+		if err = queue.Remove(c, s.Id); err != nil {
+			return
+		}
+		var activity Activity
+		cause := func() error {
+			var m map[string]interface{}
+			if err := json.Unmarshal(s.Payload, &m); err != nil {
+				return err
+			}
+			t, err := streams.DeserializeContext(c, m)
+			if err != nil {
+				return err
+			}
+			var ok bool
+			if activity, ok = t.(Activity); !ok {
+				return fmt.Errorf("scheduled value is not an Activity: %T", t)
+			}
+			return p.publishScheduled(c, s.OutboxIRI, activity)
+		}()
+		if cause == ErrActorClosed {
+			// Keep the activity for when the application restarts.
+			if err = queue.Schedule(c, s); err == nil {
+				err = ErrActorClosed
+			}
+			return
+		} else if cause != nil {
+			fields := []LogField{{Key: "outbox", Value: s.OutboxIRI}, errorLogField(cause)}
+			if activity != nil {
+				fields = append(activityLogFields(activity), fields...)
+			}
+			logEntry(c, LogLevelError, "publishing scheduled activity failed", fields...)
+			if onFailure != nil {
+				onFailure(c, s, cause)
+			}
+			continue
+		}
+		published++
+	}
+	return
+}
@@ -0,0 +1,251 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// testSchedulingSocialProtocol is a SocialProtocol implementing
+// PublishScheduler.
+type testSchedulingSocialProtocol struct {
+	*MockSocialProtocol
+	queue ScheduleQueue
+}
+
+func (s *testSchedulingSocialProtocol) ScheduleQueue(c context.Context) (ScheduleQueue, error) {
+	return s.queue, nil
+}
+
+// testScheduledPublisher records the scheduled activities it publishes.
+type testScheduledPublisher struct {
+	Actor
+	published []Activity
+	err       error
+}
+
+func (p *testScheduledPublisher) publishScheduled(c context.Context, outboxIRI *url.URL, activity Activity) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, activity)
+	return nil
+}
+
+// newTestScheduledCreate creates a Create with the given id and published time.
+func newTestScheduledCreate(id string, at time.Time) Activity {
+	create := streams.NewActivityStreamsCreate()
+	idp := streams.NewActivityStreamsIdProperty()
+	idp.Set(mustParse(id))
+	create.SetActivityStreamsId(idp)
+	published := streams.NewActivityStreamsPublishedProperty()
+	published.Set(at)
+	create.SetActivityStreamsPublished(published)
+	return create
+}
+
+func TestMemoryScheduleQueue(t *testing.T) {
+	ctx := context.Background()
+	outboxIRI := mustParse(testMyOutboxIRI)
+	otherOutboxIRI := mustParse("https://example.com/addison/other/outbox")
+	t.Run("ReturnsDueEarliestFirst", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		q := NewMemoryScheduleQueue(cl)
+		late := &ScheduledActivity{OutboxIRI: outboxIRI, PublishAt: now().Add(-time.Minute)}
+		early := &ScheduledActivity{OutboxIRI: outboxIRI, PublishAt: now().Add(-time.Hour)}
+		future := &ScheduledActivity{OutboxIRI: outboxIRI, PublishAt: now().Add(time.Hour)}
+		for _, s := range []*ScheduledActivity{late, early, future} {
+			assertEqual(t, q.Schedule(ctx, s), nil)
+		}
+		// Run
+		due, err := q.Due(ctx, 10)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 2)
+		assertEqual(t, due[0], early)
+		assertEqual(t, due[1], late)
+	})
+	t.Run("LimitsDue", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		q := NewMemoryScheduleQueue(cl)
+		assertEqual(t, q.Schedule(ctx, &ScheduledActivity{OutboxIRI: outboxIRI, PublishAt: now()}), nil)
+		assertEqual(t, q.Schedule(ctx, &ScheduledActivity{OutboxIRI: outboxIRI, PublishAt: now()}), nil)
+		// Run
+		due, err := q.Due(ctx, 1)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(due), 1)
+		assertEqual(t, due[0].Id, "1")
+	})
+	t.Run("ListsAndCancelsPerOutbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		q := NewMemoryScheduleQueue(cl)
+		mine := &ScheduledActivity{OutboxIRI: outboxIRI, PublishAt: now().Add(time.Hour)}
+		other := &ScheduledActivity{OutboxIRI: otherOutboxIRI, PublishAt: now().Add(time.Hour)}
+		assertEqual(t, q.Schedule(ctx, mine), nil)
+		assertEqual(t, q.Schedule(ctx, other), nil)
+		// Run
+		scheduled, err := q.Scheduled(ctx, outboxIRI)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(scheduled), 1)
+		assertEqual(t, scheduled[0], mine)
+		assertEqual(t, q.Remove(ctx, mine.Id), nil)
+		assertNotEqual(t, q.Remove(ctx, mine.Id), nil)
+		assertEqual(t, q.Len(), 1)
+	})
+}
+
+func TestPostOutboxScheduled(t *testing.T) {
+	ctx := context.Background()
+	outboxIRI := mustParse(testMyOutboxIRI)
+	setupFn := func(ctl *gomock.Controller) (q *MemoryScheduleQueue, db *MockDatabase, cl *MockClock, a DelegateActor) {
+		c := NewMockCommonBehavior(ctl)
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		q = NewMemoryScheduleQueue(cl)
+		a = &sideEffectActor{
+			common: c,
+			c2s: &testSchedulingSocialProtocol{
+				MockSocialProtocol: NewMockSocialProtocol(ctl),
+				queue:              q,
+			},
+			db:    db,
+			clock: cl,
+		}
+		return
+	}
+	t.Run("HoldsFutureActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, _, cl, a := setupFn(ctl)
+		cl.EXPECT().Now().Return(now())
+		publishAt := now().Add(time.Hour)
+		// Run
+		deliverable, err := a.PostOutbox(ctx, newTestScheduledCreate(testNewActivityIRI, publishAt), outboxIRI, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, deliverable, false)
+		scheduled, err := q.Scheduled(ctx, outboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(scheduled), 1)
+		assertEqual(t, scheduled[0].PublishAt, publishAt)
+		assertEqual(t, scheduled[0].OutboxIRI.String(), testMyOutboxIRI)
+	})
+	t.Run("DoesNotHoldPastActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q, _, cl, a := setupFn(ctl)
+		cl.EXPECT().Now().Return(now()).Times(2)
+		testErr := errors.New("test error")
+		sp := a.(*sideEffectActor).c2s.(*testSchedulingSocialProtocol)
+		sp.EXPECT().Callbacks(ctx).Return(SocialWrappedCallbacks{}, nil, testErr)
+		// Run
+		_, err := a.PostOutbox(ctx, newTestScheduledCreate(testNewActivityIRI, now().Add(-time.Hour)), outboxIRI, nil)
+		// Verify
+		assertEqual(t, err, testErr)
+		assertEqual(t, q.Len(), 0)
+	})
+}
+
+func TestProcessScheduled(t *testing.T) {
+	ctx := context.Background()
+	outboxIRI := mustParse(testMyOutboxIRI)
+	setupFn := func(ctl *gomock.Controller) (q *MemoryScheduleQueue) {
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		q = NewMemoryScheduleQueue(cl)
+		for _, s := range []struct {
+			id string
+			at time.Time
+		}{
+			{testNewActivityIRI, now().Add(-time.Minute)},
+			{testNewActivityIRI2, now().Add(time.Hour)},
+		} {
+			b, err := serializeJSON(newTestScheduledCreate(s.id, s.at))
+			if err != nil {
+				panic(err)
+			}
+			if err = q.Schedule(ctx, &ScheduledActivity{OutboxIRI: outboxIRI, Payload: b, PublishAt: s.at}); err != nil {
+				panic(err)
+			}
+		}
+		return
+	}
+	t.Run("PublishesDueKeepingIds", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := setupFn(ctl)
+		p := &testScheduledPublisher{}
+		// Run
+		published, err := ProcessScheduled(ctx, q, p, 10, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, published, 1)
+		assertEqual(t, len(p.published), 1)
+		assertEqual(t, p.published[0].GetActivityStreamsId().Get().String(), testNewActivityIRI)
+		assertEqual(t, q.Len(), 1)
+	})
+	t.Run("ReportsFailure", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := setupFn(ctl)
+		testErr := errors.New("test error")
+		p := &testScheduledPublisher{err: testErr}
+		var failed []*ScheduledActivity
+		onFailure := func(c context.Context, s *ScheduledActivity, err error) {
+			assertEqual(t, err, testErr)
+			failed = append(failed, s)
+		}
+		// Run
+		published, err := ProcessScheduled(ctx, q, p, 10, onFailure)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, published, 0)
+		assertEqual(t, len(failed), 1)
+		assertEqual(t, q.Len(), 1)
+	})
+	t.Run("KeepsActivityIfActorClosed", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := setupFn(ctl)
+		p := &testScheduledPublisher{err: ErrActorClosed}
+		// Run
+		published, err := ProcessScheduled(ctx, q, p, 10, nil)
+		// Verify
+		assertEqual(t, err, ErrActorClosed)
+		assertEqual(t, published, 0)
+		assertEqual(t, q.Len(), 2)
+	})
+	t.Run("ErrorIfActorCannotPublish", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		q := setupFn(ctl)
+		// Run
+		_, err := ProcessScheduled(ctx, q, struct{ Actor }{}, 10, nil)
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, q.Len(), 2)
+	})
+}
@@ -0,0 +1,117 @@
+package pub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultSeenTTL is how long a MemorySeenStore remembers an activity id, which
+// covers the retry schedules of common ActivityPub servers.
+const DefaultSeenTTL = 7 * 24 * time.Hour
+
+const (
+	// inboxActivityScheme is the scheme of the ids recorded in a SeenStore
+	// for the activities received in an inbox.
+	inboxActivityScheme = "urn"
+	// inboxActivityPrefix prefixes the ids recorded in a SeenStore for the
+	// activities received in an inbox.
+	inboxActivityPrefix = "go-fed:inbox:"
+)
+
+// SeenStore records the ids of inbound activities whose side effects have
+// been applied, so that an activity delivered again by a peer's retry or by a
+// relay has no side effects the second time.
+//
+// An activity received in an inbox is recorded for that inbox, so that one
+// addressed to several local actors has its side effects applied in each of
+// their inboxes.
+//
+// A Database may implement SeenStore to persist the ids, otherwise a
+// MemorySeenStore is used by the Actor. Unlike Database's Exists, it only
+// holds ids of activities received in an inbox, and not those created in an
+// outbox.
+type SeenStore interface {
+	// MarkSeen records the activity id, returning true if it was already
+	// recorded. It must be atomic, so that of concurrent deliveries of the
+	// same activity only one is marked as not seen.
+	MarkSeen(c context.Context, id *url.URL) (seen bool, err error)
+	// UnmarkSeen forgets the activity id, such as when applying the side
+	// effects of the activity failed after it was marked, so that it is
+	// applied when delivered again.
+	UnmarkSeen(c context.Context, id *url.URL) error
+}
+
+// MemorySeenStore is a SeenStore held in memory, forgetting ids after a TTL.
+type MemorySeenStore struct {
+	mu        sync.Mutex
+	clock     Clock
+	ttl       time.Duration
+	expires   map[string]time.Time
+	nextPrune time.Time
+}
+
+// MemorySeenStore must satisfy the SeenStore interface.
+var _ SeenStore = &MemorySeenStore{}
+
+// NewMemorySeenStore creates an empty MemorySeenStore remembering ids for the
+// TTL.
+func NewMemorySeenStore(clock Clock, ttl time.Duration) *MemorySeenStore {
+	return &MemorySeenStore{
+		clock:   clock,
+		ttl:     ttl,
+		expires: make(map[string]time.Time),
+	}
+}
+
+// MarkSeen records the id until the TTL passes.
+func (m *MemorySeenStore) MarkSeen(c context.Context, id *url.URL) (seen bool, err error) {
+	now := m.clock.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if now.After(m.nextPrune) {
+		for k, exp := range m.expires {
+			if now.After(exp) {
+				delete(m.expires, k)
+			}
+		}
+		m.nextPrune = now.Add(m.ttl)
+	}
+	key := id.String()
+	if exp, ok := m.expires[key]; ok && !now.After(exp) {
+		return true, nil
+	}
+	m.expires[key] = now.Add(m.ttl)
+	return false, nil
+}
+
+// UnmarkSeen forgets the id.
+func (m *MemorySeenStore) UnmarkSeen(c context.Context, id *url.URL) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.expires, id.String())
+	return nil
+}
+
+// inboxActivityId returns the id recorded in a SeenStore for an activity
+// received in the inbox, which differs for every inbox the activity is
+// received in.
+func inboxActivityId(inboxIRI, id *url.URL) *url.URL {
+	h := sha256.Sum256([]byte(inboxIRI.String() + "\n" + id.String()))
+	return &url.URL{
+		Scheme: inboxActivityScheme,
+		Opaque: inboxActivityPrefix + hex.EncodeToString(h[:]),
+	}
+}
+
+// newSeenStore returns the Database if it is a SeenStore, and a
+// MemorySeenStore otherwise.
+func newSeenStore(db Database, clock Clock) SeenStore {
+	if s, ok := db.(SeenStore); ok {
+		return s
+	}
+	return NewMemorySeenStore(clock, DefaultSeenTTL)
+}
@@ -0,0 +1,39 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+func TestMemorySeenStore(t *testing.T) {
+	ctx := context.Background()
+	id := mustParse(testFederatedActivityIRI)
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cl := NewMockClock(ctl)
+	s := NewMemorySeenStore(cl, DefaultSeenTTL)
+	gomock.InOrder(
+		cl.EXPECT().Now().Return(now()),
+		cl.EXPECT().Now().Return(now()),
+		cl.EXPECT().Now().Return(now().Add(DefaultSeenTTL+1)),
+		cl.EXPECT().Now().Return(now().Add(DefaultSeenTTL+1)),
+	)
+	// Run
+	first, firstErr := s.MarkSeen(ctx, id)
+	second, secondErr := s.MarkSeen(ctx, id)
+	expired, expiredErr := s.MarkSeen(ctx, id)
+	unmarkErr := s.UnmarkSeen(ctx, id)
+	unmarked, unmarkedErr := s.MarkSeen(ctx, id)
+	// Verify
+	assertEqual(t, firstErr, nil)
+	assertEqual(t, first, false)
+	assertEqual(t, secondErr, nil)
+	assertEqual(t, second, true)
+	assertEqual(t, expiredErr, nil)
+	assertEqual(t, expired, false)
+	assertEqual(t, unmarkErr, nil)
+	assertEqual(t, unmarkedErr, nil)
+	assertEqual(t, unmarked, false)
+}
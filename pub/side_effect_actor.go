@@ -8,6 +8,7 @@ import (
 	"github.com/go-fed/activity/streams/vocab"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // sideEffectActor must satisfy the DelegateActor interface.
@@ -26,6 +27,9 @@ type sideEffectActor struct {
 	c2s    SocialProtocol
 	db     Database
 	clock  Clock
+	// seen records the inbound activities whose side effects were applied.
+	// If nil, duplicates are only detected by the contents of the inbox.
+	seen SeenStore
 }
 
 // PostInboxRequestBodyHook defers to the delegate.
@@ -33,8 +37,14 @@ func (a *sideEffectActor) PostInboxRequestBodyHook(c context.Context, r *http.Re
 	return a.s2s.PostInboxRequestBodyHook(c, r, activity)
 }
 
-// PostOutboxRequestBodyHook defers to the delegate.
+// PostOutboxRequestBodyHook checks the scopes of the client if it was
+// authenticated by a C2SAuthenticator, then defers to the delegate.
 func (a *sideEffectActor) PostOutboxRequestBodyHook(c context.Context, r *http.Request, data vocab.Type) (context.Context, error) {
+	if auth, ok := a.c2s.(C2SAuthenticator); ok {
+		if err := checkScopes(c, auth, data); err != nil {
+			return c, err
+		}
+	}
 	return a.c2s.PostOutboxRequestBodyHook(c, r, data)
 }
 
@@ -48,8 +58,12 @@ func (a *sideEffectActor) AuthenticateGetInbox(c context.Context, w http.Respons
 	return a.common.AuthenticateGetInbox(c, w, r)
 }
 
-// AuthenticatePostOutbox defers to the delegate to authenticate the request.
+// AuthenticatePostOutbox authenticates the bearer token of the request if the
+// delegate is a C2SAuthenticator, and otherwise defers to the delegate.
 func (a *sideEffectActor) AuthenticatePostOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (out context.Context, authenticated bool, err error) {
+	if auth, ok := a.c2s.(C2SAuthenticator); ok {
+		return a.authenticateBearer(c, w, r, auth)
+	}
 	return a.c2s.AuthenticatePostOutbox(c, w, r)
 }
 
@@ -69,12 +83,12 @@ func (a *sideEffectActor) GetInbox(c context.Context, r *http.Request) (vocab.Ac
 }
 
 // AuthorizePostInbox defers to the federating protocol whether the peer request
-// is authorized based on the actors' ids.
+// is authorized based on the actors' ids, then applies its inbox filter.
 func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseWriter, activity Activity) (authorized bool, err error) {
 	authorized = false
 	actor := activity.GetActivityStreamsActor()
 	if actor == nil {
-		err = fmt.Errorf("no actors in post to inbox")
+		err = wrapErr(ErrActorRequired, "no actors in post to inbox")
 		return
 	}
 	var iris []*url.URL
@@ -85,7 +99,7 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 		} else if t := iter.GetType(); t != nil {
 			iris = append(iris, activity.GetActivityStreamsId().Get())
 		} else {
-			err = fmt.Errorf("actor at index %d is missing an id", i)
+			err = wrapErr(ErrActorRequired, "actor at index %d is missing an id", i)
 			return
 		}
 	}
@@ -94,22 +108,84 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 	if blocked, err = a.s2s.Blocked(c, iris); err != nil {
 		return
 	} else if blocked {
+		logEntry(c, LogLevelInfo, "rejected activity from blocked actor", activityLogFields(activity)...)
 		w.WriteHeader(http.StatusForbidden)
 		return
 	}
+	// Let the application's filter decide whether to process it.
+	if filter := a.inboxFilter(c); filter != nil {
+		var v Verdict
+		if v, err = filter.FilterInbox(c, activity); err != nil {
+			return
+		} else if !applyVerdict(w, v) {
+			logEntry(c, LogLevelInfo, "filtered activity", append(activityLogFields(activity),
+				LogField{Key: "action", Value: v.Action},
+				LogField{Key: "reason", Value: v.Reason})...)
+			return
+		}
+	}
 	authorized = true
 	return
 }
 
+// inboxFilter returns the Filter of the FederatingProtocol if it is an
+// InboxFilterer, and otherwise nil.
+func (a *sideEffectActor) inboxFilter(c context.Context) Filter {
+	if f, ok := a.s2s.(InboxFilterer); ok {
+		return f.InboxFilter(c)
+	}
+	return nil
+}
+
 // PostInbox handles the side effects of determining whether to block the peer's
 // request, adding the activity to the actor's inbox, and triggering side
 // effects based on the activity's type.
+//
+// Returns ErrDuplicateActivity if the activity is already in the inbox, or if
+// its side effects were already applied when it was received in this inbox
+// before, such as through a relay. Returns ErrBlocked if any of its actors are blocked by
+// the owner of the inbox.
 func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
-	isNew, err := a.addToInboxIfNew(c, inboxIRI, activity)
+	// Drop activities from actors blocked by the inbox's owner.
+	blocked, err := a.isBlockedByInboxOwner(c, inboxIRI, activity)
 	if err != nil {
 		return err
-	}
-	if isNew {
+	} else if blocked {
+		logEntry(c, LogLevelInfo, "dropped activity from actor blocked by inbox owner", activityLogFields(activity)...)
+		return ErrBlocked
+	}
+	// Apply the side effects of the activity, including adding it to the
+	// inbox, in one transaction if the database supports it. The activity
+	// is marked as seen in that transaction if the database is also the
+	// SeenStore. Activities the side effects deliver, such as an automatic
+	// Accept, are only delivered once the transaction is committed. A
+	// duplicate is not an error within the transaction, so that adding it
+	// to the inbox is still committed.
+	seenId := inboxActivityId(inboxIRI, activity.GetActivityStreamsId().Get())
+	marked := false
+	duplicate := false
+	var deliveries []pendingDelivery
+	err = runInTransaction(c, a.db, func(c context.Context) error {
+		deliveries = nil
+		duplicate = false
+		isNew, err := a.addToInboxIfNew(c, inboxIRI, activity)
+		if err != nil {
+			return err
+		} else if !isNew {
+			logEntry(c, LogLevelDebug, "ignored activity already in inbox", activityLogFields(activity)...)
+			duplicate = true
+			return nil
+		}
+		if a.seen != nil {
+			if seen, err := a.seen.MarkSeen(c, seenId); err != nil {
+				return err
+			} else if seen {
+				logEntry(c, LogLevelDebug, "ignored activity already received", activityLogFields(activity)...)
+				duplicate = true
+				return nil
+			}
+			marked = true
+		}
 		wrapped, other, err := a.s2s.Callbacks(c)
 		if err != nil {
 			return err
@@ -118,8 +194,14 @@ func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activi
 		wrapped.db = a.db
 		wrapped.inboxIRI = inboxIRI
 		wrapped.newTransport = a.common.NewTransport
-		wrapped.deliver = a.Deliver
+		wrapped.deliver = func(c context.Context, outboxIRI *url.URL, activity Activity) error {
+			deliveries = append(deliveries, pendingDelivery{outboxIRI: outboxIRI, activity: activity})
+			return nil
+		}
 		wrapped.addNewIds = a.AddNewIds
+		wrapped.clock = a.clock
+		wrapped.dereferencePolicy = a.s2s.DereferencePolicy
+		wrapped.seen = a.seen
 		res, err := streams.NewTypeResolver(wrapped.callbacks(other)...)
 		if err != nil {
 			return err
@@ -132,14 +214,51 @@ func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activi
 				return err
 			}
 		}
+		return nil
+	})
+	// Forget an activity whose side effects failed, so that they are
+	// applied when the peer delivers it again.
+	if err != nil && marked {
+		if uErr := a.seen.UnmarkSeen(c, seenId); uErr != nil {
+			logEntry(c, LogLevelError, "forgetting activity failed", append(activityLogFields(activity), errorLogField(uErr))...)
+		}
 	}
-	return nil
+	if err != nil {
+		return err
+	} else if duplicate {
+		return ErrDuplicateActivity
+	}
+	for _, d := range deliveries {
+		if dErr := a.Deliver(c, d.outboxIRI, d.activity); dErr != nil && err == nil {
+			err = dErr
+		}
+	}
+	return err
+}
+
+// useSharedInbox defers to the FederatingProtocol if it is a
+// SharedInboxPolicy, and otherwise collapses deliveries to every host.
+func (a *sideEffectActor) useSharedInbox(c context.Context, host string) bool {
+	if p, ok := a.s2s.(SharedInboxPolicy); ok {
+		return p.UseSharedInbox(c, host)
+	}
+	return true
+}
+
+// pendingDelivery is an activity delivered by the side effects of an inbound
+// activity, held until they are committed.
+type pendingDelivery struct {
+	outboxIRI *url.URL
+	activity  Activity
 }
 
 // InboxForwarding implements the 3-part inbox forwarding algorithm specified in
 // the ActivityPub specification. Does not modify the Activity, but may send
 // outbound requests as a side effect.
 //
+// The activity is forwarded to the inboxes of the members of the addressed
+// collections owned by this server, excluding the activity's actors.
+//
 // InboxForwarding sets the federated data in the database.
 func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL, activity Activity) error {
 	// 1. Must be first time we have seen this Activity.
@@ -208,9 +327,6 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 	// that forwarding can properly occur.
 	var myIRIs []*url.URL
 	for _, iri := range r {
-		if err != nil {
-			return err
-		}
 		err = a.db.Lock(c, iri)
 		if err != nil {
 			return err
@@ -272,8 +388,8 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 	// 3. The values of 'inReplyTo', 'object', 'target', or 'tag' are owned
 	//    by this server. This is only a boolean trigger: As soon as we get
 	//    a hit that we own something, then we should do inbox forwarding.
-	maxDepth := a.s2s.MaxInboxForwardingRecursionDepth(c)
-	ownsValue, err := a.hasInboxForwardingValues(c, inboxIRI, activity, maxDepth, 0)
+	policy := a.s2s.DereferencePolicy(c)
+	ownsValue, err := a.hasInboxForwardingValues(c, inboxIRI, activity, policy, 0)
 	if err != nil {
 		return err
 	}
@@ -284,12 +400,14 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 	}
 	// Do the inbox forwarding since the above conditions hold true. Support
 	// the behavior of letting the application filter out the resulting
-	// collections to be targeted.
+	// collections to be targeted, or veto forwarding entirely.
 	toSend, err := a.s2s.FilterForwarding(c, colIRIs, activity)
 	if err != nil {
 		return err
+	} else if len(toSend) == 0 {
+		return nil
 	}
-	recipients := make([]*url.URL, 0, len(toSend))
+	members := make([]*url.URL, 0, len(toSend))
 	for _, iri := range toSend {
 		if c, ok := col[iri.String()]; ok {
 			if it := c.GetActivityStreamsItems(); it != nil {
@@ -298,7 +416,7 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 					if err != nil {
 						return err
 					}
-					recipients = append(recipients, id)
+					members = append(members, id)
 				}
 			}
 		} else if oc, ok := oCol[iri.String()]; ok {
@@ -308,11 +426,34 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 					if err != nil {
 						return err
 					}
-					recipients = append(recipients, id)
+					members = append(members, id)
 				}
 			}
 		}
 	}
+	// Forward to the inboxes of the members, but never back to the actors
+	// that authored the activity.
+	authors, err := getActorIds(activity)
+	if err != nil {
+		return err
+	}
+	t, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
+	if err != nil {
+		return err
+	}
+	resolver := AudienceResolver{
+		Transport:      t,
+		Database:       a.db,
+		MaxDepth:       a.s2s.MaxDeliveryRecursionDepth(c),
+		UseSharedInbox: a.useSharedInbox,
+		Exclude:        authors,
+	}
+	recipients, err := resolver.ResolveIRIs(c, members, nil)
+	if err != nil {
+		return err
+	} else if len(recipients) == 0 {
+		return nil
+	}
 	return a.deliverToRecipients(c, inboxIRI, activity, recipients)
 }
 
@@ -320,10 +461,61 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 // outbox, and triggering side effects based on the activity's type.
 //
 // This implementation assumes all types are meant to be delivered except for
-// the ActivityStreams Block type.
+// the ActivityStreams Block type, and activities held to be published in the
+// future when the SocialProtocol implements PublishScheduler.
 func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
+	// Hold an activity to be published in the future, without applying
+	// its side effects nor delivering it, if the application supports
+	// scheduling.
+	var scheduled bool
+	if scheduled, err = a.schedule(c, activity, outboxIRI); err != nil || scheduled {
+		return
+	}
+	// Apply the side effects of the activity, including adding it to the
+	// outbox, in one transaction if the database supports it.
+	err = runInTransaction(c, a.db, func(c context.Context) (err error) {
+		deliverable, err = a.postOutbox(c, activity, outboxIRI, rawJSON)
+		return
+	})
+	return
+}
+
+// schedule adds the activity to the ScheduleQueue of the SocialProtocol if it
+// implements PublishScheduler and the activity is published in the future.
+func (a *sideEffectActor) schedule(c context.Context, activity Activity, outboxIRI *url.URL) (scheduled bool, err error) {
+	ps, ok := a.c2s.(PublishScheduler)
+	if !ok {
+		return
+	}
+	at, ok := publishTime(activity)
+	if !ok || !at.After(a.clock.Now()) {
+		return
+	}
+	var queue ScheduleQueue
+	if queue, err = ps.ScheduleQueue(c); err != nil {
+		return
+	}
+	var b []byte
+	if b, err = serializeJSON(activity); err != nil {
+		return
+	}
+	err = queue.Schedule(c, &ScheduledActivity{
+		OutboxIRI: outboxIRI,
+		Payload:   b,
+		PublishAt: at,
+	})
+	scheduled = err == nil
+	return
+}
+
+// postOutbox applies the side effects of the activity and adds it to the
+// outbox.
+func (a *sideEffectActor) postOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
 	// TODO: Determine this if c2s is nil
 	deliverable = true
+	if err = a.stampPublished(activity); err != nil {
+		return
+	}
 	if a.c2s != nil {
 		var wrapped SocialWrappedCallbacks
 		var other []interface{}
@@ -360,8 +552,50 @@ func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outbo
 	return
 }
 
+// stampPublished sets the 'published' property of the activity, and of the
+// objects of a Create, to the current time of the Clock if it is absent.
+// Nothing is set without a Clock.
+func (a *sideEffectActor) stampPublished(activity Activity) error {
+	if a.clock == nil {
+		return nil
+	}
+	now := a.clock.Now()
+	setPublished(activity, now)
+	if !streams.IsOrExtendsActivityStreamsCreate(activity) {
+		return nil
+	}
+	o, ok := activity.(objecter)
+	if !ok {
+		return fmt.Errorf("cannot set published for Create: %T has no object property", activity)
+	}
+	if oProp := o.GetActivityStreamsObject(); oProp != nil {
+		for iter := oProp.Begin(); iter != oProp.End(); iter = iter.Next() {
+			if t := iter.GetType(); t != nil {
+				setPublished(t, now)
+			}
+		}
+	}
+	return nil
+}
+
+// setPublished sets the 'published' property of the value to the time if it
+// has none.
+func setPublished(t vocab.Type, now time.Time) {
+	p, ok := t.(publisheder)
+	if !ok {
+		return
+	}
+	if published := p.GetActivityStreamsPublished(); published != nil && published.IsXMLSchemaDateTime() {
+		return
+	}
+	published := streams.NewActivityStreamsPublishedProperty()
+	published.Set(now)
+	p.SetActivityStreamsPublished(published)
+}
+
 // AddNewIds creates new 'id' entries on an activity and its objects if it is a
-// Create activity.
+// Create activity. Values embedded in the object and attachment properties of
+// those objects receive a new id only if they lack one.
 func (a *sideEffectActor) AddNewIds(c context.Context, activity Activity) error {
 	id, err := a.db.NewId(c, activity)
 	if err != nil {
@@ -381,19 +615,65 @@ func (a *sideEffectActor) AddNewIds(c context.Context, activity Activity) error
 				if t == nil {
 					return fmt.Errorf("cannot add new id for object in Create: object is not embedded as a value literal")
 				}
-				id, err = a.db.NewId(c, t)
-				if err != nil {
+				if err = a.setNewId(c, t); err != nil {
+					return err
+				}
+				if err = a.addMissingIds(c, t); err != nil {
 					return err
 				}
-				objId := streams.NewActivityStreamsIdProperty()
-				objId.Set(id)
-				t.SetActivityStreamsId(objId)
 			}
 		}
 	}
 	return nil
 }
 
+// addMissingIds creates new 'id' entries on the values embedded in the object
+// and attachment properties that lack one, recursively.
+func (a *sideEffectActor) addMissingIds(c context.Context, t vocab.Type) error {
+	var nested []vocab.Type
+	if o, ok := t.(objecter); ok {
+		if oProp := o.GetActivityStreamsObject(); oProp != nil {
+			for iter := oProp.Begin(); iter != oProp.End(); iter = iter.Next() {
+				if v := iter.GetType(); v != nil {
+					nested = append(nested, v)
+				}
+			}
+		}
+	}
+	if at, ok := t.(attachmenter); ok {
+		if aProp := at.GetActivityStreamsAttachment(); aProp != nil {
+			for iter := aProp.Begin(); iter != aProp.End(); iter = iter.Next() {
+				if v := iter.GetType(); v != nil {
+					nested = append(nested, v)
+				}
+			}
+		}
+	}
+	for _, v := range nested {
+		if id := v.GetActivityStreamsId(); id == nil || id.Get() == nil {
+			if err := a.setNewId(c, v); err != nil {
+				return err
+			}
+		}
+		if err := a.addMissingIds(c, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setNewId sets the 'id' of the value to a new one from the database.
+func (a *sideEffectActor) setNewId(c context.Context, t vocab.Type) error {
+	id, err := a.db.NewId(c, t)
+	if err != nil {
+		return err
+	}
+	idProp := streams.NewActivityStreamsIdProperty()
+	idProp.Set(id)
+	t.SetActivityStreamsId(idProp)
+	return nil
+}
+
 // deliver will complete the peer-to-peer sending of a federated message to
 // another server.
 //
@@ -424,9 +704,20 @@ func (a *sideEffectActor) WrapInCreate(c context.Context, obj vocab.Type, outbox
 }
 
 // deliverToRecipients will take a prepared Activity and send it to specific
-// recipients on behalf of an actor.
+// recipients on behalf of an actor, once the CommonBehavior has overridden them
+// if it is a RecipientsOverrider.
 func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL, activity Activity, recipients []*url.URL) error {
-	m, err := streams.Serialize(activity)
+	if o, ok := a.common.(RecipientsOverrider); ok {
+		overridden, err := o.OverrideRecipients(c, boxIRI, activity, recipients)
+		if err != nil {
+			return err
+		}
+		recipients = dedupeIRIs(overridden, nil)
+		if len(recipients) == 0 {
+			return nil
+		}
+	}
+	m, err := streams.SerializeContext(c, activity)
 	if err != nil {
 		return err
 	}
@@ -438,7 +729,12 @@ func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL
 	if err != nil {
 		return err
 	}
-	return tp.BatchDeliver(c, b, recipients)
+	fields := append(activityLogFields(activity), LogField{Key: "recipients", Value: len(recipients)})
+	logEntry(c, LogLevelDebug, "delivering activity", fields...)
+	if err = tp.BatchDeliver(c, b, recipients); err != nil {
+		logEntry(c, LogLevelWarn, "delivering activity failed", append(fields, errorLogField(err))...)
+	}
+	return err
 }
 
 // addToOutbox adds the activity to the outbox and creates the activity in the
@@ -482,6 +778,41 @@ func (a *sideEffectActor) addToOutbox(c context.Context, outboxIRI *url.URL, act
 	return err
 }
 
+// isBlockedByInboxOwner determines whether any of the actors of the activity
+// are in the 'blocks' collection of the actor owning the inbox. None are if the
+// Database is not a BlocksStore.
+func (a *sideEffectActor) isBlockedByInboxOwner(c context.Context, inboxIRI *url.URL, activity Activity) (bool, error) {
+	if _, ok := a.db.(BlocksStore); !ok {
+		return false, nil
+	}
+	err := a.db.Lock(c, inboxIRI)
+	if err != nil {
+		return false, err
+	}
+	// WARNING: No deferring the Unlock
+	actorIRI, err := a.db.ActorForInbox(c, inboxIRI)
+	if err != nil {
+		a.db.Unlock(c, inboxIRI)
+		return false, err
+	}
+	a.db.Unlock(c, inboxIRI)
+	// Unlock the lock at this point and every branch above
+	blocked, err := blockedIRIs(c, a.db, actorIRI)
+	if err != nil {
+		return false, err
+	}
+	ids, err := getActorIds(activity)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range ids {
+		if blocked[id.String()] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // addToInboxIfNew will add the activity to the inbox at the specified IRI if
 // the activity's ID has not yet been added to the inbox.
 //
@@ -525,12 +856,12 @@ func (a *sideEffectActor) addToInboxIfNew(c context.Context, inboxIRI *url.URL,
 // Given an ActivityStreams value, recursively examines ownership of the id or
 // href and the ones on properties applicable to inbox forwarding.
 //
-// Recursion may be limited by providing a 'maxDepth' greater than zero. A
-// value of zero or a negative number will result in infinite recursion.
-func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, maxDepth, currDepth int) (bool, error) {
+// Recursion is limited by the maximum depth of the DereferencePolicy, which
+// also determines which IRIs are dereferenced.
+func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, policy DereferencePolicy, currDepth int) (bool, error) {
 	// Stop recurring if we are exceeding the maximum depth and the maximum
 	// is a positive number.
-	if maxDepth > 0 && currDepth >= maxDepth {
+	if maxDepth := policy.MaxDepth(c); maxDepth > 0 && currDepth >= maxDepth {
 		return false, nil
 	}
 	// Determine if we own the 'id' of any values on the properties we care
@@ -576,12 +907,15 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 	}
 	// Recur Preparation: Try fetching the IRIs so we can recur into them.
 	for _, iri := range iris {
+		if !policy.ShouldDereference(c, iri) {
+			continue
+		}
 		// Dereferencing the IRI.
 		tport, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
 		if err != nil {
 			return false, err
 		}
-		b, err := tport.Dereference(c, iri)
+		b, err := policy.Dereference(c, tport, iri)
 		if err != nil {
 			// Do not fail the entire process if the data is
 			// missing.
@@ -591,7 +925,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 		if err = json.Unmarshal(b, &m); err != nil {
 			return false, err
 		}
-		t, err := streams.ToType(c, m)
+		t, err := streams.DeserializeContext(c, m)
 		if err != nil {
 			// Do not fail the entire process if we cannot handle
 			// the type.
@@ -601,7 +935,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 	}
 	// Recur.
 	for _, nextVal := range types {
-		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+1); err != nil {
+		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, policy, currDepth+1); err != nil {
 			return false, err
 		} else if has {
 			return true, nil
@@ -616,79 +950,7 @@ func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *
 //
 // Only call if both the social and federated protocol are supported.
 func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activity Activity) (r []*url.URL, err error) {
-	// Get inboxes of recipients
-	if to := activity.GetActivityStreamsTo(); to != nil {
-		for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
-			var val *url.URL
-			val, err = ToId(iter)
-			if err != nil {
-				return
-			}
-			r = append(r, val)
-		}
-	}
-	if bto := activity.GetActivityStreamsBto(); bto != nil {
-		for iter := bto.Begin(); iter != bto.End(); iter = iter.Next() {
-			var val *url.URL
-			val, err = ToId(iter)
-			if err != nil {
-				return
-			}
-			r = append(r, val)
-		}
-	}
-	if cc := activity.GetActivityStreamsCc(); cc != nil {
-		for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
-			var val *url.URL
-			val, err = ToId(iter)
-			if err != nil {
-				return
-			}
-			r = append(r, val)
-		}
-	}
-	if bcc := activity.GetActivityStreamsBcc(); bcc != nil {
-		for iter := bcc.Begin(); iter != bcc.End(); iter = iter.Next() {
-			var val *url.URL
-			val, err = ToId(iter)
-			if err != nil {
-				return
-			}
-			r = append(r, val)
-		}
-	}
-	if audience := activity.GetActivityStreamsAudience(); audience != nil {
-		for iter := audience.Begin(); iter != audience.End(); iter = iter.Next() {
-			var val *url.URL
-			val, err = ToId(iter)
-			if err != nil {
-				return
-			}
-			r = append(r, val)
-		}
-	}
-	// 1. When an object is being delivered to the originating actor's
-	//    followers, a server MAY reduce the number of receiving actors
-	//    delivered to by identifying all followers which share the same
-	//    sharedInbox who would otherwise be individual recipients and
-	//    instead deliver objects to said sharedInbox.
-	// 2. If an object is addressed to the Public special collection, a
-	//    server MAY deliver that object to all known sharedInbox endpoints
-	//    on the network.
-	r = filterURLs(r, IsPublic)
-	t, err := a.common.NewTransport(c, outboxIRI, goFedUserAgent())
-	if err != nil {
-		return nil, err
-	}
-	receiverActors, err := a.resolveInboxes(c, t, r, 0, a.s2s.MaxDeliveryRecursionDepth(c))
-	if err != nil {
-		return nil, err
-	}
-	targets, err := getInboxes(receiverActors)
-	if err != nil {
-		return nil, err
-	}
-	// Get inboxes of sender.
+	// Get the sender.
 	err = a.db.Lock(c, outboxIRI)
 	if err != nil {
 		return
@@ -700,110 +962,43 @@ func (a *sideEffectActor) prepare(c context.Context, outboxIRI *url.URL, activit
 		return
 	}
 	a.db.Unlock(c, outboxIRI)
-	// Get the inbox on the sender.
-	err = a.db.Lock(c, actorIRI)
+	// Do not deliver to actors blocked by the sender, whether addressed
+	// directly or through a collection.
+	blocked, err := blockedIRIs(c, a.db, actorIRI)
 	if err != nil {
 		return nil, err
 	}
-	// BEGIN LOCK
-	thisActor, err := a.db.Get(c, actorIRI)
-	a.db.Unlock(c, actorIRI)
-	// END LOCK -- Still need to handle err
+	exclude := make([]*url.URL, 0, len(blocked))
+	for s := range blocked {
+		var u *url.URL
+		if u, err = url.Parse(s); err != nil {
+			return nil, err
+		}
+		exclude = append(exclude, u)
+	}
+	t, err := a.common.NewTransport(c, outboxIRI, goFedUserAgent())
 	if err != nil {
 		return nil, err
 	}
-	// Post-processing
-	var ignore *url.URL
-	ignore, err = getInbox(thisActor)
+	// 1. When an object is being delivered to the originating actor's
+	//    followers, a server MAY reduce the number of receiving actors
+	//    delivered to by identifying all followers which share the same
+	//    sharedInbox who would otherwise be individual recipients and
+	//    instead deliver objects to said sharedInbox.
+	// 2. If an object is addressed to the Public special collection, a
+	//    server MAY deliver that object to all known sharedInbox endpoints
+	//    on the network.
+	resolver := AudienceResolver{
+		Transport:      t,
+		Database:       a.db,
+		MaxDepth:       a.s2s.MaxDeliveryRecursionDepth(c),
+		UseSharedInbox: a.useSharedInbox,
+		Exclude:        exclude,
+	}
+	r, err = resolver.Resolve(c, activity, actorIRI)
 	if err != nil {
 		return nil, err
 	}
-	r = dedupeIRIs(targets, []*url.URL{ignore})
 	stripHiddenRecipients(activity)
 	return r, nil
 }
-
-// resolveInboxes takes a list of Actor id URIs and returns them as concrete
-// instances of actorObject. It attempts to apply recursively when it encounters
-// a target that is a Collection or OrderedCollection.
-//
-// If maxDepth is zero or negative, then recursion is infinitely applied.
-//
-// If a recipient is a Collection or OrderedCollection, then the server MUST
-// dereference the collection, WITH the user's credentials.
-//
-// Note that this also applies to CollectionPage and OrderedCollectionPage.
-func (a *sideEffectActor) resolveInboxes(c context.Context, t Transport, r []*url.URL, depth, maxDepth int) (actors []vocab.Type, err error) {
-	if maxDepth > 0 && depth >= maxDepth {
-		return
-	}
-	for _, u := range r {
-		var act vocab.Type
-		var more []*url.URL
-		// TODO: Determine if more logic is needed here for inaccessible
-		// collections owned by peer servers.
-		act, more, err = a.dereferenceForResolvingInboxes(c, t, u)
-		if err != nil {
-			return
-		}
-		var recurActors []vocab.Type
-		recurActors, err = a.resolveInboxes(c, t, more, depth+1, maxDepth)
-		if err != nil {
-			return
-		}
-		if act != nil {
-			actors = append(actors, act)
-		}
-		actors = append(actors, recurActors...)
-	}
-	return
-}
-
-// dereferenceForResolvingInboxes dereferences an IRI solely for finding an
-// actor's inbox IRI to deliver to.
-//
-// The returned actor could be nil, if it wasn't an actor (ex: a Collection or
-// OrderedCollection).
-func (a *sideEffectActor) dereferenceForResolvingInboxes(c context.Context, t Transport, actorIRI *url.URL) (actor vocab.Type, moreActorIRIs []*url.URL, err error) {
-	var resp []byte
-	resp, err = t.Dereference(c, actorIRI)
-	if err != nil {
-		return
-	}
-	var m map[string]interface{}
-	if err = json.Unmarshal(resp, &m); err != nil {
-		return
-	}
-	actor, err = streams.ToType(c, m)
-	if err != nil {
-		return
-	}
-	// Attempt to see if the 'actor' is really some sort of type that has
-	// an 'items' or 'orderedItems' property.
-	if v, ok := actor.(itemser); ok {
-		if i := v.GetActivityStreamsItems(); i != nil {
-			for iter := i.Begin(); iter != i.End(); iter = iter.Next() {
-				var id *url.URL
-				id, err = ToId(iter)
-				if err != nil {
-					return
-				}
-				moreActorIRIs = append(moreActorIRIs, id)
-			}
-		}
-		actor = nil
-	} else if v, ok := actor.(orderedItemser); ok {
-		if i := v.GetActivityStreamsOrderedItems(); i != nil {
-			for iter := i.Begin(); iter != i.End(); iter = iter.Next() {
-				var id *url.URL
-				id, err = ToId(iter)
-				if err != nil {
-					return
-				}
-				moreActorIRIs = append(moreActorIRIs, id)
-			}
-		}
-		actor = nil
-	}
-	return
-}
@@ -2,8 +2,11 @@ package pub
 
 import (
 	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/golang/mock/gomock"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
@@ -112,7 +115,7 @@ func TestPassThroughMethods(t *testing.T) {
 }
 
 // TestAuthorizePostInbox tests the Authorization for a federated message, which
-// is only based on blocks.
+// is based on blocks and the inbox filter.
 func TestAuthorizePostInbox(t *testing.T) {
 	ctx := context.Background()
 	resp := httptest.NewRecorder()
@@ -181,12 +184,62 @@ func TestAuthorizePostInbox(t *testing.T) {
 		assertEqual(t, b, false)
 		assertEqual(t, err, nil)
 	})
+	t.Run("FilterAccepts", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, _, _, a := setupFn(ctl)
+		f := NewMockFilter(ctl)
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		a.(*sideEffectActor).s2s = &testFilteringFederatingProtocol{MockFederatingProtocol: fp, filter: f}
+		f.EXPECT().FilterInbox(ctx, testCreate).Return(FilterAccept(), nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, true)
+		assertEqual(t, err, nil)
+	})
+	t.Run("FilterDrops", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, _, _, a := setupFn(ctl)
+		f := NewMockFilter(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		a.(*sideEffectActor).s2s = &testFilteringFederatingProtocol{MockFederatingProtocol: fp, filter: f}
+		f.EXPECT().FilterInbox(ctx, testCreate).Return(FilterDrop("spam"), nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("FilterRejects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, _, _, a := setupFn(ctl)
+		f := NewMockFilter(ctl)
+		resp := httptest.NewRecorder()
+		fp.EXPECT().Blocked(ctx, []*url.URL{mustParse(testFederatedActorIRI)}).Return(false, nil)
+		a.(*sideEffectActor).s2s = &testFilteringFederatingProtocol{MockFederatingProtocol: fp, filter: f}
+		f.EXPECT().FilterInbox(ctx, testCreate).Return(FilterReject(http.StatusUnprocessableEntity, "abuse"), nil)
+		// Run
+		b, err := a.AuthorizePostInbox(ctx, resp, testCreate)
+		// Verify
+		assertEqual(t, b, false)
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusUnprocessableEntity)
+	})
 }
 
 // TestPostInbox ensures that the main application side effects of receiving a
 // federated message occur.
 func TestPostInbox(t *testing.T) {
 	ctx := context.Background()
+	actorIRI := mustParse(testMyActorIRI)
 	setupFn := func(ctl *gomock.Controller) (c *MockCommonBehavior, fp *MockFederatingProtocol, sp *MockSocialProtocol, db *MockDatabase, cl *MockClock, a DelegateActor) {
 		setupData()
 		c = NewMockCommonBehavior(ctl)
@@ -238,7 +291,135 @@ func TestPostInbox(t *testing.T) {
 		// Run
 		err := a.PostInbox(ctx, inboxIRI, testListen)
 		// Verify
+		assertEqual(t, err, ErrDuplicateActivity)
+	})
+	t.Run("DoesNotDoSideEffectsIfSeenInInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, db, cl, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		seen := NewMemorySeenStore(cl, DefaultSeenTTL)
+		a.(*sideEffectActor).seen = seen
+		cl.EXPECT().Now().Return(now()).Times(2)
+		_, err := seen.MarkSeen(ctx, inboxActivityId(inboxIRI, mustParse(testFederatedActivityIRI)))
 		assertEqual(t, err, nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err = a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, ErrDuplicateActivity)
+	})
+	t.Run("DoesSideEffectsInEachInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, db, cl, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		otherInboxIRI := mustParse("https://example.com/sam/inbox")
+		a.(*sideEffectActor).seen = NewMemorySeenStore(cl, DefaultSeenTTL)
+		cl.EXPECT().Now().Return(now()).Times(2)
+		for _, iri := range []*url.URL{inboxIRI, otherInboxIRI} {
+			gomock.InOrder(
+				db.EXPECT().Lock(ctx, iri),
+				db.EXPECT().InboxContains(ctx, iri, mustParse(testFederatedActivityIRI)).Return(false, nil),
+				db.EXPECT().GetInbox(ctx, iri).Return(streams.NewActivityStreamsOrderedCollectionPage(), nil),
+				db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+				db.EXPECT().Unlock(ctx, iri),
+			)
+		}
+		calls := 0
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, []interface{}{
+			func(c context.Context, a vocab.ActivityStreamsListen) error {
+				calls++
+				return nil
+			},
+		}, nil).Times(2)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		otherErr := a.PostInbox(ctx, otherInboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, otherErr, nil)
+		assertEqual(t, calls, 2)
+	})
+	t.Run("ForgetsSeenActivityIfSideEffectsFail", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, db, cl, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		seen := NewMemorySeenStore(cl, DefaultSeenTTL)
+		a.(*sideEffectActor).seen = seen
+		cl.EXPECT().Now().Return(now()).Times(2)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, []interface{}{
+			func(c context.Context, a vocab.ActivityStreamsListen) error {
+				return testErr
+			},
+		}, nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, testErr)
+		again, err := seen.MarkSeen(ctx, inboxActivityId(inboxIRI, mustParse(testFederatedActivityIRI)))
+		assertEqual(t, err, nil)
+		assertEqual(t, again, false)
+	})
+	t.Run("DoesNotDeliverIfSideEffectsFail", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, db, _, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		follow := streams.NewActivityStreamsFollow()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testFederatedActivityIRI))
+		follow.SetActivityStreamsId(id)
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(mustParse(testFederatedActorIRI))
+		follow.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(actorIRI)
+		follow.SetActivityStreamsObject(op)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().OutboxForInbox(ctx, inboxIRI).Return(mustParse(testMyOutboxIRI), nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().NewId(ctx, gomock.Any()).Return(mustParse(testNewActivityIRI), nil),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{
+			FollowPolicy: func(c context.Context, f vocab.ActivityStreamsFollow) (FollowDecision, error) {
+				return FollowReject, nil
+			},
+			Follow: func(c context.Context, f vocab.ActivityStreamsFollow) error {
+				return testErr
+			},
+		}, nil, nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, follow)
+		// Verify
+		assertEqual(t, err, testErr)
 	})
 	t.Run("AddsToInbox", func(t *testing.T) {
 		// Setup
@@ -341,6 +522,29 @@ func TestPostInbox(t *testing.T) {
 		assertEqual(t, err, nil)
 		assertEqual(t, pass, true)
 	})
+	t.Run("DropsIfActorIsBlocked", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, db, _, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		blocks := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(mustParse(testFederatedActorIRI))
+		blocks.SetActivityStreamsItems(items)
+		a.(*sideEffectActor).db = &testBlocksDatabase{MockDatabase: db, blocks: blocks}
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, ErrBlocked)
+	})
 }
 
 // TestInboxForwarding ensures that the inbox forwarding logic is correct.
@@ -557,7 +761,7 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
-			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
+			fp.EXPECT().DereferencePolicy(ctx).Return(NewHostDereferencePolicy(nil, 0)),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
 			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(false, nil),
@@ -589,6 +793,7 @@ func TestInboxForwarding(t *testing.T) {
 		input := mustAddTagIds(
 			mustAddAudienceIds(testListen))
 		tPort := NewMockTransport(ctl)
+		rPort := NewMockTransport(ctl)
 		gomock.InOrder(
 			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
 			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
@@ -604,7 +809,7 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
-			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
+			fp.EXPECT().DereferencePolicy(ctx).Return(NewHostDereferencePolicy(nil, 0)),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
 			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(true, nil),
@@ -623,14 +828,25 @@ func TestInboxForwarding(t *testing.T) {
 				},
 				nil,
 			),
+			// Resolve member inboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(rPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(0),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI3)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI3)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI3)),
+			rPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(mustActorBytes(testFederatedActorIRI3), nil),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI4)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI4)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI4)),
+			rPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(mustActorBytes(testFederatedActorIRI4), nil),
 			// deliverToRecipients
 			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
 			tPort.EXPECT().BatchDeliver(
 				ctx,
 				mustSerializeToBytes(input),
 				[]*url.URL{
-					mustParse(testFederatedActorIRI3),
-					mustParse(testFederatedActorIRI4),
+					mustParse(testFederatedActorIRI3 + "/inbox"),
+					mustParse(testFederatedActorIRI4 + "/inbox"),
 				},
 			),
 			// Deferred
@@ -642,6 +858,51 @@ func TestInboxForwarding(t *testing.T) {
 		// Verify
 		assertEqual(t, err, nil)
 	})
+	t.Run("DoesNotForwardIfVetoed", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, fp, _, db, _, a := setupFn(ctl)
+		input := mustAddTagIds(
+			mustAddAudienceIds(testListen))
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().Create(ctx, input).Return(nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActivityIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Owns(ctx, mustParse(testAudienceIRI2)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
+			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
+			fp.EXPECT().DereferencePolicy(ctx).Return(NewHostDereferencePolicy(nil, 0)),
+			// hasInboxForwardingValues
+			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
+			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testTagIRI)),
+			// after hasInboxForwardingValues
+			fp.EXPECT().FilterForwarding(
+				ctx,
+				[]*url.URL{
+					mustParse(testAudienceIRI),
+					mustParse(testAudienceIRI2),
+				},
+				input,
+			).Return(nil, nil),
+			// Deferred
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI2)),
+			db.EXPECT().Unlock(ctx, mustParse(testAudienceIRI)),
+		)
+		// Run
+		err := a.InboxForwarding(ctx, mustParse(testMyInboxIRI), input)
+		// Verify
+		assertEqual(t, err, nil)
+	})
 	t.Run("ForwardsToRecipientsIfChainIsNested", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -649,6 +910,7 @@ func TestInboxForwarding(t *testing.T) {
 		cm, fp, _, db, _, a := setupFn(ctl)
 		input := mustAddAudienceIds(testNestedInReplyTo)
 		tPort := NewMockTransport(ctl)
+		rPort := NewMockTransport(ctl)
 		gomock.InOrder(
 			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
 			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
@@ -664,7 +926,7 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
-			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
+			fp.EXPECT().DereferencePolicy(ctx).Return(NewHostDereferencePolicy(nil, 0)),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
 			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
@@ -686,14 +948,25 @@ func TestInboxForwarding(t *testing.T) {
 				},
 				nil,
 			),
+			// Resolve member inboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(rPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(0),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI3)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI3)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI3)),
+			rPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(mustActorBytes(testFederatedActorIRI3), nil),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI4)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI4)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI4)),
+			rPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(mustActorBytes(testFederatedActorIRI4), nil),
 			// deliverToRecipients
 			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
 			tPort.EXPECT().BatchDeliver(
 				ctx,
 				mustSerializeToBytes(input),
 				[]*url.URL{
-					mustParse(testFederatedActorIRI3),
-					mustParse(testFederatedActorIRI4),
+					mustParse(testFederatedActorIRI3 + "/inbox"),
+					mustParse(testFederatedActorIRI4 + "/inbox"),
 				},
 			),
 			// Deferred
@@ -715,6 +988,7 @@ func TestInboxForwarding(t *testing.T) {
 		tagTPort := NewMockTransport(ctl)
 		tagTPort2 := NewMockTransport(ctl)
 		tPort := NewMockTransport(ctl)
+		rPort := NewMockTransport(ctl)
 		gomock.InOrder(
 			db.EXPECT().Lock(ctx, mustParse(testFederatedActivityIRI)),
 			db.EXPECT().Exists(ctx, mustParse(testFederatedActivityIRI)).Return(false, nil),
@@ -730,7 +1004,7 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
-			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(0),
+			fp.EXPECT().DereferencePolicy(ctx).Return(NewHostDereferencePolicy(nil, 0)),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testTagIRI)),
 			db.EXPECT().Owns(ctx, mustParse(testTagIRI)).Return(false, nil),
@@ -762,14 +1036,25 @@ func TestInboxForwarding(t *testing.T) {
 				},
 				nil,
 			),
+			// Resolve member inboxes
+			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(rPort, nil),
+			fp.EXPECT().MaxDeliveryRecursionDepth(ctx).Return(0),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI3)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI3)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI3)),
+			rPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI3)).Return(mustActorBytes(testFederatedActorIRI3), nil),
+			db.EXPECT().Lock(ctx, mustParse(testFederatedActorIRI4)),
+			db.EXPECT().Owns(ctx, mustParse(testFederatedActorIRI4)).Return(false, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testFederatedActorIRI4)),
+			rPort.EXPECT().Dereference(ctx, mustParse(testFederatedActorIRI4)).Return(mustActorBytes(testFederatedActorIRI4), nil),
 			// deliverToRecipients
 			cm.EXPECT().NewTransport(ctx, mustParse(testMyInboxIRI), goFedUserAgent()).Return(tPort, nil),
 			tPort.EXPECT().BatchDeliver(
 				ctx,
 				mustSerializeToBytes(input),
 				[]*url.URL{
-					mustParse(testFederatedActorIRI3),
-					mustParse(testFederatedActorIRI4),
+					mustParse(testFederatedActorIRI3 + "/inbox"),
+					mustParse(testFederatedActorIRI4 + "/inbox"),
 				},
 			),
 			// Deferred
@@ -802,7 +1087,7 @@ func TestInboxForwarding(t *testing.T) {
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI)).Return(testOrderedCollectionOfActors, nil),
 			db.EXPECT().Lock(ctx, mustParse(testAudienceIRI2)),
 			db.EXPECT().Get(ctx, mustParse(testAudienceIRI2)).Return(testCollectionOfActors, nil),
-			fp.EXPECT().MaxInboxForwardingRecursionDepth(ctx).Return(1),
+			fp.EXPECT().DereferencePolicy(ctx).Return(NewHostDereferencePolicy(nil, 1)),
 			// hasInboxForwardingValues
 			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
 			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(false, nil),
@@ -1045,6 +1330,32 @@ func TestAddNewIds(t *testing.T) {
 		assertNotEqual(t, noteId, nil)
 		assertEqual(t, noteId.Get().String(), mustParse(testNewActivityIRI3).String())
 	})
+	t.Run("AddsMissingIdsToAttachmentsIfCreateActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, db, _, a := setupFn(ctl)
+		image := streams.NewActivityStreamsImage()
+		document := streams.NewActivityStreamsDocument()
+		documentId := streams.NewActivityStreamsIdProperty()
+		documentId.Set(mustParse(testNoteId2))
+		document.SetActivityStreamsId(documentId)
+		attachment := streams.NewActivityStreamsAttachmentProperty()
+		attachment.AppendActivityStreamsImage(image)
+		attachment.AppendActivityStreamsDocument(document)
+		testMyNote.SetActivityStreamsAttachment(attachment)
+		gomock.InOrder(
+			db.EXPECT().NewId(ctx, testMyCreate).Return(mustParse(testNewActivityIRI2), nil),
+			db.EXPECT().NewId(ctx, testMyNote).Return(mustParse(testNewActivityIRI3), nil),
+			db.EXPECT().NewId(ctx, image).Return(mustParse(testNoteId1), nil),
+		)
+		// Run
+		err := a.AddNewIds(ctx, testMyCreate)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, image.GetActivityStreamsId().Get().String(), testNoteId1)
+		assertEqual(t, document.GetActivityStreamsId().Get().String(), testNoteId2)
+	})
 	t.Run("DoesNotAddIdsToObjectsIfNotCreateActivity", func(t *testing.T) {
 		// Setup
 		ctl := gomock.NewController(t)
@@ -1115,6 +1426,92 @@ func TestDeliver(t *testing.T) {
 	})
 }
 
+// testRecipientsOverrider is a CommonBehavior overriding the recipients of
+// deliveries with a function.
+type testRecipientsOverrider struct {
+	*MockCommonBehavior
+	override func(inboxes []*url.URL) ([]*url.URL, error)
+}
+
+func (o *testRecipientsOverrider) OverrideRecipients(c context.Context, boxIRI *url.URL, activity Activity, inboxes []*url.URL) ([]*url.URL, error) {
+	return o.override(inboxes)
+}
+
+// TestDeliverToRecipients ensures a RecipientsOverrider changes the inboxes
+// an activity is delivered to.
+func TestDeliverToRecipients(t *testing.T) {
+	ctx := context.Background()
+	outboxIRI := mustParse(testMyOutboxIRI)
+	mirrorIRI := mustParse("https://mirror.example.com/inbox")
+	setupFn := func(ctl *gomock.Controller, override func(inboxes []*url.URL) ([]*url.URL, error)) (c *MockCommonBehavior, tp *MockTransport, a *sideEffectActor) {
+		setupData()
+		c = NewMockCommonBehavior(ctl)
+		tp = NewMockTransport(ctl)
+		a = &sideEffectActor{
+			common: &testRecipientsOverrider{
+				MockCommonBehavior: c,
+				override:           override,
+			},
+		}
+		return
+	}
+	t.Run("AddsAndRemovesRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		c, tp, a := setupFn(ctl, func(inboxes []*url.URL) ([]*url.URL, error) {
+			return append(inboxes[1:], mirrorIRI, mirrorIRI), nil
+		})
+		gomock.InOrder(
+			c.EXPECT().NewTransport(ctx, outboxIRI, goFedUserAgent()).Return(tp, nil),
+			tp.EXPECT().BatchDeliver(
+				ctx,
+				mustSerializeToBytes(testMyListen),
+				[]*url.URL{
+					mustParse(testFederatedActorIRI2 + "/inbox"),
+					mirrorIRI,
+				},
+			),
+		)
+		// Run
+		err := a.deliverToRecipients(ctx, outboxIRI, testMyListen, []*url.URL{
+			mustParse(testFederatedActorIRI + "/inbox"),
+			mustParse(testFederatedActorIRI2 + "/inbox"),
+		})
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("SkipsDeliveryWithoutRecipients", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, a := setupFn(ctl, func(inboxes []*url.URL) ([]*url.URL, error) {
+			return nil, nil
+		})
+		// Run
+		err := a.deliverToRecipients(ctx, outboxIRI, testMyListen, []*url.URL{
+			mustParse(testFederatedActorIRI + "/inbox"),
+		})
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("AbortsOnError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		testErr := errors.New("test error")
+		_, _, a := setupFn(ctl, func(inboxes []*url.URL) ([]*url.URL, error) {
+			return nil, testErr
+		})
+		// Run
+		err := a.deliverToRecipients(ctx, outboxIRI, testMyListen, []*url.URL{
+			mustParse(testFederatedActorIRI + "/inbox"),
+		})
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+}
+
 // TestWrapInCreate ensures an object received by the Social Protocol is
 // properly wrapped in a Create Activity.
 func TestWrapInCreate(t *testing.T) {
@@ -1143,3 +1540,36 @@ func TestWrapInCreate(t *testing.T) {
 		t.Errorf("Not yet implemented.")
 	})
 }
+
+// testSharedInboxFederatingProtocol is a FederatingProtocol that is a
+// SharedInboxPolicy.
+type testSharedInboxFederatingProtocol struct {
+	*MockFederatingProtocol
+	use bool
+}
+
+func (p *testSharedInboxFederatingProtocol) UseSharedInbox(c context.Context, host string) bool {
+	return p.use
+}
+
+// TestUseSharedInbox ensures deliveries are collapsed into sharedInbox
+// endpoints unless the FederatingProtocol is a SharedInboxPolicy refusing it.
+func TestUseSharedInbox(t *testing.T) {
+	ctx := context.Background()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	a := &sideEffectActor{s2s: NewMockFederatingProtocol(ctl)}
+	assertEqual(t, a.useSharedInbox(ctx, "other.example.com"), true)
+	a.s2s = &testSharedInboxFederatingProtocol{MockFederatingProtocol: NewMockFederatingProtocol(ctl)}
+	assertEqual(t, a.useSharedInbox(ctx, "other.example.com"), false)
+}
+
+// testFilteringFederatingProtocol is a FederatingProtocol applying a Filter.
+type testFilteringFederatingProtocol struct {
+	*MockFederatingProtocol
+	filter Filter
+}
+
+func (p *testFilteringFederatingProtocol) InboxFilter(c context.Context) Filter {
+	return p.filter
+}
@@ -0,0 +1,42 @@
+package pub
+
+import (
+	"net/http"
+)
+
+// Middleware wraps the handler so that each request must be authenticated as
+// by AuthenticateGet before it is handled, such as for custom endpoints served
+// outside of an Actor's handlers. The IRI of the signer, if any, is stored in
+// the context of the request passed on, and may be obtained with
+// SignerFromContext.
+//
+// Requests of any method are verified. The digest of a request's body is
+// checked as configured by the Digest policy, so that a signed request cannot
+// be replayed with a different body. A body too large to be checked is
+// refused with a http.StatusRequestEntityTooLarge.
+//
+// Errors from the callbacks are logged to the Logger carried by the context
+// of the request, and result in a response with the status of ErrorStatus.
+func (a *AuthorizedFetch) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := r.Context()
+		if err := a.Digest.Verify(c, r); err != nil {
+			logEntry(c, LogLevelInfo, "digest refused", errorLogField(err))
+			if IsErr(err, ErrBodyTooLarge) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+			} else {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+			return
+		}
+		out, authenticated, err := a.AuthenticateGet(c, w, r)
+		if err != nil {
+			logEntry(c, LogLevelError, "authenticating request failed", errorLogField(err))
+			w.WriteHeader(ErrorStatus(err))
+			return
+		} else if !authenticated {
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(out))
+	})
+}
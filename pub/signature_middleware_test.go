@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSignatureMiddleware(t *testing.T) {
+	keyId := testFederatedActorIRI + "#main-key"
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &AuthorizedFetch{
+		GetPublicKey: func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+			return privKey.Public(), httpsig.RSA_SHA256, mustParse(testFederatedActorIRI), nil
+		},
+	}
+	var signer *url.URL
+	handled := false
+	h := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handled = true
+		signer, _ = SignerFromContext(r.Context())
+	}))
+	newSignedPost := func(body, digestOf string, headers ...string) *http.Request {
+		r := httptest.NewRequest("POST", testNoteId1, strings.NewReader(body))
+		r.Header.Set("Date", nowDateHeader())
+		d, err := digest(DigestSHA256, []byte(digestOf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Digest", d)
+		if len(headers) == 0 {
+			headers = DefaultPostHeaders
+		}
+		s, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, headers, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = s.SignRequest(privKey, keyId, r, []byte(body)); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	t.Run("PassesSigner", func(t *testing.T) {
+		// Setup
+		handled, signer = false, nil
+		resp := httptest.NewRecorder()
+		// Run
+		h.ServeHTTP(resp, newSignedPost("{}", "{}"))
+		// Verify
+		assertEqual(t, resp.Code, http.StatusOK)
+		assertEqual(t, handled, true)
+		assertEqual(t, signer.String(), testFederatedActorIRI)
+	})
+	t.Run("RejectsUnsigned", func(t *testing.T) {
+		// Setup
+		handled = false
+		resp := httptest.NewRecorder()
+		// Run
+		h.ServeHTTP(resp, mustSignedGetRequest(testNoteId1, keyId, nil))
+		// Verify
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, handled, false)
+	})
+	t.Run("RejectsMismatchedDigest", func(t *testing.T) {
+		// Setup
+		handled = false
+		resp := httptest.NewRecorder()
+		// Run
+		h.ServeHTTP(resp, newSignedPost(`{"type":"Delete"}`, "{}"))
+		// Verify
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, handled, false)
+	})
+	t.Run("RejectsUnsignedDigest", func(t *testing.T) {
+		// Setup
+		handled = false
+		resp := httptest.NewRecorder()
+		// Run
+		h.ServeHTTP(resp, newSignedPost(`{"type":"Delete"}`, `{"type":"Delete"}`, httpsig.RequestTarget, "date"))
+		// Verify
+		assertEqual(t, resp.Code, http.StatusUnauthorized)
+		assertEqual(t, handled, false)
+	})
+}
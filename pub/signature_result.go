@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SignatureResult describes the verified HTTP Signature of a request, so that
+// applications may log it, enforce policies on algorithms, or pin the keys of
+// actors.
+type SignatureResult struct {
+	// Signer is the IRI of the actor owning the key.
+	Signer *url.URL
+	// KeyId is the id of the key the request was signed with.
+	KeyId *url.URL
+	// PublicKey is the key the signature was verified with.
+	PublicKey crypto.PublicKey
+	// Algorithm is the algorithm the signature claims, such as
+	// "rsa-sha256" or "hs2019", or the one obtained with the key if it
+	// claims none.
+	Algorithm httpsig.Algorithm
+	// Headers are the headers, pseudo-headers, or components covered by
+	// the signature, in the order signed.
+	Headers []string
+	// Created and Expires are the times of the 'created' and 'expires'
+	// parameters of the signature, and are zero if it has none.
+	Created time.Time
+	Expires time.Time
+	// MessageSignature is true for an RFC 9421 HTTP Message Signature, and
+	// false for a cavage draft HTTP Signature.
+	MessageSignature bool
+}
+
+// SignatureCheck decides whether a verified HTTP Signature is acceptable, such
+// as to refuse weak algorithms or keys other than those pinned for the
+// signer. The signature is rejected as an ErrBadSignature if an error is
+// returned. Errors already wrapping ErrBadSignature, such as a
+// KeyChangedError, are returned as they are.
+type SignatureCheck func(c context.Context, r *SignatureResult) error
+
+// newSignatureResult describes the signature of the request made with the key,
+// which was obtained with the algorithm and is owned by the actor.
+func newSignatureResult(r *http.Request, keyId *url.URL, pubKey crypto.PublicKey, algo httpsig.Algorithm, owner *url.URL) *SignatureResult {
+	res := &SignatureResult{
+		Signer:           owner,
+		KeyId:            keyId,
+		PublicKey:        pubKey,
+		Algorithm:        algo,
+		MessageSignature: isMessageSigned(r),
+	}
+	params := signatureParams(r)
+	if res.MessageSignature {
+		if m, err := parseMessageSignature(r); err == nil {
+			res.Headers = m.components
+		}
+		if v, ok := params["alg"]; ok {
+			res.Algorithm = httpsig.Algorithm(v)
+		}
+	} else {
+		if v, ok := params["algorithm"]; ok {
+			res.Algorithm = httpsig.Algorithm(v)
+		}
+		// Signatures without a 'headers' parameter cover the Date header
+		// only, or the Created pseudo-header if they are hs2019.
+		res.Headers = strings.Fields(params["headers"])
+		if len(res.Headers) == 0 && res.Algorithm == HS2019 {
+			res.Headers = []string{Created}
+		} else if len(res.Headers) == 0 {
+			res.Headers = []string{"date"}
+		}
+	}
+	if v, ok := params["created"]; ok {
+		res.Created, _ = parseUnixTime(v)
+	}
+	if v, ok := params["expires"]; ok {
+		res.Expires, _ = parseUnixTime(v)
+	}
+	return res
+}
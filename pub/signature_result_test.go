@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignatureResult(t *testing.T) {
+	ctx := context.Background()
+	keyId := testFederatedActorIRI + "#main-key"
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getKey := func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+		return privKey.Public(), httpsig.RSA_SHA256, mustParse(testFederatedActorIRI), nil
+	}
+	t.Run("DescribesHS2019Signature", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now())
+		r := httptest.NewRequest("POST", testMyInboxIRI, strings.NewReader("{}"))
+		r.Header.Set("Date", nowDateHeader())
+		s := NewHS2019Signer(cl, []string{httpsig.RequestTarget, Created, Expires, "digest"}, time.Minute, httpsig.Signature)
+		if err := s.SignRequest(privKey, keyId, r, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+		a := &AuthorizedFetch{GetPublicKey: getKey}
+		// Run
+		res, err := a.VerifyResult(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, res.Signer.String(), testFederatedActorIRI)
+		assertEqual(t, res.KeyId.String(), keyId)
+		assertEqual(t, res.Algorithm, HS2019)
+		assertEqual(t, strings.Join(res.Headers, " "), "(request-target) (created) (expires) digest")
+		assertEqual(t, res.Created.Unix(), now().Unix())
+		assertEqual(t, res.Expires.Unix(), now().Add(time.Minute).Unix())
+		assertEqual(t, res.MessageSignature, false)
+	})
+	t.Run("DescribesLegacySignature", func(t *testing.T) {
+		// Setup
+		r := mustSignedGetRequest(testNoteId1, keyId, privKey)
+		a := &AuthorizedFetch{GetPublicKey: getKey}
+		// Run
+		res, err := a.VerifyResult(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, res.Algorithm, httpsig.RSA_SHA256)
+		assertEqual(t, strings.Join(res.Headers, " "), "(request-target) date")
+		assertEqual(t, res.Created.IsZero(), true)
+		assertEqual(t, res.Expires.IsZero(), true)
+	})
+	t.Run("RejectsCheckedSignature", func(t *testing.T) {
+		// Setup
+		r := mustSignedGetRequest(testNoteId1, keyId, privKey)
+		a := &AuthorizedFetch{
+			GetPublicKey: getKey,
+			CheckSignature: func(c context.Context, res *SignatureResult) error {
+				if res.Algorithm != HS2019 {
+					return errors.New("algorithm not allowed")
+				}
+				return nil
+			},
+		}
+		// Run
+		signer, err := a.Verify(ctx, r)
+		// Verify
+		assertEqual(t, signer, (*url.URL)(nil))
+		assertEqual(t, IsErr(err, ErrBadSignature), true)
+	})
+	t.Run("NoResultIfUnsigned", func(t *testing.T) {
+		// Setup
+		r := mustSignedGetRequest(testNoteId1, keyId, nil)
+		a := &AuthorizedFetch{GetPublicKey: getKey}
+		// Run
+		res, err := a.VerifyResult(ctx, r)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, res, (*SignatureResult)(nil))
+	})
+}
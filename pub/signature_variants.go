@@ -0,0 +1,123 @@
+package pub
+
+import (
+	"crypto"
+	"github.com/go-fed/httpsig"
+	"net/http"
+	"sync"
+)
+
+// SignatureVariant is a way of signing requests, such as with an algorithm,
+// a set of signed headers, or an HTTP Signature format, that a
+// double-knocking Transport tries in turn with peers.
+type SignatureVariant struct {
+	// Name identifies the variant in logs.
+	Name string
+	// GetSigner signs GET requests.
+	GetSigner httpsig.Signer
+	// PostSigner signs POST requests.
+	PostSigner httpsig.Signer
+}
+
+// NewDoubleKnockingTransport returns a new Transport like NewHttpSigTransport
+// that signs requests with the variants, in order of preference, as Mastodon
+// does to maximize interoperability.
+//
+// A request is signed with the variant the host is known to accept, or else
+// the first one. If it is refused as Unauthorized, it is retried once signed
+// with the next variant. The variant is remembered for the host if accepted,
+// so that its later requests are signed with it first. There must be at least
+// one variant.
+func NewDoubleKnockingTransport(
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	variants []SignatureVariant,
+	pubKeyId string,
+	privKey crypto.PrivateKey) *HttpSigTransport {
+	h := NewHttpSigTransport(client, appAgent, clock, variants[0].GetSigner, variants[0].PostSigner, pubKeyId, privKey)
+	h.variants = &signatureVariants{
+		variants: variants,
+		mu:       &sync.Mutex{},
+		hosts:    make(map[string]int),
+	}
+	return h
+}
+
+// NewNegotiatingTransport returns a new Transport like NewHttpSigTransport
+// that negotiates the HTTP Signature format with each peer, for the migration
+// from the cavage draft to RFC 9421 HTTP Message Signatures, which both use the
+// Signature header and so cannot sign a request together.
+//
+// Requests are signed with the preferred signers, such as MessageSigners, and
+// double-knock with the fallback signers, such as httpsig or hs2019 Signers,
+// as by NewDoubleKnockingTransport.
+func NewNegotiatingTransport(
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	getSigner, postSigner httpsig.Signer,
+	fallbackGetSigner, fallbackPostSigner httpsig.Signer,
+	pubKeyId string,
+	privKey crypto.PrivateKey) *HttpSigTransport {
+	return NewDoubleKnockingTransport(client, appAgent, clock, []SignatureVariant{
+		{Name: "preferred", GetSigner: getSigner, PostSigner: postSigner},
+		{Name: "fallback", GetSigner: fallbackGetSigner, PostSigner: fallbackPostSigner},
+	}, pubKeyId, privKey)
+}
+
+// signatureVariants holds the variants of a double-knocking HttpSigTransport
+// and the variant each host is known to accept.
+type signatureVariants struct {
+	variants []SignatureVariant
+	// mu guards the signers of the variants and the hosts.
+	mu    *sync.Mutex
+	hosts map[string]int
+}
+
+// accepted returns the index of the variant the host is known to accept, or
+// else the index of the variant to try first.
+func (s *signatureVariants) accepted(host string, first int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i, ok := s.hosts[host]; ok {
+		return i
+	}
+	return first
+}
+
+// setAccepted records the index of the variant the host accepts.
+func (s *signatureVariants) setAccepted(host string, i int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hosts[host] = i
+}
+
+// next returns the index of the variant to retry with after the one at i is
+// refused: the most preferred other variant.
+func (s *signatureVariants) next(i int) int {
+	if i == 0 {
+		return 1
+	}
+	return 0
+}
+
+// signAndDo signs the request made by newRequest with the variant at i and
+// sends it.
+func (s *signatureVariants) signAndDo(client HttpClient, i int, newRequest func() (*http.Request, error), post bool, body []byte, pubKeyId string, privKey crypto.PrivateKey) (*http.Response, error) {
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	if !post {
+		err = s.variants[i].GetSigner.SignRequest(privKey, pubKeyId, req, nil)
+	} else {
+		err = s.variants[i].PostSigner.SignRequest(privKey, pubKeyId, req, body)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
@@ -0,0 +1,102 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDoubleKnockingTransport(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setupFn := func(ctl *gomock.Controller) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		rsaSigner, _, err := httpsig.NewSigner([]httpsig.Algorithm{httpsig.RSA_SHA256}, []string{httpsig.RequestTarget, "date"}, httpsig.Signature)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hs2019Signer := NewHS2019Signer(cl, []string{httpsig.RequestTarget, Created}, 0, httpsig.Signature)
+		tp = NewDoubleKnockingTransport(client, "test", cl, []SignatureVariant{
+			{Name: "hs2019", GetSigner: hs2019Signer, PostSigner: hs2019Signer},
+			{Name: "rsa-sha256", GetSigner: rsaSigner, PostSigner: rsaSigner},
+		}, testMyActorIRI+"#main-key", privKey)
+		return
+	}
+	// expectAlgorithm expects a request signed with the algorithm, and
+	// responds with the status.
+	expectAlgorithm := func(client *MockHttpClient, algo string, status int) *gomock.Call {
+		return client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, signatureParams(r)["algorithm"], algo)
+			return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+	}
+	t.Run("SignsWithMostPreferred", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		expectAlgorithm(client, "hs2019", http.StatusAccepted)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("RetriesAndRemembersVariant", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		gomock.InOrder(
+			expectAlgorithm(client, "hs2019", http.StatusUnauthorized),
+			expectAlgorithm(client, "rsa-sha256", http.StatusAccepted),
+			expectAlgorithm(client, "rsa-sha256", http.StatusAccepted),
+		)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		err = tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("RetriesRememberedVariantWithMostPreferred", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		gomock.InOrder(
+			expectAlgorithm(client, "hs2019", http.StatusUnauthorized),
+			expectAlgorithm(client, "rsa-sha256", http.StatusAccepted),
+			expectAlgorithm(client, "rsa-sha256", http.StatusUnauthorized),
+			expectAlgorithm(client, "hs2019", http.StatusAccepted),
+			expectAlgorithm(client, "hs2019", http.StatusAccepted),
+		)
+		// Run
+		for i := 0; i < 3; i++ {
+			err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+			// Verify
+			assertEqual(t, err, nil)
+		}
+	})
+	t.Run("DoesNotRetryOtherFailures", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		expectAlgorithm(client, "hs2019", http.StatusForbidden)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err.(*HttpStatusError).StatusCode, http.StatusForbidden)
+	})
+}
@@ -0,0 +1,88 @@
+package pub
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-fed/httpsig"
+)
+
+// DigestAlgorithm is an algorithm of the Digest header of RFC 3230 and RFC
+// 5843.
+type DigestAlgorithm string
+
+const (
+	// DigestSHA256 is the SHA-256 digest algorithm, which all peers
+	// support.
+	DigestSHA256 DigestAlgorithm = sha256Digest
+	// DigestSHA512 is the SHA-512 digest algorithm.
+	DigestSHA512 DigestAlgorithm = "SHA-512"
+)
+
+var (
+	// DefaultGetHeaders are the headers covered by the HTTP Signatures of
+	// GET requests by default.
+	DefaultGetHeaders = []string{httpsig.RequestTarget, "date"}
+	// DefaultPostHeaders are the headers covered by the HTTP Signatures of
+	// POST requests by default.
+	DefaultPostHeaders = []string{httpsig.RequestTarget, "date", "digest"}
+)
+
+// SigningOptions configure the HTTP Signatures of the requests of a Transport,
+// such as to satisfy strict verifiers.
+type SigningOptions struct {
+	// GetHeaders are the headers covered by the signatures of GET
+	// requests, such as "(request-target)", "host", and "date". If empty,
+	// DefaultGetHeaders are covered.
+	GetHeaders []string
+	// PostHeaders are the headers covered by the signatures of POST
+	// requests, which should include "digest". If empty,
+	// DefaultPostHeaders are covered.
+	PostHeaders []string
+	// DigestAlgorithm is the algorithm of the Digest header added to POST
+	// requests. If empty, DigestSHA256 is used.
+	DigestAlgorithm DigestAlgorithm
+}
+
+// newSigners returns the signers of GET and POST requests signing with the
+// algorithm, covering the headers of the options.
+func (o SigningOptions) newSigners(algo httpsig.Algorithm) (getSigner, postSigner httpsig.Signer, err error) {
+	getHeaders, postHeaders := o.GetHeaders, o.PostHeaders
+	if len(getHeaders) == 0 {
+		getHeaders = DefaultGetHeaders
+	}
+	if len(postHeaders) == 0 {
+		postHeaders = DefaultPostHeaders
+	}
+	algs := []httpsig.Algorithm{algo}
+	if getSigner, _, err = httpsig.NewSigner(algs, getHeaders, httpsig.Signature); err != nil {
+		return
+	}
+	postSigner, _, err = httpsig.NewSigner(algs, postHeaders, httpsig.Signature)
+	return
+}
+
+// digestAlgorithm returns the digest algorithm of the options.
+func (o SigningOptions) digestAlgorithm() DigestAlgorithm {
+	if len(o.DigestAlgorithm) == 0 {
+		return DigestSHA256
+	}
+	return o.DigestAlgorithm
+}
+
+// digest returns the value of the Digest header of the content.
+func digest(algo DigestAlgorithm, content []byte) (string, error) {
+	var sum []byte
+	switch algo {
+	case DigestSHA256:
+		h := sha256.Sum256(content)
+		sum = h[:]
+	case DigestSHA512:
+		h := sha512.Sum512(content)
+		sum = h[:]
+	default:
+		return "", fmt.Errorf("unsupported digest algorithm %q", algo)
+	}
+	return string(algo) + digestDelimiter + base64.StdEncoding.EncodeToString(sum), nil
+}
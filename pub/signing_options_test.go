@@ -0,0 +1,84 @@
+package pub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSigningOptions(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := NewActorKeys(mustParse(testMyActorIRI), ActorKey{Id: mustParse(testMyActorIRI + "#main-key"), PrivateKey: privKey})
+	setupFn := func(ctl *gomock.Controller, opts SigningOptions) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp, err := keys.NewTransportWithOptions(client, "test", cl, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	// expectHeaders expects a request signed covering the headers, and
+	// returns its Digest header through the pointer.
+	expectHeaders := func(client *MockHttpClient, headers string, digest *string) {
+		client.EXPECT().Do(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, signatureParams(r)["headers"], headers)
+			*digest = r.Header.Get(digestHeader)
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+		})
+	}
+	t.Run("SignsDefaultHeadersWithSHA256Digest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl, SigningOptions{})
+		var d string
+		expectHeaders(client, "(request-target) date digest", &d)
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, d, "SHA-256=RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o=")
+	})
+	t.Run("SignsConfiguredHeadersWithSHA512Digest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl, SigningOptions{
+			GetHeaders:      []string{"(request-target)", "accept", "date"},
+			PostHeaders:     []string{"(request-target)", "content-type", "date", "digest"},
+			DigestAlgorithm: DigestSHA512,
+		})
+		var d string
+		expectHeaders(client, "(request-target) content-type date digest", &d)
+		expectHeaders(client, "(request-target) accept date", new(string))
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		assertEqual(t, err, nil)
+		_, err = tp.Dereference(ctx, mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, strings.HasPrefix(d, "SHA-512="), true)
+		assertEqual(t, len(d), len("SHA-512=")+88)
+	})
+	t.Run("RefusesUnsupportedDigest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp := setupFn(ctl, SigningOptions{DigestAlgorithm: "MD5"})
+		// Run
+		err := tp.Deliver(ctx, []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
@@ -0,0 +1,116 @@
+package pub
+
+import (
+	"bytes"
+	"crypto"
+	"github.com/go-fed/httpsig"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// SigningRoundTripper is an http.RoundTripper signing each request with an
+// HTTP Signature on behalf of an actor before sending it, so applications may
+// sign requests other than those of a Transport, such as fetching media or
+// calling custom endpoints, with their own http.Client.
+//
+// GET and HEAD requests without a body are signed by the GET signer, and all
+// others by the POST signer after adding a Digest header of their body. A
+// Date header is added to requests lacking one.
+type SigningRoundTripper struct {
+	rt           http.RoundTripper
+	clock        Clock
+	getSigner    httpsig.Signer
+	getSignerMu  sync.Mutex
+	postSigner   httpsig.Signer
+	postSignerMu sync.Mutex
+	pubKeyId     string
+	privKey      crypto.PrivateKey
+	digest       DigestAlgorithm
+}
+
+// SigningRoundTripper must satisfy the http.RoundTripper interface.
+var _ http.RoundTripper = &SigningRoundTripper{}
+
+// NewSigningRoundTripper wraps the RoundTripper so its requests are signed
+// with the private key, whose public key has the id. If rt is nil,
+// http.DefaultTransport sends the requests. Bodies are digested with
+// DigestSHA256.
+func NewSigningRoundTripper(
+	rt http.RoundTripper,
+	clock Clock,
+	getSigner, postSigner httpsig.Signer,
+	pubKeyId string,
+	privKey crypto.PrivateKey) *SigningRoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &SigningRoundTripper{
+		rt:         rt,
+		clock:      clock,
+		getSigner:  getSigner,
+		postSigner: postSigner,
+		pubKeyId:   pubKeyId,
+		privKey:    privKey,
+		digest:     DigestSHA256,
+	}
+}
+
+// NewSigningRoundTripper wraps the RoundTripper so its requests are signed with
+// the active key as configured by the options. If rt is nil,
+// http.DefaultTransport sends the requests.
+func (a *ActorKeys) NewSigningRoundTripper(rt http.RoundTripper, clock Clock, opts SigningOptions) (*SigningRoundTripper, error) {
+	getSigner, postSigner, err := opts.newSigners(httpsig.RSA_SHA256)
+	if err != nil {
+		return nil, err
+	}
+	active := a.Active()
+	s := NewSigningRoundTripper(rt, clock, getSigner, postSigner, active.Id.String(), active.PrivateKey)
+	s.digest = opts.digestAlgorithm()
+	return s, nil
+}
+
+// RoundTrip signs a copy of the request and sends it. The request itself is
+// not modified, though its body is read and closed.
+func (s *SigningRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	req := r.WithContext(r.Context())
+	req.Header = make(http.Header, len(r.Header))
+	for k, v := range r.Header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+	if len(req.Header.Get("Date")) == 0 {
+		req.Header.Set("Date", s.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+	}
+	var err error
+	if body == nil && (req.Method == http.MethodGet || req.Method == http.MethodHead) {
+		s.getSignerMu.Lock()
+		err = s.getSigner.SignRequest(s.privKey, s.pubKeyId, req, nil)
+		s.getSignerMu.Unlock()
+	} else {
+		if len(req.Header.Get(digestHeader)) == 0 {
+			var d string
+			if d, err = digest(s.digest, body); err != nil {
+				return nil, err
+			}
+			req.Header.Set(digestHeader, d)
+		}
+		s.postSignerMu.Lock()
+		err = s.postSigner.SignRequest(s.privKey, s.pubKeyId, req, body)
+		s.postSignerMu.Unlock()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.rt.RoundTrip(req)
+}
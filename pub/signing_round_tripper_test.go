@@ -0,0 +1,96 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// roundTripperFunc is an http.RoundTripper calling itself.
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestSigningRoundTripper(t *testing.T) {
+	ctx := context.Background()
+	keyId := testMyActorIRI + "#main-key"
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := NewActorKeys(mustParse(testMyActorIRI), ActorKey{Id: mustParse(keyId), PrivateKey: privKey})
+	getKey := func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+		return privKey.Public(), httpsig.RSA_SHA256, mustParse(testMyActorIRI), nil
+	}
+	setupFn := func(ctl *gomock.Controller, rt roundTripperFunc) *http.Client {
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		s, err := keys.NewSigningRoundTripper(rt, cl, SigningOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Client{Transport: s}
+	}
+	ok := func() *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}
+	}
+	t.Run("SignsGet", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var signer *url.URL
+		var verr error
+		client := setupFn(ctl, func(r *http.Request) (*http.Response, error) {
+			assertEqual(t, r.Header.Get("Date"), nowDateHeader())
+			assertEqual(t, signatureParams(r)["headers"], "(request-target) date")
+			signer, verr = verifySignature(ctx, r, getKey, nil, nil, 0)
+			return ok(), nil
+		})
+		req, err := http.NewRequest("GET", testFederatedActorIRI+"/avatar.png", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		resp, err := client.Do(req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.StatusCode, http.StatusOK)
+		assertEqual(t, verr, nil)
+		assertEqual(t, signer.String(), testMyActorIRI)
+		assertEqual(t, req.Header.Get("Signature"), "")
+	})
+	t.Run("SignsPostWithDigest", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		var body string
+		var verr error
+		client := setupFn(ctl, func(r *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body = string(b)
+			assertEqual(t, r.Header.Get("Digest"), "SHA-256=RBNvo1WzZ4oRRq0W9+hknpT7T8If536DEMBg9hyq/4o=")
+			assertEqual(t, signatureParams(r)["headers"], "(request-target) date digest")
+			_, verr = verifySignature(ctx, r, getKey, nil, nil, 0)
+			return ok(), nil
+		})
+		// Run
+		_, err := client.Post(testFederatedActorIRI+"/endpoint", "application/json", strings.NewReader("{}"))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, verr, nil)
+		assertEqual(t, body, "{}")
+	})
+}
@@ -18,14 +18,16 @@ type SocialWrappedCallbacks struct {
 	//
 	// The wrapping callback copies the actor(s) to the 'attributedTo'
 	// property and copies recipients between the Create activity and all
-	// objects. It then saves the entry in the database.
+	// objects. It then saves the entry in the database, and adds objects
+	// in reply to an object owned by this server to its 'replies'
+	// collection.
 	Create func(context.Context, vocab.ActivityStreamsCreate) error
 	// Update handles additional side effects for the Update ActivityStreams
 	// type.
 	//
 	// The wrapping callback applies new top-level values on an object to
 	// the stored objects. Any top-level null literals will be deleted on
-	// the stored objects as well.
+	// the stored objects as well. The id of a stored object never changes.
 	Update func(context.Context, vocab.ActivityStreamsUpdate) error
 	// Delete handles additional side effects for the Delete ActivityStreams
 	// type.
@@ -69,20 +71,28 @@ type SocialWrappedCallbacks struct {
 	// It enforces that the actors on the Undo must correspond to all of the
 	// 'object' actors in some manner.
 	//
+	// If the activities being undone are Blocks, the wrapping function
+	// removes their 'object' entries from this actor's "blocks"
+	// collection, and the Undo is not federated.
+	//
 	// It is expected that the application will implement the proper
-	// reversal of activities that are being undone.
+	// reversal of other activities that are being undone.
 	Undo func(context.Context, vocab.ActivityStreamsUndo) error
 	// Block handles additional side effects for the Block ActivityStreams
 	// type.
 	//
-	// The wrapping callback only ensures the 'Block' has at least one
-	// 'object' entry, but otherwise has no default side effect. It is up
-	// to the wrapped application function to properly enforce the new
-	// blocking behavior.
+	// The wrapping callback ensures the 'Block' has at least one 'object'
+	// entry, and adds them to this actor's "blocks" collection. Afterwards,
+	// activities from the blocked actors are dropped before reaching the
+	// actor's inbox, and nothing is delivered to them.
 	//
 	// Note that go-fed does not federate 'Block' activities received in the
 	// Social Protocol.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Webfinger is optional. If set, the wrapping Create callback resolves
+	// the Mention tags of the objects that only have a "@user@host" 'name'
+	// to the IRIs of the mentioned actors before saving them.
+	Webfinger *WebfingerClient
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -247,6 +257,18 @@ func (w SocialWrappedCallbacks) create(c context.Context, a vocab.ActivityStream
 	if err := normalizeRecipients(a); err != nil {
 		return err
 	}
+	// Resolve the mentions entered by the user.
+	if w.Webfinger != nil {
+		tp, err := w.newTransport(c, w.outboxIRI, goFedUserAgent())
+		if err != nil {
+			return err
+		}
+		for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+			if t := iter.GetType(); t != nil {
+				w.Webfinger.ResolveMentions(c, tp, t)
+			}
+		}
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(i int) error {
@@ -260,10 +282,17 @@ func (w SocialWrappedCallbacks) create(c context.Context, a vocab.ActivityStream
 			return err
 		}
 		defer w.db.Unlock(c, id)
+		// Inherit or start the conversation of the object before it
+		// is persisted.
+		if err := setConversation(c, w.db, obj); err != nil {
+			return err
+		}
 		if err := w.db.Create(c, obj); err != nil {
 			return err
 		}
-		return nil
+		// Add the object to the replies of the objects it is in reply
+		// to that are owned by this server.
+		return addReply(c, w.db, obj)
 	}
 	// Persist all objects we've created, which will include sensitive
 	// recipients such as 'bcc' and 'bto'.
@@ -310,25 +339,22 @@ func (w SocialWrappedCallbacks) update(c context.Context, a vocab.ActivityStream
 		if err != nil {
 			return err
 		}
-		// Copy over new top-level values.
-		objType := op.At(idx).GetType()
-		if objType == nil {
-			return fmt.Errorf("object at index %d is not a literal type value", idx)
-		}
-		newM, err := objType.Serialize()
-		if err != nil {
-			return err
-		}
-		for k, v := range newM {
-			m[k] = v
-		}
-		// Delete top-level values where the raw Activity had nils.
-		for k, v := range w.rawActivity {
-			if _, ok := m[k]; v == nil && ok {
-				delete(m, k)
+		// Merge the partial update into the stored value. The raw JSON
+		// is preferred, as it preserves the null values indicating
+		// properties to remove.
+		update := rawUpdateObject(w.rawActivity, idx)
+		if update == nil {
+			objType := op.At(idx).GetType()
+			if objType == nil {
+				return fmt.Errorf("object at index %d is not a literal type value", idx)
+			}
+			update, err = objType.Serialize()
+			if err != nil {
+				return err
 			}
 		}
-		newT, err := streams.ToType(c, m)
+		m = mergePartialUpdate(m, update)
+		newT, err := streams.DeserializeContext(c, m)
 		if err != nil {
 			return err
 		}
@@ -508,6 +534,22 @@ func (w SocialWrappedCallbacks) undo(c context.Context, a vocab.ActivityStreamsU
 	if err := mustHaveActivityActorsMatchObjectActors(c, actors, op, w.newTransport, w.outboxIRI); err != nil {
 		return err
 	}
+	// Undoing Blocks unblocks their objects. Like the Block itself, the
+	// Undo is not federated.
+	unblocked, allBlocks, err := w.undoneBlockObjects(c, op)
+	if err != nil {
+		return err
+	}
+	if len(unblocked) > 0 {
+		actorIRI, err := w.actorIRI(c)
+		if err != nil {
+			return err
+		}
+		if err = updateBlocks(c, w.db, actorIRI, nil, unblocked); err != nil {
+			return err
+		}
+	}
+	*w.undeliverable = allBlocks
 	if w.Undo != nil {
 		return w.Undo(c, a)
 	}
@@ -521,8 +563,69 @@ func (w SocialWrappedCallbacks) block(c context.Context, a vocab.ActivityStreams
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
+	ids := make([]*url.URL, 0, op.Len())
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	actorIRI, err := w.actorIRI(c)
+	if err != nil {
+		return err
+	}
+	if err = updateBlocks(c, w.db, actorIRI, ids, nil); err != nil {
+		return err
+	}
 	if w.Block != nil {
 		return w.Block(c, a)
 	}
 	return nil
 }
+
+// actorIRI obtains the IRI of the actor owning the outbox.
+func (w SocialWrappedCallbacks) actorIRI(c context.Context) (*url.URL, error) {
+	if err := w.db.Lock(c, w.outboxIRI); err != nil {
+		return nil, err
+	}
+	defer w.db.Unlock(c, w.outboxIRI)
+	return w.db.ActorForOutbox(c, w.outboxIRI)
+}
+
+// undoneBlockObjects returns the 'object' ids of the Block activities being
+// undone, and whether every activity being undone is a Block. Activities
+// referenced by IRI are looked up in the database.
+func (w SocialWrappedCallbacks) undoneBlockObjects(c context.Context, op vocab.ActivityStreamsObjectProperty) (ids []*url.URL, allBlocks bool, err error) {
+	allBlocks = true
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil && iter.IsIRI() {
+			t, err = getIfExists(c, w.db, iter.GetIRI())
+			if err != nil {
+				return
+			}
+		}
+		if t == nil || !streams.IsOrExtendsActivityStreamsBlock(t) {
+			allBlocks = false
+			continue
+		}
+		o, ok := t.(objecter)
+		if !ok {
+			continue
+		}
+		bop := o.GetActivityStreamsObject()
+		if bop == nil {
+			continue
+		}
+		for bIter := bop.Begin(); bIter != bop.End(); bIter = bIter.Next() {
+			var id *url.URL
+			id, err = ToId(bIter)
+			if err != nil {
+				return
+			}
+			ids = append(ids, id)
+		}
+	}
+	return
+}
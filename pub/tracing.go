@@ -0,0 +1,140 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+)
+
+// The names of the spans started by this library.
+const (
+	// SpanDereference is the span of a Transport's Dereference.
+	SpanDereference = "activitypub.dereference"
+	// SpanDeliver is the span of a Transport's Deliver.
+	SpanDeliver = "activitypub.deliver"
+	// SpanVerifySignature is the span of verifying the HTTP Signature of a
+	// request, including obtaining its key.
+	SpanVerifySignature = "activitypub.verify_signature"
+	// SpanInboxSideEffects is the span of applying the side effects of an
+	// activity received in an inbox.
+	SpanInboxSideEffects = "activitypub.inbox_side_effects"
+	// SpanOutboxSideEffects is the span of applying the side effects of an
+	// activity posted to an outbox.
+	SpanOutboxSideEffects = "activitypub.outbox_side_effects"
+)
+
+// The keys of the attributes of the spans started by this library, following
+// the OpenTelemetry semantic conventions where they have one.
+const (
+	// SpanKeyPeerHost is the host of the peer server.
+	SpanKeyPeerHost = "net.peer.name"
+	// SpanKeyURL is the IRI requested from or delivered to.
+	SpanKeyURL = "http.url"
+	// SpanKeyStatusCode is the status code of the peer's response.
+	SpanKeyStatusCode = "http.status_code"
+	// SpanKeyKeyId is the id of the key of an HTTP Signature.
+	SpanKeyKeyId = "activitypub.key_id"
+	// SpanKeyActivityId is the id of the activity being handled.
+	SpanKeyActivityId = "activitypub.activity_id"
+	// SpanKeyActivityType is the ActivityStreams type of the activity.
+	SpanKeyActivityType = "activitypub.activity_type"
+)
+
+// tracerContextKey is the context key under which WithTracer stores the
+// Tracer.
+type tracerContextKey struct{}
+
+// SpanAttribute is a key and value attached to a span.
+type SpanAttribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts the spans of the library's federation traffic, so that slow
+// federation paths may be traced end to end. It is typically an adapter of an
+// OpenTelemetry trace.Tracer, which the library does not depend on.
+//
+// The Tracer is carried by the context given to the library, so that the
+// Actor, transports, and signature verification all trace to it without it
+// being passed to each. Use WithTracer to add it to the contexts of requests
+// and of calls to ProcessDeliveries. Without it, nothing is traced.
+//
+// Implementations must be safe for concurrent use.
+type Tracer interface {
+	// Start starts a span with the name and attributes, as a child of the
+	// span carried by the context if any, and returns a copy of the
+	// context carrying the new span.
+	Start(c context.Context, name string, attrs ...SpanAttribute) (context.Context, Span)
+}
+
+// Span is a traced operation started by a Tracer.
+type Span interface {
+	// SetAttributes adds attributes to the span.
+	SetAttributes(attrs ...SpanAttribute)
+	// End ends the span, recording the error as its status if it is not
+	// nil.
+	End(err error)
+}
+
+// NoopTracer is a Tracer whose spans do nothing.
+type NoopTracer struct{}
+
+// NoopTracer must satisfy the Tracer interface.
+var _ Tracer = NoopTracer{}
+
+// Start returns the context and a span doing nothing.
+func (NoopTracer) Start(c context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	return c, noopSpan{}
+}
+
+// noopSpan is a Span doing nothing.
+type noopSpan struct{}
+
+// SetAttributes does nothing.
+func (noopSpan) SetAttributes(attrs ...SpanAttribute) {}
+
+// End does nothing.
+func (noopSpan) End(err error) {}
+
+// WithTracer returns a copy of the context carrying the Tracer.
+func WithTracer(c context.Context, t Tracer) context.Context {
+	return context.WithValue(c, tracerContextKey{}, t)
+}
+
+// TracerFromContext returns the Tracer carried by the context, or NoopTracer
+// if there is none.
+func TracerFromContext(c context.Context) Tracer {
+	if t, ok := c.Value(tracerContextKey{}).(Tracer); ok && t != nil {
+		return t
+	}
+	return NoopTracer{}
+}
+
+// startSpan starts a span with the Tracer carried by the context.
+func startSpan(c context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	return TracerFromContext(c).Start(c, name, attrs...)
+}
+
+// startRequestSpan starts a span of a request to the IRI.
+func startRequestSpan(c context.Context, name string, iri *url.URL) (context.Context, Span) {
+	return startSpan(c, name,
+		SpanAttribute{Key: SpanKeyPeerHost, Value: iri.Host},
+		SpanAttribute{Key: SpanKeyURL, Value: iri.String()})
+}
+
+// startActivitySpan starts a span of handling the activity.
+func startActivitySpan(c context.Context, name string, activity Activity) (context.Context, Span) {
+	attrs := []SpanAttribute{{Key: SpanKeyActivityType, Value: activity.GetTypeName()}}
+	if id := activity.GetActivityStreamsId(); id != nil && id.Get() != nil {
+		attrs = append(attrs, SpanAttribute{Key: SpanKeyActivityId, Value: id.Get().String()})
+	}
+	return startSpan(c, name, attrs...)
+}
+
+// endSpan ends the span with the error, adding the status code of the
+// response if the error is an HttpStatusError.
+func endSpan(span Span, err error) {
+	if se, ok := asHttpStatusError(err); ok {
+		span.SetAttributes(SpanAttribute{Key: SpanKeyStatusCode, Value: se.StatusCode})
+	}
+	span.End(err)
+}
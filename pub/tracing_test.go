@@ -0,0 +1,137 @@
+package pub
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"github.com/go-fed/httpsig"
+	"github.com/golang/mock/gomock"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingTracer records the spans it starts.
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordingSpan
+}
+
+// recordingSpan is a span started by a recordingTracer.
+type recordingSpan struct {
+	name   string
+	parent *recordingSpan
+	attrs  map[string]interface{}
+	ended  bool
+	err    error
+}
+
+// recordingSpanKey is the context key of the current recordingSpan.
+type recordingSpanKey struct{}
+
+func (r *recordingTracer) Start(c context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := &recordingSpan{name: name, attrs: make(map[string]interface{})}
+	s.parent, _ = c.Value(recordingSpanKey{}).(*recordingSpan)
+	s.SetAttributes(attrs...)
+	r.spans = append(r.spans, s)
+	return context.WithValue(c, recordingSpanKey{}, s), s
+}
+
+func (s *recordingSpan) SetAttributes(attrs ...SpanAttribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordingSpan) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+func TestTracing(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := NewActorKeys(mustParse(testMyActorIRI), ActorKey{Id: mustParse(testMyActorIRI + "#main-key"), PrivateKey: privKey})
+	setupFn := func(ctl *gomock.Controller) (client *MockHttpClient, tp *HttpSigTransport) {
+		client = NewMockHttpClient(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp, err := keys.NewTransport(client, "test", cl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	t.Run("TracesDelivery", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		tr := &recordingTracer{}
+		client.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusAccepted, Body: ioutil.NopCloser(strings.NewReader(""))}, nil)
+		// Run
+		err := tp.Deliver(WithTracer(context.Background(), tr), []byte("{}"), mustParse(testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(tr.spans), 1)
+		s := tr.spans[0]
+		assertEqual(t, s.name, SpanDeliver)
+		assertEqual(t, s.ended, true)
+		assertEqual(t, s.attrs[SpanKeyPeerHost], "other.example.com")
+		assertEqual(t, s.attrs[SpanKeyStatusCode], http.StatusAccepted)
+	})
+	t.Run("TracesFailedDereference", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		client, tp := setupFn(ctl)
+		tr := &recordingTracer{}
+		client.EXPECT().Do(gomock.Any()).Return(&http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: ioutil.NopCloser(strings.NewReader(""))}, nil)
+		// Run
+		_, err := tp.Dereference(WithTracer(context.Background(), tr), mustParse(testNoteId1))
+		// Verify
+		assertNotEqual(t, err, nil)
+		assertEqual(t, len(tr.spans), 1)
+		s := tr.spans[0]
+		assertEqual(t, s.name, SpanDereference)
+		assertEqual(t, s.attrs[SpanKeyURL], testNoteId1)
+		assertEqual(t, s.attrs[SpanKeyStatusCode], http.StatusNotFound)
+		assertEqual(t, s.err, err)
+	})
+	t.Run("NestsKeyFetchInVerification", func(t *testing.T) {
+		// Setup
+		tr := &recordingTracer{}
+		keyId := testFederatedActorIRI + "#main-key"
+		r := mustSignedGetRequest(testNoteId1, keyId, privKey)
+		getKey := func(c context.Context, k *url.URL) (crypto.PublicKey, httpsig.Algorithm, *url.URL, error) {
+			_, span := startRequestSpan(c, SpanDereference, k)
+			span.End(nil)
+			return privKey.Public(), httpsig.RSA_SHA256, mustParse(testFederatedActorIRI), nil
+		}
+		// Run
+		_, err := verifySignature(WithTracer(context.Background(), tr), r, getKey, nil, nil, 0)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(tr.spans), 2)
+		assertEqual(t, tr.spans[0].name, SpanVerifySignature)
+		assertEqual(t, tr.spans[0].attrs[SpanKeyKeyId], keyId)
+		assertEqual(t, tr.spans[0].ended, true)
+		assertEqual(t, tr.spans[1].parent, tr.spans[0])
+	})
+	t.Run("NoopWithoutTracer", func(t *testing.T) {
+		// Run
+		c, span := startSpan(context.Background(), SpanDeliver)
+		span.End(fmt.Errorf("ignored"))
+		// Verify
+		assertEqual(t, TracerFromContext(c), Tracer(NoopTracer{}))
+	})
+}
@@ -0,0 +1,55 @@
+package pub
+
+import (
+	"context"
+)
+
+// Transactor is implemented by a Database able to apply the storage mutations
+// of one activity atomically, so that a side effect failing midway does not
+// leave collections half updated.
+//
+// When the Database is a Transactor, the side effects of every activity
+// received in an inbox or posted to an outbox, including adding it to the
+// inbox or outbox, are run between Begin and either Commit or Rollback. If the
+// Database is also a SeenStore, an inbound activity is marked as seen in the
+// same transaction. Activities delivered by the side effects, such as an
+// automatic Accept of a Follow, are sent only once the transaction commits.
+type Transactor interface {
+	// Begin starts a transaction, returning a context that carries it. The
+	// library passes that context to every Database call made in the
+	// transaction, and to Commit or Rollback.
+	Begin(c context.Context) (context.Context, error)
+	// Commit applies the transaction carried by the context.
+	Commit(c context.Context) error
+	// Rollback discards the transaction carried by the context.
+	Rollback(c context.Context) error
+}
+
+// runInTransaction calls fn within a transaction if the Database is a
+// Transactor, committing if it succeeds and rolling back if it returns an
+// error or panics. Otherwise fn is simply called.
+func runInTransaction(c context.Context, db Database, fn func(c context.Context) error) (err error) {
+	tx, ok := db.(Transactor)
+	if !ok {
+		return fn(c)
+	}
+	tc, err := tx.Begin(c)
+	if err != nil {
+		return
+	}
+	done := false
+	defer func() {
+		if !done {
+			tx.Rollback(tc)
+		}
+	}()
+	if err = fn(tc); err != nil {
+		done = true
+		if rbErr := tx.Rollback(tc); rbErr != nil {
+			logEntry(c, LogLevelError, "transaction rollback failed", errorLogField(rbErr))
+		}
+		return
+	}
+	done = true
+	return tx.Commit(tc)
+}
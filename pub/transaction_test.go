@@ -0,0 +1,177 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// txContextKey is the context key under which testTxDatabase stores its
+// transaction.
+type txContextKey struct{}
+
+// testTxDatabase is a MockDatabase that records its transactions.
+type testTxDatabase struct {
+	*MockDatabase
+	calls []string
+}
+
+func (t *testTxDatabase) Begin(c context.Context) (context.Context, error) {
+	t.calls = append(t.calls, "Begin")
+	return context.WithValue(c, txContextKey{}, "tx"), nil
+}
+
+func (t *testTxDatabase) Commit(c context.Context) error {
+	t.calls = append(t.calls, "Commit:"+c.Value(txContextKey{}).(string))
+	return nil
+}
+
+func (t *testTxDatabase) Rollback(c context.Context) error {
+	t.calls = append(t.calls, "Rollback:"+c.Value(txContextKey{}).(string))
+	return nil
+}
+
+func TestRunInTransaction(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	t.Run("CommitsOnSuccess", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := &testTxDatabase{MockDatabase: NewMockDatabase(ctl)}
+		var tx interface{}
+		// Run
+		err := runInTransaction(ctx, db, func(c context.Context) error {
+			tx = c.Value(txContextKey{})
+			return nil
+		})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, tx, "tx")
+		assertEqual(t, len(db.calls), 2)
+		assertEqual(t, db.calls[1], "Commit:tx")
+	})
+	t.Run("RollsBackOnError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := &testTxDatabase{MockDatabase: NewMockDatabase(ctl)}
+		// Run
+		err := runInTransaction(ctx, db, func(c context.Context) error {
+			return testErr
+		})
+		// Verify
+		assertEqual(t, err, testErr)
+		assertEqual(t, len(db.calls), 2)
+		assertEqual(t, db.calls[1], "Rollback:tx")
+	})
+	t.Run("RollsBackOnPanic", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := &testTxDatabase{MockDatabase: NewMockDatabase(ctl)}
+		// Run
+		func() {
+			defer func() { recover() }()
+			runInTransaction(ctx, db, func(c context.Context) error {
+				panic("test panic")
+			})
+		}()
+		// Verify
+		assertEqual(t, len(db.calls), 2)
+		assertEqual(t, db.calls[1], "Rollback:tx")
+	})
+	t.Run("RunsSideEffectsInTransaction", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		db := &testTxDatabase{MockDatabase: NewMockDatabase(ctl)}
+		a := &sideEffectActor{db: db}
+		outboxIRI := mustParse(testMyOutboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(gomock.Any(), gomock.Any()),
+			db.EXPECT().Create(gomock.Any(), testMyCreate).DoAndReturn(func(c context.Context, v interface{}) error {
+				assertEqual(t, c.Value(txContextKey{}), "tx")
+				return testErr
+			}),
+			db.EXPECT().Unlock(gomock.Any(), gomock.Any()),
+		)
+		// Run
+		_, err := a.PostOutbox(ctx, testMyCreate, outboxIRI, nil)
+		// Verify
+		assertEqual(t, err, testErr)
+		assertEqual(t, len(db.calls), 2)
+		assertEqual(t, db.calls[1], "Rollback:tx")
+	})
+	t.Run("AddsToEachInboxInTransactions", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		db := &testTxDatabase{MockDatabase: NewMockDatabase(ctl)}
+		fp := NewMockFederatingProtocol(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		a := &sideEffectActor{common: NewMockCommonBehavior(ctl), s2s: fp, db: db, clock: cl, seen: NewMemorySeenStore(cl, DefaultSeenTTL)}
+		inboxIRI := mustParse(testMyInboxIRI)
+		otherInboxIRI := mustParse("https://example.com/sam/inbox")
+		for _, iri := range []*url.URL{inboxIRI, otherInboxIRI} {
+			gomock.InOrder(
+				db.EXPECT().Lock(gomock.Any(), iri),
+				db.EXPECT().InboxContains(gomock.Any(), iri, mustParse(testFederatedActivityIRI)).Return(false, nil),
+				db.EXPECT().GetInbox(gomock.Any(), iri).Return(streams.NewActivityStreamsOrderedCollectionPage(), nil),
+				db.EXPECT().SetInbox(gomock.Any(), testOrderedCollectionWithFederatedId).Return(nil),
+				db.EXPECT().Unlock(gomock.Any(), iri),
+			)
+		}
+		calls := 0
+		fp.EXPECT().Callbacks(gomock.Any()).Return(FederatingWrappedCallbacks{}, []interface{}{
+			func(c context.Context, a vocab.ActivityStreamsListen) error {
+				calls++
+				return nil
+			},
+		}, nil).Times(2)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		otherErr := a.PostInbox(ctx, otherInboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, otherErr, nil)
+		assertEqual(t, calls, 2)
+		assertEqual(t, len(db.calls), 4)
+		assertEqual(t, db.calls[1], "Commit:tx")
+		assertEqual(t, db.calls[3], "Commit:tx")
+	})
+	t.Run("CommitsAddingSeenActivityToInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		db := &testTxDatabase{MockDatabase: NewMockDatabase(ctl)}
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		seen := NewMemorySeenStore(cl, DefaultSeenTTL)
+		a := &sideEffectActor{db: db, clock: cl, seen: seen}
+		inboxIRI := mustParse(testMyInboxIRI)
+		_, err := seen.MarkSeen(ctx, inboxActivityId(inboxIRI, mustParse(testFederatedActivityIRI)))
+		assertEqual(t, err, nil)
+		gomock.InOrder(
+			db.EXPECT().Lock(gomock.Any(), inboxIRI),
+			db.EXPECT().InboxContains(gomock.Any(), inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(gomock.Any(), inboxIRI).Return(streams.NewActivityStreamsOrderedCollectionPage(), nil),
+			db.EXPECT().SetInbox(gomock.Any(), testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(gomock.Any(), inboxIRI),
+		)
+		// Run
+		err = a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, ErrDuplicateActivity)
+		assertEqual(t, len(db.calls), 2)
+		assertEqual(t, db.calls[1], "Commit:tx")
+	})
+}
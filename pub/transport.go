@@ -9,8 +9,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -51,12 +51,25 @@ type Transport interface {
 // Transport must be implemented by HttpSigTransport.
 var _ Transport = &HttpSigTransport{}
 
+// WebfingerTransport is an optional extension of a Transport fetching WebFinger
+// resources, which are requested with a different media type than
+// ActivityStreams values. A Transport without it fetches them with Dereference.
+type WebfingerTransport interface {
+	// DereferenceWebfinger fetches the WebFinger resource at this IRI
+	// with a GET request.
+	DereferenceWebfinger(c context.Context, iri *url.URL) ([]byte, error)
+}
+
+// WebfingerTransport must be implemented by HttpSigTransport.
+var _ WebfingerTransport = &HttpSigTransport{}
+
 // HttpSigTransport makes a dereference call using HTTP signatures to
 // authenticate the request on behalf of a particular actor.
 //
 // No rate limiting is applied.
 //
-// Only one request is tried per call.
+// Only one request is tried per call, unless double-knocking as returned by
+// NewDoubleKnockingTransport.
 type HttpSigTransport struct {
 	client       HttpClient
 	appAgent     string
@@ -68,6 +81,25 @@ type HttpSigTransport struct {
 	postSignerMu *sync.Mutex
 	pubKeyId     string
 	privKey      crypto.PrivateKey
+	actor        *url.URL
+	selectKey    KeySelector
+	// variants are the signature variants to double-knock with, if any.
+	variants *signatureVariants
+	// digest is the algorithm of the Digest header added to POST
+	// requests, if any.
+	digest DigestAlgorithm
+	// cache holds the responses to Dereference calls, if caching.
+	cache ResponseCacheStore
+	// requestHooks and responseHooks observe or change every request and
+	// response.
+	requestHooks  []RequestHook
+	responseHooks []ResponseHook
+	// mediaTypes are the media types of requests to each host, if not the
+	// DefaultMediaTypes.
+	mediaTypes *HostMediaTypes
+	// quirks adjust the requests to each host for the software it runs,
+	// if any.
+	quirks *QuirksRegistry
 }
 
 // NewHttpSigTransport returns a new Transport.
@@ -106,93 +138,293 @@ func NewHttpSigTransport(
 	}
 }
 
+// NewKeySelectingTransport returns a new Transport sending requests on behalf
+// of the actor, signed with the key the KeySelector chooses for each request
+// instead of a fixed one.
+//
+// The signers are passed the private keys returned by the KeySelector, so keys
+// held in a hardware security module may be used with httpsig.Signers able to
+// sign with them.
+func NewKeySelectingTransport(
+	client HttpClient,
+	appAgent string,
+	clock Clock,
+	getSigner, postSigner httpsig.Signer,
+	actor *url.URL,
+	selectKey KeySelector) *HttpSigTransport {
+	h := NewHttpSigTransport(client, appAgent, clock, getSigner, postSigner, "", nil)
+	h.actor = actor
+	h.selectKey = selectKey
+	return h
+}
+
 // Dereference sends a GET request signed with an HTTP Signature to obtain an
-// ActivityStreams value.
+// ActivityStreams value, tracing it with the Tracer and logging failures to the
+// Logger carried by the context.
 func (h HttpSigTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
-	req, err := http.NewRequest("GET", iri.String(), nil)
+	c, span := startRequestSpan(c, SpanDereference, iri)
+	b, err := h.dereference(c, iri)
+	endSpan(span, err)
 	if err != nil {
-		return nil, err
+		logEntry(c, LogLevelWarn, "dereference failed",
+			remoteHostLogField(iri),
+			LogField{Key: "iri", Value: iri},
+			errorLogField(err))
 	}
-	req.WithContext(c)
-	req.Header.Add(acceptHeader, acceptHeaderValue)
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-	req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
-	h.getSignerMu.Lock()
-	err = h.getSigner.SignRequest(h.privKey, h.pubKeyId, req, nil)
-	h.getSignerMu.Unlock()
+	return b, err
+}
+
+// DereferenceWebfinger sends a GET request signed with an HTTP Signature to
+// obtain a WebFinger resource, logging failures to the Logger carried by the
+// context.
+func (h HttpSigTransport) DereferenceWebfinger(c context.Context, iri *url.URL) ([]byte, error) {
+	b, err := h.get(c, iri, webfingerContentType)
 	if err != nil {
-		return nil, err
+		logEntry(c, LogLevelWarn, "webfinger lookup failed",
+			remoteHostLogField(iri),
+			LogField{Key: "iri", Value: iri},
+			errorLogField(err))
+	}
+	return b, err
+}
+
+// dereference sends a GET request to obtain an ActivityStreams value, unless
+// it is cached.
+func (h HttpSigTransport) dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	if h.cache != nil {
+		return h.cachedGet(c, iri)
 	}
-	resp, err := h.client.Do(req)
+	return h.get(c, iri, h.mediaTypesOf(iri.Host).Accept)
+}
+
+// get sends a GET request accepting the media type.
+func (h HttpSigTransport) get(c context.Context, iri *url.URL, accept string) ([]byte, error) {
+	resp, err := h.sendGet(c, iri, accept, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
+		return nil, newHttpStatusError("GET", iri, resp, h.clock.Now())
 	}
 	return ioutil.ReadAll(resp.Body)
 }
 
-// Deliver sends a POST request with an HTTP Signature.
+// sendGet sends a GET request accepting the media type, with the additional
+// headers, and returns the response whatever its status.
+func (h HttpSigTransport) sendGet(c context.Context, iri *url.URL, accept string, header http.Header) (*http.Response, error) {
+	return h.send(c, iri, false, nil, func() (*http.Request, error) {
+		req, err := http.NewRequest("GET", iri.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(c)
+		req.Header.Add(acceptHeader, accept)
+		req.Header.Add("Accept-Charset", "utf-8")
+		req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+		req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		return req, nil
+	})
+}
+
+// Deliver sends a POST request with an HTTP Signature, reporting the attempt
+// to the Metrics and Logger carried by the context.
 func (h HttpSigTransport) Deliver(c context.Context, b []byte, to *url.URL) error {
-	byteCopy := make([]byte, len(b))
-	copy(byteCopy, b)
-	buf := bytes.NewBuffer(byteCopy)
-	req, err := http.NewRequest("POST", to.String(), buf)
-	if err != nil {
-		return err
-	}
-	req.WithContext(c)
-	req.Header.Add(contentTypeHeader, contentTypeHeaderValue)
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Date", h.clock.Now().UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-	req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
-	h.postSignerMu.Lock()
-	err = h.postSigner.SignRequest(h.privKey, h.pubKeyId, req, b)
-	h.postSignerMu.Unlock()
+	return h.deliverResult(c, b, to).Err
+}
+
+// deliverResult sends a POST request with an HTTP Signature, reporting the
+// attempt to the Tracer, Metrics, and Logger carried by the context, and
+// returns its result.
+func (h HttpSigTransport) deliverResult(c context.Context, b []byte, to *url.URL) DeliveryResult {
+	c, span := startRequestSpan(c, SpanDeliver, to)
+	start := h.clock.Now()
+	status, err := h.deliver(c, b, to, start)
+	latency := h.clock.Now().Sub(start)
+	if status != 0 {
+		span.SetAttributes(SpanAttribute{Key: SpanKeyStatusCode, Value: status})
+	}
+	span.End(err)
+	MetricsFromContext(c).DeliveryAttempt(to.Host, latency, err)
+	fields := []LogField{
+		remoteHostLogField(to),
+		{Key: "inbox", Value: to},
+		{Key: "latency", Value: latency},
+	}
 	if err != nil {
-		return err
+		logEntry(c, LogLevelWarn, "delivery failed", append(fields, errorLogField(err))...)
+	} else {
+		logEntry(c, LogLevelDebug, "delivered", fields...)
+	}
+	return DeliveryResult{
+		Recipient:  to,
+		StatusCode: status,
+		Err:        err,
+		Duration:   latency,
+	}
+}
+
+// deliver sends a POST request dated at the given time, and returns the status
+// code of the response, if any.
+func (h HttpSigTransport) deliver(c context.Context, b []byte, to *url.URL, date time.Time) (int, error) {
+	if h.quirks != nil {
+		var err error
+		if b, err = h.quirks.adjust(c, to.Host, b); err != nil {
+			return 0, err
+		}
 	}
-	resp, err := h.client.Do(req)
+	resp, err := h.send(c, to, true, b, func() (*http.Request, error) {
+		byteCopy := make([]byte, len(b))
+		copy(byteCopy, b)
+		buf := bytes.NewBuffer(byteCopy)
+		req, err := http.NewRequest("POST", to.String(), buf)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(c)
+		req.Header.Add(contentTypeHeader, h.mediaTypesOf(to.Host).ContentType)
+		req.Header.Add("Accept-Charset", "utf-8")
+		req.Header.Add("Date", date.UTC().Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+		req.Header.Add("User-Agent", fmt.Sprintf("%s %s", h.appAgent, h.gofedAgent))
+		if v := collectionSynchronizationFromContext(c); len(v) > 0 {
+			req.Header.Add(collectionSynchronizationHeader, v)
+		}
+		if len(h.digest) > 0 {
+			d, err := digest(h.digest, b)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Add(digestHeader, d)
+		}
+		return req, nil
+	})
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer resp.Body.Close()
 	if !isSuccess(resp.StatusCode) {
-		return fmt.Errorf("POST request to %s failed (%d): %s", to.String(), resp.StatusCode, resp.Status)
+		return resp.StatusCode, newHttpStatusError("POST", to, resp, h.clock.Now())
 	}
-	return nil
+	return resp.StatusCode, nil
 }
 
-// BatchDeliver sends concurrent POST requests. Returns an error if any of the
-// requests had an error.
-func (h HttpSigTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(recipients))
-	for _, recipient := range recipients {
-		wg.Add(1)
-		go func(r *url.URL) {
-			defer wg.Done()
-			if err := h.Deliver(c, b, r); err != nil {
-				errCh <- err
-			}
-		}(recipient)
-	}
-	wg.Wait()
-	errs := make([]string, 0, len(recipients))
-outer:
-	for {
-		select {
-		case e := <-errCh:
-			errs = append(errs, e.Error())
-		default:
-			break outer
+// send signs the request made by newRequest, a POST request of the body if
+// post is true and a GET request otherwise, and sends it.
+//
+// When double-knocking, the request is signed with the variant the host is
+// known to accept, or else the one of the Quirks of its software, or else the
+// most preferred one. If it is refused as
+// Unauthorized, it is made and sent once more signed with the next variant,
+// which is remembered for the host if accepted.
+func (h HttpSigTransport) send(c context.Context, to *url.URL, post bool, body []byte, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	pubKeyId, privKey, err := h.signingKey(c, to)
+	if err != nil {
+		return nil, err
+	}
+	newRequest = h.hookRequests(c, newRequest)
+	client := h.hookResponses(c)
+	if h.variants == nil {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+		if !post {
+			h.getSignerMu.Lock()
+			err = h.getSigner.SignRequest(privKey, pubKeyId, req, nil)
+			h.getSignerMu.Unlock()
+		} else {
+			h.postSignerMu.Lock()
+			err = h.postSigner.SignRequest(privKey, pubKeyId, req, body)
+			h.postSignerMu.Unlock()
+		}
+		if err != nil {
+			return nil, err
 		}
+		return client.Do(req)
 	}
-	if len(errs) > 0 {
-		return fmt.Errorf("batch deliver had at least one failure: %s", strings.Join(errs, "; "))
+	i := h.variants.accepted(to.Host, h.quirkVariant(to.Host))
+	resp, err := h.variants.signAndDo(client, i, newRequest, post, body, pubKeyId, privKey)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || len(h.variants.variants) < 2 {
+		return resp, err
+	}
+	resp.Body.Close()
+	next := h.variants.next(i)
+	logEntry(c, LogLevelDebug, "signature refused, retrying with another variant",
+		remoteHostLogField(to),
+		LogField{Key: "refused", Value: h.variants.variants[i].Name},
+		LogField{Key: "variant", Value: h.variants.variants[next].Name})
+	if resp, err = h.variants.signAndDo(client, next, newRequest, post, body, pubKeyId, privKey); err != nil {
+		return nil, err
+	} else if resp.StatusCode != http.StatusUnauthorized {
+		h.variants.setAccepted(to.Host, next)
+	}
+	return resp, nil
+}
+
+// signingKey returns the id of the public key and the private key signing a
+// request to the IRI.
+func (h HttpSigTransport) signingKey(c context.Context, iri *url.URL) (string, crypto.PrivateKey, error) {
+	if h.selectKey == nil {
+		return h.pubKeyId, h.privKey, nil
+	}
+	return h.selectKey(c, iri.Host, h.actor)
+}
+
+// BatchDeliver sends concurrent POST requests. Returns a BatchDeliveryError if
+// any of the requests had an error.
+func (h HttpSigTransport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	return batchError(h.BatchDeliverResults(c, b, recipients))
+}
+
+// BatchDeliverResults concurrently sends POST requests, returning the result
+// of each in the order of the recipients.
+func (h HttpSigTransport) BatchDeliverResults(c context.Context, b []byte, recipients []*url.URL) []DeliveryResult {
+	return deliverAll(c, recipients, func(to *url.URL) DeliveryResult {
+		return h.deliverResult(c, b, to)
+	})
+}
+
+// HttpStatusError is returned by the HttpSigTransport when a peer responds
+// with an unsuccessful status code.
+type HttpStatusError struct {
+	// Method is the method of the failed request.
+	Method string
+	// IRI is the target of the failed request.
+	IRI *url.URL
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Status is the status of the response.
+	Status string
+	// RetryAfter is how long the peer asked to wait before retrying with
+	// the Retry-After header of the response, or zero if it did not.
+	RetryAfter time.Duration
+}
+
+// newHttpStatusError creates the HttpStatusError for the response to a
+// request, received at the time.
+func newHttpStatusError(method string, iri *url.URL, resp *http.Response, now time.Time) *HttpStatusError {
+	return &HttpStatusError{
+		Method:     method,
+		IRI:        iri,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: retryAfter(resp.Header, now),
+	}
+}
+
+// Error describes the failed request.
+func (e *HttpStatusError) Error() string {
+	return fmt.Sprintf("%s request to %s failed (%d): %s", e.Method, e.IRI.String(), e.StatusCode, e.Status)
+}
+
+// Unwrap returns ErrNotFound if the status is 404 Not Found or 410 Gone, and
+// nil otherwise.
+func (e *HttpStatusError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound || e.StatusCode == http.StatusGone {
+		return ErrNotFound
 	}
 	return nil
 }
@@ -12,7 +12,6 @@ import (
 	"github.com/go-fed/activity/streams/vocab"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 )
 
@@ -25,42 +24,32 @@ var (
 	// set. Can be returned by DelegateActor's PostInbox or PostOutbox so a
 	// Bad Request response is set.
 	ErrTargetRequired = errors.New("target property required on the provided activity")
+	// ErrActorRequired indicates the activity needs its actor property set
+	// with the ids of its actors. Can be returned by DelegateActor's
+	// AuthorizePostInbox or PostInbox so a Bad Request response is set.
+	ErrActorRequired = errors.New("actor property required on the provided activity")
+	// ErrDuplicateActivity indicates the activity was already received and
+	// its side effects applied. Can be returned by DelegateActor's PostInbox
+	// so an Accepted response is set and inbox forwarding is skipped.
+	ErrDuplicateActivity = errors.New("activity was already received")
+	// ErrBlocked indicates the activity is from an actor blocked by the
+	// owner of the inbox. Can be returned by DelegateActor's PostInbox so
+	// an Accepted response is set without storing or forwarding the
+	// activity.
+	ErrBlocked = errors.New("activity from an actor blocked by the inbox owner")
 )
 
-// activityStreamsMediaTypes contains all of the accepted ActivityStreams media
-// types. Generated at init time.
-var activityStreamsMediaTypes []string
-
-func init() {
-	activityStreamsMediaTypes = []string{
-		"application/activity+json",
-	}
-	jsonLdType := "application/ld+json"
-	for _, semi := range []string{";", " ;", " ; ", "; "} {
-		for _, profile := range []string{
-			"profile=https://www.w3.org/ns/activitystreams",
-			"profile=\"https://www.w3.org/ns/activitystreams\"",
-		} {
-			activityStreamsMediaTypes = append(
-				activityStreamsMediaTypes,
-				fmt.Sprintf("%s%s%s", jsonLdType, semi, profile))
-		}
-	}
+// activityStreamsMediaTypes are the ActivityStreams media types, as sent in the
+// Content-Type and Accept headers of requests and responses.
+var activityStreamsMediaTypes = []string{
+	activityJSONMediaType,
+	jsonLDMediaType + "; profile=\"" + activityStreamsProfile + "\"",
 }
 
-// headerIsActivityPubMediaType returns true if the header string contains one
-// of the accepted ActivityStreams media types.
-//
-// Note we don't try to build a comprehensive parser and instead accept a
-// tolerable amount of whitespace since the HTTP specification is ambiguous
-// about the format and significance of whitespace.
+// headerIsActivityPubMediaType returns true if the header lists one of the
+// ActivityStreams media types, according to the DefaultNegotiator.
 func headerIsActivityPubMediaType(header string) bool {
-	for _, mediaType := range activityStreamsMediaTypes {
-		if strings.Contains(header, mediaType) {
-			return true
-		}
-	}
-	return false
+	return DefaultNegotiator.IsActivityStreams(header)
 }
 
 const (
@@ -71,15 +60,15 @@ const (
 )
 
 // isActivityPubPost returns true if the request is a POST request that has the
-// ActivityStreams content type header
+// ActivityStreams content type header, according to the DefaultNegotiator.
 func isActivityPubPost(r *http.Request) bool {
-	return r.Method == "POST" && headerIsActivityPubMediaType(r.Header.Get(contentTypeHeader))
+	return DefaultNegotiator.IsActivityPubPost(r)
 }
 
-// isActivityPubGet returns true if the request is a GET request that has the
-// ActivityStreams content type header
+// isActivityPubGet returns true if the request is a GET request accepting
+// ActivityStreams, according to the DefaultNegotiator.
 func isActivityPubGet(r *http.Request) bool {
-	return r.Method == "GET" && headerIsActivityPubMediaType(r.Header.Get(acceptHeader))
+	return DefaultNegotiator.IsActivityPubGet(r)
 }
 
 // dedupeOrderedItems deduplicates the 'orderedItems' within an ordered
@@ -124,6 +113,21 @@ const (
 	jsonLDContext = "@context"
 )
 
+const (
+	// endpointsProperty is the ActivityPub actor property containing the
+	// actor's endpoints. It is not part of the ActivityStreams vocabulary.
+	endpointsProperty = "endpoints"
+	// sharedInboxProperty is the endpoint of a server-wide inbox.
+	sharedInboxProperty = "sharedInbox"
+)
+
+const (
+	// idProperty is the JSON-LD property identifying a value.
+	idProperty = "id"
+	// objectProperty is the ActivityStreams 'object' property.
+	objectProperty = "object"
+)
+
 const (
 	// The Location header
 	locationHeader = "Location"
@@ -360,19 +364,6 @@ func IsPublic(s string) bool {
 	return s == PublicActivityPubIRI || s == publicJsonLD || s == publicJsonLDAS
 }
 
-// getInboxes extracts the 'inbox' IRIs from actor types.
-func getInboxes(t []vocab.Type) (u []*url.URL, err error) {
-	for _, elem := range t {
-		var iri *url.URL
-		iri, err = getInbox(elem)
-		if err != nil {
-			return
-		}
-		u = append(u, iri)
-	}
-	return
-}
-
 // getInbox extracts the 'inbox' IRI from an actor type.
 func getInbox(t vocab.Type) (u *url.URL, err error) {
 	ib, ok := t.(inboxer)
@@ -384,6 +375,31 @@ func getInbox(t vocab.Type) (u *url.URL, err error) {
 	return ToId(inbox)
 }
 
+// getSharedInbox extracts the 'sharedInbox' IRI from the 'endpoints' of an
+// actor type. Returns nil if the actor does not advertise a sharedInbox.
+//
+// The 'endpoints' property is not part of the ActivityStreams vocabulary, so
+// it is obtained from the actor's unknown properties.
+func getSharedInbox(t vocab.Type) *url.URL {
+	u, ok := t.(unknownPropertieser)
+	if !ok {
+		return nil
+	}
+	endpoints, ok := u.GetUnknownProperties()[endpointsProperty].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	s, ok := endpoints[sharedInboxProperty].(string)
+	if !ok {
+		return nil
+	}
+	iri, err := url.Parse(s)
+	if err != nil || !iri.IsAbs() {
+		return nil
+	}
+	return iri
+}
+
 // dedupeIRIs will deduplicate final inbox IRIs. The ignore list is applied to
 // the final list.
 func dedupeIRIs(recipients, ignored []*url.URL) (out []*url.URL) {
@@ -402,6 +418,25 @@ func dedupeIRIs(recipients, ignored []*url.URL) (out []*url.URL) {
 	return
 }
 
+// hiddenRecipients returns the IRIs in "bto" and "bcc" of the activity.
+func hiddenRecipients(activity Activity) (r []*url.URL) {
+	if bto := activity.GetActivityStreamsBto(); bto != nil {
+		for iter := bto.Begin(); iter != bto.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				r = append(r, id)
+			}
+		}
+	}
+	if bcc := activity.GetActivityStreamsBcc(); bcc != nil {
+		for iter := bcc.Begin(); iter != bcc.End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				r = append(r, id)
+			}
+		}
+	}
+	return
+}
+
 // stripHiddenRecipients removes "bto" and "bcc" from the activity.
 //
 // Note that this requirement of the specification is under "Section 6: Client
@@ -763,17 +798,17 @@ func mustHaveActivityActorsMatchObjectActors(c context.Context,
 			}
 			b, err := tport.Dereference(c, iter.GetIRI())
 			if err != nil {
-				return err
+				return &ErrUnresolvable{IRI: iter.GetIRI(), Err: err}
 			}
 			var m map[string]interface{}
 			if err = json.Unmarshal(b, &m); err != nil {
 				return err
 			}
-			t, err = streams.ToType(c, m)
+			t, err = streams.DeserializeContext(c, m)
 			if err != nil {
 				return err
 			}
-		} else {
+		} else if t == nil {
 			return fmt.Errorf("cannot verify actors: object is neither a value nor IRI")
 		}
 		ac, ok := t.(actorer)
@@ -1005,3 +1040,200 @@ func requestId(r *http.Request) *url.URL {
 	id.Scheme = "https"
 	return id
 }
+
+// databaseBlocks obtains the 'blocks' collection of the actor. Returns false if
+// the Database is not a BlocksStore, or is a ComposedDatabase whose
+// CollectionStore is not.
+//
+// The lock for the actor must be held.
+func databaseBlocks(c context.Context, db Database, actorIRI *url.URL) (blocks vocab.ActivityStreamsCollection, ok bool, err error) {
+	b, ok := db.(BlocksStore)
+	if !ok {
+		return
+	}
+	if blocks, err = b.Blocks(c, actorIRI); IsErr(err, ErrNotImplemented) {
+		return nil, false, nil
+	}
+	return
+}
+
+// blockedIRIs returns the ids in the 'blocks' collection of the actor, and none
+// if the Database is not a BlocksStore.
+//
+// Acquires and releases the lock for the actor.
+func blockedIRIs(c context.Context, db Database, actorIRI *url.URL) (blocked map[string]bool, err error) {
+	if _, ok := db.(BlocksStore); !ok {
+		return
+	}
+	if err = db.Lock(c, actorIRI); err != nil {
+		return
+	}
+	defer db.Unlock(c, actorIRI)
+	blocks, ok, err := databaseBlocks(c, db, actorIRI)
+	if err != nil || !ok {
+		return
+	}
+	blocked = make(map[string]bool)
+	items := blocks.GetActivityStreamsItems()
+	if items == nil {
+		return
+	}
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		var id *url.URL
+		id, err = ToId(iter)
+		if err != nil {
+			return
+		}
+		blocked[id.String()] = true
+	}
+	return
+}
+
+// updateBlocks adds and removes ids from the 'blocks' collection of the actor.
+// Ids already blocked are not added again. Nothing is done if the Database is
+// not a BlocksStore.
+//
+// Acquires and releases the lock for the actor.
+func updateBlocks(c context.Context, db Database, actorIRI *url.URL, add, remove []*url.URL) error {
+	if _, ok := db.(BlocksStore); !ok {
+		return nil
+	}
+	if err := db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, actorIRI)
+	blocks, ok, err := databaseBlocks(c, db, actorIRI)
+	if err != nil || !ok {
+		return err
+	}
+	items := blocks.GetActivityStreamsItems()
+	if items == nil {
+		items = streams.NewActivityStreamsItemsProperty()
+		blocks.SetActivityStreamsItems(items)
+	}
+	removeMap := make(map[string]bool, len(remove))
+	for _, id := range remove {
+		removeMap[id.String()] = true
+	}
+	existing := make(map[string]bool, items.Len())
+	for i := items.Len() - 1; i >= 0; i-- {
+		id, err := ToId(items.At(i))
+		if err != nil {
+			return err
+		}
+		if removeMap[id.String()] {
+			items.Remove(i)
+		} else {
+			existing[id.String()] = true
+		}
+	}
+	for _, id := range add {
+		if !existing[id.String()] {
+			items.PrependIRI(id)
+			existing[id.String()] = true
+		}
+	}
+	return db.Update(c, blocks)
+}
+
+// getOrDereference obtains the value at the IRI from the Database if it is
+// owned by this server, and dereferences it with the Transport otherwise.
+func getOrDereference(c context.Context, db Database, tp Transport, iri *url.URL) (vocab.Type, error) {
+	if err := db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	owns, err := db.Owns(c, iri)
+	if err != nil || !owns {
+		db.Unlock(c, iri)
+		if err != nil {
+			return nil, err
+		}
+		return dereferenceType(c, tp, iri)
+	}
+	defer db.Unlock(c, iri)
+	return db.Get(c, iri)
+}
+
+// getIfExists returns the database entry for the IRI, or nil if it does not
+// exist.
+func getIfExists(c context.Context, db Database, iri *url.URL) (vocab.Type, error) {
+	if err := db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, iri)
+	if exists, err := db.Exists(c, iri); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	return db.Get(c, iri)
+}
+
+// getActorIds returns the ids of the 'actor' of an activity.
+func getActorIds(activity Activity) (ids []*url.URL, err error) {
+	actors := activity.GetActivityStreamsActor()
+	if actors == nil {
+		return
+	}
+	for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+		var id *url.URL
+		id, err = ToId(iter)
+		if err != nil {
+			return
+		}
+		ids = append(ids, id)
+	}
+	return
+}
+
+// filterBlockedActors removes the actors whose ids are blocked.
+func filterBlockedActors(actors []vocab.Type, blocked map[string]bool) (out []vocab.Type, err error) {
+	out = make([]vocab.Type, 0, len(actors))
+	for _, actor := range actors {
+		var id *url.URL
+		id, err = GetId(actor)
+		if err != nil {
+			return
+		}
+		if !blocked[id.String()] {
+			out = append(out, actor)
+		}
+	}
+	return
+}
+
+// rawUpdateObject returns the raw JSON map of the object at the index in the
+// raw JSON of an Update activity, or nil if it was not embedded as a map.
+func rawUpdateObject(rawActivity map[string]interface{}, idx int) map[string]interface{} {
+	switch v := rawActivity[objectProperty].(type) {
+	case map[string]interface{}:
+		if idx == 0 {
+			return v
+		}
+	case []interface{}:
+		if idx < len(v) {
+			if m, ok := v[idx].(map[string]interface{}); ok {
+				return m
+			}
+		}
+	}
+	return nil
+}
+
+// mergePartialUpdate applies a partial update to the serialized stored object,
+// following the ActivityPub client to server Update semantics: top-level
+// properties in the update replace the stored ones, and top-level properties
+// set to null in the update are removed from the stored object. The 'id' of
+// the stored object is never changed.
+func mergePartialUpdate(stored, update map[string]interface{}) map[string]interface{} {
+	for k, v := range update {
+		if k == idProperty {
+			continue
+		} else if v == nil {
+			delete(stored, k)
+		} else {
+			stored[k] = v
+		}
+	}
+	return stored
+}
@@ -1,6 +1,11 @@
 package pub
 
 import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
 	"testing"
 )
 
@@ -74,3 +79,103 @@ func TestHeaderIsActivityPubMediaType(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateBlocks(t *testing.T) {
+	ctx := context.Background()
+	actorIRI := mustParse(testMyActorIRI)
+	blockedIRI := mustParse(testFederatedActorIRI)
+	blockedIRI2 := mustParse(testFederatedActorIRI2)
+	t.Run("AddsWithoutDuplicates", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		blocks := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(blockedIRI)
+		blocks.SetActivityStreamsItems(items)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Update(ctx, blocks).Return(nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := updateBlocks(ctx, &testBlocksDatabase{MockDatabase: db, blocks: blocks}, actorIRI, []*url.URL{blockedIRI, blockedIRI2}, nil)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 2)
+		assertEqual(t, items.At(0).GetIRI().String(), blockedIRI2.String())
+	})
+	t.Run("Removes", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		blocks := streams.NewActivityStreamsCollection()
+		items := streams.NewActivityStreamsItemsProperty()
+		items.AppendIRI(blockedIRI)
+		items.AppendIRI(blockedIRI2)
+		blocks.SetActivityStreamsItems(items)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Update(ctx, blocks).Return(nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := updateBlocks(ctx, &testBlocksDatabase{MockDatabase: db, blocks: blocks}, actorIRI, nil, []*url.URL{blockedIRI})
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, items.Len(), 1)
+		assertEqual(t, items.At(0).GetIRI().String(), blockedIRI2.String())
+	})
+	t.Run("SkipsWithoutBlocksStore", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		// Run
+		err := updateBlocks(ctx, db, actorIRI, []*url.URL{blockedIRI}, nil)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
+
+// testBlocksDatabase is a MockDatabase that is a BlocksStore.
+type testBlocksDatabase struct {
+	*MockDatabase
+	blocks vocab.ActivityStreamsCollection
+}
+
+func (d *testBlocksDatabase) Blocks(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	return d.blocks, nil
+}
+
+func TestMergePartialUpdate(t *testing.T) {
+	// Setup
+	raw := map[string]interface{}{
+		"type": "Update",
+		"object": map[string]interface{}{
+			"id":      "https://example.com/other",
+			"type":    "Note",
+			"content": "updated",
+			"summary": nil,
+		},
+	}
+	stored := map[string]interface{}{
+		"id":        testNoteId1,
+		"type":      "Note",
+		"content":   "original",
+		"summary":   "a summary",
+		"published": "2019-01-01T00:00:00Z",
+	}
+	// Run
+	update := rawUpdateObject(raw, 0)
+	m := mergePartialUpdate(stored, update)
+	// Verify
+	assertEqual(t, rawUpdateObject(raw, 1) == nil, true)
+	assertEqual(t, m["id"], testNoteId1)
+	assertEqual(t, m["content"], "updated")
+	assertEqual(t, m["published"], "2019-01-01T00:00:00Z")
+	_, hasSummary := m["summary"]
+	assertEqual(t, hasSummary, false)
+}
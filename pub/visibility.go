@@ -0,0 +1,223 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// Audience is the addressing of an ActivityStreams value, classifying who it
+// is visible to.
+type Audience struct {
+	public      bool
+	localPublic map[string]bool
+	addressed   []*url.URL
+}
+
+// NewAudience returns the Audience addressed in the 'to', 'bto', 'cc', 'bcc',
+// and 'audience' properties of the value.
+func NewAudience(t vocab.Type) Audience {
+	var iris []*url.URL
+	if v, ok := t.(toer); ok {
+		if to := v.GetActivityStreamsTo(); to != nil {
+			for iter := to.Begin(); iter != to.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					iris = append(iris, id)
+				}
+			}
+		}
+	}
+	if v, ok := t.(btoer); ok {
+		if bto := v.GetActivityStreamsBto(); bto != nil {
+			for iter := bto.Begin(); iter != bto.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					iris = append(iris, id)
+				}
+			}
+		}
+	}
+	if v, ok := t.(ccer); ok {
+		if cc := v.GetActivityStreamsCc(); cc != nil {
+			for iter := cc.Begin(); iter != cc.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					iris = append(iris, id)
+				}
+			}
+		}
+	}
+	if v, ok := t.(bccer); ok {
+		if bcc := v.GetActivityStreamsBcc(); bcc != nil {
+			for iter := bcc.Begin(); iter != bcc.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					iris = append(iris, id)
+				}
+			}
+		}
+	}
+	if v, ok := t.(audiencer); ok {
+		if audience := v.GetActivityStreamsAudience(); audience != nil {
+			for iter := audience.Begin(); iter != audience.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					iris = append(iris, id)
+				}
+			}
+		}
+	}
+	a := Audience{localPublic: make(map[string]bool)}
+	for _, iri := range iris {
+		if IsPublic(iri.String()) {
+			a.public = true
+		} else if isLocalPublic(iri) {
+			a.localPublic[iri.Host] = true
+		} else {
+			a.addressed = append(a.addressed, iri)
+		}
+	}
+	return a
+}
+
+// isLocalPublic determines whether the IRI is the local Public collection of a
+// server, such as "https://example.com/#Public", with which Pleroma and Akkoma
+// address local-only values.
+func isLocalPublic(iri *url.URL) bool {
+	return iri.Fragment == "Public" && (iri.Path == "" || iri.Path == "/")
+}
+
+// Addressed returns the IRIs addressed, other than the Public collection and
+// the local Public collections.
+func (a Audience) Addressed() []*url.URL {
+	return append([]*url.URL(nil), a.addressed...)
+}
+
+// Addresses determines whether the IRI is addressed.
+func (a Audience) Addresses(iri *url.URL) bool {
+	for _, u := range a.addressed {
+		if u.String() == iri.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUnaddressed determines whether nothing is addressed, as is the case of
+// actors and collections.
+func (a Audience) IsUnaddressed() bool {
+	return !a.public && len(a.localPublic) == 0 && len(a.addressed) == 0
+}
+
+// IsPublic determines whether the Public collection is addressed, including
+// when only in 'cc' as for unlisted values.
+func (a Audience) IsPublic() bool {
+	return a.public
+}
+
+// IsFollowersOnly determines whether the value is not public but addresses
+// the followers collection.
+func (a Audience) IsFollowersOnly(followers *url.URL) bool {
+	return !a.public && followers != nil && a.Addresses(followers)
+}
+
+// IsDirect determines whether the value is neither public, local-only, nor
+// addressed to the followers collection, if any, but to specific actors or
+// collections.
+func (a Audience) IsDirect(followers *url.URL) bool {
+	return !a.public && len(a.localPublic) == 0 && len(a.addressed) > 0 && !a.IsFollowersOnly(followers)
+}
+
+// IsLocalOnly determines whether the value is not public but addresses the
+// local Public collection of the server's domain, "https://<domain>/#Public",
+// so that it is visible to the actors of the server only.
+func (a Audience) IsLocalOnly(domain string) bool {
+	return !a.public && a.localPublic[domain]
+}
+
+// mayView determines whether the viewer, which is nil if the request is not
+// authenticated, may obtain the value served from the domain.
+//
+// Public and unaddressed values may be viewed by anyone, and local-only values
+// only by the actors on the domain. Otherwise the viewer must own the value, be
+// addressed by it, or follow an owner whose followers it addresses.
+func mayView(c context.Context, db Database, t vocab.Type, viewer *url.URL, domain string) (bool, error) {
+	a := NewAudience(t)
+	if a.IsPublic() || a.IsUnaddressed() {
+		return true, nil
+	} else if viewer == nil {
+		return false, nil
+	} else if a.IsLocalOnly(domain) {
+		return viewer.Host == domain, nil
+	}
+	owners := ownerIds(t)
+	for _, owner := range owners {
+		if owner.String() == viewer.String() {
+			return true, nil
+		}
+	}
+	if a.Addresses(viewer) {
+		return true, nil
+	}
+	for _, owner := range owners {
+		if owns, err := db.Owns(c, owner); err != nil {
+			return false, err
+		} else if !owns {
+			continue
+		}
+		follows, err := followsOwner(c, db, a, owner, viewer)
+		if err != nil || follows {
+			return follows, err
+		}
+	}
+	return false, nil
+}
+
+// followsOwner determines whether the viewer is in the owner's followers
+// collection, and the Audience addresses it.
+//
+// Acquires and releases the lock for the owner.
+func followsOwner(c context.Context, db Database, a Audience, owner, viewer *url.URL) (bool, error) {
+	if err := db.Lock(c, owner); err != nil {
+		return false, err
+	}
+	defer db.Unlock(c, owner)
+	followers, err := db.Followers(c, owner)
+	if err != nil {
+		return false, err
+	}
+	id := followers.GetActivityStreamsId()
+	if id == nil || !a.IsFollowersOnly(id.Get()) {
+		return false, nil
+	}
+	items := followers.GetActivityStreamsItems()
+	if items == nil {
+		return false, nil
+	}
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		if follower, err := ToId(iter); err == nil && follower.String() == viewer.String() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ownerIds returns the ids in the 'attributedTo' and 'actor' properties of the
+// value.
+func ownerIds(t vocab.Type) (ids []*url.URL) {
+	if v, ok := t.(attributedToer); ok {
+		if at := v.GetActivityStreamsAttributedTo(); at != nil {
+			for iter := at.Begin(); iter != at.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	if v, ok := t.(actorer); ok {
+		if actor := v.GetActivityStreamsActor(); actor != nil {
+			for iter := actor.Begin(); iter != actor.End(); iter = iter.Next() {
+				if id, err := ToId(iter); err == nil {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	return
+}
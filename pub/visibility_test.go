@@ -0,0 +1,184 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newAddressedNote returns a Note attributed to this server's actor and
+// addressed with the 'to' and 'cc' IRIs.
+func newAddressedNote(to []string, cc []string) vocab.ActivityStreamsNote {
+	note := streams.NewActivityStreamsNote()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(mustParse(testNoteId1))
+	note.SetActivityStreamsId(id)
+	at := streams.NewActivityStreamsAttributedToProperty()
+	at.AppendIRI(mustParse(testMyActorIRI))
+	note.SetActivityStreamsAttributedTo(at)
+	toProp := streams.NewActivityStreamsToProperty()
+	for _, iri := range to {
+		toProp.AppendIRI(mustParse(iri))
+	}
+	note.SetActivityStreamsTo(toProp)
+	ccProp := streams.NewActivityStreamsCcProperty()
+	for _, iri := range cc {
+		ccProp.AppendIRI(mustParse(iri))
+	}
+	note.SetActivityStreamsCc(ccProp)
+	return note
+}
+
+func TestAudience(t *testing.T) {
+	followers := mustParse(testMyFollowersIRI)
+	tests := []struct {
+		name      string
+		t         vocab.Type
+		public    bool
+		followers bool
+		direct    bool
+		local     bool
+	}{
+		{"Public", newAddressedNote([]string{PublicActivityPubIRI}, []string{testMyFollowersIRI}), true, false, false, false},
+		{"Unlisted", newAddressedNote([]string{testMyFollowersIRI}, []string{PublicActivityPubIRI}), true, false, false, false},
+		{"FollowersOnly", newAddressedNote([]string{testMyFollowersIRI}, []string{testFederatedActorIRI}), false, true, false, false},
+		{"Direct", newAddressedNote([]string{testFederatedActorIRI}, nil), false, false, true, false},
+		{"LocalOnly", newAddressedNote([]string{"https://example.com/#Public"}, []string{testMyFollowersIRI}), false, true, false, true},
+		{"LocalOnlyElsewhere", newAddressedNote([]string{"https://other.example.com/#Public"}, nil), false, false, false, false},
+		{"Unaddressed", streams.NewActivityStreamsPerson(), false, false, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := NewAudience(test.t)
+			assertEqual(t, a.IsPublic(), test.public)
+			assertEqual(t, a.IsFollowersOnly(followers), test.followers)
+			assertEqual(t, a.IsDirect(followers), test.direct)
+			assertEqual(t, a.IsLocalOnly("example.com"), test.local)
+		})
+	}
+}
+
+func TestActivityStreamsHandlerVisibility(t *testing.T) {
+	ctx := context.Background()
+	authFn := func(c context.Context, w http.ResponseWriter, r *http.Request) (bool, error) {
+		return false, nil
+	}
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest("GET", testNoteId1, nil)
+		req.Header.Set("Accept", acceptHeaderValue)
+		return req
+	}
+	setupFn := func(ctl *gomock.Controller, note vocab.Type) (db *MockDatabase, cl *MockClock) {
+		db = NewMockDatabase(ctl)
+		cl = NewMockClock(ctl)
+		db.EXPECT().Lock(gomock.Any(), mustParse(testNoteId1))
+		db.EXPECT().Get(gomock.Any(), mustParse(testNoteId1)).Return(note, nil)
+		db.EXPECT().Unlock(gomock.Any(), mustParse(testNoteId1))
+		return
+	}
+	followersOf := func(actors ...string) vocab.ActivityStreamsCollection {
+		col := streams.NewActivityStreamsCollection()
+		id := streams.NewActivityStreamsIdProperty()
+		id.Set(mustParse(testMyFollowersIRI))
+		col.SetActivityStreamsId(id)
+		items := streams.NewActivityStreamsItemsProperty()
+		for _, a := range actors {
+			items.AppendIRI(mustParse(a))
+		}
+		col.SetActivityStreamsItems(items)
+		return col
+	}
+	t.Run("ServesPublicToAnyone", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl, newAddressedNote([]string{PublicActivityPubIRI}, nil))
+		cl.EXPECT().Now().Return(now())
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActivityStreamsHandler(authFn, db, cl)(ctx, resp, newRequest())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("HidesFollowersOnlyFromUnauthenticated", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl, newAddressedNote([]string{testMyFollowersIRI}, nil))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActivityStreamsHandler(authFn, db, cl)(ctx, resp, newRequest())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusNotFound)
+	})
+	t.Run("ServesFollowersOnlyToFollower", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl, newAddressedNote([]string{testMyFollowersIRI}, nil))
+		db.EXPECT().Owns(gomock.Any(), mustParse(testMyActorIRI)).Return(true, nil)
+		db.EXPECT().Lock(gomock.Any(), mustParse(testMyActorIRI))
+		db.EXPECT().Followers(gomock.Any(), mustParse(testMyActorIRI)).Return(followersOf(testFederatedActorIRI), nil)
+		db.EXPECT().Unlock(gomock.Any(), mustParse(testMyActorIRI))
+		cl.EXPECT().Now().Return(now())
+		c := context.WithValue(ctx, signerContextKey{}, mustParse(testFederatedActorIRI))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActivityStreamsHandler(authFn, db, cl)(c, resp, newRequest())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("HidesFollowersOnlyFromOthers", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl, newAddressedNote([]string{testMyFollowersIRI}, nil))
+		db.EXPECT().Owns(gomock.Any(), mustParse(testMyActorIRI)).Return(true, nil)
+		db.EXPECT().Lock(gomock.Any(), mustParse(testMyActorIRI))
+		db.EXPECT().Followers(gomock.Any(), mustParse(testMyActorIRI)).Return(followersOf(testFederatedActorIRI2), nil)
+		db.EXPECT().Unlock(gomock.Any(), mustParse(testMyActorIRI))
+		c := context.WithValue(ctx, signerContextKey{}, mustParse(testFederatedActorIRI))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActivityStreamsHandler(authFn, db, cl)(c, resp, newRequest())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusNotFound)
+	})
+	t.Run("ServesLocalOnlyToLocalActors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl, newAddressedNote([]string{"https://example.com/#Public"}, nil))
+		cl.EXPECT().Now().Return(now())
+		c := context.WithValue(ctx, signerContextKey{}, mustParse(testMyActorIRI))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActivityStreamsHandler(authFn, db, cl)(c, resp, newRequest())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("ServesDirectToAddressee", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cl := setupFn(ctl, newAddressedNote([]string{testFederatedActorIRI}, nil))
+		cl.EXPECT().Now().Return(now())
+		req := newRequest()
+		req = req.WithContext(context.WithValue(req.Context(), signerContextKey{}, mustParse(testFederatedActorIRI)))
+		resp := httptest.NewRecorder()
+		// Run
+		_, err := NewActivityStreamsHandler(authFn, db, cl)(ctx, resp, req)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+}
@@ -0,0 +1,260 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	// WebfingerPath is the path at which WebFinger lookups are served.
+	WebfingerPath = "/.well-known/webfinger"
+	// WebfingerSubscribeRel is the rel of the link template used by
+	// Mastodon for remote follows, in which "{uri}" is replaced with the
+	// IRI of the account to follow.
+	WebfingerSubscribeRel = "http://ostatus.org/schema/1.0/subscribe"
+	// webfingerProfileRel is the rel of the link to an actor's profile
+	// page.
+	webfingerProfileRel = "http://webfinger.net/rel/profile-page"
+	// webfingerSelfRel is the rel of the link to the actor document.
+	webfingerSelfRel = "self"
+	// webfingerResourceQuery is the query parameter holding the looked up
+	// resource.
+	webfingerResourceQuery = "resource"
+	// webfingerContentType is the media type of a JSON Resource Descriptor.
+	webfingerContentType = "application/jrd+json"
+	// acctScheme is the scheme of the account URIs looked up by WebFinger.
+	acctScheme = "acct:"
+)
+
+// WebfingerLink is a link of a WebfingerResource.
+type WebfingerLink struct {
+	Rel      string `json:"rel"`
+	Type     string `json:"type,omitempty"`
+	Href     string `json:"href,omitempty"`
+	Template string `json:"template,omitempty"`
+}
+
+// WebfingerResource is the JSON Resource Descriptor describing an actor in
+// answer to a WebFinger lookup.
+type WebfingerResource struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []WebfingerLink `json:"links"`
+}
+
+// WebfingerActorFunc returns the IRI of the actor with the username, or nil if
+// there is no such actor.
+type WebfingerActorFunc func(c context.Context, username string) (*url.URL, error)
+
+// Webfinger answers WebFinger lookups of the actors owned by this server,
+// which peers such as Mastodon require to find an actor from its "@user@host"
+// handle.
+//
+// A resource may be an acct: URI or the IRI of an actor. The actor is obtained
+// from the Database, and its subject uses its 'preferredUsername'. Its id and
+// the IRIs in its 'url' property are listed as aliases, and the first 'url'
+// other than its id is linked as its profile page.
+type Webfinger struct {
+	// Templates are link templates added to every answer, by rel, such
+	// as the WebfingerSubscribeRel template of remote follows.
+	Templates map[string]string
+
+	host     string
+	actorIRI WebfingerActorFunc
+	db       Database
+}
+
+// NewWebfinger creates a Webfinger answering lookups of the accounts on the
+// host, whose actors are found by the function.
+func NewWebfinger(host string, actorIRI WebfingerActorFunc, db Database) *Webfinger {
+	return &Webfinger{
+		Templates: make(map[string]string),
+		host:      host,
+		actorIRI:  actorIRI,
+		db:        db,
+	}
+}
+
+// Lookup returns the resource describing the actor identified by the acct: URI
+// or actor IRI, or nil if it is not an actor owned by this server.
+func (f *Webfinger) Lookup(c context.Context, resource string) (*WebfingerResource, error) {
+	var actorIRI *url.URL
+	username := ""
+	if strings.HasPrefix(resource, "http://") || strings.HasPrefix(resource, "https://") {
+		iri, err := url.Parse(resource)
+		if err != nil {
+			return nil, err
+		}
+		actorIRI = iri
+	} else {
+		var host string
+		var err error
+		if username, host, err = parseAcct(resource); err != nil {
+			return nil, err
+		} else if !strings.EqualFold(host, f.host) {
+			return nil, nil
+		}
+		if actorIRI, err = f.actorIRI(c, username); err != nil || actorIRI == nil {
+			return nil, err
+		}
+	}
+	actor, err := f.getActor(c, actorIRI)
+	if err != nil || actor == nil {
+		return nil, err
+	}
+	if pu := preferredUsername(actor); len(pu) > 0 {
+		username = pu
+	} else if len(username) == 0 {
+		// Only actors are looked up by their IRI.
+		return nil, nil
+	}
+	r := &WebfingerResource{
+		Subject: acctScheme + username + "@" + f.host,
+		Aliases: []string{actorIRI.String()},
+		Links: []WebfingerLink{{
+			Rel:  webfingerSelfRel,
+			Type: activityStreamsMediaTypes[0],
+			Href: actorIRI.String(),
+		}},
+	}
+	for _, u := range actorUrls(actor) {
+		if u.String() == actorIRI.String() {
+			continue
+		}
+		r.Aliases = append(r.Aliases, u.String())
+		if len(r.Aliases) == 2 {
+			r.Links = append(r.Links, WebfingerLink{
+				Rel:  webfingerProfileRel,
+				Type: "text/html",
+				Href: u.String(),
+			})
+		}
+	}
+	rels := make([]string, 0, len(f.Templates))
+	for rel := range f.Templates {
+		rels = append(rels, rel)
+	}
+	sort.Strings(rels)
+	for _, rel := range rels {
+		r.Links = append(r.Links, WebfingerLink{
+			Rel:      rel,
+			Template: f.Templates[rel],
+		})
+	}
+	return r, nil
+}
+
+// NewHandler creates a HandlerFunc answering GET requests to the WebfingerPath.
+// Requests to any other path are not handled.
+//
+// Responds with a 400 Bad Request if the resource is missing or invalid, and
+// with a 404 Not Found if it is not an actor owned by this server.
+func (f *Webfinger) NewHandler() HandlerFunc {
+	return func(c context.Context, w http.ResponseWriter, r *http.Request) (isASRequest bool, err error) {
+		if r.Method != "GET" || r.URL.Path != WebfingerPath {
+			return
+		}
+		isASRequest = true
+		resource := r.URL.Query().Get(webfingerResourceQuery)
+		if len(resource) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		res, err := f.Lookup(c, resource)
+		if err != nil {
+			logEntry(c, LogLevelDebug, "webfinger lookup failed",
+				LogField{Key: "resource", Value: resource}, errorLogField(err))
+			err = nil
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		} else if res == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		raw, err := json.Marshal(res)
+		if err != nil {
+			return
+		}
+		w.Header().Set(contentTypeHeader, webfingerContentType)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.WriteHeader(http.StatusOK)
+		n, err := w.Write(raw)
+		if err != nil {
+			return
+		} else if n != len(raw) {
+			err = fmt.Errorf("only wrote %d of %d bytes", n, len(raw))
+			return
+		}
+		return
+	}
+}
+
+// getActor returns the actor at the IRI, or nil if it is not owned by this
+// server or does not exist.
+//
+// Acquires and releases the lock of the IRI.
+func (f *Webfinger) getActor(c context.Context, iri *url.URL) (vocab.Type, error) {
+	if err := f.db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer f.db.Unlock(c, iri)
+	if owns, err := f.db.Owns(c, iri); err != nil || !owns {
+		return nil, err
+	}
+	if exists, err := f.db.Exists(c, iri); err != nil || !exists {
+		return nil, err
+	}
+	return f.db.Get(c, iri)
+}
+
+// parseAcct returns the username and host of an acct: URI. The scheme and a
+// leading "@" are optional, as some peers omit them.
+func parseAcct(resource string) (username, host string, err error) {
+	acct := strings.TrimPrefix(strings.TrimPrefix(resource, acctScheme), "@")
+	i := strings.LastIndex(acct, "@")
+	if i <= 0 || i == len(acct)-1 {
+		err = fmt.Errorf("invalid webfinger resource: %q", resource)
+		return
+	}
+	username, host = acct[:i], acct[i+1:]
+	return
+}
+
+// preferredUsername returns the string 'preferredUsername' of the actor.
+func preferredUsername(t vocab.Type) string {
+	pu, ok := t.(preferredUsernamer)
+	if !ok {
+		return ""
+	}
+	p := pu.GetActivityStreamsPreferredUsername()
+	if p == nil || !p.IsXMLSchemaString() {
+		return ""
+	}
+	return p.GetXMLSchemaString()
+}
+
+// actorUrls returns the IRIs in the 'url' property of the actor.
+func actorUrls(t vocab.Type) (iris []*url.URL) {
+	u, ok := t.(urler)
+	if !ok {
+		return
+	}
+	p := u.GetActivityStreamsUrl()
+	if p == nil {
+		return
+	}
+	for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+		if iter.IsXMLSchemaAnyURI() {
+			iris = append(iris, iter.GetXMLSchemaAnyURI())
+		} else if iter.IsIRI() {
+			iris = append(iris, iter.GetIRI())
+		}
+	}
+	return
+}
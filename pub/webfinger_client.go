@@ -0,0 +1,185 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultWebfingerTTL is the default amount of time a resolved handle is
+// cached by a WebfingerClient.
+const DefaultWebfingerTTL = 24 * time.Hour
+
+// cachedActorIRI is an actor IRI cached by a WebfingerClient.
+type cachedActorIRI struct {
+	iri     *url.URL
+	expires time.Time
+}
+
+// WebfingerClient resolves the handles of accounts, such as "@user@host" or
+// "acct:user@host", to the IRIs of their actors with WebFinger, caching the
+// results in memory.
+//
+// Lookups are always made over HTTPS, and actor IRIs not using HTTPS are
+// refused.
+type WebfingerClient struct {
+	// TTL is how long a resolved handle is cached.
+	TTL time.Duration
+
+	clock Clock
+	mu    sync.Mutex
+	cache map[string]cachedActorIRI
+}
+
+// NewWebfingerClient creates a WebfingerClient caching resolved handles for the
+// DefaultWebfingerTTL.
+func NewWebfingerClient(clock Clock) *WebfingerClient {
+	return &WebfingerClient{
+		TTL:   DefaultWebfingerTTL,
+		clock: clock,
+		cache: make(map[string]cachedActorIRI),
+	}
+}
+
+// Resolve returns the IRI of the actor with the handle, looking it up with the
+// Transport if it is not cached. The Transport is used with
+// DereferenceWebfinger if it is a WebfingerTransport.
+func (w *WebfingerClient) Resolve(c context.Context, tp Transport, handle string) (*url.URL, error) {
+	username, host, err := parseAcct(handle)
+	if err != nil {
+		return nil, err
+	} else if strings.ContainsAny(host, "/?#@") {
+		return nil, fmt.Errorf("invalid host in handle: %q", handle)
+	}
+	acct := strings.ToLower(username + "@" + host)
+	now := w.clock.Now()
+	w.mu.Lock()
+	cached, ok := w.cache[acct]
+	w.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.iri, nil
+	}
+	iri, err := lookupActorIRI(c, tp, username, host)
+	if err != nil {
+		return nil, err
+	}
+	w.mu.Lock()
+	w.cache[acct] = cachedActorIRI{
+		iri:     iri,
+		expires: now.Add(w.TTL),
+	}
+	w.mu.Unlock()
+	return iri, nil
+}
+
+// ResolveMentions sets the 'href' of the Mention tags of the value that only
+// have a "@user@host" 'name', such as those entered by a user, to the IRIs of
+// the mentioned actors.
+//
+// Returns the names of the mentions that could not be resolved, which are left
+// unchanged.
+func (w *WebfingerClient) ResolveMentions(c context.Context, tp Transport, t vocab.Type) (unresolved []string) {
+	tg, ok := t.(tagger)
+	if !ok {
+		return
+	}
+	tags := tg.GetActivityStreamsTag()
+	if tags == nil {
+		return
+	}
+	for iter := tags.Begin(); iter != tags.End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsMention() {
+			continue
+		}
+		m := iter.GetActivityStreamsMention()
+		if href := m.GetActivityStreamsHref(); href != nil && href.Get() != nil {
+			continue
+		}
+		name := mentionName(m)
+		if !strings.HasPrefix(name, "@") {
+			continue
+		}
+		iri, err := w.Resolve(c, tp, name)
+		if err != nil {
+			logEntry(c, LogLevelInfo, "resolving mention failed",
+				LogField{Key: "mention", Value: name}, errorLogField(err))
+			unresolved = append(unresolved, name)
+			continue
+		}
+		href := streams.NewActivityStreamsHrefProperty()
+		href.Set(iri)
+		m.SetActivityStreamsHref(href)
+	}
+	return
+}
+
+// Forget removes the handle from the cache, such as when its account has moved.
+func (w *WebfingerClient) Forget(handle string) {
+	username, host, err := parseAcct(handle)
+	if err != nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.cache, strings.ToLower(username+"@"+host))
+}
+
+// lookupActorIRI fetches the WebFinger resource of the account over HTTPS and
+// returns the IRI of its ActivityStreams 'self' link.
+func lookupActorIRI(c context.Context, tp Transport, username, host string) (*url.URL, error) {
+	q := url.Values{}
+	q.Set(webfingerResourceQuery, acctScheme+username+"@"+host)
+	iri := &url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     WebfingerPath,
+		RawQuery: q.Encode(),
+	}
+	var b []byte
+	var err error
+	if wt, ok := tp.(WebfingerTransport); ok {
+		b, err = wt.DereferenceWebfinger(c, iri)
+	} else {
+		b, err = tp.Dereference(c, iri)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var r WebfingerResource
+	if err = json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	for _, l := range r.Links {
+		if l.Rel != webfingerSelfRel || !headerIsActivityPubMediaType(l.Type) {
+			continue
+		}
+		actor, err := url.Parse(l.Href)
+		if err != nil {
+			return nil, err
+		} else if actor.Scheme != "https" {
+			return nil, fmt.Errorf("actor of %s@%s does not use https: %s", username, host, actor)
+		}
+		return actor, nil
+	}
+	return nil, fmt.Errorf("no actor found for %s@%s", username, host)
+}
+
+// mentionName returns the first string 'name' of a Mention.
+func mentionName(m vocab.ActivityStreamsMention) string {
+	name := m.GetActivityStreamsName()
+	if name == nil {
+		return ""
+	}
+	for iter := name.Begin(); iter != name.End(); iter = iter.Next() {
+		if iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"testing"
+	"time"
+)
+
+// mustWebfingerBytes serializes a WebFinger resource whose 'self' link is the
+// actor IRI.
+func mustWebfingerBytes(subject, actor string) []byte {
+	b, err := json.Marshal(&WebfingerResource{
+		Subject: subject,
+		Links: []WebfingerLink{{
+			Rel:  webfingerProfileRel,
+			Type: "text/html",
+			Href: "https://other.example.com/@sam",
+		}, {
+			Rel:  webfingerSelfRel,
+			Type: "application/activity+json",
+			Href: actor,
+		}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestWebfingerClient(t *testing.T) {
+	ctx := context.Background()
+	lookupIRI := mustParse("https://other.example.com/.well-known/webfinger?resource=acct%3Asam%40other.example.com")
+	setupFn := func(ctl *gomock.Controller) (cl *MockClock, tp *MockTransport, w *WebfingerClient) {
+		cl = NewMockClock(ctl)
+		tp = NewMockTransport(ctl)
+		w = NewWebfingerClient(cl)
+		return
+	}
+	t.Run("ResolvesAndCaches", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, w := setupFn(ctl)
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			tp.EXPECT().Dereference(ctx, lookupIRI).Return(mustWebfingerBytes("acct:sam@other.example.com", testFederatedActorIRI), nil),
+			cl.EXPECT().Now().Return(now().Add(time.Hour)),
+		)
+		// Run
+		first, err := w.Resolve(ctx, tp, "@sam@other.example.com")
+		assertEqual(t, err, nil)
+		second, err := w.Resolve(ctx, tp, "acct:Sam@other.example.com")
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, first.String(), testFederatedActorIRI)
+		assertEqual(t, second.String(), testFederatedActorIRI)
+	})
+	t.Run("LooksUpAgainOnceExpired", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, w := setupFn(ctl)
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			tp.EXPECT().Dereference(ctx, lookupIRI).Return(mustWebfingerBytes("acct:sam@other.example.com", testFederatedActorIRI), nil),
+			cl.EXPECT().Now().Return(now().Add(DefaultWebfingerTTL)),
+			tp.EXPECT().Dereference(ctx, lookupIRI).Return(mustWebfingerBytes("acct:sam@other.example.com", testFederatedActorIRI2), nil),
+		)
+		// Run
+		_, err := w.Resolve(ctx, tp, "sam@other.example.com")
+		assertEqual(t, err, nil)
+		iri, err := w.Resolve(ctx, tp, "sam@other.example.com")
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, iri.String(), testFederatedActorIRI2)
+	})
+	t.Run("RefusesActorWithoutHttps", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, w := setupFn(ctl)
+		gomock.InOrder(
+			cl.EXPECT().Now().Return(now()),
+			tp.EXPECT().Dereference(ctx, lookupIRI).Return(mustWebfingerBytes("acct:sam@other.example.com", "http://other.example.com/sam"), nil),
+		)
+		// Run
+		_, err := w.Resolve(ctx, tp, "sam@other.example.com")
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("RefusesInvalidHandle", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		// Run
+		_, err := w.Resolve(ctx, tp, "sam@other.example.com/path")
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ResolvesMentions", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		cl, tp, w := setupFn(ctl)
+		note := streams.NewActivityStreamsNote()
+		tags := streams.NewActivityStreamsTagProperty()
+		for _, n := range []string{"@sam@other.example.com", "@nobody@other.example.com"} {
+			m := streams.NewActivityStreamsMention()
+			name := streams.NewActivityStreamsNameProperty()
+			name.AppendXMLSchemaString(n)
+			m.SetActivityStreamsName(name)
+			tags.AppendActivityStreamsMention(m)
+		}
+		note.SetActivityStreamsTag(tags)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		tp.EXPECT().Dereference(ctx, lookupIRI).Return(mustWebfingerBytes("acct:sam@other.example.com", testFederatedActorIRI), nil)
+		tp.EXPECT().Dereference(ctx, mustParse("https://other.example.com/.well-known/webfinger?resource=acct%3Anobody%40other.example.com")).Return(nil, &HttpStatusError{StatusCode: 404})
+		// Run
+		unresolved := w.ResolveMentions(ctx, tp, note)
+		// Verify
+		assertEqual(t, len(unresolved), 1)
+		assertEqual(t, unresolved[0], "@nobody@other.example.com")
+		assertEqual(t, tags.At(0).GetActivityStreamsMention().GetActivityStreamsHref().Get().String(), testFederatedActorIRI)
+		assertEqual(t, tags.At(1).GetActivityStreamsMention().GetActivityStreamsHref() == nil, true)
+	})
+}
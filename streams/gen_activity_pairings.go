@@ -0,0 +1,201 @@
+package streams
+
+import vocab "github.com/go-fed/activity/streams/vocab"
+
+// SideEffectCategory categorizes the side effects of an activity as described by the ActivityPub specification.
+type SideEffectCategory string
+
+const (
+	// SideEffectNone means the activity has no side effects defined by the ActivityPub specification.
+	SideEffectNone SideEffectCategory = "none"
+	// SideEffectCreate means the activity creates its object.
+	SideEffectCreate SideEffectCategory = "create"
+	// SideEffectUpdate means the activity replaces or updates its object.
+	SideEffectUpdate SideEffectCategory = "update"
+	// SideEffectDelete means the activity deletes its object.
+	SideEffectDelete SideEffectCategory = "delete"
+	// SideEffectFollow means the activity requests to follow its object.
+	SideEffectFollow SideEffectCategory = "follow"
+	// SideEffectResponse means the activity responds to a previous activity, such as a Follow.
+	SideEffectResponse SideEffectCategory = "response"
+	// SideEffectCollection means the activity adds its object to, or removes it from, the target collection.
+	SideEffectCollection SideEffectCategory = "collection"
+	// SideEffectReaction means the activity adds its actor to a collection of reactions of its object, such as likes.
+	SideEffectReaction SideEffectCategory = "reaction"
+	// SideEffectShare means the activity adds its actor to the shares of its object.
+	SideEffectShare SideEffectCategory = "share"
+	// SideEffectUndo means the activity reverses the side effects of a previous activity.
+	SideEffectUndo SideEffectCategory = "undo"
+	// SideEffectBlock means the activity blocks its object from interacting with its actor.
+	SideEffectBlock SideEffectCategory = "block"
+	// SideEffectFlag means the activity reports its object for moderation.
+	SideEffectFlag SideEffectCategory = "flag"
+	// SideEffectMove means the activity moves its object from the origin to the target.
+	SideEffectMove SideEffectCategory = "move"
+)
+
+// ActivityPairing describes the object types an activity conventionally wraps and the category of its side effects. Object types that are extended by other types, such as Object, include all of the types extending from them.
+type ActivityPairing struct {
+	// Activity is the name of the activity type.
+	Activity string
+	// VocabularyURI is the vocabulary of the activity type.
+	VocabularyURI string
+	// Objects are the names of the object types conventionally wrapped, which is empty for intransitive activities.
+	Objects []string
+	// SideEffect is the category of the side effects of the activity.
+	SideEffect SideEffectCategory
+}
+
+// ActivityPairings is the table of activities and their conventional pairings, ordered by activity name.
+var ActivityPairings = []ActivityPairing{{
+	Activity:      "Accept",
+	Objects:       []string{"Follow", "Invite", "Offer"},
+	SideEffect:    SideEffectResponse,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Add",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectCollection,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Announce",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectShare,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Arrive",
+	Objects:       []string{},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Block",
+	Objects:       []string{"Application", "Group", "Organization", "Person", "Service"},
+	SideEffect:    SideEffectBlock,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Create",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectCreate,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Delete",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectDelete,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Dislike",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectReaction,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Flag",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectFlag,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Follow",
+	Objects:       []string{"Application", "Group", "Organization", "Person", "Service"},
+	SideEffect:    SideEffectFollow,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Ignore",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Invite",
+	Objects:       []string{"Event", "Group", "Organization"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Join",
+	Objects:       []string{"Event", "Group", "Organization"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Leave",
+	Objects:       []string{"Event", "Group", "Organization"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Like",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectReaction,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Listen",
+	Objects:       []string{"Audio", "Video"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Move",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectMove,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Offer",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Question",
+	Objects:       []string{},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Read",
+	Objects:       []string{"Article", "Document", "Note", "Page"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Reject",
+	Objects:       []string{"Follow", "Invite", "Offer"},
+	SideEffect:    SideEffectResponse,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Remove",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectCollection,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "TentativeAccept",
+	Objects:       []string{"Invite", "Offer"},
+	SideEffect:    SideEffectResponse,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "TentativeReject",
+	Objects:       []string{"Invite", "Offer"},
+	SideEffect:    SideEffectResponse,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Travel",
+	Objects:       []string{},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Undo",
+	Objects:       []string{"Activity"},
+	SideEffect:    SideEffectUndo,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "Update",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectUpdate,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}, {
+	Activity:      "View",
+	Objects:       []string{"Object"},
+	SideEffect:    SideEffectNone,
+	VocabularyURI: "https://www.w3.org/ns/activitystreams",
+}}
+
+// GetActivityPairing returns the ActivityPairing of the provided activity, and
+// false if the type is not a known activity.
+func GetActivityPairing(t vocab.Type) (ActivityPairing, bool) {
+	for _, p := range ActivityPairings {
+		if p.Activity == t.GetTypeName() && p.VocabularyURI == t.VocabularyURI() {
+			return p, true
+		}
+	}
+	return ActivityPairing{}, false
+}
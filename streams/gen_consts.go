@@ -45,6 +45,9 @@ var ActivityStreamsDislikeName string = "Dislike"
 // ActivityStreamsDocumentName is the string literal of the name for the Document type in the ActivityStreams vocabulary.
 var ActivityStreamsDocumentName string = "Document"
 
+// ActivityStreamsEmojiName is the string literal of the name for the Emoji type in the ActivityStreams vocabulary.
+var ActivityStreamsEmojiName string = "Emoji"
+
 // ActivityStreamsEventName is the string literal of the name for the Event type in the ActivityStreams vocabulary.
 var ActivityStreamsEventName string = "Event"
 
@@ -57,6 +60,9 @@ var ActivityStreamsFollowName string = "Follow"
 // ActivityStreamsGroupName is the string literal of the name for the Group type in the ActivityStreams vocabulary.
 var ActivityStreamsGroupName string = "Group"
 
+// ActivityStreamsHashtagName is the string literal of the name for the Hashtag type in the ActivityStreams vocabulary.
+var ActivityStreamsHashtagName string = "Hashtag"
+
 // ActivityStreamsIgnoreName is the string literal of the name for the Ignore type in the ActivityStreams vocabulary.
 var ActivityStreamsIgnoreName string = "Ignore"
 
@@ -120,6 +126,9 @@ var ActivityStreamsPlaceName string = "Place"
 // ActivityStreamsProfileName is the string literal of the name for the Profile type in the ActivityStreams vocabulary.
 var ActivityStreamsProfileName string = "Profile"
 
+// ActivityStreamsPropertyValueName is the string literal of the name for the PropertyValue type in the ActivityStreams vocabulary.
+var ActivityStreamsPropertyValueName string = "PropertyValue"
+
 // ActivityStreamsPublicKeyName is the string literal of the name for the PublicKey type in the ActivityStreams vocabulary.
 var ActivityStreamsPublicKeyName string = "PublicKey"
 
@@ -171,6 +180,9 @@ var ActivityStreamsAccuracyPropertyName string = "accuracy"
 // ActivityStreamsActorPropertyName is the string literal of the name for the actor property in the ActivityStreams vocabulary.
 var ActivityStreamsActorPropertyName string = "actor"
 
+// ActivityStreamsAlsoKnownAsPropertyName is the string literal of the name for the alsoKnownAs property in the ActivityStreams vocabulary.
+var ActivityStreamsAlsoKnownAsPropertyName string = "alsoKnownAs"
+
 // ActivityStreamsAltitudePropertyName is the string literal of the name for the altitude property in the ActivityStreams vocabulary.
 var ActivityStreamsAltitudePropertyName string = "altitude"
 
@@ -222,6 +234,12 @@ var ActivityStreamsDurationPropertyName string = "duration"
 // ActivityStreamsEndTimePropertyName is the string literal of the name for the endTime property in the ActivityStreams vocabulary.
 var ActivityStreamsEndTimePropertyName string = "endTime"
 
+// ActivityStreamsFeaturedPropertyName is the string literal of the name for the featured property in the ActivityStreams vocabulary.
+var ActivityStreamsFeaturedPropertyName string = "featured"
+
+// ActivityStreamsFeaturedTagsPropertyName is the string literal of the name for the featuredTags property in the ActivityStreams vocabulary.
+var ActivityStreamsFeaturedTagsPropertyName string = "featuredTags"
+
 // ActivityStreamsFirstPropertyName is the string literal of the name for the first property in the ActivityStreams vocabulary.
 var ActivityStreamsFirstPropertyName string = "first"
 
@@ -285,6 +303,9 @@ var ActivityStreamsLocationPropertyName string = "location"
 // ActivityStreamsLongitudePropertyName is the string literal of the name for the longitude property in the ActivityStreams vocabulary.
 var ActivityStreamsLongitudePropertyName string = "longitude"
 
+// ActivityStreamsManuallyApprovesFollowersPropertyName is the string literal of the name for the manuallyApprovesFollowers property in the ActivityStreams vocabulary.
+var ActivityStreamsManuallyApprovesFollowersPropertyName string = "manuallyApprovesFollowers"
+
 // ActivityStreamsMediaTypePropertyName is the string literal of the name for the mediaType property in the ActivityStreams vocabulary.
 var ActivityStreamsMediaTypePropertyName string = "mediaType"
 
@@ -399,5 +420,8 @@ var ActivityStreamsUpdatedPropertyName string = "updated"
 // ActivityStreamsUrlPropertyName is the string literal of the name for the url property in the ActivityStreams vocabulary.
 var ActivityStreamsUrlPropertyName string = "url"
 
+// ActivityStreamsValuePropertyName is the string literal of the name for the value property in the ActivityStreams vocabulary.
+var ActivityStreamsValuePropertyName string = "value"
+
 // ActivityStreamsWidthPropertyName is the string literal of the name for the width property in the ActivityStreams vocabulary.
 var ActivityStreamsWidthPropertyName string = "width"
@@ -3,6 +3,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -19,6 +20,8 @@ import (
 	propertydescribes "github.com/go-fed/activity/streams/impl/activitystreams/property_describes"
 	propertyduration "github.com/go-fed/activity/streams/impl/activitystreams/property_duration"
 	propertyendtime "github.com/go-fed/activity/streams/impl/activitystreams/property_endtime"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/activitystreams/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/activitystreams/property_featuredtags"
 	propertyfirst "github.com/go-fed/activity/streams/impl/activitystreams/property_first"
 	propertyfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_followers"
 	propertyfollowing "github.com/go-fed/activity/streams/impl/activitystreams/property_following"
@@ -40,6 +43,7 @@ import (
 	propertylikes "github.com/go-fed/activity/streams/impl/activitystreams/property_likes"
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
+	propertymanuallyapprovesfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_manuallyapprovesfollowers"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
@@ -75,6 +79,7 @@ import (
 	propertyunits "github.com/go-fed/activity/streams/impl/activitystreams/property_units"
 	propertyupdated "github.com/go-fed/activity/streams/impl/activitystreams/property_updated"
 	propertyurl "github.com/go-fed/activity/streams/impl/activitystreams/property_url"
+	propertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/property_value"
 	propertywidth "github.com/go-fed/activity/streams/impl/activitystreams/property_width"
 	typeaccept "github.com/go-fed/activity/streams/impl/activitystreams/type_accept"
 	typeactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_activity"
@@ -91,10 +96,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -116,6 +123,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -148,6 +156,7 @@ func init() {
 	mgr = &Manager{}
 	propertyaccuracy.SetManager(mgr)
 	propertyactor.SetManager(mgr)
+	propertyalsoknownas.SetManager(mgr)
 	propertyaltitude.SetManager(mgr)
 	propertyanyof.SetManager(mgr)
 	propertyattachment.SetManager(mgr)
@@ -164,6 +173,8 @@ func init() {
 	propertydescribes.SetManager(mgr)
 	propertyduration.SetManager(mgr)
 	propertyendtime.SetManager(mgr)
+	propertyfeatured.SetManager(mgr)
+	propertyfeaturedtags.SetManager(mgr)
 	propertyfirst.SetManager(mgr)
 	propertyfollowers.SetManager(mgr)
 	propertyfollowing.SetManager(mgr)
@@ -185,6 +196,7 @@ func init() {
 	propertylikes.SetManager(mgr)
 	propertylocation.SetManager(mgr)
 	propertylongitude.SetManager(mgr)
+	propertymanuallyapprovesfollowers.SetManager(mgr)
 	propertymediatype.SetManager(mgr)
 	propertyname.SetManager(mgr)
 	propertynext.SetManager(mgr)
@@ -220,6 +232,7 @@ func init() {
 	propertyunits.SetManager(mgr)
 	propertyupdated.SetManager(mgr)
 	propertyurl.SetManager(mgr)
+	propertyvalue.SetManager(mgr)
 	propertywidth.SetManager(mgr)
 	typeaccept.SetManager(mgr)
 	typeactivity.SetManager(mgr)
@@ -236,10 +249,12 @@ func init() {
 	typedelete.SetManager(mgr)
 	typedislike.SetManager(mgr)
 	typedocument.SetManager(mgr)
+	typeemoji.SetManager(mgr)
 	typeevent.SetManager(mgr)
 	typeflag.SetManager(mgr)
 	typefollow.SetManager(mgr)
 	typegroup.SetManager(mgr)
+	typehashtag.SetManager(mgr)
 	typeignore.SetManager(mgr)
 	typeimage.SetManager(mgr)
 	typeintransitiveactivity.SetManager(mgr)
@@ -261,6 +276,7 @@ func init() {
 	typeperson.SetManager(mgr)
 	typeplace.SetManager(mgr)
 	typeprofile.SetManager(mgr)
+	typepropertyvalue.SetManager(mgr)
 	typepublickey.SetManager(mgr)
 	typequestion.SetManager(mgr)
 	typeread.SetManager(mgr)
@@ -291,10 +307,12 @@ func init() {
 	typedelete.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typedislike.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typedocument.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typeemoji.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeevent.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeflag.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typefollow.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typegroup.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typehashtag.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeignore.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeimage.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeintransitiveactivity.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
@@ -316,6 +334,7 @@ func init() {
 	typeperson.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeplace.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeprofile.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
+	typepropertyvalue.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typepublickey.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typequestion.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
 	typeread.SetTypePropertyConstructor(NewActivityStreamsTypeProperty)
@@ -59,6 +59,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDocument) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ActivityStreamsEmoji) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsEvent) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsFlag) error:
@@ -67,6 +69,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsGroup) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ActivityStreamsHashtag) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsIgnore) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsImage) error:
@@ -109,6 +113,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsProfile) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.ActivityStreamsPropertyValue) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsPublicKey) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsQuestion) error:
@@ -387,6 +393,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ActivityStreamsAlias+"Emoji" {
+			v, err := mgr.DeserializeEmojiActivityStreams()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ActivityStreamsEmoji) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Event" {
 			v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -431,6 +448,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ActivityStreamsAlias+"Hashtag" {
+			v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ActivityStreamsHashtag) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Ignore" {
 			v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -662,6 +690,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == ActivityStreamsAlias+"PropertyValue" {
+			v, err := mgr.DeserializePropertyValueActivityStreams()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.ActivityStreamsPropertyValue) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"PublicKey" {
 			v, err := mgr.DeserializePublicKeyActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -3,6 +3,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -19,6 +20,8 @@ import (
 	propertydescribes "github.com/go-fed/activity/streams/impl/activitystreams/property_describes"
 	propertyduration "github.com/go-fed/activity/streams/impl/activitystreams/property_duration"
 	propertyendtime "github.com/go-fed/activity/streams/impl/activitystreams/property_endtime"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/activitystreams/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/activitystreams/property_featuredtags"
 	propertyfirst "github.com/go-fed/activity/streams/impl/activitystreams/property_first"
 	propertyfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_followers"
 	propertyfollowing "github.com/go-fed/activity/streams/impl/activitystreams/property_following"
@@ -40,6 +43,7 @@ import (
 	propertylikes "github.com/go-fed/activity/streams/impl/activitystreams/property_likes"
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
+	propertymanuallyapprovesfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_manuallyapprovesfollowers"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
@@ -75,6 +79,7 @@ import (
 	propertyunits "github.com/go-fed/activity/streams/impl/activitystreams/property_units"
 	propertyupdated "github.com/go-fed/activity/streams/impl/activitystreams/property_updated"
 	propertyurl "github.com/go-fed/activity/streams/impl/activitystreams/property_url"
+	propertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/property_value"
 	propertywidth "github.com/go-fed/activity/streams/impl/activitystreams/property_width"
 	typeaccept "github.com/go-fed/activity/streams/impl/activitystreams/type_accept"
 	typeactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_activity"
@@ -91,10 +96,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -116,6 +123,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -205,6 +213,19 @@ func (this Manager) DeserializeAddActivityStreams() func(map[string]interface{},
 	}
 }
 
+// DeserializeAlsoKnownAsPropertyActivityStreams returns the deserialization
+// method for the "ActivityStreamsAlsoKnownAsProperty" non-functional property
+// in the vocabulary "ActivityStreams"
+func (this Manager) DeserializeAlsoKnownAsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+		i, err := propertyalsoknownas.DeserializeAlsoKnownAsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeAltitudePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAltitudeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -556,6 +577,19 @@ func (this Manager) DeserializeDurationPropertyActivityStreams() func(map[string
 	}
 }
 
+// DeserializeEmojiActivityStreams returns the deserialization method for the
+// "ActivityStreamsEmoji" non-functional property in the vocabulary
+// "ActivityStreams"
+func (this Manager) DeserializeEmojiActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEmoji, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEmoji, error) {
+		i, err := typeemoji.DeserializeEmoji(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeEndTimePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsEndTimeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -582,6 +616,32 @@ func (this Manager) DeserializeEventActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeFeaturedPropertyActivityStreams returns the deserialization method
+// for the "ActivityStreamsFeaturedProperty" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializeFeaturedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFeaturedProperty, error) {
+		i, err := propertyfeatured.DeserializeFeaturedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeFeaturedTagsPropertyActivityStreams returns the deserialization
+// method for the "ActivityStreamsFeaturedTagsProperty" non-functional
+// property in the vocabulary "ActivityStreams"
+func (this Manager) DeserializeFeaturedTagsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error) {
+		i, err := propertyfeaturedtags.DeserializeFeaturedTagsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeFirstPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsFirstProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -686,6 +746,19 @@ func (this Manager) DeserializeGroupActivityStreams() func(map[string]interface{
 	}
 }
 
+// DeserializeHashtagActivityStreams returns the deserialization method for the
+// "ActivityStreamsHashtag" non-functional property in the vocabulary
+// "ActivityStreams"
+func (this Manager) DeserializeHashtagActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHashtag, error) {
+		i, err := typehashtag.DeserializeHashtag(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeHeightPropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsHeightProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1011,6 +1084,20 @@ func (this Manager) DeserializeLongitudePropertyActivityStreams() func(map[strin
 	}
 }
 
+// DeserializeManuallyApprovesFollowersPropertyActivityStreams returns the
+// deserialization method for the
+// "ActivityStreamsManuallyApprovesFollowersProperty" non-functional property
+// in the vocabulary "ActivityStreams"
+func (this Manager) DeserializeManuallyApprovesFollowersPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+		i, err := propertymanuallyapprovesfollowers.DeserializeManuallyApprovesFollowersProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeMediaTypePropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsMediaTypeProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1336,6 +1423,19 @@ func (this Manager) DeserializeProfileActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializePropertyValueActivityStreams returns the deserialization method for
+// the "ActivityStreamsPropertyValue" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializePropertyValueActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPropertyValue, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPropertyValue, error) {
+		i, err := typepropertyvalue.DeserializePropertyValue(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePublicKeyActivityStreams returns the deserialization method for the
 // "ActivityStreamsPublicKey" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1791,6 +1891,19 @@ func (this Manager) DeserializeUrlPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeValuePropertyActivityStreams returns the deserialization method for
+// the "ActivityStreamsValueProperty" non-functional property in the
+// vocabulary "ActivityStreams"
+func (this Manager) DeserializeValuePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsValueProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsValueProperty, error) {
+		i, err := propertyvalue.DeserializeValueProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeVideoActivityStreams returns the deserialization method for the
 // "ActivityStreamsVideo" non-functional property in the vocabulary
 // "ActivityStreams"
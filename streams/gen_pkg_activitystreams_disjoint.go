@@ -16,10 +16,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -41,6 +43,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -149,6 +152,12 @@ func ActivityStreamsDocumentIsDisjointWith(other vocab.Type) bool {
 	return typedocument.DocumentIsDisjointWith(other)
 }
 
+// ActivityStreamsEmojiIsDisjointWith returns true if Emoji is disjoint with the
+// other's type.
+func ActivityStreamsEmojiIsDisjointWith(other vocab.Type) bool {
+	return typeemoji.EmojiIsDisjointWith(other)
+}
+
 // ActivityStreamsEventIsDisjointWith returns true if Event is disjoint with the
 // other's type.
 func ActivityStreamsEventIsDisjointWith(other vocab.Type) bool {
@@ -173,6 +182,12 @@ func ActivityStreamsGroupIsDisjointWith(other vocab.Type) bool {
 	return typegroup.GroupIsDisjointWith(other)
 }
 
+// ActivityStreamsHashtagIsDisjointWith returns true if Hashtag is disjoint with
+// the other's type.
+func ActivityStreamsHashtagIsDisjointWith(other vocab.Type) bool {
+	return typehashtag.HashtagIsDisjointWith(other)
+}
+
 // ActivityStreamsIgnoreIsDisjointWith returns true if Ignore is disjoint with the
 // other's type.
 func ActivityStreamsIgnoreIsDisjointWith(other vocab.Type) bool {
@@ -299,6 +314,12 @@ func ActivityStreamsProfileIsDisjointWith(other vocab.Type) bool {
 	return typeprofile.ProfileIsDisjointWith(other)
 }
 
+// ActivityStreamsPropertyValueIsDisjointWith returns true if PropertyValue is
+// disjoint with the other's type.
+func ActivityStreamsPropertyValueIsDisjointWith(other vocab.Type) bool {
+	return typepropertyvalue.PropertyValueIsDisjointWith(other)
+}
+
 // ActivityStreamsPublicKeyIsDisjointWith returns true if PublicKey is disjoint
 // with the other's type.
 func ActivityStreamsPublicKeyIsDisjointWith(other vocab.Type) bool {
@@ -16,10 +16,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -41,6 +43,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -164,6 +167,13 @@ func ActivityStreamsDocumentIsExtendedBy(other vocab.Type) bool {
 	return typedocument.DocumentIsExtendedBy(other)
 }
 
+// ActivityStreamsEmojiIsExtendedBy returns true if the other's type extends from
+// Emoji. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ActivityStreamsEmojiIsExtendedBy(other vocab.Type) bool {
+	return typeemoji.EmojiIsExtendedBy(other)
+}
+
 // ActivityStreamsEventIsExtendedBy returns true if the other's type extends from
 // Event. Note that it returns false if the types are the same; see the
 // "IsOrExtends" variant instead.
@@ -192,6 +202,13 @@ func ActivityStreamsGroupIsExtendedBy(other vocab.Type) bool {
 	return typegroup.GroupIsExtendedBy(other)
 }
 
+// ActivityStreamsHashtagIsExtendedBy returns true if the other's type extends
+// from Hashtag. Note that it returns false if the types are the same; see the
+// "IsOrExtends" variant instead.
+func ActivityStreamsHashtagIsExtendedBy(other vocab.Type) bool {
+	return typehashtag.HashtagIsExtendedBy(other)
+}
+
 // ActivityStreamsIgnoreIsExtendedBy returns true if the other's type extends from
 // Ignore. Note that it returns false if the types are the same; see the
 // "IsOrExtends" variant instead.
@@ -339,6 +356,13 @@ func ActivityStreamsProfileIsExtendedBy(other vocab.Type) bool {
 	return typeprofile.ProfileIsExtendedBy(other)
 }
 
+// ActivityStreamsPropertyValueIsExtendedBy returns true if the other's type
+// extends from PropertyValue. Note that it returns false if the types are the
+// same; see the "IsOrExtends" variant instead.
+func ActivityStreamsPropertyValueIsExtendedBy(other vocab.Type) bool {
+	return typepropertyvalue.PropertyValueIsExtendedBy(other)
+}
+
 // ActivityStreamsPublicKeyIsExtendedBy returns true if the other's type extends
 // from PublicKey. Note that it returns false if the types are the same; see
 // the "IsOrExtends" variant instead.
@@ -16,10 +16,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -41,6 +43,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -149,6 +152,12 @@ func ActivityStreamsActivityStreamsDocumentExtends(other vocab.Type) bool {
 	return typedocument.ActivityStreamsDocumentExtends(other)
 }
 
+// ActivityStreamsActivityStreamsEmojiExtends returns true if Emoji extends from
+// the other's type.
+func ActivityStreamsActivityStreamsEmojiExtends(other vocab.Type) bool {
+	return typeemoji.ActivityStreamsEmojiExtends(other)
+}
+
 // ActivityStreamsActivityStreamsEventExtends returns true if Event extends from
 // the other's type.
 func ActivityStreamsActivityStreamsEventExtends(other vocab.Type) bool {
@@ -173,6 +182,12 @@ func ActivityStreamsActivityStreamsGroupExtends(other vocab.Type) bool {
 	return typegroup.ActivityStreamsGroupExtends(other)
 }
 
+// ActivityStreamsActivityStreamsHashtagExtends returns true if Hashtag extends
+// from the other's type.
+func ActivityStreamsActivityStreamsHashtagExtends(other vocab.Type) bool {
+	return typehashtag.ActivityStreamsHashtagExtends(other)
+}
+
 // ActivityStreamsActivityStreamsIgnoreExtends returns true if Ignore extends from
 // the other's type.
 func ActivityStreamsActivityStreamsIgnoreExtends(other vocab.Type) bool {
@@ -299,6 +314,12 @@ func ActivityStreamsActivityStreamsProfileExtends(other vocab.Type) bool {
 	return typeprofile.ActivityStreamsProfileExtends(other)
 }
 
+// ActivityStreamsActivityStreamsPropertyValueExtends returns true if
+// PropertyValue extends from the other's type.
+func ActivityStreamsActivityStreamsPropertyValueExtends(other vocab.Type) bool {
+	return typepropertyvalue.ActivityStreamsPropertyValueExtends(other)
+}
+
 // ActivityStreamsActivityStreamsPublicKeyExtends returns true if PublicKey
 // extends from the other's type.
 func ActivityStreamsActivityStreamsPublicKeyExtends(other vocab.Type) bool {
@@ -16,10 +16,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -41,6 +43,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -149,6 +152,12 @@ func IsOrExtendsActivityStreamsDocument(other vocab.Type) bool {
 	return typedocument.IsOrExtendsDocument(other)
 }
 
+// IsOrExtendsActivityStreamsEmoji returns true if the other provided type is the
+// Emoji type or extends from the Emoji type.
+func IsOrExtendsActivityStreamsEmoji(other vocab.Type) bool {
+	return typeemoji.IsOrExtendsEmoji(other)
+}
+
 // IsOrExtendsActivityStreamsEvent returns true if the other provided type is the
 // Event type or extends from the Event type.
 func IsOrExtendsActivityStreamsEvent(other vocab.Type) bool {
@@ -173,6 +182,12 @@ func IsOrExtendsActivityStreamsGroup(other vocab.Type) bool {
 	return typegroup.IsOrExtendsGroup(other)
 }
 
+// IsOrExtendsActivityStreamsHashtag returns true if the other provided type is
+// the Hashtag type or extends from the Hashtag type.
+func IsOrExtendsActivityStreamsHashtag(other vocab.Type) bool {
+	return typehashtag.IsOrExtendsHashtag(other)
+}
+
 // IsOrExtendsActivityStreamsIgnore returns true if the other provided type is the
 // Ignore type or extends from the Ignore type.
 func IsOrExtendsActivityStreamsIgnore(other vocab.Type) bool {
@@ -302,6 +317,12 @@ func IsOrExtendsActivityStreamsProfile(other vocab.Type) bool {
 	return typeprofile.IsOrExtendsProfile(other)
 }
 
+// IsOrExtendsActivityStreamsPropertyValue returns true if the other provided type
+// is the PropertyValue type or extends from the PropertyValue type.
+func IsOrExtendsActivityStreamsPropertyValue(other vocab.Type) bool {
+	return typepropertyvalue.IsOrExtendsPropertyValue(other)
+}
+
 // IsOrExtendsActivityStreamsPublicKey returns true if the other provided type is
 // the PublicKey type or extends from the PublicKey type.
 func IsOrExtendsActivityStreamsPublicKey(other vocab.Type) bool {
@@ -3,6 +3,7 @@ package streams
 import (
 	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
 	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
 	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
 	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
 	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
@@ -19,6 +20,8 @@ import (
 	propertydescribes "github.com/go-fed/activity/streams/impl/activitystreams/property_describes"
 	propertyduration "github.com/go-fed/activity/streams/impl/activitystreams/property_duration"
 	propertyendtime "github.com/go-fed/activity/streams/impl/activitystreams/property_endtime"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/activitystreams/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/activitystreams/property_featuredtags"
 	propertyfirst "github.com/go-fed/activity/streams/impl/activitystreams/property_first"
 	propertyfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_followers"
 	propertyfollowing "github.com/go-fed/activity/streams/impl/activitystreams/property_following"
@@ -40,6 +43,7 @@ import (
 	propertylikes "github.com/go-fed/activity/streams/impl/activitystreams/property_likes"
 	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
 	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
+	propertymanuallyapprovesfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_manuallyapprovesfollowers"
 	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
 	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
 	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
@@ -75,6 +79,7 @@ import (
 	propertyunits "github.com/go-fed/activity/streams/impl/activitystreams/property_units"
 	propertyupdated "github.com/go-fed/activity/streams/impl/activitystreams/property_updated"
 	propertyurl "github.com/go-fed/activity/streams/impl/activitystreams/property_url"
+	propertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/property_value"
 	propertywidth "github.com/go-fed/activity/streams/impl/activitystreams/property_width"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
@@ -91,6 +96,12 @@ func NewActivityStreamsActorProperty() vocab.ActivityStreamsActorProperty {
 	return propertyactor.NewActivityStreamsActorProperty()
 }
 
+// NewActivityStreamsActivityStreamsAlsoKnownAsProperty creates a new
+// ActivityStreamsAlsoKnownAsProperty
+func NewActivityStreamsAlsoKnownAsProperty() vocab.ActivityStreamsAlsoKnownAsProperty {
+	return propertyalsoknownas.NewActivityStreamsAlsoKnownAsProperty()
+}
+
 // NewActivityStreamsActivityStreamsAltitudeProperty creates a new
 // ActivityStreamsAltitudeProperty
 func NewActivityStreamsAltitudeProperty() vocab.ActivityStreamsAltitudeProperty {
@@ -187,6 +198,18 @@ func NewActivityStreamsEndTimeProperty() vocab.ActivityStreamsEndTimeProperty {
 	return propertyendtime.NewActivityStreamsEndTimeProperty()
 }
 
+// NewActivityStreamsActivityStreamsFeaturedProperty creates a new
+// ActivityStreamsFeaturedProperty
+func NewActivityStreamsFeaturedProperty() vocab.ActivityStreamsFeaturedProperty {
+	return propertyfeatured.NewActivityStreamsFeaturedProperty()
+}
+
+// NewActivityStreamsActivityStreamsFeaturedTagsProperty creates a new
+// ActivityStreamsFeaturedTagsProperty
+func NewActivityStreamsFeaturedTagsProperty() vocab.ActivityStreamsFeaturedTagsProperty {
+	return propertyfeaturedtags.NewActivityStreamsFeaturedTagsProperty()
+}
+
 // NewActivityStreamsActivityStreamsFirstProperty creates a new
 // ActivityStreamsFirstProperty
 func NewActivityStreamsFirstProperty() vocab.ActivityStreamsFirstProperty {
@@ -313,6 +336,12 @@ func NewActivityStreamsLongitudeProperty() vocab.ActivityStreamsLongitudePropert
 	return propertylongitude.NewActivityStreamsLongitudeProperty()
 }
 
+// NewActivityStreamsActivityStreamsManuallyApprovesFollowersProperty creates a
+// new ActivityStreamsManuallyApprovesFollowersProperty
+func NewActivityStreamsManuallyApprovesFollowersProperty() vocab.ActivityStreamsManuallyApprovesFollowersProperty {
+	return propertymanuallyapprovesfollowers.NewActivityStreamsManuallyApprovesFollowersProperty()
+}
+
 // NewActivityStreamsActivityStreamsMediaTypeProperty creates a new
 // ActivityStreamsMediaTypeProperty
 func NewActivityStreamsMediaTypeProperty() vocab.ActivityStreamsMediaTypeProperty {
@@ -523,6 +552,12 @@ func NewActivityStreamsUrlProperty() vocab.ActivityStreamsUrlProperty {
 	return propertyurl.NewActivityStreamsUrlProperty()
 }
 
+// NewActivityStreamsActivityStreamsValueProperty creates a new
+// ActivityStreamsValueProperty
+func NewActivityStreamsValueProperty() vocab.ActivityStreamsValueProperty {
+	return propertyvalue.NewActivityStreamsValueProperty()
+}
+
 // NewActivityStreamsActivityStreamsWidthProperty creates a new
 // ActivityStreamsWidthProperty
 func NewActivityStreamsWidthProperty() vocab.ActivityStreamsWidthProperty {
@@ -0,0 +1,4010 @@
+package streams
+
+import vocab "github.com/go-fed/activity/streams/vocab"
+
+// ToActivityStreamsAcceptShallowView extracts the ShallowView of the Accept type.
+func ToActivityStreamsAcceptShallowView(t vocab.ActivityStreamsAccept) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsActivityShallowView extracts the ShallowView of the Activity
+// type.
+func ToActivityStreamsActivityShallowView(t vocab.ActivityStreamsActivity) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsAddShallowView extracts the ShallowView of the Add type.
+func ToActivityStreamsAddShallowView(t vocab.ActivityStreamsAdd) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsAnnounceShallowView extracts the ShallowView of the Announce
+// type.
+func ToActivityStreamsAnnounceShallowView(t vocab.ActivityStreamsAnnounce) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsApplicationShallowView extracts the ShallowView of the
+// Application type.
+func ToActivityStreamsApplicationShallowView(t vocab.ActivityStreamsApplication) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsArriveShallowView extracts the ShallowView of the Arrive type.
+func ToActivityStreamsArriveShallowView(t vocab.ActivityStreamsArrive) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsArticleShallowView extracts the ShallowView of the Article
+// type.
+func ToActivityStreamsArticleShallowView(t vocab.ActivityStreamsArticle) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsAudioShallowView extracts the ShallowView of the Audio type.
+func ToActivityStreamsAudioShallowView(t vocab.ActivityStreamsAudio) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsBlockShallowView extracts the ShallowView of the Block type.
+func ToActivityStreamsBlockShallowView(t vocab.ActivityStreamsBlock) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsCollectionShallowView extracts the ShallowView of the
+// Collection type.
+func ToActivityStreamsCollectionShallowView(t vocab.ActivityStreamsCollection) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsCollectionPageShallowView extracts the ShallowView of the
+// CollectionPage type.
+func ToActivityStreamsCollectionPageShallowView(t vocab.ActivityStreamsCollectionPage) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsCreateShallowView extracts the ShallowView of the Create type.
+func ToActivityStreamsCreateShallowView(t vocab.ActivityStreamsCreate) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsDeleteShallowView extracts the ShallowView of the Delete type.
+func ToActivityStreamsDeleteShallowView(t vocab.ActivityStreamsDelete) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsDislikeShallowView extracts the ShallowView of the Dislike
+// type.
+func ToActivityStreamsDislikeShallowView(t vocab.ActivityStreamsDislike) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsDocumentShallowView extracts the ShallowView of the Document
+// type.
+func ToActivityStreamsDocumentShallowView(t vocab.ActivityStreamsDocument) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsEmojiShallowView extracts the ShallowView of the Emoji type.
+func ToActivityStreamsEmojiShallowView(t vocab.ActivityStreamsEmoji) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsEventShallowView extracts the ShallowView of the Event type.
+func ToActivityStreamsEventShallowView(t vocab.ActivityStreamsEvent) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsFlagShallowView extracts the ShallowView of the Flag type.
+func ToActivityStreamsFlagShallowView(t vocab.ActivityStreamsFlag) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsFollowShallowView extracts the ShallowView of the Follow type.
+func ToActivityStreamsFollowShallowView(t vocab.ActivityStreamsFollow) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsGroupShallowView extracts the ShallowView of the Group type.
+func ToActivityStreamsGroupShallowView(t vocab.ActivityStreamsGroup) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsHashtagShallowView extracts the ShallowView of the Hashtag
+// type.
+func ToActivityStreamsHashtagShallowView(t vocab.ActivityStreamsHashtag) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	return v
+}
+
+// ToActivityStreamsIgnoreShallowView extracts the ShallowView of the Ignore type.
+func ToActivityStreamsIgnoreShallowView(t vocab.ActivityStreamsIgnore) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsImageShallowView extracts the ShallowView of the Image type.
+func ToActivityStreamsImageShallowView(t vocab.ActivityStreamsImage) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsIntransitiveActivityShallowView extracts the ShallowView of
+// the IntransitiveActivity type.
+func ToActivityStreamsIntransitiveActivityShallowView(t vocab.ActivityStreamsIntransitiveActivity) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsInviteShallowView extracts the ShallowView of the Invite type.
+func ToActivityStreamsInviteShallowView(t vocab.ActivityStreamsInvite) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsJoinShallowView extracts the ShallowView of the Join type.
+func ToActivityStreamsJoinShallowView(t vocab.ActivityStreamsJoin) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsLeaveShallowView extracts the ShallowView of the Leave type.
+func ToActivityStreamsLeaveShallowView(t vocab.ActivityStreamsLeave) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsLikeShallowView extracts the ShallowView of the Like type.
+func ToActivityStreamsLikeShallowView(t vocab.ActivityStreamsLike) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsLinkShallowView extracts the ShallowView of the Link type.
+func ToActivityStreamsLinkShallowView(t vocab.ActivityStreamsLink) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	return v
+}
+
+// ToActivityStreamsListenShallowView extracts the ShallowView of the Listen type.
+func ToActivityStreamsListenShallowView(t vocab.ActivityStreamsListen) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsMentionShallowView extracts the ShallowView of the Mention
+// type.
+func ToActivityStreamsMentionShallowView(t vocab.ActivityStreamsMention) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	return v
+}
+
+// ToActivityStreamsMoveShallowView extracts the ShallowView of the Move type.
+func ToActivityStreamsMoveShallowView(t vocab.ActivityStreamsMove) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsNoteShallowView extracts the ShallowView of the Note type.
+func ToActivityStreamsNoteShallowView(t vocab.ActivityStreamsNote) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsObjectShallowView extracts the ShallowView of the Object type.
+func ToActivityStreamsObjectShallowView(t vocab.ActivityStreamsObject) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsOfferShallowView extracts the ShallowView of the Offer type.
+func ToActivityStreamsOfferShallowView(t vocab.ActivityStreamsOffer) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsOrderedCollectionShallowView extracts the ShallowView of the
+// OrderedCollection type.
+func ToActivityStreamsOrderedCollectionShallowView(t vocab.ActivityStreamsOrderedCollection) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsOrderedCollectionPageShallowView extracts the ShallowView of
+// the OrderedCollectionPage type.
+func ToActivityStreamsOrderedCollectionPageShallowView(t vocab.ActivityStreamsOrderedCollectionPage) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsOrganizationShallowView extracts the ShallowView of the
+// Organization type.
+func ToActivityStreamsOrganizationShallowView(t vocab.ActivityStreamsOrganization) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsPageShallowView extracts the ShallowView of the Page type.
+func ToActivityStreamsPageShallowView(t vocab.ActivityStreamsPage) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsPersonShallowView extracts the ShallowView of the Person type.
+func ToActivityStreamsPersonShallowView(t vocab.ActivityStreamsPerson) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsPlaceShallowView extracts the ShallowView of the Place type.
+func ToActivityStreamsPlaceShallowView(t vocab.ActivityStreamsPlace) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsProfileShallowView extracts the ShallowView of the Profile
+// type.
+func ToActivityStreamsProfileShallowView(t vocab.ActivityStreamsProfile) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsPropertyValueShallowView extracts the ShallowView of the
+// PropertyValue type.
+func ToActivityStreamsPropertyValueShallowView(t vocab.ActivityStreamsPropertyValue) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsPublicKeyShallowView extracts the ShallowView of the PublicKey
+// type.
+func ToActivityStreamsPublicKeyShallowView(t vocab.ActivityStreamsPublicKey) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	return v
+}
+
+// ToActivityStreamsQuestionShallowView extracts the ShallowView of the Question
+// type.
+func ToActivityStreamsQuestionShallowView(t vocab.ActivityStreamsQuestion) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsReadShallowView extracts the ShallowView of the Read type.
+func ToActivityStreamsReadShallowView(t vocab.ActivityStreamsRead) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsRejectShallowView extracts the ShallowView of the Reject type.
+func ToActivityStreamsRejectShallowView(t vocab.ActivityStreamsReject) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsRelationshipShallowView extracts the ShallowView of the
+// Relationship type.
+func ToActivityStreamsRelationshipShallowView(t vocab.ActivityStreamsRelationship) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsRemoveShallowView extracts the ShallowView of the Remove type.
+func ToActivityStreamsRemoveShallowView(t vocab.ActivityStreamsRemove) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsServiceShallowView extracts the ShallowView of the Service
+// type.
+func ToActivityStreamsServiceShallowView(t vocab.ActivityStreamsService) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsTentativeAcceptShallowView extracts the ShallowView of the
+// TentativeAccept type.
+func ToActivityStreamsTentativeAcceptShallowView(t vocab.ActivityStreamsTentativeAccept) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsTentativeRejectShallowView extracts the ShallowView of the
+// TentativeReject type.
+func ToActivityStreamsTentativeRejectShallowView(t vocab.ActivityStreamsTentativeReject) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsTombstoneShallowView extracts the ShallowView of the Tombstone
+// type.
+func ToActivityStreamsTombstoneShallowView(t vocab.ActivityStreamsTombstone) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsTravelShallowView extracts the ShallowView of the Travel type.
+func ToActivityStreamsTravelShallowView(t vocab.ActivityStreamsTravel) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsUndoShallowView extracts the ShallowView of the Undo type.
+func ToActivityStreamsUndoShallowView(t vocab.ActivityStreamsUndo) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsUpdateShallowView extracts the ShallowView of the Update type.
+func ToActivityStreamsUpdateShallowView(t vocab.ActivityStreamsUpdate) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsVideoShallowView extracts the ShallowView of the Video type.
+func ToActivityStreamsVideoShallowView(t vocab.ActivityStreamsVideo) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
+
+// ToActivityStreamsViewShallowView extracts the ShallowView of the View type.
+func ToActivityStreamsViewShallowView(t vocab.ActivityStreamsView) ShallowView {
+	v := ShallowView{Type: t.GetTypeName()}
+	if id := t.GetActivityStreamsId(); id != nil {
+		v.Id = id.Get()
+	}
+	if p := t.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+		v.Published = p.Get()
+	}
+	if p := t.GetActivityStreamsActor(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Actor = append(v.Actor, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Actor = append(v.Actor, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsTo(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.To = append(v.To, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.To = append(v.To, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBto(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bto = append(v.Bto, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bto = append(v.Bto, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsCc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Cc = append(v.Cc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Cc = append(v.Cc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsBcc(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Bcc = append(v.Bcc, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Bcc = append(v.Bcc, id.Get())
+				}
+			}
+		}
+	}
+	if p := t.GetActivityStreamsAudience(); p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			if iter.IsIRI() {
+				v.Audience = append(v.Audience, iter.GetIRI())
+			} else if tv := iter.GetType(); tv != nil {
+				if id := tv.GetActivityStreamsId(); id != nil {
+					v.Audience = append(v.Audience, id.Get())
+				}
+			}
+		}
+	}
+	return v
+}
@@ -0,0 +1,635 @@
+package streams
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// ToActivityStreamsAccept returns the other provided type as the Accept type.
+// Returns an error if the other type is not exactly the Accept type; types
+// extending from Accept are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsAccept(other vocab.Type) (vocab.ActivityStreamsAccept, error) {
+	if v, ok := other.(vocab.ActivityStreamsAccept); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsAccept")
+}
+
+// ToActivityStreamsActivity returns the other provided type as the Activity type.
+// Returns an error if the other type is not exactly the Activity type; types
+// extending from Activity are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsActivity(other vocab.Type) (vocab.ActivityStreamsActivity, error) {
+	if v, ok := other.(vocab.ActivityStreamsActivity); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsActivity")
+}
+
+// ToActivityStreamsAdd returns the other provided type as the Add type. Returns
+// an error if the other type is not exactly the Add type; types extending
+// from Add are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsAdd(other vocab.Type) (vocab.ActivityStreamsAdd, error) {
+	if v, ok := other.(vocab.ActivityStreamsAdd); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsAdd")
+}
+
+// ToActivityStreamsAnnounce returns the other provided type as the Announce type.
+// Returns an error if the other type is not exactly the Announce type; types
+// extending from Announce are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsAnnounce(other vocab.Type) (vocab.ActivityStreamsAnnounce, error) {
+	if v, ok := other.(vocab.ActivityStreamsAnnounce); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsAnnounce")
+}
+
+// ToActivityStreamsApplication returns the other provided type as the Application
+// type. Returns an error if the other type is not exactly the Application
+// type; types extending from Application are not converted, see the
+// "IsOrExtends" variant to detect those.
+func ToActivityStreamsApplication(other vocab.Type) (vocab.ActivityStreamsApplication, error) {
+	if v, ok := other.(vocab.ActivityStreamsApplication); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsApplication")
+}
+
+// ToActivityStreamsArrive returns the other provided type as the Arrive type.
+// Returns an error if the other type is not exactly the Arrive type; types
+// extending from Arrive are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsArrive(other vocab.Type) (vocab.ActivityStreamsArrive, error) {
+	if v, ok := other.(vocab.ActivityStreamsArrive); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsArrive")
+}
+
+// ToActivityStreamsArticle returns the other provided type as the Article type.
+// Returns an error if the other type is not exactly the Article type; types
+// extending from Article are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsArticle(other vocab.Type) (vocab.ActivityStreamsArticle, error) {
+	if v, ok := other.(vocab.ActivityStreamsArticle); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsArticle")
+}
+
+// ToActivityStreamsAudio returns the other provided type as the Audio type.
+// Returns an error if the other type is not exactly the Audio type; types
+// extending from Audio are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsAudio(other vocab.Type) (vocab.ActivityStreamsAudio, error) {
+	if v, ok := other.(vocab.ActivityStreamsAudio); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsAudio")
+}
+
+// ToActivityStreamsBlock returns the other provided type as the Block type.
+// Returns an error if the other type is not exactly the Block type; types
+// extending from Block are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsBlock(other vocab.Type) (vocab.ActivityStreamsBlock, error) {
+	if v, ok := other.(vocab.ActivityStreamsBlock); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsBlock")
+}
+
+// ToActivityStreamsCollection returns the other provided type as the Collection
+// type. Returns an error if the other type is not exactly the Collection
+// type; types extending from Collection are not converted, see the
+// "IsOrExtends" variant to detect those.
+func ToActivityStreamsCollection(other vocab.Type) (vocab.ActivityStreamsCollection, error) {
+	if v, ok := other.(vocab.ActivityStreamsCollection); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsCollection")
+}
+
+// ToActivityStreamsCollectionPage returns the other provided type as the
+// CollectionPage type. Returns an error if the other type is not exactly the
+// CollectionPage type; types extending from CollectionPage are not converted,
+// see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsCollectionPage(other vocab.Type) (vocab.ActivityStreamsCollectionPage, error) {
+	if v, ok := other.(vocab.ActivityStreamsCollectionPage); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsCollectionPage")
+}
+
+// ToActivityStreamsCreate returns the other provided type as the Create type.
+// Returns an error if the other type is not exactly the Create type; types
+// extending from Create are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsCreate(other vocab.Type) (vocab.ActivityStreamsCreate, error) {
+	if v, ok := other.(vocab.ActivityStreamsCreate); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsCreate")
+}
+
+// ToActivityStreamsDelete returns the other provided type as the Delete type.
+// Returns an error if the other type is not exactly the Delete type; types
+// extending from Delete are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsDelete(other vocab.Type) (vocab.ActivityStreamsDelete, error) {
+	if v, ok := other.(vocab.ActivityStreamsDelete); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsDelete")
+}
+
+// ToActivityStreamsDislike returns the other provided type as the Dislike type.
+// Returns an error if the other type is not exactly the Dislike type; types
+// extending from Dislike are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsDislike(other vocab.Type) (vocab.ActivityStreamsDislike, error) {
+	if v, ok := other.(vocab.ActivityStreamsDislike); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsDislike")
+}
+
+// ToActivityStreamsDocument returns the other provided type as the Document type.
+// Returns an error if the other type is not exactly the Document type; types
+// extending from Document are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsDocument(other vocab.Type) (vocab.ActivityStreamsDocument, error) {
+	if v, ok := other.(vocab.ActivityStreamsDocument); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsDocument")
+}
+
+// ToActivityStreamsEmoji returns the other provided type as the Emoji type.
+// Returns an error if the other type is not exactly the Emoji type; types
+// extending from Emoji are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsEmoji(other vocab.Type) (vocab.ActivityStreamsEmoji, error) {
+	if v, ok := other.(vocab.ActivityStreamsEmoji); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsEmoji")
+}
+
+// ToActivityStreamsEvent returns the other provided type as the Event type.
+// Returns an error if the other type is not exactly the Event type; types
+// extending from Event are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsEvent(other vocab.Type) (vocab.ActivityStreamsEvent, error) {
+	if v, ok := other.(vocab.ActivityStreamsEvent); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsEvent")
+}
+
+// ToActivityStreamsFlag returns the other provided type as the Flag type. Returns
+// an error if the other type is not exactly the Flag type; types extending
+// from Flag are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsFlag(other vocab.Type) (vocab.ActivityStreamsFlag, error) {
+	if v, ok := other.(vocab.ActivityStreamsFlag); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsFlag")
+}
+
+// ToActivityStreamsFollow returns the other provided type as the Follow type.
+// Returns an error if the other type is not exactly the Follow type; types
+// extending from Follow are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsFollow(other vocab.Type) (vocab.ActivityStreamsFollow, error) {
+	if v, ok := other.(vocab.ActivityStreamsFollow); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsFollow")
+}
+
+// ToActivityStreamsGroup returns the other provided type as the Group type.
+// Returns an error if the other type is not exactly the Group type; types
+// extending from Group are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsGroup(other vocab.Type) (vocab.ActivityStreamsGroup, error) {
+	if v, ok := other.(vocab.ActivityStreamsGroup); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsGroup")
+}
+
+// ToActivityStreamsHashtag returns the other provided type as the Hashtag type.
+// Returns an error if the other type is not exactly the Hashtag type; types
+// extending from Hashtag are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsHashtag(other vocab.Type) (vocab.ActivityStreamsHashtag, error) {
+	if v, ok := other.(vocab.ActivityStreamsHashtag); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsHashtag")
+}
+
+// ToActivityStreamsIgnore returns the other provided type as the Ignore type.
+// Returns an error if the other type is not exactly the Ignore type; types
+// extending from Ignore are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsIgnore(other vocab.Type) (vocab.ActivityStreamsIgnore, error) {
+	if v, ok := other.(vocab.ActivityStreamsIgnore); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsIgnore")
+}
+
+// ToActivityStreamsImage returns the other provided type as the Image type.
+// Returns an error if the other type is not exactly the Image type; types
+// extending from Image are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsImage(other vocab.Type) (vocab.ActivityStreamsImage, error) {
+	if v, ok := other.(vocab.ActivityStreamsImage); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsImage")
+}
+
+// ToActivityStreamsIntransitiveActivity returns the other provided type as the
+// IntransitiveActivity type. Returns an error if the other type is not
+// exactly the IntransitiveActivity type; types extending from
+// IntransitiveActivity are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsIntransitiveActivity(other vocab.Type) (vocab.ActivityStreamsIntransitiveActivity, error) {
+	if v, ok := other.(vocab.ActivityStreamsIntransitiveActivity); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsIntransitiveActivity")
+}
+
+// ToActivityStreamsInvite returns the other provided type as the Invite type.
+// Returns an error if the other type is not exactly the Invite type; types
+// extending from Invite are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsInvite(other vocab.Type) (vocab.ActivityStreamsInvite, error) {
+	if v, ok := other.(vocab.ActivityStreamsInvite); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsInvite")
+}
+
+// ToActivityStreamsJoin returns the other provided type as the Join type. Returns
+// an error if the other type is not exactly the Join type; types extending
+// from Join are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsJoin(other vocab.Type) (vocab.ActivityStreamsJoin, error) {
+	if v, ok := other.(vocab.ActivityStreamsJoin); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsJoin")
+}
+
+// ToActivityStreamsLeave returns the other provided type as the Leave type.
+// Returns an error if the other type is not exactly the Leave type; types
+// extending from Leave are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsLeave(other vocab.Type) (vocab.ActivityStreamsLeave, error) {
+	if v, ok := other.(vocab.ActivityStreamsLeave); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsLeave")
+}
+
+// ToActivityStreamsLike returns the other provided type as the Like type. Returns
+// an error if the other type is not exactly the Like type; types extending
+// from Like are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsLike(other vocab.Type) (vocab.ActivityStreamsLike, error) {
+	if v, ok := other.(vocab.ActivityStreamsLike); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsLike")
+}
+
+// ToActivityStreamsLink returns the other provided type as the Link type. Returns
+// an error if the other type is not exactly the Link type; types extending
+// from Link are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsLink(other vocab.Type) (vocab.ActivityStreamsLink, error) {
+	if v, ok := other.(vocab.ActivityStreamsLink); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsLink")
+}
+
+// ToActivityStreamsListen returns the other provided type as the Listen type.
+// Returns an error if the other type is not exactly the Listen type; types
+// extending from Listen are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsListen(other vocab.Type) (vocab.ActivityStreamsListen, error) {
+	if v, ok := other.(vocab.ActivityStreamsListen); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsListen")
+}
+
+// ToActivityStreamsMention returns the other provided type as the Mention type.
+// Returns an error if the other type is not exactly the Mention type; types
+// extending from Mention are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsMention(other vocab.Type) (vocab.ActivityStreamsMention, error) {
+	if v, ok := other.(vocab.ActivityStreamsMention); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsMention")
+}
+
+// ToActivityStreamsMove returns the other provided type as the Move type. Returns
+// an error if the other type is not exactly the Move type; types extending
+// from Move are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsMove(other vocab.Type) (vocab.ActivityStreamsMove, error) {
+	if v, ok := other.(vocab.ActivityStreamsMove); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsMove")
+}
+
+// ToActivityStreamsNote returns the other provided type as the Note type. Returns
+// an error if the other type is not exactly the Note type; types extending
+// from Note are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsNote(other vocab.Type) (vocab.ActivityStreamsNote, error) {
+	if v, ok := other.(vocab.ActivityStreamsNote); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsNote")
+}
+
+// ToActivityStreamsObject returns the other provided type as the Object type.
+// Returns an error if the other type is not exactly the Object type; types
+// extending from Object are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsObject(other vocab.Type) (vocab.ActivityStreamsObject, error) {
+	if v, ok := other.(vocab.ActivityStreamsObject); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsObject")
+}
+
+// ToActivityStreamsOffer returns the other provided type as the Offer type.
+// Returns an error if the other type is not exactly the Offer type; types
+// extending from Offer are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsOffer(other vocab.Type) (vocab.ActivityStreamsOffer, error) {
+	if v, ok := other.(vocab.ActivityStreamsOffer); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsOffer")
+}
+
+// ToActivityStreamsOrderedCollection returns the other provided type as the
+// OrderedCollection type. Returns an error if the other type is not exactly
+// the OrderedCollection type; types extending from OrderedCollection are not
+// converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsOrderedCollection(other vocab.Type) (vocab.ActivityStreamsOrderedCollection, error) {
+	if v, ok := other.(vocab.ActivityStreamsOrderedCollection); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsOrderedCollection")
+}
+
+// ToActivityStreamsOrderedCollectionPage returns the other provided type as the
+// OrderedCollectionPage type. Returns an error if the other type is not
+// exactly the OrderedCollectionPage type; types extending from
+// OrderedCollectionPage are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsOrderedCollectionPage(other vocab.Type) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	if v, ok := other.(vocab.ActivityStreamsOrderedCollectionPage); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsOrderedCollectionPage")
+}
+
+// ToActivityStreamsOrganization returns the other provided type as the
+// Organization type. Returns an error if the other type is not exactly the
+// Organization type; types extending from Organization are not converted, see
+// the "IsOrExtends" variant to detect those.
+func ToActivityStreamsOrganization(other vocab.Type) (vocab.ActivityStreamsOrganization, error) {
+	if v, ok := other.(vocab.ActivityStreamsOrganization); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsOrganization")
+}
+
+// ToActivityStreamsPage returns the other provided type as the Page type. Returns
+// an error if the other type is not exactly the Page type; types extending
+// from Page are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsPage(other vocab.Type) (vocab.ActivityStreamsPage, error) {
+	if v, ok := other.(vocab.ActivityStreamsPage); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsPage")
+}
+
+// ToActivityStreamsPerson returns the other provided type as the Person type.
+// Returns an error if the other type is not exactly the Person type; types
+// extending from Person are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsPerson(other vocab.Type) (vocab.ActivityStreamsPerson, error) {
+	if v, ok := other.(vocab.ActivityStreamsPerson); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsPerson")
+}
+
+// ToActivityStreamsPlace returns the other provided type as the Place type.
+// Returns an error if the other type is not exactly the Place type; types
+// extending from Place are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsPlace(other vocab.Type) (vocab.ActivityStreamsPlace, error) {
+	if v, ok := other.(vocab.ActivityStreamsPlace); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsPlace")
+}
+
+// ToActivityStreamsProfile returns the other provided type as the Profile type.
+// Returns an error if the other type is not exactly the Profile type; types
+// extending from Profile are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsProfile(other vocab.Type) (vocab.ActivityStreamsProfile, error) {
+	if v, ok := other.(vocab.ActivityStreamsProfile); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsProfile")
+}
+
+// ToActivityStreamsPropertyValue returns the other provided type as the
+// PropertyValue type. Returns an error if the other type is not exactly the
+// PropertyValue type; types extending from PropertyValue are not converted,
+// see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsPropertyValue(other vocab.Type) (vocab.ActivityStreamsPropertyValue, error) {
+	if v, ok := other.(vocab.ActivityStreamsPropertyValue); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsPropertyValue")
+}
+
+// ToActivityStreamsPublicKey returns the other provided type as the PublicKey
+// type. Returns an error if the other type is not exactly the PublicKey type;
+// types extending from PublicKey are not converted, see the "IsOrExtends"
+// variant to detect those.
+func ToActivityStreamsPublicKey(other vocab.Type) (vocab.ActivityStreamsPublicKey, error) {
+	if v, ok := other.(vocab.ActivityStreamsPublicKey); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsPublicKey")
+}
+
+// ToActivityStreamsQuestion returns the other provided type as the Question type.
+// Returns an error if the other type is not exactly the Question type; types
+// extending from Question are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsQuestion(other vocab.Type) (vocab.ActivityStreamsQuestion, error) {
+	if v, ok := other.(vocab.ActivityStreamsQuestion); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsQuestion")
+}
+
+// ToActivityStreamsRead returns the other provided type as the Read type. Returns
+// an error if the other type is not exactly the Read type; types extending
+// from Read are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsRead(other vocab.Type) (vocab.ActivityStreamsRead, error) {
+	if v, ok := other.(vocab.ActivityStreamsRead); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsRead")
+}
+
+// ToActivityStreamsReject returns the other provided type as the Reject type.
+// Returns an error if the other type is not exactly the Reject type; types
+// extending from Reject are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsReject(other vocab.Type) (vocab.ActivityStreamsReject, error) {
+	if v, ok := other.(vocab.ActivityStreamsReject); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsReject")
+}
+
+// ToActivityStreamsRelationship returns the other provided type as the
+// Relationship type. Returns an error if the other type is not exactly the
+// Relationship type; types extending from Relationship are not converted, see
+// the "IsOrExtends" variant to detect those.
+func ToActivityStreamsRelationship(other vocab.Type) (vocab.ActivityStreamsRelationship, error) {
+	if v, ok := other.(vocab.ActivityStreamsRelationship); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsRelationship")
+}
+
+// ToActivityStreamsRemove returns the other provided type as the Remove type.
+// Returns an error if the other type is not exactly the Remove type; types
+// extending from Remove are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsRemove(other vocab.Type) (vocab.ActivityStreamsRemove, error) {
+	if v, ok := other.(vocab.ActivityStreamsRemove); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsRemove")
+}
+
+// ToActivityStreamsService returns the other provided type as the Service type.
+// Returns an error if the other type is not exactly the Service type; types
+// extending from Service are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsService(other vocab.Type) (vocab.ActivityStreamsService, error) {
+	if v, ok := other.(vocab.ActivityStreamsService); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsService")
+}
+
+// ToActivityStreamsTentativeAccept returns the other provided type as the
+// TentativeAccept type. Returns an error if the other type is not exactly the
+// TentativeAccept type; types extending from TentativeAccept are not
+// converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsTentativeAccept(other vocab.Type) (vocab.ActivityStreamsTentativeAccept, error) {
+	if v, ok := other.(vocab.ActivityStreamsTentativeAccept); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsTentativeAccept")
+}
+
+// ToActivityStreamsTentativeReject returns the other provided type as the
+// TentativeReject type. Returns an error if the other type is not exactly the
+// TentativeReject type; types extending from TentativeReject are not
+// converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsTentativeReject(other vocab.Type) (vocab.ActivityStreamsTentativeReject, error) {
+	if v, ok := other.(vocab.ActivityStreamsTentativeReject); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsTentativeReject")
+}
+
+// ToActivityStreamsTombstone returns the other provided type as the Tombstone
+// type. Returns an error if the other type is not exactly the Tombstone type;
+// types extending from Tombstone are not converted, see the "IsOrExtends"
+// variant to detect those.
+func ToActivityStreamsTombstone(other vocab.Type) (vocab.ActivityStreamsTombstone, error) {
+	if v, ok := other.(vocab.ActivityStreamsTombstone); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsTombstone")
+}
+
+// ToActivityStreamsTravel returns the other provided type as the Travel type.
+// Returns an error if the other type is not exactly the Travel type; types
+// extending from Travel are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsTravel(other vocab.Type) (vocab.ActivityStreamsTravel, error) {
+	if v, ok := other.(vocab.ActivityStreamsTravel); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsTravel")
+}
+
+// ToActivityStreamsUndo returns the other provided type as the Undo type. Returns
+// an error if the other type is not exactly the Undo type; types extending
+// from Undo are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsUndo(other vocab.Type) (vocab.ActivityStreamsUndo, error) {
+	if v, ok := other.(vocab.ActivityStreamsUndo); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsUndo")
+}
+
+// ToActivityStreamsUpdate returns the other provided type as the Update type.
+// Returns an error if the other type is not exactly the Update type; types
+// extending from Update are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsUpdate(other vocab.Type) (vocab.ActivityStreamsUpdate, error) {
+	if v, ok := other.(vocab.ActivityStreamsUpdate); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsUpdate")
+}
+
+// ToActivityStreamsVideo returns the other provided type as the Video type.
+// Returns an error if the other type is not exactly the Video type; types
+// extending from Video are not converted, see the "IsOrExtends" variant to
+// detect those.
+func ToActivityStreamsVideo(other vocab.Type) (vocab.ActivityStreamsVideo, error) {
+	if v, ok := other.(vocab.ActivityStreamsVideo); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsVideo")
+}
+
+// ToActivityStreamsView returns the other provided type as the View type. Returns
+// an error if the other type is not exactly the View type; types extending
+// from View are not converted, see the "IsOrExtends" variant to detect those.
+func ToActivityStreamsView(other vocab.Type) (vocab.ActivityStreamsView, error) {
+	if v, ok := other.(vocab.ActivityStreamsView); ok {
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %q type of vocabulary %q to %s", other.GetTypeName(), other.VocabularyURI(), "ActivityStreamsView")
+}
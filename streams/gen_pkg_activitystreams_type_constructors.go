@@ -16,10 +16,12 @@ import (
 	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
 	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
 	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
 	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
 	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
 	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
 	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
 	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
 	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
 	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
@@ -41,6 +43,7 @@ import (
 	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
 	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
 	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
 	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
 	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
@@ -134,6 +137,11 @@ func NewActivityStreamsDocument() vocab.ActivityStreamsDocument {
 	return typedocument.NewActivityStreamsDocument()
 }
 
+// NewActivityStreamsEmoji creates a new ActivityStreamsEmoji
+func NewActivityStreamsEmoji() vocab.ActivityStreamsEmoji {
+	return typeemoji.NewActivityStreamsEmoji()
+}
+
 // NewActivityStreamsEvent creates a new ActivityStreamsEvent
 func NewActivityStreamsEvent() vocab.ActivityStreamsEvent {
 	return typeevent.NewActivityStreamsEvent()
@@ -154,6 +162,11 @@ func NewActivityStreamsGroup() vocab.ActivityStreamsGroup {
 	return typegroup.NewActivityStreamsGroup()
 }
 
+// NewActivityStreamsHashtag creates a new ActivityStreamsHashtag
+func NewActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	return typehashtag.NewActivityStreamsHashtag()
+}
+
 // NewActivityStreamsIgnore creates a new ActivityStreamsIgnore
 func NewActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
 	return typeignore.NewActivityStreamsIgnore()
@@ -262,6 +275,11 @@ func NewActivityStreamsProfile() vocab.ActivityStreamsProfile {
 	return typeprofile.NewActivityStreamsProfile()
 }
 
+// NewActivityStreamsPropertyValue creates a new ActivityStreamsPropertyValue
+func NewActivityStreamsPropertyValue() vocab.ActivityStreamsPropertyValue {
+	return typepropertyvalue.NewActivityStreamsPropertyValue()
+}
+
 // NewActivityStreamsPublicKey creates a new ActivityStreamsPublicKey
 func NewActivityStreamsPublicKey() vocab.ActivityStreamsPublicKey {
 	return typepublickey.NewActivityStreamsPublicKey()
@@ -92,6 +92,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsDocument) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ActivityStreamsEmoji) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsEvent) error {
 		t = i
 		return nil
@@ -104,6 +107,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsGroup) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ActivityStreamsHashtag) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsIgnore) error {
 		t = i
 		return nil
@@ -167,6 +173,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsProfile) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.ActivityStreamsPropertyValue) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsPublicKey) error {
 		t = i
 		return nil
@@ -0,0 +1,152 @@
+package streams
+
+import (
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"time"
+)
+
+// ShallowView is a lightweight projection of an ActivityStreams type, capturing
+// its id, type, actor, published, and audience values. It is intended for
+// uses such as search indexing and timeline storage, where the full type is
+// not needed. Embedded values are captured by their id.
+type ShallowView struct {
+	Id        *url.URL
+	Type      string
+	Published time.Time
+	Actor     []*url.URL
+	To        []*url.URL
+	Bto       []*url.URL
+	Cc        []*url.URL
+	Bcc       []*url.URL
+	Audience  []*url.URL
+}
+
+// ToShallowView extracts the ShallowView of any ActivityStreams type. Types not
+// known to this package only have their id and type extracted.
+func ToShallowView(t vocab.Type) ShallowView {
+	switch v := t.(type) {
+	case vocab.ActivityStreamsAccept:
+		return ToActivityStreamsAcceptShallowView(v)
+	case vocab.ActivityStreamsActivity:
+		return ToActivityStreamsActivityShallowView(v)
+	case vocab.ActivityStreamsAdd:
+		return ToActivityStreamsAddShallowView(v)
+	case vocab.ActivityStreamsAnnounce:
+		return ToActivityStreamsAnnounceShallowView(v)
+	case vocab.ActivityStreamsApplication:
+		return ToActivityStreamsApplicationShallowView(v)
+	case vocab.ActivityStreamsArrive:
+		return ToActivityStreamsArriveShallowView(v)
+	case vocab.ActivityStreamsArticle:
+		return ToActivityStreamsArticleShallowView(v)
+	case vocab.ActivityStreamsAudio:
+		return ToActivityStreamsAudioShallowView(v)
+	case vocab.ActivityStreamsBlock:
+		return ToActivityStreamsBlockShallowView(v)
+	case vocab.ActivityStreamsCollection:
+		return ToActivityStreamsCollectionShallowView(v)
+	case vocab.ActivityStreamsCollectionPage:
+		return ToActivityStreamsCollectionPageShallowView(v)
+	case vocab.ActivityStreamsCreate:
+		return ToActivityStreamsCreateShallowView(v)
+	case vocab.ActivityStreamsDelete:
+		return ToActivityStreamsDeleteShallowView(v)
+	case vocab.ActivityStreamsDislike:
+		return ToActivityStreamsDislikeShallowView(v)
+	case vocab.ActivityStreamsDocument:
+		return ToActivityStreamsDocumentShallowView(v)
+	case vocab.ActivityStreamsEmoji:
+		return ToActivityStreamsEmojiShallowView(v)
+	case vocab.ActivityStreamsEvent:
+		return ToActivityStreamsEventShallowView(v)
+	case vocab.ActivityStreamsFlag:
+		return ToActivityStreamsFlagShallowView(v)
+	case vocab.ActivityStreamsFollow:
+		return ToActivityStreamsFollowShallowView(v)
+	case vocab.ActivityStreamsGroup:
+		return ToActivityStreamsGroupShallowView(v)
+	case vocab.ActivityStreamsHashtag:
+		return ToActivityStreamsHashtagShallowView(v)
+	case vocab.ActivityStreamsIgnore:
+		return ToActivityStreamsIgnoreShallowView(v)
+	case vocab.ActivityStreamsImage:
+		return ToActivityStreamsImageShallowView(v)
+	case vocab.ActivityStreamsIntransitiveActivity:
+		return ToActivityStreamsIntransitiveActivityShallowView(v)
+	case vocab.ActivityStreamsInvite:
+		return ToActivityStreamsInviteShallowView(v)
+	case vocab.ActivityStreamsJoin:
+		return ToActivityStreamsJoinShallowView(v)
+	case vocab.ActivityStreamsLeave:
+		return ToActivityStreamsLeaveShallowView(v)
+	case vocab.ActivityStreamsLike:
+		return ToActivityStreamsLikeShallowView(v)
+	case vocab.ActivityStreamsLink:
+		return ToActivityStreamsLinkShallowView(v)
+	case vocab.ActivityStreamsListen:
+		return ToActivityStreamsListenShallowView(v)
+	case vocab.ActivityStreamsMention:
+		return ToActivityStreamsMentionShallowView(v)
+	case vocab.ActivityStreamsMove:
+		return ToActivityStreamsMoveShallowView(v)
+	case vocab.ActivityStreamsNote:
+		return ToActivityStreamsNoteShallowView(v)
+	case vocab.ActivityStreamsObject:
+		return ToActivityStreamsObjectShallowView(v)
+	case vocab.ActivityStreamsOffer:
+		return ToActivityStreamsOfferShallowView(v)
+	case vocab.ActivityStreamsOrderedCollection:
+		return ToActivityStreamsOrderedCollectionShallowView(v)
+	case vocab.ActivityStreamsOrderedCollectionPage:
+		return ToActivityStreamsOrderedCollectionPageShallowView(v)
+	case vocab.ActivityStreamsOrganization:
+		return ToActivityStreamsOrganizationShallowView(v)
+	case vocab.ActivityStreamsPage:
+		return ToActivityStreamsPageShallowView(v)
+	case vocab.ActivityStreamsPerson:
+		return ToActivityStreamsPersonShallowView(v)
+	case vocab.ActivityStreamsPlace:
+		return ToActivityStreamsPlaceShallowView(v)
+	case vocab.ActivityStreamsProfile:
+		return ToActivityStreamsProfileShallowView(v)
+	case vocab.ActivityStreamsPropertyValue:
+		return ToActivityStreamsPropertyValueShallowView(v)
+	case vocab.ActivityStreamsPublicKey:
+		return ToActivityStreamsPublicKeyShallowView(v)
+	case vocab.ActivityStreamsQuestion:
+		return ToActivityStreamsQuestionShallowView(v)
+	case vocab.ActivityStreamsRead:
+		return ToActivityStreamsReadShallowView(v)
+	case vocab.ActivityStreamsReject:
+		return ToActivityStreamsRejectShallowView(v)
+	case vocab.ActivityStreamsRelationship:
+		return ToActivityStreamsRelationshipShallowView(v)
+	case vocab.ActivityStreamsRemove:
+		return ToActivityStreamsRemoveShallowView(v)
+	case vocab.ActivityStreamsService:
+		return ToActivityStreamsServiceShallowView(v)
+	case vocab.ActivityStreamsTentativeAccept:
+		return ToActivityStreamsTentativeAcceptShallowView(v)
+	case vocab.ActivityStreamsTentativeReject:
+		return ToActivityStreamsTentativeRejectShallowView(v)
+	case vocab.ActivityStreamsTombstone:
+		return ToActivityStreamsTombstoneShallowView(v)
+	case vocab.ActivityStreamsTravel:
+		return ToActivityStreamsTravelShallowView(v)
+	case vocab.ActivityStreamsUndo:
+		return ToActivityStreamsUndoShallowView(v)
+	case vocab.ActivityStreamsUpdate:
+		return ToActivityStreamsUpdateShallowView(v)
+	case vocab.ActivityStreamsVideo:
+		return ToActivityStreamsVideoShallowView(v)
+	case vocab.ActivityStreamsView:
+		return ToActivityStreamsViewShallowView(v)
+	default:
+		sv := ShallowView{Type: t.GetTypeName()}
+		if id := t.GetActivityStreamsId(); id != nil {
+			sv.Id = id.Get()
+		}
+		return sv
+	}
+}
@@ -57,6 +57,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsDocument) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ActivityStreamsEmoji) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsEvent) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsFlag) (bool, error):
@@ -65,6 +67,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsGroup) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ActivityStreamsHashtag) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsIgnore) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsImage) (bool, error):
@@ -107,6 +111,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsProfile) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.ActivityStreamsPropertyValue) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsPublicKey) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsQuestion) (bool, error):
@@ -321,6 +327,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Emoji" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsEmoji) (bool, error)); ok {
+			if v, ok := o.(vocab.ActivityStreamsEmoji); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Event" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsEvent) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsEvent); ok {
@@ -365,6 +382,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Hashtag" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsHashtag) (bool, error)); ok {
+			if v, ok := o.(vocab.ActivityStreamsHashtag); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Ignore" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsIgnore) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsIgnore); ok {
@@ -596,6 +624,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "PropertyValue" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsPropertyValue) (bool, error)); ok {
+			if v, ok := o.(vocab.ActivityStreamsPropertyValue); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "PublicKey" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsPublicKey) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsPublicKey); ok {
@@ -0,0 +1,234 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"time"
+)
+
+// addressed is a value with the addressing properties of an Object.
+type addressed interface {
+	GetActivityStreamsTo() vocab.ActivityStreamsToProperty
+	SetActivityStreamsTo(vocab.ActivityStreamsToProperty)
+	GetActivityStreamsBto() vocab.ActivityStreamsBtoProperty
+	SetActivityStreamsBto(vocab.ActivityStreamsBtoProperty)
+	GetActivityStreamsCc() vocab.ActivityStreamsCcProperty
+	SetActivityStreamsCc(vocab.ActivityStreamsCcProperty)
+	GetActivityStreamsBcc() vocab.ActivityStreamsBccProperty
+	SetActivityStreamsBcc(vocab.ActivityStreamsBccProperty)
+	GetActivityStreamsAudience() vocab.ActivityStreamsAudienceProperty
+	SetActivityStreamsAudience(vocab.ActivityStreamsAudienceProperty)
+}
+
+// hrefer is a value with the "href" property, such as a Link.
+type hrefer interface {
+	GetActivityStreamsHref() vocab.ActivityStreamsHrefProperty
+}
+
+// iriValue is a value of a property which may be an IRI or a Type, such as an
+// iterator of the "to" property.
+type iriValue interface {
+	IsIRI() bool
+	GetIRI() *url.URL
+	GetType() vocab.Type
+}
+
+// idOf returns the IRI of the value, the "id" of an embedded Type, or the
+// "href" of an embedded Link. Returns nil if the value has none of them.
+func idOf(v iriValue) *url.URL {
+	if v.IsIRI() {
+		return v.GetIRI()
+	}
+	t := v.GetType()
+	if t == nil {
+		return nil
+	} else if id := t.GetActivityStreamsId(); id != nil {
+		return id.Get()
+	} else if h, ok := t.(hrefer); ok && h.GetActivityStreamsHref() != nil {
+		return h.GetActivityStreamsHref().Get()
+	}
+	return nil
+}
+
+// newPublished returns a "published" property set to the time, in UTC.
+func newPublished(published time.Time) vocab.ActivityStreamsPublishedProperty {
+	p := streams.NewActivityStreamsPublishedProperty()
+	p.Set(published.UTC())
+	return p
+}
+
+// newTo returns a "to" property addressing the IRIs, or nil if there are none.
+func newTo(to []*url.URL) vocab.ActivityStreamsToProperty {
+	if len(to) == 0 {
+		return nil
+	}
+	p := streams.NewActivityStreamsToProperty()
+	for _, iri := range to {
+		p.AppendIRI(iri)
+	}
+	return p
+}
+
+// copyAddressing sets the "to", "bto", "cc", "bcc", and "audience" properties
+// of the activity to the IRIs addressed by the object. Embedded values are
+// addressed by their id, and those without one are skipped.
+func copyAddressing(activity addressed, object vocab.Type) {
+	o, ok := object.(addressed)
+	if !ok {
+		return
+	}
+	if p := o.GetActivityStreamsTo(); p != nil {
+		to := streams.NewActivityStreamsToProperty()
+		p.ForEach(func(_ int, it vocab.ActivityStreamsToPropertyIterator) bool {
+			if id := idOf(it); id != nil {
+				to.AppendIRI(id)
+			}
+			return true
+		})
+		activity.SetActivityStreamsTo(to)
+	}
+	if p := o.GetActivityStreamsBto(); p != nil {
+		bto := streams.NewActivityStreamsBtoProperty()
+		p.ForEach(func(_ int, it vocab.ActivityStreamsBtoPropertyIterator) bool {
+			if id := idOf(it); id != nil {
+				bto.AppendIRI(id)
+			}
+			return true
+		})
+		activity.SetActivityStreamsBto(bto)
+	}
+	if p := o.GetActivityStreamsCc(); p != nil {
+		cc := streams.NewActivityStreamsCcProperty()
+		p.ForEach(func(_ int, it vocab.ActivityStreamsCcPropertyIterator) bool {
+			if id := idOf(it); id != nil {
+				cc.AppendIRI(id)
+			}
+			return true
+		})
+		activity.SetActivityStreamsCc(cc)
+	}
+	if p := o.GetActivityStreamsBcc(); p != nil {
+		bcc := streams.NewActivityStreamsBccProperty()
+		p.ForEach(func(_ int, it vocab.ActivityStreamsBccPropertyIterator) bool {
+			if id := idOf(it); id != nil {
+				bcc.AppendIRI(id)
+			}
+			return true
+		})
+		activity.SetActivityStreamsBcc(bcc)
+	}
+	if p := o.GetActivityStreamsAudience(); p != nil {
+		audience := streams.NewActivityStreamsAudienceProperty()
+		p.ForEach(func(_ int, it vocab.ActivityStreamsAudiencePropertyIterator) bool {
+			if id := idOf(it); id != nil {
+				audience.AppendIRI(id)
+			}
+			return true
+		})
+		activity.SetActivityStreamsAudience(audience)
+	}
+}
+
+// NewNote returns a Note attributed to the actor with the content, published at
+// the time, and addressed to the IRIs. The time is usually the current time of
+// the Clock of the application.
+func NewNote(attributedTo *url.URL, content string, published time.Time, to ...*url.URL) vocab.ActivityStreamsNote {
+	n := streams.NewActivityStreamsNote()
+	attr := streams.NewActivityStreamsAttributedToProperty()
+	attr.AppendIRI(attributedTo)
+	n.SetActivityStreamsAttributedTo(attr)
+	c := streams.NewActivityStreamsContentProperty()
+	c.AppendXMLSchemaString(content)
+	n.SetActivityStreamsContent(c)
+	n.SetActivityStreamsPublished(newPublished(published))
+	if p := newTo(to); p != nil {
+		n.SetActivityStreamsTo(p)
+	}
+	return n
+}
+
+// NewCreate returns a Create by the actor of the embedded object. The Create is
+// addressed to the same recipients as the object and, if the object has a
+// "published" property, published at the same time.
+//
+// Returns an error if the object is not a type of a generated vocabulary.
+func NewCreate(actor *url.URL, object vocab.Type) (vocab.ActivityStreamsCreate, error) {
+	c := streams.NewActivityStreamsCreate()
+	a := streams.NewActivityStreamsActorProperty()
+	a.AppendIRI(actor)
+	c.SetActivityStreamsActor(a)
+	o := streams.NewActivityStreamsObjectProperty()
+	if err := o.AppendType(object); err != nil {
+		return nil, err
+	}
+	c.SetActivityStreamsObject(o)
+	if p, ok := object.(publisheder); ok && p.GetActivityStreamsPublished() != nil {
+		c.SetActivityStreamsPublished(p.GetActivityStreamsPublished())
+	}
+	copyAddressing(c, object)
+	return c, nil
+}
+
+// NewCreateNote returns a Create by the actor of a new Note with the content,
+// both published at the time and addressed to the IRIs.
+func NewCreateNote(actor *url.URL, content string, published time.Time, to ...*url.URL) vocab.ActivityStreamsCreate {
+	// A Note is always a valid object, so NewCreate cannot fail.
+	c, _ := NewCreate(actor, NewNote(actor, content, published, to...))
+	return c
+}
+
+// NewFollow returns a Follow of the object by the actor, addressed to the
+// object so that it is delivered to its inbox.
+func NewFollow(actor, object *url.URL) vocab.ActivityStreamsFollow {
+	f := streams.NewActivityStreamsFollow()
+	a := streams.NewActivityStreamsActorProperty()
+	a.AppendIRI(actor)
+	f.SetActivityStreamsActor(a)
+	o := streams.NewActivityStreamsObjectProperty()
+	o.AppendIRI(object)
+	f.SetActivityStreamsObject(o)
+	f.SetActivityStreamsTo(newTo([]*url.URL{object}))
+	return f
+}
+
+// NewAccept returns an Accept by the actor of the embedded Follow, addressed to
+// the actors of the Follow.
+func NewAccept(actor *url.URL, follow vocab.ActivityStreamsFollow) vocab.ActivityStreamsAccept {
+	acc := streams.NewActivityStreamsAccept()
+	a := streams.NewActivityStreamsActorProperty()
+	a.AppendIRI(actor)
+	acc.SetActivityStreamsActor(a)
+	o := streams.NewActivityStreamsObjectProperty()
+	o.AppendActivityStreamsFollow(follow)
+	acc.SetActivityStreamsObject(o)
+	if p := follow.GetActivityStreamsActor(); p != nil {
+		to := streams.NewActivityStreamsToProperty()
+		p.ForEach(func(_ int, it vocab.ActivityStreamsActorPropertyIterator) bool {
+			if id := idOf(it); id != nil {
+				to.AppendIRI(id)
+			}
+			return true
+		})
+		acc.SetActivityStreamsTo(to)
+	}
+	return acc
+}
+
+// NewUndo returns an Undo by the actor of the embedded activity, addressed to
+// the same recipients as the activity.
+//
+// Returns an error if the activity is not a type of a generated vocabulary.
+func NewUndo(actor *url.URL, activity vocab.Type) (vocab.ActivityStreamsUndo, error) {
+	u := streams.NewActivityStreamsUndo()
+	a := streams.NewActivityStreamsActorProperty()
+	a.AppendIRI(actor)
+	u.SetActivityStreamsActor(a)
+	o := streams.NewActivityStreamsObjectProperty()
+	if err := o.AppendType(activity); err != nil {
+		return nil, err
+	}
+	u.SetActivityStreamsObject(o)
+	copyAddressing(u, activity)
+	return u, nil
+}
@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+var (
+	testActor     = mustParse("https://example.com/users/alice")
+	testFollowers = mustParse("https://example.com/users/alice/followers")
+	testOther     = mustParse("https://example.net/users/bob")
+	testPublished = time.Date(2020, 5, 1, 18, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+)
+
+func TestNewCreateNote(t *testing.T) {
+	c := NewCreateNote(testActor, "Hello", testPublished, testFollowers, testOther)
+	m, err := streams.Serialize(c)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %s", err)
+	}
+	if m["@context"] != "https://www.w3.org/ns/activitystreams" {
+		t.Errorf("unexpected @context: %v", m["@context"])
+	}
+	if m["type"] != "Create" {
+		t.Errorf("unexpected type: %v", m["type"])
+	}
+	if m["actor"] != testActor.String() {
+		t.Errorf("unexpected actor: %v", m["actor"])
+	}
+	to, ok := m["to"].([]interface{})
+	if !ok || len(to) != 2 || to[0] != testFollowers.String() || to[1] != testOther.String() {
+		t.Errorf("unexpected to of the Create: %v", m["to"])
+	}
+	if m["published"] != "2020-05-01T16:00:00Z" {
+		t.Errorf("unexpected published of the Create: %v", m["published"])
+	}
+	note, ok := m["object"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected an embedded object, got %v", m["object"])
+	}
+	if note["type"] != "Note" || note["content"] != "Hello" || note["attributedTo"] != testActor.String() {
+		t.Errorf("unexpected Note: %v", note)
+	}
+	if note["published"] != m["published"] {
+		t.Errorf("expected the Create to be published with the Note: %v != %v", m["published"], note["published"])
+	}
+	to, ok = note["to"].([]interface{})
+	if !ok || len(to) != 2 {
+		t.Errorf("unexpected to of the Note: %v", note["to"])
+	}
+}
+
+func TestNewCreateCopiesAddressing(t *testing.T) {
+	n := streams.NewActivityStreamsNote()
+	cc := streams.NewActivityStreamsCcProperty()
+	cc.AppendIRI(testFollowers)
+	n.SetActivityStreamsCc(cc)
+	bcc := streams.NewActivityStreamsBccProperty()
+	embedded := streams.NewActivityStreamsPerson()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(testOther)
+	embedded.SetActivityStreamsId(id)
+	bcc.AppendActivityStreamsPerson(embedded)
+	bcc.AppendActivityStreamsPerson(streams.NewActivityStreamsPerson())
+	n.SetActivityStreamsBcc(bcc)
+	c, err := NewCreate(testActor, n)
+	if err != nil {
+		t.Fatalf("NewCreate returned error: %s", err)
+	}
+	if c.GetActivityStreamsTo() != nil {
+		t.Errorf("expected no to")
+	}
+	if p := c.GetActivityStreamsCc(); p == nil || p.Len() != 1 || p.At(0).GetIRI().String() != testFollowers.String() {
+		t.Errorf("expected cc to be copied")
+	}
+	if p := c.GetActivityStreamsBcc(); p == nil || p.Len() != 1 || !p.At(0).IsIRI() || p.At(0).GetIRI().String() != testOther.String() {
+		t.Errorf("expected the id of the embedded bcc to be copied")
+	}
+	if c.GetActivityStreamsPublished() != nil {
+		t.Errorf("expected no published")
+	}
+}
+
+func TestNewFollowAcceptUndo(t *testing.T) {
+	f := NewFollow(testActor, testOther)
+	if p := f.GetActivityStreamsObject(); p == nil || p.Len() != 1 || p.At(0).GetIRI().String() != testOther.String() {
+		t.Errorf("unexpected object of the Follow")
+	}
+	if p := f.GetActivityStreamsTo(); p == nil || p.Len() != 1 || p.At(0).GetIRI().String() != testOther.String() {
+		t.Errorf("expected the Follow to be addressed to its object")
+	}
+	a := NewAccept(testOther, f)
+	if p := a.GetActivityStreamsObject(); p == nil || p.Len() != 1 || !p.At(0).IsActivityStreamsFollow() {
+		t.Errorf("expected the Accept to embed the Follow")
+	}
+	if p := a.GetActivityStreamsTo(); p == nil || p.Len() != 1 || p.At(0).GetIRI().String() != testActor.String() {
+		t.Errorf("expected the Accept to be addressed to the follower")
+	}
+	u, err := NewUndo(testActor, f)
+	if err != nil {
+		t.Fatalf("NewUndo returned error: %s", err)
+	}
+	if p := u.GetActivityStreamsObject(); p == nil || p.Len() != 1 || !p.At(0).IsActivityStreamsFollow() {
+		t.Errorf("expected the Undo to embed the Follow")
+	}
+	if p := u.GetActivityStreamsTo(); p == nil || p.Len() != 1 || p.At(0).GetIRI().String() != testOther.String() {
+		t.Errorf("expected the Undo to be addressed like the Follow")
+	}
+}
@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// Addressing is the "to" and "cc" of a value for one visibility, as built by
+// Public, Unlisted, FollowersOnly, and Direct.
+//
+// The presets place the Public collection and the followers collection the
+// way Mastodon does, which is how it and most other software infer the
+// visibility of a post.
+type Addressing struct {
+	To []*url.URL
+	Cc []*url.URL
+}
+
+// Public addresses a value to everyone, shown on public timelines. The actor's
+// followers and the mentioned actors are copied.
+func Public(followers *url.URL, mentioned ...*url.URL) Addressing {
+	return Addressing{
+		To: []*url.URL{publicIRI()},
+		Cc: append([]*url.URL{followers}, mentioned...),
+	}
+}
+
+// Unlisted addresses a value to the actor's followers and to everyone, but is
+// not shown on public timelines. The mentioned actors are copied.
+func Unlisted(followers *url.URL, mentioned ...*url.URL) Addressing {
+	return Addressing{
+		To: []*url.URL{followers},
+		Cc: append([]*url.URL{publicIRI()}, mentioned...),
+	}
+}
+
+// FollowersOnly addresses a value only to the actor's followers and the
+// mentioned actors.
+func FollowersOnly(followers *url.URL, mentioned ...*url.URL) Addressing {
+	return Addressing{
+		To: []*url.URL{followers},
+		Cc: append([]*url.URL(nil), mentioned...),
+	}
+}
+
+// Direct addresses a value only to the actors.
+func Direct(to ...*url.URL) Addressing {
+	return Addressing{To: append([]*url.URL(nil), to...)}
+}
+
+// Apply replaces the "to" and "cc" properties of the value, and of the values
+// embedded in its "object" property, such as the Note of a Create. A property
+// is cleared if the addressing has no IRIs for it. Other addressing properties
+// are left as they are.
+//
+// Returns an error if the value has no addressing properties.
+func (a Addressing) Apply(t vocab.Type) error {
+	v, ok := t.(addressed)
+	if !ok {
+		return noPropertyError(t, "to")
+	}
+	a.apply(v)
+	if o, ok := t.(objecter); ok && o.GetActivityStreamsObject() != nil {
+		o.GetActivityStreamsObject().ForEach(func(_ int, it vocab.ActivityStreamsObjectPropertyIterator) bool {
+			if ov, ok := it.GetType().(addressed); ok {
+				a.apply(ov)
+			}
+			return true
+		})
+	}
+	return nil
+}
+
+// apply sets the "to" and "cc" properties of the value.
+func (a Addressing) apply(v addressed) {
+	v.SetActivityStreamsTo(newTo(a.To))
+	var cc vocab.ActivityStreamsCcProperty
+	if len(a.Cc) > 0 {
+		cc = streams.NewActivityStreamsCcProperty()
+		for _, iri := range a.Cc {
+			cc.AppendIRI(iri)
+		}
+	}
+	v.SetActivityStreamsCc(cc)
+}
+
+// publicIRI returns a new copy of PublicIRI.
+func publicIRI() *url.URL {
+	// PublicIRI is a valid IRI, so parsing it cannot fail.
+	u, _ := url.Parse(PublicIRI)
+	return u
+}
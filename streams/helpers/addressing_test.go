@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAddressing(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Addressing
+		to   []string
+		cc   []string
+	}{
+		{
+			name: "Public",
+			a:    Public(testFollowers, testOther),
+			to:   []string{PublicIRI},
+			cc:   []string{testFollowers.String(), testOther.String()},
+		},
+		{
+			name: "Unlisted",
+			a:    Unlisted(testFollowers),
+			to:   []string{testFollowers.String()},
+			cc:   []string{PublicIRI},
+		},
+		{
+			name: "FollowersOnly",
+			a:    FollowersOnly(testFollowers, testOther),
+			to:   []string{testFollowers.String()},
+			cc:   []string{testOther.String()},
+		},
+		{
+			name: "Direct",
+			a:    Direct(testOther),
+			to:   []string{testOther.String()},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The Note starts addressed to someone else.
+			c := NewCreateNote(testActor, "Hello", testPublished, testActor)
+			if err := test.a.Apply(c); err != nil {
+				t.Fatalf("Apply returned error: %s", err)
+			}
+			note := c.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+			for _, v := range []addressed{c, note} {
+				var to, cc []string
+				if p := v.GetActivityStreamsTo(); p != nil {
+					for it := p.Begin(); it != p.End(); it = it.Next() {
+						to = append(to, it.GetIRI().String())
+					}
+				}
+				if p := v.GetActivityStreamsCc(); p != nil {
+					for it := p.Begin(); it != p.End(); it = it.Next() {
+						cc = append(cc, it.GetIRI().String())
+					}
+				}
+				if !reflect.DeepEqual(to, test.to) {
+					t.Errorf("unexpected to: got %v, want %v", to, test.to)
+				}
+				if !reflect.DeepEqual(cc, test.cc) {
+					t.Errorf("unexpected cc: got %v, want %v", cc, test.cc)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// blurhashProperty is the Mastodon extension property of a media attachment
+// holding a compact placeholder of the image to show while it loads.
+const blurhashProperty = "blurhash"
+
+// attachmenter is a value with the "attachment" property, such as an Object.
+type attachmenter interface {
+	GetActivityStreamsAttachment() vocab.ActivityStreamsAttachmentProperty
+	SetActivityStreamsAttachment(vocab.ActivityStreamsAttachmentProperty)
+}
+
+// urler is a value with the "url" property, such as an Object.
+type urler interface {
+	GetActivityStreamsUrl() vocab.ActivityStreamsUrlProperty
+}
+
+// mediaTyper is a value with the "mediaType" property.
+type mediaTyper interface {
+	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
+}
+
+// widther is a value with the "width" property, such as a Link or Image.
+type widther interface {
+	GetActivityStreamsWidth() vocab.ActivityStreamsWidthProperty
+}
+
+// heighter is a value with the "height" property, such as a Link or Image.
+type heighter interface {
+	GetActivityStreamsHeight() vocab.ActivityStreamsHeightProperty
+}
+
+// unknownPropertieser is a value with properties outside of its vocabulary.
+type unknownPropertieser interface {
+	GetUnknownProperties() map[string]interface{}
+}
+
+// Attachment is a media file in the "attachment" property of a value,
+// whether it is an Image, Document, or other Object, a Link, or a bare IRI.
+type Attachment struct {
+	// Type is the name of the type of the attachment, such as "Image" or
+	// "Document", and empty if it is a bare IRI.
+	Type string
+	// URL is the location of the media: the "url" of an Object, the "href"
+	// of a Link, or the IRI itself.
+	URL *url.URL
+	// MediaType is the MIME type of the media, such as "image/png".
+	MediaType string
+	// Name is the text describing the media to those who cannot see or
+	// hear it.
+	Name string
+	// Blurhash is the placeholder of an image shown while it loads.
+	Blurhash string
+	// Width and Height are the dimensions of the media in pixels, and zero
+	// if they are unknown.
+	Width, Height int
+}
+
+// NewImageAttachment returns an Image at the URL, of the media type such as
+// "image/png", described by the alternative text. The alternative text is
+// omitted if it is empty.
+func NewImageAttachment(u *url.URL, mediaType, alt string) vocab.ActivityStreamsImage {
+	i := streams.NewActivityStreamsImage()
+	i.SetActivityStreamsUrl(newUrl(u))
+	i.SetActivityStreamsMediaType(newMediaType(mediaType))
+	if len(alt) > 0 {
+		i.SetActivityStreamsName(newName(alt))
+	}
+	return i
+}
+
+// NewAudioAttachment returns an Audio at the URL, in the same manner as
+// NewImageAttachment.
+func NewAudioAttachment(u *url.URL, mediaType, alt string) vocab.ActivityStreamsAudio {
+	a := streams.NewActivityStreamsAudio()
+	a.SetActivityStreamsUrl(newUrl(u))
+	a.SetActivityStreamsMediaType(newMediaType(mediaType))
+	if len(alt) > 0 {
+		a.SetActivityStreamsName(newName(alt))
+	}
+	return a
+}
+
+// NewVideoAttachment returns a Video at the URL, in the same manner as
+// NewImageAttachment.
+func NewVideoAttachment(u *url.URL, mediaType, alt string) vocab.ActivityStreamsVideo {
+	v := streams.NewActivityStreamsVideo()
+	v.SetActivityStreamsUrl(newUrl(u))
+	v.SetActivityStreamsMediaType(newMediaType(mediaType))
+	if len(alt) > 0 {
+		v.SetActivityStreamsName(newName(alt))
+	}
+	return v
+}
+
+// SetBlurhash sets the "blurhash" of a media attachment, or clears it if the
+// hash is empty.
+//
+// The "blurhash" property is not part of the ActivityStreams vocabulary, so it
+// is kept in the value's unknown properties.
+func SetBlurhash(t vocab.Type, hash string) error {
+	u, ok := t.(unknownPropertieser)
+	if !ok {
+		return noPropertyError(t, blurhashProperty)
+	} else if len(hash) == 0 {
+		delete(u.GetUnknownProperties(), blurhashProperty)
+		return nil
+	}
+	u.GetUnknownProperties()[blurhashProperty] = hash
+	return nil
+}
+
+// AddAttachments appends the attachments, such as those built by
+// NewImageAttachment, to the "attachment" property of the value.
+//
+// Returns an error if the value has no "attachment" property, or an attachment
+// is not a type of a generated vocabulary.
+func AddAttachments(t vocab.Type, attachments ...vocab.Type) error {
+	v, ok := t.(attachmenter)
+	if !ok {
+		return noPropertyError(t, "attachment")
+	}
+	p := v.GetActivityStreamsAttachment()
+	if p == nil {
+		p = streams.NewActivityStreamsAttachmentProperty()
+	}
+	for _, a := range attachments {
+		if err := p.AppendType(a); err != nil {
+			return err
+		}
+	}
+	v.SetActivityStreamsAttachment(p)
+	return nil
+}
+
+// Attachments returns the values of the "attachment" property of the value.
+// Attachments without a URL are skipped. Returns nil if the value has no such
+// property or it is not set.
+func Attachments(t vocab.Type) (as []Attachment) {
+	v, ok := t.(attachmenter)
+	if !ok || v.GetActivityStreamsAttachment() == nil {
+		return
+	}
+	v.GetActivityStreamsAttachment().ForEach(func(_ int, it vocab.ActivityStreamsAttachmentPropertyIterator) bool {
+		if it.IsIRI() {
+			as = append(as, Attachment{URL: it.GetIRI()})
+		} else if at := it.GetType(); at != nil {
+			if a := toAttachment(at); a.URL != nil {
+				as = append(as, a)
+			}
+		}
+		return true
+	})
+	return
+}
+
+// toAttachment normalizes the embedded Object or Link into an Attachment.
+func toAttachment(t vocab.Type) Attachment {
+	a := Attachment{Type: t.GetTypeName()}
+	if h, ok := t.(hrefer); ok {
+		a.URL = hrefOf(h)
+	} else if u, ok := t.(urler); ok {
+		a.URL = urlOf(u.GetActivityStreamsUrl())
+		// The media type may instead be given by a Link in the "url".
+		if p := u.GetActivityStreamsUrl(); p != nil && p.Len() > 0 {
+			if m, ok := p.At(0).GetType().(mediaTyper); ok {
+				a.MediaType = mediaTypeOf(m)
+			}
+		}
+	}
+	if m, ok := t.(mediaTyper); ok && len(mediaTypeOf(m)) > 0 {
+		a.MediaType = mediaTypeOf(m)
+	}
+	if n, ok := t.(namer); ok {
+		a.Name = nameOf(n)
+	}
+	if w, ok := t.(widther); ok && w.GetActivityStreamsWidth() != nil && w.GetActivityStreamsWidth().IsXMLSchemaNonNegativeInteger() {
+		a.Width = w.GetActivityStreamsWidth().Get()
+	}
+	if h, ok := t.(heighter); ok && h.GetActivityStreamsHeight() != nil && h.GetActivityStreamsHeight().IsXMLSchemaNonNegativeInteger() {
+		a.Height = h.GetActivityStreamsHeight().Get()
+	}
+	if u, ok := t.(unknownPropertieser); ok {
+		unknown := u.GetUnknownProperties()
+		a.Blurhash, _ = unknown[blurhashProperty].(string)
+		// Mastodon also sends the dimensions of a Document, which has no
+		// such properties in the vocabulary.
+		if f, ok := unknown["width"].(float64); ok && a.Width == 0 {
+			a.Width = int(f)
+		}
+		if f, ok := unknown["height"].(float64); ok && a.Height == 0 {
+			a.Height = int(f)
+		}
+	}
+	return a
+}
+
+// newUrl returns a "url" property with the single IRI.
+func newUrl(u *url.URL) vocab.ActivityStreamsUrlProperty {
+	p := streams.NewActivityStreamsUrlProperty()
+	p.AppendIRI(u)
+	return p
+}
+
+// newMediaType returns a "mediaType" property of the MIME type, or nil if it is
+// empty.
+func newMediaType(mediaType string) vocab.ActivityStreamsMediaTypeProperty {
+	if len(mediaType) == 0 {
+		return nil
+	}
+	p := streams.NewActivityStreamsMediaTypeProperty()
+	p.Set(mediaType)
+	return p
+}
+
+// mediaTypeOf returns the "mediaType" of the value, or an empty string if it is
+// not set or is an IRI.
+func mediaTypeOf(m mediaTyper) string {
+	if p := m.GetActivityStreamsMediaType(); p != nil && p.IsRFCRfc2045() {
+		return p.Get()
+	}
+	return ""
+}
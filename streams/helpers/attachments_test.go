@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"testing"
+)
+
+func TestAttachments(t *testing.T) {
+	imageURL := mustParse("https://example.com/files/cat.png")
+	n := NewNote(testActor, "A cat", testPublished)
+	image := NewImageAttachment(imageURL, "image/png", "A sleeping cat")
+	if err := SetBlurhash(image, "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH"); err != nil {
+		t.Fatalf("SetBlurhash returned error: %s", err)
+	}
+	if err := AddAttachments(n, image); err != nil {
+		t.Fatalf("AddAttachments returned error: %s", err)
+	}
+	as := Attachments(n)
+	if len(as) != 1 {
+		t.Fatalf("expected 1 attachment, got %v", as)
+	}
+	a := as[0]
+	if a.Type != "Image" || a.URL.String() != imageURL.String() || a.MediaType != "image/png" || a.Name != "A sleeping cat" || a.Blurhash != "UBL_:rOpGG-oBUNG,qRj2so|=eE1w^n4S5NH" {
+		t.Errorf("unexpected attachment: %+v", a)
+	}
+	// A Document and a Link as sent by Mastodon and other software.
+	m := map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type":     "Note",
+		"attachment": []interface{}{
+			map[string]interface{}{
+				"type":      "Document",
+				"mediaType": "video/mp4",
+				"url":       "https://example.com/files/clip.mp4",
+				"name":      nil,
+				"blurhash":  "U9Bo",
+				"width":     float64(640),
+				"height":    float64(480),
+			},
+			map[string]interface{}{
+				"type":      "Link",
+				"mediaType": "audio/ogg",
+				"href":      "https://example.com/files/song.ogg",
+			},
+			"https://example.com/files/other.png",
+		},
+	}
+	received, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	as = Attachments(received)
+	if len(as) != 3 {
+		t.Fatalf("expected 3 attachments, got %v", as)
+	}
+	if a := as[0]; a.Type != "Document" || a.URL.String() != "https://example.com/files/clip.mp4" || a.MediaType != "video/mp4" || a.Blurhash != "U9Bo" || a.Width != 640 || a.Height != 480 {
+		t.Errorf("unexpected Document attachment: %+v", a)
+	}
+	if a := as[1]; a.Type != "Link" || a.URL.String() != "https://example.com/files/song.ogg" || a.MediaType != "audio/ogg" {
+		t.Errorf("unexpected Link attachment: %+v", a)
+	}
+	if a := as[2]; a.Type != "" || a.URL.String() != "https://example.com/files/other.png" {
+		t.Errorf("unexpected IRI attachment: %+v", a)
+	}
+}
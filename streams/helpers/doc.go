@@ -0,0 +1,44 @@
+// Package helpers assembles and inspects common ActivityStreams values in one
+// call.
+//
+// Building an activity with the generated constructors in the streams package
+// requires creating and setting every property by hand. The functions in this
+// package instead build the activities most applications send, such as
+// creating a Note or following an actor, with their actor, object, and
+// addressing already set:
+//
+//	create := helpers.NewCreateNote(actorIRI, "Hello, world!", clock.Now(), followersIRI)
+//	m, err := streams.Serialize(create)
+//
+// The values returned are ordinary generated types, so any other property may
+// still be set on them before they are sent.
+//
+// Other functions read values received from peers, such as Recipients, which
+// lists every IRI a value is addressed to, and GetActorIRIs, GetObjectIRIs,
+// and GetTargetIRIs, which identify the parties of any activity.
+//
+// Published, Updated, StartTime, EndTime, and Duration read the times of any
+// value, and return the zero value if the property is not set. Their setters
+// clear the property when given the zero value.
+//
+// NewMention, NewHashtag, and NewEmoji build the entries of the "tag"
+// property, which AddTags appends to any object. Mentions, Hashtags, and Emojis
+// extract them back as Tag values of their href and name.
+//
+// NewImageAttachment, NewAudioAttachment, and NewVideoAttachment build media
+// attachments, which AddAttachments appends to any object. Attachments reads
+// them back as Attachment values whether they were sent as an Image, a
+// Document, a Link, or a bare IRI.
+//
+// NewReaction and NewEmojiReaction build a Like reacting with an emoji, as
+// Misskey and Pleroma send them, which GetReaction reads back.
+//
+// ProfileFields and SetProfileFields read and write the PropertyValue name and
+// value pairs Mastodon shows on the profile of an actor.
+//
+// Public, Unlisted, FollowersOnly, and Direct return the Addressing of each
+// visibility, placing the Public collection where Mastodon expects it. Apply
+// sets it on an activity and the object it wraps at once:
+//
+//	err := helpers.Unlisted(followersIRI, mentionedIRI).Apply(create)
+package helpers
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// actorer is a value with the "actor" property, such as an Activity.
+type actorer interface {
+	GetActivityStreamsActor() vocab.ActivityStreamsActorProperty
+}
+
+// targeter is a value with the "target" property, such as an Activity.
+type targeter interface {
+	GetActivityStreamsTarget() vocab.ActivityStreamsTargetProperty
+}
+
+// GetActorIRIs returns the IRIs of the "actor" property of any activity.
+//
+// Embedded values are identified by their id, or by their href if they are a
+// Link, and those with neither are skipped. Returns nil if the value has no
+// such property or it is not set.
+func GetActorIRIs(t vocab.Type) (iris []*url.URL) {
+	a, ok := t.(actorer)
+	if !ok || a.GetActivityStreamsActor() == nil {
+		return
+	}
+	a.GetActivityStreamsActor().ForEach(func(_ int, it vocab.ActivityStreamsActorPropertyIterator) bool {
+		if id := idOf(it); id != nil {
+			iris = append(iris, id)
+		}
+		return true
+	})
+	return
+}
+
+// GetObjectIRIs returns the IRIs of the "object" property of any activity, in
+// the same manner as GetActorIRIs.
+func GetObjectIRIs(t vocab.Type) (iris []*url.URL) {
+	o, ok := t.(objecter)
+	if !ok || o.GetActivityStreamsObject() == nil {
+		return
+	}
+	o.GetActivityStreamsObject().ForEach(func(_ int, it vocab.ActivityStreamsObjectPropertyIterator) bool {
+		if id := idOf(it); id != nil {
+			iris = append(iris, id)
+		}
+		return true
+	})
+	return
+}
+
+// GetTargetIRIs returns the IRIs of the "target" property of any activity, in
+// the same manner as GetActorIRIs.
+func GetTargetIRIs(t vocab.Type) (iris []*url.URL) {
+	tg, ok := t.(targeter)
+	if !ok || tg.GetActivityStreamsTarget() == nil {
+		return
+	}
+	tg.GetActivityStreamsTarget().ForEach(func(_ int, it vocab.ActivityStreamsTargetPropertyIterator) bool {
+		if id := idOf(it); id != nil {
+			iris = append(iris, id)
+		}
+		return true
+	})
+	return
+}
@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"testing"
+)
+
+func TestGetIRIs(t *testing.T) {
+	collection := mustParse("https://example.com/users/alice/collections/featured")
+	add := streams.NewActivityStreamsAdd()
+	actor := streams.NewActivityStreamsActorProperty()
+	person := streams.NewActivityStreamsPerson()
+	id := streams.NewActivityStreamsIdProperty()
+	id.Set(testActor)
+	person.SetActivityStreamsId(id)
+	actor.AppendActivityStreamsPerson(person)
+	add.SetActivityStreamsActor(actor)
+	object := streams.NewActivityStreamsObjectProperty()
+	object.AppendIRI(testOther)
+	// An embedded Note without an id is skipped.
+	object.AppendActivityStreamsNote(streams.NewActivityStreamsNote())
+	add.SetActivityStreamsObject(object)
+	target := streams.NewActivityStreamsTargetProperty()
+	link := streams.NewActivityStreamsLink()
+	href := streams.NewActivityStreamsHrefProperty()
+	href.Set(collection)
+	link.SetActivityStreamsHref(href)
+	target.AppendActivityStreamsLink(link)
+	add.SetActivityStreamsTarget(target)
+	if iris := GetActorIRIs(add); len(iris) != 1 || iris[0].String() != testActor.String() {
+		t.Errorf("unexpected actor IRIs: %v", iris)
+	}
+	if iris := GetObjectIRIs(add); len(iris) != 1 || iris[0].String() != testOther.String() {
+		t.Errorf("unexpected object IRIs: %v", iris)
+	}
+	if iris := GetTargetIRIs(add); len(iris) != 1 || iris[0].String() != collection.String() {
+		t.Errorf("unexpected target IRIs: %v", iris)
+	}
+	if iris := GetTargetIRIs(NewFollow(testActor, testOther)); iris != nil {
+		t.Errorf("expected no target IRIs, got %v", iris)
+	}
+	if iris := GetActorIRIs(streams.NewActivityStreamsNote()); iris != nil {
+		t.Errorf("expected no actor IRIs of a Note, got %v", iris)
+	}
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// ProfileField is a name and value pair shown on the profile of an actor, such
+// as "Website" and a link to it, sent as a PropertyValue in its "attachment"
+// property.
+type ProfileField struct {
+	Name string
+	// Value is the content of the field, which Mastodon sends as HTML.
+	Value string
+}
+
+// NewPropertyValue returns a PropertyValue of the profile field.
+func NewPropertyValue(f ProfileField) vocab.ActivityStreamsPropertyValue {
+	p := streams.NewActivityStreamsPropertyValue()
+	p.SetActivityStreamsName(newName(f.Name))
+	v := streams.NewActivityStreamsValueProperty()
+	v.Set(f.Value)
+	p.SetActivityStreamsValue(v)
+	return p
+}
+
+// SetProfileFields replaces the PropertyValues in the "attachment" property of
+// the actor with the fields, in order. Other attachments are kept.
+//
+// Returns an error if the value has no "attachment" property.
+func SetProfileFields(t vocab.Type, fields ...ProfileField) error {
+	v, ok := t.(attachmenter)
+	if !ok {
+		return noPropertyError(t, "attachment")
+	}
+	p := streams.NewActivityStreamsAttachmentProperty()
+	if old := v.GetActivityStreamsAttachment(); old != nil {
+		for it := old.Begin(); it != old.End(); it = it.Next() {
+			if it.IsActivityStreamsPropertyValue() {
+				continue
+			} else if it.IsIRI() {
+				p.AppendIRI(it.GetIRI())
+			} else if at := it.GetType(); at != nil {
+				if err := p.AppendType(at); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, f := range fields {
+		p.AppendActivityStreamsPropertyValue(NewPropertyValue(f))
+	}
+	if p.Len() == 0 {
+		p = nil
+	}
+	v.SetActivityStreamsAttachment(p)
+	return nil
+}
+
+// ProfileFields returns the PropertyValues in the "attachment" property of the
+// actor, in order. Returns nil if the value has no such property or it has no
+// PropertyValues.
+func ProfileFields(t vocab.Type) (fields []ProfileField) {
+	v, ok := t.(attachmenter)
+	if !ok || v.GetActivityStreamsAttachment() == nil {
+		return
+	}
+	v.GetActivityStreamsAttachment().ForEach(func(_ int, it vocab.ActivityStreamsAttachmentPropertyIterator) bool {
+		if !it.IsActivityStreamsPropertyValue() {
+			return true
+		}
+		pv := it.GetActivityStreamsPropertyValue()
+		f := ProfileField{Name: nameOf(pv)}
+		if p := pv.GetActivityStreamsValue(); p != nil && p.IsXMLSchemaString() {
+			f.Value = p.Get()
+		}
+		fields = append(fields, f)
+		return true
+	})
+	return
+}
@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"testing"
+)
+
+const mastodonActor = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/users/alice",
+  "type": "Person",
+  "manuallyApprovesFollowers": true,
+  "alsoKnownAs": ["https://example.net/users/alice"],
+  "featured": "https://example.com/users/alice/collections/featured",
+  "featuredTags": "https://example.com/users/alice/collections/tags",
+  "attachment": [
+    {"type": "PropertyValue", "name": "Website", "value": "<a href=\"https://alice.example\">alice.example</a>"},
+    {"type": "Image", "url": "https://example.com/files/banner.png"}
+  ]
+}`
+
+func TestProfileFields(t *testing.T) {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(mastodonActor), &m); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %s", err)
+	}
+	v, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	p, ok := v.(vocab.ActivityStreamsPerson)
+	if !ok {
+		t.Fatalf("expected a Person, got %T", v)
+	}
+	if mf := p.GetActivityStreamsManuallyApprovesFollowers(); mf == nil || !mf.Get() {
+		t.Errorf("expected manuallyApprovesFollowers to be true")
+	}
+	if aka := p.GetActivityStreamsAlsoKnownAs(); aka == nil || aka.Len() != 1 || aka.At(0).Get().String() != "https://example.net/users/alice" {
+		t.Errorf("unexpected alsoKnownAs: %v", aka)
+	}
+	if f := p.GetActivityStreamsFeatured(); f == nil || !f.IsIRI() {
+		t.Errorf("expected featured to be an IRI, got %v", f)
+	}
+	if f := p.GetActivityStreamsFeaturedTags(); f == nil || !f.IsIRI() {
+		t.Errorf("expected featuredTags to be an IRI, got %v", f)
+	}
+	if _, ok := p.GetUnknownProperties()["alsoKnownAs"]; ok {
+		t.Errorf("expected alsoKnownAs not to be an unknown property")
+	}
+	fields := ProfileFields(p)
+	if len(fields) != 1 || fields[0].Name != "Website" || fields[0].Value != `<a href="https://alice.example">alice.example</a>` {
+		t.Fatalf("unexpected profile fields: %v", fields)
+	}
+	err = SetProfileFields(p, ProfileField{Name: "Pronouns", Value: "they/them"}, fields[0])
+	if err != nil {
+		t.Fatalf("SetProfileFields returned error: %s", err)
+	}
+	if fields := ProfileFields(p); len(fields) != 2 || fields[0].Name != "Pronouns" || fields[1].Name != "Website" {
+		t.Errorf("unexpected profile fields after setting: %v", fields)
+	}
+	if as := Attachments(p); len(as) != 1 || as[0].Type != "Image" {
+		t.Errorf("expected the other attachments to be kept, got %v", as)
+	}
+	if err := SetProfileFields(streams.NewActivityStreamsMention()); err == nil {
+		t.Errorf("expected an error setting profile fields of a Mention")
+	}
+}
@@ -0,0 +1,114 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// misskeyReactionProperty is the Misskey extension property of a Like holding
+// its reaction, which Misskey sends alongside the "content".
+const misskeyReactionProperty = "_misskey_reaction"
+
+// contenter is a value with the "content" property, such as an Object.
+type contenter interface {
+	GetActivityStreamsContent() vocab.ActivityStreamsContentProperty
+}
+
+// Reaction is the emoji of a Like sent as a reaction, as Misskey and Pleroma
+// do, rather than as a plain like.
+type Reaction struct {
+	// Content is the reaction, which is either a Unicode emoji such as
+	// "👍" or the shortcode of a custom emoji such as ":blobcat:".
+	Content string
+	// Emoji is the custom emoji of the shortcode from the "tag" property of
+	// the Like, and nil for a Unicode emoji or if it was not sent.
+	Emoji *Tag
+}
+
+// IsCustom returns whether the reaction is the shortcode of a custom emoji.
+func (r Reaction) IsCustom() bool {
+	return len(r.Content) > 2 && strings.HasPrefix(r.Content, ":") && strings.HasSuffix(r.Content, ":")
+}
+
+// NewReaction returns a Like of the object by the actor, reacting with the
+// Unicode emoji in its "content", published at the time, and addressed to the
+// IRIs, such as the actor of the object.
+func NewReaction(actor, object *url.URL, emoji string, published time.Time, to ...*url.URL) vocab.ActivityStreamsLike {
+	l := streams.NewActivityStreamsLike()
+	a := streams.NewActivityStreamsActorProperty()
+	a.AppendIRI(actor)
+	l.SetActivityStreamsActor(a)
+	o := streams.NewActivityStreamsObjectProperty()
+	o.AppendIRI(object)
+	l.SetActivityStreamsObject(o)
+	c := streams.NewActivityStreamsContentProperty()
+	c.AppendXMLSchemaString(emoji)
+	l.SetActivityStreamsContent(c)
+	l.GetUnknownProperties()[misskeyReactionProperty] = emoji
+	l.SetActivityStreamsTo(newTo(to))
+	l.SetActivityStreamsPublished(newPublished(published))
+	return l
+}
+
+// NewEmojiReaction returns a Like reacting with the custom emoji, such as one
+// built by NewEmoji, in the same manner as NewReaction. Its shortcode is the
+// "content" and the emoji is in the "tag" property, so that peers can show
+// its image.
+func NewEmojiReaction(actor, object *url.URL, emoji vocab.ActivityStreamsEmoji, published time.Time, to ...*url.URL) vocab.ActivityStreamsLike {
+	l := NewReaction(actor, object, nameOf(emoji), published, to...)
+	tag := streams.NewActivityStreamsTagProperty()
+	tag.AppendActivityStreamsEmoji(emoji)
+	l.SetActivityStreamsTag(tag)
+	return l
+}
+
+// GetReaction returns the reaction of a Like, and whether it is one. A Like
+// without "content" is a plain like, and is not a reaction.
+//
+// The reaction is read from the Misskey extension property if there is no
+// "content".
+func GetReaction(t vocab.Type) (r Reaction, ok bool) {
+	if c, isC := t.(contenter); isC {
+		r.Content = contentOf(c)
+	}
+	if len(r.Content) == 0 {
+		if u, isU := t.(unknownPropertieser); isU {
+			r.Content, _ = u.GetUnknownProperties()[misskeyReactionProperty].(string)
+		}
+	}
+	r.Content = strings.TrimSpace(r.Content)
+	if len(r.Content) == 0 {
+		return r, false
+	}
+	if r.IsCustom() {
+		for _, e := range Emojis(t) {
+			if e.Name == r.Content {
+				e := e
+				r.Emoji = &e
+				break
+			}
+		}
+	}
+	return r, true
+}
+
+// contentOf returns the first string of the "content" property of the value, in
+// the same manner as nameOf.
+func contentOf(c contenter) string {
+	p := c.GetActivityStreamsContent()
+	if p == nil {
+		return ""
+	}
+	var content string
+	for it := p.Begin(); it != p.End(); it = it.Next() {
+		if it.IsXMLSchemaString() {
+			return it.GetXMLSchemaString()
+		} else if it.IsRDFLangString() && len(content) == 0 {
+			content = firstLangString(it.GetRDFLangString())
+		}
+	}
+	return content
+}
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"testing"
+)
+
+func TestReactions(t *testing.T) {
+	noteIRI := mustParse("https://example.net/notes/1")
+	emojiIcon := mustParse("https://example.com/files/blobcat.png")
+	emoji := NewEmoji(mustParse("https://example.com/emojis/1"), "blobcat", emojiIcon, "image/png")
+	// Round-trip through JSON, as a received reaction would be.
+	l := NewEmojiReaction(testActor, noteIRI, emoji, testPublished, testOther)
+	m, err := streams.Serialize(l)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %s", err)
+	}
+	if m["_misskey_reaction"] != ":blobcat:" {
+		t.Errorf("expected the Misskey reaction to be set, got %v", m["_misskey_reaction"])
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %s", err)
+	}
+	m = nil
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %s", err)
+	}
+	received, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	r, ok := GetReaction(received)
+	if !ok || r.Content != ":blobcat:" || !r.IsCustom() {
+		t.Fatalf("unexpected reaction: %+v, %v", r, ok)
+	}
+	if r.Emoji == nil || r.Emoji.Icon == nil || r.Emoji.Icon.String() != emojiIcon.String() {
+		t.Errorf("unexpected reaction emoji: %+v", r.Emoji)
+	}
+	r, ok = GetReaction(NewReaction(testActor, noteIRI, "👍", testPublished))
+	if !ok || r.Content != "👍" || r.IsCustom() || r.Emoji != nil {
+		t.Errorf("unexpected reaction: %+v, %v", r, ok)
+	}
+	// Misskey may send the reaction only in its extension property.
+	like := streams.NewActivityStreamsLike()
+	like.GetUnknownProperties()["_misskey_reaction"] = "🎉"
+	if r, ok := GetReaction(like); !ok || r.Content != "🎉" {
+		t.Errorf("unexpected Misskey reaction: %+v, %v", r, ok)
+	}
+	if r, ok := GetReaction(streams.NewActivityStreamsLike()); ok {
+		t.Errorf("expected a plain Like not to be a reaction, got %+v", r)
+	}
+}
@@ -0,0 +1,111 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+const (
+	// PublicIRI is the IRI of the Public collection, which addresses a
+	// value to everyone.
+	PublicIRI = "https://www.w3.org/ns/activitystreams#Public"
+	// publicCompacted are the compacted JSON-LD forms of PublicIRI.
+	publicCompacted   = "Public"
+	publicCompactedAS = "as:Public"
+)
+
+// IsPublic determines if an IRI is the Public collection, including its
+// compacted JSON-LD forms.
+func IsPublic(iri *url.URL) bool {
+	s := iri.String()
+	return s == PublicIRI || s == publicCompacted || s == publicCompactedAS
+}
+
+// objecter is a value with the "object" property, such as an Activity.
+type objecter interface {
+	GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty
+}
+
+// RecipientsOptions configure which recipients Recipients returns. The zero
+// value returns every IRI addressed by the value itself.
+type RecipientsOptions struct {
+	// Embedded also returns the recipients addressed by the values
+	// embedded in the "object" property, and by those embedded in their
+	// "object" property in turn. ActivityPub requires the addressing of
+	// the object of a Create to be copied to it, but peers do not always
+	// do so.
+	Embedded bool
+	// ExcludeHidden omits the recipients in the "bto" and "bcc"
+	// properties, such as when listing who may see a value.
+	ExcludeHidden bool
+	// ExcludePublic omits the Public collection, which cannot be
+	// delivered to.
+	ExcludePublic bool
+}
+
+// Recipients returns the deduplicated IRIs addressed in the "to", "bto", "cc",
+// "bcc", and "audience" properties of the value, in that order.
+//
+// Embedded values are addressed by their id, or by their href if they are a
+// Link, and those with neither are skipped.
+func Recipients(t vocab.Type, opts RecipientsOptions) []*url.URL {
+	var r []*url.URL
+	seen := make(map[string]bool)
+	add := func(id *url.URL) {
+		if id == nil || seen[id.String()] || (opts.ExcludePublic && IsPublic(id)) {
+			return
+		}
+		seen[id.String()] = true
+		r = append(r, id)
+	}
+	appendRecipients(t, opts, add)
+	return r
+}
+
+// appendRecipients calls add with the IRIs addressed by the value and, if the
+// options include them, by its embedded objects.
+func appendRecipients(t vocab.Type, opts RecipientsOptions, add func(*url.URL)) {
+	if a, ok := t.(addressed); ok {
+		if p := a.GetActivityStreamsTo(); p != nil {
+			p.ForEach(func(_ int, it vocab.ActivityStreamsToPropertyIterator) bool {
+				add(idOf(it))
+				return true
+			})
+		}
+		if p := a.GetActivityStreamsBto(); p != nil && !opts.ExcludeHidden {
+			p.ForEach(func(_ int, it vocab.ActivityStreamsBtoPropertyIterator) bool {
+				add(idOf(it))
+				return true
+			})
+		}
+		if p := a.GetActivityStreamsCc(); p != nil {
+			p.ForEach(func(_ int, it vocab.ActivityStreamsCcPropertyIterator) bool {
+				add(idOf(it))
+				return true
+			})
+		}
+		if p := a.GetActivityStreamsBcc(); p != nil && !opts.ExcludeHidden {
+			p.ForEach(func(_ int, it vocab.ActivityStreamsBccPropertyIterator) bool {
+				add(idOf(it))
+				return true
+			})
+		}
+		if p := a.GetActivityStreamsAudience(); p != nil {
+			p.ForEach(func(_ int, it vocab.ActivityStreamsAudiencePropertyIterator) bool {
+				add(idOf(it))
+				return true
+			})
+		}
+	}
+	if !opts.Embedded {
+		return
+	}
+	if o, ok := t.(objecter); ok && o.GetActivityStreamsObject() != nil {
+		o.GetActivityStreamsObject().ForEach(func(_ int, it vocab.ActivityStreamsObjectPropertyIterator) bool {
+			if e := it.GetType(); e != nil {
+				appendRecipients(e, opts, add)
+			}
+			return true
+		})
+	}
+}
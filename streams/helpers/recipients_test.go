@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"net/url"
+	"testing"
+)
+
+func TestRecipients(t *testing.T) {
+	public := mustParse(PublicIRI)
+	hidden := mustParse("https://example.org/users/carol")
+	n := NewNote(testActor, "Hello", testPublished, public, testOther)
+	bcc := streams.NewActivityStreamsBccProperty()
+	bcc.AppendIRI(hidden)
+	n.SetActivityStreamsBcc(bcc)
+	// The Create only addresses the followers, not the recipients of the
+	// Note.
+	c, err := NewCreate(testActor, n)
+	if err != nil {
+		t.Fatalf("NewCreate returned error: %s", err)
+	}
+	c.SetActivityStreamsTo(newTo([]*url.URL{testFollowers, testFollowers}))
+	c.SetActivityStreamsBcc(nil)
+	tests := []struct {
+		name   string
+		opts   RecipientsOptions
+		expect []*url.URL
+	}{
+		{
+			name:   "zero options",
+			expect: []*url.URL{testFollowers},
+		},
+		{
+			name:   "embedded",
+			opts:   RecipientsOptions{Embedded: true},
+			expect: []*url.URL{testFollowers, public, testOther, hidden},
+		},
+		{
+			name:   "embedded excluding hidden and public",
+			opts:   RecipientsOptions{Embedded: true, ExcludeHidden: true, ExcludePublic: true},
+			expect: []*url.URL{testFollowers, testOther},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Run
+			r := Recipients(c, test.opts)
+			// Verify
+			if len(r) != len(test.expect) {
+				t.Fatalf("expected %v, got %v", test.expect, r)
+			}
+			for i := range r {
+				if r[i].String() != test.expect[i].String() {
+					t.Errorf("expected %v, got %v", test.expect, r)
+				}
+			}
+		})
+	}
+}
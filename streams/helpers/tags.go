@@ -0,0 +1,250 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// tagger is a value with the "tag" property, such as an Object.
+type tagger interface {
+	GetActivityStreamsTag() vocab.ActivityStreamsTagProperty
+	SetActivityStreamsTag(vocab.ActivityStreamsTagProperty)
+}
+
+// namer is a value with the "name" property.
+type namer interface {
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+}
+
+// Tag is a Mention, Hashtag, or Emoji in the "tag" property of a value.
+type Tag struct {
+	// Href is the IRI of the mentioned actor, the page of the hashtag, or
+	// the id of the emoji.
+	Href *url.URL
+	// Name is the text of the tag in the content, such as
+	// "@alice@example.com", "#activitypub", or ":blobcat:".
+	Name string
+	// Icon is the URL of the image of an Emoji, and nil for other tags.
+	Icon *url.URL
+}
+
+// NewMention returns a Mention of the actor, named by the text mentioning it
+// in the content, such as "@alice@example.com".
+func NewMention(actor *url.URL, name string) vocab.ActivityStreamsMention {
+	m := streams.NewActivityStreamsMention()
+	h := streams.NewActivityStreamsHrefProperty()
+	h.Set(actor)
+	m.SetActivityStreamsHref(h)
+	m.SetActivityStreamsName(newName(name))
+	return m
+}
+
+// NewHashtag returns a Hashtag linking to the page of the tag. A "#" is added
+// to the name if it does not already start with one.
+func NewHashtag(href *url.URL, name string) vocab.ActivityStreamsHashtag {
+	t := streams.NewActivityStreamsHashtag()
+	h := streams.NewActivityStreamsHrefProperty()
+	h.Set(href)
+	t.SetActivityStreamsHref(h)
+	if !strings.HasPrefix(name, "#") {
+		name = "#" + name
+	}
+	t.SetActivityStreamsName(newName(name))
+	return t
+}
+
+// NewEmoji returns an Emoji with the id whose shortcode is replaced by the
+// image at the icon URL, of the media type such as "image/png". Colons are
+// added around the shortcode if it is not already surrounded by them.
+func NewEmoji(id *url.URL, shortcode string, icon *url.URL, mediaType string) vocab.ActivityStreamsEmoji {
+	e := streams.NewActivityStreamsEmoji()
+	idp := streams.NewActivityStreamsIdProperty()
+	idp.Set(id)
+	e.SetActivityStreamsId(idp)
+	if !strings.HasPrefix(shortcode, ":") {
+		shortcode = ":" + shortcode
+	}
+	if !strings.HasSuffix(shortcode, ":") || len(shortcode) == 1 {
+		shortcode = shortcode + ":"
+	}
+	e.SetActivityStreamsName(newName(shortcode))
+	img := streams.NewActivityStreamsImage()
+	u := streams.NewActivityStreamsUrlProperty()
+	u.AppendIRI(icon)
+	img.SetActivityStreamsUrl(u)
+	if len(mediaType) > 0 {
+		mt := streams.NewActivityStreamsMediaTypeProperty()
+		mt.Set(mediaType)
+		img.SetActivityStreamsMediaType(mt)
+	}
+	ip := streams.NewActivityStreamsIconProperty()
+	ip.AppendActivityStreamsImage(img)
+	e.SetActivityStreamsIcon(ip)
+	return e
+}
+
+// AddTags appends the tags, such as those built by NewMention, NewHashtag, and
+// NewEmoji, to the "tag" property of the value.
+//
+// Returns an error if the value has no "tag" property, or a tag is not a type
+// of a generated vocabulary.
+func AddTags(t vocab.Type, tags ...vocab.Type) error {
+	v, ok := t.(tagger)
+	if !ok {
+		return noPropertyError(t, "tag")
+	}
+	p := v.GetActivityStreamsTag()
+	if p == nil {
+		p = streams.NewActivityStreamsTagProperty()
+	}
+	for _, tag := range tags {
+		if err := p.AppendType(tag); err != nil {
+			return err
+		}
+	}
+	v.SetActivityStreamsTag(p)
+	return nil
+}
+
+// Mentions returns the Mentions in the "tag" property of the value. Returns
+// nil if the value has no such property or it has no Mentions.
+func Mentions(t vocab.Type) (tags []Tag) {
+	forEachTag(t, func(it vocab.ActivityStreamsTagPropertyIterator) {
+		if it.IsActivityStreamsMention() {
+			m := it.GetActivityStreamsMention()
+			tags = append(tags, Tag{Href: hrefOf(m), Name: nameOf(m)})
+		}
+	})
+	return
+}
+
+// Hashtags returns the Hashtags in the "tag" property of the value, in the same
+// manner as Mentions.
+func Hashtags(t vocab.Type) (tags []Tag) {
+	forEachTag(t, func(it vocab.ActivityStreamsTagPropertyIterator) {
+		if it.IsActivityStreamsHashtag() {
+			h := it.GetActivityStreamsHashtag()
+			tags = append(tags, Tag{Href: hrefOf(h), Name: nameOf(h)})
+		}
+	})
+	return
+}
+
+// Emojis returns the Emojis in the "tag" property of the value, in the same
+// manner as Mentions. The Href of each is its id.
+func Emojis(t vocab.Type) (tags []Tag) {
+	forEachTag(t, func(it vocab.ActivityStreamsTagPropertyIterator) {
+		if !it.IsActivityStreamsEmoji() {
+			return
+		}
+		e := it.GetActivityStreamsEmoji()
+		tag := Tag{Name: nameOf(e), Icon: iconOf(e)}
+		if id := e.GetActivityStreamsId(); id != nil {
+			tag.Href = id.Get()
+		}
+		tags = append(tags, tag)
+	})
+	return
+}
+
+// forEachTag calls fn with each value of the "tag" property of the value.
+func forEachTag(t vocab.Type, fn func(vocab.ActivityStreamsTagPropertyIterator)) {
+	v, ok := t.(tagger)
+	if !ok || v.GetActivityStreamsTag() == nil {
+		return
+	}
+	v.GetActivityStreamsTag().ForEach(func(_ int, it vocab.ActivityStreamsTagPropertyIterator) bool {
+		fn(it)
+		return true
+	})
+}
+
+// newName returns a "name" property with the single string.
+func newName(name string) vocab.ActivityStreamsNameProperty {
+	n := streams.NewActivityStreamsNameProperty()
+	n.AppendXMLSchemaString(name)
+	return n
+}
+
+// hrefOf returns the "href" of the Link, or nil if it is not set.
+func hrefOf(h hrefer) *url.URL {
+	if p := h.GetActivityStreamsHref(); p != nil {
+		return p.Get()
+	}
+	return nil
+}
+
+// nameOf returns the first string of the "name" property of the value,
+// preferring a plain string over a language-tagged one, and then the language
+// that sorts first. Returns an empty string if the name is not set.
+func nameOf(n namer) string {
+	p := n.GetActivityStreamsName()
+	if p == nil {
+		return ""
+	}
+	var name string
+	for it := p.Begin(); it != p.End(); it = it.Next() {
+		if it.IsXMLSchemaString() {
+			return it.GetXMLSchemaString()
+		} else if it.IsRDFLangString() && len(name) == 0 {
+			name = firstLangString(it.GetRDFLangString())
+		}
+	}
+	return name
+}
+
+// firstLangString returns the string of the language that sorts first, or an
+// empty string if there is none.
+func firstLangString(langs map[string]string) string {
+	keys := make([]string, 0, len(langs))
+	for k := range langs {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+	return langs[keys[0]]
+}
+
+// iconOf returns the URL of the first "icon" of the Emoji, which is either an
+// IRI or the "url" of an embedded Image. Returns nil if there is no icon.
+func iconOf(e vocab.ActivityStreamsEmoji) *url.URL {
+	p := e.GetActivityStreamsIcon()
+	if p == nil {
+		return nil
+	}
+	for it := p.Begin(); it != p.End(); it = it.Next() {
+		if it.IsIRI() {
+			return it.GetIRI()
+		} else if it.IsActivityStreamsImage() {
+			if u := urlOf(it.GetActivityStreamsImage().GetActivityStreamsUrl()); u != nil {
+				return u
+			}
+		}
+	}
+	return nil
+}
+
+// urlOf returns the first URL of the "url" property, which is either an IRI or
+// the "href" of an embedded Link. Returns nil if there is none.
+func urlOf(p vocab.ActivityStreamsUrlProperty) *url.URL {
+	if p == nil {
+		return nil
+	}
+	for it := p.Begin(); it != p.End(); it = it.Next() {
+		if it.IsIRI() {
+			return it.GetIRI()
+		} else if it.IsXMLSchemaAnyURI() {
+			return it.GetXMLSchemaAnyURI()
+		} else if t, ok := it.GetType().(hrefer); ok {
+			if u := hrefOf(t); u != nil {
+				return u
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"testing"
+)
+
+func TestTags(t *testing.T) {
+	tagPage := mustParse("https://example.com/tags/activitypub")
+	emojiID := mustParse("https://example.com/emojis/1")
+	emojiIcon := mustParse("https://example.com/files/blobcat.png")
+	n := NewNote(testActor, "Hi @bob #activitypub :blobcat:", testPublished)
+	err := AddTags(n,
+		NewMention(testOther, "@bob@example.net"),
+		NewHashtag(tagPage, "activitypub"),
+		NewEmoji(emojiID, "blobcat", emojiIcon, "image/png"))
+	if err != nil {
+		t.Fatalf("AddTags returned error: %s", err)
+	}
+	// Round-trip through JSON, as a received value would be.
+	m, err := streams.Serialize(n)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %s", err)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %s", err)
+	}
+	m = nil
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %s", err)
+	}
+	received, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	if tags := Mentions(received); len(tags) != 1 || tags[0].Href.String() != testOther.String() || tags[0].Name != "@bob@example.net" {
+		t.Errorf("unexpected mentions: %v", tags)
+	}
+	if tags := Hashtags(received); len(tags) != 1 || tags[0].Href.String() != tagPage.String() || tags[0].Name != "#activitypub" {
+		t.Errorf("unexpected hashtags: %v", tags)
+	}
+	tags := Emojis(received)
+	if len(tags) != 1 || tags[0].Href.String() != emojiID.String() || tags[0].Name != ":blobcat:" {
+		t.Fatalf("unexpected emojis: %v", tags)
+	}
+	if tags[0].Icon == nil || tags[0].Icon.String() != emojiIcon.String() {
+		t.Errorf("unexpected emoji icon: %v", tags[0].Icon)
+	}
+	if tags := Mentions(NewFollow(testActor, testOther)); tags != nil {
+		t.Errorf("expected no mentions of a Follow, got %v", tags)
+	}
+	if err := AddTags(streams.NewActivityStreamsMention(), NewHashtag(tagPage, "#go")); err == nil {
+		t.Errorf("expected an error tagging a Mention")
+	}
+}
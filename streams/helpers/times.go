@@ -0,0 +1,185 @@
+package helpers
+
+import (
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"time"
+)
+
+// publisheder is a value with the "published" property.
+type publisheder interface {
+	GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty
+	SetActivityStreamsPublished(vocab.ActivityStreamsPublishedProperty)
+}
+
+// updateder is a value with the "updated" property.
+type updateder interface {
+	GetActivityStreamsUpdated() vocab.ActivityStreamsUpdatedProperty
+	SetActivityStreamsUpdated(vocab.ActivityStreamsUpdatedProperty)
+}
+
+// startTimeer is a value with the "startTime" property.
+type startTimeer interface {
+	GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty
+	SetActivityStreamsStartTime(vocab.ActivityStreamsStartTimeProperty)
+}
+
+// endTimeer is a value with the "endTime" property.
+type endTimeer interface {
+	GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty
+	SetActivityStreamsEndTime(vocab.ActivityStreamsEndTimeProperty)
+}
+
+// durationer is a value with the "duration" property.
+type durationer interface {
+	GetActivityStreamsDuration() vocab.ActivityStreamsDurationProperty
+	SetActivityStreamsDuration(vocab.ActivityStreamsDurationProperty)
+}
+
+// noPropertyError returns the error of setting a property the value does not
+// have.
+func noPropertyError(t vocab.Type, name string) error {
+	return fmt.Errorf("the %s type has no %q property", t.GetTypeName(), name)
+}
+
+// Published returns the time of the "published" property of the value. Returns
+// the zero time if the value has no such property, it is not set, or it is an
+// IRI instead of a time.
+func Published(t vocab.Type) time.Time {
+	if v, ok := t.(publisheder); ok {
+		if p := v.GetActivityStreamsPublished(); p != nil && p.IsXMLSchemaDateTime() {
+			return p.Get()
+		}
+	}
+	return time.Time{}
+}
+
+// SetPublished sets the "published" property of the value to the time, or
+// clears it if the time is zero.
+//
+// Returns an error if the value has no "published" property.
+func SetPublished(t vocab.Type, tm time.Time) error {
+	v, ok := t.(publisheder)
+	if !ok {
+		return noPropertyError(t, "published")
+	} else if tm.IsZero() {
+		v.SetActivityStreamsPublished(nil)
+		return nil
+	}
+	p := streams.NewActivityStreamsPublishedProperty()
+	p.Set(tm)
+	v.SetActivityStreamsPublished(p)
+	return nil
+}
+
+// Updated returns the time of the "updated" property of the value, in the same
+// manner as Published.
+func Updated(t vocab.Type) time.Time {
+	if v, ok := t.(updateder); ok {
+		if p := v.GetActivityStreamsUpdated(); p != nil && p.IsXMLSchemaDateTime() {
+			return p.Get()
+		}
+	}
+	return time.Time{}
+}
+
+// SetUpdated sets the "updated" property of the value, in the same manner as
+// SetPublished.
+func SetUpdated(t vocab.Type, tm time.Time) error {
+	v, ok := t.(updateder)
+	if !ok {
+		return noPropertyError(t, "updated")
+	} else if tm.IsZero() {
+		v.SetActivityStreamsUpdated(nil)
+		return nil
+	}
+	p := streams.NewActivityStreamsUpdatedProperty()
+	p.Set(tm)
+	v.SetActivityStreamsUpdated(p)
+	return nil
+}
+
+// StartTime returns the time of the "startTime" property of the value, in the
+// same manner as Published.
+func StartTime(t vocab.Type) time.Time {
+	if v, ok := t.(startTimeer); ok {
+		if p := v.GetActivityStreamsStartTime(); p != nil && p.IsXMLSchemaDateTime() {
+			return p.Get()
+		}
+	}
+	return time.Time{}
+}
+
+// SetStartTime sets the "startTime" property of the value, in the same manner
+// as SetPublished.
+func SetStartTime(t vocab.Type, tm time.Time) error {
+	v, ok := t.(startTimeer)
+	if !ok {
+		return noPropertyError(t, "startTime")
+	} else if tm.IsZero() {
+		v.SetActivityStreamsStartTime(nil)
+		return nil
+	}
+	p := streams.NewActivityStreamsStartTimeProperty()
+	p.Set(tm)
+	v.SetActivityStreamsStartTime(p)
+	return nil
+}
+
+// EndTime returns the time of the "endTime" property of the value, in the same
+// manner as Published.
+func EndTime(t vocab.Type) time.Time {
+	if v, ok := t.(endTimeer); ok {
+		if p := v.GetActivityStreamsEndTime(); p != nil && p.IsXMLSchemaDateTime() {
+			return p.Get()
+		}
+	}
+	return time.Time{}
+}
+
+// SetEndTime sets the "endTime" property of the value, in the same manner as
+// SetPublished.
+func SetEndTime(t vocab.Type, tm time.Time) error {
+	v, ok := t.(endTimeer)
+	if !ok {
+		return noPropertyError(t, "endTime")
+	} else if tm.IsZero() {
+		v.SetActivityStreamsEndTime(nil)
+		return nil
+	}
+	p := streams.NewActivityStreamsEndTimeProperty()
+	p.Set(tm)
+	v.SetActivityStreamsEndTime(p)
+	return nil
+}
+
+// Duration returns the "duration" property of the value. Returns zero if the
+// value has no such property, it is not set, or it is an IRI instead of a
+// duration.
+func Duration(t vocab.Type) time.Duration {
+	if v, ok := t.(durationer); ok {
+		if p := v.GetActivityStreamsDuration(); p != nil && p.IsXMLSchemaDuration() {
+			return p.Get()
+		}
+	}
+	return 0
+}
+
+// SetDuration sets the "duration" property of the value, or clears it if the
+// duration is zero.
+//
+// Returns an error if the value has no "duration" property.
+func SetDuration(t vocab.Type, d time.Duration) error {
+	v, ok := t.(durationer)
+	if !ok {
+		return noPropertyError(t, "duration")
+	} else if d == 0 {
+		v.SetActivityStreamsDuration(nil)
+		return nil
+	}
+	p := streams.NewActivityStreamsDurationProperty()
+	p.Set(d)
+	v.SetActivityStreamsDuration(p)
+	return nil
+}
@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"github.com/go-fed/activity/streams"
+	"testing"
+	"time"
+)
+
+func TestTimes(t *testing.T) {
+	event := streams.NewActivityStreamsEvent()
+	if !Published(event).IsZero() || !StartTime(event).IsZero() || Duration(event) != 0 {
+		t.Errorf("expected zero values of an empty Event")
+	}
+	start := time.Date(2020, 5, 1, 18, 0, 0, 0, time.UTC)
+	if err := SetStartTime(event, start); err != nil {
+		t.Fatalf("SetStartTime returned error: %s", err)
+	}
+	if err := SetEndTime(event, start.Add(2*time.Hour)); err != nil {
+		t.Fatalf("SetEndTime returned error: %s", err)
+	}
+	if err := SetDuration(event, 2*time.Hour); err != nil {
+		t.Fatalf("SetDuration returned error: %s", err)
+	}
+	if err := SetUpdated(event, start); err != nil {
+		t.Fatalf("SetUpdated returned error: %s", err)
+	}
+	if got := StartTime(event); !got.Equal(start) {
+		t.Errorf("StartTime: got %v", got)
+	}
+	if got := EndTime(event); !got.Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("EndTime: got %v", got)
+	}
+	if got := Duration(event); got != 2*time.Hour {
+		t.Errorf("Duration: got %v", got)
+	}
+	if got := Updated(event); !got.Equal(start) {
+		t.Errorf("Updated: got %v", got)
+	}
+	if err := SetUpdated(event, time.Time{}); err != nil {
+		t.Fatalf("SetUpdated returned error: %s", err)
+	} else if event.GetActivityStreamsUpdated() != nil {
+		t.Errorf("expected SetUpdated of the zero time to clear the property")
+	}
+	if err := SetDuration(event, 0); err != nil {
+		t.Fatalf("SetDuration returned error: %s", err)
+	} else if event.GetActivityStreamsDuration() != nil {
+		t.Errorf("expected SetDuration of zero to clear the property")
+	}
+	// A published IRI is not a time.
+	published := streams.NewActivityStreamsPublishedProperty()
+	published.SetIRI(testActor)
+	event.SetActivityStreamsPublished(published)
+	if !Published(event).IsZero() {
+		t.Errorf("expected the zero time of a published IRI")
+	}
+	if err := SetPublished(streams.NewActivityStreamsPublicKey(), start); err == nil {
+		t.Errorf("expected an error setting the published time of a PublicKey")
+	}
+}
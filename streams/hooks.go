@@ -0,0 +1,87 @@
+package streams
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"sync"
+)
+
+// SerializeHook is called by SerializeContext with its context, the value, and
+// the map the value is serialized into, before the map is returned.
+//
+// It may modify the map, such as to remove properties that must not leave the
+// server, or return an error to fail the serialization. The context carries the
+// request-scoped data and deadline of the caller.
+type SerializeHook func(c context.Context, t vocab.Type, m map[string]interface{}) error
+
+// DeserializeHook is called by DeserializeContext with its context, the map
+// that was deserialized, and the resulting value, before the value is
+// returned.
+//
+// It may modify the value, such as to sanitize its content, read extension
+// properties from the map, or return an error to reject the value. The context
+// carries the request-scoped data and deadline of the caller.
+type DeserializeHook func(c context.Context, m map[string]interface{}, t vocab.Type) error
+
+var (
+	hooksMu          sync.RWMutex
+	serializeHooks   []SerializeHook
+	deserializeHooks []DeserializeHook
+)
+
+// RegisterSerializeHook adds a hook called by SerializeContext, and so by
+// Serialize, after the hooks already registered. It is typically called when
+// an application is initialized.
+func RegisterSerializeHook(h SerializeHook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	serializeHooks = append(serializeHooks, h)
+}
+
+// RegisterDeserializeHook adds a hook called by DeserializeContext after the
+// hooks already registered. It is typically called when an application is
+// initialized.
+func RegisterDeserializeHook(h DeserializeHook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	deserializeHooks = append(deserializeHooks, h)
+}
+
+// SerializeContext is Serialize with a context, which is passed to each
+// registered SerializeHook. Returns the error of the first hook to fail.
+func SerializeContext(c context.Context, a vocab.Type) (m map[string]interface{}, e error) {
+	m, e = serialize(a)
+	if e != nil {
+		return
+	}
+	hooksMu.RLock()
+	hooks := serializeHooks
+	hooksMu.RUnlock()
+	for _, h := range hooks {
+		if e = h(c, a, m); e != nil {
+			return nil, e
+		}
+	}
+	return
+}
+
+// DeserializeContext is ToType followed by each registered DeserializeHook,
+// which are passed the context. Returns the error of the first hook to fail.
+//
+// ToType itself does not call the hooks, so that applications may deserialize
+// values of their own without them.
+func DeserializeContext(c context.Context, m map[string]interface{}) (t vocab.Type, err error) {
+	t, err = ToType(c, m)
+	if err != nil {
+		return
+	}
+	hooksMu.RLock()
+	hooks := deserializeHooks
+	hooksMu.RUnlock()
+	for _, h := range hooks {
+		if err = h(c, m, t); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
@@ -4,13 +4,17 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsAttachmentPropertyIterator is an iterator for a property. It is
 // permitted to be one of multiple value types. At most, one type of value can
 // be present, or none at all. Setting a value will clear the other types of
 // values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsAttachmentPropertyIterator struct {
 	activitystreamsObjectMember                vocab.ActivityStreamsObject
 	activitystreamsLinkMember                  vocab.ActivityStreamsLink
@@ -29,10 +33,12 @@ type ActivityStreamsAttachmentPropertyIterator struct {
 	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	activitystreamsEmojiMember                 vocab.ActivityStreamsEmoji
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
 	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	activitystreamsHashtagMember               vocab.ActivityStreamsHashtag
 	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
 	activitystreamsImageMember                 vocab.ActivityStreamsImage
 	activitystreamsIntransitiveActivityMember  vocab.ActivityStreamsIntransitiveActivity
@@ -52,6 +58,7 @@ type ActivityStreamsAttachmentPropertyIterator struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	activitystreamsPropertyValueMember         vocab.ActivityStreamsPropertyValue
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
 	activitystreamsRejectMember                vocab.ActivityStreamsReject
@@ -67,6 +74,9 @@ type ActivityStreamsAttachmentPropertyIterator struct {
 	activitystreamsVideoMember                 vocab.ActivityStreamsVideo
 	activitystreamsViewMember                  vocab.ActivityStreamsView
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 	myIdx                                      int
@@ -99,331 +109,13 @@ func deserializeActivityStreamsAttachmentPropertyIterator(i interface{}, aliasMa
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
-		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsObjectMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsLinkMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsAcceptMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsActivityMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsAddMember: v,
-				alias:                    alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsAnnounceMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsApplicationMember: v,
-				alias:                            alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsArriveMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsArticleMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsAudioMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsBlockMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsCollectionMember: v,
-				alias:                           alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsCollectionPageMember: v,
-				alias:                               alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsCreateMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsDeleteMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsDislikeMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsDocumentMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsEventMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsFlagMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsFollowMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsGroupMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsIgnoreMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsImageMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsIntransitiveActivityMember: v,
-				alias: alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsInviteMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsJoinMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsLeaveMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsLikeMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsListenMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsMentionMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsMoveMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsNoteMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsOfferMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsOrderedCollectionMember: v,
-				alias:                                  alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsOrderedCollectionPageMember: v,
-				alias: alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsOrganizationMember: v,
-				alias:                             alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsPageMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsPersonMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsPlaceMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsProfileMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsQuestionMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsReadMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsRejectMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsRelationshipMember: v,
-				alias:                             alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsRemoveMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsServiceMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsTentativeAcceptMember: v,
-				alias:                                alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsTentativeRejectMember: v,
-				alias:                                alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsTombstoneMember: v,
-				alias:                          alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsTravelMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsUndoMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsUpdateMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsVideoMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttachmentPropertyIterator{
-				activitystreamsViewMember: v,
-				alias:                     alias,
-			}
-			return this, nil
+		// Defer deserializing the value until it is first accessed.
+		this := &ActivityStreamsAttachmentPropertyIterator{
+			alias:       alias,
+			raw:         m,
+			rawAliasMap: aliasMap,
 		}
+		return this, nil
 	}
 	this := &ActivityStreamsAttachmentPropertyIterator{
 		alias:   alias,
@@ -435,390 +127,468 @@ func deserializeActivityStreamsAttachmentPropertyIterator(i interface{}, aliasMa
 // GetActivityStreamsAccept returns the value of this property. When
 // IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+	this.resolve()
 	return this.activitystreamsAcceptMember
 }
 
 // GetActivityStreamsActivity returns the value of this property. When
 // IsActivityStreamsActivity returns false, GetActivityStreamsActivity will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+	this.resolve()
 	return this.activitystreamsActivityMember
 }
 
 // GetActivityStreamsAdd returns the value of this property. When
 // IsActivityStreamsAdd returns false, GetActivityStreamsAdd will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+	this.resolve()
 	return this.activitystreamsAddMember
 }
 
 // GetActivityStreamsAnnounce returns the value of this property. When
 // IsActivityStreamsAnnounce returns false, GetActivityStreamsAnnounce will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+	this.resolve()
 	return this.activitystreamsAnnounceMember
 }
 
 // GetActivityStreamsApplication returns the value of this property. When
 // IsActivityStreamsApplication returns false, GetActivityStreamsApplication
 // will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+	this.resolve()
 	return this.activitystreamsApplicationMember
 }
 
 // GetActivityStreamsArrive returns the value of this property. When
 // IsActivityStreamsArrive returns false, GetActivityStreamsArrive will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+	this.resolve()
 	return this.activitystreamsArriveMember
 }
 
 // GetActivityStreamsArticle returns the value of this property. When
 // IsActivityStreamsArticle returns false, GetActivityStreamsArticle will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+	this.resolve()
 	return this.activitystreamsArticleMember
 }
 
 // GetActivityStreamsAudio returns the value of this property. When
 // IsActivityStreamsAudio returns false, GetActivityStreamsAudio will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+	this.resolve()
 	return this.activitystreamsAudioMember
 }
 
 // GetActivityStreamsBlock returns the value of this property. When
 // IsActivityStreamsBlock returns false, GetActivityStreamsBlock will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+	this.resolve()
 	return this.activitystreamsBlockMember
 }
 
 // GetActivityStreamsCollection returns the value of this property. When
 // IsActivityStreamsCollection returns false, GetActivityStreamsCollection
 // will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	this.resolve()
 	return this.activitystreamsCollectionMember
 }
 
 // GetActivityStreamsCollectionPage returns the value of this property. When
 // IsActivityStreamsCollectionPage returns false,
 // GetActivityStreamsCollectionPage will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember
 }
 
 // GetActivityStreamsCreate returns the value of this property. When
 // IsActivityStreamsCreate returns false, GetActivityStreamsCreate will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+	this.resolve()
 	return this.activitystreamsCreateMember
 }
 
 // GetActivityStreamsDelete returns the value of this property. When
 // IsActivityStreamsDelete returns false, GetActivityStreamsDelete will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+	this.resolve()
 	return this.activitystreamsDeleteMember
 }
 
 // GetActivityStreamsDislike returns the value of this property. When
 // IsActivityStreamsDislike returns false, GetActivityStreamsDislike will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+	this.resolve()
 	return this.activitystreamsDislikeMember
 }
 
 // GetActivityStreamsDocument returns the value of this property. When
 // IsActivityStreamsDocument returns false, GetActivityStreamsDocument will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+	this.resolve()
 	return this.activitystreamsDocumentMember
 }
 
+// GetActivityStreamsEmoji returns the value of this property. When
+// IsActivityStreamsEmoji returns false, GetActivityStreamsEmoji will return
+// an arbitrary value.
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsEmoji() vocab.ActivityStreamsEmoji {
+	this.resolve()
+	return this.activitystreamsEmojiMember
+}
+
 // GetActivityStreamsEvent returns the value of this property. When
 // IsActivityStreamsEvent returns false, GetActivityStreamsEvent will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+	this.resolve()
 	return this.activitystreamsEventMember
 }
 
 // GetActivityStreamsFlag returns the value of this property. When
 // IsActivityStreamsFlag returns false, GetActivityStreamsFlag will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+	this.resolve()
 	return this.activitystreamsFlagMember
 }
 
 // GetActivityStreamsFollow returns the value of this property. When
 // IsActivityStreamsFollow returns false, GetActivityStreamsFollow will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+	this.resolve()
 	return this.activitystreamsFollowMember
 }
 
 // GetActivityStreamsGroup returns the value of this property. When
 // IsActivityStreamsGroup returns false, GetActivityStreamsGroup will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+	this.resolve()
 	return this.activitystreamsGroupMember
 }
 
+// GetActivityStreamsHashtag returns the value of this property. When
+// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag will
+// return an arbitrary value.
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	this.resolve()
+	return this.activitystreamsHashtagMember
+}
+
 // GetActivityStreamsIgnore returns the value of this property. When
 // IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+	this.resolve()
 	return this.activitystreamsIgnoreMember
 }
 
 // GetActivityStreamsImage returns the value of this property. When
 // IsActivityStreamsImage returns false, GetActivityStreamsImage will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+	this.resolve()
 	return this.activitystreamsImageMember
 }
 
 // GetActivityStreamsIntransitiveActivity returns the value of this property. When
 // IsActivityStreamsIntransitiveActivity returns false,
 // GetActivityStreamsIntransitiveActivity will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+	this.resolve()
 	return this.activitystreamsIntransitiveActivityMember
 }
 
 // GetActivityStreamsInvite returns the value of this property. When
 // IsActivityStreamsInvite returns false, GetActivityStreamsInvite will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+	this.resolve()
 	return this.activitystreamsInviteMember
 }
 
 // GetActivityStreamsJoin returns the value of this property. When
 // IsActivityStreamsJoin returns false, GetActivityStreamsJoin will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+	this.resolve()
 	return this.activitystreamsJoinMember
 }
 
 // GetActivityStreamsLeave returns the value of this property. When
 // IsActivityStreamsLeave returns false, GetActivityStreamsLeave will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+	this.resolve()
 	return this.activitystreamsLeaveMember
 }
 
 // GetActivityStreamsLike returns the value of this property. When
 // IsActivityStreamsLike returns false, GetActivityStreamsLike will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+	this.resolve()
 	return this.activitystreamsLikeMember
 }
 
 // GetActivityStreamsLink returns the value of this property. When
 // IsActivityStreamsLink returns false, GetActivityStreamsLink will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+	this.resolve()
 	return this.activitystreamsLinkMember
 }
 
 // GetActivityStreamsListen returns the value of this property. When
 // IsActivityStreamsListen returns false, GetActivityStreamsListen will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+	this.resolve()
 	return this.activitystreamsListenMember
 }
 
 // GetActivityStreamsMention returns the value of this property. When
 // IsActivityStreamsMention returns false, GetActivityStreamsMention will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+	this.resolve()
 	return this.activitystreamsMentionMember
 }
 
 // GetActivityStreamsMove returns the value of this property. When
 // IsActivityStreamsMove returns false, GetActivityStreamsMove will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+	this.resolve()
 	return this.activitystreamsMoveMember
 }
 
 // GetActivityStreamsNote returns the value of this property. When
 // IsActivityStreamsNote returns false, GetActivityStreamsNote will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+	this.resolve()
 	return this.activitystreamsNoteMember
 }
 
 // GetActivityStreamsObject returns the value of this property. When
 // IsActivityStreamsObject returns false, GetActivityStreamsObject will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+	this.resolve()
 	return this.activitystreamsObjectMember
 }
 
 // GetActivityStreamsOffer returns the value of this property. When
 // IsActivityStreamsOffer returns false, GetActivityStreamsOffer will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+	this.resolve()
 	return this.activitystreamsOfferMember
 }
 
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetActivityStreamsOrganization returns the value of this property. When
 // IsActivityStreamsOrganization returns false, GetActivityStreamsOrganization
 // will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+	this.resolve()
 	return this.activitystreamsOrganizationMember
 }
 
 // GetActivityStreamsPage returns the value of this property. When
 // IsActivityStreamsPage returns false, GetActivityStreamsPage will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+	this.resolve()
 	return this.activitystreamsPageMember
 }
 
 // GetActivityStreamsPerson returns the value of this property. When
 // IsActivityStreamsPerson returns false, GetActivityStreamsPerson will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+	this.resolve()
 	return this.activitystreamsPersonMember
 }
 
 // GetActivityStreamsPlace returns the value of this property. When
 // IsActivityStreamsPlace returns false, GetActivityStreamsPlace will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+	this.resolve()
 	return this.activitystreamsPlaceMember
 }
 
 // GetActivityStreamsProfile returns the value of this property. When
 // IsActivityStreamsProfile returns false, GetActivityStreamsProfile will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+	this.resolve()
 	return this.activitystreamsProfileMember
 }
 
+// GetActivityStreamsPropertyValue returns the value of this property. When
+// IsActivityStreamsPropertyValue returns false,
+// GetActivityStreamsPropertyValue will return an arbitrary value.
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsPropertyValue() vocab.ActivityStreamsPropertyValue {
+	this.resolve()
+	return this.activitystreamsPropertyValueMember
+}
+
 // GetActivityStreamsQuestion returns the value of this property. When
 // IsActivityStreamsQuestion returns false, GetActivityStreamsQuestion will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+	this.resolve()
 	return this.activitystreamsQuestionMember
 }
 
 // GetActivityStreamsRead returns the value of this property. When
 // IsActivityStreamsRead returns false, GetActivityStreamsRead will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+	this.resolve()
 	return this.activitystreamsReadMember
 }
 
 // GetActivityStreamsReject returns the value of this property. When
 // IsActivityStreamsReject returns false, GetActivityStreamsReject will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+	this.resolve()
 	return this.activitystreamsRejectMember
 }
 
 // GetActivityStreamsRelationship returns the value of this property. When
 // IsActivityStreamsRelationship returns false, GetActivityStreamsRelationship
 // will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+	this.resolve()
 	return this.activitystreamsRelationshipMember
 }
 
 // GetActivityStreamsRemove returns the value of this property. When
 // IsActivityStreamsRemove returns false, GetActivityStreamsRemove will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+	this.resolve()
 	return this.activitystreamsRemoveMember
 }
 
 // GetActivityStreamsService returns the value of this property. When
 // IsActivityStreamsService returns false, GetActivityStreamsService will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsService() vocab.ActivityStreamsService {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsService() vocab.ActivityStreamsService {
+	this.resolve()
 	return this.activitystreamsServiceMember
 }
 
 // GetActivityStreamsTentativeAccept returns the value of this property. When
 // IsActivityStreamsTentativeAccept returns false,
 // GetActivityStreamsTentativeAccept will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+	this.resolve()
 	return this.activitystreamsTentativeAcceptMember
 }
 
 // GetActivityStreamsTentativeReject returns the value of this property. When
 // IsActivityStreamsTentativeReject returns false,
 // GetActivityStreamsTentativeReject will return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+	this.resolve()
 	return this.activitystreamsTentativeRejectMember
 }
 
 // GetActivityStreamsTombstone returns the value of this property. When
 // IsActivityStreamsTombstone returns false, GetActivityStreamsTombstone will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+	this.resolve()
 	return this.activitystreamsTombstoneMember
 }
 
 // GetActivityStreamsTravel returns the value of this property. When
 // IsActivityStreamsTravel returns false, GetActivityStreamsTravel will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+	this.resolve()
 	return this.activitystreamsTravelMember
 }
 
 // GetActivityStreamsUndo returns the value of this property. When
 // IsActivityStreamsUndo returns false, GetActivityStreamsUndo will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+	this.resolve()
 	return this.activitystreamsUndoMember
 }
 
 // GetActivityStreamsUpdate returns the value of this property. When
 // IsActivityStreamsUpdate returns false, GetActivityStreamsUpdate will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+	this.resolve()
 	return this.activitystreamsUpdateMember
 }
 
 // GetActivityStreamsVideo returns the value of this property. When
 // IsActivityStreamsVideo returns false, GetActivityStreamsVideo will return
 // an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+	this.resolve()
 	return this.activitystreamsVideoMember
 }
 
 // GetActivityStreamsView returns the value of this property. When
 // IsActivityStreamsView returns false, GetActivityStreamsView will return an
 // arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsView() vocab.ActivityStreamsView {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetActivityStreamsView() vocab.ActivityStreamsView {
+	this.resolve()
 	return this.activitystreamsViewMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetIRI() *url.URL {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsAttachmentPropertyIterator) GetType() vocab.Type {
+func (this *ActivityStreamsAttachmentPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsObject() {
 		return this.GetActivityStreamsObject()
 	}
@@ -870,6 +640,9 @@ func (this ActivityStreamsAttachmentPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument()
 	}
+	if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -882,6 +655,9 @@ func (this ActivityStreamsAttachmentPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup()
 	}
+	if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag()
+	}
 	if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore()
 	}
@@ -939,6 +715,9 @@ func (this ActivityStreamsAttachmentPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue()
+	}
 	if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion()
 	}
@@ -986,7 +765,7 @@ func (this ActivityStreamsAttachmentPropertyIterator) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsAttachmentPropertyIterator) HasAny() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) HasAny() bool {
 	return this.IsActivityStreamsObject() ||
 		this.IsActivityStreamsLink() ||
 		this.IsActivityStreamsAccept() ||
@@ -1004,10 +783,12 @@ func (this ActivityStreamsAttachmentPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsDelete() ||
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
+		this.IsActivityStreamsEmoji() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
 		this.IsActivityStreamsGroup() ||
+		this.IsActivityStreamsHashtag() ||
 		this.IsActivityStreamsIgnore() ||
 		this.IsActivityStreamsImage() ||
 		this.IsActivityStreamsIntransitiveActivity() ||
@@ -1027,6 +808,7 @@ func (this ActivityStreamsAttachmentPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsActivityStreamsPropertyValue() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
 		this.IsActivityStreamsReject() ||
@@ -1047,147 +829,184 @@ func (this ActivityStreamsAttachmentPropertyIterator) HasAny() bool {
 // IsActivityStreamsAccept returns true if this property has a type of "Accept".
 // When true, use the GetActivityStreamsAccept and SetActivityStreamsAccept
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAccept() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAccept() bool {
+	this.resolve()
 	return this.activitystreamsAcceptMember != nil
 }
 
 // IsActivityStreamsActivity returns true if this property has a type of
 // "Activity". When true, use the GetActivityStreamsActivity and
 // SetActivityStreamsActivity methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsActivity() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsActivity() bool {
+	this.resolve()
 	return this.activitystreamsActivityMember != nil
 }
 
 // IsActivityStreamsAdd returns true if this property has a type of "Add". When
 // true, use the GetActivityStreamsAdd and SetActivityStreamsAdd methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAdd() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAdd() bool {
+	this.resolve()
 	return this.activitystreamsAddMember != nil
 }
 
 // IsActivityStreamsAnnounce returns true if this property has a type of
 // "Announce". When true, use the GetActivityStreamsAnnounce and
 // SetActivityStreamsAnnounce methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAnnounce() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAnnounce() bool {
+	this.resolve()
 	return this.activitystreamsAnnounceMember != nil
 }
 
 // IsActivityStreamsApplication returns true if this property has a type of
 // "Application". When true, use the GetActivityStreamsApplication and
 // SetActivityStreamsApplication methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsApplication() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsApplication() bool {
+	this.resolve()
 	return this.activitystreamsApplicationMember != nil
 }
 
 // IsActivityStreamsArrive returns true if this property has a type of "Arrive".
 // When true, use the GetActivityStreamsArrive and SetActivityStreamsArrive
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsArrive() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsArrive() bool {
+	this.resolve()
 	return this.activitystreamsArriveMember != nil
 }
 
 // IsActivityStreamsArticle returns true if this property has a type of "Article".
 // When true, use the GetActivityStreamsArticle and SetActivityStreamsArticle
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsArticle() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsArticle() bool {
+	this.resolve()
 	return this.activitystreamsArticleMember != nil
 }
 
 // IsActivityStreamsAudio returns true if this property has a type of "Audio".
 // When true, use the GetActivityStreamsAudio and SetActivityStreamsAudio
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAudio() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsAudio() bool {
+	this.resolve()
 	return this.activitystreamsAudioMember != nil
 }
 
 // IsActivityStreamsBlock returns true if this property has a type of "Block".
 // When true, use the GetActivityStreamsBlock and SetActivityStreamsBlock
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsBlock() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsBlock() bool {
+	this.resolve()
 	return this.activitystreamsBlockMember != nil
 }
 
 // IsActivityStreamsCollection returns true if this property has a type of
 // "Collection". When true, use the GetActivityStreamsCollection and
 // SetActivityStreamsCollection methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsCollection() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsCollection() bool {
+	this.resolve()
 	return this.activitystreamsCollectionMember != nil
 }
 
 // IsActivityStreamsCollectionPage returns true if this property has a type of
 // "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
 // SetActivityStreamsCollectionPage methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsCollectionPage() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember != nil
 }
 
 // IsActivityStreamsCreate returns true if this property has a type of "Create".
 // When true, use the GetActivityStreamsCreate and SetActivityStreamsCreate
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsCreate() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsCreate() bool {
+	this.resolve()
 	return this.activitystreamsCreateMember != nil
 }
 
 // IsActivityStreamsDelete returns true if this property has a type of "Delete".
 // When true, use the GetActivityStreamsDelete and SetActivityStreamsDelete
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsDelete() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsDelete() bool {
+	this.resolve()
 	return this.activitystreamsDeleteMember != nil
 }
 
 // IsActivityStreamsDislike returns true if this property has a type of "Dislike".
 // When true, use the GetActivityStreamsDislike and SetActivityStreamsDislike
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsDislike() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsDislike() bool {
+	this.resolve()
 	return this.activitystreamsDislikeMember != nil
 }
 
 // IsActivityStreamsDocument returns true if this property has a type of
 // "Document". When true, use the GetActivityStreamsDocument and
 // SetActivityStreamsDocument methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsDocument() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsDocument() bool {
+	this.resolve()
 	return this.activitystreamsDocumentMember != nil
 }
 
+// IsActivityStreamsEmoji returns true if this property has a type of "Emoji".
+// When true, use the GetActivityStreamsEmoji and SetActivityStreamsEmoji
+// methods to access and set this property.
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsEmoji() bool {
+	this.resolve()
+	return this.activitystreamsEmojiMember != nil
+}
+
 // IsActivityStreamsEvent returns true if this property has a type of "Event".
 // When true, use the GetActivityStreamsEvent and SetActivityStreamsEvent
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsEvent() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsEvent() bool {
+	this.resolve()
 	return this.activitystreamsEventMember != nil
 }
 
 // IsActivityStreamsFlag returns true if this property has a type of "Flag". When
 // true, use the GetActivityStreamsFlag and SetActivityStreamsFlag methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsFlag() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsFlag() bool {
+	this.resolve()
 	return this.activitystreamsFlagMember != nil
 }
 
 // IsActivityStreamsFollow returns true if this property has a type of "Follow".
 // When true, use the GetActivityStreamsFollow and SetActivityStreamsFollow
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsFollow() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsFollow() bool {
+	this.resolve()
 	return this.activitystreamsFollowMember != nil
 }
 
 // IsActivityStreamsGroup returns true if this property has a type of "Group".
 // When true, use the GetActivityStreamsGroup and SetActivityStreamsGroup
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsGroup() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsGroup() bool {
+	this.resolve()
 	return this.activitystreamsGroupMember != nil
 }
 
+// IsActivityStreamsHashtag returns true if this property has a type of "Hashtag".
+// When true, use the GetActivityStreamsHashtag and SetActivityStreamsHashtag
+// methods to access and set this property.
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsHashtag() bool {
+	this.resolve()
+	return this.activitystreamsHashtagMember != nil
+}
+
 // IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
 // When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsIgnore() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsIgnore() bool {
+	this.resolve()
 	return this.activitystreamsIgnoreMember != nil
 }
 
 // IsActivityStreamsImage returns true if this property has a type of "Image".
 // When true, use the GetActivityStreamsImage and SetActivityStreamsImage
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsImage() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsImage() bool {
+	this.resolve()
 	return this.activitystreamsImageMember != nil
 }
 
@@ -1196,84 +1015,96 @@ func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsImage() b
 // GetActivityStreamsIntransitiveActivity and
 // SetActivityStreamsIntransitiveActivity methods to access and set this
 // property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsIntransitiveActivity() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsIntransitiveActivity() bool {
+	this.resolve()
 	return this.activitystreamsIntransitiveActivityMember != nil
 }
 
 // IsActivityStreamsInvite returns true if this property has a type of "Invite".
 // When true, use the GetActivityStreamsInvite and SetActivityStreamsInvite
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsInvite() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsInvite() bool {
+	this.resolve()
 	return this.activitystreamsInviteMember != nil
 }
 
 // IsActivityStreamsJoin returns true if this property has a type of "Join". When
 // true, use the GetActivityStreamsJoin and SetActivityStreamsJoin methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsJoin() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsJoin() bool {
+	this.resolve()
 	return this.activitystreamsJoinMember != nil
 }
 
 // IsActivityStreamsLeave returns true if this property has a type of "Leave".
 // When true, use the GetActivityStreamsLeave and SetActivityStreamsLeave
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsLeave() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsLeave() bool {
+	this.resolve()
 	return this.activitystreamsLeaveMember != nil
 }
 
 // IsActivityStreamsLike returns true if this property has a type of "Like". When
 // true, use the GetActivityStreamsLike and SetActivityStreamsLike methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsLike() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsLike() bool {
+	this.resolve()
 	return this.activitystreamsLikeMember != nil
 }
 
 // IsActivityStreamsLink returns true if this property has a type of "Link". When
 // true, use the GetActivityStreamsLink and SetActivityStreamsLink methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsLink() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsLink() bool {
+	this.resolve()
 	return this.activitystreamsLinkMember != nil
 }
 
 // IsActivityStreamsListen returns true if this property has a type of "Listen".
 // When true, use the GetActivityStreamsListen and SetActivityStreamsListen
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsListen() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsListen() bool {
+	this.resolve()
 	return this.activitystreamsListenMember != nil
 }
 
 // IsActivityStreamsMention returns true if this property has a type of "Mention".
 // When true, use the GetActivityStreamsMention and SetActivityStreamsMention
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsMention() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsMention() bool {
+	this.resolve()
 	return this.activitystreamsMentionMember != nil
 }
 
 // IsActivityStreamsMove returns true if this property has a type of "Move". When
 // true, use the GetActivityStreamsMove and SetActivityStreamsMove methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsMove() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsMove() bool {
+	this.resolve()
 	return this.activitystreamsMoveMember != nil
 }
 
 // IsActivityStreamsNote returns true if this property has a type of "Note". When
 // true, use the GetActivityStreamsNote and SetActivityStreamsNote methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsNote() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsNote() bool {
+	this.resolve()
 	return this.activitystreamsNoteMember != nil
 }
 
 // IsActivityStreamsObject returns true if this property has a type of "Object".
 // When true, use the GetActivityStreamsObject and SetActivityStreamsObject
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsObject() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsObject() bool {
+	this.resolve()
 	return this.activitystreamsObjectMember != nil
 }
 
 // IsActivityStreamsOffer returns true if this property has a type of "Offer".
 // When true, use the GetActivityStreamsOffer and SetActivityStreamsOffer
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOffer() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOffer() bool {
+	this.resolve()
 	return this.activitystreamsOfferMember != nil
 }
 
@@ -1281,7 +1112,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOffer() b
 // "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
 // and SetActivityStreamsOrderedCollection methods to access and set this
 // property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrderedCollection() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrderedCollection() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember != nil
 }
 
@@ -1290,153 +1122,181 @@ func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrderedCo
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsActivityStreamsOrganization returns true if this property has a type of
 // "Organization". When true, use the GetActivityStreamsOrganization and
 // SetActivityStreamsOrganization methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrganization() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsOrganization() bool {
+	this.resolve()
 	return this.activitystreamsOrganizationMember != nil
 }
 
 // IsActivityStreamsPage returns true if this property has a type of "Page". When
 // true, use the GetActivityStreamsPage and SetActivityStreamsPage methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPage() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPage() bool {
+	this.resolve()
 	return this.activitystreamsPageMember != nil
 }
 
 // IsActivityStreamsPerson returns true if this property has a type of "Person".
 // When true, use the GetActivityStreamsPerson and SetActivityStreamsPerson
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPerson() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPerson() bool {
+	this.resolve()
 	return this.activitystreamsPersonMember != nil
 }
 
 // IsActivityStreamsPlace returns true if this property has a type of "Place".
 // When true, use the GetActivityStreamsPlace and SetActivityStreamsPlace
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPlace() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPlace() bool {
+	this.resolve()
 	return this.activitystreamsPlaceMember != nil
 }
 
 // IsActivityStreamsProfile returns true if this property has a type of "Profile".
 // When true, use the GetActivityStreamsProfile and SetActivityStreamsProfile
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsProfile() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsProfile() bool {
+	this.resolve()
 	return this.activitystreamsProfileMember != nil
 }
 
+// IsActivityStreamsPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetActivityStreamsPropertyValue and
+// SetActivityStreamsPropertyValue methods to access and set this property.
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsPropertyValue() bool {
+	this.resolve()
+	return this.activitystreamsPropertyValueMember != nil
+}
+
 // IsActivityStreamsQuestion returns true if this property has a type of
 // "Question". When true, use the GetActivityStreamsQuestion and
 // SetActivityStreamsQuestion methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsQuestion() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsQuestion() bool {
+	this.resolve()
 	return this.activitystreamsQuestionMember != nil
 }
 
 // IsActivityStreamsRead returns true if this property has a type of "Read". When
 // true, use the GetActivityStreamsRead and SetActivityStreamsRead methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsRead() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsRead() bool {
+	this.resolve()
 	return this.activitystreamsReadMember != nil
 }
 
 // IsActivityStreamsReject returns true if this property has a type of "Reject".
 // When true, use the GetActivityStreamsReject and SetActivityStreamsReject
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsReject() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsReject() bool {
+	this.resolve()
 	return this.activitystreamsRejectMember != nil
 }
 
 // IsActivityStreamsRelationship returns true if this property has a type of
 // "Relationship". When true, use the GetActivityStreamsRelationship and
 // SetActivityStreamsRelationship methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsRelationship() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsRelationship() bool {
+	this.resolve()
 	return this.activitystreamsRelationshipMember != nil
 }
 
 // IsActivityStreamsRemove returns true if this property has a type of "Remove".
 // When true, use the GetActivityStreamsRemove and SetActivityStreamsRemove
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsRemove() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsRemove() bool {
+	this.resolve()
 	return this.activitystreamsRemoveMember != nil
 }
 
 // IsActivityStreamsService returns true if this property has a type of "Service".
 // When true, use the GetActivityStreamsService and SetActivityStreamsService
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsService() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsService() bool {
+	this.resolve()
 	return this.activitystreamsServiceMember != nil
 }
 
 // IsActivityStreamsTentativeAccept returns true if this property has a type of
 // "TentativeAccept". When true, use the GetActivityStreamsTentativeAccept and
 // SetActivityStreamsTentativeAccept methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTentativeAccept() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTentativeAccept() bool {
+	this.resolve()
 	return this.activitystreamsTentativeAcceptMember != nil
 }
 
 // IsActivityStreamsTentativeReject returns true if this property has a type of
 // "TentativeReject". When true, use the GetActivityStreamsTentativeReject and
 // SetActivityStreamsTentativeReject methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTentativeReject() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTentativeReject() bool {
+	this.resolve()
 	return this.activitystreamsTentativeRejectMember != nil
 }
 
 // IsActivityStreamsTombstone returns true if this property has a type of
 // "Tombstone". When true, use the GetActivityStreamsTombstone and
 // SetActivityStreamsTombstone methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTombstone() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTombstone() bool {
+	this.resolve()
 	return this.activitystreamsTombstoneMember != nil
 }
 
 // IsActivityStreamsTravel returns true if this property has a type of "Travel".
 // When true, use the GetActivityStreamsTravel and SetActivityStreamsTravel
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTravel() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsTravel() bool {
+	this.resolve()
 	return this.activitystreamsTravelMember != nil
 }
 
 // IsActivityStreamsUndo returns true if this property has a type of "Undo". When
 // true, use the GetActivityStreamsUndo and SetActivityStreamsUndo methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsUndo() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsUndo() bool {
+	this.resolve()
 	return this.activitystreamsUndoMember != nil
 }
 
 // IsActivityStreamsUpdate returns true if this property has a type of "Update".
 // When true, use the GetActivityStreamsUpdate and SetActivityStreamsUpdate
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsUpdate() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsUpdate() bool {
+	this.resolve()
 	return this.activitystreamsUpdateMember != nil
 }
 
 // IsActivityStreamsVideo returns true if this property has a type of "Video".
 // When true, use the GetActivityStreamsVideo and SetActivityStreamsVideo
 // methods to access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsVideo() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsVideo() bool {
+	this.resolve()
 	return this.activitystreamsVideoMember != nil
 }
 
 // IsActivityStreamsView returns true if this property has a type of "View". When
 // true, use the GetActivityStreamsView and SetActivityStreamsView methods to
 // access and set this property.
-func (this ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsView() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsActivityStreamsView() bool {
+	this.resolve()
 	return this.activitystreamsViewMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsAttachmentPropertyIterator) IsIRI() bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsAttachmentPropertyIterator) JSONLDContext() map[string]string {
+func (this *ActivityStreamsAttachmentPropertyIterator) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsObject() {
@@ -1473,6 +1333,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) JSONLDContext() map[string
 		child = this.GetActivityStreamsDislike().JSONLDContext()
 	} else if this.IsActivityStreamsDocument() {
 		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsActivityStreamsEmoji() {
+		child = this.GetActivityStreamsEmoji().JSONLDContext()
 	} else if this.IsActivityStreamsEvent() {
 		child = this.GetActivityStreamsEvent().JSONLDContext()
 	} else if this.IsActivityStreamsFlag() {
@@ -1481,6 +1343,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) JSONLDContext() map[string
 		child = this.GetActivityStreamsFollow().JSONLDContext()
 	} else if this.IsActivityStreamsGroup() {
 		child = this.GetActivityStreamsGroup().JSONLDContext()
+	} else if this.IsActivityStreamsHashtag() {
+		child = this.GetActivityStreamsHashtag().JSONLDContext()
 	} else if this.IsActivityStreamsIgnore() {
 		child = this.GetActivityStreamsIgnore().JSONLDContext()
 	} else if this.IsActivityStreamsImage() {
@@ -1519,6 +1383,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) JSONLDContext() map[string
 		child = this.GetActivityStreamsPlace().JSONLDContext()
 	} else if this.IsActivityStreamsProfile() {
 		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsActivityStreamsPropertyValue() {
+		child = this.GetActivityStreamsPropertyValue().JSONLDContext()
 	} else if this.IsActivityStreamsQuestion() {
 		child = this.GetActivityStreamsQuestion().JSONLDContext()
 	} else if this.IsActivityStreamsRead() {
@@ -1562,7 +1428,7 @@ func (this ActivityStreamsAttachmentPropertyIterator) JSONLDContext() map[string
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsAttachmentPropertyIterator) KindIndex() int {
+func (this *ActivityStreamsAttachmentPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsObject() {
 		return 0
 	}
@@ -1614,117 +1480,126 @@ func (this ActivityStreamsAttachmentPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsDocument() {
 		return 16
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsActivityStreamsEmoji() {
 		return 17
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsEvent() {
 		return 18
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsFlag() {
 		return 19
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFollow() {
 		return 20
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsActivityStreamsGroup() {
 		return 21
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsActivityStreamsHashtag() {
 		return 22
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsIgnore() {
 		return 23
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsImage() {
 		return 24
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 25
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsInvite() {
 		return 26
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsJoin() {
 		return 27
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsLeave() {
 		return 28
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsLike() {
 		return 29
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsListen() {
 		return 30
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsMention() {
 		return 31
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsMove() {
 		return 32
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsNote() {
 		return 33
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsOffer() {
 		return 34
 	}
-	if this.IsActivityStreamsOrganization() {
+	if this.IsActivityStreamsOrderedCollection() {
 		return 35
 	}
-	if this.IsActivityStreamsPage() {
+	if this.IsActivityStreamsOrderedCollectionPage() {
 		return 36
 	}
-	if this.IsActivityStreamsPerson() {
+	if this.IsActivityStreamsOrganization() {
 		return 37
 	}
-	if this.IsActivityStreamsPlace() {
+	if this.IsActivityStreamsPage() {
 		return 38
 	}
-	if this.IsActivityStreamsProfile() {
+	if this.IsActivityStreamsPerson() {
 		return 39
 	}
-	if this.IsActivityStreamsQuestion() {
+	if this.IsActivityStreamsPlace() {
 		return 40
 	}
-	if this.IsActivityStreamsRead() {
+	if this.IsActivityStreamsProfile() {
 		return 41
 	}
-	if this.IsActivityStreamsReject() {
+	if this.IsActivityStreamsPropertyValue() {
 		return 42
 	}
-	if this.IsActivityStreamsRelationship() {
+	if this.IsActivityStreamsQuestion() {
 		return 43
 	}
-	if this.IsActivityStreamsRemove() {
+	if this.IsActivityStreamsRead() {
 		return 44
 	}
-	if this.IsActivityStreamsService() {
+	if this.IsActivityStreamsReject() {
 		return 45
 	}
-	if this.IsActivityStreamsTentativeAccept() {
+	if this.IsActivityStreamsRelationship() {
 		return 46
 	}
-	if this.IsActivityStreamsTentativeReject() {
+	if this.IsActivityStreamsRemove() {
 		return 47
 	}
-	if this.IsActivityStreamsTombstone() {
+	if this.IsActivityStreamsService() {
 		return 48
 	}
-	if this.IsActivityStreamsTravel() {
+	if this.IsActivityStreamsTentativeAccept() {
 		return 49
 	}
-	if this.IsActivityStreamsUndo() {
+	if this.IsActivityStreamsTentativeReject() {
 		return 50
 	}
-	if this.IsActivityStreamsUpdate() {
+	if this.IsActivityStreamsTombstone() {
 		return 51
 	}
-	if this.IsActivityStreamsVideo() {
+	if this.IsActivityStreamsTravel() {
 		return 52
 	}
-	if this.IsActivityStreamsView() {
+	if this.IsActivityStreamsUndo() {
 		return 53
 	}
+	if this.IsActivityStreamsUpdate() {
+		return 54
+	}
+	if this.IsActivityStreamsVideo() {
+		return 55
+	}
+	if this.IsActivityStreamsView() {
+		return 56
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -1735,7 +1610,7 @@ func (this ActivityStreamsAttachmentPropertyIterator) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsAttachmentPropertyIterator) LessThan(o vocab.ActivityStreamsAttachmentPropertyIterator) bool {
+func (this *ActivityStreamsAttachmentPropertyIterator) LessThan(o vocab.ActivityStreamsAttachmentPropertyIterator) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -1776,6 +1651,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) LessThan(o vocab.ActivityS
 		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji().LessThan(o.GetActivityStreamsEmoji())
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
 	} else if this.IsActivityStreamsFlag() {
@@ -1784,6 +1661,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) LessThan(o vocab.ActivityS
 		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().LessThan(o.GetActivityStreamsHashtag())
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
 	} else if this.IsActivityStreamsImage() {
@@ -1822,6 +1701,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) LessThan(o vocab.ActivityS
 		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue().LessThan(o.GetActivityStreamsPropertyValue())
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
 	} else if this.IsActivityStreamsRead() {
@@ -1857,12 +1738,12 @@ func (this ActivityStreamsAttachmentPropertyIterator) LessThan(o vocab.ActivityS
 }
 
 // Name returns the name of this property: "ActivityStreamsAttachment".
-func (this ActivityStreamsAttachmentPropertyIterator) Name() string {
+func (this *ActivityStreamsAttachmentPropertyIterator) Name() string {
 	return "ActivityStreamsAttachment"
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
-func (this ActivityStreamsAttachmentPropertyIterator) Next() vocab.ActivityStreamsAttachmentPropertyIterator {
+func (this *ActivityStreamsAttachmentPropertyIterator) Next() vocab.ActivityStreamsAttachmentPropertyIterator {
 	if this.myIdx+1 >= this.parent.Len() {
 		return nil
 	} else {
@@ -1871,7 +1752,7 @@ func (this ActivityStreamsAttachmentPropertyIterator) Next() vocab.ActivityStrea
 }
 
 // Prev returns the previous iterator, or nil if there is no previous iterator.
-func (this ActivityStreamsAttachmentPropertyIterator) Prev() vocab.ActivityStreamsAttachmentPropertyIterator {
+func (this *ActivityStreamsAttachmentPropertyIterator) Prev() vocab.ActivityStreamsAttachmentPropertyIterator {
 	if this.myIdx-1 < 0 {
 		return nil
 	} else {
@@ -1984,6 +1865,13 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsDocumen
 	this.activitystreamsDocumentMember = v
 }
 
+// SetActivityStreamsEmoji sets the value of this property. Calling
+// IsActivityStreamsEmoji afterwards returns true.
+func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.clear()
+	this.activitystreamsEmojiMember = v
+}
+
 // SetActivityStreamsEvent sets the value of this property. Calling
 // IsActivityStreamsEvent afterwards returns true.
 func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
@@ -2012,6 +1900,13 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsGroup(v
 	this.activitystreamsGroupMember = v
 }
 
+// SetActivityStreamsHashtag sets the value of this property. Calling
+// IsActivityStreamsHashtag afterwards returns true.
+func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.clear()
+	this.activitystreamsHashtagMember = v
+}
+
 // SetActivityStreamsIgnore sets the value of this property. Calling
 // IsActivityStreamsIgnore afterwards returns true.
 func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -2159,6 +2054,13 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsProfile
 	this.activitystreamsProfileMember = v
 }
 
+// SetActivityStreamsPropertyValue sets the value of this property. Calling
+// IsActivityStreamsPropertyValue afterwards returns true.
+func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.clear()
+	this.activitystreamsPropertyValueMember = v
+}
+
 // SetActivityStreamsQuestion sets the value of this property. Calling
 // IsActivityStreamsQuestion afterwards returns true.
 func (this *ActivityStreamsAttachmentPropertyIterator) SetActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
@@ -2334,6 +2236,10 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetType(t vocab.Type) err
 		this.SetActivityStreamsDocument(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsEmoji); ok {
+		this.SetActivityStreamsEmoji(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2350,6 +2256,10 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetType(t vocab.Type) err
 		this.SetActivityStreamsGroup(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsHashtag); ok {
+		this.SetActivityStreamsHashtag(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsIgnore); ok {
 		this.SetActivityStreamsIgnore(v)
 		return nil
@@ -2426,6 +2336,10 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetType(t vocab.Type) err
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsPropertyValue); ok {
+		this.SetActivityStreamsPropertyValue(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
 		this.SetActivityStreamsQuestion(v)
 		return nil
@@ -2486,6 +2400,33 @@ func (this *ActivityStreamsAttachmentPropertyIterator) SetType(t vocab.Type) err
 	return fmt.Errorf("illegal type to set on ActivityStreamsAttachment property: %T", t)
 }
 
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsAttachmentPropertyIterator) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
 // clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ActivityStreamsAttachmentPropertyIterator) clear() {
@@ -2506,10 +2447,12 @@ func (this *ActivityStreamsAttachmentPropertyIterator) clear() {
 	this.activitystreamsDeleteMember = nil
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
+	this.activitystreamsEmojiMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
 	this.activitystreamsGroupMember = nil
+	this.activitystreamsHashtagMember = nil
 	this.activitystreamsIgnoreMember = nil
 	this.activitystreamsImageMember = nil
 	this.activitystreamsIntransitiveActivityMember = nil
@@ -2529,6 +2472,7 @@ func (this *ActivityStreamsAttachmentPropertyIterator) clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.activitystreamsPropertyValueMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
 	this.activitystreamsRejectMember = nil
@@ -2545,13 +2489,145 @@ func (this *ActivityStreamsAttachmentPropertyIterator) clear() {
 	this.activitystreamsViewMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsAttachmentPropertyIterator) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsObjectMember = v
+		} else if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLinkMember = v
+		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAcceptMember = v
+		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsActivityMember = v
+		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAddMember = v
+		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAnnounceMember = v
+		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsApplicationMember = v
+		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsArriveMember = v
+		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsArticleMember = v
+		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAudioMember = v
+		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsBlockMember = v
+		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionPageMember = v
+		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCreateMember = v
+		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDeleteMember = v
+		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDislikeMember = v
+		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDocumentMember = v
+		} else if v, err := mgr.DeserializeEmojiActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsEmojiMember = v
+		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsEventMember = v
+		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsFlagMember = v
+		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsFollowMember = v
+		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsGroupMember = v
+		} else if v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsHashtagMember = v
+		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsIgnoreMember = v
+		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsImageMember = v
+		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsIntransitiveActivityMember = v
+		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsInviteMember = v
+		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsJoinMember = v
+		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLeaveMember = v
+		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLikeMember = v
+		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsListenMember = v
+		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsMentionMember = v
+		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsMoveMember = v
+		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsNoteMember = v
+		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOfferMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrganizationMember = v
+		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPageMember = v
+		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPersonMember = v
+		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPlaceMember = v
+		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsProfileMember = v
+		} else if v, err := mgr.DeserializePropertyValueActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPropertyValueMember = v
+		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsQuestionMember = v
+		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsReadMember = v
+		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRejectMember = v
+		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRelationshipMember = v
+		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRemoveMember = v
+		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsServiceMember = v
+		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTentativeAcceptMember = v
+		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTentativeRejectMember = v
+		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTombstoneMember = v
+		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTravelMember = v
+		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsUndoMember = v
+		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsUpdateMember = v
+		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsVideoMember = v
+		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsViewMember = v
+		} else {
+			this.unknown = m
+		}
+	})
 }
 
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsAttachmentPropertyIterator) serialize() (interface{}, error) {
+func (this *ActivityStreamsAttachmentPropertyIterator) serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsObject() {
 		return this.GetActivityStreamsObject().Serialize()
 	} else if this.IsActivityStreamsLink() {
@@ -2586,6 +2662,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) serialize() (interface{},
 		return this.GetActivityStreamsDislike().Serialize()
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2594,6 +2672,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) serialize() (interface{},
 		return this.GetActivityStreamsFollow().Serialize()
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Serialize()
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().Serialize()
 	} else if this.IsActivityStreamsImage() {
@@ -2632,6 +2712,8 @@ func (this ActivityStreamsAttachmentPropertyIterator) serialize() (interface{},
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().Serialize()
 	} else if this.IsActivityStreamsRead() {
@@ -2894,6 +2976,17 @@ func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsDocument(v v
 	})
 }
 
+// AppendActivityStreamsEmoji appends a Emoji value to the back of a list of the
+// property "attachment". Invalidates iterators that are traversing using Prev.
+func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.properties = append(this.properties, &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
 // AppendActivityStreamsEvent appends a Event value to the back of a list of the
 // property "attachment". Invalidates iterators that are traversing using Prev.
 func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
@@ -2938,6 +3031,18 @@ func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsGroup(v voca
 	})
 }
 
+// AppendActivityStreamsHashtag appends a Hashtag value to the back of a list of
+// the property "attachment". Invalidates iterators that are traversing using
+// Prev.
+func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties = append(this.properties, &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
 // AppendActivityStreamsIgnore appends a Ignore value to the back of a list of the
 // property "attachment". Invalidates iterators that are traversing using Prev.
 func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -3175,6 +3280,18 @@ func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsProfile(v vo
 	})
 }
 
+// AppendActivityStreamsPropertyValue appends a PropertyValue value to the back of
+// a list of the property "attachment". Invalidates iterators that are
+// traversing using Prev.
+func (this *ActivityStreamsAttachmentProperty) AppendActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.properties = append(this.properties, &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              this.Len(),
+		parent:                             this,
+	})
+}
+
 // AppendActivityStreamsQuestion appends a Question value to the back of a list of
 // the property "attachment". Invalidates iterators that are traversing using
 // Prev.
@@ -3392,6 +3509,19 @@ func (this ActivityStreamsAttachmentProperty) End() vocab.ActivityStreamsAttachm
 	return nil
 }
 
+// ForEach calls fn with the index and iterator of each value, from front to back,
+// until fn returns false. Unlike the iterator's Next method, which copies the
+// iterator it is called on, it neither copies nor allocates per value, so
+// that it suits hot loops over long properties. The property must not be
+// modified by fn.
+func (this ActivityStreamsAttachmentProperty) ForEach(fn func(idx int, it vocab.ActivityStreamsAttachmentPropertyIterator) bool) {
+	for i, elem := range this.properties {
+		if !fn(i, elem) {
+			return
+		}
+	}
+}
+
 // InsertActivityStreamsAccept inserts a Accept value at the specified index for a
 // property "attachment". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -3647,6 +3777,23 @@ func (this *ActivityStreamsAttachmentProperty) InsertActivityStreamsDocument(idx
 	}
 }
 
+// InsertActivityStreamsEmoji inserts a Emoji value at the specified index for a
+// property "attachment". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) InsertActivityStreamsEmoji(idx int, v vocab.ActivityStreamsEmoji) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsEvent inserts a Event value at the specified index for a
 // property "attachment". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -3715,6 +3862,23 @@ func (this *ActivityStreamsAttachmentProperty) InsertActivityStreamsGroup(idx in
 	}
 }
 
+// InsertActivityStreamsHashtag inserts a Hashtag value at the specified index for
+// a property "attachment". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) InsertActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsIgnore inserts a Ignore value at the specified index for a
 // property "attachment". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -4072,6 +4236,23 @@ func (this *ActivityStreamsAttachmentProperty) InsertActivityStreamsProfile(idx
 	}
 }
 
+// InsertActivityStreamsPropertyValue inserts a PropertyValue value at the
+// specified index for a property "attachment". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) InsertActivityStreamsPropertyValue(idx int, v vocab.ActivityStreamsPropertyValue) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              idx,
+		parent:                             this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsQuestion inserts a Question value at the specified index
 // for a property "attachment". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -4457,150 +4638,162 @@ func (this ActivityStreamsAttachmentProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetActivityStreamsDocument()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 17 {
+			lhs := this.properties[i].GetActivityStreamsEmoji()
+			rhs := this.properties[j].GetActivityStreamsEmoji()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 18 {
 			lhs := this.properties[i].GetActivityStreamsEvent()
 			rhs := this.properties[j].GetActivityStreamsEvent()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 18 {
+		} else if idx1 == 19 {
 			lhs := this.properties[i].GetActivityStreamsFlag()
 			rhs := this.properties[j].GetActivityStreamsFlag()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 19 {
+		} else if idx1 == 20 {
 			lhs := this.properties[i].GetActivityStreamsFollow()
 			rhs := this.properties[j].GetActivityStreamsFollow()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 20 {
+		} else if idx1 == 21 {
 			lhs := this.properties[i].GetActivityStreamsGroup()
 			rhs := this.properties[j].GetActivityStreamsGroup()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 21 {
+		} else if idx1 == 22 {
+			lhs := this.properties[i].GetActivityStreamsHashtag()
+			rhs := this.properties[j].GetActivityStreamsHashtag()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 23 {
 			lhs := this.properties[i].GetActivityStreamsIgnore()
 			rhs := this.properties[j].GetActivityStreamsIgnore()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 22 {
+		} else if idx1 == 24 {
 			lhs := this.properties[i].GetActivityStreamsImage()
 			rhs := this.properties[j].GetActivityStreamsImage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 23 {
+		} else if idx1 == 25 {
 			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
 			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 24 {
+		} else if idx1 == 26 {
 			lhs := this.properties[i].GetActivityStreamsInvite()
 			rhs := this.properties[j].GetActivityStreamsInvite()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 25 {
+		} else if idx1 == 27 {
 			lhs := this.properties[i].GetActivityStreamsJoin()
 			rhs := this.properties[j].GetActivityStreamsJoin()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 26 {
+		} else if idx1 == 28 {
 			lhs := this.properties[i].GetActivityStreamsLeave()
 			rhs := this.properties[j].GetActivityStreamsLeave()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 27 {
+		} else if idx1 == 29 {
 			lhs := this.properties[i].GetActivityStreamsLike()
 			rhs := this.properties[j].GetActivityStreamsLike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 28 {
+		} else if idx1 == 30 {
 			lhs := this.properties[i].GetActivityStreamsListen()
 			rhs := this.properties[j].GetActivityStreamsListen()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 29 {
+		} else if idx1 == 31 {
 			lhs := this.properties[i].GetActivityStreamsMention()
 			rhs := this.properties[j].GetActivityStreamsMention()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 30 {
+		} else if idx1 == 32 {
 			lhs := this.properties[i].GetActivityStreamsMove()
 			rhs := this.properties[j].GetActivityStreamsMove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 31 {
+		} else if idx1 == 33 {
 			lhs := this.properties[i].GetActivityStreamsNote()
 			rhs := this.properties[j].GetActivityStreamsNote()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 32 {
+		} else if idx1 == 34 {
 			lhs := this.properties[i].GetActivityStreamsOffer()
 			rhs := this.properties[j].GetActivityStreamsOffer()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 33 {
+		} else if idx1 == 35 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 34 {
+		} else if idx1 == 36 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 35 {
+		} else if idx1 == 37 {
 			lhs := this.properties[i].GetActivityStreamsOrganization()
 			rhs := this.properties[j].GetActivityStreamsOrganization()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 36 {
+		} else if idx1 == 38 {
 			lhs := this.properties[i].GetActivityStreamsPage()
 			rhs := this.properties[j].GetActivityStreamsPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 37 {
+		} else if idx1 == 39 {
 			lhs := this.properties[i].GetActivityStreamsPerson()
 			rhs := this.properties[j].GetActivityStreamsPerson()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 38 {
+		} else if idx1 == 40 {
 			lhs := this.properties[i].GetActivityStreamsPlace()
 			rhs := this.properties[j].GetActivityStreamsPlace()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 39 {
+		} else if idx1 == 41 {
 			lhs := this.properties[i].GetActivityStreamsProfile()
 			rhs := this.properties[j].GetActivityStreamsProfile()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 40 {
+		} else if idx1 == 42 {
+			lhs := this.properties[i].GetActivityStreamsPropertyValue()
+			rhs := this.properties[j].GetActivityStreamsPropertyValue()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 43 {
 			lhs := this.properties[i].GetActivityStreamsQuestion()
 			rhs := this.properties[j].GetActivityStreamsQuestion()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 41 {
+		} else if idx1 == 44 {
 			lhs := this.properties[i].GetActivityStreamsRead()
 			rhs := this.properties[j].GetActivityStreamsRead()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 42 {
+		} else if idx1 == 45 {
 			lhs := this.properties[i].GetActivityStreamsReject()
 			rhs := this.properties[j].GetActivityStreamsReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 43 {
+		} else if idx1 == 46 {
 			lhs := this.properties[i].GetActivityStreamsRelationship()
 			rhs := this.properties[j].GetActivityStreamsRelationship()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 44 {
+		} else if idx1 == 47 {
 			lhs := this.properties[i].GetActivityStreamsRemove()
 			rhs := this.properties[j].GetActivityStreamsRemove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 45 {
+		} else if idx1 == 48 {
 			lhs := this.properties[i].GetActivityStreamsService()
 			rhs := this.properties[j].GetActivityStreamsService()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 46 {
+		} else if idx1 == 49 {
 			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
 			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 47 {
+		} else if idx1 == 50 {
 			lhs := this.properties[i].GetActivityStreamsTentativeReject()
 			rhs := this.properties[j].GetActivityStreamsTentativeReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 48 {
+		} else if idx1 == 51 {
 			lhs := this.properties[i].GetActivityStreamsTombstone()
 			rhs := this.properties[j].GetActivityStreamsTombstone()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 49 {
+		} else if idx1 == 52 {
 			lhs := this.properties[i].GetActivityStreamsTravel()
 			rhs := this.properties[j].GetActivityStreamsTravel()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 50 {
+		} else if idx1 == 53 {
 			lhs := this.properties[i].GetActivityStreamsUndo()
 			rhs := this.properties[j].GetActivityStreamsUndo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 51 {
+		} else if idx1 == 54 {
 			lhs := this.properties[i].GetActivityStreamsUpdate()
 			rhs := this.properties[j].GetActivityStreamsUpdate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 52 {
+		} else if idx1 == 55 {
 			lhs := this.properties[i].GetActivityStreamsVideo()
 			rhs := this.properties[j].GetActivityStreamsVideo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 53 {
+		} else if idx1 == 56 {
 			lhs := this.properties[i].GetActivityStreamsView()
 			rhs := this.properties[j].GetActivityStreamsView()
 			return lhs.LessThan(rhs)
@@ -4849,6 +5042,20 @@ func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsDocument(v
 	}
 }
 
+// PrependActivityStreamsEmoji prepends a Emoji value to the front of a list of
+// the property "attachment". Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.properties = append([]*ActivityStreamsAttachmentPropertyIterator{{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsEvent prepends a Event value to the front of a list of
 // the property "attachment". Invalidates all iterators.
 func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
@@ -4905,6 +5112,20 @@ func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsGroup(v voc
 	}
 }
 
+// PrependActivityStreamsHashtag prepends a Hashtag value to the front of a list
+// of the property "attachment". Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties = append([]*ActivityStreamsAttachmentPropertyIterator{{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsIgnore prepends a Ignore value to the front of a list of
 // the property "attachment". Invalidates all iterators.
 func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -5201,6 +5422,20 @@ func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsProfile(v v
 	}
 }
 
+// PrependActivityStreamsPropertyValue prepends a PropertyValue value to the front
+// of a list of the property "attachment". Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.properties = append([]*ActivityStreamsAttachmentPropertyIterator{{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              0,
+		parent:                             this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsQuestion prepends a Question value to the front of a list
 // of the property "attachment". Invalidates all iterators.
 func (this *ActivityStreamsAttachmentProperty) PrependActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
@@ -5658,6 +5893,19 @@ func (this *ActivityStreamsAttachmentProperty) SetActivityStreamsDocument(idx in
 	}
 }
 
+// SetActivityStreamsEmoji sets a Emoji value to be at the specified index for the
+// property "attachment". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ActivityStreamsAttachmentProperty) SetActivityStreamsEmoji(idx int, v vocab.ActivityStreamsEmoji) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
 // SetActivityStreamsEvent sets a Event value to be at the specified index for the
 // property "attachment". Panics if the index is out of bounds. Invalidates
 // all iterators.
@@ -5710,6 +5958,19 @@ func (this *ActivityStreamsAttachmentProperty) SetActivityStreamsGroup(idx int,
 	}
 }
 
+// SetActivityStreamsHashtag sets a Hashtag value to be at the specified index for
+// the property "attachment". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) SetActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
 // SetActivityStreamsIgnore sets a Ignore value to be at the specified index for
 // the property "attachment". Panics if the index is out of bounds.
 // Invalidates all iterators.
@@ -5983,6 +6244,19 @@ func (this *ActivityStreamsAttachmentProperty) SetActivityStreamsProfile(idx int
 	}
 }
 
+// SetActivityStreamsPropertyValue sets a PropertyValue value to be at the
+// specified index for the property "attachment". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ActivityStreamsAttachmentProperty) SetActivityStreamsPropertyValue(idx int, v vocab.ActivityStreamsPropertyValue) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAttachmentPropertyIterator{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              idx,
+		parent:                             this,
+	}
+}
+
 // SetActivityStreamsQuestion sets a Question value to be at the specified index
 // for the property "attachment". Panics if the index is out of bounds.
 // Invalidates all iterators.
@@ -6194,6 +6468,21 @@ func (this *ActivityStreamsAttachmentProperty) SetType(idx int, t vocab.Type) er
 	return nil
 }
 
+// String returns a compact, human-readable form of the values of this property
+// for logs and debugging, listing at most the first 3. It is not a
+// serialization.
+func (this ActivityStreamsAttachmentProperty) String() string {
+	s := make([]string, 0, len(this.properties))
+	for i, elem := range this.properties {
+		if i == 3 {
+			s = append(s, fmt.Sprintf("...+%d", len(this.properties)-3))
+			break
+		}
+		s = append(s, elem.String())
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
 // Swap swaps the location of values at two indices for the "attachment" property.
 func (this ActivityStreamsAttachmentProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
@@ -4,13 +4,17 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // ActivityStreamsAttributedToPropertyIterator is an iterator for a property. It
 // is permitted to be one of multiple value types. At most, one type of value
 // can be present, or none at all. Setting a value will clear the other types
 // of values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsAttributedToPropertyIterator struct {
 	activitystreamsLinkMember                  vocab.ActivityStreamsLink
 	activitystreamsObjectMember                vocab.ActivityStreamsObject
@@ -29,10 +33,12 @@ type ActivityStreamsAttributedToPropertyIterator struct {
 	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	activitystreamsEmojiMember                 vocab.ActivityStreamsEmoji
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
 	activitystreamsGroupMember                 vocab.ActivityStreamsGroup
+	activitystreamsHashtagMember               vocab.ActivityStreamsHashtag
 	activitystreamsIgnoreMember                vocab.ActivityStreamsIgnore
 	activitystreamsImageMember                 vocab.ActivityStreamsImage
 	activitystreamsIntransitiveActivityMember  vocab.ActivityStreamsIntransitiveActivity
@@ -52,6 +58,7 @@ type ActivityStreamsAttributedToPropertyIterator struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	activitystreamsPropertyValueMember         vocab.ActivityStreamsPropertyValue
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
 	activitystreamsRejectMember                vocab.ActivityStreamsReject
@@ -67,6 +74,9 @@ type ActivityStreamsAttributedToPropertyIterator struct {
 	activitystreamsVideoMember                 vocab.ActivityStreamsVideo
 	activitystreamsViewMember                  vocab.ActivityStreamsView
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 	myIdx                                      int
@@ -99,331 +109,13 @@ func deserializeActivityStreamsAttributedToPropertyIterator(i interface{}, alias
 		}
 	}
 	if m, ok := i.(map[string]interface{}); ok {
-		if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsLinkMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsObjectMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsAcceptMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsActivityMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsAddMember: v,
-				alias:                    alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsAnnounceMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsApplicationMember: v,
-				alias:                            alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsArriveMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsArticleMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsAudioMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsBlockMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsCollectionMember: v,
-				alias:                           alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsCollectionPageMember: v,
-				alias:                               alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsCreateMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsDeleteMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsDislikeMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsDocumentMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsEventMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsFlagMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsFollowMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsGroupMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsIgnoreMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsImageMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsIntransitiveActivityMember: v,
-				alias: alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsInviteMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsJoinMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsLeaveMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsLikeMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsListenMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsMentionMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsMoveMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsNoteMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsOfferMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsOrderedCollectionMember: v,
-				alias:                                  alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsOrderedCollectionPageMember: v,
-				alias: alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsOrganizationMember: v,
-				alias:                             alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsPageMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsPersonMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsPlaceMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsProfileMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsQuestionMember: v,
-				alias:                         alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsReadMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsRejectMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsRelationshipMember: v,
-				alias:                             alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsRemoveMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsServiceMember: v,
-				alias:                        alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsTentativeAcceptMember: v,
-				alias:                                alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsTentativeRejectMember: v,
-				alias:                                alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsTombstoneMember: v,
-				alias:                          alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsTravelMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsUndoMember: v,
-				alias:                     alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsUpdateMember: v,
-				alias:                       alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsVideoMember: v,
-				alias:                      alias,
-			}
-			return this, nil
-		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
-			this := &ActivityStreamsAttributedToPropertyIterator{
-				activitystreamsViewMember: v,
-				alias:                     alias,
-			}
-			return this, nil
+		// Defer deserializing the value until it is first accessed.
+		this := &ActivityStreamsAttributedToPropertyIterator{
+			alias:       alias,
+			raw:         m,
+			rawAliasMap: aliasMap,
 		}
+		return this, nil
 	}
 	this := &ActivityStreamsAttributedToPropertyIterator{
 		alias:   alias,
@@ -435,390 +127,468 @@ func deserializeActivityStreamsAttributedToPropertyIterator(i interface{}, alias
 // GetActivityStreamsAccept returns the value of this property. When
 // IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+	this.resolve()
 	return this.activitystreamsAcceptMember
 }
 
 // GetActivityStreamsActivity returns the value of this property. When
 // IsActivityStreamsActivity returns false, GetActivityStreamsActivity will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+	this.resolve()
 	return this.activitystreamsActivityMember
 }
 
 // GetActivityStreamsAdd returns the value of this property. When
 // IsActivityStreamsAdd returns false, GetActivityStreamsAdd will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+	this.resolve()
 	return this.activitystreamsAddMember
 }
 
 // GetActivityStreamsAnnounce returns the value of this property. When
 // IsActivityStreamsAnnounce returns false, GetActivityStreamsAnnounce will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+	this.resolve()
 	return this.activitystreamsAnnounceMember
 }
 
 // GetActivityStreamsApplication returns the value of this property. When
 // IsActivityStreamsApplication returns false, GetActivityStreamsApplication
 // will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+	this.resolve()
 	return this.activitystreamsApplicationMember
 }
 
 // GetActivityStreamsArrive returns the value of this property. When
 // IsActivityStreamsArrive returns false, GetActivityStreamsArrive will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+	this.resolve()
 	return this.activitystreamsArriveMember
 }
 
 // GetActivityStreamsArticle returns the value of this property. When
 // IsActivityStreamsArticle returns false, GetActivityStreamsArticle will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+	this.resolve()
 	return this.activitystreamsArticleMember
 }
 
 // GetActivityStreamsAudio returns the value of this property. When
 // IsActivityStreamsAudio returns false, GetActivityStreamsAudio will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+	this.resolve()
 	return this.activitystreamsAudioMember
 }
 
 // GetActivityStreamsBlock returns the value of this property. When
 // IsActivityStreamsBlock returns false, GetActivityStreamsBlock will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+	this.resolve()
 	return this.activitystreamsBlockMember
 }
 
 // GetActivityStreamsCollection returns the value of this property. When
 // IsActivityStreamsCollection returns false, GetActivityStreamsCollection
 // will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	this.resolve()
 	return this.activitystreamsCollectionMember
 }
 
 // GetActivityStreamsCollectionPage returns the value of this property. When
 // IsActivityStreamsCollectionPage returns false,
 // GetActivityStreamsCollectionPage will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember
 }
 
 // GetActivityStreamsCreate returns the value of this property. When
 // IsActivityStreamsCreate returns false, GetActivityStreamsCreate will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+	this.resolve()
 	return this.activitystreamsCreateMember
 }
 
 // GetActivityStreamsDelete returns the value of this property. When
 // IsActivityStreamsDelete returns false, GetActivityStreamsDelete will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+	this.resolve()
 	return this.activitystreamsDeleteMember
 }
 
 // GetActivityStreamsDislike returns the value of this property. When
 // IsActivityStreamsDislike returns false, GetActivityStreamsDislike will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+	this.resolve()
 	return this.activitystreamsDislikeMember
 }
 
 // GetActivityStreamsDocument returns the value of this property. When
 // IsActivityStreamsDocument returns false, GetActivityStreamsDocument will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+	this.resolve()
 	return this.activitystreamsDocumentMember
 }
 
+// GetActivityStreamsEmoji returns the value of this property. When
+// IsActivityStreamsEmoji returns false, GetActivityStreamsEmoji will return
+// an arbitrary value.
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsEmoji() vocab.ActivityStreamsEmoji {
+	this.resolve()
+	return this.activitystreamsEmojiMember
+}
+
 // GetActivityStreamsEvent returns the value of this property. When
 // IsActivityStreamsEvent returns false, GetActivityStreamsEvent will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+	this.resolve()
 	return this.activitystreamsEventMember
 }
 
 // GetActivityStreamsFlag returns the value of this property. When
 // IsActivityStreamsFlag returns false, GetActivityStreamsFlag will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+	this.resolve()
 	return this.activitystreamsFlagMember
 }
 
 // GetActivityStreamsFollow returns the value of this property. When
 // IsActivityStreamsFollow returns false, GetActivityStreamsFollow will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+	this.resolve()
 	return this.activitystreamsFollowMember
 }
 
 // GetActivityStreamsGroup returns the value of this property. When
 // IsActivityStreamsGroup returns false, GetActivityStreamsGroup will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+	this.resolve()
 	return this.activitystreamsGroupMember
 }
 
+// GetActivityStreamsHashtag returns the value of this property. When
+// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag will
+// return an arbitrary value.
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	this.resolve()
+	return this.activitystreamsHashtagMember
+}
+
 // GetActivityStreamsIgnore returns the value of this property. When
 // IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+	this.resolve()
 	return this.activitystreamsIgnoreMember
 }
 
 // GetActivityStreamsImage returns the value of this property. When
 // IsActivityStreamsImage returns false, GetActivityStreamsImage will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+	this.resolve()
 	return this.activitystreamsImageMember
 }
 
 // GetActivityStreamsIntransitiveActivity returns the value of this property. When
 // IsActivityStreamsIntransitiveActivity returns false,
 // GetActivityStreamsIntransitiveActivity will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+	this.resolve()
 	return this.activitystreamsIntransitiveActivityMember
 }
 
 // GetActivityStreamsInvite returns the value of this property. When
 // IsActivityStreamsInvite returns false, GetActivityStreamsInvite will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+	this.resolve()
 	return this.activitystreamsInviteMember
 }
 
 // GetActivityStreamsJoin returns the value of this property. When
 // IsActivityStreamsJoin returns false, GetActivityStreamsJoin will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+	this.resolve()
 	return this.activitystreamsJoinMember
 }
 
 // GetActivityStreamsLeave returns the value of this property. When
 // IsActivityStreamsLeave returns false, GetActivityStreamsLeave will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+	this.resolve()
 	return this.activitystreamsLeaveMember
 }
 
 // GetActivityStreamsLike returns the value of this property. When
 // IsActivityStreamsLike returns false, GetActivityStreamsLike will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+	this.resolve()
 	return this.activitystreamsLikeMember
 }
 
 // GetActivityStreamsLink returns the value of this property. When
 // IsActivityStreamsLink returns false, GetActivityStreamsLink will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+	this.resolve()
 	return this.activitystreamsLinkMember
 }
 
 // GetActivityStreamsListen returns the value of this property. When
 // IsActivityStreamsListen returns false, GetActivityStreamsListen will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+	this.resolve()
 	return this.activitystreamsListenMember
 }
 
 // GetActivityStreamsMention returns the value of this property. When
 // IsActivityStreamsMention returns false, GetActivityStreamsMention will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+	this.resolve()
 	return this.activitystreamsMentionMember
 }
 
 // GetActivityStreamsMove returns the value of this property. When
 // IsActivityStreamsMove returns false, GetActivityStreamsMove will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+	this.resolve()
 	return this.activitystreamsMoveMember
 }
 
 // GetActivityStreamsNote returns the value of this property. When
 // IsActivityStreamsNote returns false, GetActivityStreamsNote will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+	this.resolve()
 	return this.activitystreamsNoteMember
 }
 
 // GetActivityStreamsObject returns the value of this property. When
 // IsActivityStreamsObject returns false, GetActivityStreamsObject will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+	this.resolve()
 	return this.activitystreamsObjectMember
 }
 
 // GetActivityStreamsOffer returns the value of this property. When
 // IsActivityStreamsOffer returns false, GetActivityStreamsOffer will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+	this.resolve()
 	return this.activitystreamsOfferMember
 }
 
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetActivityStreamsOrganization returns the value of this property. When
 // IsActivityStreamsOrganization returns false, GetActivityStreamsOrganization
 // will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+	this.resolve()
 	return this.activitystreamsOrganizationMember
 }
 
 // GetActivityStreamsPage returns the value of this property. When
 // IsActivityStreamsPage returns false, GetActivityStreamsPage will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+	this.resolve()
 	return this.activitystreamsPageMember
 }
 
 // GetActivityStreamsPerson returns the value of this property. When
 // IsActivityStreamsPerson returns false, GetActivityStreamsPerson will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+	this.resolve()
 	return this.activitystreamsPersonMember
 }
 
 // GetActivityStreamsPlace returns the value of this property. When
 // IsActivityStreamsPlace returns false, GetActivityStreamsPlace will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+	this.resolve()
 	return this.activitystreamsPlaceMember
 }
 
 // GetActivityStreamsProfile returns the value of this property. When
 // IsActivityStreamsProfile returns false, GetActivityStreamsProfile will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+	this.resolve()
 	return this.activitystreamsProfileMember
 }
 
+// GetActivityStreamsPropertyValue returns the value of this property. When
+// IsActivityStreamsPropertyValue returns false,
+// GetActivityStreamsPropertyValue will return an arbitrary value.
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsPropertyValue() vocab.ActivityStreamsPropertyValue {
+	this.resolve()
+	return this.activitystreamsPropertyValueMember
+}
+
 // GetActivityStreamsQuestion returns the value of this property. When
 // IsActivityStreamsQuestion returns false, GetActivityStreamsQuestion will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+	this.resolve()
 	return this.activitystreamsQuestionMember
 }
 
 // GetActivityStreamsRead returns the value of this property. When
 // IsActivityStreamsRead returns false, GetActivityStreamsRead will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+	this.resolve()
 	return this.activitystreamsReadMember
 }
 
 // GetActivityStreamsReject returns the value of this property. When
 // IsActivityStreamsReject returns false, GetActivityStreamsReject will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+	this.resolve()
 	return this.activitystreamsRejectMember
 }
 
 // GetActivityStreamsRelationship returns the value of this property. When
 // IsActivityStreamsRelationship returns false, GetActivityStreamsRelationship
 // will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+	this.resolve()
 	return this.activitystreamsRelationshipMember
 }
 
 // GetActivityStreamsRemove returns the value of this property. When
 // IsActivityStreamsRemove returns false, GetActivityStreamsRemove will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+	this.resolve()
 	return this.activitystreamsRemoveMember
 }
 
 // GetActivityStreamsService returns the value of this property. When
 // IsActivityStreamsService returns false, GetActivityStreamsService will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsService() vocab.ActivityStreamsService {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsService() vocab.ActivityStreamsService {
+	this.resolve()
 	return this.activitystreamsServiceMember
 }
 
 // GetActivityStreamsTentativeAccept returns the value of this property. When
 // IsActivityStreamsTentativeAccept returns false,
 // GetActivityStreamsTentativeAccept will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+	this.resolve()
 	return this.activitystreamsTentativeAcceptMember
 }
 
 // GetActivityStreamsTentativeReject returns the value of this property. When
 // IsActivityStreamsTentativeReject returns false,
 // GetActivityStreamsTentativeReject will return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+	this.resolve()
 	return this.activitystreamsTentativeRejectMember
 }
 
 // GetActivityStreamsTombstone returns the value of this property. When
 // IsActivityStreamsTombstone returns false, GetActivityStreamsTombstone will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+	this.resolve()
 	return this.activitystreamsTombstoneMember
 }
 
 // GetActivityStreamsTravel returns the value of this property. When
 // IsActivityStreamsTravel returns false, GetActivityStreamsTravel will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+	this.resolve()
 	return this.activitystreamsTravelMember
 }
 
 // GetActivityStreamsUndo returns the value of this property. When
 // IsActivityStreamsUndo returns false, GetActivityStreamsUndo will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+	this.resolve()
 	return this.activitystreamsUndoMember
 }
 
 // GetActivityStreamsUpdate returns the value of this property. When
 // IsActivityStreamsUpdate returns false, GetActivityStreamsUpdate will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+	this.resolve()
 	return this.activitystreamsUpdateMember
 }
 
 // GetActivityStreamsVideo returns the value of this property. When
 // IsActivityStreamsVideo returns false, GetActivityStreamsVideo will return
 // an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+	this.resolve()
 	return this.activitystreamsVideoMember
 }
 
 // GetActivityStreamsView returns the value of this property. When
 // IsActivityStreamsView returns false, GetActivityStreamsView will return an
 // arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsView() vocab.ActivityStreamsView {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetActivityStreamsView() vocab.ActivityStreamsView {
+	this.resolve()
 	return this.activitystreamsViewMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetIRI() *url.URL {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsAttributedToPropertyIterator) GetType() vocab.Type {
+func (this *ActivityStreamsAttributedToPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink()
 	}
@@ -870,6 +640,9 @@ func (this ActivityStreamsAttributedToPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument()
 	}
+	if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -882,6 +655,9 @@ func (this ActivityStreamsAttributedToPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup()
 	}
+	if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag()
+	}
 	if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore()
 	}
@@ -939,6 +715,9 @@ func (this ActivityStreamsAttributedToPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue()
+	}
 	if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion()
 	}
@@ -986,7 +765,7 @@ func (this ActivityStreamsAttributedToPropertyIterator) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsAttributedToPropertyIterator) HasAny() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) HasAny() bool {
 	return this.IsActivityStreamsLink() ||
 		this.IsActivityStreamsObject() ||
 		this.IsActivityStreamsAccept() ||
@@ -1004,10 +783,12 @@ func (this ActivityStreamsAttributedToPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsDelete() ||
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
+		this.IsActivityStreamsEmoji() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
 		this.IsActivityStreamsGroup() ||
+		this.IsActivityStreamsHashtag() ||
 		this.IsActivityStreamsIgnore() ||
 		this.IsActivityStreamsImage() ||
 		this.IsActivityStreamsIntransitiveActivity() ||
@@ -1027,6 +808,7 @@ func (this ActivityStreamsAttributedToPropertyIterator) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsActivityStreamsPropertyValue() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
 		this.IsActivityStreamsReject() ||
@@ -1047,147 +829,184 @@ func (this ActivityStreamsAttributedToPropertyIterator) HasAny() bool {
 // IsActivityStreamsAccept returns true if this property has a type of "Accept".
 // When true, use the GetActivityStreamsAccept and SetActivityStreamsAccept
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAccept() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAccept() bool {
+	this.resolve()
 	return this.activitystreamsAcceptMember != nil
 }
 
 // IsActivityStreamsActivity returns true if this property has a type of
 // "Activity". When true, use the GetActivityStreamsActivity and
 // SetActivityStreamsActivity methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsActivity() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsActivity() bool {
+	this.resolve()
 	return this.activitystreamsActivityMember != nil
 }
 
 // IsActivityStreamsAdd returns true if this property has a type of "Add". When
 // true, use the GetActivityStreamsAdd and SetActivityStreamsAdd methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAdd() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAdd() bool {
+	this.resolve()
 	return this.activitystreamsAddMember != nil
 }
 
 // IsActivityStreamsAnnounce returns true if this property has a type of
 // "Announce". When true, use the GetActivityStreamsAnnounce and
 // SetActivityStreamsAnnounce methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAnnounce() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAnnounce() bool {
+	this.resolve()
 	return this.activitystreamsAnnounceMember != nil
 }
 
 // IsActivityStreamsApplication returns true if this property has a type of
 // "Application". When true, use the GetActivityStreamsApplication and
 // SetActivityStreamsApplication methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsApplication() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsApplication() bool {
+	this.resolve()
 	return this.activitystreamsApplicationMember != nil
 }
 
 // IsActivityStreamsArrive returns true if this property has a type of "Arrive".
 // When true, use the GetActivityStreamsArrive and SetActivityStreamsArrive
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsArrive() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsArrive() bool {
+	this.resolve()
 	return this.activitystreamsArriveMember != nil
 }
 
 // IsActivityStreamsArticle returns true if this property has a type of "Article".
 // When true, use the GetActivityStreamsArticle and SetActivityStreamsArticle
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsArticle() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsArticle() bool {
+	this.resolve()
 	return this.activitystreamsArticleMember != nil
 }
 
 // IsActivityStreamsAudio returns true if this property has a type of "Audio".
 // When true, use the GetActivityStreamsAudio and SetActivityStreamsAudio
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAudio() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsAudio() bool {
+	this.resolve()
 	return this.activitystreamsAudioMember != nil
 }
 
 // IsActivityStreamsBlock returns true if this property has a type of "Block".
 // When true, use the GetActivityStreamsBlock and SetActivityStreamsBlock
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsBlock() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsBlock() bool {
+	this.resolve()
 	return this.activitystreamsBlockMember != nil
 }
 
 // IsActivityStreamsCollection returns true if this property has a type of
 // "Collection". When true, use the GetActivityStreamsCollection and
 // SetActivityStreamsCollection methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsCollection() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsCollection() bool {
+	this.resolve()
 	return this.activitystreamsCollectionMember != nil
 }
 
 // IsActivityStreamsCollectionPage returns true if this property has a type of
 // "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
 // SetActivityStreamsCollectionPage methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsCollectionPage() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember != nil
 }
 
 // IsActivityStreamsCreate returns true if this property has a type of "Create".
 // When true, use the GetActivityStreamsCreate and SetActivityStreamsCreate
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsCreate() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsCreate() bool {
+	this.resolve()
 	return this.activitystreamsCreateMember != nil
 }
 
 // IsActivityStreamsDelete returns true if this property has a type of "Delete".
 // When true, use the GetActivityStreamsDelete and SetActivityStreamsDelete
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsDelete() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsDelete() bool {
+	this.resolve()
 	return this.activitystreamsDeleteMember != nil
 }
 
 // IsActivityStreamsDislike returns true if this property has a type of "Dislike".
 // When true, use the GetActivityStreamsDislike and SetActivityStreamsDislike
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsDislike() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsDislike() bool {
+	this.resolve()
 	return this.activitystreamsDislikeMember != nil
 }
 
 // IsActivityStreamsDocument returns true if this property has a type of
 // "Document". When true, use the GetActivityStreamsDocument and
 // SetActivityStreamsDocument methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsDocument() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsDocument() bool {
+	this.resolve()
 	return this.activitystreamsDocumentMember != nil
 }
 
+// IsActivityStreamsEmoji returns true if this property has a type of "Emoji".
+// When true, use the GetActivityStreamsEmoji and SetActivityStreamsEmoji
+// methods to access and set this property.
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsEmoji() bool {
+	this.resolve()
+	return this.activitystreamsEmojiMember != nil
+}
+
 // IsActivityStreamsEvent returns true if this property has a type of "Event".
 // When true, use the GetActivityStreamsEvent and SetActivityStreamsEvent
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsEvent() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsEvent() bool {
+	this.resolve()
 	return this.activitystreamsEventMember != nil
 }
 
 // IsActivityStreamsFlag returns true if this property has a type of "Flag". When
 // true, use the GetActivityStreamsFlag and SetActivityStreamsFlag methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsFlag() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsFlag() bool {
+	this.resolve()
 	return this.activitystreamsFlagMember != nil
 }
 
 // IsActivityStreamsFollow returns true if this property has a type of "Follow".
 // When true, use the GetActivityStreamsFollow and SetActivityStreamsFollow
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsFollow() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsFollow() bool {
+	this.resolve()
 	return this.activitystreamsFollowMember != nil
 }
 
 // IsActivityStreamsGroup returns true if this property has a type of "Group".
 // When true, use the GetActivityStreamsGroup and SetActivityStreamsGroup
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsGroup() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsGroup() bool {
+	this.resolve()
 	return this.activitystreamsGroupMember != nil
 }
 
+// IsActivityStreamsHashtag returns true if this property has a type of "Hashtag".
+// When true, use the GetActivityStreamsHashtag and SetActivityStreamsHashtag
+// methods to access and set this property.
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsHashtag() bool {
+	this.resolve()
+	return this.activitystreamsHashtagMember != nil
+}
+
 // IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
 // When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsIgnore() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsIgnore() bool {
+	this.resolve()
 	return this.activitystreamsIgnoreMember != nil
 }
 
 // IsActivityStreamsImage returns true if this property has a type of "Image".
 // When true, use the GetActivityStreamsImage and SetActivityStreamsImage
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsImage() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsImage() bool {
+	this.resolve()
 	return this.activitystreamsImageMember != nil
 }
 
@@ -1196,84 +1015,96 @@ func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsImage()
 // GetActivityStreamsIntransitiveActivity and
 // SetActivityStreamsIntransitiveActivity methods to access and set this
 // property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsIntransitiveActivity() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsIntransitiveActivity() bool {
+	this.resolve()
 	return this.activitystreamsIntransitiveActivityMember != nil
 }
 
 // IsActivityStreamsInvite returns true if this property has a type of "Invite".
 // When true, use the GetActivityStreamsInvite and SetActivityStreamsInvite
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsInvite() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsInvite() bool {
+	this.resolve()
 	return this.activitystreamsInviteMember != nil
 }
 
 // IsActivityStreamsJoin returns true if this property has a type of "Join". When
 // true, use the GetActivityStreamsJoin and SetActivityStreamsJoin methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsJoin() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsJoin() bool {
+	this.resolve()
 	return this.activitystreamsJoinMember != nil
 }
 
 // IsActivityStreamsLeave returns true if this property has a type of "Leave".
 // When true, use the GetActivityStreamsLeave and SetActivityStreamsLeave
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsLeave() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsLeave() bool {
+	this.resolve()
 	return this.activitystreamsLeaveMember != nil
 }
 
 // IsActivityStreamsLike returns true if this property has a type of "Like". When
 // true, use the GetActivityStreamsLike and SetActivityStreamsLike methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsLike() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsLike() bool {
+	this.resolve()
 	return this.activitystreamsLikeMember != nil
 }
 
 // IsActivityStreamsLink returns true if this property has a type of "Link". When
 // true, use the GetActivityStreamsLink and SetActivityStreamsLink methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsLink() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsLink() bool {
+	this.resolve()
 	return this.activitystreamsLinkMember != nil
 }
 
 // IsActivityStreamsListen returns true if this property has a type of "Listen".
 // When true, use the GetActivityStreamsListen and SetActivityStreamsListen
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsListen() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsListen() bool {
+	this.resolve()
 	return this.activitystreamsListenMember != nil
 }
 
 // IsActivityStreamsMention returns true if this property has a type of "Mention".
 // When true, use the GetActivityStreamsMention and SetActivityStreamsMention
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsMention() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsMention() bool {
+	this.resolve()
 	return this.activitystreamsMentionMember != nil
 }
 
 // IsActivityStreamsMove returns true if this property has a type of "Move". When
 // true, use the GetActivityStreamsMove and SetActivityStreamsMove methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsMove() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsMove() bool {
+	this.resolve()
 	return this.activitystreamsMoveMember != nil
 }
 
 // IsActivityStreamsNote returns true if this property has a type of "Note". When
 // true, use the GetActivityStreamsNote and SetActivityStreamsNote methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsNote() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsNote() bool {
+	this.resolve()
 	return this.activitystreamsNoteMember != nil
 }
 
 // IsActivityStreamsObject returns true if this property has a type of "Object".
 // When true, use the GetActivityStreamsObject and SetActivityStreamsObject
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsObject() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsObject() bool {
+	this.resolve()
 	return this.activitystreamsObjectMember != nil
 }
 
 // IsActivityStreamsOffer returns true if this property has a type of "Offer".
 // When true, use the GetActivityStreamsOffer and SetActivityStreamsOffer
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOffer() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOffer() bool {
+	this.resolve()
 	return this.activitystreamsOfferMember != nil
 }
 
@@ -1281,7 +1112,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOffer()
 // "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
 // and SetActivityStreamsOrderedCollection methods to access and set this
 // property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrderedCollection() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrderedCollection() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember != nil
 }
 
@@ -1290,153 +1122,181 @@ func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrdered
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsActivityStreamsOrganization returns true if this property has a type of
 // "Organization". When true, use the GetActivityStreamsOrganization and
 // SetActivityStreamsOrganization methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrganization() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsOrganization() bool {
+	this.resolve()
 	return this.activitystreamsOrganizationMember != nil
 }
 
 // IsActivityStreamsPage returns true if this property has a type of "Page". When
 // true, use the GetActivityStreamsPage and SetActivityStreamsPage methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPage() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPage() bool {
+	this.resolve()
 	return this.activitystreamsPageMember != nil
 }
 
 // IsActivityStreamsPerson returns true if this property has a type of "Person".
 // When true, use the GetActivityStreamsPerson and SetActivityStreamsPerson
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPerson() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPerson() bool {
+	this.resolve()
 	return this.activitystreamsPersonMember != nil
 }
 
 // IsActivityStreamsPlace returns true if this property has a type of "Place".
 // When true, use the GetActivityStreamsPlace and SetActivityStreamsPlace
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPlace() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPlace() bool {
+	this.resolve()
 	return this.activitystreamsPlaceMember != nil
 }
 
 // IsActivityStreamsProfile returns true if this property has a type of "Profile".
 // When true, use the GetActivityStreamsProfile and SetActivityStreamsProfile
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsProfile() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsProfile() bool {
+	this.resolve()
 	return this.activitystreamsProfileMember != nil
 }
 
+// IsActivityStreamsPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetActivityStreamsPropertyValue and
+// SetActivityStreamsPropertyValue methods to access and set this property.
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsPropertyValue() bool {
+	this.resolve()
+	return this.activitystreamsPropertyValueMember != nil
+}
+
 // IsActivityStreamsQuestion returns true if this property has a type of
 // "Question". When true, use the GetActivityStreamsQuestion and
 // SetActivityStreamsQuestion methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsQuestion() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsQuestion() bool {
+	this.resolve()
 	return this.activitystreamsQuestionMember != nil
 }
 
 // IsActivityStreamsRead returns true if this property has a type of "Read". When
 // true, use the GetActivityStreamsRead and SetActivityStreamsRead methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsRead() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsRead() bool {
+	this.resolve()
 	return this.activitystreamsReadMember != nil
 }
 
 // IsActivityStreamsReject returns true if this property has a type of "Reject".
 // When true, use the GetActivityStreamsReject and SetActivityStreamsReject
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsReject() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsReject() bool {
+	this.resolve()
 	return this.activitystreamsRejectMember != nil
 }
 
 // IsActivityStreamsRelationship returns true if this property has a type of
 // "Relationship". When true, use the GetActivityStreamsRelationship and
 // SetActivityStreamsRelationship methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsRelationship() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsRelationship() bool {
+	this.resolve()
 	return this.activitystreamsRelationshipMember != nil
 }
 
 // IsActivityStreamsRemove returns true if this property has a type of "Remove".
 // When true, use the GetActivityStreamsRemove and SetActivityStreamsRemove
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsRemove() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsRemove() bool {
+	this.resolve()
 	return this.activitystreamsRemoveMember != nil
 }
 
 // IsActivityStreamsService returns true if this property has a type of "Service".
 // When true, use the GetActivityStreamsService and SetActivityStreamsService
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsService() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsService() bool {
+	this.resolve()
 	return this.activitystreamsServiceMember != nil
 }
 
 // IsActivityStreamsTentativeAccept returns true if this property has a type of
 // "TentativeAccept". When true, use the GetActivityStreamsTentativeAccept and
 // SetActivityStreamsTentativeAccept methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTentativeAccept() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTentativeAccept() bool {
+	this.resolve()
 	return this.activitystreamsTentativeAcceptMember != nil
 }
 
 // IsActivityStreamsTentativeReject returns true if this property has a type of
 // "TentativeReject". When true, use the GetActivityStreamsTentativeReject and
 // SetActivityStreamsTentativeReject methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTentativeReject() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTentativeReject() bool {
+	this.resolve()
 	return this.activitystreamsTentativeRejectMember != nil
 }
 
 // IsActivityStreamsTombstone returns true if this property has a type of
 // "Tombstone". When true, use the GetActivityStreamsTombstone and
 // SetActivityStreamsTombstone methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTombstone() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTombstone() bool {
+	this.resolve()
 	return this.activitystreamsTombstoneMember != nil
 }
 
 // IsActivityStreamsTravel returns true if this property has a type of "Travel".
 // When true, use the GetActivityStreamsTravel and SetActivityStreamsTravel
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTravel() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsTravel() bool {
+	this.resolve()
 	return this.activitystreamsTravelMember != nil
 }
 
 // IsActivityStreamsUndo returns true if this property has a type of "Undo". When
 // true, use the GetActivityStreamsUndo and SetActivityStreamsUndo methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsUndo() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsUndo() bool {
+	this.resolve()
 	return this.activitystreamsUndoMember != nil
 }
 
 // IsActivityStreamsUpdate returns true if this property has a type of "Update".
 // When true, use the GetActivityStreamsUpdate and SetActivityStreamsUpdate
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsUpdate() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsUpdate() bool {
+	this.resolve()
 	return this.activitystreamsUpdateMember != nil
 }
 
 // IsActivityStreamsVideo returns true if this property has a type of "Video".
 // When true, use the GetActivityStreamsVideo and SetActivityStreamsVideo
 // methods to access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsVideo() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsVideo() bool {
+	this.resolve()
 	return this.activitystreamsVideoMember != nil
 }
 
 // IsActivityStreamsView returns true if this property has a type of "View". When
 // true, use the GetActivityStreamsView and SetActivityStreamsView methods to
 // access and set this property.
-func (this ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsView() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsActivityStreamsView() bool {
+	this.resolve()
 	return this.activitystreamsViewMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsAttributedToPropertyIterator) IsIRI() bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsAttributedToPropertyIterator) JSONLDContext() map[string]string {
+func (this *ActivityStreamsAttributedToPropertyIterator) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsLink() {
@@ -1473,6 +1333,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) JSONLDContext() map[stri
 		child = this.GetActivityStreamsDislike().JSONLDContext()
 	} else if this.IsActivityStreamsDocument() {
 		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsActivityStreamsEmoji() {
+		child = this.GetActivityStreamsEmoji().JSONLDContext()
 	} else if this.IsActivityStreamsEvent() {
 		child = this.GetActivityStreamsEvent().JSONLDContext()
 	} else if this.IsActivityStreamsFlag() {
@@ -1481,6 +1343,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) JSONLDContext() map[stri
 		child = this.GetActivityStreamsFollow().JSONLDContext()
 	} else if this.IsActivityStreamsGroup() {
 		child = this.GetActivityStreamsGroup().JSONLDContext()
+	} else if this.IsActivityStreamsHashtag() {
+		child = this.GetActivityStreamsHashtag().JSONLDContext()
 	} else if this.IsActivityStreamsIgnore() {
 		child = this.GetActivityStreamsIgnore().JSONLDContext()
 	} else if this.IsActivityStreamsImage() {
@@ -1519,6 +1383,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) JSONLDContext() map[stri
 		child = this.GetActivityStreamsPlace().JSONLDContext()
 	} else if this.IsActivityStreamsProfile() {
 		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsActivityStreamsPropertyValue() {
+		child = this.GetActivityStreamsPropertyValue().JSONLDContext()
 	} else if this.IsActivityStreamsQuestion() {
 		child = this.GetActivityStreamsQuestion().JSONLDContext()
 	} else if this.IsActivityStreamsRead() {
@@ -1562,7 +1428,7 @@ func (this ActivityStreamsAttributedToPropertyIterator) JSONLDContext() map[stri
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsAttributedToPropertyIterator) KindIndex() int {
+func (this *ActivityStreamsAttributedToPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsLink() {
 		return 0
 	}
@@ -1614,117 +1480,126 @@ func (this ActivityStreamsAttributedToPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsDocument() {
 		return 16
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsActivityStreamsEmoji() {
 		return 17
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsEvent() {
 		return 18
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsFlag() {
 		return 19
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFollow() {
 		return 20
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsActivityStreamsGroup() {
 		return 21
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsActivityStreamsHashtag() {
 		return 22
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsIgnore() {
 		return 23
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsImage() {
 		return 24
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 25
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsInvite() {
 		return 26
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsJoin() {
 		return 27
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsLeave() {
 		return 28
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsLike() {
 		return 29
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsListen() {
 		return 30
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsMention() {
 		return 31
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsMove() {
 		return 32
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsNote() {
 		return 33
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsOffer() {
 		return 34
 	}
-	if this.IsActivityStreamsOrganization() {
+	if this.IsActivityStreamsOrderedCollection() {
 		return 35
 	}
-	if this.IsActivityStreamsPage() {
+	if this.IsActivityStreamsOrderedCollectionPage() {
 		return 36
 	}
-	if this.IsActivityStreamsPerson() {
+	if this.IsActivityStreamsOrganization() {
 		return 37
 	}
-	if this.IsActivityStreamsPlace() {
+	if this.IsActivityStreamsPage() {
 		return 38
 	}
-	if this.IsActivityStreamsProfile() {
+	if this.IsActivityStreamsPerson() {
 		return 39
 	}
-	if this.IsActivityStreamsQuestion() {
+	if this.IsActivityStreamsPlace() {
 		return 40
 	}
-	if this.IsActivityStreamsRead() {
+	if this.IsActivityStreamsProfile() {
 		return 41
 	}
-	if this.IsActivityStreamsReject() {
+	if this.IsActivityStreamsPropertyValue() {
 		return 42
 	}
-	if this.IsActivityStreamsRelationship() {
+	if this.IsActivityStreamsQuestion() {
 		return 43
 	}
-	if this.IsActivityStreamsRemove() {
+	if this.IsActivityStreamsRead() {
 		return 44
 	}
-	if this.IsActivityStreamsService() {
+	if this.IsActivityStreamsReject() {
 		return 45
 	}
-	if this.IsActivityStreamsTentativeAccept() {
+	if this.IsActivityStreamsRelationship() {
 		return 46
 	}
-	if this.IsActivityStreamsTentativeReject() {
+	if this.IsActivityStreamsRemove() {
 		return 47
 	}
-	if this.IsActivityStreamsTombstone() {
+	if this.IsActivityStreamsService() {
 		return 48
 	}
-	if this.IsActivityStreamsTravel() {
+	if this.IsActivityStreamsTentativeAccept() {
 		return 49
 	}
-	if this.IsActivityStreamsUndo() {
+	if this.IsActivityStreamsTentativeReject() {
 		return 50
 	}
-	if this.IsActivityStreamsUpdate() {
+	if this.IsActivityStreamsTombstone() {
 		return 51
 	}
-	if this.IsActivityStreamsVideo() {
+	if this.IsActivityStreamsTravel() {
 		return 52
 	}
-	if this.IsActivityStreamsView() {
+	if this.IsActivityStreamsUndo() {
 		return 53
 	}
+	if this.IsActivityStreamsUpdate() {
+		return 54
+	}
+	if this.IsActivityStreamsVideo() {
+		return 55
+	}
+	if this.IsActivityStreamsView() {
+		return 56
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -1735,7 +1610,7 @@ func (this ActivityStreamsAttributedToPropertyIterator) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsAttributedToPropertyIterator) LessThan(o vocab.ActivityStreamsAttributedToPropertyIterator) bool {
+func (this *ActivityStreamsAttributedToPropertyIterator) LessThan(o vocab.ActivityStreamsAttributedToPropertyIterator) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -1776,6 +1651,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) LessThan(o vocab.Activit
 		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji().LessThan(o.GetActivityStreamsEmoji())
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
 	} else if this.IsActivityStreamsFlag() {
@@ -1784,6 +1661,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) LessThan(o vocab.Activit
 		return this.GetActivityStreamsFollow().LessThan(o.GetActivityStreamsFollow())
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().LessThan(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().LessThan(o.GetActivityStreamsHashtag())
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().LessThan(o.GetActivityStreamsIgnore())
 	} else if this.IsActivityStreamsImage() {
@@ -1822,6 +1701,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) LessThan(o vocab.Activit
 		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue().LessThan(o.GetActivityStreamsPropertyValue())
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
 	} else if this.IsActivityStreamsRead() {
@@ -1857,12 +1738,12 @@ func (this ActivityStreamsAttributedToPropertyIterator) LessThan(o vocab.Activit
 }
 
 // Name returns the name of this property: "ActivityStreamsAttributedTo".
-func (this ActivityStreamsAttributedToPropertyIterator) Name() string {
+func (this *ActivityStreamsAttributedToPropertyIterator) Name() string {
 	return "ActivityStreamsAttributedTo"
 }
 
 // Next returns the next iterator, or nil if there is no next iterator.
-func (this ActivityStreamsAttributedToPropertyIterator) Next() vocab.ActivityStreamsAttributedToPropertyIterator {
+func (this *ActivityStreamsAttributedToPropertyIterator) Next() vocab.ActivityStreamsAttributedToPropertyIterator {
 	if this.myIdx+1 >= this.parent.Len() {
 		return nil
 	} else {
@@ -1871,7 +1752,7 @@ func (this ActivityStreamsAttributedToPropertyIterator) Next() vocab.ActivityStr
 }
 
 // Prev returns the previous iterator, or nil if there is no previous iterator.
-func (this ActivityStreamsAttributedToPropertyIterator) Prev() vocab.ActivityStreamsAttributedToPropertyIterator {
+func (this *ActivityStreamsAttributedToPropertyIterator) Prev() vocab.ActivityStreamsAttributedToPropertyIterator {
 	if this.myIdx-1 < 0 {
 		return nil
 	} else {
@@ -1984,6 +1865,13 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsDocum
 	this.activitystreamsDocumentMember = v
 }
 
+// SetActivityStreamsEmoji sets the value of this property. Calling
+// IsActivityStreamsEmoji afterwards returns true.
+func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.clear()
+	this.activitystreamsEmojiMember = v
+}
+
 // SetActivityStreamsEvent sets the value of this property. Calling
 // IsActivityStreamsEvent afterwards returns true.
 func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
@@ -2012,6 +1900,13 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsGroup
 	this.activitystreamsGroupMember = v
 }
 
+// SetActivityStreamsHashtag sets the value of this property. Calling
+// IsActivityStreamsHashtag afterwards returns true.
+func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.clear()
+	this.activitystreamsHashtagMember = v
+}
+
 // SetActivityStreamsIgnore sets the value of this property. Calling
 // IsActivityStreamsIgnore afterwards returns true.
 func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -2159,6 +2054,13 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsProfi
 	this.activitystreamsProfileMember = v
 }
 
+// SetActivityStreamsPropertyValue sets the value of this property. Calling
+// IsActivityStreamsPropertyValue afterwards returns true.
+func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.clear()
+	this.activitystreamsPropertyValueMember = v
+}
+
 // SetActivityStreamsQuestion sets the value of this property. Calling
 // IsActivityStreamsQuestion afterwards returns true.
 func (this *ActivityStreamsAttributedToPropertyIterator) SetActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
@@ -2334,6 +2236,10 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetType(t vocab.Type) e
 		this.SetActivityStreamsDocument(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsEmoji); ok {
+		this.SetActivityStreamsEmoji(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2350,6 +2256,10 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetType(t vocab.Type) e
 		this.SetActivityStreamsGroup(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsHashtag); ok {
+		this.SetActivityStreamsHashtag(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsIgnore); ok {
 		this.SetActivityStreamsIgnore(v)
 		return nil
@@ -2426,6 +2336,10 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetType(t vocab.Type) e
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsPropertyValue); ok {
+		this.SetActivityStreamsPropertyValue(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
 		this.SetActivityStreamsQuestion(v)
 		return nil
@@ -2486,6 +2400,33 @@ func (this *ActivityStreamsAttributedToPropertyIterator) SetType(t vocab.Type) e
 	return fmt.Errorf("illegal type to set on ActivityStreamsAttributedTo property: %T", t)
 }
 
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsAttributedToPropertyIterator) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
 // clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ActivityStreamsAttributedToPropertyIterator) clear() {
@@ -2506,10 +2447,12 @@ func (this *ActivityStreamsAttributedToPropertyIterator) clear() {
 	this.activitystreamsDeleteMember = nil
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
+	this.activitystreamsEmojiMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
 	this.activitystreamsGroupMember = nil
+	this.activitystreamsHashtagMember = nil
 	this.activitystreamsIgnoreMember = nil
 	this.activitystreamsImageMember = nil
 	this.activitystreamsIntransitiveActivityMember = nil
@@ -2529,6 +2472,7 @@ func (this *ActivityStreamsAttributedToPropertyIterator) clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.activitystreamsPropertyValueMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
 	this.activitystreamsRejectMember = nil
@@ -2545,13 +2489,145 @@ func (this *ActivityStreamsAttributedToPropertyIterator) clear() {
 	this.activitystreamsViewMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsAttributedToPropertyIterator) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLinkMember = v
+		} else if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsObjectMember = v
+		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAcceptMember = v
+		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsActivityMember = v
+		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAddMember = v
+		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAnnounceMember = v
+		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsApplicationMember = v
+		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsArriveMember = v
+		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsArticleMember = v
+		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAudioMember = v
+		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsBlockMember = v
+		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionPageMember = v
+		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCreateMember = v
+		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDeleteMember = v
+		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDislikeMember = v
+		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDocumentMember = v
+		} else if v, err := mgr.DeserializeEmojiActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsEmojiMember = v
+		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsEventMember = v
+		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsFlagMember = v
+		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsFollowMember = v
+		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsGroupMember = v
+		} else if v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsHashtagMember = v
+		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsIgnoreMember = v
+		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsImageMember = v
+		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsIntransitiveActivityMember = v
+		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsInviteMember = v
+		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsJoinMember = v
+		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLeaveMember = v
+		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLikeMember = v
+		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsListenMember = v
+		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsMentionMember = v
+		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsMoveMember = v
+		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsNoteMember = v
+		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOfferMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrganizationMember = v
+		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPageMember = v
+		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPersonMember = v
+		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPlaceMember = v
+		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsProfileMember = v
+		} else if v, err := mgr.DeserializePropertyValueActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPropertyValueMember = v
+		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsQuestionMember = v
+		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsReadMember = v
+		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRejectMember = v
+		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRelationshipMember = v
+		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRemoveMember = v
+		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsServiceMember = v
+		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTentativeAcceptMember = v
+		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTentativeRejectMember = v
+		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTombstoneMember = v
+		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTravelMember = v
+		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsUndoMember = v
+		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsUpdateMember = v
+		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsVideoMember = v
+		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsViewMember = v
+		} else {
+			this.unknown = m
+		}
+	})
 }
 
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsAttributedToPropertyIterator) serialize() (interface{}, error) {
+func (this *ActivityStreamsAttributedToPropertyIterator) serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink().Serialize()
 	} else if this.IsActivityStreamsObject() {
@@ -2586,6 +2662,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) serialize() (interface{}
 		return this.GetActivityStreamsDislike().Serialize()
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -2594,6 +2672,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) serialize() (interface{}
 		return this.GetActivityStreamsFollow().Serialize()
 	} else if this.IsActivityStreamsGroup() {
 		return this.GetActivityStreamsGroup().Serialize()
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Serialize()
 	} else if this.IsActivityStreamsIgnore() {
 		return this.GetActivityStreamsIgnore().Serialize()
 	} else if this.IsActivityStreamsImage() {
@@ -2632,6 +2712,8 @@ func (this ActivityStreamsAttributedToPropertyIterator) serialize() (interface{}
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().Serialize()
 	} else if this.IsActivityStreamsRead() {
@@ -2903,6 +2985,18 @@ func (this *ActivityStreamsAttributedToProperty) AppendActivityStreamsDocument(v
 	})
 }
 
+// AppendActivityStreamsEmoji appends a Emoji value to the back of a list of the
+// property "attributedTo". Invalidates iterators that are traversing using
+// Prev.
+func (this *ActivityStreamsAttributedToProperty) AppendActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.properties = append(this.properties, &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      this.Len(),
+		parent:                     this,
+	})
+}
+
 // AppendActivityStreamsEvent appends a Event value to the back of a list of the
 // property "attributedTo". Invalidates iterators that are traversing using
 // Prev.
@@ -2951,6 +3045,18 @@ func (this *ActivityStreamsAttributedToProperty) AppendActivityStreamsGroup(v vo
 	})
 }
 
+// AppendActivityStreamsHashtag appends a Hashtag value to the back of a list of
+// the property "attributedTo". Invalidates iterators that are traversing
+// using Prev.
+func (this *ActivityStreamsAttributedToProperty) AppendActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties = append(this.properties, &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        this.Len(),
+		parent:                       this,
+	})
+}
+
 // AppendActivityStreamsIgnore appends a Ignore value to the back of a list of the
 // property "attributedTo". Invalidates iterators that are traversing using
 // Prev.
@@ -3203,6 +3309,18 @@ func (this *ActivityStreamsAttributedToProperty) AppendActivityStreamsProfile(v
 	})
 }
 
+// AppendActivityStreamsPropertyValue appends a PropertyValue value to the back of
+// a list of the property "attributedTo". Invalidates iterators that are
+// traversing using Prev.
+func (this *ActivityStreamsAttributedToProperty) AppendActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.properties = append(this.properties, &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              this.Len(),
+		parent:                             this,
+	})
+}
+
 // AppendActivityStreamsQuestion appends a Question value to the back of a list of
 // the property "attributedTo". Invalidates iterators that are traversing
 // using Prev.
@@ -3428,6 +3546,19 @@ func (this ActivityStreamsAttributedToProperty) End() vocab.ActivityStreamsAttri
 	return nil
 }
 
+// ForEach calls fn with the index and iterator of each value, from front to back,
+// until fn returns false. Unlike the iterator's Next method, which copies the
+// iterator it is called on, it neither copies nor allocates per value, so
+// that it suits hot loops over long properties. The property must not be
+// modified by fn.
+func (this ActivityStreamsAttributedToProperty) ForEach(fn func(idx int, it vocab.ActivityStreamsAttributedToPropertyIterator) bool) {
+	for i, elem := range this.properties {
+		if !fn(i, elem) {
+			return
+		}
+	}
+}
+
 // InsertActivityStreamsAccept inserts a Accept value at the specified index for a
 // property "attributedTo". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -3683,6 +3814,23 @@ func (this *ActivityStreamsAttributedToProperty) InsertActivityStreamsDocument(i
 	}
 }
 
+// InsertActivityStreamsEmoji inserts a Emoji value at the specified index for a
+// property "attributedTo". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) InsertActivityStreamsEmoji(idx int, v vocab.ActivityStreamsEmoji) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsEvent inserts a Event value at the specified index for a
 // property "attributedTo". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -3751,6 +3899,23 @@ func (this *ActivityStreamsAttributedToProperty) InsertActivityStreamsGroup(idx
 	}
 }
 
+// InsertActivityStreamsHashtag inserts a Hashtag value at the specified index for
+// a property "attributedTo". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) InsertActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsIgnore inserts a Ignore value at the specified index for a
 // property "attributedTo". Existing elements at that index and higher are
 // shifted back once. Invalidates all iterators.
@@ -4109,6 +4274,23 @@ func (this *ActivityStreamsAttributedToProperty) InsertActivityStreamsProfile(id
 	}
 }
 
+// InsertActivityStreamsPropertyValue inserts a PropertyValue value at the
+// specified index for a property "attributedTo". Existing elements at that
+// index and higher are shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) InsertActivityStreamsPropertyValue(idx int, v vocab.ActivityStreamsPropertyValue) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              idx,
+		parent:                             this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // InsertActivityStreamsQuestion inserts a Question value at the specified index
 // for a property "attributedTo". Existing elements at that index and higher
 // are shifted back once. Invalidates all iterators.
@@ -4494,150 +4676,162 @@ func (this ActivityStreamsAttributedToProperty) Less(i, j int) bool {
 			rhs := this.properties[j].GetActivityStreamsDocument()
 			return lhs.LessThan(rhs)
 		} else if idx1 == 17 {
+			lhs := this.properties[i].GetActivityStreamsEmoji()
+			rhs := this.properties[j].GetActivityStreamsEmoji()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 18 {
 			lhs := this.properties[i].GetActivityStreamsEvent()
 			rhs := this.properties[j].GetActivityStreamsEvent()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 18 {
+		} else if idx1 == 19 {
 			lhs := this.properties[i].GetActivityStreamsFlag()
 			rhs := this.properties[j].GetActivityStreamsFlag()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 19 {
+		} else if idx1 == 20 {
 			lhs := this.properties[i].GetActivityStreamsFollow()
 			rhs := this.properties[j].GetActivityStreamsFollow()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 20 {
+		} else if idx1 == 21 {
 			lhs := this.properties[i].GetActivityStreamsGroup()
 			rhs := this.properties[j].GetActivityStreamsGroup()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 21 {
+		} else if idx1 == 22 {
+			lhs := this.properties[i].GetActivityStreamsHashtag()
+			rhs := this.properties[j].GetActivityStreamsHashtag()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 23 {
 			lhs := this.properties[i].GetActivityStreamsIgnore()
 			rhs := this.properties[j].GetActivityStreamsIgnore()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 22 {
+		} else if idx1 == 24 {
 			lhs := this.properties[i].GetActivityStreamsImage()
 			rhs := this.properties[j].GetActivityStreamsImage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 23 {
+		} else if idx1 == 25 {
 			lhs := this.properties[i].GetActivityStreamsIntransitiveActivity()
 			rhs := this.properties[j].GetActivityStreamsIntransitiveActivity()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 24 {
+		} else if idx1 == 26 {
 			lhs := this.properties[i].GetActivityStreamsInvite()
 			rhs := this.properties[j].GetActivityStreamsInvite()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 25 {
+		} else if idx1 == 27 {
 			lhs := this.properties[i].GetActivityStreamsJoin()
 			rhs := this.properties[j].GetActivityStreamsJoin()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 26 {
+		} else if idx1 == 28 {
 			lhs := this.properties[i].GetActivityStreamsLeave()
 			rhs := this.properties[j].GetActivityStreamsLeave()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 27 {
+		} else if idx1 == 29 {
 			lhs := this.properties[i].GetActivityStreamsLike()
 			rhs := this.properties[j].GetActivityStreamsLike()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 28 {
+		} else if idx1 == 30 {
 			lhs := this.properties[i].GetActivityStreamsListen()
 			rhs := this.properties[j].GetActivityStreamsListen()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 29 {
+		} else if idx1 == 31 {
 			lhs := this.properties[i].GetActivityStreamsMention()
 			rhs := this.properties[j].GetActivityStreamsMention()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 30 {
+		} else if idx1 == 32 {
 			lhs := this.properties[i].GetActivityStreamsMove()
 			rhs := this.properties[j].GetActivityStreamsMove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 31 {
+		} else if idx1 == 33 {
 			lhs := this.properties[i].GetActivityStreamsNote()
 			rhs := this.properties[j].GetActivityStreamsNote()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 32 {
+		} else if idx1 == 34 {
 			lhs := this.properties[i].GetActivityStreamsOffer()
 			rhs := this.properties[j].GetActivityStreamsOffer()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 33 {
+		} else if idx1 == 35 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollection()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollection()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 34 {
+		} else if idx1 == 36 {
 			lhs := this.properties[i].GetActivityStreamsOrderedCollectionPage()
 			rhs := this.properties[j].GetActivityStreamsOrderedCollectionPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 35 {
+		} else if idx1 == 37 {
 			lhs := this.properties[i].GetActivityStreamsOrganization()
 			rhs := this.properties[j].GetActivityStreamsOrganization()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 36 {
+		} else if idx1 == 38 {
 			lhs := this.properties[i].GetActivityStreamsPage()
 			rhs := this.properties[j].GetActivityStreamsPage()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 37 {
+		} else if idx1 == 39 {
 			lhs := this.properties[i].GetActivityStreamsPerson()
 			rhs := this.properties[j].GetActivityStreamsPerson()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 38 {
+		} else if idx1 == 40 {
 			lhs := this.properties[i].GetActivityStreamsPlace()
 			rhs := this.properties[j].GetActivityStreamsPlace()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 39 {
+		} else if idx1 == 41 {
 			lhs := this.properties[i].GetActivityStreamsProfile()
 			rhs := this.properties[j].GetActivityStreamsProfile()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 40 {
+		} else if idx1 == 42 {
+			lhs := this.properties[i].GetActivityStreamsPropertyValue()
+			rhs := this.properties[j].GetActivityStreamsPropertyValue()
+			return lhs.LessThan(rhs)
+		} else if idx1 == 43 {
 			lhs := this.properties[i].GetActivityStreamsQuestion()
 			rhs := this.properties[j].GetActivityStreamsQuestion()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 41 {
+		} else if idx1 == 44 {
 			lhs := this.properties[i].GetActivityStreamsRead()
 			rhs := this.properties[j].GetActivityStreamsRead()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 42 {
+		} else if idx1 == 45 {
 			lhs := this.properties[i].GetActivityStreamsReject()
 			rhs := this.properties[j].GetActivityStreamsReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 43 {
+		} else if idx1 == 46 {
 			lhs := this.properties[i].GetActivityStreamsRelationship()
 			rhs := this.properties[j].GetActivityStreamsRelationship()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 44 {
+		} else if idx1 == 47 {
 			lhs := this.properties[i].GetActivityStreamsRemove()
 			rhs := this.properties[j].GetActivityStreamsRemove()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 45 {
+		} else if idx1 == 48 {
 			lhs := this.properties[i].GetActivityStreamsService()
 			rhs := this.properties[j].GetActivityStreamsService()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 46 {
+		} else if idx1 == 49 {
 			lhs := this.properties[i].GetActivityStreamsTentativeAccept()
 			rhs := this.properties[j].GetActivityStreamsTentativeAccept()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 47 {
+		} else if idx1 == 50 {
 			lhs := this.properties[i].GetActivityStreamsTentativeReject()
 			rhs := this.properties[j].GetActivityStreamsTentativeReject()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 48 {
+		} else if idx1 == 51 {
 			lhs := this.properties[i].GetActivityStreamsTombstone()
 			rhs := this.properties[j].GetActivityStreamsTombstone()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 49 {
+		} else if idx1 == 52 {
 			lhs := this.properties[i].GetActivityStreamsTravel()
 			rhs := this.properties[j].GetActivityStreamsTravel()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 50 {
+		} else if idx1 == 53 {
 			lhs := this.properties[i].GetActivityStreamsUndo()
 			rhs := this.properties[j].GetActivityStreamsUndo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 51 {
+		} else if idx1 == 54 {
 			lhs := this.properties[i].GetActivityStreamsUpdate()
 			rhs := this.properties[j].GetActivityStreamsUpdate()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 52 {
+		} else if idx1 == 55 {
 			lhs := this.properties[i].GetActivityStreamsVideo()
 			rhs := this.properties[j].GetActivityStreamsVideo()
 			return lhs.LessThan(rhs)
-		} else if idx1 == 53 {
+		} else if idx1 == 56 {
 			lhs := this.properties[i].GetActivityStreamsView()
 			rhs := this.properties[j].GetActivityStreamsView()
 			return lhs.LessThan(rhs)
@@ -4886,6 +5080,20 @@ func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsDocument(
 	}
 }
 
+// PrependActivityStreamsEmoji prepends a Emoji value to the front of a list of
+// the property "attributedTo". Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.properties = append([]*ActivityStreamsAttributedToPropertyIterator{{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      0,
+		parent:                     this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsEvent prepends a Event value to the front of a list of
 // the property "attributedTo". Invalidates all iterators.
 func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
@@ -4942,6 +5150,20 @@ func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsGroup(v v
 	}
 }
 
+// PrependActivityStreamsHashtag prepends a Hashtag value to the front of a list
+// of the property "attributedTo". Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.properties = append([]*ActivityStreamsAttributedToPropertyIterator{{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        0,
+		parent:                       this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsIgnore prepends a Ignore value to the front of a list of
 // the property "attributedTo". Invalidates all iterators.
 func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsIgnore(v vocab.ActivityStreamsIgnore) {
@@ -5239,6 +5461,20 @@ func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsProfile(v
 	}
 }
 
+// PrependActivityStreamsPropertyValue prepends a PropertyValue value to the front
+// of a list of the property "attributedTo". Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.properties = append([]*ActivityStreamsAttributedToPropertyIterator{{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              0,
+		parent:                             this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
 // PrependActivityStreamsQuestion prepends a Question value to the front of a list
 // of the property "attributedTo". Invalidates all iterators.
 func (this *ActivityStreamsAttributedToProperty) PrependActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
@@ -5696,6 +5932,19 @@ func (this *ActivityStreamsAttributedToProperty) SetActivityStreamsDocument(idx
 	}
 }
 
+// SetActivityStreamsEmoji sets a Emoji value to be at the specified index for the
+// property "attributedTo". Panics if the index is out of bounds. Invalidates
+// all iterators.
+func (this *ActivityStreamsAttributedToProperty) SetActivityStreamsEmoji(idx int, v vocab.ActivityStreamsEmoji) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsEmojiMember: v,
+		alias:                      this.alias,
+		myIdx:                      idx,
+		parent:                     this,
+	}
+}
+
 // SetActivityStreamsEvent sets a Event value to be at the specified index for the
 // property "attributedTo". Panics if the index is out of bounds. Invalidates
 // all iterators.
@@ -5748,6 +5997,19 @@ func (this *ActivityStreamsAttributedToProperty) SetActivityStreamsGroup(idx int
 	}
 }
 
+// SetActivityStreamsHashtag sets a Hashtag value to be at the specified index for
+// the property "attributedTo". Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) SetActivityStreamsHashtag(idx int, v vocab.ActivityStreamsHashtag) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsHashtagMember: v,
+		alias:                        this.alias,
+		myIdx:                        idx,
+		parent:                       this,
+	}
+}
+
 // SetActivityStreamsIgnore sets a Ignore value to be at the specified index for
 // the property "attributedTo". Panics if the index is out of bounds.
 // Invalidates all iterators.
@@ -6021,6 +6283,19 @@ func (this *ActivityStreamsAttributedToProperty) SetActivityStreamsProfile(idx i
 	}
 }
 
+// SetActivityStreamsPropertyValue sets a PropertyValue value to be at the
+// specified index for the property "attributedTo". Panics if the index is out
+// of bounds. Invalidates all iterators.
+func (this *ActivityStreamsAttributedToProperty) SetActivityStreamsPropertyValue(idx int, v vocab.ActivityStreamsPropertyValue) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsAttributedToPropertyIterator{
+		activitystreamsPropertyValueMember: v,
+		alias:                              this.alias,
+		myIdx:                              idx,
+		parent:                             this,
+	}
+}
+
 // SetActivityStreamsQuestion sets a Question value to be at the specified index
 // for the property "attributedTo". Panics if the index is out of bounds.
 // Invalidates all iterators.
@@ -6232,6 +6507,21 @@ func (this *ActivityStreamsAttributedToProperty) SetType(idx int, t vocab.Type)
 	return nil
 }
 
+// String returns a compact, human-readable form of the values of this property
+// for logs and debugging, listing at most the first 3. It is not a
+// serialization.
+func (this ActivityStreamsAttributedToProperty) String() string {
+	s := make([]string, 0, len(this.properties))
+	for i, elem := range this.properties {
+		if i == 3 {
+			s = append(s, fmt.Sprintf("...+%d", len(this.properties)-3))
+			break
+		}
+		s = append(s, elem.String())
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
 // Swap swaps the location of values at two indices for the "attributedTo"
 // property.
 func (this ActivityStreamsAttributedToProperty) Swap(i, j int) {
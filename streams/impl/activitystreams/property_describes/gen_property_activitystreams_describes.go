@@ -4,13 +4,16 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"sync"
 )
 
 // ActivityStreamsDescribesProperty is the functional property "describes". It is
 // permitted to be one of multiple value types. At most, one type of value can
 // be present, or none at all. Setting a value will clear the other types of
 // values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsDescribesProperty struct {
 	activitystreamsObjectMember                vocab.ActivityStreamsObject
 	activitystreamsAcceptMember                vocab.ActivityStreamsAccept
@@ -28,6 +31,7 @@ type ActivityStreamsDescribesProperty struct {
 	activitystreamsDeleteMember                vocab.ActivityStreamsDelete
 	activitystreamsDislikeMember               vocab.ActivityStreamsDislike
 	activitystreamsDocumentMember              vocab.ActivityStreamsDocument
+	activitystreamsEmojiMember                 vocab.ActivityStreamsEmoji
 	activitystreamsEventMember                 vocab.ActivityStreamsEvent
 	activitystreamsFlagMember                  vocab.ActivityStreamsFlag
 	activitystreamsFollowMember                vocab.ActivityStreamsFollow
@@ -50,6 +54,7 @@ type ActivityStreamsDescribesProperty struct {
 	activitystreamsPersonMember                vocab.ActivityStreamsPerson
 	activitystreamsPlaceMember                 vocab.ActivityStreamsPlace
 	activitystreamsProfileMember               vocab.ActivityStreamsProfile
+	activitystreamsPropertyValueMember         vocab.ActivityStreamsPropertyValue
 	activitystreamsQuestionMember              vocab.ActivityStreamsQuestion
 	activitystreamsReadMember                  vocab.ActivityStreamsRead
 	activitystreamsRejectMember                vocab.ActivityStreamsReject
@@ -65,6 +70,9 @@ type ActivityStreamsDescribesProperty struct {
 	activitystreamsVideoMember                 vocab.ActivityStreamsVideo
 	activitystreamsViewMember                  vocab.ActivityStreamsView
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 }
@@ -97,319 +105,13 @@ func DeserializeDescribesProperty(m map[string]interface{}, aliasMap map[string]
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsObjectMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsAcceptMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsActivityMember: v,
-					alias:                         alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsAddMember: v,
-					alias:                    alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsAnnounceMember: v,
-					alias:                         alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsApplicationMember: v,
-					alias:                            alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsArriveMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsArticleMember: v,
-					alias:                        alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsAudioMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsBlockMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsCollectionMember: v,
-					alias:                           alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsCollectionPageMember: v,
-					alias:                               alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsCreateMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsDeleteMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsDislikeMember: v,
-					alias:                        alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsDocumentMember: v,
-					alias:                         alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsEventMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsFlagMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsFollowMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsGroupMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsIgnoreMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsImageMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsIntransitiveActivityMember: v,
-					alias: alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsInviteMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsJoinMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsLeaveMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsLikeMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsListenMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsMoveMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsNoteMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsOfferMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsOrderedCollectionMember: v,
-					alias:                                  alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsOrganizationMember: v,
-					alias:                             alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsPageMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsPersonMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsPlaceMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsProfileMember: v,
-					alias:                        alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsQuestionMember: v,
-					alias:                         alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsReadMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsRejectMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsRelationshipMember: v,
-					alias:                             alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsRemoveMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsServiceMember: v,
-					alias:                        alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsTentativeAcceptMember: v,
-					alias:                                alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsTentativeRejectMember: v,
-					alias:                                alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsTombstoneMember: v,
-					alias:                          alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsTravelMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsUndoMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsUpdateMember: v,
-					alias:                       alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsVideoMember: v,
-					alias:                      alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsDescribesProperty{
-					activitystreamsViewMember: v,
-					alias:                     alias,
-				}
-				return this, nil
+			// Defer deserializing the value until it is first accessed.
+			this := &ActivityStreamsDescribesProperty{
+				alias:       alias,
+				raw:         m,
+				rawAliasMap: aliasMap,
 			}
+			return this, nil
 		}
 		this := &ActivityStreamsDescribesProperty{
 			alias:   alias,
@@ -444,6 +146,7 @@ func (this *ActivityStreamsDescribesProperty) Clear() {
 	this.activitystreamsDeleteMember = nil
 	this.activitystreamsDislikeMember = nil
 	this.activitystreamsDocumentMember = nil
+	this.activitystreamsEmojiMember = nil
 	this.activitystreamsEventMember = nil
 	this.activitystreamsFlagMember = nil
 	this.activitystreamsFollowMember = nil
@@ -466,6 +169,7 @@ func (this *ActivityStreamsDescribesProperty) Clear() {
 	this.activitystreamsPersonMember = nil
 	this.activitystreamsPlaceMember = nil
 	this.activitystreamsProfileMember = nil
+	this.activitystreamsPropertyValueMember = nil
 	this.activitystreamsQuestionMember = nil
 	this.activitystreamsReadMember = nil
 	this.activitystreamsRejectMember = nil
@@ -482,381 +186,451 @@ func (this *ActivityStreamsDescribesProperty) Clear() {
 	this.activitystreamsViewMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
 }
 
 // GetActivityStreamsAccept returns the value of this property. When
 // IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsAccept() vocab.ActivityStreamsAccept {
+	this.resolve()
 	return this.activitystreamsAcceptMember
 }
 
 // GetActivityStreamsActivity returns the value of this property. When
 // IsActivityStreamsActivity returns false, GetActivityStreamsActivity will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsActivity() vocab.ActivityStreamsActivity {
+	this.resolve()
 	return this.activitystreamsActivityMember
 }
 
 // GetActivityStreamsAdd returns the value of this property. When
 // IsActivityStreamsAdd returns false, GetActivityStreamsAdd will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsAdd() vocab.ActivityStreamsAdd {
+	this.resolve()
 	return this.activitystreamsAddMember
 }
 
 // GetActivityStreamsAnnounce returns the value of this property. When
 // IsActivityStreamsAnnounce returns false, GetActivityStreamsAnnounce will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsAnnounce() vocab.ActivityStreamsAnnounce {
+	this.resolve()
 	return this.activitystreamsAnnounceMember
 }
 
 // GetActivityStreamsApplication returns the value of this property. When
 // IsActivityStreamsApplication returns false, GetActivityStreamsApplication
 // will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsApplication() vocab.ActivityStreamsApplication {
+	this.resolve()
 	return this.activitystreamsApplicationMember
 }
 
 // GetActivityStreamsArrive returns the value of this property. When
 // IsActivityStreamsArrive returns false, GetActivityStreamsArrive will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsArrive() vocab.ActivityStreamsArrive {
+	this.resolve()
 	return this.activitystreamsArriveMember
 }
 
 // GetActivityStreamsArticle returns the value of this property. When
 // IsActivityStreamsArticle returns false, GetActivityStreamsArticle will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsArticle() vocab.ActivityStreamsArticle {
+	this.resolve()
 	return this.activitystreamsArticleMember
 }
 
 // GetActivityStreamsAudio returns the value of this property. When
 // IsActivityStreamsAudio returns false, GetActivityStreamsAudio will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsAudio() vocab.ActivityStreamsAudio {
+	this.resolve()
 	return this.activitystreamsAudioMember
 }
 
 // GetActivityStreamsBlock returns the value of this property. When
 // IsActivityStreamsBlock returns false, GetActivityStreamsBlock will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsBlock() vocab.ActivityStreamsBlock {
+	this.resolve()
 	return this.activitystreamsBlockMember
 }
 
 // GetActivityStreamsCollection returns the value of this property. When
 // IsActivityStreamsCollection returns false, GetActivityStreamsCollection
 // will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	this.resolve()
 	return this.activitystreamsCollectionMember
 }
 
 // GetActivityStreamsCollectionPage returns the value of this property. When
 // IsActivityStreamsCollectionPage returns false,
 // GetActivityStreamsCollectionPage will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember
 }
 
 // GetActivityStreamsCreate returns the value of this property. When
 // IsActivityStreamsCreate returns false, GetActivityStreamsCreate will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsCreate() vocab.ActivityStreamsCreate {
+	this.resolve()
 	return this.activitystreamsCreateMember
 }
 
 // GetActivityStreamsDelete returns the value of this property. When
 // IsActivityStreamsDelete returns false, GetActivityStreamsDelete will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsDelete() vocab.ActivityStreamsDelete {
+	this.resolve()
 	return this.activitystreamsDeleteMember
 }
 
 // GetActivityStreamsDislike returns the value of this property. When
 // IsActivityStreamsDislike returns false, GetActivityStreamsDislike will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsDislike() vocab.ActivityStreamsDislike {
+	this.resolve()
 	return this.activitystreamsDislikeMember
 }
 
 // GetActivityStreamsDocument returns the value of this property. When
 // IsActivityStreamsDocument returns false, GetActivityStreamsDocument will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsDocument() vocab.ActivityStreamsDocument {
+	this.resolve()
 	return this.activitystreamsDocumentMember
 }
 
+// GetActivityStreamsEmoji returns the value of this property. When
+// IsActivityStreamsEmoji returns false, GetActivityStreamsEmoji will return
+// an arbitrary value.
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsEmoji() vocab.ActivityStreamsEmoji {
+	this.resolve()
+	return this.activitystreamsEmojiMember
+}
+
 // GetActivityStreamsEvent returns the value of this property. When
 // IsActivityStreamsEvent returns false, GetActivityStreamsEvent will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsEvent() vocab.ActivityStreamsEvent {
+	this.resolve()
 	return this.activitystreamsEventMember
 }
 
 // GetActivityStreamsFlag returns the value of this property. When
 // IsActivityStreamsFlag returns false, GetActivityStreamsFlag will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsFlag() vocab.ActivityStreamsFlag {
+	this.resolve()
 	return this.activitystreamsFlagMember
 }
 
 // GetActivityStreamsFollow returns the value of this property. When
 // IsActivityStreamsFollow returns false, GetActivityStreamsFollow will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsFollow() vocab.ActivityStreamsFollow {
+	this.resolve()
 	return this.activitystreamsFollowMember
 }
 
 // GetActivityStreamsGroup returns the value of this property. When
 // IsActivityStreamsGroup returns false, GetActivityStreamsGroup will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsGroup() vocab.ActivityStreamsGroup {
+	this.resolve()
 	return this.activitystreamsGroupMember
 }
 
 // GetActivityStreamsIgnore returns the value of this property. When
 // IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsIgnore() vocab.ActivityStreamsIgnore {
+	this.resolve()
 	return this.activitystreamsIgnoreMember
 }
 
 // GetActivityStreamsImage returns the value of this property. When
 // IsActivityStreamsImage returns false, GetActivityStreamsImage will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsImage() vocab.ActivityStreamsImage {
+	this.resolve()
 	return this.activitystreamsImageMember
 }
 
 // GetActivityStreamsIntransitiveActivity returns the value of this property. When
 // IsActivityStreamsIntransitiveActivity returns false,
 // GetActivityStreamsIntransitiveActivity will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsIntransitiveActivity() vocab.ActivityStreamsIntransitiveActivity {
+	this.resolve()
 	return this.activitystreamsIntransitiveActivityMember
 }
 
 // GetActivityStreamsInvite returns the value of this property. When
 // IsActivityStreamsInvite returns false, GetActivityStreamsInvite will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsInvite() vocab.ActivityStreamsInvite {
+	this.resolve()
 	return this.activitystreamsInviteMember
 }
 
 // GetActivityStreamsJoin returns the value of this property. When
 // IsActivityStreamsJoin returns false, GetActivityStreamsJoin will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsJoin() vocab.ActivityStreamsJoin {
+	this.resolve()
 	return this.activitystreamsJoinMember
 }
 
 // GetActivityStreamsLeave returns the value of this property. When
 // IsActivityStreamsLeave returns false, GetActivityStreamsLeave will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsLeave() vocab.ActivityStreamsLeave {
+	this.resolve()
 	return this.activitystreamsLeaveMember
 }
 
 // GetActivityStreamsLike returns the value of this property. When
 // IsActivityStreamsLike returns false, GetActivityStreamsLike will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsLike() vocab.ActivityStreamsLike {
+	this.resolve()
 	return this.activitystreamsLikeMember
 }
 
 // GetActivityStreamsListen returns the value of this property. When
 // IsActivityStreamsListen returns false, GetActivityStreamsListen will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsListen() vocab.ActivityStreamsListen {
+	this.resolve()
 	return this.activitystreamsListenMember
 }
 
 // GetActivityStreamsMove returns the value of this property. When
 // IsActivityStreamsMove returns false, GetActivityStreamsMove will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsMove() vocab.ActivityStreamsMove {
+	this.resolve()
 	return this.activitystreamsMoveMember
 }
 
 // GetActivityStreamsNote returns the value of this property. When
 // IsActivityStreamsNote returns false, GetActivityStreamsNote will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsNote() vocab.ActivityStreamsNote {
+	this.resolve()
 	return this.activitystreamsNoteMember
 }
 
 // GetActivityStreamsObject returns the value of this property. When
 // IsActivityStreamsObject returns false, GetActivityStreamsObject will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsObject() vocab.ActivityStreamsObject {
+	this.resolve()
 	return this.activitystreamsObjectMember
 }
 
 // GetActivityStreamsOffer returns the value of this property. When
 // IsActivityStreamsOffer returns false, GetActivityStreamsOffer will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsOffer() vocab.ActivityStreamsOffer {
+	this.resolve()
 	return this.activitystreamsOfferMember
 }
 
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetActivityStreamsOrganization returns the value of this property. When
 // IsActivityStreamsOrganization returns false, GetActivityStreamsOrganization
 // will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsOrganization() vocab.ActivityStreamsOrganization {
+	this.resolve()
 	return this.activitystreamsOrganizationMember
 }
 
 // GetActivityStreamsPage returns the value of this property. When
 // IsActivityStreamsPage returns false, GetActivityStreamsPage will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsPage() vocab.ActivityStreamsPage {
+	this.resolve()
 	return this.activitystreamsPageMember
 }
 
 // GetActivityStreamsPerson returns the value of this property. When
 // IsActivityStreamsPerson returns false, GetActivityStreamsPerson will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsPerson() vocab.ActivityStreamsPerson {
+	this.resolve()
 	return this.activitystreamsPersonMember
 }
 
 // GetActivityStreamsPlace returns the value of this property. When
 // IsActivityStreamsPlace returns false, GetActivityStreamsPlace will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsPlace() vocab.ActivityStreamsPlace {
+	this.resolve()
 	return this.activitystreamsPlaceMember
 }
 
 // GetActivityStreamsProfile returns the value of this property. When
 // IsActivityStreamsProfile returns false, GetActivityStreamsProfile will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsProfile() vocab.ActivityStreamsProfile {
+	this.resolve()
 	return this.activitystreamsProfileMember
 }
 
+// GetActivityStreamsPropertyValue returns the value of this property. When
+// IsActivityStreamsPropertyValue returns false,
+// GetActivityStreamsPropertyValue will return an arbitrary value.
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsPropertyValue() vocab.ActivityStreamsPropertyValue {
+	this.resolve()
+	return this.activitystreamsPropertyValueMember
+}
+
 // GetActivityStreamsQuestion returns the value of this property. When
 // IsActivityStreamsQuestion returns false, GetActivityStreamsQuestion will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsQuestion() vocab.ActivityStreamsQuestion {
+	this.resolve()
 	return this.activitystreamsQuestionMember
 }
 
 // GetActivityStreamsRead returns the value of this property. When
 // IsActivityStreamsRead returns false, GetActivityStreamsRead will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsRead() vocab.ActivityStreamsRead {
+	this.resolve()
 	return this.activitystreamsReadMember
 }
 
 // GetActivityStreamsReject returns the value of this property. When
 // IsActivityStreamsReject returns false, GetActivityStreamsReject will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsReject() vocab.ActivityStreamsReject {
+	this.resolve()
 	return this.activitystreamsRejectMember
 }
 
 // GetActivityStreamsRelationship returns the value of this property. When
 // IsActivityStreamsRelationship returns false, GetActivityStreamsRelationship
 // will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsRelationship() vocab.ActivityStreamsRelationship {
+	this.resolve()
 	return this.activitystreamsRelationshipMember
 }
 
 // GetActivityStreamsRemove returns the value of this property. When
 // IsActivityStreamsRemove returns false, GetActivityStreamsRemove will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsRemove() vocab.ActivityStreamsRemove {
+	this.resolve()
 	return this.activitystreamsRemoveMember
 }
 
 // GetActivityStreamsService returns the value of this property. When
 // IsActivityStreamsService returns false, GetActivityStreamsService will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsService() vocab.ActivityStreamsService {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsService() vocab.ActivityStreamsService {
+	this.resolve()
 	return this.activitystreamsServiceMember
 }
 
 // GetActivityStreamsTentativeAccept returns the value of this property. When
 // IsActivityStreamsTentativeAccept returns false,
 // GetActivityStreamsTentativeAccept will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsTentativeAccept() vocab.ActivityStreamsTentativeAccept {
+	this.resolve()
 	return this.activitystreamsTentativeAcceptMember
 }
 
 // GetActivityStreamsTentativeReject returns the value of this property. When
 // IsActivityStreamsTentativeReject returns false,
 // GetActivityStreamsTentativeReject will return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsTentativeReject() vocab.ActivityStreamsTentativeReject {
+	this.resolve()
 	return this.activitystreamsTentativeRejectMember
 }
 
 // GetActivityStreamsTombstone returns the value of this property. When
 // IsActivityStreamsTombstone returns false, GetActivityStreamsTombstone will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsTombstone() vocab.ActivityStreamsTombstone {
+	this.resolve()
 	return this.activitystreamsTombstoneMember
 }
 
 // GetActivityStreamsTravel returns the value of this property. When
 // IsActivityStreamsTravel returns false, GetActivityStreamsTravel will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsTravel() vocab.ActivityStreamsTravel {
+	this.resolve()
 	return this.activitystreamsTravelMember
 }
 
 // GetActivityStreamsUndo returns the value of this property. When
 // IsActivityStreamsUndo returns false, GetActivityStreamsUndo will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsUndo() vocab.ActivityStreamsUndo {
+	this.resolve()
 	return this.activitystreamsUndoMember
 }
 
 // GetActivityStreamsUpdate returns the value of this property. When
 // IsActivityStreamsUpdate returns false, GetActivityStreamsUpdate will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsUpdate() vocab.ActivityStreamsUpdate {
+	this.resolve()
 	return this.activitystreamsUpdateMember
 }
 
 // GetActivityStreamsVideo returns the value of this property. When
 // IsActivityStreamsVideo returns false, GetActivityStreamsVideo will return
 // an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsVideo() vocab.ActivityStreamsVideo {
+	this.resolve()
 	return this.activitystreamsVideoMember
 }
 
 // GetActivityStreamsView returns the value of this property. When
 // IsActivityStreamsView returns false, GetActivityStreamsView will return an
 // arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetActivityStreamsView() vocab.ActivityStreamsView {
+func (this *ActivityStreamsDescribesProperty) GetActivityStreamsView() vocab.ActivityStreamsView {
+	this.resolve()
 	return this.activitystreamsViewMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsDescribesProperty) GetIRI() *url.URL {
+func (this *ActivityStreamsDescribesProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsDescribesProperty) GetType() vocab.Type {
+func (this *ActivityStreamsDescribesProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsObject() {
 		return this.GetActivityStreamsObject()
 	}
@@ -905,6 +679,9 @@ func (this ActivityStreamsDescribesProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument()
 	}
+	if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji()
+	}
 	if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent()
 	}
@@ -971,6 +748,9 @@ func (this ActivityStreamsDescribesProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile()
 	}
+	if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue()
+	}
 	if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion()
 	}
@@ -1018,7 +798,7 @@ func (this ActivityStreamsDescribesProperty) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsDescribesProperty) HasAny() bool {
+func (this *ActivityStreamsDescribesProperty) HasAny() bool {
 	return this.IsActivityStreamsObject() ||
 		this.IsActivityStreamsAccept() ||
 		this.IsActivityStreamsActivity() ||
@@ -1035,6 +815,7 @@ func (this ActivityStreamsDescribesProperty) HasAny() bool {
 		this.IsActivityStreamsDelete() ||
 		this.IsActivityStreamsDislike() ||
 		this.IsActivityStreamsDocument() ||
+		this.IsActivityStreamsEmoji() ||
 		this.IsActivityStreamsEvent() ||
 		this.IsActivityStreamsFlag() ||
 		this.IsActivityStreamsFollow() ||
@@ -1057,6 +838,7 @@ func (this ActivityStreamsDescribesProperty) HasAny() bool {
 		this.IsActivityStreamsPerson() ||
 		this.IsActivityStreamsPlace() ||
 		this.IsActivityStreamsProfile() ||
+		this.IsActivityStreamsPropertyValue() ||
 		this.IsActivityStreamsQuestion() ||
 		this.IsActivityStreamsRead() ||
 		this.IsActivityStreamsReject() ||
@@ -1077,147 +859,176 @@ func (this ActivityStreamsDescribesProperty) HasAny() bool {
 // IsActivityStreamsAccept returns true if this property has a type of "Accept".
 // When true, use the GetActivityStreamsAccept and SetActivityStreamsAccept
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsAccept() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsAccept() bool {
+	this.resolve()
 	return this.activitystreamsAcceptMember != nil
 }
 
 // IsActivityStreamsActivity returns true if this property has a type of
 // "Activity". When true, use the GetActivityStreamsActivity and
 // SetActivityStreamsActivity methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsActivity() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsActivity() bool {
+	this.resolve()
 	return this.activitystreamsActivityMember != nil
 }
 
 // IsActivityStreamsAdd returns true if this property has a type of "Add". When
 // true, use the GetActivityStreamsAdd and SetActivityStreamsAdd methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsAdd() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsAdd() bool {
+	this.resolve()
 	return this.activitystreamsAddMember != nil
 }
 
 // IsActivityStreamsAnnounce returns true if this property has a type of
 // "Announce". When true, use the GetActivityStreamsAnnounce and
 // SetActivityStreamsAnnounce methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsAnnounce() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsAnnounce() bool {
+	this.resolve()
 	return this.activitystreamsAnnounceMember != nil
 }
 
 // IsActivityStreamsApplication returns true if this property has a type of
 // "Application". When true, use the GetActivityStreamsApplication and
 // SetActivityStreamsApplication methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsApplication() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsApplication() bool {
+	this.resolve()
 	return this.activitystreamsApplicationMember != nil
 }
 
 // IsActivityStreamsArrive returns true if this property has a type of "Arrive".
 // When true, use the GetActivityStreamsArrive and SetActivityStreamsArrive
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsArrive() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsArrive() bool {
+	this.resolve()
 	return this.activitystreamsArriveMember != nil
 }
 
 // IsActivityStreamsArticle returns true if this property has a type of "Article".
 // When true, use the GetActivityStreamsArticle and SetActivityStreamsArticle
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsArticle() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsArticle() bool {
+	this.resolve()
 	return this.activitystreamsArticleMember != nil
 }
 
 // IsActivityStreamsAudio returns true if this property has a type of "Audio".
 // When true, use the GetActivityStreamsAudio and SetActivityStreamsAudio
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsAudio() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsAudio() bool {
+	this.resolve()
 	return this.activitystreamsAudioMember != nil
 }
 
 // IsActivityStreamsBlock returns true if this property has a type of "Block".
 // When true, use the GetActivityStreamsBlock and SetActivityStreamsBlock
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsBlock() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsBlock() bool {
+	this.resolve()
 	return this.activitystreamsBlockMember != nil
 }
 
 // IsActivityStreamsCollection returns true if this property has a type of
 // "Collection". When true, use the GetActivityStreamsCollection and
 // SetActivityStreamsCollection methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsCollection() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsCollection() bool {
+	this.resolve()
 	return this.activitystreamsCollectionMember != nil
 }
 
 // IsActivityStreamsCollectionPage returns true if this property has a type of
 // "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
 // SetActivityStreamsCollectionPage methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsCollectionPage() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember != nil
 }
 
 // IsActivityStreamsCreate returns true if this property has a type of "Create".
 // When true, use the GetActivityStreamsCreate and SetActivityStreamsCreate
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsCreate() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsCreate() bool {
+	this.resolve()
 	return this.activitystreamsCreateMember != nil
 }
 
 // IsActivityStreamsDelete returns true if this property has a type of "Delete".
 // When true, use the GetActivityStreamsDelete and SetActivityStreamsDelete
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsDelete() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsDelete() bool {
+	this.resolve()
 	return this.activitystreamsDeleteMember != nil
 }
 
 // IsActivityStreamsDislike returns true if this property has a type of "Dislike".
 // When true, use the GetActivityStreamsDislike and SetActivityStreamsDislike
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsDislike() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsDislike() bool {
+	this.resolve()
 	return this.activitystreamsDislikeMember != nil
 }
 
 // IsActivityStreamsDocument returns true if this property has a type of
 // "Document". When true, use the GetActivityStreamsDocument and
 // SetActivityStreamsDocument methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsDocument() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsDocument() bool {
+	this.resolve()
 	return this.activitystreamsDocumentMember != nil
 }
 
+// IsActivityStreamsEmoji returns true if this property has a type of "Emoji".
+// When true, use the GetActivityStreamsEmoji and SetActivityStreamsEmoji
+// methods to access and set this property.
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsEmoji() bool {
+	this.resolve()
+	return this.activitystreamsEmojiMember != nil
+}
+
 // IsActivityStreamsEvent returns true if this property has a type of "Event".
 // When true, use the GetActivityStreamsEvent and SetActivityStreamsEvent
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsEvent() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsEvent() bool {
+	this.resolve()
 	return this.activitystreamsEventMember != nil
 }
 
 // IsActivityStreamsFlag returns true if this property has a type of "Flag". When
 // true, use the GetActivityStreamsFlag and SetActivityStreamsFlag methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsFlag() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsFlag() bool {
+	this.resolve()
 	return this.activitystreamsFlagMember != nil
 }
 
 // IsActivityStreamsFollow returns true if this property has a type of "Follow".
 // When true, use the GetActivityStreamsFollow and SetActivityStreamsFollow
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsFollow() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsFollow() bool {
+	this.resolve()
 	return this.activitystreamsFollowMember != nil
 }
 
 // IsActivityStreamsGroup returns true if this property has a type of "Group".
 // When true, use the GetActivityStreamsGroup and SetActivityStreamsGroup
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsGroup() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsGroup() bool {
+	this.resolve()
 	return this.activitystreamsGroupMember != nil
 }
 
 // IsActivityStreamsIgnore returns true if this property has a type of "Ignore".
 // When true, use the GetActivityStreamsIgnore and SetActivityStreamsIgnore
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsIgnore() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsIgnore() bool {
+	this.resolve()
 	return this.activitystreamsIgnoreMember != nil
 }
 
 // IsActivityStreamsImage returns true if this property has a type of "Image".
 // When true, use the GetActivityStreamsImage and SetActivityStreamsImage
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsImage() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsImage() bool {
+	this.resolve()
 	return this.activitystreamsImageMember != nil
 }
 
@@ -1226,70 +1037,80 @@ func (this ActivityStreamsDescribesProperty) IsActivityStreamsImage() bool {
 // GetActivityStreamsIntransitiveActivity and
 // SetActivityStreamsIntransitiveActivity methods to access and set this
 // property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsIntransitiveActivity() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsIntransitiveActivity() bool {
+	this.resolve()
 	return this.activitystreamsIntransitiveActivityMember != nil
 }
 
 // IsActivityStreamsInvite returns true if this property has a type of "Invite".
 // When true, use the GetActivityStreamsInvite and SetActivityStreamsInvite
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsInvite() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsInvite() bool {
+	this.resolve()
 	return this.activitystreamsInviteMember != nil
 }
 
 // IsActivityStreamsJoin returns true if this property has a type of "Join". When
 // true, use the GetActivityStreamsJoin and SetActivityStreamsJoin methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsJoin() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsJoin() bool {
+	this.resolve()
 	return this.activitystreamsJoinMember != nil
 }
 
 // IsActivityStreamsLeave returns true if this property has a type of "Leave".
 // When true, use the GetActivityStreamsLeave and SetActivityStreamsLeave
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsLeave() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsLeave() bool {
+	this.resolve()
 	return this.activitystreamsLeaveMember != nil
 }
 
 // IsActivityStreamsLike returns true if this property has a type of "Like". When
 // true, use the GetActivityStreamsLike and SetActivityStreamsLike methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsLike() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsLike() bool {
+	this.resolve()
 	return this.activitystreamsLikeMember != nil
 }
 
 // IsActivityStreamsListen returns true if this property has a type of "Listen".
 // When true, use the GetActivityStreamsListen and SetActivityStreamsListen
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsListen() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsListen() bool {
+	this.resolve()
 	return this.activitystreamsListenMember != nil
 }
 
 // IsActivityStreamsMove returns true if this property has a type of "Move". When
 // true, use the GetActivityStreamsMove and SetActivityStreamsMove methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsMove() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsMove() bool {
+	this.resolve()
 	return this.activitystreamsMoveMember != nil
 }
 
 // IsActivityStreamsNote returns true if this property has a type of "Note". When
 // true, use the GetActivityStreamsNote and SetActivityStreamsNote methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsNote() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsNote() bool {
+	this.resolve()
 	return this.activitystreamsNoteMember != nil
 }
 
 // IsActivityStreamsObject returns true if this property has a type of "Object".
 // When true, use the GetActivityStreamsObject and SetActivityStreamsObject
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsObject() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsObject() bool {
+	this.resolve()
 	return this.activitystreamsObjectMember != nil
 }
 
 // IsActivityStreamsOffer returns true if this property has a type of "Offer".
 // When true, use the GetActivityStreamsOffer and SetActivityStreamsOffer
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsOffer() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsOffer() bool {
+	this.resolve()
 	return this.activitystreamsOfferMember != nil
 }
 
@@ -1297,7 +1118,8 @@ func (this ActivityStreamsDescribesProperty) IsActivityStreamsOffer() bool {
 // "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
 // and SetActivityStreamsOrderedCollection methods to access and set this
 // property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsOrderedCollection() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsOrderedCollection() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember != nil
 }
 
@@ -1306,153 +1128,181 @@ func (this ActivityStreamsDescribesProperty) IsActivityStreamsOrderedCollection(
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsActivityStreamsOrganization returns true if this property has a type of
 // "Organization". When true, use the GetActivityStreamsOrganization and
 // SetActivityStreamsOrganization methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsOrganization() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsOrganization() bool {
+	this.resolve()
 	return this.activitystreamsOrganizationMember != nil
 }
 
 // IsActivityStreamsPage returns true if this property has a type of "Page". When
 // true, use the GetActivityStreamsPage and SetActivityStreamsPage methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsPage() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsPage() bool {
+	this.resolve()
 	return this.activitystreamsPageMember != nil
 }
 
 // IsActivityStreamsPerson returns true if this property has a type of "Person".
 // When true, use the GetActivityStreamsPerson and SetActivityStreamsPerson
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsPerson() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsPerson() bool {
+	this.resolve()
 	return this.activitystreamsPersonMember != nil
 }
 
 // IsActivityStreamsPlace returns true if this property has a type of "Place".
 // When true, use the GetActivityStreamsPlace and SetActivityStreamsPlace
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsPlace() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsPlace() bool {
+	this.resolve()
 	return this.activitystreamsPlaceMember != nil
 }
 
 // IsActivityStreamsProfile returns true if this property has a type of "Profile".
 // When true, use the GetActivityStreamsProfile and SetActivityStreamsProfile
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsProfile() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsProfile() bool {
+	this.resolve()
 	return this.activitystreamsProfileMember != nil
 }
 
+// IsActivityStreamsPropertyValue returns true if this property has a type of
+// "PropertyValue". When true, use the GetActivityStreamsPropertyValue and
+// SetActivityStreamsPropertyValue methods to access and set this property.
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsPropertyValue() bool {
+	this.resolve()
+	return this.activitystreamsPropertyValueMember != nil
+}
+
 // IsActivityStreamsQuestion returns true if this property has a type of
 // "Question". When true, use the GetActivityStreamsQuestion and
 // SetActivityStreamsQuestion methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsQuestion() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsQuestion() bool {
+	this.resolve()
 	return this.activitystreamsQuestionMember != nil
 }
 
 // IsActivityStreamsRead returns true if this property has a type of "Read". When
 // true, use the GetActivityStreamsRead and SetActivityStreamsRead methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsRead() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsRead() bool {
+	this.resolve()
 	return this.activitystreamsReadMember != nil
 }
 
 // IsActivityStreamsReject returns true if this property has a type of "Reject".
 // When true, use the GetActivityStreamsReject and SetActivityStreamsReject
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsReject() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsReject() bool {
+	this.resolve()
 	return this.activitystreamsRejectMember != nil
 }
 
 // IsActivityStreamsRelationship returns true if this property has a type of
 // "Relationship". When true, use the GetActivityStreamsRelationship and
 // SetActivityStreamsRelationship methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsRelationship() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsRelationship() bool {
+	this.resolve()
 	return this.activitystreamsRelationshipMember != nil
 }
 
 // IsActivityStreamsRemove returns true if this property has a type of "Remove".
 // When true, use the GetActivityStreamsRemove and SetActivityStreamsRemove
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsRemove() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsRemove() bool {
+	this.resolve()
 	return this.activitystreamsRemoveMember != nil
 }
 
 // IsActivityStreamsService returns true if this property has a type of "Service".
 // When true, use the GetActivityStreamsService and SetActivityStreamsService
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsService() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsService() bool {
+	this.resolve()
 	return this.activitystreamsServiceMember != nil
 }
 
 // IsActivityStreamsTentativeAccept returns true if this property has a type of
 // "TentativeAccept". When true, use the GetActivityStreamsTentativeAccept and
 // SetActivityStreamsTentativeAccept methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsTentativeAccept() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsTentativeAccept() bool {
+	this.resolve()
 	return this.activitystreamsTentativeAcceptMember != nil
 }
 
 // IsActivityStreamsTentativeReject returns true if this property has a type of
 // "TentativeReject". When true, use the GetActivityStreamsTentativeReject and
 // SetActivityStreamsTentativeReject methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsTentativeReject() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsTentativeReject() bool {
+	this.resolve()
 	return this.activitystreamsTentativeRejectMember != nil
 }
 
 // IsActivityStreamsTombstone returns true if this property has a type of
 // "Tombstone". When true, use the GetActivityStreamsTombstone and
 // SetActivityStreamsTombstone methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsTombstone() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsTombstone() bool {
+	this.resolve()
 	return this.activitystreamsTombstoneMember != nil
 }
 
 // IsActivityStreamsTravel returns true if this property has a type of "Travel".
 // When true, use the GetActivityStreamsTravel and SetActivityStreamsTravel
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsTravel() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsTravel() bool {
+	this.resolve()
 	return this.activitystreamsTravelMember != nil
 }
 
 // IsActivityStreamsUndo returns true if this property has a type of "Undo". When
 // true, use the GetActivityStreamsUndo and SetActivityStreamsUndo methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsUndo() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsUndo() bool {
+	this.resolve()
 	return this.activitystreamsUndoMember != nil
 }
 
 // IsActivityStreamsUpdate returns true if this property has a type of "Update".
 // When true, use the GetActivityStreamsUpdate and SetActivityStreamsUpdate
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsUpdate() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsUpdate() bool {
+	this.resolve()
 	return this.activitystreamsUpdateMember != nil
 }
 
 // IsActivityStreamsVideo returns true if this property has a type of "Video".
 // When true, use the GetActivityStreamsVideo and SetActivityStreamsVideo
 // methods to access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsVideo() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsVideo() bool {
+	this.resolve()
 	return this.activitystreamsVideoMember != nil
 }
 
 // IsActivityStreamsView returns true if this property has a type of "View". When
 // true, use the GetActivityStreamsView and SetActivityStreamsView methods to
 // access and set this property.
-func (this ActivityStreamsDescribesProperty) IsActivityStreamsView() bool {
+func (this *ActivityStreamsDescribesProperty) IsActivityStreamsView() bool {
+	this.resolve()
 	return this.activitystreamsViewMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsDescribesProperty) IsIRI() bool {
+func (this *ActivityStreamsDescribesProperty) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsDescribesProperty) JSONLDContext() map[string]string {
+func (this *ActivityStreamsDescribesProperty) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsObject() {
@@ -1487,6 +1337,8 @@ func (this ActivityStreamsDescribesProperty) JSONLDContext() map[string]string {
 		child = this.GetActivityStreamsDislike().JSONLDContext()
 	} else if this.IsActivityStreamsDocument() {
 		child = this.GetActivityStreamsDocument().JSONLDContext()
+	} else if this.IsActivityStreamsEmoji() {
+		child = this.GetActivityStreamsEmoji().JSONLDContext()
 	} else if this.IsActivityStreamsEvent() {
 		child = this.GetActivityStreamsEvent().JSONLDContext()
 	} else if this.IsActivityStreamsFlag() {
@@ -1531,6 +1383,8 @@ func (this ActivityStreamsDescribesProperty) JSONLDContext() map[string]string {
 		child = this.GetActivityStreamsPlace().JSONLDContext()
 	} else if this.IsActivityStreamsProfile() {
 		child = this.GetActivityStreamsProfile().JSONLDContext()
+	} else if this.IsActivityStreamsPropertyValue() {
+		child = this.GetActivityStreamsPropertyValue().JSONLDContext()
 	} else if this.IsActivityStreamsQuestion() {
 		child = this.GetActivityStreamsQuestion().JSONLDContext()
 	} else if this.IsActivityStreamsRead() {
@@ -1574,7 +1428,7 @@ func (this ActivityStreamsDescribesProperty) JSONLDContext() map[string]string {
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsDescribesProperty) KindIndex() int {
+func (this *ActivityStreamsDescribesProperty) KindIndex() int {
 	if this.IsActivityStreamsObject() {
 		return 0
 	}
@@ -1623,114 +1477,120 @@ func (this ActivityStreamsDescribesProperty) KindIndex() int {
 	if this.IsActivityStreamsDocument() {
 		return 15
 	}
-	if this.IsActivityStreamsEvent() {
+	if this.IsActivityStreamsEmoji() {
 		return 16
 	}
-	if this.IsActivityStreamsFlag() {
+	if this.IsActivityStreamsEvent() {
 		return 17
 	}
-	if this.IsActivityStreamsFollow() {
+	if this.IsActivityStreamsFlag() {
 		return 18
 	}
-	if this.IsActivityStreamsGroup() {
+	if this.IsActivityStreamsFollow() {
 		return 19
 	}
-	if this.IsActivityStreamsIgnore() {
+	if this.IsActivityStreamsGroup() {
 		return 20
 	}
-	if this.IsActivityStreamsImage() {
+	if this.IsActivityStreamsIgnore() {
 		return 21
 	}
-	if this.IsActivityStreamsIntransitiveActivity() {
+	if this.IsActivityStreamsImage() {
 		return 22
 	}
-	if this.IsActivityStreamsInvite() {
+	if this.IsActivityStreamsIntransitiveActivity() {
 		return 23
 	}
-	if this.IsActivityStreamsJoin() {
+	if this.IsActivityStreamsInvite() {
 		return 24
 	}
-	if this.IsActivityStreamsLeave() {
+	if this.IsActivityStreamsJoin() {
 		return 25
 	}
-	if this.IsActivityStreamsLike() {
+	if this.IsActivityStreamsLeave() {
 		return 26
 	}
-	if this.IsActivityStreamsListen() {
+	if this.IsActivityStreamsLike() {
 		return 27
 	}
-	if this.IsActivityStreamsMove() {
+	if this.IsActivityStreamsListen() {
 		return 28
 	}
-	if this.IsActivityStreamsNote() {
+	if this.IsActivityStreamsMove() {
 		return 29
 	}
-	if this.IsActivityStreamsOffer() {
+	if this.IsActivityStreamsNote() {
 		return 30
 	}
-	if this.IsActivityStreamsOrderedCollection() {
+	if this.IsActivityStreamsOffer() {
 		return 31
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsOrderedCollection() {
 		return 32
 	}
-	if this.IsActivityStreamsOrganization() {
+	if this.IsActivityStreamsOrderedCollectionPage() {
 		return 33
 	}
-	if this.IsActivityStreamsPage() {
+	if this.IsActivityStreamsOrganization() {
 		return 34
 	}
-	if this.IsActivityStreamsPerson() {
+	if this.IsActivityStreamsPage() {
 		return 35
 	}
-	if this.IsActivityStreamsPlace() {
+	if this.IsActivityStreamsPerson() {
 		return 36
 	}
-	if this.IsActivityStreamsProfile() {
+	if this.IsActivityStreamsPlace() {
 		return 37
 	}
-	if this.IsActivityStreamsQuestion() {
+	if this.IsActivityStreamsProfile() {
 		return 38
 	}
-	if this.IsActivityStreamsRead() {
+	if this.IsActivityStreamsPropertyValue() {
 		return 39
 	}
-	if this.IsActivityStreamsReject() {
+	if this.IsActivityStreamsQuestion() {
 		return 40
 	}
-	if this.IsActivityStreamsRelationship() {
+	if this.IsActivityStreamsRead() {
 		return 41
 	}
-	if this.IsActivityStreamsRemove() {
+	if this.IsActivityStreamsReject() {
 		return 42
 	}
-	if this.IsActivityStreamsService() {
+	if this.IsActivityStreamsRelationship() {
 		return 43
 	}
-	if this.IsActivityStreamsTentativeAccept() {
+	if this.IsActivityStreamsRemove() {
 		return 44
 	}
-	if this.IsActivityStreamsTentativeReject() {
+	if this.IsActivityStreamsService() {
 		return 45
 	}
-	if this.IsActivityStreamsTombstone() {
+	if this.IsActivityStreamsTentativeAccept() {
 		return 46
 	}
-	if this.IsActivityStreamsTravel() {
+	if this.IsActivityStreamsTentativeReject() {
 		return 47
 	}
-	if this.IsActivityStreamsUndo() {
+	if this.IsActivityStreamsTombstone() {
 		return 48
 	}
-	if this.IsActivityStreamsUpdate() {
+	if this.IsActivityStreamsTravel() {
 		return 49
 	}
-	if this.IsActivityStreamsVideo() {
+	if this.IsActivityStreamsUndo() {
 		return 50
 	}
-	if this.IsActivityStreamsView() {
+	if this.IsActivityStreamsUpdate() {
 		return 51
 	}
+	if this.IsActivityStreamsVideo() {
+		return 52
+	}
+	if this.IsActivityStreamsView() {
+		return 53
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -1741,7 +1601,7 @@ func (this ActivityStreamsDescribesProperty) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsDescribesProperty) LessThan(o vocab.ActivityStreamsDescribesProperty) bool {
+func (this *ActivityStreamsDescribesProperty) LessThan(o vocab.ActivityStreamsDescribesProperty) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -1780,6 +1640,8 @@ func (this ActivityStreamsDescribesProperty) LessThan(o vocab.ActivityStreamsDes
 		return this.GetActivityStreamsDislike().LessThan(o.GetActivityStreamsDislike())
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().LessThan(o.GetActivityStreamsDocument())
+	} else if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji().LessThan(o.GetActivityStreamsEmoji())
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().LessThan(o.GetActivityStreamsEvent())
 	} else if this.IsActivityStreamsFlag() {
@@ -1824,6 +1686,8 @@ func (this ActivityStreamsDescribesProperty) LessThan(o vocab.ActivityStreamsDes
 		return this.GetActivityStreamsPlace().LessThan(o.GetActivityStreamsPlace())
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().LessThan(o.GetActivityStreamsProfile())
+	} else if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue().LessThan(o.GetActivityStreamsPropertyValue())
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().LessThan(o.GetActivityStreamsQuestion())
 	} else if this.IsActivityStreamsRead() {
@@ -1859,7 +1723,7 @@ func (this ActivityStreamsDescribesProperty) LessThan(o vocab.ActivityStreamsDes
 }
 
 // Name returns the name of this property: "describes".
-func (this ActivityStreamsDescribesProperty) Name() string {
+func (this *ActivityStreamsDescribesProperty) Name() string {
 	return "describes"
 }
 
@@ -1867,7 +1731,8 @@ func (this ActivityStreamsDescribesProperty) Name() string {
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsDescribesProperty) Serialize() (interface{}, error) {
+func (this *ActivityStreamsDescribesProperty) Serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsObject() {
 		return this.GetActivityStreamsObject().Serialize()
 	} else if this.IsActivityStreamsAccept() {
@@ -1900,6 +1765,8 @@ func (this ActivityStreamsDescribesProperty) Serialize() (interface{}, error) {
 		return this.GetActivityStreamsDislike().Serialize()
 	} else if this.IsActivityStreamsDocument() {
 		return this.GetActivityStreamsDocument().Serialize()
+	} else if this.IsActivityStreamsEmoji() {
+		return this.GetActivityStreamsEmoji().Serialize()
 	} else if this.IsActivityStreamsEvent() {
 		return this.GetActivityStreamsEvent().Serialize()
 	} else if this.IsActivityStreamsFlag() {
@@ -1944,6 +1811,8 @@ func (this ActivityStreamsDescribesProperty) Serialize() (interface{}, error) {
 		return this.GetActivityStreamsPlace().Serialize()
 	} else if this.IsActivityStreamsProfile() {
 		return this.GetActivityStreamsProfile().Serialize()
+	} else if this.IsActivityStreamsPropertyValue() {
+		return this.GetActivityStreamsPropertyValue().Serialize()
 	} else if this.IsActivityStreamsQuestion() {
 		return this.GetActivityStreamsQuestion().Serialize()
 	} else if this.IsActivityStreamsRead() {
@@ -2083,6 +1952,13 @@ func (this *ActivityStreamsDescribesProperty) SetActivityStreamsDocument(v vocab
 	this.activitystreamsDocumentMember = v
 }
 
+// SetActivityStreamsEmoji sets the value of this property. Calling
+// IsActivityStreamsEmoji afterwards returns true.
+func (this *ActivityStreamsDescribesProperty) SetActivityStreamsEmoji(v vocab.ActivityStreamsEmoji) {
+	this.Clear()
+	this.activitystreamsEmojiMember = v
+}
+
 // SetActivityStreamsEvent sets the value of this property. Calling
 // IsActivityStreamsEvent afterwards returns true.
 func (this *ActivityStreamsDescribesProperty) SetActivityStreamsEvent(v vocab.ActivityStreamsEvent) {
@@ -2244,6 +2120,13 @@ func (this *ActivityStreamsDescribesProperty) SetActivityStreamsProfile(v vocab.
 	this.activitystreamsProfileMember = v
 }
 
+// SetActivityStreamsPropertyValue sets the value of this property. Calling
+// IsActivityStreamsPropertyValue afterwards returns true.
+func (this *ActivityStreamsDescribesProperty) SetActivityStreamsPropertyValue(v vocab.ActivityStreamsPropertyValue) {
+	this.Clear()
+	this.activitystreamsPropertyValueMember = v
+}
+
 // SetActivityStreamsQuestion sets the value of this property. Calling
 // IsActivityStreamsQuestion afterwards returns true.
 func (this *ActivityStreamsDescribesProperty) SetActivityStreamsQuestion(v vocab.ActivityStreamsQuestion) {
@@ -2415,6 +2298,10 @@ func (this *ActivityStreamsDescribesProperty) SetType(t vocab.Type) error {
 		this.SetActivityStreamsDocument(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsEmoji); ok {
+		this.SetActivityStreamsEmoji(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsEvent); ok {
 		this.SetActivityStreamsEvent(v)
 		return nil
@@ -2503,6 +2390,10 @@ func (this *ActivityStreamsDescribesProperty) SetType(t vocab.Type) error {
 		this.SetActivityStreamsProfile(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsPropertyValue); ok {
+		this.SetActivityStreamsPropertyValue(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsQuestion); ok {
 		this.SetActivityStreamsQuestion(v)
 		return nil
@@ -2562,3 +2453,153 @@ func (this *ActivityStreamsDescribesProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on describes property: %T", t)
 }
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsDescribesProperty) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.Serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsDescribesProperty) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeObjectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsObjectMember = v
+		} else if v, err := mgr.DeserializeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAcceptMember = v
+		} else if v, err := mgr.DeserializeActivityActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsActivityMember = v
+		} else if v, err := mgr.DeserializeAddActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAddMember = v
+		} else if v, err := mgr.DeserializeAnnounceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAnnounceMember = v
+		} else if v, err := mgr.DeserializeApplicationActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsApplicationMember = v
+		} else if v, err := mgr.DeserializeArriveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsArriveMember = v
+		} else if v, err := mgr.DeserializeArticleActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsArticleMember = v
+		} else if v, err := mgr.DeserializeAudioActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsAudioMember = v
+		} else if v, err := mgr.DeserializeBlockActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsBlockMember = v
+		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionPageMember = v
+		} else if v, err := mgr.DeserializeCreateActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCreateMember = v
+		} else if v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDeleteMember = v
+		} else if v, err := mgr.DeserializeDislikeActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDislikeMember = v
+		} else if v, err := mgr.DeserializeDocumentActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsDocumentMember = v
+		} else if v, err := mgr.DeserializeEmojiActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsEmojiMember = v
+		} else if v, err := mgr.DeserializeEventActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsEventMember = v
+		} else if v, err := mgr.DeserializeFlagActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsFlagMember = v
+		} else if v, err := mgr.DeserializeFollowActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsFollowMember = v
+		} else if v, err := mgr.DeserializeGroupActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsGroupMember = v
+		} else if v, err := mgr.DeserializeIgnoreActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsIgnoreMember = v
+		} else if v, err := mgr.DeserializeImageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsImageMember = v
+		} else if v, err := mgr.DeserializeIntransitiveActivityActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsIntransitiveActivityMember = v
+		} else if v, err := mgr.DeserializeInviteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsInviteMember = v
+		} else if v, err := mgr.DeserializeJoinActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsJoinMember = v
+		} else if v, err := mgr.DeserializeLeaveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLeaveMember = v
+		} else if v, err := mgr.DeserializeLikeActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLikeMember = v
+		} else if v, err := mgr.DeserializeListenActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsListenMember = v
+		} else if v, err := mgr.DeserializeMoveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsMoveMember = v
+		} else if v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsNoteMember = v
+		} else if v, err := mgr.DeserializeOfferActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOfferMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else if v, err := mgr.DeserializeOrganizationActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrganizationMember = v
+		} else if v, err := mgr.DeserializePageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPageMember = v
+		} else if v, err := mgr.DeserializePersonActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPersonMember = v
+		} else if v, err := mgr.DeserializePlaceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPlaceMember = v
+		} else if v, err := mgr.DeserializeProfileActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsProfileMember = v
+		} else if v, err := mgr.DeserializePropertyValueActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPropertyValueMember = v
+		} else if v, err := mgr.DeserializeQuestionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsQuestionMember = v
+		} else if v, err := mgr.DeserializeReadActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsReadMember = v
+		} else if v, err := mgr.DeserializeRejectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRejectMember = v
+		} else if v, err := mgr.DeserializeRelationshipActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRelationshipMember = v
+		} else if v, err := mgr.DeserializeRemoveActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsRemoveMember = v
+		} else if v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsServiceMember = v
+		} else if v, err := mgr.DeserializeTentativeAcceptActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTentativeAcceptMember = v
+		} else if v, err := mgr.DeserializeTentativeRejectActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTentativeRejectMember = v
+		} else if v, err := mgr.DeserializeTombstoneActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTombstoneMember = v
+		} else if v, err := mgr.DeserializeTravelActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsTravelMember = v
+		} else if v, err := mgr.DeserializeUndoActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsUndoMember = v
+		} else if v, err := mgr.DeserializeUpdateActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsUpdateMember = v
+		} else if v, err := mgr.DeserializeVideoActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsVideoMember = v
+		} else if v, err := mgr.DeserializeViewActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsViewMember = v
+		} else {
+			this.unknown = m
+		}
+	})
+}
@@ -4,19 +4,26 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"sync"
 )
 
 // ActivityStreamsFirstProperty is the functional property "first". It is
 // permitted to be one of multiple value types. At most, one type of value can
 // be present, or none at all. Setting a value will clear the other types of
 // values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsFirstProperty struct {
 	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
 	activitystreamsLinkMember                  vocab.ActivityStreamsLink
+	activitystreamsHashtagMember               vocab.ActivityStreamsHashtag
 	activitystreamsMentionMember               vocab.ActivityStreamsMention
 	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 }
@@ -49,31 +56,13 @@ func DeserializeFirstProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFirstProperty{
-					activitystreamsCollectionPageMember: v,
-					alias:                               alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFirstProperty{
-					activitystreamsLinkMember: v,
-					alias:                     alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFirstProperty{
-					activitystreamsMentionMember: v,
-					alias:                        alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFirstProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
-				}
-				return this, nil
+			// Defer deserializing the value until it is first accessed.
+			this := &ActivityStreamsFirstProperty{
+				alias:       alias,
+				raw:         m,
+				rawAliasMap: aliasMap,
 			}
+			return this, nil
 		}
 		this := &ActivityStreamsFirstProperty{
 			alias:   alias,
@@ -94,55 +83,73 @@ func NewActivityStreamsFirstProperty() *ActivityStreamsFirstProperty {
 func (this *ActivityStreamsFirstProperty) Clear() {
 	this.activitystreamsCollectionPageMember = nil
 	this.activitystreamsLinkMember = nil
+	this.activitystreamsHashtagMember = nil
 	this.activitystreamsMentionMember = nil
 	this.activitystreamsOrderedCollectionPageMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
 }
 
 // GetActivityStreamsCollectionPage returns the value of this property. When
 // IsActivityStreamsCollectionPage returns false,
 // GetActivityStreamsCollectionPage will return an arbitrary value.
-func (this ActivityStreamsFirstProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+func (this *ActivityStreamsFirstProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember
 }
 
+// GetActivityStreamsHashtag returns the value of this property. When
+// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag will
+// return an arbitrary value.
+func (this *ActivityStreamsFirstProperty) GetActivityStreamsHashtag() vocab.ActivityStreamsHashtag {
+	this.resolve()
+	return this.activitystreamsHashtagMember
+}
+
 // GetActivityStreamsLink returns the value of this property. When
 // IsActivityStreamsLink returns false, GetActivityStreamsLink will return an
 // arbitrary value.
-func (this ActivityStreamsFirstProperty) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+func (this *ActivityStreamsFirstProperty) GetActivityStreamsLink() vocab.ActivityStreamsLink {
+	this.resolve()
 	return this.activitystreamsLinkMember
 }
 
 // GetActivityStreamsMention returns the value of this property. When
 // IsActivityStreamsMention returns false, GetActivityStreamsMention will
 // return an arbitrary value.
-func (this ActivityStreamsFirstProperty) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+func (this *ActivityStreamsFirstProperty) GetActivityStreamsMention() vocab.ActivityStreamsMention {
+	this.resolve()
 	return this.activitystreamsMentionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsFirstProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsFirstProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsFirstProperty) GetIRI() *url.URL {
+func (this *ActivityStreamsFirstProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsFirstProperty) GetType() vocab.Type {
+func (this *ActivityStreamsFirstProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage()
 	}
 	if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink()
 	}
+	if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag()
+	}
 	if this.IsActivityStreamsMention() {
 		return this.GetActivityStreamsMention()
 	}
@@ -154,9 +161,10 @@ func (this ActivityStreamsFirstProperty) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsFirstProperty) HasAny() bool {
+func (this *ActivityStreamsFirstProperty) HasAny() bool {
 	return this.IsActivityStreamsCollectionPage() ||
 		this.IsActivityStreamsLink() ||
+		this.IsActivityStreamsHashtag() ||
 		this.IsActivityStreamsMention() ||
 		this.IsActivityStreamsOrderedCollectionPage() ||
 		this.iri != nil
@@ -165,21 +173,32 @@ func (this ActivityStreamsFirstProperty) HasAny() bool {
 // IsActivityStreamsCollectionPage returns true if this property has a type of
 // "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
 // SetActivityStreamsCollectionPage methods to access and set this property.
-func (this ActivityStreamsFirstProperty) IsActivityStreamsCollectionPage() bool {
+func (this *ActivityStreamsFirstProperty) IsActivityStreamsCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember != nil
 }
 
+// IsActivityStreamsHashtag returns true if this property has a type of "Hashtag".
+// When true, use the GetActivityStreamsHashtag and SetActivityStreamsHashtag
+// methods to access and set this property.
+func (this *ActivityStreamsFirstProperty) IsActivityStreamsHashtag() bool {
+	this.resolve()
+	return this.activitystreamsHashtagMember != nil
+}
+
 // IsActivityStreamsLink returns true if this property has a type of "Link". When
 // true, use the GetActivityStreamsLink and SetActivityStreamsLink methods to
 // access and set this property.
-func (this ActivityStreamsFirstProperty) IsActivityStreamsLink() bool {
+func (this *ActivityStreamsFirstProperty) IsActivityStreamsLink() bool {
+	this.resolve()
 	return this.activitystreamsLinkMember != nil
 }
 
 // IsActivityStreamsMention returns true if this property has a type of "Mention".
 // When true, use the GetActivityStreamsMention and SetActivityStreamsMention
 // methods to access and set this property.
-func (this ActivityStreamsFirstProperty) IsActivityStreamsMention() bool {
+func (this *ActivityStreamsFirstProperty) IsActivityStreamsMention() bool {
+	this.resolve()
 	return this.activitystreamsMentionMember != nil
 }
 
@@ -188,26 +207,29 @@ func (this ActivityStreamsFirstProperty) IsActivityStreamsMention() bool {
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsFirstProperty) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsFirstProperty) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsFirstProperty) IsIRI() bool {
+func (this *ActivityStreamsFirstProperty) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsFirstProperty) JSONLDContext() map[string]string {
+func (this *ActivityStreamsFirstProperty) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsCollectionPage() {
 		child = this.GetActivityStreamsCollectionPage().JSONLDContext()
 	} else if this.IsActivityStreamsLink() {
 		child = this.GetActivityStreamsLink().JSONLDContext()
+	} else if this.IsActivityStreamsHashtag() {
+		child = this.GetActivityStreamsHashtag().JSONLDContext()
 	} else if this.IsActivityStreamsMention() {
 		child = this.GetActivityStreamsMention().JSONLDContext()
 	} else if this.IsActivityStreamsOrderedCollectionPage() {
@@ -227,19 +249,22 @@ func (this ActivityStreamsFirstProperty) JSONLDContext() map[string]string {
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsFirstProperty) KindIndex() int {
+func (this *ActivityStreamsFirstProperty) KindIndex() int {
 	if this.IsActivityStreamsCollectionPage() {
 		return 0
 	}
 	if this.IsActivityStreamsLink() {
 		return 1
 	}
-	if this.IsActivityStreamsMention() {
+	if this.IsActivityStreamsHashtag() {
 		return 2
 	}
-	if this.IsActivityStreamsOrderedCollectionPage() {
+	if this.IsActivityStreamsMention() {
 		return 3
 	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return 4
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -250,7 +275,7 @@ func (this ActivityStreamsFirstProperty) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsFirstProperty) LessThan(o vocab.ActivityStreamsFirstProperty) bool {
+func (this *ActivityStreamsFirstProperty) LessThan(o vocab.ActivityStreamsFirstProperty) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -261,6 +286,8 @@ func (this ActivityStreamsFirstProperty) LessThan(o vocab.ActivityStreamsFirstPr
 		return this.GetActivityStreamsCollectionPage().LessThan(o.GetActivityStreamsCollectionPage())
 	} else if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink().LessThan(o.GetActivityStreamsLink())
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().LessThan(o.GetActivityStreamsHashtag())
 	} else if this.IsActivityStreamsMention() {
 		return this.GetActivityStreamsMention().LessThan(o.GetActivityStreamsMention())
 	} else if this.IsActivityStreamsOrderedCollectionPage() {
@@ -272,7 +299,7 @@ func (this ActivityStreamsFirstProperty) LessThan(o vocab.ActivityStreamsFirstPr
 }
 
 // Name returns the name of this property: "first".
-func (this ActivityStreamsFirstProperty) Name() string {
+func (this *ActivityStreamsFirstProperty) Name() string {
 	return "first"
 }
 
@@ -280,11 +307,14 @@ func (this ActivityStreamsFirstProperty) Name() string {
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsFirstProperty) Serialize() (interface{}, error) {
+func (this *ActivityStreamsFirstProperty) Serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsCollectionPage() {
 		return this.GetActivityStreamsCollectionPage().Serialize()
 	} else if this.IsActivityStreamsLink() {
 		return this.GetActivityStreamsLink().Serialize()
+	} else if this.IsActivityStreamsHashtag() {
+		return this.GetActivityStreamsHashtag().Serialize()
 	} else if this.IsActivityStreamsMention() {
 		return this.GetActivityStreamsMention().Serialize()
 	} else if this.IsActivityStreamsOrderedCollectionPage() {
@@ -302,6 +332,13 @@ func (this *ActivityStreamsFirstProperty) SetActivityStreamsCollectionPage(v voc
 	this.activitystreamsCollectionPageMember = v
 }
 
+// SetActivityStreamsHashtag sets the value of this property. Calling
+// IsActivityStreamsHashtag afterwards returns true.
+func (this *ActivityStreamsFirstProperty) SetActivityStreamsHashtag(v vocab.ActivityStreamsHashtag) {
+	this.Clear()
+	this.activitystreamsHashtagMember = v
+}
+
 // SetActivityStreamsLink sets the value of this property. Calling
 // IsActivityStreamsLink afterwards returns true.
 func (this *ActivityStreamsFirstProperty) SetActivityStreamsLink(v vocab.ActivityStreamsLink) {
@@ -340,6 +377,10 @@ func (this *ActivityStreamsFirstProperty) SetType(t vocab.Type) error {
 		this.SetActivityStreamsLink(v)
 		return nil
 	}
+	if v, ok := t.(vocab.ActivityStreamsHashtag); ok {
+		this.SetActivityStreamsHashtag(v)
+		return nil
+	}
 	if v, ok := t.(vocab.ActivityStreamsMention); ok {
 		this.SetActivityStreamsMention(v)
 		return nil
@@ -351,3 +392,55 @@ func (this *ActivityStreamsFirstProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on first property: %T", t)
 }
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsFirstProperty) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.Serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsFirstProperty) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionPageMember = v
+		} else if v, err := mgr.DeserializeLinkActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsLinkMember = v
+		} else if v, err := mgr.DeserializeHashtagActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsHashtagMember = v
+		} else if v, err := mgr.DeserializeMentionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsMentionMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else {
+			this.unknown = m
+		}
+	})
+}
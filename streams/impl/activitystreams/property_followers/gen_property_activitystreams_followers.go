@@ -4,19 +4,25 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"sync"
 )
 
 // ActivityStreamsFollowersProperty is the functional property "followers". It is
 // permitted to be one of multiple value types. At most, one type of value can
 // be present, or none at all. Setting a value will clear the other types of
 // values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsFollowersProperty struct {
 	activitystreamsOrderedCollectionMember     vocab.ActivityStreamsOrderedCollection
 	activitystreamsCollectionMember            vocab.ActivityStreamsCollection
 	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
 	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 }
@@ -49,31 +55,13 @@ func DeserializeFollowersProperty(m map[string]interface{}, aliasMap map[string]
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowersProperty{
-					activitystreamsOrderedCollectionMember: v,
-					alias:                                  alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowersProperty{
-					activitystreamsCollectionMember: v,
-					alias:                           alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowersProperty{
-					activitystreamsCollectionPageMember: v,
-					alias:                               alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowersProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
-				}
-				return this, nil
+			// Defer deserializing the value until it is first accessed.
+			this := &ActivityStreamsFollowersProperty{
+				alias:       alias,
+				raw:         m,
+				rawAliasMap: aliasMap,
 			}
+			return this, nil
 		}
 		this := &ActivityStreamsFollowersProperty{
 			alias:   alias,
@@ -98,45 +86,51 @@ func (this *ActivityStreamsFollowersProperty) Clear() {
 	this.activitystreamsOrderedCollectionPageMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
 }
 
 // GetActivityStreamsCollection returns the value of this property. When
 // IsActivityStreamsCollection returns false, GetActivityStreamsCollection
 // will return an arbitrary value.
-func (this ActivityStreamsFollowersProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+func (this *ActivityStreamsFollowersProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	this.resolve()
 	return this.activitystreamsCollectionMember
 }
 
 // GetActivityStreamsCollectionPage returns the value of this property. When
 // IsActivityStreamsCollectionPage returns false,
 // GetActivityStreamsCollectionPage will return an arbitrary value.
-func (this ActivityStreamsFollowersProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+func (this *ActivityStreamsFollowersProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember
 }
 
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
-func (this ActivityStreamsFollowersProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+func (this *ActivityStreamsFollowersProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsFollowersProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsFollowersProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsFollowersProperty) GetIRI() *url.URL {
+func (this *ActivityStreamsFollowersProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsFollowersProperty) GetType() vocab.Type {
+func (this *ActivityStreamsFollowersProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsOrderedCollection() {
 		return this.GetActivityStreamsOrderedCollection()
 	}
@@ -154,7 +148,7 @@ func (this ActivityStreamsFollowersProperty) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsFollowersProperty) HasAny() bool {
+func (this *ActivityStreamsFollowersProperty) HasAny() bool {
 	return this.IsActivityStreamsOrderedCollection() ||
 		this.IsActivityStreamsCollection() ||
 		this.IsActivityStreamsCollectionPage() ||
@@ -165,14 +159,16 @@ func (this ActivityStreamsFollowersProperty) HasAny() bool {
 // IsActivityStreamsCollection returns true if this property has a type of
 // "Collection". When true, use the GetActivityStreamsCollection and
 // SetActivityStreamsCollection methods to access and set this property.
-func (this ActivityStreamsFollowersProperty) IsActivityStreamsCollection() bool {
+func (this *ActivityStreamsFollowersProperty) IsActivityStreamsCollection() bool {
+	this.resolve()
 	return this.activitystreamsCollectionMember != nil
 }
 
 // IsActivityStreamsCollectionPage returns true if this property has a type of
 // "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
 // SetActivityStreamsCollectionPage methods to access and set this property.
-func (this ActivityStreamsFollowersProperty) IsActivityStreamsCollectionPage() bool {
+func (this *ActivityStreamsFollowersProperty) IsActivityStreamsCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember != nil
 }
 
@@ -180,7 +176,8 @@ func (this ActivityStreamsFollowersProperty) IsActivityStreamsCollectionPage() b
 // "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
 // and SetActivityStreamsOrderedCollection methods to access and set this
 // property.
-func (this ActivityStreamsFollowersProperty) IsActivityStreamsOrderedCollection() bool {
+func (this *ActivityStreamsFollowersProperty) IsActivityStreamsOrderedCollection() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember != nil
 }
 
@@ -189,20 +186,21 @@ func (this ActivityStreamsFollowersProperty) IsActivityStreamsOrderedCollection(
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsFollowersProperty) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsFollowersProperty) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsFollowersProperty) IsIRI() bool {
+func (this *ActivityStreamsFollowersProperty) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsFollowersProperty) JSONLDContext() map[string]string {
+func (this *ActivityStreamsFollowersProperty) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsOrderedCollection() {
@@ -228,7 +226,7 @@ func (this ActivityStreamsFollowersProperty) JSONLDContext() map[string]string {
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsFollowersProperty) KindIndex() int {
+func (this *ActivityStreamsFollowersProperty) KindIndex() int {
 	if this.IsActivityStreamsOrderedCollection() {
 		return 0
 	}
@@ -251,7 +249,7 @@ func (this ActivityStreamsFollowersProperty) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsFollowersProperty) LessThan(o vocab.ActivityStreamsFollowersProperty) bool {
+func (this *ActivityStreamsFollowersProperty) LessThan(o vocab.ActivityStreamsFollowersProperty) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -273,7 +271,7 @@ func (this ActivityStreamsFollowersProperty) LessThan(o vocab.ActivityStreamsFol
 }
 
 // Name returns the name of this property: "followers".
-func (this ActivityStreamsFollowersProperty) Name() string {
+func (this *ActivityStreamsFollowersProperty) Name() string {
 	return "followers"
 }
 
@@ -281,7 +279,8 @@ func (this ActivityStreamsFollowersProperty) Name() string {
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsFollowersProperty) Serialize() (interface{}, error) {
+func (this *ActivityStreamsFollowersProperty) Serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsOrderedCollection() {
 		return this.GetActivityStreamsOrderedCollection().Serialize()
 	} else if this.IsActivityStreamsCollection() {
@@ -352,3 +351,53 @@ func (this *ActivityStreamsFollowersProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on followers property: %T", t)
 }
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsFollowersProperty) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.Serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsFollowersProperty) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionPageMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else {
+			this.unknown = m
+		}
+	})
+}
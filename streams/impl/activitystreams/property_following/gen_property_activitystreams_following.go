@@ -4,19 +4,25 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"sync"
 )
 
 // ActivityStreamsFollowingProperty is the functional property "following". It is
 // permitted to be one of multiple value types. At most, one type of value can
 // be present, or none at all. Setting a value will clear the other types of
 // values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsFollowingProperty struct {
 	activitystreamsOrderedCollectionMember     vocab.ActivityStreamsOrderedCollection
 	activitystreamsCollectionMember            vocab.ActivityStreamsCollection
 	activitystreamsCollectionPageMember        vocab.ActivityStreamsCollectionPage
 	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 }
@@ -49,31 +55,13 @@ func DeserializeFollowingProperty(m map[string]interface{}, aliasMap map[string]
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowingProperty{
-					activitystreamsOrderedCollectionMember: v,
-					alias:                                  alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowingProperty{
-					activitystreamsCollectionMember: v,
-					alias:                           alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowingProperty{
-					activitystreamsCollectionPageMember: v,
-					alias:                               alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsFollowingProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
-				}
-				return this, nil
+			// Defer deserializing the value until it is first accessed.
+			this := &ActivityStreamsFollowingProperty{
+				alias:       alias,
+				raw:         m,
+				rawAliasMap: aliasMap,
 			}
+			return this, nil
 		}
 		this := &ActivityStreamsFollowingProperty{
 			alias:   alias,
@@ -98,45 +86,51 @@ func (this *ActivityStreamsFollowingProperty) Clear() {
 	this.activitystreamsOrderedCollectionPageMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
 }
 
 // GetActivityStreamsCollection returns the value of this property. When
 // IsActivityStreamsCollection returns false, GetActivityStreamsCollection
 // will return an arbitrary value.
-func (this ActivityStreamsFollowingProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+func (this *ActivityStreamsFollowingProperty) GetActivityStreamsCollection() vocab.ActivityStreamsCollection {
+	this.resolve()
 	return this.activitystreamsCollectionMember
 }
 
 // GetActivityStreamsCollectionPage returns the value of this property. When
 // IsActivityStreamsCollectionPage returns false,
 // GetActivityStreamsCollectionPage will return an arbitrary value.
-func (this ActivityStreamsFollowingProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+func (this *ActivityStreamsFollowingProperty) GetActivityStreamsCollectionPage() vocab.ActivityStreamsCollectionPage {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember
 }
 
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
-func (this ActivityStreamsFollowingProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+func (this *ActivityStreamsFollowingProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsFollowingProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsFollowingProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsFollowingProperty) GetIRI() *url.URL {
+func (this *ActivityStreamsFollowingProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsFollowingProperty) GetType() vocab.Type {
+func (this *ActivityStreamsFollowingProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsOrderedCollection() {
 		return this.GetActivityStreamsOrderedCollection()
 	}
@@ -154,7 +148,7 @@ func (this ActivityStreamsFollowingProperty) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsFollowingProperty) HasAny() bool {
+func (this *ActivityStreamsFollowingProperty) HasAny() bool {
 	return this.IsActivityStreamsOrderedCollection() ||
 		this.IsActivityStreamsCollection() ||
 		this.IsActivityStreamsCollectionPage() ||
@@ -165,14 +159,16 @@ func (this ActivityStreamsFollowingProperty) HasAny() bool {
 // IsActivityStreamsCollection returns true if this property has a type of
 // "Collection". When true, use the GetActivityStreamsCollection and
 // SetActivityStreamsCollection methods to access and set this property.
-func (this ActivityStreamsFollowingProperty) IsActivityStreamsCollection() bool {
+func (this *ActivityStreamsFollowingProperty) IsActivityStreamsCollection() bool {
+	this.resolve()
 	return this.activitystreamsCollectionMember != nil
 }
 
 // IsActivityStreamsCollectionPage returns true if this property has a type of
 // "CollectionPage". When true, use the GetActivityStreamsCollectionPage and
 // SetActivityStreamsCollectionPage methods to access and set this property.
-func (this ActivityStreamsFollowingProperty) IsActivityStreamsCollectionPage() bool {
+func (this *ActivityStreamsFollowingProperty) IsActivityStreamsCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsCollectionPageMember != nil
 }
 
@@ -180,7 +176,8 @@ func (this ActivityStreamsFollowingProperty) IsActivityStreamsCollectionPage() b
 // "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
 // and SetActivityStreamsOrderedCollection methods to access and set this
 // property.
-func (this ActivityStreamsFollowingProperty) IsActivityStreamsOrderedCollection() bool {
+func (this *ActivityStreamsFollowingProperty) IsActivityStreamsOrderedCollection() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember != nil
 }
 
@@ -189,20 +186,21 @@ func (this ActivityStreamsFollowingProperty) IsActivityStreamsOrderedCollection(
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsFollowingProperty) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsFollowingProperty) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsFollowingProperty) IsIRI() bool {
+func (this *ActivityStreamsFollowingProperty) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsFollowingProperty) JSONLDContext() map[string]string {
+func (this *ActivityStreamsFollowingProperty) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsOrderedCollection() {
@@ -228,7 +226,7 @@ func (this ActivityStreamsFollowingProperty) JSONLDContext() map[string]string {
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsFollowingProperty) KindIndex() int {
+func (this *ActivityStreamsFollowingProperty) KindIndex() int {
 	if this.IsActivityStreamsOrderedCollection() {
 		return 0
 	}
@@ -251,7 +249,7 @@ func (this ActivityStreamsFollowingProperty) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsFollowingProperty) LessThan(o vocab.ActivityStreamsFollowingProperty) bool {
+func (this *ActivityStreamsFollowingProperty) LessThan(o vocab.ActivityStreamsFollowingProperty) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -273,7 +271,7 @@ func (this ActivityStreamsFollowingProperty) LessThan(o vocab.ActivityStreamsFol
 }
 
 // Name returns the name of this property: "following".
-func (this ActivityStreamsFollowingProperty) Name() string {
+func (this *ActivityStreamsFollowingProperty) Name() string {
 	return "following"
 }
 
@@ -281,7 +279,8 @@ func (this ActivityStreamsFollowingProperty) Name() string {
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsFollowingProperty) Serialize() (interface{}, error) {
+func (this *ActivityStreamsFollowingProperty) Serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsOrderedCollection() {
 		return this.GetActivityStreamsOrderedCollection().Serialize()
 	} else if this.IsActivityStreamsCollection() {
@@ -352,3 +351,53 @@ func (this *ActivityStreamsFollowingProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on following property: %T", t)
 }
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsFollowingProperty) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.Serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsFollowingProperty) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionMember = v
+		} else if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsCollectionPageMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else {
+			this.unknown = m
+		}
+	})
+}
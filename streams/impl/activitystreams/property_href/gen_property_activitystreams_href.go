@@ -5,6 +5,7 @@ import (
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
 )
 
 // ActivityStreamsHrefProperty is the functional property "href". It is permitted
@@ -173,3 +174,25 @@ func (this *ActivityStreamsHrefProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.Set(v)
 }
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this ActivityStreamsHrefProperty) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+
+	v, err := this.Serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
@@ -4,17 +4,23 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"sync"
 )
 
 // ActivityStreamsInboxProperty is the functional property "inbox". It is
 // permitted to be one of multiple value types. At most, one type of value can
 // be present, or none at all. Setting a value will clear the other types of
 // values so that only one of the 'Is' methods will return true. It is
-// possible to clear all values, so that this property is empty.
+// possible to clear all values, so that this property is empty. A value that
+// is a type is deserialized when it is first accessed.
 type ActivityStreamsInboxProperty struct {
 	activitystreamsOrderedCollectionMember     vocab.ActivityStreamsOrderedCollection
 	activitystreamsOrderedCollectionPageMember vocab.ActivityStreamsOrderedCollectionPage
 	unknown                                    interface{}
+	raw                                        map[string]interface{}
+	rawAliasMap                                map[string]string
+	resolveOnce                                sync.Once
 	iri                                        *url.URL
 	alias                                      string
 }
@@ -47,19 +53,13 @@ func DeserializeInboxProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsInboxProperty{
-					activitystreamsOrderedCollectionMember: v,
-					alias:                                  alias,
-				}
-				return this, nil
-			} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsInboxProperty{
-					activitystreamsOrderedCollectionPageMember: v,
-					alias: alias,
-				}
-				return this, nil
+			// Defer deserializing the value until it is first accessed.
+			this := &ActivityStreamsInboxProperty{
+				alias:       alias,
+				raw:         m,
+				rawAliasMap: aliasMap,
 			}
+			return this, nil
 		}
 		this := &ActivityStreamsInboxProperty{
 			alias:   alias,
@@ -82,31 +82,35 @@ func (this *ActivityStreamsInboxProperty) Clear() {
 	this.activitystreamsOrderedCollectionPageMember = nil
 	this.unknown = nil
 	this.iri = nil
+	this.raw = nil
+	this.rawAliasMap = nil
 }
 
 // GetActivityStreamsOrderedCollection returns the value of this property. When
 // IsActivityStreamsOrderedCollection returns false,
 // GetActivityStreamsOrderedCollection will return an arbitrary value.
-func (this ActivityStreamsInboxProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+func (this *ActivityStreamsInboxProperty) GetActivityStreamsOrderedCollection() vocab.ActivityStreamsOrderedCollection {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember
 }
 
 // GetActivityStreamsOrderedCollectionPage returns the value of this property.
 // When IsActivityStreamsOrderedCollectionPage returns false,
 // GetActivityStreamsOrderedCollectionPage will return an arbitrary value.
-func (this ActivityStreamsInboxProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+func (this *ActivityStreamsInboxProperty) GetActivityStreamsOrderedCollectionPage() vocab.ActivityStreamsOrderedCollectionPage {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
-func (this ActivityStreamsInboxProperty) GetIRI() *url.URL {
+func (this *ActivityStreamsInboxProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsInboxProperty) GetType() vocab.Type {
+func (this *ActivityStreamsInboxProperty) GetType() vocab.Type {
 	if this.IsActivityStreamsOrderedCollection() {
 		return this.GetActivityStreamsOrderedCollection()
 	}
@@ -118,7 +122,7 @@ func (this ActivityStreamsInboxProperty) GetType() vocab.Type {
 }
 
 // HasAny returns true if any of the different values is set.
-func (this ActivityStreamsInboxProperty) HasAny() bool {
+func (this *ActivityStreamsInboxProperty) HasAny() bool {
 	return this.IsActivityStreamsOrderedCollection() ||
 		this.IsActivityStreamsOrderedCollectionPage() ||
 		this.iri != nil
@@ -128,7 +132,8 @@ func (this ActivityStreamsInboxProperty) HasAny() bool {
 // "OrderedCollection". When true, use the GetActivityStreamsOrderedCollection
 // and SetActivityStreamsOrderedCollection methods to access and set this
 // property.
-func (this ActivityStreamsInboxProperty) IsActivityStreamsOrderedCollection() bool {
+func (this *ActivityStreamsInboxProperty) IsActivityStreamsOrderedCollection() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionMember != nil
 }
 
@@ -137,20 +142,21 @@ func (this ActivityStreamsInboxProperty) IsActivityStreamsOrderedCollection() bo
 // GetActivityStreamsOrderedCollectionPage and
 // SetActivityStreamsOrderedCollectionPage methods to access and set this
 // property.
-func (this ActivityStreamsInboxProperty) IsActivityStreamsOrderedCollectionPage() bool {
+func (this *ActivityStreamsInboxProperty) IsActivityStreamsOrderedCollectionPage() bool {
+	this.resolve()
 	return this.activitystreamsOrderedCollectionPageMember != nil
 }
 
 // IsIRI returns true if this property is an IRI. When true, use GetIRI and SetIRI
 // to access and set this property
-func (this ActivityStreamsInboxProperty) IsIRI() bool {
+func (this *ActivityStreamsInboxProperty) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsInboxProperty) JSONLDContext() map[string]string {
+func (this *ActivityStreamsInboxProperty) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsOrderedCollection() {
@@ -172,7 +178,7 @@ func (this ActivityStreamsInboxProperty) JSONLDContext() map[string]string {
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsInboxProperty) KindIndex() int {
+func (this *ActivityStreamsInboxProperty) KindIndex() int {
 	if this.IsActivityStreamsOrderedCollection() {
 		return 0
 	}
@@ -189,7 +195,7 @@ func (this ActivityStreamsInboxProperty) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsInboxProperty) LessThan(o vocab.ActivityStreamsInboxProperty) bool {
+func (this *ActivityStreamsInboxProperty) LessThan(o vocab.ActivityStreamsInboxProperty) bool {
 	idx1 := this.KindIndex()
 	idx2 := o.KindIndex()
 	if idx1 < idx2 {
@@ -207,7 +213,7 @@ func (this ActivityStreamsInboxProperty) LessThan(o vocab.ActivityStreamsInboxPr
 }
 
 // Name returns the name of this property: "inbox".
-func (this ActivityStreamsInboxProperty) Name() string {
+func (this *ActivityStreamsInboxProperty) Name() string {
 	return "inbox"
 }
 
@@ -215,7 +221,8 @@ func (this ActivityStreamsInboxProperty) Name() string {
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsInboxProperty) Serialize() (interface{}, error) {
+func (this *ActivityStreamsInboxProperty) Serialize() (interface{}, error) {
+	this.resolve()
 	if this.IsActivityStreamsOrderedCollection() {
 		return this.GetActivityStreamsOrderedCollection().Serialize()
 	} else if this.IsActivityStreamsOrderedCollectionPage() {
@@ -260,3 +267,49 @@ func (this *ActivityStreamsInboxProperty) SetType(t vocab.Type) error {
 
 	return fmt.Errorf("illegal type to set on inbox property: %T", t)
 }
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsInboxProperty) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.Serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsInboxProperty) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionMember = v
+		} else if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsOrderedCollectionPageMember = v
+		} else {
+			this.unknown = m
+		}
+	})
+}
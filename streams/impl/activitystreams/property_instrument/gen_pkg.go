@@ -67,6 +67,10 @@ type privateManager interface {
 	// for the "ActivityStreamsDocument" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeDocumentActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDocument, error)
+	// DeserializeEmojiActivityStreams returns the deserialization method for
+	// the "ActivityStreamsEmoji" non-functional property in the
+	// vocabulary "ActivityStreams"
+	DeserializeEmojiActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEmoji, error)
 	// DeserializeEventActivityStreams returns the deserialization method for
 	// the "ActivityStreamsEvent" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -83,6 +87,10 @@ type privateManager interface {
 	// the "ActivityStreamsGroup" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeGroupActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGroup, error)
+	// DeserializeHashtagActivityStreams returns the deserialization method
+	// for the "ActivityStreamsHashtag" non-functional property in the
+	// vocabulary "ActivityStreams"
+	DeserializeHashtagActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error)
 	// DeserializeIgnoreActivityStreams returns the deserialization method for
 	// the "ActivityStreamsIgnore" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -169,6 +177,10 @@ type privateManager interface {
 	// for the "ActivityStreamsProfile" non-functional property in the
 	// vocabulary "ActivityStreams"
 	DeserializeProfileActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsProfile, error)
+	// DeserializePropertyValueActivityStreams returns the deserialization
+	// method for the "ActivityStreamsPropertyValue" non-functional
+	// property in the vocabulary "ActivityStreams"
+	DeserializePropertyValueActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPropertyValue, error)
 	// DeserializeQuestionActivityStreams returns the deserialization method
 	// for the "ActivityStreamsQuestion" non-functional property in the
 	// vocabulary "ActivityStreams"
@@ -4,90 +4,83 @@ import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 )
 
-// ActivityStreamsPublicKeyProperty is the functional property "publicKey". It is
-// permitted to be a single nilable value type.
-type ActivityStreamsPublicKeyProperty struct {
+// ActivityStreamsPublicKeyPropertyIterator is an iterator for a property. It is
+// permitted to be a single nilable value type. A value that is a type is
+// deserialized when it is first accessed.
+type ActivityStreamsPublicKeyPropertyIterator struct {
 	activitystreamsPublicKeyMember vocab.ActivityStreamsPublicKey
 	unknown                        interface{}
+	raw                            map[string]interface{}
+	rawAliasMap                    map[string]string
+	resolveOnce                    sync.Once
 	iri                            *url.URL
 	alias                          string
+	myIdx                          int
+	parent                         vocab.ActivityStreamsPublicKeyProperty
 }
 
-// DeserializePublicKeyProperty creates a "publicKey" property from an interface
-// representation that has been unmarshalled from a text or binary format.
-func DeserializePublicKeyProperty(m map[string]interface{}, aliasMap map[string]string) (*ActivityStreamsPublicKeyProperty, error) {
+// NewActivityStreamsPublicKeyPropertyIterator creates a new
+// ActivityStreamsPublicKey property.
+func NewActivityStreamsPublicKeyPropertyIterator() *ActivityStreamsPublicKeyPropertyIterator {
+	return &ActivityStreamsPublicKeyPropertyIterator{alias: ""}
+}
+
+// deserializeActivityStreamsPublicKeyPropertyIterator creates an iterator from an
+// element that has been unmarshalled from a text or binary format.
+func deserializeActivityStreamsPublicKeyPropertyIterator(i interface{}, aliasMap map[string]string) (*ActivityStreamsPublicKeyPropertyIterator, error) {
 	alias := ""
 	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
 		alias = a
 	}
-	propName := "publicKey"
-	if len(alias) > 0 {
-		// Use alias both to find the property, and set within the property.
-		propName = fmt.Sprintf("%s:%s", alias, "publicKey")
-	}
-	i, ok := m[propName]
-
-	if ok {
-		if s, ok := i.(string); ok {
-			u, err := url.Parse(s)
-			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
-			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
-			if err == nil && len(u.Scheme) > 0 {
-				this := &ActivityStreamsPublicKeyProperty{
-					alias: alias,
-					iri:   u,
-				}
-				return this, nil
+	if s, ok := i.(string); ok {
+		u, err := url.Parse(s)
+		// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+		// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+		if err == nil && len(u.Scheme) > 0 {
+			this := &ActivityStreamsPublicKeyPropertyIterator{
+				alias: alias,
+				iri:   u,
 			}
+			return this, nil
 		}
-		if m, ok := i.(map[string]interface{}); ok {
-			if v, err := mgr.DeserializePublicKeyActivityStreams()(m, aliasMap); err == nil {
-				this := &ActivityStreamsPublicKeyProperty{
-					activitystreamsPublicKeyMember: v,
-					alias:                          alias,
-				}
-				return this, nil
-			}
-		}
-		this := &ActivityStreamsPublicKeyProperty{
-			alias:   alias,
-			unknown: i,
+	}
+	if m, ok := i.(map[string]interface{}); ok {
+		// Defer deserializing the value until it is first accessed.
+		this := &ActivityStreamsPublicKeyPropertyIterator{
+			alias:       alias,
+			raw:         m,
+			rawAliasMap: aliasMap,
 		}
 		return this, nil
 	}
-	return nil, nil
-}
-
-// NewActivityStreamsPublicKeyProperty creates a new publicKey property.
-func NewActivityStreamsPublicKeyProperty() *ActivityStreamsPublicKeyProperty {
-	return &ActivityStreamsPublicKeyProperty{alias: ""}
-}
-
-// Clear ensures no value of this property is set. Calling
-// IsActivityStreamsPublicKey afterwards will return false.
-func (this *ActivityStreamsPublicKeyProperty) Clear() {
-	this.unknown = nil
-	this.iri = nil
-	this.activitystreamsPublicKeyMember = nil
+	this := &ActivityStreamsPublicKeyPropertyIterator{
+		alias:   alias,
+		unknown: i,
+	}
+	return this, nil
 }
 
 // Get returns the value of this property. When IsActivityStreamsPublicKey returns
 // false, Get will return any arbitrary value.
-func (this ActivityStreamsPublicKeyProperty) Get() vocab.ActivityStreamsPublicKey {
+func (this *ActivityStreamsPublicKeyPropertyIterator) Get() vocab.ActivityStreamsPublicKey {
+	this.resolve()
 	return this.activitystreamsPublicKeyMember
 }
 
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return any arbitrary value.
-func (this ActivityStreamsPublicKeyProperty) GetIRI() *url.URL {
+func (this *ActivityStreamsPublicKeyPropertyIterator) GetIRI() *url.URL {
 	return this.iri
 }
 
 // GetType returns the value in this property as a Type. Returns nil if the value
 // is not an ActivityStreams type, such as an IRI or another value.
-func (this ActivityStreamsPublicKeyProperty) GetType() vocab.Type {
+func (this *ActivityStreamsPublicKeyPropertyIterator) GetType() vocab.Type {
 	if this.IsActivityStreamsPublicKey() {
 		return this.Get()
 	}
@@ -96,24 +89,25 @@ func (this ActivityStreamsPublicKeyProperty) GetType() vocab.Type {
 }
 
 // HasAny returns true if the value or IRI is set.
-func (this ActivityStreamsPublicKeyProperty) HasAny() bool {
+func (this *ActivityStreamsPublicKeyPropertyIterator) HasAny() bool {
 	return this.IsActivityStreamsPublicKey() || this.iri != nil
 }
 
 // IsActivityStreamsPublicKey returns true if this property is set and not an IRI.
-func (this ActivityStreamsPublicKeyProperty) IsActivityStreamsPublicKey() bool {
+func (this *ActivityStreamsPublicKeyPropertyIterator) IsActivityStreamsPublicKey() bool {
+	this.resolve()
 	return this.activitystreamsPublicKeyMember != nil
 }
 
 // IsIRI returns true if this property is an IRI.
-func (this ActivityStreamsPublicKeyProperty) IsIRI() bool {
+func (this *ActivityStreamsPublicKeyPropertyIterator) IsIRI() bool {
 	return this.iri != nil
 }
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
-func (this ActivityStreamsPublicKeyProperty) JSONLDContext() map[string]string {
+func (this *ActivityStreamsPublicKeyPropertyIterator) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	var child map[string]string
 	if this.IsActivityStreamsPublicKey() {
@@ -133,7 +127,7 @@ func (this ActivityStreamsPublicKeyProperty) JSONLDContext() map[string]string {
 // KindIndex computes an arbitrary value for indexing this kind of value. This is
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
-func (this ActivityStreamsPublicKeyProperty) KindIndex() int {
+func (this *ActivityStreamsPublicKeyPropertyIterator) KindIndex() int {
 	if this.IsActivityStreamsPublicKey() {
 		return 0
 	}
@@ -147,7 +141,7 @@ func (this ActivityStreamsPublicKeyProperty) KindIndex() int {
 // comparison. Applications should not use this because it is only meant to
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
-func (this ActivityStreamsPublicKeyProperty) LessThan(o vocab.ActivityStreamsPublicKeyProperty) bool {
+func (this *ActivityStreamsPublicKeyPropertyIterator) LessThan(o vocab.ActivityStreamsPublicKeyPropertyIterator) bool {
 	// LessThan comparison for if either or both are IRIs.
 	if this.IsIRI() && o.IsIRI() {
 		return this.iri.String() < o.GetIRI().String()
@@ -174,45 +168,528 @@ func (this ActivityStreamsPublicKeyProperty) LessThan(o vocab.ActivityStreamsPub
 	}
 }
 
-// Name returns the name of this property: "publicKey".
-func (this ActivityStreamsPublicKeyProperty) Name() string {
-	return "publicKey"
+// Name returns the name of this property: "ActivityStreamsPublicKey".
+func (this *ActivityStreamsPublicKeyPropertyIterator) Name() string {
+	return "ActivityStreamsPublicKey"
 }
 
-// Serialize converts this into an interface representation suitable for
-// marshalling into a text or binary format. Applications should not need this
-// function as most typical use cases serialize types instead of individual
-// properties. It is exposed for alternatives to go-fed implementations to use.
-func (this ActivityStreamsPublicKeyProperty) Serialize() (interface{}, error) {
-	if this.IsActivityStreamsPublicKey() {
-		return this.Get().Serialize()
-	} else if this.IsIRI() {
-		return this.iri.String(), nil
+// Next returns the next iterator, or nil if there is no next iterator.
+func (this *ActivityStreamsPublicKeyPropertyIterator) Next() vocab.ActivityStreamsPublicKeyPropertyIterator {
+	if this.myIdx+1 >= this.parent.Len() {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx + 1)
+	}
+}
+
+// Prev returns the previous iterator, or nil if there is no previous iterator.
+func (this *ActivityStreamsPublicKeyPropertyIterator) Prev() vocab.ActivityStreamsPublicKeyPropertyIterator {
+	if this.myIdx-1 < 0 {
+		return nil
+	} else {
+		return this.parent.At(this.myIdx - 1)
 	}
-	return this.unknown, nil
 }
 
 // Set sets the value of this property. Calling IsActivityStreamsPublicKey
 // afterwards will return true.
-func (this *ActivityStreamsPublicKeyProperty) Set(v vocab.ActivityStreamsPublicKey) {
-	this.Clear()
+func (this *ActivityStreamsPublicKeyPropertyIterator) Set(v vocab.ActivityStreamsPublicKey) {
+	this.clear()
 	this.activitystreamsPublicKeyMember = v
 }
 
 // SetIRI sets the value of this property. Calling IsIRI afterwards will return
 // true.
-func (this *ActivityStreamsPublicKeyProperty) SetIRI(v *url.URL) {
-	this.Clear()
+func (this *ActivityStreamsPublicKeyPropertyIterator) SetIRI(v *url.URL) {
+	this.clear()
 	this.iri = v
 }
 
 // SetType attempts to set the property for the arbitrary type. Returns an error
 // if it is not a valid type to set on this property.
-func (this *ActivityStreamsPublicKeyProperty) SetType(t vocab.Type) error {
+func (this *ActivityStreamsPublicKeyPropertyIterator) SetType(t vocab.Type) error {
 	if v, ok := t.(vocab.ActivityStreamsPublicKey); ok {
 		this.Set(v)
 		return nil
 	}
 
-	return fmt.Errorf("illegal type to set on publicKey property: %T", t)
+	return fmt.Errorf("illegal type to set on ActivityStreamsPublicKey property: %T", t)
+}
+
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this *ActivityStreamsPublicKeyPropertyIterator) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+	if t := this.GetType(); t != nil {
+		if id := t.GetActivityStreamsId(); id != nil && id.Get() != nil {
+			return t.GetTypeName() + "(" + id.Get().String() + ")"
+		}
+		return t.GetTypeName()
+	}
+	v, err := this.serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// clear ensures no value of this property is set. Calling
+// IsActivityStreamsPublicKey afterwards will return false.
+func (this *ActivityStreamsPublicKeyPropertyIterator) clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.activitystreamsPublicKeyMember = nil
+	this.raw = nil
+	this.rawAliasMap = nil
+}
+
+// resolve deserializes the value kept when this property was deserialized, the
+// first time this property is accessed.
+func (this *ActivityStreamsPublicKeyPropertyIterator) resolve() {
+	this.resolveOnce.Do(func() {
+		if this.raw == nil {
+			return
+		}
+		m, aliasMap := this.raw, this.rawAliasMap
+		this.raw, this.rawAliasMap = nil, nil
+		if v, err := mgr.DeserializePublicKeyActivityStreams()(m, aliasMap); err == nil {
+			this.activitystreamsPublicKeyMember = v
+		} else {
+			this.unknown = m
+		}
+	})
+}
+
+// serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this *ActivityStreamsPublicKeyPropertyIterator) serialize() (interface{}, error) {
+	this.resolve()
+	if this.IsActivityStreamsPublicKey() {
+		return this.Get().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// ActivityStreamsPublicKeyProperty is the non-functional property "publicKey". It
+// is permitted to have one or more values, and of different value types.
+type ActivityStreamsPublicKeyProperty struct {
+	properties []*ActivityStreamsPublicKeyPropertyIterator
+	alias      string
+}
+
+// DeserializePublicKeyProperty creates a "publicKey" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializePublicKeyProperty(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKeyProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
+		alias = a
+	}
+	propName := "publicKey"
+	if len(alias) > 0 {
+		propName = fmt.Sprintf("%s:%s", alias, "publicKey")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		this := &ActivityStreamsPublicKeyProperty{
+			alias:      alias,
+			properties: []*ActivityStreamsPublicKeyPropertyIterator{},
+		}
+		if list, ok := i.([]interface{}); ok {
+			for _, iterator := range list {
+				if p, err := deserializeActivityStreamsPublicKeyPropertyIterator(iterator, aliasMap); err != nil {
+					return this, err
+				} else if p != nil {
+					this.properties = append(this.properties, p)
+				}
+			}
+		} else {
+			if p, err := deserializeActivityStreamsPublicKeyPropertyIterator(i, aliasMap); err != nil {
+				return this, err
+			} else if p != nil {
+				this.properties = append(this.properties, p)
+			}
+		}
+		// Set up the properties for iteration.
+		for idx, ele := range this.properties {
+			ele.parent = this
+			ele.myIdx = idx
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewActivityStreamsPublicKeyProperty creates a new publicKey property.
+func NewActivityStreamsPublicKeyProperty() *ActivityStreamsPublicKeyProperty {
+	return &ActivityStreamsPublicKeyProperty{alias: ""}
+}
+
+// AppendActivityStreamsPublicKey appends a PublicKey value to the back of a list
+// of the property "publicKey". Invalidates iterators that are traversing
+// using Prev.
+func (this *ActivityStreamsPublicKeyProperty) AppendActivityStreamsPublicKey(v vocab.ActivityStreamsPublicKey) {
+	this.properties = append(this.properties, &ActivityStreamsPublicKeyPropertyIterator{
+		activitystreamsPublicKeyMember: v,
+		alias:                          this.alias,
+		myIdx:                          this.Len(),
+		parent:                         this,
+	})
+}
+
+// AppendIRI appends an IRI value to the back of a list of the property "publicKey"
+func (this *ActivityStreamsPublicKeyProperty) AppendIRI(v *url.URL) {
+	this.properties = append(this.properties, &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  this.Len(),
+		parent: this,
+	})
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "publicKey". Invalidates iterators that are traversing using Prev.
+// Returns an error if the type is not a valid one to set for this property.
+func (this *ActivityStreamsPublicKeyProperty) AppendType(t vocab.Type) error {
+	n := &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  this.Len(),
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append(this.properties, n)
+	return nil
+}
+
+// At returns the property value for the specified index. Panics if the index is
+// out of bounds.
+func (this ActivityStreamsPublicKeyProperty) At(index int) vocab.ActivityStreamsPublicKeyPropertyIterator {
+	return this.properties[index]
+}
+
+// Begin returns the first iterator, or nil if empty. Can be used with the
+// iterator's Next method and this property's End method to iterate from front
+// to back through all values.
+func (this ActivityStreamsPublicKeyProperty) Begin() vocab.ActivityStreamsPublicKeyPropertyIterator {
+	if this.Empty() {
+		return nil
+	} else {
+		return this.properties[0]
+	}
+}
+
+// Empty returns returns true if there are no elements.
+func (this ActivityStreamsPublicKeyProperty) Empty() bool {
+	return this.Len() == 0
+}
+
+// End returns beyond-the-last iterator, which is nil. Can be used with the
+// iterator's Next method and this property's Begin method to iterate from
+// front to back through all values.
+func (this ActivityStreamsPublicKeyProperty) End() vocab.ActivityStreamsPublicKeyPropertyIterator {
+	return nil
+}
+
+// ForEach calls fn with the index and iterator of each value, from front to back,
+// until fn returns false. Unlike the iterator's Next method, which copies the
+// iterator it is called on, it neither copies nor allocates per value, so
+// that it suits hot loops over long properties. The property must not be
+// modified by fn.
+func (this ActivityStreamsPublicKeyProperty) ForEach(fn func(idx int, it vocab.ActivityStreamsPublicKeyPropertyIterator) bool) {
+	for i, elem := range this.properties {
+		if !fn(i, elem) {
+			return
+		}
+	}
+}
+
+// InsertActivityStreamsPublicKey inserts a PublicKey value at the specified index
+// for a property "publicKey". Existing elements at that index and higher are
+// shifted back once. Invalidates all iterators.
+func (this *ActivityStreamsPublicKeyProperty) InsertActivityStreamsPublicKey(idx int, v vocab.ActivityStreamsPublicKey) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsPublicKeyPropertyIterator{
+		activitystreamsPublicKeyMember: v,
+		alias:                          this.alias,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Insert inserts an IRI value at the specified index for a property "publicKey".
+// Existing elements at that index and higher are shifted back once.
+// Invalidates all iterators.
+func (this *ActivityStreamsPublicKeyProperty) InsertIRI(idx int, v *url.URL) {
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "publicKey". Invalidates all iterators. Returns an error if the
+// type is not a valid one to set for this property.
+func (this *ActivityStreamsPublicKeyProperty) InsertType(idx int, t vocab.Type) error {
+	n := &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append(this.properties, nil)
+	copy(this.properties[idx+1:], this.properties[idx:])
+	this.properties[idx] = n
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+	return nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this ActivityStreamsPublicKeyProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
+	for _, elem := range this.properties {
+		child := elem.JSONLDContext()
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		for k, v := range child {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API method specifically needed only for alternate implementations
+// for go-fed. Applications should not use this method. Panics if the index is
+// out of bounds.
+func (this ActivityStreamsPublicKeyProperty) KindIndex(idx int) int {
+	return this.properties[idx].KindIndex()
+}
+
+// Len returns the number of values that exist for the "publicKey" property.
+func (this ActivityStreamsPublicKeyProperty) Len() (length int) {
+	return len(this.properties)
+}
+
+// Less computes whether another property is less than this one. Mixing types
+// results in a consistent but arbitrary ordering
+func (this ActivityStreamsPublicKeyProperty) Less(i, j int) bool {
+	idx1 := this.KindIndex(i)
+	idx2 := this.KindIndex(j)
+	if idx1 < idx2 {
+		return true
+	} else if idx1 == idx2 {
+		if idx1 == 0 {
+			lhs := this.properties[i].Get()
+			rhs := this.properties[j].Get()
+			return lhs.LessThan(rhs)
+		} else if idx1 == -2 {
+			lhs := this.properties[i].GetIRI()
+			rhs := this.properties[j].GetIRI()
+			return lhs.String() < rhs.String()
+		}
+	}
+	return false
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this ActivityStreamsPublicKeyProperty) LessThan(o vocab.ActivityStreamsPublicKeyProperty) bool {
+	l1 := this.Len()
+	l2 := o.Len()
+	l := l1
+	if l2 < l1 {
+		l = l2
+	}
+	for i := 0; i < l; i++ {
+		if this.properties[i].LessThan(o.At(i)) {
+			return true
+		} else if o.At(i).LessThan(this.properties[i]) {
+			return false
+		}
+	}
+	return l1 < l2
+}
+
+// Name returns the name of this property: "publicKey".
+func (this ActivityStreamsPublicKeyProperty) Name() string {
+	return "publicKey"
+}
+
+// PrependActivityStreamsPublicKey prepends a PublicKey value to the front of a
+// list of the property "publicKey". Invalidates all iterators.
+func (this *ActivityStreamsPublicKeyProperty) PrependActivityStreamsPublicKey(v vocab.ActivityStreamsPublicKey) {
+	this.properties = append([]*ActivityStreamsPublicKeyPropertyIterator{{
+		activitystreamsPublicKeyMember: v,
+		alias:                          this.alias,
+		myIdx:                          0,
+		parent:                         this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependIRI prepends an IRI value to the front of a list of the property
+// "publicKey".
+func (this *ActivityStreamsPublicKeyProperty) PrependIRI(v *url.URL) {
+	this.properties = append([]*ActivityStreamsPublicKeyPropertyIterator{{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  0,
+		parent: this,
+	}}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// PrependType prepends an arbitrary type value to the front of a list of the
+// property "publicKey". Invalidates all iterators. Returns an error if the
+// type is not a valid one to set for this property.
+func (this *ActivityStreamsPublicKeyProperty) PrependType(t vocab.Type) error {
+	n := &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  0,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	this.properties = append([]*ActivityStreamsPublicKeyPropertyIterator{n}, this.properties...)
+	for i := 1; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+	return nil
+}
+
+// Remove deletes an element at the specified index from a list of the property
+// "publicKey", regardless of its type. Panics if the index is out of bounds.
+// Invalidates all iterators.
+func (this *ActivityStreamsPublicKeyProperty) Remove(idx int) {
+	(this.properties)[idx].parent = nil
+	copy((this.properties)[idx:], (this.properties)[idx+1:])
+	(this.properties)[len(this.properties)-1] = &ActivityStreamsPublicKeyPropertyIterator{}
+	this.properties = (this.properties)[:len(this.properties)-1]
+	for i := idx; i < this.Len(); i++ {
+		(this.properties)[i].myIdx = i
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this ActivityStreamsPublicKeyProperty) Serialize() (interface{}, error) {
+	s := make([]interface{}, 0, len(this.properties))
+	for _, iterator := range this.properties {
+		if b, err := iterator.serialize(); err != nil {
+			return s, err
+		} else {
+			s = append(s, b)
+		}
+	}
+	// Shortcut: if serializing one value, don't return an array -- pretty sure other Fediverse software would choke on a "type" value with array, for example.
+	if len(s) == 1 {
+		return s[0], nil
+	}
+	return s, nil
+}
+
+// Set sets a PublicKey value to be at the specified index for the property
+// "publicKey". Panics if the index is out of bounds. Invalidates all
+// iterators.
+func (this *ActivityStreamsPublicKeyProperty) Set(idx int, v vocab.ActivityStreamsPublicKey) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsPublicKeyPropertyIterator{
+		activitystreamsPublicKeyMember: v,
+		alias:                          this.alias,
+		myIdx:                          idx,
+		parent:                         this,
+	}
+}
+
+// SetIRI sets an IRI value to be at the specified index for the property
+// "publicKey". Panics if the index is out of bounds.
+func (this *ActivityStreamsPublicKeyProperty) SetIRI(idx int, v *url.URL) {
+	(this.properties)[idx].parent = nil
+	(this.properties)[idx] = &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		iri:    v,
+		myIdx:  idx,
+		parent: this,
+	}
+}
+
+// SetType sets an arbitrary type value to the specified index of the property
+// "publicKey". Invalidates all iterators. Returns an error if the type is not
+// a valid one to set for this property. Panics if the index is out of bounds.
+func (this *ActivityStreamsPublicKeyProperty) SetType(idx int, t vocab.Type) error {
+	n := &ActivityStreamsPublicKeyPropertyIterator{
+		alias:  this.alias,
+		myIdx:  idx,
+		parent: this,
+	}
+	if err := n.SetType(t); err != nil {
+		return err
+	}
+	(this.properties)[idx] = n
+	return nil
+}
+
+// String returns a compact, human-readable form of the values of this property
+// for logs and debugging, listing at most the first 3. It is not a
+// serialization.
+func (this ActivityStreamsPublicKeyProperty) String() string {
+	s := make([]string, 0, len(this.properties))
+	for i, elem := range this.properties {
+		if i == 3 {
+			s = append(s, fmt.Sprintf("...+%d", len(this.properties)-3))
+			break
+		}
+		s = append(s, elem.String())
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// Swap swaps the location of values at two indices for the "publicKey" property.
+func (this ActivityStreamsPublicKeyProperty) Swap(i, j int) {
+	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
 }
@@ -6,6 +6,8 @@ import (
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // ActivityStreamsSummaryPropertyIterator is an iterator for a property. It is
@@ -261,6 +263,28 @@ func (this *ActivityStreamsSummaryPropertyIterator) SetXMLSchemaString(v string)
 	this.hasStringMember = true
 }
 
+// String returns a compact, human-readable form of the value of this property for
+// logs and debugging: an IRI, a quoted string shortened to 64 characters, the
+// name and id of a type, or another value as printed by fmt. It is not a
+// serialization.
+func (this ActivityStreamsSummaryPropertyIterator) String() string {
+	if this.IsIRI() {
+		return this.GetIRI().String()
+	}
+
+	v, err := this.serialize()
+	if err != nil {
+		return "!(" + err.Error() + ")"
+	}
+	if s, ok := v.(string); ok {
+		if r := []rune(s); len(r) > 64 {
+			s = string(r[:61]) + "..."
+		}
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
 // clear ensures no value and no language map for this property is set. Calling
 // HasAny or any of the 'Is' methods afterwards will return false.
 func (this *ActivityStreamsSummaryPropertyIterator) clear() {
@@ -406,6 +430,19 @@ func (this ActivityStreamsSummaryProperty) End() vocab.ActivityStreamsSummaryPro
 	return nil
 }
 
+// ForEach calls fn with the index and iterator of each value, from front to back,
+// until fn returns false. Unlike the iterator's Next method, which copies the
+// iterator it is called on, it neither copies nor allocates per value, so
+// that it suits hot loops over long properties. The property must not be
+// modified by fn.
+func (this ActivityStreamsSummaryProperty) ForEach(fn func(idx int, it vocab.ActivityStreamsSummaryPropertyIterator) bool) {
+	for i, elem := range this.properties {
+		if !fn(i, elem) {
+			return
+		}
+	}
+}
+
 // Insert inserts an IRI value at the specified index for a property "summary".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -660,6 +697,21 @@ func (this *ActivityStreamsSummaryProperty) SetXMLSchemaString(idx int, v string
 	}
 }
 
+// String returns a compact, human-readable form of the values of this property
+// for logs and debugging, listing at most the first 3. It is not a
+// serialization.
+func (this ActivityStreamsSummaryProperty) String() string {
+	s := make([]string, 0, len(this.properties))
+	for i, elem := range this.properties {
+		if i == 3 {
+			s = append(s, fmt.Sprintf("...+%d", len(this.properties)-3))
+			break
+		}
+		s = append(s, elem.String())
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
 // Swap swaps the location of values at two indices for the "summary" property.
 func (this ActivityStreamsSummaryProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
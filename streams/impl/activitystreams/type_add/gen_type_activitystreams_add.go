@@ -3,7 +3,11 @@ package typeadd
 import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Indicates that the actor has added the object to the target. If the target
@@ -102,7 +106,7 @@ func ActivityStreamsAddExtends(other vocab.Type) bool {
 // AddIsDisjointWith returns true if the other provided type is disjoint with the
 // Add type.
 func AddIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -455,6 +459,1060 @@ func NewActivityStreamsAdd() *ActivityStreamsAdd {
 	}
 }
 
+// applyMapValue calls apply with each form coerceMapValue coerces the value into,
+// until one is applied. Returns an error if none is.
+func applyMapValue(v interface{}, apply func(interface{}) bool) error {
+	for _, c := range coerceMapValue(v) {
+		if apply(c) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot apply a value of type %T", v)
+}
+
+// coerceMapValue returns the forms of a value unmarshalled from JSON that the
+// value may be given as, in order of preference.
+func coerceMapValue(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case string:
+		c := []interface{}{t}
+		if f, err := strconv.ParseFloat(t, 64); err == nil {
+			c = append(c, f)
+		}
+		if b, err := strconv.ParseBool(t); err == nil {
+			c = append(c, b)
+		}
+		return c
+	case int:
+		return []interface{}{float64(t)}
+	case int64:
+		return []interface{}{float64(t)}
+	case float32:
+		return []interface{}{float64(t)}
+	case *url.URL:
+		return []interface{}{t.String()}
+	case time.Time:
+		return []interface{}{t.Format(time.RFC3339)}
+	case []string:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e
+		}
+		return []interface{}{s}
+	case []*url.URL:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e.String()
+		}
+		return []interface{}{s}
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = coerceMapValue(e)[0]
+		}
+		return []interface{}{s}
+	}
+	return []interface{}{v}
+}
+
+// ActorIRI returns the first value of the "actor" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ActorIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsActor == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsActor.Len(); i++ {
+		if iter := this.ActivityStreamsActor.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ActorType returns the first value of the "actor" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) ActorType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsActor == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsActor.Len(); i++ {
+		if iter := this.ActivityStreamsActor.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// AltitudeFloat returns the value of the "altitude" property if it is of type
+// "float", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) AltitudeFloat() (v float64, ok bool) {
+	if this.ActivityStreamsAltitude != nil && this.ActivityStreamsAltitude.IsXMLSchemaFloat() {
+		return this.ActivityStreamsAltitude.Get(), true
+	}
+	return
+}
+
+// AltitudeIRI returns the value of the "altitude" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) AltitudeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAltitude != nil && this.ActivityStreamsAltitude.IsIRI() {
+		return this.ActivityStreamsAltitude.GetIRI(), true
+	}
+	return
+}
+
+// ApplyMap sets the properties named by the keys of the map, named as by
+// GetProperty, to its values. A nil value clears the property. The values may
+// be those of a map unmarshalled from JSON, or plain Go values such as
+// strings, numbers, bools, time.Time, *url.URL, and slices of them. A string
+// is also tried as a number or a bool if the property does not accept it as
+// is, such as the values of a submitted form. Every value that can be applied
+// is, and the returned error lists each key that could not be.
+func (this *ActivityStreamsAdd) ApplyMap(m map[string]interface{}) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var errs []string
+	for _, k := range keys {
+		var err error
+		switch k {
+		case "actor":
+			if m[k] == nil {
+				this.ActivityStreamsActor = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeActorPropertyActivityStreams()(map[string]interface{}{"actor": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsActor = p
+					return true
+				})
+			}
+		case "altitude":
+			if m[k] == nil {
+				this.ActivityStreamsAltitude = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAltitudePropertyActivityStreams()(map[string]interface{}{"altitude": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsAltitude = p
+					return true
+				})
+			}
+		case "attachment":
+			if m[k] == nil {
+				this.ActivityStreamsAttachment = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAttachmentPropertyActivityStreams()(map[string]interface{}{"attachment": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAttachment = p
+					return true
+				})
+			}
+		case "attributedTo":
+			if m[k] == nil {
+				this.ActivityStreamsAttributedTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAttributedToPropertyActivityStreams()(map[string]interface{}{"attributedTo": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAttributedTo = p
+					return true
+				})
+			}
+		case "audience":
+			if m[k] == nil {
+				this.ActivityStreamsAudience = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAudiencePropertyActivityStreams()(map[string]interface{}{"audience": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAudience = p
+					return true
+				})
+			}
+		case "bcc":
+			if m[k] == nil {
+				this.ActivityStreamsBcc = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeBccPropertyActivityStreams()(map[string]interface{}{"bcc": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsBcc = p
+					return true
+				})
+			}
+		case "bto":
+			if m[k] == nil {
+				this.ActivityStreamsBto = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeBtoPropertyActivityStreams()(map[string]interface{}{"bto": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsBto = p
+					return true
+				})
+			}
+		case "cc":
+			if m[k] == nil {
+				this.ActivityStreamsCc = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeCcPropertyActivityStreams()(map[string]interface{}{"cc": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsCc = p
+					return true
+				})
+			}
+		case "content":
+			if m[k] == nil {
+				this.ActivityStreamsContent = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeContentPropertyActivityStreams()(map[string]interface{}{"content": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsContent = p
+					return true
+				})
+			}
+		case "context":
+			if m[k] == nil {
+				this.ActivityStreamsContext = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeContextPropertyActivityStreams()(map[string]interface{}{"context": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsContext = p
+					return true
+				})
+			}
+		case "duration":
+			if m[k] == nil {
+				this.ActivityStreamsDuration = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeDurationPropertyActivityStreams()(map[string]interface{}{"duration": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsDuration = p
+					return true
+				})
+			}
+		case "endTime":
+			if m[k] == nil {
+				this.ActivityStreamsEndTime = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeEndTimePropertyActivityStreams()(map[string]interface{}{"endTime": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsEndTime = p
+					return true
+				})
+			}
+		case "generator":
+			if m[k] == nil {
+				this.ActivityStreamsGenerator = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeGeneratorPropertyActivityStreams()(map[string]interface{}{"generator": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsGenerator = p
+					return true
+				})
+			}
+		case "icon":
+			if m[k] == nil {
+				this.ActivityStreamsIcon = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeIconPropertyActivityStreams()(map[string]interface{}{"icon": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsIcon = p
+					return true
+				})
+			}
+		case "id":
+			if m[k] == nil {
+				this.ActivityStreamsId = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeIdPropertyActivityStreams()(map[string]interface{}{"id": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsId = p
+					return true
+				})
+			}
+		case "image":
+			if m[k] == nil {
+				this.ActivityStreamsImage = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeImagePropertyActivityStreams()(map[string]interface{}{"image": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsImage = p
+					return true
+				})
+			}
+		case "inReplyTo":
+			if m[k] == nil {
+				this.ActivityStreamsInReplyTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeInReplyToPropertyActivityStreams()(map[string]interface{}{"inReplyTo": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsInReplyTo = p
+					return true
+				})
+			}
+		case "instrument":
+			if m[k] == nil {
+				this.ActivityStreamsInstrument = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeInstrumentPropertyActivityStreams()(map[string]interface{}{"instrument": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsInstrument = p
+					return true
+				})
+			}
+		case "likes":
+			if m[k] == nil {
+				this.ActivityStreamsLikes = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeLikesPropertyActivityStreams()(map[string]interface{}{"likes": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsLikes = p
+					return true
+				})
+			}
+		case "location":
+			if m[k] == nil {
+				this.ActivityStreamsLocation = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeLocationPropertyActivityStreams()(map[string]interface{}{"location": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsLocation = p
+					return true
+				})
+			}
+		case "mediaType":
+			if m[k] == nil {
+				this.ActivityStreamsMediaType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeMediaTypePropertyActivityStreams()(map[string]interface{}{"mediaType": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsMediaType = p
+					return true
+				})
+			}
+		case "name":
+			if m[k] == nil {
+				this.ActivityStreamsName = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeNamePropertyActivityStreams()(map[string]interface{}{"name": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsName = p
+					return true
+				})
+			}
+		case "object":
+			if m[k] == nil {
+				this.ActivityStreamsObject = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeObjectPropertyActivityStreams()(map[string]interface{}{"object": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsObject = p
+					return true
+				})
+			}
+		case "origin":
+			if m[k] == nil {
+				this.ActivityStreamsOrigin = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeOriginPropertyActivityStreams()(map[string]interface{}{"origin": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsOrigin = p
+					return true
+				})
+			}
+		case "preview":
+			if m[k] == nil {
+				this.ActivityStreamsPreview = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePreviewPropertyActivityStreams()(map[string]interface{}{"preview": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsPreview = p
+					return true
+				})
+			}
+		case "published":
+			if m[k] == nil {
+				this.ActivityStreamsPublished = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePublishedPropertyActivityStreams()(map[string]interface{}{"published": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsPublished = p
+					return true
+				})
+			}
+		case "replies":
+			if m[k] == nil {
+				this.ActivityStreamsReplies = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeRepliesPropertyActivityStreams()(map[string]interface{}{"replies": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsReplies = p
+					return true
+				})
+			}
+		case "result":
+			if m[k] == nil {
+				this.ActivityStreamsResult = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeResultPropertyActivityStreams()(map[string]interface{}{"result": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsResult = p
+					return true
+				})
+			}
+		case "shares":
+			if m[k] == nil {
+				this.ActivityStreamsShares = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeSharesPropertyActivityStreams()(map[string]interface{}{"shares": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsShares = p
+					return true
+				})
+			}
+		case "startTime":
+			if m[k] == nil {
+				this.ActivityStreamsStartTime = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeStartTimePropertyActivityStreams()(map[string]interface{}{"startTime": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsStartTime = p
+					return true
+				})
+			}
+		case "summary":
+			if m[k] == nil {
+				this.ActivityStreamsSummary = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeSummaryPropertyActivityStreams()(map[string]interface{}{"summary": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsSummary = p
+					return true
+				})
+			}
+		case "tag":
+			if m[k] == nil {
+				this.ActivityStreamsTag = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTagPropertyActivityStreams()(map[string]interface{}{"tag": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsTag = p
+					return true
+				})
+			}
+		case "target":
+			if m[k] == nil {
+				this.ActivityStreamsTarget = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTargetPropertyActivityStreams()(map[string]interface{}{"target": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsTarget = p
+					return true
+				})
+			}
+		case "to":
+			if m[k] == nil {
+				this.ActivityStreamsTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeToPropertyActivityStreams()(map[string]interface{}{"to": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsTo = p
+					return true
+				})
+			}
+		case "type":
+			if m[k] == nil {
+				this.ActivityStreamsType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTypePropertyActivityStreams()(map[string]interface{}{"type": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsType = p
+					return true
+				})
+			}
+		case "updated":
+			if m[k] == nil {
+				this.ActivityStreamsUpdated = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeUpdatedPropertyActivityStreams()(map[string]interface{}{"updated": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsUpdated = p
+					return true
+				})
+			}
+		case "url":
+			if m[k] == nil {
+				this.ActivityStreamsUrl = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeUrlPropertyActivityStreams()(map[string]interface{}{"url": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsUrl = p
+					return true
+				})
+			}
+		default:
+			err = fmt.Errorf("no such property")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%q: %s", k, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot apply %d of %d values to the Add type: %s", len(errs), len(m), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// AttachmentIRI returns the first value of the "attachment" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) AttachmentIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAttachment == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttachment.Len(); i++ {
+		if iter := this.ActivityStreamsAttachment.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AttachmentType returns the first value of the "attachment" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) AttachmentType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAttachment == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttachment.Len(); i++ {
+		if iter := this.ActivityStreamsAttachment.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// AttributedToIRI returns the first value of the "attributedTo" property that is
+// an IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) AttributedToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAttributedTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttributedTo.Len(); i++ {
+		if iter := this.ActivityStreamsAttributedTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AttributedToType returns the first value of the "attributedTo" property that is
+// an ActivityStreams type, and false if the property is not set or has no
+// such value.
+func (this ActivityStreamsAdd) AttributedToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAttributedTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttributedTo.Len(); i++ {
+		if iter := this.ActivityStreamsAttributedTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// AudienceIRI returns the first value of the "audience" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) AudienceIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAudience == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAudience.Len(); i++ {
+		if iter := this.ActivityStreamsAudience.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AudienceType returns the first value of the "audience" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) AudienceType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAudience == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAudience.Len(); i++ {
+		if iter := this.ActivityStreamsAudience.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// BccIRI returns the first value of the "bcc" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsAdd) BccIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsBcc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBcc.Len(); i++ {
+		if iter := this.ActivityStreamsBcc.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// BccType returns the first value of the "bcc" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) BccType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsBcc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBcc.Len(); i++ {
+		if iter := this.ActivityStreamsBcc.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// BtoIRI returns the first value of the "bto" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsAdd) BtoIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsBto == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBto.Len(); i++ {
+		if iter := this.ActivityStreamsBto.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// BtoType returns the first value of the "bto" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) BtoType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsBto == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBto.Len(); i++ {
+		if iter := this.ActivityStreamsBto.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// CcIRI returns the first value of the "cc" property that is an IRI, and false if
+// the property is not set or has no such value.
+func (this ActivityStreamsAdd) CcIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsCc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsCc.Len(); i++ {
+		if iter := this.ActivityStreamsCc.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// CcType returns the first value of the "cc" property that is an ActivityStreams
+// type, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) CcType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsCc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsCc.Len(); i++ {
+		if iter := this.ActivityStreamsCc.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// ContentIRI returns the first value of the "content" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ContentIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsContent == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContent.Len(); i++ {
+		if iter := this.ActivityStreamsContent.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ContentLangString returns the first value of the "content" property that is of
+// type "langString", and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) ContentLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsContent == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContent.Len(); i++ {
+		if iter := this.ActivityStreamsContent.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// ContentString returns the first value of the "content" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ContentString() (v string, ok bool) {
+	if this.ActivityStreamsContent == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContent.Len(); i++ {
+		if iter := this.ActivityStreamsContent.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// ContextIRI returns the first value of the "context" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ContextIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsContext == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContext.Len(); i++ {
+		if iter := this.ActivityStreamsContext.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ContextType returns the first value of the "context" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) ContextType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsContext == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContext.Len(); i++ {
+		if iter := this.ActivityStreamsContext.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// DurationDuration returns the value of the "duration" property if it is of type
+// "duration", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) DurationDuration() (v time.Duration, ok bool) {
+	if this.ActivityStreamsDuration != nil && this.ActivityStreamsDuration.IsXMLSchemaDuration() {
+		return this.ActivityStreamsDuration.Get(), true
+	}
+	return
+}
+
+// DurationIRI returns the value of the "duration" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) DurationIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsDuration != nil && this.ActivityStreamsDuration.IsIRI() {
+		return this.ActivityStreamsDuration.GetIRI(), true
+	}
+	return
+}
+
+// EndTimeDateTime returns the value of the "endTime" property if it is of type
+// "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) EndTimeDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsEndTime != nil && this.ActivityStreamsEndTime.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsEndTime.Get(), true
+	}
+	return
+}
+
+// EndTimeIRI returns the value of the "endTime" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) EndTimeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsEndTime != nil && this.ActivityStreamsEndTime.IsIRI() {
+		return this.ActivityStreamsEndTime.GetIRI(), true
+	}
+	return
+}
+
+// GeneratorIRI returns the first value of the "generator" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) GeneratorIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsGenerator == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsGenerator.Len(); i++ {
+		if iter := this.ActivityStreamsGenerator.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// GeneratorType returns the first value of the "generator" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) GeneratorType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsGenerator == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsGenerator.Len(); i++ {
+		if iter := this.ActivityStreamsGenerator.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
 // GetActivityStreamsActor returns the "actor" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsAdd) GetActivityStreamsActor() vocab.ActivityStreamsActorProperty {
@@ -674,6 +1732,203 @@ func (this ActivityStreamsAdd) GetActivityStreamsUrl() vocab.ActivityStreamsUrlP
 	return this.ActivityStreamsUrl
 }
 
+// GetProperty returns the property with the name, such as "actor", which is nil
+// if it is not set. Returns false if this type has no such property. A
+// property whose name is shared with another property of this type is named
+// with its vocabulary as a prefix, such as "ActivityStreams:name". The value
+// may be passed to SetProperty on another value of this type.
+func (this ActivityStreamsAdd) GetProperty(name string) (interface{}, bool) {
+	switch name {
+	case "actor":
+		if this.ActivityStreamsActor == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsActor, true
+	case "altitude":
+		if this.ActivityStreamsAltitude == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAltitude, true
+	case "attachment":
+		if this.ActivityStreamsAttachment == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAttachment, true
+	case "attributedTo":
+		if this.ActivityStreamsAttributedTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAttributedTo, true
+	case "audience":
+		if this.ActivityStreamsAudience == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAudience, true
+	case "bcc":
+		if this.ActivityStreamsBcc == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsBcc, true
+	case "bto":
+		if this.ActivityStreamsBto == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsBto, true
+	case "cc":
+		if this.ActivityStreamsCc == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsCc, true
+	case "content":
+		if this.ActivityStreamsContent == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsContent, true
+	case "context":
+		if this.ActivityStreamsContext == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsContext, true
+	case "duration":
+		if this.ActivityStreamsDuration == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsDuration, true
+	case "endTime":
+		if this.ActivityStreamsEndTime == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsEndTime, true
+	case "generator":
+		if this.ActivityStreamsGenerator == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsGenerator, true
+	case "icon":
+		if this.ActivityStreamsIcon == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsIcon, true
+	case "id":
+		if this.ActivityStreamsId == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsId, true
+	case "image":
+		if this.ActivityStreamsImage == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsImage, true
+	case "inReplyTo":
+		if this.ActivityStreamsInReplyTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsInReplyTo, true
+	case "instrument":
+		if this.ActivityStreamsInstrument == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsInstrument, true
+	case "likes":
+		if this.ActivityStreamsLikes == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsLikes, true
+	case "location":
+		if this.ActivityStreamsLocation == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsLocation, true
+	case "mediaType":
+		if this.ActivityStreamsMediaType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsMediaType, true
+	case "name":
+		if this.ActivityStreamsName == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsName, true
+	case "object":
+		if this.ActivityStreamsObject == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsObject, true
+	case "origin":
+		if this.ActivityStreamsOrigin == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsOrigin, true
+	case "preview":
+		if this.ActivityStreamsPreview == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPreview, true
+	case "published":
+		if this.ActivityStreamsPublished == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPublished, true
+	case "replies":
+		if this.ActivityStreamsReplies == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsReplies, true
+	case "result":
+		if this.ActivityStreamsResult == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsResult, true
+	case "shares":
+		if this.ActivityStreamsShares == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsShares, true
+	case "startTime":
+		if this.ActivityStreamsStartTime == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsStartTime, true
+	case "summary":
+		if this.ActivityStreamsSummary == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsSummary, true
+	case "tag":
+		if this.ActivityStreamsTag == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsTag, true
+	case "target":
+		if this.ActivityStreamsTarget == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsTarget, true
+	case "to":
+		if this.ActivityStreamsTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsTo, true
+	case "type":
+		if this.ActivityStreamsType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsType, true
+	case "updated":
+		if this.ActivityStreamsUpdated == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsUpdated, true
+	case "url":
+		if this.ActivityStreamsUrl == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsUrl, true
+	default:
+		return nil, false
+	}
+}
+
 // GetTypeName returns the name of this type.
 func (this ActivityStreamsAdd) GetTypeName() string {
 	return "Add"
@@ -689,84 +1944,190 @@ func (this ActivityStreamsAdd) GetUnknownProperties() map[string]interface{} {
 	return this.unknown
 }
 
-// IsExtending returns true if the Add type extends from the other type.
-func (this ActivityStreamsAdd) IsExtending(other vocab.Type) bool {
-	return ActivityStreamsAddExtends(other)
+// GoString returns the same form as String, so that printing this value with the
+// %!v(MISSING) verb or in a debugger is readable instead of a dump of its members.
+func (this ActivityStreamsAdd) GoString() string {
+	return this.String()
 }
 
-// JSONLDContext returns the JSONLD URIs required in the context string for this
-// type and the specific properties that are set. The value in the map is the
-// alias used to import the type and its properties.
-func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	m = this.helperJSONLDContext(this.ActivityStreamsActor, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsId, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInReplyTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInstrument, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLikes, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLocation, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsOrigin, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsResult, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTarget, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
-
-	return m
+// IconIRI returns the first value of the "icon" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) IconIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsIcon == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsIcon.Len(); i++ {
+		if iter := this.ActivityStreamsIcon.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
 }
 
-// LessThan computes if this Add is lesser, with an arbitrary but stable
-// determination.
-func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
-	// Begin: Compare known properties
-	// Compare property "actor"
-	if lhs, rhs := this.ActivityStreamsActor, o.GetActivityStreamsActor(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
-		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
-		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
-	// Compare property "altitude"
-	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+// IconType returns the first value of the "icon" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) IconType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsIcon == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsIcon.Len(); i++ {
+		if iter := this.ActivityStreamsIcon.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
 		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
+	}
+	return
+}
+
+// IdAnyURI returns the value of the "id" property if it is of type "anyURI", and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) IdAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsXMLSchemaAnyURI() {
+		return this.ActivityStreamsId.Get(), true
+	}
+	return
+}
+
+// IdIRI returns the value of the "id" property if it is an IRI, and false if the
+// property is not set or has another value.
+func (this ActivityStreamsAdd) IdIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsIRI() {
+		return this.ActivityStreamsId.GetIRI(), true
+	}
+	return
+}
+
+// ImageIRI returns the first value of the "image" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ImageIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsImage == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsImage.Len(); i++ {
+		if iter := this.ActivityStreamsImage.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ImageType returns the first value of the "image" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) ImageType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsImage == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsImage.Len(); i++ {
+		if iter := this.ActivityStreamsImage.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// InReplyToIRI returns the first value of the "inReplyTo" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) InReplyToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsInReplyTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsInReplyTo.Len(); i++ {
+		if iter := this.ActivityStreamsInReplyTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// InReplyToType returns the first value of the "inReplyTo" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) InReplyToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsInReplyTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsInReplyTo.Len(); i++ {
+		if iter := this.ActivityStreamsInReplyTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// InstrumentIRI returns the first value of the "instrument" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) InstrumentIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsInstrument == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsInstrument.Len(); i++ {
+		if iter := this.ActivityStreamsInstrument.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// InstrumentType returns the first value of the "instrument" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) InstrumentType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsInstrument == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsInstrument.Len(); i++ {
+		if iter := this.ActivityStreamsInstrument.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// IsExtending returns true if the Add type extends from the other type.
+func (this ActivityStreamsAdd) IsExtending(other vocab.Type) bool {
+	return ActivityStreamsAddExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this ActivityStreamsAdd) JSONLDContext() map[string]string {
+	/*
+	   Every property of this type, and every type they may
+	   hold, is in this type's vocabulary, so the context is
+	   determined at code-generation time.
+	*/
+	return map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
+}
+
+// LessThan computes if this Add is lesser, with an arbitrary but stable
+// determination.
+func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
+	// Begin: Compare known properties
+	// Compare property "actor"
+	if lhs, rhs := this.ActivityStreamsActor, o.GetActivityStreamsActor(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "altitude"
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
 		return true
 	} else if rhs != nil && rhs == nil {
 		// Anything else is greater than nil
@@ -1275,6 +2636,266 @@ func (this ActivityStreamsAdd) LessThan(o vocab.ActivityStreamsAdd) bool {
 	return false
 }
 
+// LikesIRI returns the value of the "likes" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsAdd) LikesIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsLikes != nil && this.ActivityStreamsLikes.IsIRI() {
+		return this.ActivityStreamsLikes.GetIRI(), true
+	}
+	return
+}
+
+// LikesType returns the value of the "likes" property if it is an ActivityStreams
+// type, and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) LikesType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsLikes != nil && this.ActivityStreamsLikes.GetType() != nil {
+		return this.ActivityStreamsLikes.GetType(), true
+	}
+	return
+}
+
+// LocationIRI returns the first value of the "location" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) LocationIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsLocation == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsLocation.Len(); i++ {
+		if iter := this.ActivityStreamsLocation.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// LocationType returns the first value of the "location" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) LocationType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsLocation == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsLocation.Len(); i++ {
+		if iter := this.ActivityStreamsLocation.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// MediaTypeIRI returns the value of the "mediaType" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) MediaTypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsMediaType != nil && this.ActivityStreamsMediaType.IsIRI() {
+		return this.ActivityStreamsMediaType.GetIRI(), true
+	}
+	return
+}
+
+// MediaTypeRfc2045 returns the value of the "mediaType" property if it is of type
+// "rfc2045", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) MediaTypeRfc2045() (v string, ok bool) {
+	if this.ActivityStreamsMediaType != nil && this.ActivityStreamsMediaType.IsRFCRfc2045() {
+		return this.ActivityStreamsMediaType.Get(), true
+	}
+	return
+}
+
+// NameIRI returns the first value of the "name" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) NameIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// NameLangString returns the first value of the "name" property that is of type
+// "langString", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) NameLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// NameString returns the first value of the "name" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) NameString() (v string, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// ObjectIRI returns the first value of the "object" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ObjectIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsObject == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsObject.Len(); i++ {
+		if iter := this.ActivityStreamsObject.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ObjectType returns the first value of the "object" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) ObjectType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsObject == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsObject.Len(); i++ {
+		if iter := this.ActivityStreamsObject.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// OriginIRI returns the first value of the "origin" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) OriginIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsOrigin == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsOrigin.Len(); i++ {
+		if iter := this.ActivityStreamsOrigin.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// OriginType returns the first value of the "origin" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) OriginType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsOrigin == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsOrigin.Len(); i++ {
+		if iter := this.ActivityStreamsOrigin.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// PreviewIRI returns the first value of the "preview" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) PreviewIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPreview == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPreview.Len(); i++ {
+		if iter := this.ActivityStreamsPreview.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// PreviewType returns the first value of the "preview" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) PreviewType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsPreview == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPreview.Len(); i++ {
+		if iter := this.ActivityStreamsPreview.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// PublishedDateTime returns the value of the "published" property if it is of
+// type "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) PublishedDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsPublished != nil && this.ActivityStreamsPublished.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsPublished.Get(), true
+	}
+	return
+}
+
+// PublishedIRI returns the value of the "published" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) PublishedIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPublished != nil && this.ActivityStreamsPublished.IsIRI() {
+		return this.ActivityStreamsPublished.GetIRI(), true
+	}
+	return
+}
+
+// RepliesIRI returns the value of the "replies" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) RepliesIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsReplies != nil && this.ActivityStreamsReplies.IsIRI() {
+		return this.ActivityStreamsReplies.GetIRI(), true
+	}
+	return
+}
+
+// RepliesType returns the value of the "replies" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsAdd) RepliesType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsReplies != nil && this.ActivityStreamsReplies.GetType() != nil {
+		return this.ActivityStreamsReplies.GetType(), true
+	}
+	return
+}
+
+// ResultIRI returns the first value of the "result" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ResultIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsResult == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsResult.Len(); i++ {
+		if iter := this.ActivityStreamsResult.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ResultType returns the first value of the "result" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) ResultType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsResult == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsResult.Len(); i++ {
+		if iter := this.ActivityStreamsResult.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this ActivityStreamsAdd) Serialize() (map[string]interface{}, error) {
@@ -1780,24 +3401,814 @@ func (this *ActivityStreamsAdd) SetActivityStreamsUrl(i vocab.ActivityStreamsUrl
 	this.ActivityStreamsUrl = i
 }
 
-// VocabularyURI returns the vocabulary's URI as a string.
-func (this ActivityStreamsAdd) VocabularyURI() string {
-	return "https://www.w3.org/ns/activitystreams"
+// SetProperty sets the property with the name, named as by GetProperty, to the
+// value, which must be that property's interface. A nil value clears the
+// property. Returns an error if this type has no such property or the value
+// is of another type.
+func (this *ActivityStreamsAdd) SetProperty(name string, v interface{}) error {
+	switch name {
+	case "actor":
+		if v == nil {
+			this.ActivityStreamsActor = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsActorProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsActor = p
+		return nil
+	case "altitude":
+		if v == nil {
+			this.ActivityStreamsAltitude = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAltitudeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsAltitude = p
+		return nil
+	case "attachment":
+		if v == nil {
+			this.ActivityStreamsAttachment = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAttachmentProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsAttachment = p
+		return nil
+	case "attributedTo":
+		if v == nil {
+			this.ActivityStreamsAttributedTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAttributedToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsAttributedTo = p
+		return nil
+	case "audience":
+		if v == nil {
+			this.ActivityStreamsAudience = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAudienceProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsAudience = p
+		return nil
+	case "bcc":
+		if v == nil {
+			this.ActivityStreamsBcc = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsBccProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsBcc = p
+		return nil
+	case "bto":
+		if v == nil {
+			this.ActivityStreamsBto = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsBtoProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsBto = p
+		return nil
+	case "cc":
+		if v == nil {
+			this.ActivityStreamsCc = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsCcProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsCc = p
+		return nil
+	case "content":
+		if v == nil {
+			this.ActivityStreamsContent = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsContentProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsContent = p
+		return nil
+	case "context":
+		if v == nil {
+			this.ActivityStreamsContext = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsContextProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsContext = p
+		return nil
+	case "duration":
+		if v == nil {
+			this.ActivityStreamsDuration = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsDurationProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsDuration = p
+		return nil
+	case "endTime":
+		if v == nil {
+			this.ActivityStreamsEndTime = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsEndTimeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsEndTime = p
+		return nil
+	case "generator":
+		if v == nil {
+			this.ActivityStreamsGenerator = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsGeneratorProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsGenerator = p
+		return nil
+	case "icon":
+		if v == nil {
+			this.ActivityStreamsIcon = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsIconProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsIcon = p
+		return nil
+	case "id":
+		if v == nil {
+			this.ActivityStreamsId = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsIdProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsId = p
+		return nil
+	case "image":
+		if v == nil {
+			this.ActivityStreamsImage = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsImageProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsImage = p
+		return nil
+	case "inReplyTo":
+		if v == nil {
+			this.ActivityStreamsInReplyTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsInReplyToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsInReplyTo = p
+		return nil
+	case "instrument":
+		if v == nil {
+			this.ActivityStreamsInstrument = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsInstrumentProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsInstrument = p
+		return nil
+	case "likes":
+		if v == nil {
+			this.ActivityStreamsLikes = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsLikesProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsLikes = p
+		return nil
+	case "location":
+		if v == nil {
+			this.ActivityStreamsLocation = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsLocationProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsLocation = p
+		return nil
+	case "mediaType":
+		if v == nil {
+			this.ActivityStreamsMediaType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsMediaTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsMediaType = p
+		return nil
+	case "name":
+		if v == nil {
+			this.ActivityStreamsName = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsNameProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsName = p
+		return nil
+	case "object":
+		if v == nil {
+			this.ActivityStreamsObject = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsObjectProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsObject = p
+		return nil
+	case "origin":
+		if v == nil {
+			this.ActivityStreamsOrigin = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsOriginProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsOrigin = p
+		return nil
+	case "preview":
+		if v == nil {
+			this.ActivityStreamsPreview = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPreviewProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsPreview = p
+		return nil
+	case "published":
+		if v == nil {
+			this.ActivityStreamsPublished = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPublishedProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsPublished = p
+		return nil
+	case "replies":
+		if v == nil {
+			this.ActivityStreamsReplies = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsRepliesProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsReplies = p
+		return nil
+	case "result":
+		if v == nil {
+			this.ActivityStreamsResult = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsResultProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsResult = p
+		return nil
+	case "shares":
+		if v == nil {
+			this.ActivityStreamsShares = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsSharesProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsShares = p
+		return nil
+	case "startTime":
+		if v == nil {
+			this.ActivityStreamsStartTime = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsStartTimeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsStartTime = p
+		return nil
+	case "summary":
+		if v == nil {
+			this.ActivityStreamsSummary = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsSummaryProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsSummary = p
+		return nil
+	case "tag":
+		if v == nil {
+			this.ActivityStreamsTag = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTagProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsTag = p
+		return nil
+	case "target":
+		if v == nil {
+			this.ActivityStreamsTarget = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTargetProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsTarget = p
+		return nil
+	case "to":
+		if v == nil {
+			this.ActivityStreamsTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsTo = p
+		return nil
+	case "type":
+		if v == nil {
+			this.ActivityStreamsType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsType = p
+		return nil
+	case "updated":
+		if v == nil {
+			this.ActivityStreamsUpdated = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsUpdatedProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsUpdated = p
+		return nil
+	case "url":
+		if v == nil {
+			this.ActivityStreamsUrl = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsUrlProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Add type to a %T", name, v)
+		}
+		this.ActivityStreamsUrl = p
+		return nil
+	default:
+		return fmt.Errorf("the Add type has no property %q", name)
+	}
 }
 
-// helperJSONLDContext obtains the context uris and their aliases from a property,
-// if it is not nil.
-func (this ActivityStreamsAdd) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
-	if i == nil {
-		return toMerge
+// SharesIRI returns the value of the "shares" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsAdd) SharesIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsShares != nil && this.ActivityStreamsShares.IsIRI() {
+		return this.ActivityStreamsShares.GetIRI(), true
 	}
-	for k, v := range i.JSONLDContext() {
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		toMerge[k] = v
+	return
+}
+
+// SharesType returns the value of the "shares" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsAdd) SharesType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsShares != nil && this.ActivityStreamsShares.GetType() != nil {
+		return this.ActivityStreamsShares.GetType(), true
+	}
+	return
+}
+
+// StartTimeDateTime returns the value of the "startTime" property if it is of
+// type "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) StartTimeDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsStartTime != nil && this.ActivityStreamsStartTime.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsStartTime.Get(), true
+	}
+	return
+}
+
+// StartTimeIRI returns the value of the "startTime" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) StartTimeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsStartTime != nil && this.ActivityStreamsStartTime.IsIRI() {
+		return this.ActivityStreamsStartTime.GetIRI(), true
+	}
+	return
+}
+
+// String returns a compact, human-readable form of this Add for logs and
+// debugging, such as Add{id: https://example.com/1, name: "Example"}. It
+// lists the id first and then each other property that is set, with long
+// values shortened. It is not a serialization; use Serialize for that.
+func (this ActivityStreamsAdd) String() string {
+	var s []string
+	if this.ActivityStreamsId != nil {
+		s = append(s, "id: "+this.ActivityStreamsId.String())
+	}
+	if this.ActivityStreamsActor != nil {
+		s = append(s, "actor: "+this.ActivityStreamsActor.String())
+	}
+	if this.ActivityStreamsAltitude != nil {
+		s = append(s, "altitude: "+this.ActivityStreamsAltitude.String())
+	}
+	if this.ActivityStreamsAttachment != nil {
+		s = append(s, "attachment: "+this.ActivityStreamsAttachment.String())
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		s = append(s, "attributedTo: "+this.ActivityStreamsAttributedTo.String())
+	}
+	if this.ActivityStreamsAudience != nil {
+		s = append(s, "audience: "+this.ActivityStreamsAudience.String())
+	}
+	if this.ActivityStreamsBcc != nil {
+		s = append(s, "bcc: "+this.ActivityStreamsBcc.String())
+	}
+	if this.ActivityStreamsBto != nil {
+		s = append(s, "bto: "+this.ActivityStreamsBto.String())
+	}
+	if this.ActivityStreamsCc != nil {
+		s = append(s, "cc: "+this.ActivityStreamsCc.String())
+	}
+	if this.ActivityStreamsContent != nil {
+		s = append(s, "content: "+this.ActivityStreamsContent.String())
+	}
+	if this.ActivityStreamsContext != nil {
+		s = append(s, "context: "+this.ActivityStreamsContext.String())
+	}
+	if this.ActivityStreamsDuration != nil {
+		s = append(s, "duration: "+this.ActivityStreamsDuration.String())
+	}
+	if this.ActivityStreamsEndTime != nil {
+		s = append(s, "endTime: "+this.ActivityStreamsEndTime.String())
+	}
+	if this.ActivityStreamsGenerator != nil {
+		s = append(s, "generator: "+this.ActivityStreamsGenerator.String())
+	}
+	if this.ActivityStreamsIcon != nil {
+		s = append(s, "icon: "+this.ActivityStreamsIcon.String())
+	}
+	if this.ActivityStreamsImage != nil {
+		s = append(s, "image: "+this.ActivityStreamsImage.String())
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		s = append(s, "inReplyTo: "+this.ActivityStreamsInReplyTo.String())
+	}
+	if this.ActivityStreamsInstrument != nil {
+		s = append(s, "instrument: "+this.ActivityStreamsInstrument.String())
+	}
+	if this.ActivityStreamsLikes != nil {
+		s = append(s, "likes: "+this.ActivityStreamsLikes.String())
+	}
+	if this.ActivityStreamsLocation != nil {
+		s = append(s, "location: "+this.ActivityStreamsLocation.String())
 	}
-	return toMerge
+	if this.ActivityStreamsMediaType != nil {
+		s = append(s, "mediaType: "+this.ActivityStreamsMediaType.String())
+	}
+	if this.ActivityStreamsName != nil {
+		s = append(s, "name: "+this.ActivityStreamsName.String())
+	}
+	if this.ActivityStreamsObject != nil {
+		s = append(s, "object: "+this.ActivityStreamsObject.String())
+	}
+	if this.ActivityStreamsOrigin != nil {
+		s = append(s, "origin: "+this.ActivityStreamsOrigin.String())
+	}
+	if this.ActivityStreamsPreview != nil {
+		s = append(s, "preview: "+this.ActivityStreamsPreview.String())
+	}
+	if this.ActivityStreamsPublished != nil {
+		s = append(s, "published: "+this.ActivityStreamsPublished.String())
+	}
+	if this.ActivityStreamsReplies != nil {
+		s = append(s, "replies: "+this.ActivityStreamsReplies.String())
+	}
+	if this.ActivityStreamsResult != nil {
+		s = append(s, "result: "+this.ActivityStreamsResult.String())
+	}
+	if this.ActivityStreamsShares != nil {
+		s = append(s, "shares: "+this.ActivityStreamsShares.String())
+	}
+	if this.ActivityStreamsStartTime != nil {
+		s = append(s, "startTime: "+this.ActivityStreamsStartTime.String())
+	}
+	if this.ActivityStreamsSummary != nil {
+		s = append(s, "summary: "+this.ActivityStreamsSummary.String())
+	}
+	if this.ActivityStreamsTag != nil {
+		s = append(s, "tag: "+this.ActivityStreamsTag.String())
+	}
+	if this.ActivityStreamsTarget != nil {
+		s = append(s, "target: "+this.ActivityStreamsTarget.String())
+	}
+	if this.ActivityStreamsTo != nil {
+		s = append(s, "to: "+this.ActivityStreamsTo.String())
+	}
+	if this.ActivityStreamsUpdated != nil {
+		s = append(s, "updated: "+this.ActivityStreamsUpdated.String())
+	}
+	if this.ActivityStreamsUrl != nil {
+		s = append(s, "url: "+this.ActivityStreamsUrl.String())
+	}
+	if len(this.unknown) > 0 {
+		s = append(s, fmt.Sprintf("+%d unknown", len(this.unknown)))
+	}
+	return "Add{" + strings.Join(s, ", ") + "}"
+}
+
+// SummaryIRI returns the first value of the "summary" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) SummaryIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// SummaryLangString returns the first value of the "summary" property that is of
+// type "langString", and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) SummaryLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// SummaryString returns the first value of the "summary" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) SummaryString() (v string, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// TagIRI returns the first value of the "tag" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsAdd) TagIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsTag == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTag.Len(); i++ {
+		if iter := this.ActivityStreamsTag.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// TagType returns the first value of the "tag" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) TagType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsTag == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTag.Len(); i++ {
+		if iter := this.ActivityStreamsTag.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// TargetIRI returns the first value of the "target" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) TargetIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsTarget == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTarget.Len(); i++ {
+		if iter := this.ActivityStreamsTarget.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// TargetType returns the first value of the "target" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) TargetType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsTarget == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTarget.Len(); i++ {
+		if iter := this.ActivityStreamsTarget.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// ToIRI returns the first value of the "to" property that is an IRI, and false if
+// the property is not set or has no such value.
+func (this ActivityStreamsAdd) ToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTo.Len(); i++ {
+		if iter := this.ActivityStreamsTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ToType returns the first value of the "to" property that is an ActivityStreams
+// type, and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) ToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTo.Len(); i++ {
+		if iter := this.ActivityStreamsTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// TypeAnyURI returns the first value of the "type" property that is of type
+// "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) TypeAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.GetXMLSchemaAnyURI(), true
+		}
+	}
+	return
+}
+
+// TypeIRI returns the first value of the "type" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) TypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// TypeString returns the first value of the "type" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) TypeString() (v string, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// UpdatedDateTime returns the value of the "updated" property if it is of type
+// "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsAdd) UpdatedDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsUpdated != nil && this.ActivityStreamsUpdated.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsUpdated.Get(), true
+	}
+	return
+}
+
+// UpdatedIRI returns the value of the "updated" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsAdd) UpdatedIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsUpdated != nil && this.ActivityStreamsUpdated.IsIRI() {
+		return this.ActivityStreamsUpdated.GetIRI(), true
+	}
+	return
+}
+
+// UrlAnyURI returns the first value of the "url" property that is of type
+// "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsAdd) UrlAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsUrl == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsUrl.Len(); i++ {
+		if iter := this.ActivityStreamsUrl.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.GetXMLSchemaAnyURI(), true
+		}
+	}
+	return
+}
+
+// UrlIRI returns the first value of the "url" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsAdd) UrlIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsUrl == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsUrl.Len(); i++ {
+		if iter := this.ActivityStreamsUrl.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// UrlType returns the first value of the "url" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsAdd) UrlType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsUrl == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsUrl.Len(); i++ {
+		if iter := this.ActivityStreamsUrl.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this ActivityStreamsAdd) VocabularyURI() string {
+	return "https://www.w3.org/ns/activitystreams"
 }
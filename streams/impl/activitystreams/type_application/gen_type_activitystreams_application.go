@@ -3,7 +3,11 @@ package typeapplication
 import (
 	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Describes a software application.
@@ -14,48 +18,52 @@ import (
 //     "type": "Application"
 //   }
 type ActivityStreamsApplication struct {
-	ActivityStreamsAltitude          vocab.ActivityStreamsAltitudeProperty
-	ActivityStreamsAttachment        vocab.ActivityStreamsAttachmentProperty
-	ActivityStreamsAttributedTo      vocab.ActivityStreamsAttributedToProperty
-	ActivityStreamsAudience          vocab.ActivityStreamsAudienceProperty
-	ActivityStreamsBcc               vocab.ActivityStreamsBccProperty
-	ActivityStreamsBto               vocab.ActivityStreamsBtoProperty
-	ActivityStreamsCc                vocab.ActivityStreamsCcProperty
-	ActivityStreamsContent           vocab.ActivityStreamsContentProperty
-	ActivityStreamsContext           vocab.ActivityStreamsContextProperty
-	ActivityStreamsDuration          vocab.ActivityStreamsDurationProperty
-	ActivityStreamsEndTime           vocab.ActivityStreamsEndTimeProperty
-	ActivityStreamsFollowers         vocab.ActivityStreamsFollowersProperty
-	ActivityStreamsFollowing         vocab.ActivityStreamsFollowingProperty
-	ActivityStreamsGenerator         vocab.ActivityStreamsGeneratorProperty
-	ActivityStreamsIcon              vocab.ActivityStreamsIconProperty
-	ActivityStreamsId                vocab.ActivityStreamsIdProperty
-	ActivityStreamsImage             vocab.ActivityStreamsImageProperty
-	ActivityStreamsInReplyTo         vocab.ActivityStreamsInReplyToProperty
-	ActivityStreamsInbox             vocab.ActivityStreamsInboxProperty
-	ActivityStreamsLiked             vocab.ActivityStreamsLikedProperty
-	ActivityStreamsLikes             vocab.ActivityStreamsLikesProperty
-	ActivityStreamsLocation          vocab.ActivityStreamsLocationProperty
-	ActivityStreamsMediaType         vocab.ActivityStreamsMediaTypeProperty
-	ActivityStreamsName              vocab.ActivityStreamsNameProperty
-	ActivityStreamsObject            vocab.ActivityStreamsObjectProperty
-	ActivityStreamsOutbox            vocab.ActivityStreamsOutboxProperty
-	ActivityStreamsPreferredUsername vocab.ActivityStreamsPreferredUsernameProperty
-	ActivityStreamsPreview           vocab.ActivityStreamsPreviewProperty
-	ActivityStreamsPublicKey         vocab.ActivityStreamsPublicKeyProperty
-	ActivityStreamsPublished         vocab.ActivityStreamsPublishedProperty
-	ActivityStreamsReplies           vocab.ActivityStreamsRepliesProperty
-	ActivityStreamsShares            vocab.ActivityStreamsSharesProperty
-	ActivityStreamsStartTime         vocab.ActivityStreamsStartTimeProperty
-	ActivityStreamsStreams           vocab.ActivityStreamsStreamsProperty
-	ActivityStreamsSummary           vocab.ActivityStreamsSummaryProperty
-	ActivityStreamsTag               vocab.ActivityStreamsTagProperty
-	ActivityStreamsTo                vocab.ActivityStreamsToProperty
-	ActivityStreamsType              vocab.ActivityStreamsTypeProperty
-	ActivityStreamsUpdated           vocab.ActivityStreamsUpdatedProperty
-	ActivityStreamsUrl               vocab.ActivityStreamsUrlProperty
-	alias                            string
-	unknown                          map[string]interface{}
+	ActivityStreamsAlsoKnownAs               vocab.ActivityStreamsAlsoKnownAsProperty
+	ActivityStreamsAltitude                  vocab.ActivityStreamsAltitudeProperty
+	ActivityStreamsAttachment                vocab.ActivityStreamsAttachmentProperty
+	ActivityStreamsAttributedTo              vocab.ActivityStreamsAttributedToProperty
+	ActivityStreamsAudience                  vocab.ActivityStreamsAudienceProperty
+	ActivityStreamsBcc                       vocab.ActivityStreamsBccProperty
+	ActivityStreamsBto                       vocab.ActivityStreamsBtoProperty
+	ActivityStreamsCc                        vocab.ActivityStreamsCcProperty
+	ActivityStreamsContent                   vocab.ActivityStreamsContentProperty
+	ActivityStreamsContext                   vocab.ActivityStreamsContextProperty
+	ActivityStreamsDuration                  vocab.ActivityStreamsDurationProperty
+	ActivityStreamsEndTime                   vocab.ActivityStreamsEndTimeProperty
+	ActivityStreamsFeatured                  vocab.ActivityStreamsFeaturedProperty
+	ActivityStreamsFeaturedTags              vocab.ActivityStreamsFeaturedTagsProperty
+	ActivityStreamsFollowers                 vocab.ActivityStreamsFollowersProperty
+	ActivityStreamsFollowing                 vocab.ActivityStreamsFollowingProperty
+	ActivityStreamsGenerator                 vocab.ActivityStreamsGeneratorProperty
+	ActivityStreamsIcon                      vocab.ActivityStreamsIconProperty
+	ActivityStreamsId                        vocab.ActivityStreamsIdProperty
+	ActivityStreamsImage                     vocab.ActivityStreamsImageProperty
+	ActivityStreamsInReplyTo                 vocab.ActivityStreamsInReplyToProperty
+	ActivityStreamsInbox                     vocab.ActivityStreamsInboxProperty
+	ActivityStreamsLiked                     vocab.ActivityStreamsLikedProperty
+	ActivityStreamsLikes                     vocab.ActivityStreamsLikesProperty
+	ActivityStreamsLocation                  vocab.ActivityStreamsLocationProperty
+	ActivityStreamsManuallyApprovesFollowers vocab.ActivityStreamsManuallyApprovesFollowersProperty
+	ActivityStreamsMediaType                 vocab.ActivityStreamsMediaTypeProperty
+	ActivityStreamsName                      vocab.ActivityStreamsNameProperty
+	ActivityStreamsObject                    vocab.ActivityStreamsObjectProperty
+	ActivityStreamsOutbox                    vocab.ActivityStreamsOutboxProperty
+	ActivityStreamsPreferredUsername         vocab.ActivityStreamsPreferredUsernameProperty
+	ActivityStreamsPreview                   vocab.ActivityStreamsPreviewProperty
+	ActivityStreamsPublicKey                 vocab.ActivityStreamsPublicKeyProperty
+	ActivityStreamsPublished                 vocab.ActivityStreamsPublishedProperty
+	ActivityStreamsReplies                   vocab.ActivityStreamsRepliesProperty
+	ActivityStreamsShares                    vocab.ActivityStreamsSharesProperty
+	ActivityStreamsStartTime                 vocab.ActivityStreamsStartTimeProperty
+	ActivityStreamsStreams                   vocab.ActivityStreamsStreamsProperty
+	ActivityStreamsSummary                   vocab.ActivityStreamsSummaryProperty
+	ActivityStreamsTag                       vocab.ActivityStreamsTagProperty
+	ActivityStreamsTo                        vocab.ActivityStreamsToProperty
+	ActivityStreamsType                      vocab.ActivityStreamsTypeProperty
+	ActivityStreamsUpdated                   vocab.ActivityStreamsUpdatedProperty
+	ActivityStreamsUrl                       vocab.ActivityStreamsUrlProperty
+	alias                                    string
+	unknown                                  map[string]interface{}
 }
 
 // ActivityStreamsApplicationExtends returns true if the Application type extends
@@ -73,7 +81,7 @@ func ActivityStreamsApplicationExtends(other vocab.Type) bool {
 // ApplicationIsDisjointWith returns true if the other provided type is disjoint
 // with the Application type.
 func ApplicationIsDisjointWith(other vocab.Type) bool {
-	disjointWith := []string{"Link", "Mention"}
+	disjointWith := []string{"Hashtag", "Link", "Mention"}
 	for _, disjoint := range disjointWith {
 		if disjoint == other.GetTypeName() {
 			return true
@@ -127,6 +135,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
 	}
 	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeAlsoKnownAsPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAlsoKnownAs = p
+	}
 	if p, err := mgr.DeserializeAltitudePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -182,6 +195,16 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsEndTime = p
 	}
+	if p, err := mgr.DeserializeFeaturedPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsFeatured = p
+	}
+	if p, err := mgr.DeserializeFeaturedTagsPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsFeaturedTags = p
+	}
 	if p, err := mgr.DeserializeFollowersPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -237,6 +260,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsLocation = p
 	}
+	if p, err := mgr.DeserializeManuallyApprovesFollowersPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsManuallyApprovesFollowers = p
+	}
 	if p, err := mgr.DeserializeMediaTypePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -332,7 +360,9 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	// Begin: Unknown deserialization
 	for k, v := range m {
 		// Begin: Code that ensures a property name is unknown
-		if k == "altitude" {
+		if k == "alsoKnownAs" {
+			continue
+		} else if k == "altitude" {
 			continue
 		} else if k == "attachment" {
 			continue
@@ -356,6 +386,10 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "endTime" {
 			continue
+		} else if k == "featured" {
+			continue
+		} else if k == "featuredTags" {
+			continue
 		} else if k == "followers" {
 			continue
 		} else if k == "following" {
@@ -378,6 +412,8 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "location" {
 			continue
+		} else if k == "manuallyApprovesFollowers" {
+			continue
 		} else if k == "mediaType" {
 			continue
 		} else if k == "name" {
@@ -449,6 +485,1222 @@ func NewActivityStreamsApplication() *ActivityStreamsApplication {
 	}
 }
 
+// applyMapValue calls apply with each form coerceMapValue coerces the value into,
+// until one is applied. Returns an error if none is.
+func applyMapValue(v interface{}, apply func(interface{}) bool) error {
+	for _, c := range coerceMapValue(v) {
+		if apply(c) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot apply a value of type %T", v)
+}
+
+// coerceMapValue returns the forms of a value unmarshalled from JSON that the
+// value may be given as, in order of preference.
+func coerceMapValue(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case string:
+		c := []interface{}{t}
+		if f, err := strconv.ParseFloat(t, 64); err == nil {
+			c = append(c, f)
+		}
+		if b, err := strconv.ParseBool(t); err == nil {
+			c = append(c, b)
+		}
+		return c
+	case int:
+		return []interface{}{float64(t)}
+	case int64:
+		return []interface{}{float64(t)}
+	case float32:
+		return []interface{}{float64(t)}
+	case *url.URL:
+		return []interface{}{t.String()}
+	case time.Time:
+		return []interface{}{t.Format(time.RFC3339)}
+	case []string:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e
+		}
+		return []interface{}{s}
+	case []*url.URL:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e.String()
+		}
+		return []interface{}{s}
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = coerceMapValue(e)[0]
+		}
+		return []interface{}{s}
+	}
+	return []interface{}{v}
+}
+
+// AlsoKnownAsAnyURI returns the first value of the "alsoKnownAs" property that is
+// of type "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) AlsoKnownAsAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAlsoKnownAs == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAlsoKnownAs.Len(); i++ {
+		if iter := this.ActivityStreamsAlsoKnownAs.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.Get(), true
+		}
+	}
+	return
+}
+
+// AlsoKnownAsIRI returns the first value of the "alsoKnownAs" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) AlsoKnownAsIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAlsoKnownAs == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAlsoKnownAs.Len(); i++ {
+		if iter := this.ActivityStreamsAlsoKnownAs.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AltitudeFloat returns the value of the "altitude" property if it is of type
+// "float", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) AltitudeFloat() (v float64, ok bool) {
+	if this.ActivityStreamsAltitude != nil && this.ActivityStreamsAltitude.IsXMLSchemaFloat() {
+		return this.ActivityStreamsAltitude.Get(), true
+	}
+	return
+}
+
+// AltitudeIRI returns the value of the "altitude" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) AltitudeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAltitude != nil && this.ActivityStreamsAltitude.IsIRI() {
+		return this.ActivityStreamsAltitude.GetIRI(), true
+	}
+	return
+}
+
+// ApplyMap sets the properties named by the keys of the map, named as by
+// GetProperty, to its values. A nil value clears the property. The values may
+// be those of a map unmarshalled from JSON, or plain Go values such as
+// strings, numbers, bools, time.Time, *url.URL, and slices of them. A string
+// is also tried as a number or a bool if the property does not accept it as
+// is, such as the values of a submitted form. Every value that can be applied
+// is, and the returned error lists each key that could not be.
+func (this *ActivityStreamsApplication) ApplyMap(m map[string]interface{}) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var errs []string
+	for _, k := range keys {
+		var err error
+		switch k {
+		case "alsoKnownAs":
+			if m[k] == nil {
+				this.ActivityStreamsAlsoKnownAs = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAlsoKnownAsPropertyActivityStreams()(map[string]interface{}{"alsoKnownAs": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAlsoKnownAs = p
+					return true
+				})
+			}
+		case "altitude":
+			if m[k] == nil {
+				this.ActivityStreamsAltitude = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAltitudePropertyActivityStreams()(map[string]interface{}{"altitude": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsAltitude = p
+					return true
+				})
+			}
+		case "attachment":
+			if m[k] == nil {
+				this.ActivityStreamsAttachment = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAttachmentPropertyActivityStreams()(map[string]interface{}{"attachment": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAttachment = p
+					return true
+				})
+			}
+		case "attributedTo":
+			if m[k] == nil {
+				this.ActivityStreamsAttributedTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAttributedToPropertyActivityStreams()(map[string]interface{}{"attributedTo": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAttributedTo = p
+					return true
+				})
+			}
+		case "audience":
+			if m[k] == nil {
+				this.ActivityStreamsAudience = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAudiencePropertyActivityStreams()(map[string]interface{}{"audience": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAudience = p
+					return true
+				})
+			}
+		case "bcc":
+			if m[k] == nil {
+				this.ActivityStreamsBcc = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeBccPropertyActivityStreams()(map[string]interface{}{"bcc": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsBcc = p
+					return true
+				})
+			}
+		case "bto":
+			if m[k] == nil {
+				this.ActivityStreamsBto = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeBtoPropertyActivityStreams()(map[string]interface{}{"bto": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsBto = p
+					return true
+				})
+			}
+		case "cc":
+			if m[k] == nil {
+				this.ActivityStreamsCc = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeCcPropertyActivityStreams()(map[string]interface{}{"cc": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsCc = p
+					return true
+				})
+			}
+		case "content":
+			if m[k] == nil {
+				this.ActivityStreamsContent = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeContentPropertyActivityStreams()(map[string]interface{}{"content": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsContent = p
+					return true
+				})
+			}
+		case "context":
+			if m[k] == nil {
+				this.ActivityStreamsContext = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeContextPropertyActivityStreams()(map[string]interface{}{"context": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsContext = p
+					return true
+				})
+			}
+		case "duration":
+			if m[k] == nil {
+				this.ActivityStreamsDuration = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeDurationPropertyActivityStreams()(map[string]interface{}{"duration": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsDuration = p
+					return true
+				})
+			}
+		case "endTime":
+			if m[k] == nil {
+				this.ActivityStreamsEndTime = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeEndTimePropertyActivityStreams()(map[string]interface{}{"endTime": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsEndTime = p
+					return true
+				})
+			}
+		case "featured":
+			if m[k] == nil {
+				this.ActivityStreamsFeatured = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeFeaturedPropertyActivityStreams()(map[string]interface{}{"featured": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsFeatured = p
+					return true
+				})
+			}
+		case "featuredTags":
+			if m[k] == nil {
+				this.ActivityStreamsFeaturedTags = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeFeaturedTagsPropertyActivityStreams()(map[string]interface{}{"featuredTags": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsFeaturedTags = p
+					return true
+				})
+			}
+		case "followers":
+			if m[k] == nil {
+				this.ActivityStreamsFollowers = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeFollowersPropertyActivityStreams()(map[string]interface{}{"followers": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsFollowers = p
+					return true
+				})
+			}
+		case "following":
+			if m[k] == nil {
+				this.ActivityStreamsFollowing = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeFollowingPropertyActivityStreams()(map[string]interface{}{"following": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsFollowing = p
+					return true
+				})
+			}
+		case "generator":
+			if m[k] == nil {
+				this.ActivityStreamsGenerator = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeGeneratorPropertyActivityStreams()(map[string]interface{}{"generator": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsGenerator = p
+					return true
+				})
+			}
+		case "icon":
+			if m[k] == nil {
+				this.ActivityStreamsIcon = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeIconPropertyActivityStreams()(map[string]interface{}{"icon": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsIcon = p
+					return true
+				})
+			}
+		case "id":
+			if m[k] == nil {
+				this.ActivityStreamsId = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeIdPropertyActivityStreams()(map[string]interface{}{"id": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsId = p
+					return true
+				})
+			}
+		case "image":
+			if m[k] == nil {
+				this.ActivityStreamsImage = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeImagePropertyActivityStreams()(map[string]interface{}{"image": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsImage = p
+					return true
+				})
+			}
+		case "inReplyTo":
+			if m[k] == nil {
+				this.ActivityStreamsInReplyTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeInReplyToPropertyActivityStreams()(map[string]interface{}{"inReplyTo": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsInReplyTo = p
+					return true
+				})
+			}
+		case "inbox":
+			if m[k] == nil {
+				this.ActivityStreamsInbox = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeInboxPropertyActivityStreams()(map[string]interface{}{"inbox": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsInbox = p
+					return true
+				})
+			}
+		case "liked":
+			if m[k] == nil {
+				this.ActivityStreamsLiked = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeLikedPropertyActivityStreams()(map[string]interface{}{"liked": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsLiked = p
+					return true
+				})
+			}
+		case "likes":
+			if m[k] == nil {
+				this.ActivityStreamsLikes = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeLikesPropertyActivityStreams()(map[string]interface{}{"likes": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsLikes = p
+					return true
+				})
+			}
+		case "location":
+			if m[k] == nil {
+				this.ActivityStreamsLocation = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeLocationPropertyActivityStreams()(map[string]interface{}{"location": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsLocation = p
+					return true
+				})
+			}
+		case "manuallyApprovesFollowers":
+			if m[k] == nil {
+				this.ActivityStreamsManuallyApprovesFollowers = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeManuallyApprovesFollowersPropertyActivityStreams()(map[string]interface{}{"manuallyApprovesFollowers": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsManuallyApprovesFollowers = p
+					return true
+				})
+			}
+		case "mediaType":
+			if m[k] == nil {
+				this.ActivityStreamsMediaType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeMediaTypePropertyActivityStreams()(map[string]interface{}{"mediaType": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsMediaType = p
+					return true
+				})
+			}
+		case "name":
+			if m[k] == nil {
+				this.ActivityStreamsName = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeNamePropertyActivityStreams()(map[string]interface{}{"name": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsName = p
+					return true
+				})
+			}
+		case "object":
+			if m[k] == nil {
+				this.ActivityStreamsObject = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeObjectPropertyActivityStreams()(map[string]interface{}{"object": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsObject = p
+					return true
+				})
+			}
+		case "outbox":
+			if m[k] == nil {
+				this.ActivityStreamsOutbox = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeOutboxPropertyActivityStreams()(map[string]interface{}{"outbox": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsOutbox = p
+					return true
+				})
+			}
+		case "preferredUsername":
+			if m[k] == nil {
+				this.ActivityStreamsPreferredUsername = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePreferredUsernamePropertyActivityStreams()(map[string]interface{}{"preferredUsername": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsPreferredUsername = p
+					return true
+				})
+			}
+		case "preview":
+			if m[k] == nil {
+				this.ActivityStreamsPreview = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePreviewPropertyActivityStreams()(map[string]interface{}{"preview": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsPreview = p
+					return true
+				})
+			}
+		case "publicKey":
+			if m[k] == nil {
+				this.ActivityStreamsPublicKey = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePublicKeyPropertyActivityStreams()(map[string]interface{}{"publicKey": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsPublicKey = p
+					return true
+				})
+			}
+		case "published":
+			if m[k] == nil {
+				this.ActivityStreamsPublished = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePublishedPropertyActivityStreams()(map[string]interface{}{"published": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsPublished = p
+					return true
+				})
+			}
+		case "replies":
+			if m[k] == nil {
+				this.ActivityStreamsReplies = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeRepliesPropertyActivityStreams()(map[string]interface{}{"replies": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsReplies = p
+					return true
+				})
+			}
+		case "shares":
+			if m[k] == nil {
+				this.ActivityStreamsShares = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeSharesPropertyActivityStreams()(map[string]interface{}{"shares": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsShares = p
+					return true
+				})
+			}
+		case "startTime":
+			if m[k] == nil {
+				this.ActivityStreamsStartTime = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeStartTimePropertyActivityStreams()(map[string]interface{}{"startTime": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsStartTime = p
+					return true
+				})
+			}
+		case "streams":
+			if m[k] == nil {
+				this.ActivityStreamsStreams = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeStreamsPropertyActivityStreams()(map[string]interface{}{"streams": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsStreams = p
+					return true
+				})
+			}
+		case "summary":
+			if m[k] == nil {
+				this.ActivityStreamsSummary = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeSummaryPropertyActivityStreams()(map[string]interface{}{"summary": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsSummary = p
+					return true
+				})
+			}
+		case "tag":
+			if m[k] == nil {
+				this.ActivityStreamsTag = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTagPropertyActivityStreams()(map[string]interface{}{"tag": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsTag = p
+					return true
+				})
+			}
+		case "to":
+			if m[k] == nil {
+				this.ActivityStreamsTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeToPropertyActivityStreams()(map[string]interface{}{"to": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsTo = p
+					return true
+				})
+			}
+		case "type":
+			if m[k] == nil {
+				this.ActivityStreamsType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTypePropertyActivityStreams()(map[string]interface{}{"type": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsType = p
+					return true
+				})
+			}
+		case "updated":
+			if m[k] == nil {
+				this.ActivityStreamsUpdated = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeUpdatedPropertyActivityStreams()(map[string]interface{}{"updated": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsUpdated = p
+					return true
+				})
+			}
+		case "url":
+			if m[k] == nil {
+				this.ActivityStreamsUrl = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeUrlPropertyActivityStreams()(map[string]interface{}{"url": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsUrl = p
+					return true
+				})
+			}
+		default:
+			err = fmt.Errorf("no such property")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%q: %s", k, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot apply %d of %d values to the Application type: %s", len(errs), len(m), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// AttachmentIRI returns the first value of the "attachment" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) AttachmentIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAttachment == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttachment.Len(); i++ {
+		if iter := this.ActivityStreamsAttachment.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AttachmentType returns the first value of the "attachment" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) AttachmentType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAttachment == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttachment.Len(); i++ {
+		if iter := this.ActivityStreamsAttachment.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// AttributedToIRI returns the first value of the "attributedTo" property that is
+// an IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) AttributedToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAttributedTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttributedTo.Len(); i++ {
+		if iter := this.ActivityStreamsAttributedTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AttributedToType returns the first value of the "attributedTo" property that is
+// an ActivityStreams type, and false if the property is not set or has no
+// such value.
+func (this ActivityStreamsApplication) AttributedToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAttributedTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttributedTo.Len(); i++ {
+		if iter := this.ActivityStreamsAttributedTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// AudienceIRI returns the first value of the "audience" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) AudienceIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAudience == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAudience.Len(); i++ {
+		if iter := this.ActivityStreamsAudience.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AudienceType returns the first value of the "audience" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) AudienceType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAudience == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAudience.Len(); i++ {
+		if iter := this.ActivityStreamsAudience.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// BccIRI returns the first value of the "bcc" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsApplication) BccIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsBcc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBcc.Len(); i++ {
+		if iter := this.ActivityStreamsBcc.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// BccType returns the first value of the "bcc" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) BccType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsBcc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBcc.Len(); i++ {
+		if iter := this.ActivityStreamsBcc.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// BtoIRI returns the first value of the "bto" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsApplication) BtoIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsBto == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBto.Len(); i++ {
+		if iter := this.ActivityStreamsBto.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// BtoType returns the first value of the "bto" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) BtoType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsBto == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsBto.Len(); i++ {
+		if iter := this.ActivityStreamsBto.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// CcIRI returns the first value of the "cc" property that is an IRI, and false if
+// the property is not set or has no such value.
+func (this ActivityStreamsApplication) CcIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsCc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsCc.Len(); i++ {
+		if iter := this.ActivityStreamsCc.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// CcType returns the first value of the "cc" property that is an ActivityStreams
+// type, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) CcType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsCc == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsCc.Len(); i++ {
+		if iter := this.ActivityStreamsCc.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// ContentIRI returns the first value of the "content" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) ContentIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsContent == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContent.Len(); i++ {
+		if iter := this.ActivityStreamsContent.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ContentLangString returns the first value of the "content" property that is of
+// type "langString", and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) ContentLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsContent == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContent.Len(); i++ {
+		if iter := this.ActivityStreamsContent.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// ContentString returns the first value of the "content" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) ContentString() (v string, ok bool) {
+	if this.ActivityStreamsContent == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContent.Len(); i++ {
+		if iter := this.ActivityStreamsContent.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// ContextIRI returns the first value of the "context" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) ContextIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsContext == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContext.Len(); i++ {
+		if iter := this.ActivityStreamsContext.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ContextType returns the first value of the "context" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) ContextType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsContext == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsContext.Len(); i++ {
+		if iter := this.ActivityStreamsContext.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// DurationDuration returns the value of the "duration" property if it is of type
+// "duration", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) DurationDuration() (v time.Duration, ok bool) {
+	if this.ActivityStreamsDuration != nil && this.ActivityStreamsDuration.IsXMLSchemaDuration() {
+		return this.ActivityStreamsDuration.Get(), true
+	}
+	return
+}
+
+// DurationIRI returns the value of the "duration" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) DurationIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsDuration != nil && this.ActivityStreamsDuration.IsIRI() {
+		return this.ActivityStreamsDuration.GetIRI(), true
+	}
+	return
+}
+
+// EndTimeDateTime returns the value of the "endTime" property if it is of type
+// "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) EndTimeDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsEndTime != nil && this.ActivityStreamsEndTime.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsEndTime.Get(), true
+	}
+	return
+}
+
+// EndTimeIRI returns the value of the "endTime" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) EndTimeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsEndTime != nil && this.ActivityStreamsEndTime.IsIRI() {
+		return this.ActivityStreamsEndTime.GetIRI(), true
+	}
+	return
+}
+
+// FeaturedIRI returns the value of the "featured" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) FeaturedIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsFeatured != nil && this.ActivityStreamsFeatured.IsIRI() {
+		return this.ActivityStreamsFeatured.GetIRI(), true
+	}
+	return
+}
+
+// FeaturedTagsIRI returns the value of the "featuredTags" property if it is an
+// IRI, and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) FeaturedTagsIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsFeaturedTags != nil && this.ActivityStreamsFeaturedTags.IsIRI() {
+		return this.ActivityStreamsFeaturedTags.GetIRI(), true
+	}
+	return
+}
+
+// FeaturedTagsType returns the value of the "featuredTags" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) FeaturedTagsType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsFeaturedTags != nil && this.ActivityStreamsFeaturedTags.GetType() != nil {
+		return this.ActivityStreamsFeaturedTags.GetType(), true
+	}
+	return
+}
+
+// FeaturedType returns the value of the "featured" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) FeaturedType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsFeatured != nil && this.ActivityStreamsFeatured.GetType() != nil {
+		return this.ActivityStreamsFeatured.GetType(), true
+	}
+	return
+}
+
+// FollowersIRI returns the value of the "followers" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) FollowersIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsFollowers != nil && this.ActivityStreamsFollowers.IsIRI() {
+		return this.ActivityStreamsFollowers.GetIRI(), true
+	}
+	return
+}
+
+// FollowersType returns the value of the "followers" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) FollowersType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsFollowers != nil && this.ActivityStreamsFollowers.GetType() != nil {
+		return this.ActivityStreamsFollowers.GetType(), true
+	}
+	return
+}
+
+// FollowingIRI returns the value of the "following" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) FollowingIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsFollowing != nil && this.ActivityStreamsFollowing.IsIRI() {
+		return this.ActivityStreamsFollowing.GetIRI(), true
+	}
+	return
+}
+
+// FollowingType returns the value of the "following" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) FollowingType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsFollowing != nil && this.ActivityStreamsFollowing.GetType() != nil {
+		return this.ActivityStreamsFollowing.GetType(), true
+	}
+	return
+}
+
+// GeneratorIRI returns the first value of the "generator" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) GeneratorIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsGenerator == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsGenerator.Len(); i++ {
+		if iter := this.ActivityStreamsGenerator.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// GeneratorType returns the first value of the "generator" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) GeneratorType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsGenerator == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsGenerator.Len(); i++ {
+		if iter := this.ActivityStreamsGenerator.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// GetActivityStreamsAlsoKnownAs returns the "alsoKnownAs" property if it exists,
+// and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsAlsoKnownAs() vocab.ActivityStreamsAlsoKnownAsProperty {
+	return this.ActivityStreamsAlsoKnownAs
+}
+
 // GetActivityStreamsAltitude returns the "altitude" property if it exists, and
 // nil otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
@@ -514,6 +1766,18 @@ func (this ActivityStreamsApplication) GetActivityStreamsEndTime() vocab.Activit
 	return this.ActivityStreamsEndTime
 }
 
+// GetActivityStreamsFeatured returns the "featured" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsFeatured() vocab.ActivityStreamsFeaturedProperty {
+	return this.ActivityStreamsFeatured
+}
+
+// GetActivityStreamsFeaturedTags returns the "featuredTags" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsFeaturedTags() vocab.ActivityStreamsFeaturedTagsProperty {
+	return this.ActivityStreamsFeaturedTags
+}
+
 // GetActivityStreamsFollowers returns the "followers" property if it exists, and
 // nil otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsFollowers() vocab.ActivityStreamsFollowersProperty {
@@ -579,6 +1843,12 @@ func (this ActivityStreamsApplication) GetActivityStreamsLocation() vocab.Activi
 	return this.ActivityStreamsLocation
 }
 
+// GetActivityStreamsManuallyApprovesFollowers returns the
+// "manuallyApprovesFollowers" property if it exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetActivityStreamsManuallyApprovesFollowers() vocab.ActivityStreamsManuallyApprovesFollowersProperty {
+	return this.ActivityStreamsManuallyApprovesFollowers
+}
+
 // GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
 // nil otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
@@ -686,79 +1956,417 @@ func (this ActivityStreamsApplication) GetActivityStreamsUrl() vocab.ActivityStr
 	return this.ActivityStreamsUrl
 }
 
-// GetTypeName returns the name of this type.
-func (this ActivityStreamsApplication) GetTypeName() string {
-	return "Application"
-}
-
-// GetUnknownProperties returns the unknown properties for the Application type.
-// Note that this should not be used by app developers. It is only used to
-// help determine which implementation is LessThan the other. Developers who
-// are creating a different implementation of this type's interface can use
-// this method in their LessThan implementation, but routine ActivityPub
-// applications should not use this to bypass the code generation tool.
-func (this ActivityStreamsApplication) GetUnknownProperties() map[string]interface{} {
-	return this.unknown
-}
-
-// IsExtending returns true if the Application type extends from the other type.
-func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
-	return ActivityStreamsApplicationExtends(other)
-}
+// GetProperty returns the property with the name, such as "alsoKnownAs", which is
+// nil if it is not set. Returns false if this type has no such property. A
+// property whose name is shared with another property of this type is named
+// with its vocabulary as a prefix, such as "ActivityStreams:name". The value
+// may be passed to SetProperty on another value of this type.
+func (this ActivityStreamsApplication) GetProperty(name string) (interface{}, bool) {
+	switch name {
+	case "alsoKnownAs":
+		if this.ActivityStreamsAlsoKnownAs == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAlsoKnownAs, true
+	case "altitude":
+		if this.ActivityStreamsAltitude == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAltitude, true
+	case "attachment":
+		if this.ActivityStreamsAttachment == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAttachment, true
+	case "attributedTo":
+		if this.ActivityStreamsAttributedTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAttributedTo, true
+	case "audience":
+		if this.ActivityStreamsAudience == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAudience, true
+	case "bcc":
+		if this.ActivityStreamsBcc == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsBcc, true
+	case "bto":
+		if this.ActivityStreamsBto == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsBto, true
+	case "cc":
+		if this.ActivityStreamsCc == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsCc, true
+	case "content":
+		if this.ActivityStreamsContent == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsContent, true
+	case "context":
+		if this.ActivityStreamsContext == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsContext, true
+	case "duration":
+		if this.ActivityStreamsDuration == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsDuration, true
+	case "endTime":
+		if this.ActivityStreamsEndTime == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsEndTime, true
+	case "featured":
+		if this.ActivityStreamsFeatured == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsFeatured, true
+	case "featuredTags":
+		if this.ActivityStreamsFeaturedTags == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsFeaturedTags, true
+	case "followers":
+		if this.ActivityStreamsFollowers == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsFollowers, true
+	case "following":
+		if this.ActivityStreamsFollowing == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsFollowing, true
+	case "generator":
+		if this.ActivityStreamsGenerator == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsGenerator, true
+	case "icon":
+		if this.ActivityStreamsIcon == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsIcon, true
+	case "id":
+		if this.ActivityStreamsId == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsId, true
+	case "image":
+		if this.ActivityStreamsImage == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsImage, true
+	case "inReplyTo":
+		if this.ActivityStreamsInReplyTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsInReplyTo, true
+	case "inbox":
+		if this.ActivityStreamsInbox == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsInbox, true
+	case "liked":
+		if this.ActivityStreamsLiked == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsLiked, true
+	case "likes":
+		if this.ActivityStreamsLikes == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsLikes, true
+	case "location":
+		if this.ActivityStreamsLocation == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsLocation, true
+	case "manuallyApprovesFollowers":
+		if this.ActivityStreamsManuallyApprovesFollowers == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsManuallyApprovesFollowers, true
+	case "mediaType":
+		if this.ActivityStreamsMediaType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsMediaType, true
+	case "name":
+		if this.ActivityStreamsName == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsName, true
+	case "object":
+		if this.ActivityStreamsObject == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsObject, true
+	case "outbox":
+		if this.ActivityStreamsOutbox == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsOutbox, true
+	case "preferredUsername":
+		if this.ActivityStreamsPreferredUsername == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPreferredUsername, true
+	case "preview":
+		if this.ActivityStreamsPreview == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPreview, true
+	case "publicKey":
+		if this.ActivityStreamsPublicKey == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPublicKey, true
+	case "published":
+		if this.ActivityStreamsPublished == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPublished, true
+	case "replies":
+		if this.ActivityStreamsReplies == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsReplies, true
+	case "shares":
+		if this.ActivityStreamsShares == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsShares, true
+	case "startTime":
+		if this.ActivityStreamsStartTime == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsStartTime, true
+	case "streams":
+		if this.ActivityStreamsStreams == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsStreams, true
+	case "summary":
+		if this.ActivityStreamsSummary == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsSummary, true
+	case "tag":
+		if this.ActivityStreamsTag == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsTag, true
+	case "to":
+		if this.ActivityStreamsTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsTo, true
+	case "type":
+		if this.ActivityStreamsType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsType, true
+	case "updated":
+		if this.ActivityStreamsUpdated == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsUpdated, true
+	case "url":
+		if this.ActivityStreamsUrl == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsUrl, true
+	default:
+		return nil, false
+	}
+}
+
+// GetTypeName returns the name of this type.
+func (this ActivityStreamsApplication) GetTypeName() string {
+	return "Application"
+}
+
+// GetUnknownProperties returns the unknown properties for the Application type.
+// Note that this should not be used by app developers. It is only used to
+// help determine which implementation is LessThan the other. Developers who
+// are creating a different implementation of this type's interface can use
+// this method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this ActivityStreamsApplication) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GoString returns the same form as String, so that printing this value with the
+// %!v(MISSING) verb or in a debugger is readable instead of a dump of its members.
+func (this ActivityStreamsApplication) GoString() string {
+	return this.String()
+}
+
+// IconIRI returns the first value of the "icon" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) IconIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsIcon == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsIcon.Len(); i++ {
+		if iter := this.ActivityStreamsIcon.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// IconType returns the first value of the "icon" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) IconType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsIcon == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsIcon.Len(); i++ {
+		if iter := this.ActivityStreamsIcon.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// IdAnyURI returns the value of the "id" property if it is of type "anyURI", and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) IdAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsXMLSchemaAnyURI() {
+		return this.ActivityStreamsId.Get(), true
+	}
+	return
+}
+
+// IdIRI returns the value of the "id" property if it is an IRI, and false if the
+// property is not set or has another value.
+func (this ActivityStreamsApplication) IdIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsIRI() {
+		return this.ActivityStreamsId.GetIRI(), true
+	}
+	return
+}
+
+// ImageIRI returns the first value of the "image" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) ImageIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsImage == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsImage.Len(); i++ {
+		if iter := this.ActivityStreamsImage.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ImageType returns the first value of the "image" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) ImageType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsImage == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsImage.Len(); i++ {
+		if iter := this.ActivityStreamsImage.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// InReplyToIRI returns the first value of the "inReplyTo" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) InReplyToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsInReplyTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsInReplyTo.Len(); i++ {
+		if iter := this.ActivityStreamsInReplyTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// InReplyToType returns the first value of the "inReplyTo" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) InReplyToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsInReplyTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsInReplyTo.Len(); i++ {
+		if iter := this.ActivityStreamsInReplyTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// InboxIRI returns the value of the "inbox" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsApplication) InboxIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsInbox != nil && this.ActivityStreamsInbox.IsIRI() {
+		return this.ActivityStreamsInbox.GetIRI(), true
+	}
+	return
+}
+
+// InboxType returns the value of the "inbox" property if it is an ActivityStreams
+// type, and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) InboxType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsInbox != nil && this.ActivityStreamsInbox.GetType() != nil {
+		return this.ActivityStreamsInbox.GetType(), true
+	}
+	return
+}
+
+// IsExtending returns true if the Application type extends from the other type.
+func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
+	return ActivityStreamsApplicationExtends(other)
+}
 
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // type and the specific properties that are set. The value in the map is the
 // alias used to import the type and its properties.
 func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBcc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsBto, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsCc, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContent, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsContext, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsDuration, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsEndTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsFollowers, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsFollowing, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsGenerator, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsIcon, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsId, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsImage, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInReplyTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsInbox, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLiked, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLikes, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsLocation, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsMediaType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsName, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsOutbox, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPreferredUsername, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPublicKey, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsStreams, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsTo, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsType, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUpdated, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsUrl, m)
-
-	return m
+	/*
+	   Every property of this type, and every type they may
+	   hold, is in this type's vocabulary, so the context is
+	   determined at code-generation time.
+	*/
+	return map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 }
 
 // LessThan computes if this Application is lesser, with an arbitrary but stable
 // determination.
 func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplication) bool {
 	// Begin: Compare known properties
+	// Compare property "alsoKnownAs"
+	if lhs, rhs := this.ActivityStreamsAlsoKnownAs, o.GetActivityStreamsAlsoKnownAs(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "altitude"
 	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -913,6 +2521,34 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "featured"
+	if lhs, rhs := this.ActivityStreamsFeatured, o.GetActivityStreamsFeatured(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "featuredTags"
+	if lhs, rhs := this.ActivityStreamsFeaturedTags, o.GetActivityStreamsFeaturedTags(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "followers"
 	if lhs, rhs := this.ActivityStreamsFollowers, o.GetActivityStreamsFollowers(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1067,6 +2703,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "manuallyApprovesFollowers"
+	if lhs, rhs := this.ActivityStreamsManuallyApprovesFollowers, o.GetActivityStreamsManuallyApprovesFollowers(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "mediaType"
 	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1332,82 +2982,407 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 	return false
 }
 
-// Serialize converts this into an interface representation suitable for
-// marshalling into a text or binary format.
-func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, error) {
-	m := make(map[string]interface{})
-	typeName := "Application"
-	if len(this.alias) > 0 {
-		typeName = this.alias + ":" + "Application"
+// LikedIRI returns the value of the "liked" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsApplication) LikedIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsLiked != nil && this.ActivityStreamsLiked.IsIRI() {
+		return this.ActivityStreamsLiked.GetIRI(), true
 	}
-	m["type"] = typeName
-	// Begin: Serialize known properties
-	// Maybe serialize property "altitude"
-	if this.ActivityStreamsAltitude != nil {
-		if i, err := this.ActivityStreamsAltitude.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsAltitude.Name()] = i
-		}
+	return
+}
+
+// LikedType returns the value of the "liked" property if it is an ActivityStreams
+// type, and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) LikedType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsLiked != nil && this.ActivityStreamsLiked.GetType() != nil {
+		return this.ActivityStreamsLiked.GetType(), true
 	}
-	// Maybe serialize property "attachment"
-	if this.ActivityStreamsAttachment != nil {
-		if i, err := this.ActivityStreamsAttachment.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsAttachment.Name()] = i
-		}
+	return
+}
+
+// LikesIRI returns the value of the "likes" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsApplication) LikesIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsLikes != nil && this.ActivityStreamsLikes.IsIRI() {
+		return this.ActivityStreamsLikes.GetIRI(), true
 	}
-	// Maybe serialize property "attributedTo"
-	if this.ActivityStreamsAttributedTo != nil {
-		if i, err := this.ActivityStreamsAttributedTo.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsAttributedTo.Name()] = i
+	return
+}
+
+// LikesType returns the value of the "likes" property if it is an ActivityStreams
+// type, and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) LikesType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsLikes != nil && this.ActivityStreamsLikes.GetType() != nil {
+		return this.ActivityStreamsLikes.GetType(), true
+	}
+	return
+}
+
+// LocationIRI returns the first value of the "location" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) LocationIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsLocation == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsLocation.Len(); i++ {
+		if iter := this.ActivityStreamsLocation.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
 		}
 	}
-	// Maybe serialize property "audience"
-	if this.ActivityStreamsAudience != nil {
-		if i, err := this.ActivityStreamsAudience.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsAudience.Name()] = i
+	return
+}
+
+// LocationType returns the first value of the "location" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) LocationType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsLocation == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsLocation.Len(); i++ {
+		if iter := this.ActivityStreamsLocation.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
 		}
 	}
-	// Maybe serialize property "bcc"
-	if this.ActivityStreamsBcc != nil {
-		if i, err := this.ActivityStreamsBcc.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsBcc.Name()] = i
+	return
+}
+
+// ManuallyApprovesFollowersBoolean returns the value of the
+// "manuallyApprovesFollowers" property if it is of type "boolean", and false
+// if the property is not set or has another value.
+func (this ActivityStreamsApplication) ManuallyApprovesFollowersBoolean() (v bool, ok bool) {
+	if this.ActivityStreamsManuallyApprovesFollowers != nil && this.ActivityStreamsManuallyApprovesFollowers.IsXMLSchemaBoolean() {
+		return this.ActivityStreamsManuallyApprovesFollowers.Get(), true
+	}
+	return
+}
+
+// ManuallyApprovesFollowersIRI returns the value of the
+// "manuallyApprovesFollowers" property if it is an IRI, and false if the
+// property is not set or has another value.
+func (this ActivityStreamsApplication) ManuallyApprovesFollowersIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsManuallyApprovesFollowers != nil && this.ActivityStreamsManuallyApprovesFollowers.IsIRI() {
+		return this.ActivityStreamsManuallyApprovesFollowers.GetIRI(), true
+	}
+	return
+}
+
+// MediaTypeIRI returns the value of the "mediaType" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) MediaTypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsMediaType != nil && this.ActivityStreamsMediaType.IsIRI() {
+		return this.ActivityStreamsMediaType.GetIRI(), true
+	}
+	return
+}
+
+// MediaTypeRfc2045 returns the value of the "mediaType" property if it is of type
+// "rfc2045", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) MediaTypeRfc2045() (v string, ok bool) {
+	if this.ActivityStreamsMediaType != nil && this.ActivityStreamsMediaType.IsRFCRfc2045() {
+		return this.ActivityStreamsMediaType.Get(), true
+	}
+	return
+}
+
+// NameIRI returns the first value of the "name" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) NameIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
 		}
 	}
-	// Maybe serialize property "bto"
-	if this.ActivityStreamsBto != nil {
-		if i, err := this.ActivityStreamsBto.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsBto.Name()] = i
+	return
+}
+
+// NameLangString returns the first value of the "name" property that is of type
+// "langString", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) NameLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
 		}
 	}
-	// Maybe serialize property "cc"
-	if this.ActivityStreamsCc != nil {
-		if i, err := this.ActivityStreamsCc.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsCc.Name()] = i
+	return
+}
+
+// NameString returns the first value of the "name" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) NameString() (v string, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
 		}
 	}
-	// Maybe serialize property "content"
-	if this.ActivityStreamsContent != nil {
-		if i, err := this.ActivityStreamsContent.Serialize(); err != nil {
-			return nil, err
-		} else if i != nil {
-			m[this.ActivityStreamsContent.Name()] = i
+	return
+}
+
+// ObjectIRI returns the first value of the "object" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) ObjectIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsObject == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsObject.Len(); i++ {
+		if iter := this.ActivityStreamsObject.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
 		}
 	}
-	// Maybe serialize property "context"
-	if this.ActivityStreamsContext != nil {
+	return
+}
+
+// ObjectType returns the first value of the "object" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) ObjectType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsObject == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsObject.Len(); i++ {
+		if iter := this.ActivityStreamsObject.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// OutboxIRI returns the value of the "outbox" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsApplication) OutboxIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsOutbox != nil && this.ActivityStreamsOutbox.IsIRI() {
+		return this.ActivityStreamsOutbox.GetIRI(), true
+	}
+	return
+}
+
+// OutboxType returns the value of the "outbox" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) OutboxType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsOutbox != nil && this.ActivityStreamsOutbox.GetType() != nil {
+		return this.ActivityStreamsOutbox.GetType(), true
+	}
+	return
+}
+
+// PreferredUsernameIRI returns the value of the "preferredUsername" property if
+// it is an IRI, and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) PreferredUsernameIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPreferredUsername != nil && this.ActivityStreamsPreferredUsername.IsIRI() {
+		return this.ActivityStreamsPreferredUsername.GetIRI(), true
+	}
+	return
+}
+
+// PreferredUsernameLangString returns the value of the "preferredUsername"
+// property if it is of type "langString", and false if the property is not
+// set or has another value.
+func (this ActivityStreamsApplication) PreferredUsernameLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsPreferredUsername != nil && this.ActivityStreamsPreferredUsername.IsRDFLangString() {
+		return this.ActivityStreamsPreferredUsername.GetRDFLangString(), true
+	}
+	return
+}
+
+// PreferredUsernameString returns the value of the "preferredUsername" property
+// if it is of type "string", and false if the property is not set or has
+// another value.
+func (this ActivityStreamsApplication) PreferredUsernameString() (v string, ok bool) {
+	if this.ActivityStreamsPreferredUsername != nil && this.ActivityStreamsPreferredUsername.IsXMLSchemaString() {
+		return this.ActivityStreamsPreferredUsername.GetXMLSchemaString(), true
+	}
+	return
+}
+
+// PreviewIRI returns the first value of the "preview" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) PreviewIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPreview == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPreview.Len(); i++ {
+		if iter := this.ActivityStreamsPreview.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// PreviewType returns the first value of the "preview" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) PreviewType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsPreview == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPreview.Len(); i++ {
+		if iter := this.ActivityStreamsPreview.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// PublicKeyIRI returns the first value of the "publicKey" property that is an
+// IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) PublicKeyIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPublicKey == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPublicKey.Len(); i++ {
+		if iter := this.ActivityStreamsPublicKey.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// PublicKeyType returns the first value of the "publicKey" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) PublicKeyType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsPublicKey == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPublicKey.Len(); i++ {
+		if iter := this.ActivityStreamsPublicKey.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// PublishedDateTime returns the value of the "published" property if it is of
+// type "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) PublishedDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsPublished != nil && this.ActivityStreamsPublished.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsPublished.Get(), true
+	}
+	return
+}
+
+// PublishedIRI returns the value of the "published" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) PublishedIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPublished != nil && this.ActivityStreamsPublished.IsIRI() {
+		return this.ActivityStreamsPublished.GetIRI(), true
+	}
+	return
+}
+
+// RepliesIRI returns the value of the "replies" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) RepliesIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsReplies != nil && this.ActivityStreamsReplies.IsIRI() {
+		return this.ActivityStreamsReplies.GetIRI(), true
+	}
+	return
+}
+
+// RepliesType returns the value of the "replies" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) RepliesType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsReplies != nil && this.ActivityStreamsReplies.GetType() != nil {
+		return this.ActivityStreamsReplies.GetType(), true
+	}
+	return
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "Application"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "Application"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "alsoKnownAs"
+	if this.ActivityStreamsAlsoKnownAs != nil {
+		if i, err := this.ActivityStreamsAlsoKnownAs.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAlsoKnownAs.Name()] = i
+		}
+	}
+	// Maybe serialize property "altitude"
+	if this.ActivityStreamsAltitude != nil {
+		if i, err := this.ActivityStreamsAltitude.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAltitude.Name()] = i
+		}
+	}
+	// Maybe serialize property "attachment"
+	if this.ActivityStreamsAttachment != nil {
+		if i, err := this.ActivityStreamsAttachment.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAttachment.Name()] = i
+		}
+	}
+	// Maybe serialize property "attributedTo"
+	if this.ActivityStreamsAttributedTo != nil {
+		if i, err := this.ActivityStreamsAttributedTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAttributedTo.Name()] = i
+		}
+	}
+	// Maybe serialize property "audience"
+	if this.ActivityStreamsAudience != nil {
+		if i, err := this.ActivityStreamsAudience.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAudience.Name()] = i
+		}
+	}
+	// Maybe serialize property "bcc"
+	if this.ActivityStreamsBcc != nil {
+		if i, err := this.ActivityStreamsBcc.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsBcc.Name()] = i
+		}
+	}
+	// Maybe serialize property "bto"
+	if this.ActivityStreamsBto != nil {
+		if i, err := this.ActivityStreamsBto.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsBto.Name()] = i
+		}
+	}
+	// Maybe serialize property "cc"
+	if this.ActivityStreamsCc != nil {
+		if i, err := this.ActivityStreamsCc.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsCc.Name()] = i
+		}
+	}
+	// Maybe serialize property "content"
+	if this.ActivityStreamsContent != nil {
+		if i, err := this.ActivityStreamsContent.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsContent.Name()] = i
+		}
+	}
+	// Maybe serialize property "context"
+	if this.ActivityStreamsContext != nil {
 		if i, err := this.ActivityStreamsContext.Serialize(); err != nil {
 			return nil, err
 		} else if i != nil {
@@ -1430,6 +3405,22 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsEndTime.Name()] = i
 		}
 	}
+	// Maybe serialize property "featured"
+	if this.ActivityStreamsFeatured != nil {
+		if i, err := this.ActivityStreamsFeatured.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsFeatured.Name()] = i
+		}
+	}
+	// Maybe serialize property "featuredTags"
+	if this.ActivityStreamsFeaturedTags != nil {
+		if i, err := this.ActivityStreamsFeaturedTags.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsFeaturedTags.Name()] = i
+		}
+	}
 	// Maybe serialize property "followers"
 	if this.ActivityStreamsFollowers != nil {
 		if i, err := this.ActivityStreamsFollowers.Serialize(); err != nil {
@@ -1518,6 +3509,14 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 			m[this.ActivityStreamsLocation.Name()] = i
 		}
 	}
+	// Maybe serialize property "manuallyApprovesFollowers"
+	if this.ActivityStreamsManuallyApprovesFollowers != nil {
+		if i, err := this.ActivityStreamsManuallyApprovesFollowers.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsManuallyApprovesFollowers.Name()] = i
+		}
+	}
 	// Maybe serialize property "mediaType"
 	if this.ActivityStreamsMediaType != nil {
 		if i, err := this.ActivityStreamsMediaType.Serialize(); err != nil {
@@ -1676,6 +3675,11 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 	return m, nil
 }
 
+// SetActivityStreamsAlsoKnownAs sets the "alsoKnownAs" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsAlsoKnownAs(i vocab.ActivityStreamsAlsoKnownAsProperty) {
+	this.ActivityStreamsAlsoKnownAs = i
+}
+
 // SetActivityStreamsAltitude sets the "altitude" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsAltitude(i vocab.ActivityStreamsAltitudeProperty) {
 	this.ActivityStreamsAltitude = i
@@ -1731,6 +3735,16 @@ func (this *ActivityStreamsApplication) SetActivityStreamsEndTime(i vocab.Activi
 	this.ActivityStreamsEndTime = i
 }
 
+// SetActivityStreamsFeatured sets the "featured" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsFeatured(i vocab.ActivityStreamsFeaturedProperty) {
+	this.ActivityStreamsFeatured = i
+}
+
+// SetActivityStreamsFeaturedTags sets the "featuredTags" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsFeaturedTags(i vocab.ActivityStreamsFeaturedTagsProperty) {
+	this.ActivityStreamsFeaturedTags = i
+}
+
 // SetActivityStreamsFollowers sets the "followers" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsFollowers(i vocab.ActivityStreamsFollowersProperty) {
 	this.ActivityStreamsFollowers = i
@@ -1786,6 +3800,12 @@ func (this *ActivityStreamsApplication) SetActivityStreamsLocation(i vocab.Activ
 	this.ActivityStreamsLocation = i
 }
 
+// SetActivityStreamsManuallyApprovesFollowers sets the
+// "manuallyApprovesFollowers" property.
+func (this *ActivityStreamsApplication) SetActivityStreamsManuallyApprovesFollowers(i vocab.ActivityStreamsManuallyApprovesFollowersProperty) {
+	this.ActivityStreamsManuallyApprovesFollowers = i
+}
+
 // SetActivityStreamsMediaType sets the "mediaType" property.
 func (this *ActivityStreamsApplication) SetActivityStreamsMediaType(i vocab.ActivityStreamsMediaTypeProperty) {
 	this.ActivityStreamsMediaType = i
@@ -1876,24 +3896,912 @@ func (this *ActivityStreamsApplication) SetActivityStreamsUrl(i vocab.ActivitySt
 	this.ActivityStreamsUrl = i
 }
 
-// VocabularyURI returns the vocabulary's URI as a string.
-func (this ActivityStreamsApplication) VocabularyURI() string {
-	return "https://www.w3.org/ns/activitystreams"
+// SetProperty sets the property with the name, named as by GetProperty, to the
+// value, which must be that property's interface. A nil value clears the
+// property. Returns an error if this type has no such property or the value
+// is of another type.
+func (this *ActivityStreamsApplication) SetProperty(name string, v interface{}) error {
+	switch name {
+	case "alsoKnownAs":
+		if v == nil {
+			this.ActivityStreamsAlsoKnownAs = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAlsoKnownAsProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsAlsoKnownAs = p
+		return nil
+	case "altitude":
+		if v == nil {
+			this.ActivityStreamsAltitude = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAltitudeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsAltitude = p
+		return nil
+	case "attachment":
+		if v == nil {
+			this.ActivityStreamsAttachment = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAttachmentProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsAttachment = p
+		return nil
+	case "attributedTo":
+		if v == nil {
+			this.ActivityStreamsAttributedTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAttributedToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsAttributedTo = p
+		return nil
+	case "audience":
+		if v == nil {
+			this.ActivityStreamsAudience = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAudienceProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsAudience = p
+		return nil
+	case "bcc":
+		if v == nil {
+			this.ActivityStreamsBcc = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsBccProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsBcc = p
+		return nil
+	case "bto":
+		if v == nil {
+			this.ActivityStreamsBto = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsBtoProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsBto = p
+		return nil
+	case "cc":
+		if v == nil {
+			this.ActivityStreamsCc = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsCcProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsCc = p
+		return nil
+	case "content":
+		if v == nil {
+			this.ActivityStreamsContent = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsContentProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsContent = p
+		return nil
+	case "context":
+		if v == nil {
+			this.ActivityStreamsContext = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsContextProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsContext = p
+		return nil
+	case "duration":
+		if v == nil {
+			this.ActivityStreamsDuration = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsDurationProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsDuration = p
+		return nil
+	case "endTime":
+		if v == nil {
+			this.ActivityStreamsEndTime = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsEndTimeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsEndTime = p
+		return nil
+	case "featured":
+		if v == nil {
+			this.ActivityStreamsFeatured = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsFeaturedProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsFeatured = p
+		return nil
+	case "featuredTags":
+		if v == nil {
+			this.ActivityStreamsFeaturedTags = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsFeaturedTagsProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsFeaturedTags = p
+		return nil
+	case "followers":
+		if v == nil {
+			this.ActivityStreamsFollowers = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsFollowersProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsFollowers = p
+		return nil
+	case "following":
+		if v == nil {
+			this.ActivityStreamsFollowing = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsFollowingProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsFollowing = p
+		return nil
+	case "generator":
+		if v == nil {
+			this.ActivityStreamsGenerator = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsGeneratorProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsGenerator = p
+		return nil
+	case "icon":
+		if v == nil {
+			this.ActivityStreamsIcon = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsIconProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsIcon = p
+		return nil
+	case "id":
+		if v == nil {
+			this.ActivityStreamsId = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsIdProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsId = p
+		return nil
+	case "image":
+		if v == nil {
+			this.ActivityStreamsImage = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsImageProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsImage = p
+		return nil
+	case "inReplyTo":
+		if v == nil {
+			this.ActivityStreamsInReplyTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsInReplyToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsInReplyTo = p
+		return nil
+	case "inbox":
+		if v == nil {
+			this.ActivityStreamsInbox = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsInboxProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsInbox = p
+		return nil
+	case "liked":
+		if v == nil {
+			this.ActivityStreamsLiked = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsLikedProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsLiked = p
+		return nil
+	case "likes":
+		if v == nil {
+			this.ActivityStreamsLikes = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsLikesProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsLikes = p
+		return nil
+	case "location":
+		if v == nil {
+			this.ActivityStreamsLocation = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsLocationProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsLocation = p
+		return nil
+	case "manuallyApprovesFollowers":
+		if v == nil {
+			this.ActivityStreamsManuallyApprovesFollowers = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsManuallyApprovesFollowersProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsManuallyApprovesFollowers = p
+		return nil
+	case "mediaType":
+		if v == nil {
+			this.ActivityStreamsMediaType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsMediaTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsMediaType = p
+		return nil
+	case "name":
+		if v == nil {
+			this.ActivityStreamsName = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsNameProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsName = p
+		return nil
+	case "object":
+		if v == nil {
+			this.ActivityStreamsObject = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsObjectProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsObject = p
+		return nil
+	case "outbox":
+		if v == nil {
+			this.ActivityStreamsOutbox = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsOutboxProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsOutbox = p
+		return nil
+	case "preferredUsername":
+		if v == nil {
+			this.ActivityStreamsPreferredUsername = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPreferredUsernameProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsPreferredUsername = p
+		return nil
+	case "preview":
+		if v == nil {
+			this.ActivityStreamsPreview = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPreviewProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsPreview = p
+		return nil
+	case "publicKey":
+		if v == nil {
+			this.ActivityStreamsPublicKey = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPublicKeyProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsPublicKey = p
+		return nil
+	case "published":
+		if v == nil {
+			this.ActivityStreamsPublished = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPublishedProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsPublished = p
+		return nil
+	case "replies":
+		if v == nil {
+			this.ActivityStreamsReplies = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsRepliesProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsReplies = p
+		return nil
+	case "shares":
+		if v == nil {
+			this.ActivityStreamsShares = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsSharesProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsShares = p
+		return nil
+	case "startTime":
+		if v == nil {
+			this.ActivityStreamsStartTime = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsStartTimeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsStartTime = p
+		return nil
+	case "streams":
+		if v == nil {
+			this.ActivityStreamsStreams = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsStreamsProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsStreams = p
+		return nil
+	case "summary":
+		if v == nil {
+			this.ActivityStreamsSummary = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsSummaryProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsSummary = p
+		return nil
+	case "tag":
+		if v == nil {
+			this.ActivityStreamsTag = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTagProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsTag = p
+		return nil
+	case "to":
+		if v == nil {
+			this.ActivityStreamsTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsTo = p
+		return nil
+	case "type":
+		if v == nil {
+			this.ActivityStreamsType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsType = p
+		return nil
+	case "updated":
+		if v == nil {
+			this.ActivityStreamsUpdated = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsUpdatedProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsUpdated = p
+		return nil
+	case "url":
+		if v == nil {
+			this.ActivityStreamsUrl = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsUrlProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Application type to a %T", name, v)
+		}
+		this.ActivityStreamsUrl = p
+		return nil
+	default:
+		return fmt.Errorf("the Application type has no property %q", name)
+	}
+}
+
+// SharesIRI returns the value of the "shares" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsApplication) SharesIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsShares != nil && this.ActivityStreamsShares.IsIRI() {
+		return this.ActivityStreamsShares.GetIRI(), true
+	}
+	return
+}
+
+// SharesType returns the value of the "shares" property if it is an
+// ActivityStreams type, and false if the property is not set or has another
+// value.
+func (this ActivityStreamsApplication) SharesType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsShares != nil && this.ActivityStreamsShares.GetType() != nil {
+		return this.ActivityStreamsShares.GetType(), true
+	}
+	return
+}
+
+// StartTimeDateTime returns the value of the "startTime" property if it is of
+// type "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) StartTimeDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsStartTime != nil && this.ActivityStreamsStartTime.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsStartTime.Get(), true
+	}
+	return
+}
+
+// StartTimeIRI returns the value of the "startTime" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) StartTimeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsStartTime != nil && this.ActivityStreamsStartTime.IsIRI() {
+		return this.ActivityStreamsStartTime.GetIRI(), true
+	}
+	return
+}
+
+// StreamsIRI returns the first value of the "streams" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) StreamsIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsStreams == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsStreams.Len(); i++ {
+		if iter := this.ActivityStreamsStreams.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// StreamsType returns the first value of the "streams" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) StreamsType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsStreams == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsStreams.Len(); i++ {
+		if iter := this.ActivityStreamsStreams.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// String returns a compact, human-readable form of this Application for logs and
+// debugging, such as Application{id: https://example.com/1, name: "Example"}.
+// It lists the id first and then each other property that is set, with long
+// values shortened. It is not a serialization; use Serialize for that.
+func (this ActivityStreamsApplication) String() string {
+	var s []string
+	if this.ActivityStreamsId != nil {
+		s = append(s, "id: "+this.ActivityStreamsId.String())
+	}
+	if this.ActivityStreamsAlsoKnownAs != nil {
+		s = append(s, "alsoKnownAs: "+this.ActivityStreamsAlsoKnownAs.String())
+	}
+	if this.ActivityStreamsAltitude != nil {
+		s = append(s, "altitude: "+this.ActivityStreamsAltitude.String())
+	}
+	if this.ActivityStreamsAttachment != nil {
+		s = append(s, "attachment: "+this.ActivityStreamsAttachment.String())
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		s = append(s, "attributedTo: "+this.ActivityStreamsAttributedTo.String())
+	}
+	if this.ActivityStreamsAudience != nil {
+		s = append(s, "audience: "+this.ActivityStreamsAudience.String())
+	}
+	if this.ActivityStreamsBcc != nil {
+		s = append(s, "bcc: "+this.ActivityStreamsBcc.String())
+	}
+	if this.ActivityStreamsBto != nil {
+		s = append(s, "bto: "+this.ActivityStreamsBto.String())
+	}
+	if this.ActivityStreamsCc != nil {
+		s = append(s, "cc: "+this.ActivityStreamsCc.String())
+	}
+	if this.ActivityStreamsContent != nil {
+		s = append(s, "content: "+this.ActivityStreamsContent.String())
+	}
+	if this.ActivityStreamsContext != nil {
+		s = append(s, "context: "+this.ActivityStreamsContext.String())
+	}
+	if this.ActivityStreamsDuration != nil {
+		s = append(s, "duration: "+this.ActivityStreamsDuration.String())
+	}
+	if this.ActivityStreamsEndTime != nil {
+		s = append(s, "endTime: "+this.ActivityStreamsEndTime.String())
+	}
+	if this.ActivityStreamsFeatured != nil {
+		s = append(s, "featured: "+this.ActivityStreamsFeatured.String())
+	}
+	if this.ActivityStreamsFeaturedTags != nil {
+		s = append(s, "featuredTags: "+this.ActivityStreamsFeaturedTags.String())
+	}
+	if this.ActivityStreamsFollowers != nil {
+		s = append(s, "followers: "+this.ActivityStreamsFollowers.String())
+	}
+	if this.ActivityStreamsFollowing != nil {
+		s = append(s, "following: "+this.ActivityStreamsFollowing.String())
+	}
+	if this.ActivityStreamsGenerator != nil {
+		s = append(s, "generator: "+this.ActivityStreamsGenerator.String())
+	}
+	if this.ActivityStreamsIcon != nil {
+		s = append(s, "icon: "+this.ActivityStreamsIcon.String())
+	}
+	if this.ActivityStreamsImage != nil {
+		s = append(s, "image: "+this.ActivityStreamsImage.String())
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		s = append(s, "inReplyTo: "+this.ActivityStreamsInReplyTo.String())
+	}
+	if this.ActivityStreamsInbox != nil {
+		s = append(s, "inbox: "+this.ActivityStreamsInbox.String())
+	}
+	if this.ActivityStreamsLiked != nil {
+		s = append(s, "liked: "+this.ActivityStreamsLiked.String())
+	}
+	if this.ActivityStreamsLikes != nil {
+		s = append(s, "likes: "+this.ActivityStreamsLikes.String())
+	}
+	if this.ActivityStreamsLocation != nil {
+		s = append(s, "location: "+this.ActivityStreamsLocation.String())
+	}
+	if this.ActivityStreamsManuallyApprovesFollowers != nil {
+		s = append(s, "manuallyApprovesFollowers: "+this.ActivityStreamsManuallyApprovesFollowers.String())
+	}
+	if this.ActivityStreamsMediaType != nil {
+		s = append(s, "mediaType: "+this.ActivityStreamsMediaType.String())
+	}
+	if this.ActivityStreamsName != nil {
+		s = append(s, "name: "+this.ActivityStreamsName.String())
+	}
+	if this.ActivityStreamsObject != nil {
+		s = append(s, "object: "+this.ActivityStreamsObject.String())
+	}
+	if this.ActivityStreamsOutbox != nil {
+		s = append(s, "outbox: "+this.ActivityStreamsOutbox.String())
+	}
+	if this.ActivityStreamsPreferredUsername != nil {
+		s = append(s, "preferredUsername: "+this.ActivityStreamsPreferredUsername.String())
+	}
+	if this.ActivityStreamsPreview != nil {
+		s = append(s, "preview: "+this.ActivityStreamsPreview.String())
+	}
+	if this.ActivityStreamsPublicKey != nil {
+		s = append(s, "publicKey: "+this.ActivityStreamsPublicKey.String())
+	}
+	if this.ActivityStreamsPublished != nil {
+		s = append(s, "published: "+this.ActivityStreamsPublished.String())
+	}
+	if this.ActivityStreamsReplies != nil {
+		s = append(s, "replies: "+this.ActivityStreamsReplies.String())
+	}
+	if this.ActivityStreamsShares != nil {
+		s = append(s, "shares: "+this.ActivityStreamsShares.String())
+	}
+	if this.ActivityStreamsStartTime != nil {
+		s = append(s, "startTime: "+this.ActivityStreamsStartTime.String())
+	}
+	if this.ActivityStreamsStreams != nil {
+		s = append(s, "streams: "+this.ActivityStreamsStreams.String())
+	}
+	if this.ActivityStreamsSummary != nil {
+		s = append(s, "summary: "+this.ActivityStreamsSummary.String())
+	}
+	if this.ActivityStreamsTag != nil {
+		s = append(s, "tag: "+this.ActivityStreamsTag.String())
+	}
+	if this.ActivityStreamsTo != nil {
+		s = append(s, "to: "+this.ActivityStreamsTo.String())
+	}
+	if this.ActivityStreamsUpdated != nil {
+		s = append(s, "updated: "+this.ActivityStreamsUpdated.String())
+	}
+	if this.ActivityStreamsUrl != nil {
+		s = append(s, "url: "+this.ActivityStreamsUrl.String())
+	}
+	if len(this.unknown) > 0 {
+		s = append(s, fmt.Sprintf("+%d unknown", len(this.unknown)))
+	}
+	return "Application{" + strings.Join(s, ", ") + "}"
+}
+
+// SummaryIRI returns the first value of the "summary" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) SummaryIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// SummaryLangString returns the first value of the "summary" property that is of
+// type "langString", and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) SummaryLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// SummaryString returns the first value of the "summary" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) SummaryString() (v string, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// TagIRI returns the first value of the "tag" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsApplication) TagIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsTag == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTag.Len(); i++ {
+		if iter := this.ActivityStreamsTag.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// TagType returns the first value of the "tag" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) TagType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsTag == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTag.Len(); i++ {
+		if iter := this.ActivityStreamsTag.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// ToIRI returns the first value of the "to" property that is an IRI, and false if
+// the property is not set or has no such value.
+func (this ActivityStreamsApplication) ToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTo.Len(); i++ {
+		if iter := this.ActivityStreamsTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// ToType returns the first value of the "to" property that is an ActivityStreams
+// type, and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) ToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsTo.Len(); i++ {
+		if iter := this.ActivityStreamsTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// TypeAnyURI returns the first value of the "type" property that is of type
+// "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) TypeAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.GetXMLSchemaAnyURI(), true
+		}
+	}
+	return
+}
+
+// TypeIRI returns the first value of the "type" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) TypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
 }
 
-// helperJSONLDContext obtains the context uris and their aliases from a property,
-// if it is not nil.
-func (this ActivityStreamsApplication) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
-	if i == nil {
-		return toMerge
+// TypeString returns the first value of the "type" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) TypeString() (v string, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
 	}
-	for k, v := range i.JSONLDContext() {
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		toMerge[k] = v
+	return
+}
+
+// UpdatedDateTime returns the value of the "updated" property if it is of type
+// "dateTime", and false if the property is not set or has another value.
+func (this ActivityStreamsApplication) UpdatedDateTime() (v time.Time, ok bool) {
+	if this.ActivityStreamsUpdated != nil && this.ActivityStreamsUpdated.IsXMLSchemaDateTime() {
+		return this.ActivityStreamsUpdated.Get(), true
 	}
-	return toMerge
+	return
+}
+
+// UpdatedIRI returns the value of the "updated" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsApplication) UpdatedIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsUpdated != nil && this.ActivityStreamsUpdated.IsIRI() {
+		return this.ActivityStreamsUpdated.GetIRI(), true
+	}
+	return
+}
+
+// UrlAnyURI returns the first value of the "url" property that is of type
+// "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsApplication) UrlAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsUrl == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsUrl.Len(); i++ {
+		if iter := this.ActivityStreamsUrl.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.GetXMLSchemaAnyURI(), true
+		}
+	}
+	return
+}
+
+// UrlIRI returns the first value of the "url" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsApplication) UrlIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsUrl == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsUrl.Len(); i++ {
+		if iter := this.ActivityStreamsUrl.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// UrlType returns the first value of the "url" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsApplication) UrlType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsUrl == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsUrl.Len(); i++ {
+		if iter := this.ActivityStreamsUrl.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this ActivityStreamsApplication) VocabularyURI() string {
+	return "https://www.w3.org/ns/activitystreams"
 }
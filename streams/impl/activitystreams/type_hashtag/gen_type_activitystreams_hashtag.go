@@ -0,0 +1,1563 @@
+package typehashtag
+
+import (
+	"fmt"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A specialized Link that represents a #hashtag, whose href is the page of the
+// hashtag. It is not part of the ActivityStreams vocabulary, but is used as
+// as:Hashtag by Mastodon and other software.
+//
+// Hashtag Example (https://docs.joinmastodon.org/spec/activitypub/#ex-hashtag-jsonld):
+//   {
+//     "href": "https://example.com/tags/activitypub",
+//     "name": "#activitypub",
+//     "type": "Hashtag"
+//   }
+type ActivityStreamsHashtag struct {
+	ActivityStreamsAttributedTo vocab.ActivityStreamsAttributedToProperty
+	ActivityStreamsHeight       vocab.ActivityStreamsHeightProperty
+	ActivityStreamsHref         vocab.ActivityStreamsHrefProperty
+	ActivityStreamsHreflang     vocab.ActivityStreamsHreflangProperty
+	ActivityStreamsId           vocab.ActivityStreamsIdProperty
+	ActivityStreamsMediaType    vocab.ActivityStreamsMediaTypeProperty
+	ActivityStreamsName         vocab.ActivityStreamsNameProperty
+	ActivityStreamsPreview      vocab.ActivityStreamsPreviewProperty
+	ActivityStreamsRel          vocab.ActivityStreamsRelProperty
+	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
+	ActivityStreamsType         vocab.ActivityStreamsTypeProperty
+	ActivityStreamsWidth        vocab.ActivityStreamsWidthProperty
+	alias                       string
+	unknown                     map[string]interface{}
+}
+
+// ActivityStreamsHashtagExtends returns true if the Hashtag type extends from the
+// other type.
+func ActivityStreamsHashtagExtends(other vocab.Type) bool {
+	extensions := []string{"Link"}
+	for _, ext := range extensions {
+		if ext == other.GetTypeName() {
+			return true
+		}
+	}
+	return false
+}
+
+// DeserializeHashtag creates a Hashtag from a map representation that has been
+// unmarshalled from a text or binary format.
+func DeserializeHashtag(m map[string]interface{}, aliasMap map[string]string) (*ActivityStreamsHashtag, error) {
+	alias := ""
+	aliasPrefix := ""
+	if a, ok := aliasMap["https://www.w3.org/ns/activitystreams"]; ok {
+		alias = a
+		aliasPrefix = a + ":"
+	}
+	this := &ActivityStreamsHashtag{
+		alias:   alias,
+		unknown: make(map[string]interface{}),
+	}
+	if typeValue, ok := m["type"]; !ok {
+		return nil, fmt.Errorf("no \"type\" property in map")
+	} else if typeString, ok := typeValue.(string); ok {
+		typeName := strings.TrimPrefix(typeString, aliasPrefix)
+		if typeName != "Hashtag" {
+			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "Hashtag", typeName)
+		}
+		// Fall through, success in finding a proper Type
+	} else if arrType, ok := typeValue.([]interface{}); ok {
+		found := false
+		for _, elemVal := range arrType {
+			if typeString, ok := elemVal.(string); ok && strings.TrimPrefix(typeString, aliasPrefix) == "Hashtag" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "Hashtag")
+		}
+		// Fall through, success in finding a proper Type
+	} else {
+		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+	}
+	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeAttributedToPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsAttributedTo = p
+	}
+	if p, err := mgr.DeserializeHeightPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsHeight = p
+	}
+	if p, err := mgr.DeserializeHrefPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsHref = p
+	}
+	if p, err := mgr.DeserializeHreflangPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsHreflang = p
+	}
+	if p, err := mgr.DeserializeIdPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsId = p
+	}
+	if p, err := mgr.DeserializeMediaTypePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsMediaType = p
+	}
+	if p, err := mgr.DeserializeNamePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsName = p
+	}
+	if p, err := mgr.DeserializePreviewPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsPreview = p
+	}
+	if p, err := mgr.DeserializeRelPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsRel = p
+	}
+	if p, err := mgr.DeserializeSummaryPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsSummary = p
+	}
+	if p, err := mgr.DeserializeTypePropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsType = p
+	}
+	if p, err := mgr.DeserializeWidthPropertyActivityStreams()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.ActivityStreamsWidth = p
+	}
+	// End: Known property deserialization
+
+	// Begin: Unknown deserialization
+	for k, v := range m {
+		// Begin: Code that ensures a property name is unknown
+		if k == "attributedTo" {
+			continue
+		} else if k == "height" {
+			continue
+		} else if k == "href" {
+			continue
+		} else if k == "hreflang" {
+			continue
+		} else if k == "id" {
+			continue
+		} else if k == "mediaType" {
+			continue
+		} else if k == "name" {
+			continue
+		} else if k == "nameMap" {
+			continue
+		} else if k == "preview" {
+			continue
+		} else if k == "rel" {
+			continue
+		} else if k == "summary" {
+			continue
+		} else if k == "summaryMap" {
+			continue
+		} else if k == "type" {
+			continue
+		} else if k == "width" {
+			continue
+		} // End: Code that ensures a property name is unknown
+
+		this.unknown[k] = v
+	}
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// HashtagIsDisjointWith returns true if the other provided type is disjoint with
+// the Hashtag type.
+func HashtagIsDisjointWith(other vocab.Type) bool {
+	disjointWith := []string{"Accept", "Activity", "Add", "Announce", "Application", "Arrive", "Article", "Audio", "Block", "Collection", "CollectionPage", "Create", "Delete", "Dislike", "Document", "Emoji", "Event", "Flag", "Follow", "Group", "Ignore", "Image", "IntransitiveActivity", "Invite", "Join", "Leave", "Like", "Listen", "Move", "Note", "Object", "Offer", "OrderedCollection", "OrderedCollectionPage", "OrderedCollectionPage", "Organization", "Page", "Person", "Place", "Profile", "PropertyValue", "Question", "Read", "Reject", "Relationship", "Remove", "Service", "TentativeAccept", "TentativeReject", "Tombstone", "Travel", "Undo", "Update", "Video", "View"}
+	for _, disjoint := range disjointWith {
+		if disjoint == other.GetTypeName() {
+			return true
+		}
+	}
+	return false
+}
+
+// HashtagIsExtendedBy returns true if the other provided type extends from the
+// Hashtag type. Note that it returns false if the types are the same; see the
+// "IsOrExtendsHashtag" variant instead.
+func HashtagIsExtendedBy(other vocab.Type) bool {
+	// Shortcut implementation: is not extended by anything.
+	return false
+}
+
+// IsOrExtendsHashtag returns true if the other provided type is the Hashtag type
+// or extends from the Hashtag type.
+func IsOrExtendsHashtag(other vocab.Type) bool {
+	if other.GetTypeName() == "Hashtag" {
+		return true
+	}
+	return HashtagIsExtendedBy(other)
+}
+
+// NewActivityStreamsHashtag creates a new Hashtag type
+func NewActivityStreamsHashtag() *ActivityStreamsHashtag {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("Hashtag")
+	return &ActivityStreamsHashtag{
+		ActivityStreamsType: typeProp,
+		alias:               "",
+		unknown:             make(map[string]interface{}, 0),
+	}
+}
+
+// applyMapValue calls apply with each form coerceMapValue coerces the value into,
+// until one is applied. Returns an error if none is.
+func applyMapValue(v interface{}, apply func(interface{}) bool) error {
+	for _, c := range coerceMapValue(v) {
+		if apply(c) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot apply a value of type %T", v)
+}
+
+// coerceMapValue returns the forms of a value unmarshalled from JSON that the
+// value may be given as, in order of preference.
+func coerceMapValue(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case string:
+		c := []interface{}{t}
+		if f, err := strconv.ParseFloat(t, 64); err == nil {
+			c = append(c, f)
+		}
+		if b, err := strconv.ParseBool(t); err == nil {
+			c = append(c, b)
+		}
+		return c
+	case int:
+		return []interface{}{float64(t)}
+	case int64:
+		return []interface{}{float64(t)}
+	case float32:
+		return []interface{}{float64(t)}
+	case *url.URL:
+		return []interface{}{t.String()}
+	case time.Time:
+		return []interface{}{t.Format(time.RFC3339)}
+	case []string:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e
+		}
+		return []interface{}{s}
+	case []*url.URL:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e.String()
+		}
+		return []interface{}{s}
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = coerceMapValue(e)[0]
+		}
+		return []interface{}{s}
+	}
+	return []interface{}{v}
+}
+
+// ApplyMap sets the properties named by the keys of the map, named as by
+// GetProperty, to its values. A nil value clears the property. The values may
+// be those of a map unmarshalled from JSON, or plain Go values such as
+// strings, numbers, bools, time.Time, *url.URL, and slices of them. A string
+// is also tried as a number or a bool if the property does not accept it as
+// is, such as the values of a submitted form. Every value that can be applied
+// is, and the returned error lists each key that could not be.
+func (this *ActivityStreamsHashtag) ApplyMap(m map[string]interface{}) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var errs []string
+	for _, k := range keys {
+		var err error
+		switch k {
+		case "attributedTo":
+			if m[k] == nil {
+				this.ActivityStreamsAttributedTo = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeAttributedToPropertyActivityStreams()(map[string]interface{}{"attributedTo": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsAttributedTo = p
+					return true
+				})
+			}
+		case "height":
+			if m[k] == nil {
+				this.ActivityStreamsHeight = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeHeightPropertyActivityStreams()(map[string]interface{}{"height": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsHeight = p
+					return true
+				})
+			}
+		case "href":
+			if m[k] == nil {
+				this.ActivityStreamsHref = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeHrefPropertyActivityStreams()(map[string]interface{}{"href": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsHref = p
+					return true
+				})
+			}
+		case "hreflang":
+			if m[k] == nil {
+				this.ActivityStreamsHreflang = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeHreflangPropertyActivityStreams()(map[string]interface{}{"hreflang": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsHreflang = p
+					return true
+				})
+			}
+		case "id":
+			if m[k] == nil {
+				this.ActivityStreamsId = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeIdPropertyActivityStreams()(map[string]interface{}{"id": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsId = p
+					return true
+				})
+			}
+		case "mediaType":
+			if m[k] == nil {
+				this.ActivityStreamsMediaType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeMediaTypePropertyActivityStreams()(map[string]interface{}{"mediaType": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsMediaType = p
+					return true
+				})
+			}
+		case "name":
+			if m[k] == nil {
+				this.ActivityStreamsName = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeNamePropertyActivityStreams()(map[string]interface{}{"name": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsName = p
+					return true
+				})
+			}
+		case "preview":
+			if m[k] == nil {
+				this.ActivityStreamsPreview = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePreviewPropertyActivityStreams()(map[string]interface{}{"preview": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsPreview = p
+					return true
+				})
+			}
+		case "rel":
+			if m[k] == nil {
+				this.ActivityStreamsRel = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeRelPropertyActivityStreams()(map[string]interface{}{"rel": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsRel = p
+					return true
+				})
+			}
+		case "summary":
+			if m[k] == nil {
+				this.ActivityStreamsSummary = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeSummaryPropertyActivityStreams()(map[string]interface{}{"summary": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsSummary = p
+					return true
+				})
+			}
+		case "type":
+			if m[k] == nil {
+				this.ActivityStreamsType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTypePropertyActivityStreams()(map[string]interface{}{"type": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsType = p
+					return true
+				})
+			}
+		case "width":
+			if m[k] == nil {
+				this.ActivityStreamsWidth = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeWidthPropertyActivityStreams()(map[string]interface{}{"width": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsWidth = p
+					return true
+				})
+			}
+		default:
+			err = fmt.Errorf("no such property")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%q: %s", k, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot apply %d of %d values to the Hashtag type: %s", len(errs), len(m), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// AttributedToIRI returns the first value of the "attributedTo" property that is
+// an IRI, and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) AttributedToIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsAttributedTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttributedTo.Len(); i++ {
+		if iter := this.ActivityStreamsAttributedTo.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// AttributedToType returns the first value of the "attributedTo" property that is
+// an ActivityStreams type, and false if the property is not set or has no
+// such value.
+func (this ActivityStreamsHashtag) AttributedToType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsAttributedTo == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsAttributedTo.Len(); i++ {
+		if iter := this.ActivityStreamsAttributedTo.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// GetActivityStreamsAttributedTo returns the "attributedTo" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty {
+	return this.ActivityStreamsAttributedTo
+}
+
+// GetActivityStreamsHeight returns the "height" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsHeight() vocab.ActivityStreamsHeightProperty {
+	return this.ActivityStreamsHeight
+}
+
+// GetActivityStreamsHref returns the "href" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsHref() vocab.ActivityStreamsHrefProperty {
+	return this.ActivityStreamsHref
+}
+
+// GetActivityStreamsHreflang returns the "hreflang" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsHreflang() vocab.ActivityStreamsHreflangProperty {
+	return this.ActivityStreamsHreflang
+}
+
+// GetActivityStreamsId returns the "id" property if it exists, and nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsId() vocab.ActivityStreamsIdProperty {
+	return this.ActivityStreamsId
+}
+
+// GetActivityStreamsMediaType returns the "mediaType" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty {
+	return this.ActivityStreamsMediaType
+}
+
+// GetActivityStreamsName returns the "name" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsName() vocab.ActivityStreamsNameProperty {
+	return this.ActivityStreamsName
+}
+
+// GetActivityStreamsPreview returns the "preview" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsPreview() vocab.ActivityStreamsPreviewProperty {
+	return this.ActivityStreamsPreview
+}
+
+// GetActivityStreamsRel returns the "rel" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsRel() vocab.ActivityStreamsRelProperty {
+	return this.ActivityStreamsRel
+}
+
+// GetActivityStreamsSummary returns the "summary" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty {
+	return this.ActivityStreamsSummary
+}
+
+// GetActivityStreamsType returns the "type" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsType() vocab.ActivityStreamsTypeProperty {
+	return this.ActivityStreamsType
+}
+
+// GetActivityStreamsWidth returns the "width" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsHashtag) GetActivityStreamsWidth() vocab.ActivityStreamsWidthProperty {
+	return this.ActivityStreamsWidth
+}
+
+// GetProperty returns the property with the name, such as "attributedTo", which
+// is nil if it is not set. Returns false if this type has no such property. A
+// property whose name is shared with another property of this type is named
+// with its vocabulary as a prefix, such as "ActivityStreams:name". The value
+// may be passed to SetProperty on another value of this type.
+func (this ActivityStreamsHashtag) GetProperty(name string) (interface{}, bool) {
+	switch name {
+	case "attributedTo":
+		if this.ActivityStreamsAttributedTo == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsAttributedTo, true
+	case "height":
+		if this.ActivityStreamsHeight == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsHeight, true
+	case "href":
+		if this.ActivityStreamsHref == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsHref, true
+	case "hreflang":
+		if this.ActivityStreamsHreflang == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsHreflang, true
+	case "id":
+		if this.ActivityStreamsId == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsId, true
+	case "mediaType":
+		if this.ActivityStreamsMediaType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsMediaType, true
+	case "name":
+		if this.ActivityStreamsName == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsName, true
+	case "preview":
+		if this.ActivityStreamsPreview == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPreview, true
+	case "rel":
+		if this.ActivityStreamsRel == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsRel, true
+	case "summary":
+		if this.ActivityStreamsSummary == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsSummary, true
+	case "type":
+		if this.ActivityStreamsType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsType, true
+	case "width":
+		if this.ActivityStreamsWidth == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsWidth, true
+	default:
+		return nil, false
+	}
+}
+
+// GetTypeName returns the name of this type.
+func (this ActivityStreamsHashtag) GetTypeName() string {
+	return "Hashtag"
+}
+
+// GetUnknownProperties returns the unknown properties for the Hashtag type. Note
+// that this should not be used by app developers. It is only used to help
+// determine which implementation is LessThan the other. Developers who are
+// creating a different implementation of this type's interface can use this
+// method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this ActivityStreamsHashtag) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GoString returns the same form as String, so that printing this value with the
+// %!v(MISSING) verb or in a debugger is readable instead of a dump of its members.
+func (this ActivityStreamsHashtag) GoString() string {
+	return this.String()
+}
+
+// HeightIRI returns the value of the "height" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsHashtag) HeightIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsHeight != nil && this.ActivityStreamsHeight.IsIRI() {
+		return this.ActivityStreamsHeight.GetIRI(), true
+	}
+	return
+}
+
+// HeightNonNegativeInteger returns the value of the "height" property if it is of
+// type "nonNegativeInteger", and false if the property is not set or has
+// another value.
+func (this ActivityStreamsHashtag) HeightNonNegativeInteger() (v int, ok bool) {
+	if this.ActivityStreamsHeight != nil && this.ActivityStreamsHeight.IsXMLSchemaNonNegativeInteger() {
+		return this.ActivityStreamsHeight.Get(), true
+	}
+	return
+}
+
+// HrefAnyURI returns the value of the "href" property if it is of type "anyURI",
+// and false if the property is not set or has another value.
+func (this ActivityStreamsHashtag) HrefAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsHref != nil && this.ActivityStreamsHref.IsXMLSchemaAnyURI() {
+		return this.ActivityStreamsHref.Get(), true
+	}
+	return
+}
+
+// HrefIRI returns the value of the "href" property if it is an IRI, and false if
+// the property is not set or has another value.
+func (this ActivityStreamsHashtag) HrefIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsHref != nil && this.ActivityStreamsHref.IsIRI() {
+		return this.ActivityStreamsHref.GetIRI(), true
+	}
+	return
+}
+
+// HreflangBcp47 returns the value of the "hreflang" property if it is of type
+// "bcp47", and false if the property is not set or has another value.
+func (this ActivityStreamsHashtag) HreflangBcp47() (v string, ok bool) {
+	if this.ActivityStreamsHreflang != nil && this.ActivityStreamsHreflang.IsRFCBcp47() {
+		return this.ActivityStreamsHreflang.Get(), true
+	}
+	return
+}
+
+// HreflangIRI returns the value of the "hreflang" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsHashtag) HreflangIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsHreflang != nil && this.ActivityStreamsHreflang.IsIRI() {
+		return this.ActivityStreamsHreflang.GetIRI(), true
+	}
+	return
+}
+
+// IdAnyURI returns the value of the "id" property if it is of type "anyURI", and
+// false if the property is not set or has another value.
+func (this ActivityStreamsHashtag) IdAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsXMLSchemaAnyURI() {
+		return this.ActivityStreamsId.Get(), true
+	}
+	return
+}
+
+// IdIRI returns the value of the "id" property if it is an IRI, and false if the
+// property is not set or has another value.
+func (this ActivityStreamsHashtag) IdIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsIRI() {
+		return this.ActivityStreamsId.GetIRI(), true
+	}
+	return
+}
+
+// IsExtending returns true if the Hashtag type extends from the other type.
+func (this ActivityStreamsHashtag) IsExtending(other vocab.Type) bool {
+	return ActivityStreamsHashtagExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this ActivityStreamsHashtag) JSONLDContext() map[string]string {
+	/*
+	   Every property of this type, and every type they may
+	   hold, is in this type's vocabulary, so the context is
+	   determined at code-generation time.
+	*/
+	return map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
+}
+
+// LessThan computes if this Hashtag is lesser, with an arbitrary but stable
+// determination.
+func (this ActivityStreamsHashtag) LessThan(o vocab.ActivityStreamsHashtag) bool {
+	// Begin: Compare known properties
+	// Compare property "attributedTo"
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "height"
+	if lhs, rhs := this.ActivityStreamsHeight, o.GetActivityStreamsHeight(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "href"
+	if lhs, rhs := this.ActivityStreamsHref, o.GetActivityStreamsHref(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "hreflang"
+	if lhs, rhs := this.ActivityStreamsHreflang, o.GetActivityStreamsHreflang(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "id"
+	if lhs, rhs := this.ActivityStreamsId, o.GetActivityStreamsId(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "mediaType"
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "name"
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "preview"
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "rel"
+	if lhs, rhs := this.ActivityStreamsRel, o.GetActivityStreamsRel(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "summary"
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "type"
+	if lhs, rhs := this.ActivityStreamsType, o.GetActivityStreamsType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "width"
+	if lhs, rhs := this.ActivityStreamsWidth, o.GetActivityStreamsWidth(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// MediaTypeIRI returns the value of the "mediaType" property if it is an IRI, and
+// false if the property is not set or has another value.
+func (this ActivityStreamsHashtag) MediaTypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsMediaType != nil && this.ActivityStreamsMediaType.IsIRI() {
+		return this.ActivityStreamsMediaType.GetIRI(), true
+	}
+	return
+}
+
+// MediaTypeRfc2045 returns the value of the "mediaType" property if it is of type
+// "rfc2045", and false if the property is not set or has another value.
+func (this ActivityStreamsHashtag) MediaTypeRfc2045() (v string, ok bool) {
+	if this.ActivityStreamsMediaType != nil && this.ActivityStreamsMediaType.IsRFCRfc2045() {
+		return this.ActivityStreamsMediaType.Get(), true
+	}
+	return
+}
+
+// NameIRI returns the first value of the "name" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) NameIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// NameLangString returns the first value of the "name" property that is of type
+// "langString", and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) NameLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// NameString returns the first value of the "name" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) NameString() (v string, ok bool) {
+	if this.ActivityStreamsName == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsName.Len(); i++ {
+		if iter := this.ActivityStreamsName.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// PreviewIRI returns the first value of the "preview" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) PreviewIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPreview == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPreview.Len(); i++ {
+		if iter := this.ActivityStreamsPreview.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// PreviewType returns the first value of the "preview" property that is an
+// ActivityStreams type, and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsHashtag) PreviewType() (v vocab.Type, ok bool) {
+	if this.ActivityStreamsPreview == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsPreview.Len(); i++ {
+		if iter := this.ActivityStreamsPreview.At(i); iter.GetType() != nil {
+			return iter.GetType(), true
+		}
+	}
+	return
+}
+
+// RelIRI returns the first value of the "rel" property that is an IRI, and false
+// if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) RelIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsRel == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsRel.Len(); i++ {
+		if iter := this.ActivityStreamsRel.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// RelRfc5988 returns the first value of the "rel" property that is of type
+// "rfc5988", and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) RelRfc5988() (v string, ok bool) {
+	if this.ActivityStreamsRel == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsRel.Len(); i++ {
+		if iter := this.ActivityStreamsRel.At(i); iter.IsRFCRfc5988() {
+			return iter.Get(), true
+		}
+	}
+	return
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this ActivityStreamsHashtag) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "Hashtag"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "Hashtag"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "attributedTo"
+	if this.ActivityStreamsAttributedTo != nil {
+		if i, err := this.ActivityStreamsAttributedTo.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsAttributedTo.Name()] = i
+		}
+	}
+	// Maybe serialize property "height"
+	if this.ActivityStreamsHeight != nil {
+		if i, err := this.ActivityStreamsHeight.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsHeight.Name()] = i
+		}
+	}
+	// Maybe serialize property "href"
+	if this.ActivityStreamsHref != nil {
+		if i, err := this.ActivityStreamsHref.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsHref.Name()] = i
+		}
+	}
+	// Maybe serialize property "hreflang"
+	if this.ActivityStreamsHreflang != nil {
+		if i, err := this.ActivityStreamsHreflang.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsHreflang.Name()] = i
+		}
+	}
+	// Maybe serialize property "id"
+	if this.ActivityStreamsId != nil {
+		if i, err := this.ActivityStreamsId.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsId.Name()] = i
+		}
+	}
+	// Maybe serialize property "mediaType"
+	if this.ActivityStreamsMediaType != nil {
+		if i, err := this.ActivityStreamsMediaType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsMediaType.Name()] = i
+		}
+	}
+	// Maybe serialize property "name"
+	if this.ActivityStreamsName != nil {
+		if i, err := this.ActivityStreamsName.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsName.Name()] = i
+		}
+	}
+	// Maybe serialize property "preview"
+	if this.ActivityStreamsPreview != nil {
+		if i, err := this.ActivityStreamsPreview.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsPreview.Name()] = i
+		}
+	}
+	// Maybe serialize property "rel"
+	if this.ActivityStreamsRel != nil {
+		if i, err := this.ActivityStreamsRel.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsRel.Name()] = i
+		}
+	}
+	// Maybe serialize property "summary"
+	if this.ActivityStreamsSummary != nil {
+		if i, err := this.ActivityStreamsSummary.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSummary.Name()] = i
+		}
+	}
+	// Maybe serialize property "type"
+	if this.ActivityStreamsType != nil {
+		if i, err := this.ActivityStreamsType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsType.Name()] = i
+		}
+	}
+	// Maybe serialize property "width"
+	if this.ActivityStreamsWidth != nil {
+		if i, err := this.ActivityStreamsWidth.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsWidth.Name()] = i
+		}
+	}
+	// End: Serialize known properties
+
+	// Begin: Serialize unknown properties
+	for k, v := range this.unknown {
+		// To be safe, ensure we aren't overwriting a known property
+		if _, has := m[k]; !has {
+			m[k] = v
+		}
+	}
+	// End: Serialize unknown properties
+
+	return m, nil
+}
+
+// SetActivityStreamsAttributedTo sets the "attributedTo" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsAttributedTo(i vocab.ActivityStreamsAttributedToProperty) {
+	this.ActivityStreamsAttributedTo = i
+}
+
+// SetActivityStreamsHeight sets the "height" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsHeight(i vocab.ActivityStreamsHeightProperty) {
+	this.ActivityStreamsHeight = i
+}
+
+// SetActivityStreamsHref sets the "href" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsHref(i vocab.ActivityStreamsHrefProperty) {
+	this.ActivityStreamsHref = i
+}
+
+// SetActivityStreamsHreflang sets the "hreflang" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsHreflang(i vocab.ActivityStreamsHreflangProperty) {
+	this.ActivityStreamsHreflang = i
+}
+
+// SetActivityStreamsId sets the "id" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsId(i vocab.ActivityStreamsIdProperty) {
+	this.ActivityStreamsId = i
+}
+
+// SetActivityStreamsMediaType sets the "mediaType" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsMediaType(i vocab.ActivityStreamsMediaTypeProperty) {
+	this.ActivityStreamsMediaType = i
+}
+
+// SetActivityStreamsName sets the "name" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsName(i vocab.ActivityStreamsNameProperty) {
+	this.ActivityStreamsName = i
+}
+
+// SetActivityStreamsPreview sets the "preview" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsPreview(i vocab.ActivityStreamsPreviewProperty) {
+	this.ActivityStreamsPreview = i
+}
+
+// SetActivityStreamsRel sets the "rel" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsRel(i vocab.ActivityStreamsRelProperty) {
+	this.ActivityStreamsRel = i
+}
+
+// SetActivityStreamsSummary sets the "summary" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsSummary(i vocab.ActivityStreamsSummaryProperty) {
+	this.ActivityStreamsSummary = i
+}
+
+// SetActivityStreamsType sets the "type" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsType(i vocab.ActivityStreamsTypeProperty) {
+	this.ActivityStreamsType = i
+}
+
+// SetActivityStreamsWidth sets the "width" property.
+func (this *ActivityStreamsHashtag) SetActivityStreamsWidth(i vocab.ActivityStreamsWidthProperty) {
+	this.ActivityStreamsWidth = i
+}
+
+// SetProperty sets the property with the name, named as by GetProperty, to the
+// value, which must be that property's interface. A nil value clears the
+// property. Returns an error if this type has no such property or the value
+// is of another type.
+func (this *ActivityStreamsHashtag) SetProperty(name string, v interface{}) error {
+	switch name {
+	case "attributedTo":
+		if v == nil {
+			this.ActivityStreamsAttributedTo = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsAttributedToProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsAttributedTo = p
+		return nil
+	case "height":
+		if v == nil {
+			this.ActivityStreamsHeight = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsHeightProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsHeight = p
+		return nil
+	case "href":
+		if v == nil {
+			this.ActivityStreamsHref = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsHrefProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsHref = p
+		return nil
+	case "hreflang":
+		if v == nil {
+			this.ActivityStreamsHreflang = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsHreflangProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsHreflang = p
+		return nil
+	case "id":
+		if v == nil {
+			this.ActivityStreamsId = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsIdProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsId = p
+		return nil
+	case "mediaType":
+		if v == nil {
+			this.ActivityStreamsMediaType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsMediaTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsMediaType = p
+		return nil
+	case "name":
+		if v == nil {
+			this.ActivityStreamsName = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsNameProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsName = p
+		return nil
+	case "preview":
+		if v == nil {
+			this.ActivityStreamsPreview = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPreviewProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsPreview = p
+		return nil
+	case "rel":
+		if v == nil {
+			this.ActivityStreamsRel = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsRelProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsRel = p
+		return nil
+	case "summary":
+		if v == nil {
+			this.ActivityStreamsSummary = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsSummaryProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsSummary = p
+		return nil
+	case "type":
+		if v == nil {
+			this.ActivityStreamsType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsType = p
+		return nil
+	case "width":
+		if v == nil {
+			this.ActivityStreamsWidth = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsWidthProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the Hashtag type to a %T", name, v)
+		}
+		this.ActivityStreamsWidth = p
+		return nil
+	default:
+		return fmt.Errorf("the Hashtag type has no property %q", name)
+	}
+}
+
+// String returns a compact, human-readable form of this Hashtag for logs and
+// debugging, such as Hashtag{id: https://example.com/1, name: "Example"}. It
+// lists the id first and then each other property that is set, with long
+// values shortened. It is not a serialization; use Serialize for that.
+func (this ActivityStreamsHashtag) String() string {
+	var s []string
+	if this.ActivityStreamsId != nil {
+		s = append(s, "id: "+this.ActivityStreamsId.String())
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		s = append(s, "attributedTo: "+this.ActivityStreamsAttributedTo.String())
+	}
+	if this.ActivityStreamsHeight != nil {
+		s = append(s, "height: "+this.ActivityStreamsHeight.String())
+	}
+	if this.ActivityStreamsHref != nil {
+		s = append(s, "href: "+this.ActivityStreamsHref.String())
+	}
+	if this.ActivityStreamsHreflang != nil {
+		s = append(s, "hreflang: "+this.ActivityStreamsHreflang.String())
+	}
+	if this.ActivityStreamsMediaType != nil {
+		s = append(s, "mediaType: "+this.ActivityStreamsMediaType.String())
+	}
+	if this.ActivityStreamsName != nil {
+		s = append(s, "name: "+this.ActivityStreamsName.String())
+	}
+	if this.ActivityStreamsPreview != nil {
+		s = append(s, "preview: "+this.ActivityStreamsPreview.String())
+	}
+	if this.ActivityStreamsRel != nil {
+		s = append(s, "rel: "+this.ActivityStreamsRel.String())
+	}
+	if this.ActivityStreamsSummary != nil {
+		s = append(s, "summary: "+this.ActivityStreamsSummary.String())
+	}
+	if this.ActivityStreamsWidth != nil {
+		s = append(s, "width: "+this.ActivityStreamsWidth.String())
+	}
+	if len(this.unknown) > 0 {
+		s = append(s, fmt.Sprintf("+%d unknown", len(this.unknown)))
+	}
+	return "Hashtag{" + strings.Join(s, ", ") + "}"
+}
+
+// SummaryIRI returns the first value of the "summary" property that is an IRI,
+// and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) SummaryIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// SummaryLangString returns the first value of the "summary" property that is of
+// type "langString", and false if the property is not set or has no such
+// value.
+func (this ActivityStreamsHashtag) SummaryLangString() (v map[string]string, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsRDFLangString() {
+			return iter.GetRDFLangString(), true
+		}
+	}
+	return
+}
+
+// SummaryString returns the first value of the "summary" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) SummaryString() (v string, ok bool) {
+	if this.ActivityStreamsSummary == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsSummary.Len(); i++ {
+		if iter := this.ActivityStreamsSummary.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// TypeAnyURI returns the first value of the "type" property that is of type
+// "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) TypeAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.GetXMLSchemaAnyURI(), true
+		}
+	}
+	return
+}
+
+// TypeIRI returns the first value of the "type" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) TypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// TypeString returns the first value of the "type" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsHashtag) TypeString() (v string, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
+	}
+	return
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this ActivityStreamsHashtag) VocabularyURI() string {
+	return "https://www.w3.org/ns/activitystreams"
+}
+
+// WidthIRI returns the value of the "width" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsHashtag) WidthIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsWidth != nil && this.ActivityStreamsWidth.IsIRI() {
+		return this.ActivityStreamsWidth.GetIRI(), true
+	}
+	return
+}
+
+// WidthNonNegativeInteger returns the value of the "width" property if it is of
+// type "nonNegativeInteger", and false if the property is not set or has
+// another value.
+func (this ActivityStreamsHashtag) WidthNonNegativeInteger() (v int, ok bool) {
+	if this.ActivityStreamsWidth != nil && this.ActivityStreamsWidth.IsXMLSchemaNonNegativeInteger() {
+		return this.ActivityStreamsWidth.Get(), true
+	}
+	return
+}
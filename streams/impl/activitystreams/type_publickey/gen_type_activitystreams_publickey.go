@@ -1,7 +1,13 @@
 package typepublickey
 
 import (
+	"fmt"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // A public key represents a public cryptographical key for a user
@@ -114,6 +120,149 @@ func PublicKeyIsExtendedBy(other vocab.Type) bool {
 	return false
 }
 
+// applyMapValue calls apply with each form coerceMapValue coerces the value into,
+// until one is applied. Returns an error if none is.
+func applyMapValue(v interface{}, apply func(interface{}) bool) error {
+	for _, c := range coerceMapValue(v) {
+		if apply(c) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot apply a value of type %T", v)
+}
+
+// coerceMapValue returns the forms of a value unmarshalled from JSON that the
+// value may be given as, in order of preference.
+func coerceMapValue(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case string:
+		c := []interface{}{t}
+		if f, err := strconv.ParseFloat(t, 64); err == nil {
+			c = append(c, f)
+		}
+		if b, err := strconv.ParseBool(t); err == nil {
+			c = append(c, b)
+		}
+		return c
+	case int:
+		return []interface{}{float64(t)}
+	case int64:
+		return []interface{}{float64(t)}
+	case float32:
+		return []interface{}{float64(t)}
+	case *url.URL:
+		return []interface{}{t.String()}
+	case time.Time:
+		return []interface{}{t.Format(time.RFC3339)}
+	case []string:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e
+		}
+		return []interface{}{s}
+	case []*url.URL:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = e.String()
+		}
+		return []interface{}{s}
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = coerceMapValue(e)[0]
+		}
+		return []interface{}{s}
+	}
+	return []interface{}{v}
+}
+
+// ApplyMap sets the properties named by the keys of the map, named as by
+// GetProperty, to its values. A nil value clears the property. The values may
+// be those of a map unmarshalled from JSON, or plain Go values such as
+// strings, numbers, bools, time.Time, *url.URL, and slices of them. A string
+// is also tried as a number or a bool if the property does not accept it as
+// is, such as the values of a submitted form. Every value that can be applied
+// is, and the returned error lists each key that could not be.
+func (this *ActivityStreamsPublicKey) ApplyMap(m map[string]interface{}) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var errs []string
+	for _, k := range keys {
+		var err error
+		switch k {
+		case "id":
+			if m[k] == nil {
+				this.ActivityStreamsId = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeIdPropertyActivityStreams()(map[string]interface{}{"id": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsId = p
+					return true
+				})
+			}
+		case "owner":
+			if m[k] == nil {
+				this.ActivityStreamsOwner = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeOwnerPropertyActivityStreams()(map[string]interface{}{"owner": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsOwner = p
+					return true
+				})
+			}
+		case "publicKeyPem":
+			if m[k] == nil {
+				this.ActivityStreamsPublicKeyPem = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializePublicKeyPemPropertyActivityStreams()(map[string]interface{}{"publicKeyPem": v}, nil)
+					if e != nil || p == nil || !p.HasAny() {
+						return false
+					}
+					this.ActivityStreamsPublicKeyPem = p
+					return true
+				})
+			}
+		case "type":
+			if m[k] == nil {
+				this.ActivityStreamsType = nil
+			} else {
+				err = applyMapValue(m[k], func(v interface{}) bool {
+					p, e := mgr.DeserializeTypePropertyActivityStreams()(map[string]interface{}{"type": v}, nil)
+					if e != nil || p == nil {
+						return false
+					}
+					for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+						if !iter.HasAny() {
+							return false
+						}
+					}
+					this.ActivityStreamsType = p
+					return true
+				})
+			}
+		default:
+			err = fmt.Errorf("no such property")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%q: %s", k, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot apply %d of %d values to the PublicKey type: %s", len(errs), len(m), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 // GetActivityStreamsId returns the "id" property if it exists, and nil otherwise.
 func (this ActivityStreamsPublicKey) GetActivityStreamsId() vocab.ActivityStreamsIdProperty {
 	return this.ActivityStreamsId
@@ -137,6 +286,38 @@ func (this ActivityStreamsPublicKey) GetActivityStreamsType() vocab.ActivityStre
 	return this.ActivityStreamsType
 }
 
+// GetProperty returns the property with the name, such as "id", which is nil if
+// it is not set. Returns false if this type has no such property. A property
+// whose name is shared with another property of this type is named with its
+// vocabulary as a prefix, such as "ActivityStreams:name". The value may be
+// passed to SetProperty on another value of this type.
+func (this ActivityStreamsPublicKey) GetProperty(name string) (interface{}, bool) {
+	switch name {
+	case "id":
+		if this.ActivityStreamsId == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsId, true
+	case "owner":
+		if this.ActivityStreamsOwner == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsOwner, true
+	case "publicKeyPem":
+		if this.ActivityStreamsPublicKeyPem == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsPublicKeyPem, true
+	case "type":
+		if this.ActivityStreamsType == nil {
+			return nil, true
+		}
+		return this.ActivityStreamsType, true
+	default:
+		return nil, false
+	}
+}
+
 // GetTypeName returns the name of this type.
 func (this ActivityStreamsPublicKey) GetTypeName() string {
 	return "PublicKey"
@@ -152,6 +333,30 @@ func (this ActivityStreamsPublicKey) GetUnknownProperties() map[string]interface
 	return this.unknown
 }
 
+// GoString returns the same form as String, so that printing this value with the
+// %!v(MISSING) verb or in a debugger is readable instead of a dump of its members.
+func (this ActivityStreamsPublicKey) GoString() string {
+	return this.String()
+}
+
+// IdAnyURI returns the value of the "id" property if it is of type "anyURI", and
+// false if the property is not set or has another value.
+func (this ActivityStreamsPublicKey) IdAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsXMLSchemaAnyURI() {
+		return this.ActivityStreamsId.Get(), true
+	}
+	return
+}
+
+// IdIRI returns the value of the "id" property if it is an IRI, and false if the
+// property is not set or has another value.
+func (this ActivityStreamsPublicKey) IdIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsId != nil && this.ActivityStreamsId.IsIRI() {
+		return this.ActivityStreamsId.GetIRI(), true
+	}
+	return
+}
+
 // IsExtending returns true if the PublicKey type extends from the other type.
 func (this ActivityStreamsPublicKey) IsExtending(other vocab.Type) bool {
 	return ActivityStreamsPublicKeyExtends(other)
@@ -161,13 +366,12 @@ func (this ActivityStreamsPublicKey) IsExtending(other vocab.Type) bool {
 // type and the specific properties that are set. The value in the map is the
 // alias used to import the type and its properties.
 func (this ActivityStreamsPublicKey) JSONLDContext() map[string]string {
-	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
-	m = this.helperJSONLDContext(this.ActivityStreamsId, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsOwner, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsPublicKeyPem, m)
-	m = this.helperJSONLDContext(this.ActivityStreamsType, m)
-
-	return m
+	/*
+	   Every property of this type, and every type they may
+	   hold, is in this type's vocabulary, so the context is
+	   determined at code-generation time.
+	*/
+	return map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 }
 
 // LessThan computes if this PublicKey is lesser, with an arbitrary but stable
@@ -243,6 +447,42 @@ func (this ActivityStreamsPublicKey) LessThan(o vocab.ActivityStreamsPublicKey)
 	return false
 }
 
+// OwnerAnyURI returns the value of the "owner" property if it is of type
+// "anyURI", and false if the property is not set or has another value.
+func (this ActivityStreamsPublicKey) OwnerAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsOwner != nil && this.ActivityStreamsOwner.IsXMLSchemaAnyURI() {
+		return this.ActivityStreamsOwner.Get(), true
+	}
+	return
+}
+
+// OwnerIRI returns the value of the "owner" property if it is an IRI, and false
+// if the property is not set or has another value.
+func (this ActivityStreamsPublicKey) OwnerIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsOwner != nil && this.ActivityStreamsOwner.IsIRI() {
+		return this.ActivityStreamsOwner.GetIRI(), true
+	}
+	return
+}
+
+// PublicKeyPemIRI returns the value of the "publicKeyPem" property if it is an
+// IRI, and false if the property is not set or has another value.
+func (this ActivityStreamsPublicKey) PublicKeyPemIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsPublicKeyPem != nil && this.ActivityStreamsPublicKeyPem.IsIRI() {
+		return this.ActivityStreamsPublicKeyPem.GetIRI(), true
+	}
+	return
+}
+
+// PublicKeyPemString returns the value of the "publicKeyPem" property if it is of
+// type "string", and false if the property is not set or has another value.
+func (this ActivityStreamsPublicKey) PublicKeyPemString() (v string, ok bool) {
+	if this.ActivityStreamsPublicKeyPem != nil && this.ActivityStreamsPublicKeyPem.IsXMLSchemaString() {
+		return this.ActivityStreamsPublicKeyPem.Get(), true
+	}
+	return
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this ActivityStreamsPublicKey) Serialize() (map[string]interface{}, error) {
@@ -319,24 +559,125 @@ func (this *ActivityStreamsPublicKey) SetActivityStreamsType(i vocab.ActivityStr
 	this.ActivityStreamsType = i
 }
 
-// VocabularyURI returns the vocabulary's URI as a string.
-func (this ActivityStreamsPublicKey) VocabularyURI() string {
-	return "https://www.w3.org/ns/activitystreams"
+// SetProperty sets the property with the name, named as by GetProperty, to the
+// value, which must be that property's interface. A nil value clears the
+// property. Returns an error if this type has no such property or the value
+// is of another type.
+func (this *ActivityStreamsPublicKey) SetProperty(name string, v interface{}) error {
+	switch name {
+	case "id":
+		if v == nil {
+			this.ActivityStreamsId = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsIdProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the PublicKey type to a %T", name, v)
+		}
+		this.ActivityStreamsId = p
+		return nil
+	case "owner":
+		if v == nil {
+			this.ActivityStreamsOwner = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsOwnerProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the PublicKey type to a %T", name, v)
+		}
+		this.ActivityStreamsOwner = p
+		return nil
+	case "publicKeyPem":
+		if v == nil {
+			this.ActivityStreamsPublicKeyPem = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsPublicKeyPemProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the PublicKey type to a %T", name, v)
+		}
+		this.ActivityStreamsPublicKeyPem = p
+		return nil
+	case "type":
+		if v == nil {
+			this.ActivityStreamsType = nil
+			return nil
+		}
+		p, ok := v.(vocab.ActivityStreamsTypeProperty)
+		if !ok {
+			return fmt.Errorf("cannot set property %q of the PublicKey type to a %T", name, v)
+		}
+		this.ActivityStreamsType = p
+		return nil
+	default:
+		return fmt.Errorf("the PublicKey type has no property %q", name)
+	}
+}
+
+// String returns a compact, human-readable form of this PublicKey for logs and
+// debugging, such as PublicKey{id: https://example.com/1, name: "Example"}.
+// It lists the id first and then each other property that is set, with long
+// values shortened. It is not a serialization; use Serialize for that.
+func (this ActivityStreamsPublicKey) String() string {
+	var s []string
+	if this.ActivityStreamsId != nil {
+		s = append(s, "id: "+this.ActivityStreamsId.String())
+	}
+	if this.ActivityStreamsOwner != nil {
+		s = append(s, "owner: "+this.ActivityStreamsOwner.String())
+	}
+	if this.ActivityStreamsPublicKeyPem != nil {
+		s = append(s, "publicKeyPem: "+this.ActivityStreamsPublicKeyPem.String())
+	}
+	if len(this.unknown) > 0 {
+		s = append(s, fmt.Sprintf("+%d unknown", len(this.unknown)))
+	}
+	return "PublicKey{" + strings.Join(s, ", ") + "}"
+}
+
+// TypeAnyURI returns the first value of the "type" property that is of type
+// "anyURI", and false if the property is not set or has no such value.
+func (this ActivityStreamsPublicKey) TypeAnyURI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaAnyURI() {
+			return iter.GetXMLSchemaAnyURI(), true
+		}
+	}
+	return
 }
 
-// helperJSONLDContext obtains the context uris and their aliases from a property,
-// if it is not nil.
-func (this ActivityStreamsPublicKey) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
-	if i == nil {
-		return toMerge
+// TypeIRI returns the first value of the "type" property that is an IRI, and
+// false if the property is not set or has no such value.
+func (this ActivityStreamsPublicKey) TypeIRI() (v *url.URL, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
 	}
-	for k, v := range i.JSONLDContext() {
-		/*
-		   Since the literal maps in this function are determined at
-		   code-generation time, this loop should not overwrite an existing key with a
-		   new value.
-		*/
-		toMerge[k] = v
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsIRI() {
+			return iter.GetIRI(), true
+		}
+	}
+	return
+}
+
+// TypeString returns the first value of the "type" property that is of type
+// "string", and false if the property is not set or has no such value.
+func (this ActivityStreamsPublicKey) TypeString() (v string, ok bool) {
+	if this.ActivityStreamsType == nil {
+		return
+	}
+	for i := 0; i < this.ActivityStreamsType.Len(); i++ {
+		if iter := this.ActivityStreamsType.At(i); iter.IsXMLSchemaString() {
+			return iter.GetXMLSchemaString(), true
+		}
 	}
-	return toMerge
+	return
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this ActivityStreamsPublicKey) VocabularyURI() string {
+	return "https://www.w3.org/ns/activitystreams"
 }
@@ -9,6 +9,10 @@ var typePropertyConstructor func() vocab.ActivityStreamsTypeProperty
 // privateManager abstracts the code-generated manager that provides access to
 // concrete implementations.
 type privateManager interface {
+	// DeserializeAlsoKnownAsPropertyActivityStreams returns the
+	// deserialization method for the "ActivityStreamsAlsoKnownAsProperty"
+	// non-functional property in the vocabulary "ActivityStreams"
+	DeserializeAlsoKnownAsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error)
 	// DeserializeAltitudePropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsAltitudeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -54,6 +58,15 @@ type privateManager interface {
 	// method for the "ActivityStreamsEndTimeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeEndTimePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error)
+	// DeserializeFeaturedPropertyActivityStreams returns the deserialization
+	// method for the "ActivityStreamsFeaturedProperty" non-functional
+	// property in the vocabulary "ActivityStreams"
+	DeserializeFeaturedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedProperty, error)
+	// DeserializeFeaturedTagsPropertyActivityStreams returns the
+	// deserialization method for the
+	// "ActivityStreamsFeaturedTagsProperty" non-functional property in
+	// the vocabulary "ActivityStreams"
+	DeserializeFeaturedTagsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error)
 	// DeserializeFollowersPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsFollowersProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -98,6 +111,11 @@ type privateManager interface {
 	// method for the "ActivityStreamsLocationProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeLocationPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLocationProperty, error)
+	// DeserializeManuallyApprovesFollowersPropertyActivityStreams returns the
+	// deserialization method for the
+	// "ActivityStreamsManuallyApprovesFollowersProperty" non-functional
+	// property in the vocabulary "ActivityStreams"
+	DeserializeManuallyApprovesFollowersPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error)
 	// DeserializeMediaTypePropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsMediaTypeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -0,0 +1,312 @@
+// Package names contains typed constants for the names of the generated
+// ActivityStreams types and properties, as well as the context IRIs of their
+// vocabularies. This package is code-generated and subject to the same
+// license as the go-fed tool used to generate it.
+//
+// Applications should prefer these constants over string literals in switch
+// statements, database queries, and anywhere else a name is compared.
+package names
+
+// TypeName is the name of a type in a vocabulary.
+type TypeName string
+
+// PropertyName is the name of a property in a vocabulary.
+type PropertyName string
+
+// ContextIRI is the IRI of a vocabulary, as used in a JSON-LD @context.
+type ContextIRI string
+
+const (
+	// ActivityStreamsContext is the context IRI of the ActivityStreams vocabulary.
+	ActivityStreamsContext ContextIRI = "https://www.w3.org/ns/activitystreams"
+)
+
+const (
+	// ActivityStreamsAccept is the name of the Accept type in the ActivityStreams vocabulary.
+	ActivityStreamsAccept TypeName = "Accept"
+	// ActivityStreamsActivity is the name of the Activity type in the ActivityStreams vocabulary.
+	ActivityStreamsActivity TypeName = "Activity"
+	// ActivityStreamsAdd is the name of the Add type in the ActivityStreams vocabulary.
+	ActivityStreamsAdd TypeName = "Add"
+	// ActivityStreamsAnnounce is the name of the Announce type in the ActivityStreams vocabulary.
+	ActivityStreamsAnnounce TypeName = "Announce"
+	// ActivityStreamsApplication is the name of the Application type in the ActivityStreams vocabulary.
+	ActivityStreamsApplication TypeName = "Application"
+	// ActivityStreamsArrive is the name of the Arrive type in the ActivityStreams vocabulary.
+	ActivityStreamsArrive TypeName = "Arrive"
+	// ActivityStreamsArticle is the name of the Article type in the ActivityStreams vocabulary.
+	ActivityStreamsArticle TypeName = "Article"
+	// ActivityStreamsAudio is the name of the Audio type in the ActivityStreams vocabulary.
+	ActivityStreamsAudio TypeName = "Audio"
+	// ActivityStreamsBlock is the name of the Block type in the ActivityStreams vocabulary.
+	ActivityStreamsBlock TypeName = "Block"
+	// ActivityStreamsCollection is the name of the Collection type in the ActivityStreams vocabulary.
+	ActivityStreamsCollection TypeName = "Collection"
+	// ActivityStreamsCollectionPage is the name of the CollectionPage type in the ActivityStreams vocabulary.
+	ActivityStreamsCollectionPage TypeName = "CollectionPage"
+	// ActivityStreamsCreate is the name of the Create type in the ActivityStreams vocabulary.
+	ActivityStreamsCreate TypeName = "Create"
+	// ActivityStreamsDelete is the name of the Delete type in the ActivityStreams vocabulary.
+	ActivityStreamsDelete TypeName = "Delete"
+	// ActivityStreamsDislike is the name of the Dislike type in the ActivityStreams vocabulary.
+	ActivityStreamsDislike TypeName = "Dislike"
+	// ActivityStreamsDocument is the name of the Document type in the ActivityStreams vocabulary.
+	ActivityStreamsDocument TypeName = "Document"
+	// ActivityStreamsEmoji is the name of the Emoji type in the ActivityStreams vocabulary.
+	ActivityStreamsEmoji TypeName = "Emoji"
+	// ActivityStreamsEvent is the name of the Event type in the ActivityStreams vocabulary.
+	ActivityStreamsEvent TypeName = "Event"
+	// ActivityStreamsFlag is the name of the Flag type in the ActivityStreams vocabulary.
+	ActivityStreamsFlag TypeName = "Flag"
+	// ActivityStreamsFollow is the name of the Follow type in the ActivityStreams vocabulary.
+	ActivityStreamsFollow TypeName = "Follow"
+	// ActivityStreamsGroup is the name of the Group type in the ActivityStreams vocabulary.
+	ActivityStreamsGroup TypeName = "Group"
+	// ActivityStreamsHashtag is the name of the Hashtag type in the ActivityStreams vocabulary.
+	ActivityStreamsHashtag TypeName = "Hashtag"
+	// ActivityStreamsIgnore is the name of the Ignore type in the ActivityStreams vocabulary.
+	ActivityStreamsIgnore TypeName = "Ignore"
+	// ActivityStreamsImage is the name of the Image type in the ActivityStreams vocabulary.
+	ActivityStreamsImage TypeName = "Image"
+	// ActivityStreamsIntransitiveActivity is the name of the IntransitiveActivity type in the ActivityStreams vocabulary.
+	ActivityStreamsIntransitiveActivity TypeName = "IntransitiveActivity"
+	// ActivityStreamsInvite is the name of the Invite type in the ActivityStreams vocabulary.
+	ActivityStreamsInvite TypeName = "Invite"
+	// ActivityStreamsJoin is the name of the Join type in the ActivityStreams vocabulary.
+	ActivityStreamsJoin TypeName = "Join"
+	// ActivityStreamsLeave is the name of the Leave type in the ActivityStreams vocabulary.
+	ActivityStreamsLeave TypeName = "Leave"
+	// ActivityStreamsLike is the name of the Like type in the ActivityStreams vocabulary.
+	ActivityStreamsLike TypeName = "Like"
+	// ActivityStreamsLink is the name of the Link type in the ActivityStreams vocabulary.
+	ActivityStreamsLink TypeName = "Link"
+	// ActivityStreamsListen is the name of the Listen type in the ActivityStreams vocabulary.
+	ActivityStreamsListen TypeName = "Listen"
+	// ActivityStreamsMention is the name of the Mention type in the ActivityStreams vocabulary.
+	ActivityStreamsMention TypeName = "Mention"
+	// ActivityStreamsMove is the name of the Move type in the ActivityStreams vocabulary.
+	ActivityStreamsMove TypeName = "Move"
+	// ActivityStreamsNote is the name of the Note type in the ActivityStreams vocabulary.
+	ActivityStreamsNote TypeName = "Note"
+	// ActivityStreamsObject is the name of the Object type in the ActivityStreams vocabulary.
+	ActivityStreamsObject TypeName = "Object"
+	// ActivityStreamsOffer is the name of the Offer type in the ActivityStreams vocabulary.
+	ActivityStreamsOffer TypeName = "Offer"
+	// ActivityStreamsOrderedCollection is the name of the OrderedCollection type in the ActivityStreams vocabulary.
+	ActivityStreamsOrderedCollection TypeName = "OrderedCollection"
+	// ActivityStreamsOrderedCollectionPage is the name of the OrderedCollectionPage type in the ActivityStreams vocabulary.
+	ActivityStreamsOrderedCollectionPage TypeName = "OrderedCollectionPage"
+	// ActivityStreamsOrganization is the name of the Organization type in the ActivityStreams vocabulary.
+	ActivityStreamsOrganization TypeName = "Organization"
+	// ActivityStreamsPage is the name of the Page type in the ActivityStreams vocabulary.
+	ActivityStreamsPage TypeName = "Page"
+	// ActivityStreamsPerson is the name of the Person type in the ActivityStreams vocabulary.
+	ActivityStreamsPerson TypeName = "Person"
+	// ActivityStreamsPlace is the name of the Place type in the ActivityStreams vocabulary.
+	ActivityStreamsPlace TypeName = "Place"
+	// ActivityStreamsProfile is the name of the Profile type in the ActivityStreams vocabulary.
+	ActivityStreamsProfile TypeName = "Profile"
+	// ActivityStreamsPropertyValue is the name of the PropertyValue type in the ActivityStreams vocabulary.
+	ActivityStreamsPropertyValue TypeName = "PropertyValue"
+	// ActivityStreamsPublicKey is the name of the PublicKey type in the ActivityStreams vocabulary.
+	ActivityStreamsPublicKey TypeName = "PublicKey"
+	// ActivityStreamsQuestion is the name of the Question type in the ActivityStreams vocabulary.
+	ActivityStreamsQuestion TypeName = "Question"
+	// ActivityStreamsRead is the name of the Read type in the ActivityStreams vocabulary.
+	ActivityStreamsRead TypeName = "Read"
+	// ActivityStreamsReject is the name of the Reject type in the ActivityStreams vocabulary.
+	ActivityStreamsReject TypeName = "Reject"
+	// ActivityStreamsRelationship is the name of the Relationship type in the ActivityStreams vocabulary.
+	ActivityStreamsRelationship TypeName = "Relationship"
+	// ActivityStreamsRemove is the name of the Remove type in the ActivityStreams vocabulary.
+	ActivityStreamsRemove TypeName = "Remove"
+	// ActivityStreamsService is the name of the Service type in the ActivityStreams vocabulary.
+	ActivityStreamsService TypeName = "Service"
+	// ActivityStreamsTentativeAccept is the name of the TentativeAccept type in the ActivityStreams vocabulary.
+	ActivityStreamsTentativeAccept TypeName = "TentativeAccept"
+	// ActivityStreamsTentativeReject is the name of the TentativeReject type in the ActivityStreams vocabulary.
+	ActivityStreamsTentativeReject TypeName = "TentativeReject"
+	// ActivityStreamsTombstone is the name of the Tombstone type in the ActivityStreams vocabulary.
+	ActivityStreamsTombstone TypeName = "Tombstone"
+	// ActivityStreamsTravel is the name of the Travel type in the ActivityStreams vocabulary.
+	ActivityStreamsTravel TypeName = "Travel"
+	// ActivityStreamsUndo is the name of the Undo type in the ActivityStreams vocabulary.
+	ActivityStreamsUndo TypeName = "Undo"
+	// ActivityStreamsUpdate is the name of the Update type in the ActivityStreams vocabulary.
+	ActivityStreamsUpdate TypeName = "Update"
+	// ActivityStreamsVideo is the name of the Video type in the ActivityStreams vocabulary.
+	ActivityStreamsVideo TypeName = "Video"
+	// ActivityStreamsView is the name of the View type in the ActivityStreams vocabulary.
+	ActivityStreamsView TypeName = "View"
+)
+
+const (
+	// ActivityStreamsAccuracyProperty is the name of the accuracy property in the ActivityStreams vocabulary.
+	ActivityStreamsAccuracyProperty PropertyName = "accuracy"
+	// ActivityStreamsActorProperty is the name of the actor property in the ActivityStreams vocabulary.
+	ActivityStreamsActorProperty PropertyName = "actor"
+	// ActivityStreamsAlsoKnownAsProperty is the name of the alsoKnownAs property in the ActivityStreams vocabulary.
+	ActivityStreamsAlsoKnownAsProperty PropertyName = "alsoKnownAs"
+	// ActivityStreamsAltitudeProperty is the name of the altitude property in the ActivityStreams vocabulary.
+	ActivityStreamsAltitudeProperty PropertyName = "altitude"
+	// ActivityStreamsAnyOfProperty is the name of the anyOf property in the ActivityStreams vocabulary.
+	ActivityStreamsAnyOfProperty PropertyName = "anyOf"
+	// ActivityStreamsAttachmentProperty is the name of the attachment property in the ActivityStreams vocabulary.
+	ActivityStreamsAttachmentProperty PropertyName = "attachment"
+	// ActivityStreamsAttributedToProperty is the name of the attributedTo property in the ActivityStreams vocabulary.
+	ActivityStreamsAttributedToProperty PropertyName = "attributedTo"
+	// ActivityStreamsAudienceProperty is the name of the audience property in the ActivityStreams vocabulary.
+	ActivityStreamsAudienceProperty PropertyName = "audience"
+	// ActivityStreamsBccProperty is the name of the bcc property in the ActivityStreams vocabulary.
+	ActivityStreamsBccProperty PropertyName = "bcc"
+	// ActivityStreamsBtoProperty is the name of the bto property in the ActivityStreams vocabulary.
+	ActivityStreamsBtoProperty PropertyName = "bto"
+	// ActivityStreamsCcProperty is the name of the cc property in the ActivityStreams vocabulary.
+	ActivityStreamsCcProperty PropertyName = "cc"
+	// ActivityStreamsClosedProperty is the name of the closed property in the ActivityStreams vocabulary.
+	ActivityStreamsClosedProperty PropertyName = "closed"
+	// ActivityStreamsContentProperty is the name of the content property in the ActivityStreams vocabulary.
+	ActivityStreamsContentProperty PropertyName = "content"
+	// ActivityStreamsContentMapProperty is the name of the content property in the ActivityStreams vocabulary when it is a natural language map.
+	ActivityStreamsContentMapProperty PropertyName = "contentMap"
+	// ActivityStreamsContextProperty is the name of the context property in the ActivityStreams vocabulary.
+	ActivityStreamsContextProperty PropertyName = "context"
+	// ActivityStreamsCurrentProperty is the name of the current property in the ActivityStreams vocabulary.
+	ActivityStreamsCurrentProperty PropertyName = "current"
+	// ActivityStreamsDeletedProperty is the name of the deleted property in the ActivityStreams vocabulary.
+	ActivityStreamsDeletedProperty PropertyName = "deleted"
+	// ActivityStreamsDescribesProperty is the name of the describes property in the ActivityStreams vocabulary.
+	ActivityStreamsDescribesProperty PropertyName = "describes"
+	// ActivityStreamsDurationProperty is the name of the duration property in the ActivityStreams vocabulary.
+	ActivityStreamsDurationProperty PropertyName = "duration"
+	// ActivityStreamsEndTimeProperty is the name of the endTime property in the ActivityStreams vocabulary.
+	ActivityStreamsEndTimeProperty PropertyName = "endTime"
+	// ActivityStreamsFeaturedProperty is the name of the featured property in the ActivityStreams vocabulary.
+	ActivityStreamsFeaturedProperty PropertyName = "featured"
+	// ActivityStreamsFeaturedTagsProperty is the name of the featuredTags property in the ActivityStreams vocabulary.
+	ActivityStreamsFeaturedTagsProperty PropertyName = "featuredTags"
+	// ActivityStreamsFirstProperty is the name of the first property in the ActivityStreams vocabulary.
+	ActivityStreamsFirstProperty PropertyName = "first"
+	// ActivityStreamsFollowersProperty is the name of the followers property in the ActivityStreams vocabulary.
+	ActivityStreamsFollowersProperty PropertyName = "followers"
+	// ActivityStreamsFollowingProperty is the name of the following property in the ActivityStreams vocabulary.
+	ActivityStreamsFollowingProperty PropertyName = "following"
+	// ActivityStreamsFormerTypeProperty is the name of the formerType property in the ActivityStreams vocabulary.
+	ActivityStreamsFormerTypeProperty PropertyName = "formerType"
+	// ActivityStreamsGeneratorProperty is the name of the generator property in the ActivityStreams vocabulary.
+	ActivityStreamsGeneratorProperty PropertyName = "generator"
+	// ActivityStreamsHeightProperty is the name of the height property in the ActivityStreams vocabulary.
+	ActivityStreamsHeightProperty PropertyName = "height"
+	// ActivityStreamsHrefProperty is the name of the href property in the ActivityStreams vocabulary.
+	ActivityStreamsHrefProperty PropertyName = "href"
+	// ActivityStreamsHreflangProperty is the name of the hreflang property in the ActivityStreams vocabulary.
+	ActivityStreamsHreflangProperty PropertyName = "hreflang"
+	// ActivityStreamsIconProperty is the name of the icon property in the ActivityStreams vocabulary.
+	ActivityStreamsIconProperty PropertyName = "icon"
+	// ActivityStreamsIdProperty is the name of the id property in the ActivityStreams vocabulary.
+	ActivityStreamsIdProperty PropertyName = "id"
+	// ActivityStreamsImageProperty is the name of the image property in the ActivityStreams vocabulary.
+	ActivityStreamsImageProperty PropertyName = "image"
+	// ActivityStreamsInReplyToProperty is the name of the inReplyTo property in the ActivityStreams vocabulary.
+	ActivityStreamsInReplyToProperty PropertyName = "inReplyTo"
+	// ActivityStreamsInboxProperty is the name of the inbox property in the ActivityStreams vocabulary.
+	ActivityStreamsInboxProperty PropertyName = "inbox"
+	// ActivityStreamsInstrumentProperty is the name of the instrument property in the ActivityStreams vocabulary.
+	ActivityStreamsInstrumentProperty PropertyName = "instrument"
+	// ActivityStreamsItemsProperty is the name of the items property in the ActivityStreams vocabulary.
+	ActivityStreamsItemsProperty PropertyName = "items"
+	// ActivityStreamsLastProperty is the name of the last property in the ActivityStreams vocabulary.
+	ActivityStreamsLastProperty PropertyName = "last"
+	// ActivityStreamsLatitudeProperty is the name of the latitude property in the ActivityStreams vocabulary.
+	ActivityStreamsLatitudeProperty PropertyName = "latitude"
+	// ActivityStreamsLikedProperty is the name of the liked property in the ActivityStreams vocabulary.
+	ActivityStreamsLikedProperty PropertyName = "liked"
+	// ActivityStreamsLikesProperty is the name of the likes property in the ActivityStreams vocabulary.
+	ActivityStreamsLikesProperty PropertyName = "likes"
+	// ActivityStreamsLocationProperty is the name of the location property in the ActivityStreams vocabulary.
+	ActivityStreamsLocationProperty PropertyName = "location"
+	// ActivityStreamsLongitudeProperty is the name of the longitude property in the ActivityStreams vocabulary.
+	ActivityStreamsLongitudeProperty PropertyName = "longitude"
+	// ActivityStreamsManuallyApprovesFollowersProperty is the name of the manuallyApprovesFollowers property in the ActivityStreams vocabulary.
+	ActivityStreamsManuallyApprovesFollowersProperty PropertyName = "manuallyApprovesFollowers"
+	// ActivityStreamsMediaTypeProperty is the name of the mediaType property in the ActivityStreams vocabulary.
+	ActivityStreamsMediaTypeProperty PropertyName = "mediaType"
+	// ActivityStreamsNameProperty is the name of the name property in the ActivityStreams vocabulary.
+	ActivityStreamsNameProperty PropertyName = "name"
+	// ActivityStreamsNameMapProperty is the name of the name property in the ActivityStreams vocabulary when it is a natural language map.
+	ActivityStreamsNameMapProperty PropertyName = "nameMap"
+	// ActivityStreamsNextProperty is the name of the next property in the ActivityStreams vocabulary.
+	ActivityStreamsNextProperty PropertyName = "next"
+	// ActivityStreamsObjectProperty is the name of the object property in the ActivityStreams vocabulary.
+	ActivityStreamsObjectProperty PropertyName = "object"
+	// ActivityStreamsOneOfProperty is the name of the oneOf property in the ActivityStreams vocabulary.
+	ActivityStreamsOneOfProperty PropertyName = "oneOf"
+	// ActivityStreamsOrderedItemsProperty is the name of the orderedItems property in the ActivityStreams vocabulary.
+	ActivityStreamsOrderedItemsProperty PropertyName = "orderedItems"
+	// ActivityStreamsOriginProperty is the name of the origin property in the ActivityStreams vocabulary.
+	ActivityStreamsOriginProperty PropertyName = "origin"
+	// ActivityStreamsOutboxProperty is the name of the outbox property in the ActivityStreams vocabulary.
+	ActivityStreamsOutboxProperty PropertyName = "outbox"
+	// ActivityStreamsOwnerProperty is the name of the owner property in the ActivityStreams vocabulary.
+	ActivityStreamsOwnerProperty PropertyName = "owner"
+	// ActivityStreamsPartOfProperty is the name of the partOf property in the ActivityStreams vocabulary.
+	ActivityStreamsPartOfProperty PropertyName = "partOf"
+	// ActivityStreamsPreferredUsernameProperty is the name of the preferredUsername property in the ActivityStreams vocabulary.
+	ActivityStreamsPreferredUsernameProperty PropertyName = "preferredUsername"
+	// ActivityStreamsPreferredUsernameMapProperty is the name of the preferredUsername property in the ActivityStreams vocabulary when it is a natural language map.
+	ActivityStreamsPreferredUsernameMapProperty PropertyName = "preferredUsernameMap"
+	// ActivityStreamsPrevProperty is the name of the prev property in the ActivityStreams vocabulary.
+	ActivityStreamsPrevProperty PropertyName = "prev"
+	// ActivityStreamsPreviewProperty is the name of the preview property in the ActivityStreams vocabulary.
+	ActivityStreamsPreviewProperty PropertyName = "preview"
+	// ActivityStreamsPublicKeyProperty is the name of the publicKey property in the ActivityStreams vocabulary.
+	ActivityStreamsPublicKeyProperty PropertyName = "publicKey"
+	// ActivityStreamsPublicKeyPemProperty is the name of the publicKeyPem property in the ActivityStreams vocabulary.
+	ActivityStreamsPublicKeyPemProperty PropertyName = "publicKeyPem"
+	// ActivityStreamsPublishedProperty is the name of the published property in the ActivityStreams vocabulary.
+	ActivityStreamsPublishedProperty PropertyName = "published"
+	// ActivityStreamsRadiusProperty is the name of the radius property in the ActivityStreams vocabulary.
+	ActivityStreamsRadiusProperty PropertyName = "radius"
+	// ActivityStreamsRelProperty is the name of the rel property in the ActivityStreams vocabulary.
+	ActivityStreamsRelProperty PropertyName = "rel"
+	// ActivityStreamsRelationshipProperty is the name of the relationship property in the ActivityStreams vocabulary.
+	ActivityStreamsRelationshipProperty PropertyName = "relationship"
+	// ActivityStreamsRepliesProperty is the name of the replies property in the ActivityStreams vocabulary.
+	ActivityStreamsRepliesProperty PropertyName = "replies"
+	// ActivityStreamsResultProperty is the name of the result property in the ActivityStreams vocabulary.
+	ActivityStreamsResultProperty PropertyName = "result"
+	// ActivityStreamsSharesProperty is the name of the shares property in the ActivityStreams vocabulary.
+	ActivityStreamsSharesProperty PropertyName = "shares"
+	// ActivityStreamsStartIndexProperty is the name of the startIndex property in the ActivityStreams vocabulary.
+	ActivityStreamsStartIndexProperty PropertyName = "startIndex"
+	// ActivityStreamsStartTimeProperty is the name of the startTime property in the ActivityStreams vocabulary.
+	ActivityStreamsStartTimeProperty PropertyName = "startTime"
+	// ActivityStreamsStreamsProperty is the name of the streams property in the ActivityStreams vocabulary.
+	ActivityStreamsStreamsProperty PropertyName = "streams"
+	// ActivityStreamsSubjectProperty is the name of the subject property in the ActivityStreams vocabulary.
+	ActivityStreamsSubjectProperty PropertyName = "subject"
+	// ActivityStreamsSummaryProperty is the name of the summary property in the ActivityStreams vocabulary.
+	ActivityStreamsSummaryProperty PropertyName = "summary"
+	// ActivityStreamsSummaryMapProperty is the name of the summary property in the ActivityStreams vocabulary when it is a natural language map.
+	ActivityStreamsSummaryMapProperty PropertyName = "summaryMap"
+	// ActivityStreamsTagProperty is the name of the tag property in the ActivityStreams vocabulary.
+	ActivityStreamsTagProperty PropertyName = "tag"
+	// ActivityStreamsTargetProperty is the name of the target property in the ActivityStreams vocabulary.
+	ActivityStreamsTargetProperty PropertyName = "target"
+	// ActivityStreamsToProperty is the name of the to property in the ActivityStreams vocabulary.
+	ActivityStreamsToProperty PropertyName = "to"
+	// ActivityStreamsTotalItemsProperty is the name of the totalItems property in the ActivityStreams vocabulary.
+	ActivityStreamsTotalItemsProperty PropertyName = "totalItems"
+	// ActivityStreamsTypeProperty is the name of the type property in the ActivityStreams vocabulary.
+	ActivityStreamsTypeProperty PropertyName = "type"
+	// ActivityStreamsUnitsProperty is the name of the units property in the ActivityStreams vocabulary.
+	ActivityStreamsUnitsProperty PropertyName = "units"
+	// ActivityStreamsUpdatedProperty is the name of the updated property in the ActivityStreams vocabulary.
+	ActivityStreamsUpdatedProperty PropertyName = "updated"
+	// ActivityStreamsUrlProperty is the name of the url property in the ActivityStreams vocabulary.
+	ActivityStreamsUrlProperty PropertyName = "url"
+	// ActivityStreamsValueProperty is the name of the value property in the ActivityStreams vocabulary.
+	ActivityStreamsValueProperty PropertyName = "value"
+	// ActivityStreamsWidthProperty is the name of the width property in the ActivityStreams vocabulary.
+	ActivityStreamsWidthProperty PropertyName = "width"
+)
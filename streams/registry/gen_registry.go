@@ -0,0 +1,6778 @@
+// Package registry contains a Registry of only the ActivityStreams types an
+// Application handles. This package is code-generated and subject to the same
+// license as the go-fed tool used to generate it.
+//
+// Importing the root package initializes the implementation of every type and
+// property, and links all of them into the binary. Applications in
+// constrained deployments may instead create a Registry, register the types
+// they handle, and deserialize values with it, without importing the root
+// package or any package importing it.
+package registry
+
+import (
+	"context"
+	"errors"
+	propertyaccuracy "github.com/go-fed/activity/streams/impl/activitystreams/property_accuracy"
+	propertyactor "github.com/go-fed/activity/streams/impl/activitystreams/property_actor"
+	propertyalsoknownas "github.com/go-fed/activity/streams/impl/activitystreams/property_alsoknownas"
+	propertyaltitude "github.com/go-fed/activity/streams/impl/activitystreams/property_altitude"
+	propertyanyof "github.com/go-fed/activity/streams/impl/activitystreams/property_anyof"
+	propertyattachment "github.com/go-fed/activity/streams/impl/activitystreams/property_attachment"
+	propertyattributedto "github.com/go-fed/activity/streams/impl/activitystreams/property_attributedto"
+	propertyaudience "github.com/go-fed/activity/streams/impl/activitystreams/property_audience"
+	propertybcc "github.com/go-fed/activity/streams/impl/activitystreams/property_bcc"
+	propertybto "github.com/go-fed/activity/streams/impl/activitystreams/property_bto"
+	propertycc "github.com/go-fed/activity/streams/impl/activitystreams/property_cc"
+	propertyclosed "github.com/go-fed/activity/streams/impl/activitystreams/property_closed"
+	propertycontent "github.com/go-fed/activity/streams/impl/activitystreams/property_content"
+	propertycontext "github.com/go-fed/activity/streams/impl/activitystreams/property_context"
+	propertycurrent "github.com/go-fed/activity/streams/impl/activitystreams/property_current"
+	propertydeleted "github.com/go-fed/activity/streams/impl/activitystreams/property_deleted"
+	propertydescribes "github.com/go-fed/activity/streams/impl/activitystreams/property_describes"
+	propertyduration "github.com/go-fed/activity/streams/impl/activitystreams/property_duration"
+	propertyendtime "github.com/go-fed/activity/streams/impl/activitystreams/property_endtime"
+	propertyfeatured "github.com/go-fed/activity/streams/impl/activitystreams/property_featured"
+	propertyfeaturedtags "github.com/go-fed/activity/streams/impl/activitystreams/property_featuredtags"
+	propertyfirst "github.com/go-fed/activity/streams/impl/activitystreams/property_first"
+	propertyfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_followers"
+	propertyfollowing "github.com/go-fed/activity/streams/impl/activitystreams/property_following"
+	propertyformertype "github.com/go-fed/activity/streams/impl/activitystreams/property_formertype"
+	propertygenerator "github.com/go-fed/activity/streams/impl/activitystreams/property_generator"
+	propertyheight "github.com/go-fed/activity/streams/impl/activitystreams/property_height"
+	propertyhref "github.com/go-fed/activity/streams/impl/activitystreams/property_href"
+	propertyhreflang "github.com/go-fed/activity/streams/impl/activitystreams/property_hreflang"
+	propertyicon "github.com/go-fed/activity/streams/impl/activitystreams/property_icon"
+	propertyid "github.com/go-fed/activity/streams/impl/activitystreams/property_id"
+	propertyimage "github.com/go-fed/activity/streams/impl/activitystreams/property_image"
+	propertyinbox "github.com/go-fed/activity/streams/impl/activitystreams/property_inbox"
+	propertyinreplyto "github.com/go-fed/activity/streams/impl/activitystreams/property_inreplyto"
+	propertyinstrument "github.com/go-fed/activity/streams/impl/activitystreams/property_instrument"
+	propertyitems "github.com/go-fed/activity/streams/impl/activitystreams/property_items"
+	propertylast "github.com/go-fed/activity/streams/impl/activitystreams/property_last"
+	propertylatitude "github.com/go-fed/activity/streams/impl/activitystreams/property_latitude"
+	propertyliked "github.com/go-fed/activity/streams/impl/activitystreams/property_liked"
+	propertylikes "github.com/go-fed/activity/streams/impl/activitystreams/property_likes"
+	propertylocation "github.com/go-fed/activity/streams/impl/activitystreams/property_location"
+	propertylongitude "github.com/go-fed/activity/streams/impl/activitystreams/property_longitude"
+	propertymanuallyapprovesfollowers "github.com/go-fed/activity/streams/impl/activitystreams/property_manuallyapprovesfollowers"
+	propertymediatype "github.com/go-fed/activity/streams/impl/activitystreams/property_mediatype"
+	propertyname "github.com/go-fed/activity/streams/impl/activitystreams/property_name"
+	propertynext "github.com/go-fed/activity/streams/impl/activitystreams/property_next"
+	propertyobject "github.com/go-fed/activity/streams/impl/activitystreams/property_object"
+	propertyoneof "github.com/go-fed/activity/streams/impl/activitystreams/property_oneof"
+	propertyordereditems "github.com/go-fed/activity/streams/impl/activitystreams/property_ordereditems"
+	propertyorigin "github.com/go-fed/activity/streams/impl/activitystreams/property_origin"
+	propertyoutbox "github.com/go-fed/activity/streams/impl/activitystreams/property_outbox"
+	propertyowner "github.com/go-fed/activity/streams/impl/activitystreams/property_owner"
+	propertypartof "github.com/go-fed/activity/streams/impl/activitystreams/property_partof"
+	propertypreferredusername "github.com/go-fed/activity/streams/impl/activitystreams/property_preferredusername"
+	propertyprev "github.com/go-fed/activity/streams/impl/activitystreams/property_prev"
+	propertypreview "github.com/go-fed/activity/streams/impl/activitystreams/property_preview"
+	propertypublickey "github.com/go-fed/activity/streams/impl/activitystreams/property_publickey"
+	propertypublickeypem "github.com/go-fed/activity/streams/impl/activitystreams/property_publickeypem"
+	propertypublished "github.com/go-fed/activity/streams/impl/activitystreams/property_published"
+	propertyradius "github.com/go-fed/activity/streams/impl/activitystreams/property_radius"
+	propertyrel "github.com/go-fed/activity/streams/impl/activitystreams/property_rel"
+	propertyrelationship "github.com/go-fed/activity/streams/impl/activitystreams/property_relationship"
+	propertyreplies "github.com/go-fed/activity/streams/impl/activitystreams/property_replies"
+	propertyresult "github.com/go-fed/activity/streams/impl/activitystreams/property_result"
+	propertyshares "github.com/go-fed/activity/streams/impl/activitystreams/property_shares"
+	propertystartindex "github.com/go-fed/activity/streams/impl/activitystreams/property_startindex"
+	propertystarttime "github.com/go-fed/activity/streams/impl/activitystreams/property_starttime"
+	propertystreams "github.com/go-fed/activity/streams/impl/activitystreams/property_streams"
+	propertysubject "github.com/go-fed/activity/streams/impl/activitystreams/property_subject"
+	propertysummary "github.com/go-fed/activity/streams/impl/activitystreams/property_summary"
+	propertytag "github.com/go-fed/activity/streams/impl/activitystreams/property_tag"
+	propertytarget "github.com/go-fed/activity/streams/impl/activitystreams/property_target"
+	propertyto "github.com/go-fed/activity/streams/impl/activitystreams/property_to"
+	propertytotalitems "github.com/go-fed/activity/streams/impl/activitystreams/property_totalitems"
+	propertytype "github.com/go-fed/activity/streams/impl/activitystreams/property_type"
+	propertyunits "github.com/go-fed/activity/streams/impl/activitystreams/property_units"
+	propertyupdated "github.com/go-fed/activity/streams/impl/activitystreams/property_updated"
+	propertyurl "github.com/go-fed/activity/streams/impl/activitystreams/property_url"
+	propertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/property_value"
+	propertywidth "github.com/go-fed/activity/streams/impl/activitystreams/property_width"
+	typeaccept "github.com/go-fed/activity/streams/impl/activitystreams/type_accept"
+	typeactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_activity"
+	typeadd "github.com/go-fed/activity/streams/impl/activitystreams/type_add"
+	typeannounce "github.com/go-fed/activity/streams/impl/activitystreams/type_announce"
+	typeapplication "github.com/go-fed/activity/streams/impl/activitystreams/type_application"
+	typearrive "github.com/go-fed/activity/streams/impl/activitystreams/type_arrive"
+	typearticle "github.com/go-fed/activity/streams/impl/activitystreams/type_article"
+	typeaudio "github.com/go-fed/activity/streams/impl/activitystreams/type_audio"
+	typeblock "github.com/go-fed/activity/streams/impl/activitystreams/type_block"
+	typecollection "github.com/go-fed/activity/streams/impl/activitystreams/type_collection"
+	typecollectionpage "github.com/go-fed/activity/streams/impl/activitystreams/type_collectionpage"
+	typecreate "github.com/go-fed/activity/streams/impl/activitystreams/type_create"
+	typedelete "github.com/go-fed/activity/streams/impl/activitystreams/type_delete"
+	typedislike "github.com/go-fed/activity/streams/impl/activitystreams/type_dislike"
+	typedocument "github.com/go-fed/activity/streams/impl/activitystreams/type_document"
+	typeemoji "github.com/go-fed/activity/streams/impl/activitystreams/type_emoji"
+	typeevent "github.com/go-fed/activity/streams/impl/activitystreams/type_event"
+	typeflag "github.com/go-fed/activity/streams/impl/activitystreams/type_flag"
+	typefollow "github.com/go-fed/activity/streams/impl/activitystreams/type_follow"
+	typegroup "github.com/go-fed/activity/streams/impl/activitystreams/type_group"
+	typehashtag "github.com/go-fed/activity/streams/impl/activitystreams/type_hashtag"
+	typeignore "github.com/go-fed/activity/streams/impl/activitystreams/type_ignore"
+	typeimage "github.com/go-fed/activity/streams/impl/activitystreams/type_image"
+	typeintransitiveactivity "github.com/go-fed/activity/streams/impl/activitystreams/type_intransitiveactivity"
+	typeinvite "github.com/go-fed/activity/streams/impl/activitystreams/type_invite"
+	typejoin "github.com/go-fed/activity/streams/impl/activitystreams/type_join"
+	typeleave "github.com/go-fed/activity/streams/impl/activitystreams/type_leave"
+	typelike "github.com/go-fed/activity/streams/impl/activitystreams/type_like"
+	typelink "github.com/go-fed/activity/streams/impl/activitystreams/type_link"
+	typelisten "github.com/go-fed/activity/streams/impl/activitystreams/type_listen"
+	typemention "github.com/go-fed/activity/streams/impl/activitystreams/type_mention"
+	typemove "github.com/go-fed/activity/streams/impl/activitystreams/type_move"
+	typenote "github.com/go-fed/activity/streams/impl/activitystreams/type_note"
+	typeobject "github.com/go-fed/activity/streams/impl/activitystreams/type_object"
+	typeoffer "github.com/go-fed/activity/streams/impl/activitystreams/type_offer"
+	typeorderedcollection "github.com/go-fed/activity/streams/impl/activitystreams/type_orderedcollection"
+	typeorderedcollectionpage "github.com/go-fed/activity/streams/impl/activitystreams/type_orderedcollectionpage"
+	typeorganization "github.com/go-fed/activity/streams/impl/activitystreams/type_organization"
+	typepage "github.com/go-fed/activity/streams/impl/activitystreams/type_page"
+	typeperson "github.com/go-fed/activity/streams/impl/activitystreams/type_person"
+	typeplace "github.com/go-fed/activity/streams/impl/activitystreams/type_place"
+	typeprofile "github.com/go-fed/activity/streams/impl/activitystreams/type_profile"
+	typepropertyvalue "github.com/go-fed/activity/streams/impl/activitystreams/type_propertyvalue"
+	typepublickey "github.com/go-fed/activity/streams/impl/activitystreams/type_publickey"
+	typequestion "github.com/go-fed/activity/streams/impl/activitystreams/type_question"
+	typeread "github.com/go-fed/activity/streams/impl/activitystreams/type_read"
+	typereject "github.com/go-fed/activity/streams/impl/activitystreams/type_reject"
+	typerelationship "github.com/go-fed/activity/streams/impl/activitystreams/type_relationship"
+	typeremove "github.com/go-fed/activity/streams/impl/activitystreams/type_remove"
+	typeservice "github.com/go-fed/activity/streams/impl/activitystreams/type_service"
+	typetentativeaccept "github.com/go-fed/activity/streams/impl/activitystreams/type_tentativeaccept"
+	typetentativereject "github.com/go-fed/activity/streams/impl/activitystreams/type_tentativereject"
+	typetombstone "github.com/go-fed/activity/streams/impl/activitystreams/type_tombstone"
+	typetravel "github.com/go-fed/activity/streams/impl/activitystreams/type_travel"
+	typeundo "github.com/go-fed/activity/streams/impl/activitystreams/type_undo"
+	typeupdate "github.com/go-fed/activity/streams/impl/activitystreams/type_update"
+	typevideo "github.com/go-fed/activity/streams/impl/activitystreams/type_video"
+	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"strings"
+)
+
+// ErrUnregisteredType is returned when deserializing a value whose type is not registered with the Registry.
+var ErrUnregisteredType = errors.New("type is not registered")
+
+// registeredType is a type registered with the Registry.
+type registeredType struct {
+	vocabURI    string
+	name        string
+	deserialize func(map[string]interface{}, map[string]string) (vocab.Type, error)
+}
+
+// Registry manages the deserialization of only the types registered with it, and
+// of their properties. It is an alternative to the Manager of the root
+// package for Applications that handle few types, so that the implementations
+// of the other types are neither initialized nor linked into the binary.
+// Types are registered with its Register methods, which must be called before
+// any value is deserialized or constructed, and not concurrently with other
+// methods. The root package must not be imported by the Application, as it
+// injects its own Manager into every implementation when initialized.
+type Registry struct {
+	deserializeAcceptActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAccept, error)
+	deserializeActivityActivityStreams                          func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsActivity, error)
+	deserializeAddActivityStreams                               func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAdd, error)
+	deserializeAnnounceActivityStreams                          func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAnnounce, error)
+	deserializeApplicationActivityStreams                       func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsApplication, error)
+	deserializeArriveActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsArrive, error)
+	deserializeArticleActivityStreams                           func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsArticle, error)
+	deserializeAudioActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAudio, error)
+	deserializeBlockActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBlock, error)
+	deserializeCollectionActivityStreams                        func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollection, error)
+	deserializeCollectionPageActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollectionPage, error)
+	deserializeCreateActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCreate, error)
+	deserializeDeleteActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDelete, error)
+	deserializeDislikeActivityStreams                           func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDislike, error)
+	deserializeDocumentActivityStreams                          func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDocument, error)
+	deserializeEmojiActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEmoji, error)
+	deserializeEventActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEvent, error)
+	deserializeFlagActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFlag, error)
+	deserializeFollowActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollow, error)
+	deserializeGroupActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGroup, error)
+	deserializeHashtagActivityStreams                           func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error)
+	deserializeIgnoreActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIgnore, error)
+	deserializeImageActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsImage, error)
+	deserializeIntransitiveActivityActivityStreams              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIntransitiveActivity, error)
+	deserializeInviteActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInvite, error)
+	deserializeJoinActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsJoin, error)
+	deserializeLeaveActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLeave, error)
+	deserializeLikeActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLike, error)
+	deserializeLinkActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLink, error)
+	deserializeListenActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsListen, error)
+	deserializeMentionActivityStreams                           func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMention, error)
+	deserializeMoveActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMove, error)
+	deserializeNoteActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsNote, error)
+	deserializeObjectActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsObject, error)
+	deserializeOfferActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOffer, error)
+	deserializeOrderedCollectionActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedCollection, error)
+	deserializeOrderedCollectionPageActivityStreams             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedCollectionPage, error)
+	deserializeOrganizationActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrganization, error)
+	deserializePageActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPage, error)
+	deserializePersonActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPerson, error)
+	deserializePlaceActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPlace, error)
+	deserializeProfileActivityStreams                           func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsProfile, error)
+	deserializePropertyValueActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPropertyValue, error)
+	deserializePublicKeyActivityStreams                         func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublicKey, error)
+	deserializeQuestionActivityStreams                          func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsQuestion, error)
+	deserializeReadActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRead, error)
+	deserializeRejectActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsReject, error)
+	deserializeRelationshipActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelationship, error)
+	deserializeRemoveActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRemove, error)
+	deserializeServiceActivityStreams                           func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsService, error)
+	deserializeTentativeAcceptActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeAccept, error)
+	deserializeTentativeRejectActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeReject, error)
+	deserializeTombstoneActivityStreams                         func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTombstone, error)
+	deserializeTravelActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTravel, error)
+	deserializeUndoActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUndo, error)
+	deserializeUpdateActivityStreams                            func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUpdate, error)
+	deserializeVideoActivityStreams                             func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsVideo, error)
+	deserializeViewActivityStreams                              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsView, error)
+	deserializeAccuracyPropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAccuracyProperty, error)
+	deserializeAltitudePropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAltitudeProperty, error)
+	deserializeCurrentPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCurrentProperty, error)
+	deserializeDeletedPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDeletedProperty, error)
+	deserializeDescribesPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDescribesProperty, error)
+	deserializeDurationPropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDurationProperty, error)
+	deserializeEndTimePropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error)
+	deserializeFeaturedPropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedProperty, error)
+	deserializeFeaturedTagsPropertyActivityStreams              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error)
+	deserializeFirstPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFirstProperty, error)
+	deserializeFollowersPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollowersProperty, error)
+	deserializeFollowingPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollowingProperty, error)
+	deserializeHeightPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHeightProperty, error)
+	deserializeHrefPropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHrefProperty, error)
+	deserializeHreflangPropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHreflangProperty, error)
+	deserializeIdPropertyActivityStreams                        func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIdProperty, error)
+	deserializeInboxPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInboxProperty, error)
+	deserializeLastPropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLastProperty, error)
+	deserializeLatitudePropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLatitudeProperty, error)
+	deserializeLikedPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLikedProperty, error)
+	deserializeLikesPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLikesProperty, error)
+	deserializeLongitudePropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLongitudeProperty, error)
+	deserializeManuallyApprovesFollowersPropertyActivityStreams func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error)
+	deserializeMediaTypePropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMediaTypeProperty, error)
+	deserializeNextPropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsNextProperty, error)
+	deserializeOutboxPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOutboxProperty, error)
+	deserializeOwnerPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOwnerProperty, error)
+	deserializePartOfPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPartOfProperty, error)
+	deserializePreferredUsernamePropertyActivityStreams         func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreferredUsernameProperty, error)
+	deserializePrevPropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPrevProperty, error)
+	deserializePublicKeyPemPropertyActivityStreams              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublicKeyPemProperty, error)
+	deserializePublishedPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublishedProperty, error)
+	deserializeRadiusPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRadiusProperty, error)
+	deserializeRepliesPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRepliesProperty, error)
+	deserializeSharesPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSharesProperty, error)
+	deserializeStartIndexPropertyActivityStreams                func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsStartIndexProperty, error)
+	deserializeStartTimePropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsStartTimeProperty, error)
+	deserializeSubjectPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSubjectProperty, error)
+	deserializeTotalItemsPropertyActivityStreams                func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error)
+	deserializeUnitsPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUnitsProperty, error)
+	deserializeUpdatedPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUpdatedProperty, error)
+	deserializeValuePropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsValueProperty, error)
+	deserializeWidthPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsWidthProperty, error)
+	deserializeActorPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsActorProperty, error)
+	deserializeAlsoKnownAsPropertyActivityStreams               func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error)
+	deserializeAnyOfPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAnyOfProperty, error)
+	deserializeAttachmentPropertyActivityStreams                func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttachmentProperty, error)
+	deserializeAttributedToPropertyActivityStreams              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttributedToProperty, error)
+	deserializeAudiencePropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAudienceProperty, error)
+	deserializeBccPropertyActivityStreams                       func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBccProperty, error)
+	deserializeBtoPropertyActivityStreams                       func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBtoProperty, error)
+	deserializeCcPropertyActivityStreams                        func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCcProperty, error)
+	deserializeClosedPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsClosedProperty, error)
+	deserializeContentPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsContentProperty, error)
+	deserializeContextPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsContextProperty, error)
+	deserializeFormerTypePropertyActivityStreams                func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFormerTypeProperty, error)
+	deserializeGeneratorPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGeneratorProperty, error)
+	deserializeIconPropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIconProperty, error)
+	deserializeImagePropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsImageProperty, error)
+	deserializeInReplyToPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInReplyToProperty, error)
+	deserializeInstrumentPropertyActivityStreams                func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInstrumentProperty, error)
+	deserializeItemsPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsItemsProperty, error)
+	deserializeLocationPropertyActivityStreams                  func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLocationProperty, error)
+	deserializeNamePropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsNameProperty, error)
+	deserializeObjectPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsObjectProperty, error)
+	deserializeOneOfPropertyActivityStreams                     func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOneOfProperty, error)
+	deserializeOrderedItemsPropertyActivityStreams              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedItemsProperty, error)
+	deserializeOriginPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOriginProperty, error)
+	deserializePreviewPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreviewProperty, error)
+	deserializePublicKeyPropertyActivityStreams                 func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublicKeyProperty, error)
+	deserializeRelPropertyActivityStreams                       func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelProperty, error)
+	deserializeRelationshipPropertyActivityStreams              func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelationshipProperty, error)
+	deserializeResultPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsResultProperty, error)
+	deserializeStreamsPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsStreamsProperty, error)
+	deserializeSummaryPropertyActivityStreams                   func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSummaryProperty, error)
+	deserializeTagPropertyActivityStreams                       func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTagProperty, error)
+	deserializeTargetPropertyActivityStreams                    func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTargetProperty, error)
+	deserializeToPropertyActivityStreams                        func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsToProperty, error)
+	deserializeTypePropertyActivityStreams                      func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTypeProperty, error)
+	deserializeUrlPropertyActivityStreams                       func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUrlProperty, error)
+	types                                                       []registeredType
+}
+
+// NewRegistry creates a Registry with no types registered.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// DeserializeAcceptActivityStreams returns the deserialization method for
+// "ActivityStreamsAccept" if it is registered.
+func (this *Registry) DeserializeAcceptActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAccept, error) {
+	if this.deserializeAcceptActivityStreams != nil {
+		return this.deserializeAcceptActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAccept, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeAccuracyPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsAccuracyProperty" if it is registered.
+func (this *Registry) DeserializeAccuracyPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAccuracyProperty, error) {
+	if this.deserializeAccuracyPropertyActivityStreams != nil {
+		return this.deserializeAccuracyPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAccuracyProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeActivityActivityStreams returns the deserialization method for
+// "ActivityStreamsActivity" if it is registered.
+func (this *Registry) DeserializeActivityActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsActivity, error) {
+	if this.deserializeActivityActivityStreams != nil {
+		return this.deserializeActivityActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsActivity, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeActorPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsActorProperty" if it is registered.
+func (this *Registry) DeserializeActorPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsActorProperty, error) {
+	if this.deserializeActorPropertyActivityStreams != nil {
+		return this.deserializeActorPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsActorProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeAddActivityStreams returns the deserialization method for
+// "ActivityStreamsAdd" if it is registered.
+func (this *Registry) DeserializeAddActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAdd, error) {
+	if this.deserializeAddActivityStreams != nil {
+		return this.deserializeAddActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAdd, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeAlsoKnownAsPropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsAlsoKnownAsProperty" if it is registered.
+func (this *Registry) DeserializeAlsoKnownAsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+	if this.deserializeAlsoKnownAsPropertyActivityStreams != nil {
+		return this.deserializeAlsoKnownAsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeAltitudePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsAltitudeProperty" if it is registered.
+func (this *Registry) DeserializeAltitudePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAltitudeProperty, error) {
+	if this.deserializeAltitudePropertyActivityStreams != nil {
+		return this.deserializeAltitudePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAltitudeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeAnnounceActivityStreams returns the deserialization method for
+// "ActivityStreamsAnnounce" if it is registered.
+func (this *Registry) DeserializeAnnounceActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAnnounce, error) {
+	if this.deserializeAnnounceActivityStreams != nil {
+		return this.deserializeAnnounceActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAnnounce, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeAnyOfPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsAnyOfProperty" if it is registered.
+func (this *Registry) DeserializeAnyOfPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAnyOfProperty, error) {
+	if this.deserializeAnyOfPropertyActivityStreams != nil {
+		return this.deserializeAnyOfPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAnyOfProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeApplicationActivityStreams returns the deserialization method for
+// "ActivityStreamsApplication" if it is registered.
+func (this *Registry) DeserializeApplicationActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsApplication, error) {
+	if this.deserializeApplicationActivityStreams != nil {
+		return this.deserializeApplicationActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsApplication, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeArriveActivityStreams returns the deserialization method for
+// "ActivityStreamsArrive" if it is registered.
+func (this *Registry) DeserializeArriveActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsArrive, error) {
+	if this.deserializeArriveActivityStreams != nil {
+		return this.deserializeArriveActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsArrive, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeArticleActivityStreams returns the deserialization method for
+// "ActivityStreamsArticle" if it is registered.
+func (this *Registry) DeserializeArticleActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsArticle, error) {
+	if this.deserializeArticleActivityStreams != nil {
+		return this.deserializeArticleActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsArticle, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeAttachmentPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsAttachmentProperty" if it is registered.
+func (this *Registry) DeserializeAttachmentPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttachmentProperty, error) {
+	if this.deserializeAttachmentPropertyActivityStreams != nil {
+		return this.deserializeAttachmentPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAttachmentProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeAttributedToPropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsAttributedToProperty" if it is registered.
+func (this *Registry) DeserializeAttributedToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAttributedToProperty, error) {
+	if this.deserializeAttributedToPropertyActivityStreams != nil {
+		return this.deserializeAttributedToPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAttributedToProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeAudiencePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsAudienceProperty" if it is registered.
+func (this *Registry) DeserializeAudiencePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAudienceProperty, error) {
+	if this.deserializeAudiencePropertyActivityStreams != nil {
+		return this.deserializeAudiencePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAudienceProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeAudioActivityStreams returns the deserialization method for
+// "ActivityStreamsAudio" if it is registered.
+func (this *Registry) DeserializeAudioActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsAudio, error) {
+	if this.deserializeAudioActivityStreams != nil {
+		return this.deserializeAudioActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAudio, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeBccPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsBccProperty" if it is registered.
+func (this *Registry) DeserializeBccPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBccProperty, error) {
+	if this.deserializeBccPropertyActivityStreams != nil {
+		return this.deserializeBccPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBccProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeBlockActivityStreams returns the deserialization method for
+// "ActivityStreamsBlock" if it is registered.
+func (this *Registry) DeserializeBlockActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBlock, error) {
+	if this.deserializeBlockActivityStreams != nil {
+		return this.deserializeBlockActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBlock, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeBtoPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsBtoProperty" if it is registered.
+func (this *Registry) DeserializeBtoPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsBtoProperty, error) {
+	if this.deserializeBtoPropertyActivityStreams != nil {
+		return this.deserializeBtoPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBtoProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeCcPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsCcProperty" if it is registered.
+func (this *Registry) DeserializeCcPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCcProperty, error) {
+	if this.deserializeCcPropertyActivityStreams != nil {
+		return this.deserializeCcPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCcProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeClosedPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsClosedProperty" if it is registered.
+func (this *Registry) DeserializeClosedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsClosedProperty, error) {
+	if this.deserializeClosedPropertyActivityStreams != nil {
+		return this.deserializeClosedPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsClosedProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeCollectionActivityStreams returns the deserialization method for
+// "ActivityStreamsCollection" if it is registered.
+func (this *Registry) DeserializeCollectionActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollection, error) {
+	if this.deserializeCollectionActivityStreams != nil {
+		return this.deserializeCollectionActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCollection, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeCollectionPageActivityStreams returns the deserialization method for
+// "ActivityStreamsCollectionPage" if it is registered.
+func (this *Registry) DeserializeCollectionPageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCollectionPage, error) {
+	if this.deserializeCollectionPageActivityStreams != nil {
+		return this.deserializeCollectionPageActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCollectionPage, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeContentPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsContentProperty" if it is registered.
+func (this *Registry) DeserializeContentPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsContentProperty, error) {
+	if this.deserializeContentPropertyActivityStreams != nil {
+		return this.deserializeContentPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsContentProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeContextPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsContextProperty" if it is registered.
+func (this *Registry) DeserializeContextPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsContextProperty, error) {
+	if this.deserializeContextPropertyActivityStreams != nil {
+		return this.deserializeContextPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsContextProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeCreateActivityStreams returns the deserialization method for
+// "ActivityStreamsCreate" if it is registered.
+func (this *Registry) DeserializeCreateActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCreate, error) {
+	if this.deserializeCreateActivityStreams != nil {
+		return this.deserializeCreateActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCreate, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeCurrentPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsCurrentProperty" if it is registered.
+func (this *Registry) DeserializeCurrentPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsCurrentProperty, error) {
+	if this.deserializeCurrentPropertyActivityStreams != nil {
+		return this.deserializeCurrentPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCurrentProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeDeleteActivityStreams returns the deserialization method for
+// "ActivityStreamsDelete" if it is registered.
+func (this *Registry) DeserializeDeleteActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDelete, error) {
+	if this.deserializeDeleteActivityStreams != nil {
+		return this.deserializeDeleteActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDelete, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeDeletedPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsDeletedProperty" if it is registered.
+func (this *Registry) DeserializeDeletedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDeletedProperty, error) {
+	if this.deserializeDeletedPropertyActivityStreams != nil {
+		return this.deserializeDeletedPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDeletedProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeDescribesPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsDescribesProperty" if it is registered.
+func (this *Registry) DeserializeDescribesPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDescribesProperty, error) {
+	if this.deserializeDescribesPropertyActivityStreams != nil {
+		return this.deserializeDescribesPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDescribesProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeDislikeActivityStreams returns the deserialization method for
+// "ActivityStreamsDislike" if it is registered.
+func (this *Registry) DeserializeDislikeActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDislike, error) {
+	if this.deserializeDislikeActivityStreams != nil {
+		return this.deserializeDislikeActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDislike, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeDocumentActivityStreams returns the deserialization method for
+// "ActivityStreamsDocument" if it is registered.
+func (this *Registry) DeserializeDocumentActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDocument, error) {
+	if this.deserializeDocumentActivityStreams != nil {
+		return this.deserializeDocumentActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDocument, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeDurationPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsDurationProperty" if it is registered.
+func (this *Registry) DeserializeDurationPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsDurationProperty, error) {
+	if this.deserializeDurationPropertyActivityStreams != nil {
+		return this.deserializeDurationPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDurationProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeEmojiActivityStreams returns the deserialization method for
+// "ActivityStreamsEmoji" if it is registered.
+func (this *Registry) DeserializeEmojiActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEmoji, error) {
+	if this.deserializeEmojiActivityStreams != nil {
+		return this.deserializeEmojiActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEmoji, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeEndTimePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsEndTimeProperty" if it is registered.
+func (this *Registry) DeserializeEndTimePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
+	if this.deserializeEndTimePropertyActivityStreams != nil {
+		return this.deserializeEndTimePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeEventActivityStreams returns the deserialization method for
+// "ActivityStreamsEvent" if it is registered.
+func (this *Registry) DeserializeEventActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsEvent, error) {
+	if this.deserializeEventActivityStreams != nil {
+		return this.deserializeEventActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEvent, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeFeaturedPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsFeaturedProperty" if it is registered.
+func (this *Registry) DeserializeFeaturedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedProperty, error) {
+	if this.deserializeFeaturedPropertyActivityStreams != nil {
+		return this.deserializeFeaturedPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFeaturedProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeFeaturedTagsPropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsFeaturedTagsProperty" if it is registered.
+func (this *Registry) DeserializeFeaturedTagsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error) {
+	if this.deserializeFeaturedTagsPropertyActivityStreams != nil {
+		return this.deserializeFeaturedTagsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeFirstPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsFirstProperty" if it is registered.
+func (this *Registry) DeserializeFirstPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFirstProperty, error) {
+	if this.deserializeFirstPropertyActivityStreams != nil {
+		return this.deserializeFirstPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFirstProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeFlagActivityStreams returns the deserialization method for
+// "ActivityStreamsFlag" if it is registered.
+func (this *Registry) DeserializeFlagActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFlag, error) {
+	if this.deserializeFlagActivityStreams != nil {
+		return this.deserializeFlagActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFlag, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeFollowActivityStreams returns the deserialization method for
+// "ActivityStreamsFollow" if it is registered.
+func (this *Registry) DeserializeFollowActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollow, error) {
+	if this.deserializeFollowActivityStreams != nil {
+		return this.deserializeFollowActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollow, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeFollowersPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsFollowersProperty" if it is registered.
+func (this *Registry) DeserializeFollowersPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollowersProperty, error) {
+	if this.deserializeFollowersPropertyActivityStreams != nil {
+		return this.deserializeFollowersPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollowersProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeFollowingPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsFollowingProperty" if it is registered.
+func (this *Registry) DeserializeFollowingPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFollowingProperty, error) {
+	if this.deserializeFollowingPropertyActivityStreams != nil {
+		return this.deserializeFollowingPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollowingProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeFormerTypePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsFormerTypeProperty" if it is registered.
+func (this *Registry) DeserializeFormerTypePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsFormerTypeProperty, error) {
+	if this.deserializeFormerTypePropertyActivityStreams != nil {
+		return this.deserializeFormerTypePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFormerTypeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeGeneratorPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsGeneratorProperty" if it is registered.
+func (this *Registry) DeserializeGeneratorPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGeneratorProperty, error) {
+	if this.deserializeGeneratorPropertyActivityStreams != nil {
+		return this.deserializeGeneratorPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsGeneratorProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeGroupActivityStreams returns the deserialization method for
+// "ActivityStreamsGroup" if it is registered.
+func (this *Registry) DeserializeGroupActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsGroup, error) {
+	if this.deserializeGroupActivityStreams != nil {
+		return this.deserializeGroupActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsGroup, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeHashtagActivityStreams returns the deserialization method for
+// "ActivityStreamsHashtag" if it is registered.
+func (this *Registry) DeserializeHashtagActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHashtag, error) {
+	if this.deserializeHashtagActivityStreams != nil {
+		return this.deserializeHashtagActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHashtag, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeHeightPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsHeightProperty" if it is registered.
+func (this *Registry) DeserializeHeightPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHeightProperty, error) {
+	if this.deserializeHeightPropertyActivityStreams != nil {
+		return this.deserializeHeightPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHeightProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeHrefPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsHrefProperty" if it is registered.
+func (this *Registry) DeserializeHrefPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHrefProperty, error) {
+	if this.deserializeHrefPropertyActivityStreams != nil {
+		return this.deserializeHrefPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHrefProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeHreflangPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsHreflangProperty" if it is registered.
+func (this *Registry) DeserializeHreflangPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsHreflangProperty, error) {
+	if this.deserializeHreflangPropertyActivityStreams != nil {
+		return this.deserializeHreflangPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHreflangProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeIconPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsIconProperty" if it is registered.
+func (this *Registry) DeserializeIconPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIconProperty, error) {
+	if this.deserializeIconPropertyActivityStreams != nil {
+		return this.deserializeIconPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIconProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeIdPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsIdProperty" if it is registered.
+func (this *Registry) DeserializeIdPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIdProperty, error) {
+	if this.deserializeIdPropertyActivityStreams != nil {
+		return this.deserializeIdPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIdProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeIgnoreActivityStreams returns the deserialization method for
+// "ActivityStreamsIgnore" if it is registered.
+func (this *Registry) DeserializeIgnoreActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIgnore, error) {
+	if this.deserializeIgnoreActivityStreams != nil {
+		return this.deserializeIgnoreActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIgnore, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeImageActivityStreams returns the deserialization method for
+// "ActivityStreamsImage" if it is registered.
+func (this *Registry) DeserializeImageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsImage, error) {
+	if this.deserializeImageActivityStreams != nil {
+		return this.deserializeImageActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsImage, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeImagePropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsImageProperty" if it is registered.
+func (this *Registry) DeserializeImagePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsImageProperty, error) {
+	if this.deserializeImagePropertyActivityStreams != nil {
+		return this.deserializeImagePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsImageProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeInReplyToPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsInReplyToProperty" if it is registered.
+func (this *Registry) DeserializeInReplyToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInReplyToProperty, error) {
+	if this.deserializeInReplyToPropertyActivityStreams != nil {
+		return this.deserializeInReplyToPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInReplyToProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeInboxPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsInboxProperty" if it is registered.
+func (this *Registry) DeserializeInboxPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInboxProperty, error) {
+	if this.deserializeInboxPropertyActivityStreams != nil {
+		return this.deserializeInboxPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInboxProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeInstrumentPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsInstrumentProperty" if it is registered.
+func (this *Registry) DeserializeInstrumentPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInstrumentProperty, error) {
+	if this.deserializeInstrumentPropertyActivityStreams != nil {
+		return this.deserializeInstrumentPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInstrumentProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeIntransitiveActivityActivityStreams returns the deserialization
+// method for "ActivityStreamsIntransitiveActivity" if it is registered.
+func (this *Registry) DeserializeIntransitiveActivityActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsIntransitiveActivity, error) {
+	if this.deserializeIntransitiveActivityActivityStreams != nil {
+		return this.deserializeIntransitiveActivityActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIntransitiveActivity, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeInviteActivityStreams returns the deserialization method for
+// "ActivityStreamsInvite" if it is registered.
+func (this *Registry) DeserializeInviteActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsInvite, error) {
+	if this.deserializeInviteActivityStreams != nil {
+		return this.deserializeInviteActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInvite, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeItemsPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsItemsProperty" if it is registered.
+func (this *Registry) DeserializeItemsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsItemsProperty, error) {
+	if this.deserializeItemsPropertyActivityStreams != nil {
+		return this.deserializeItemsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsItemsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeJoinActivityStreams returns the deserialization method for
+// "ActivityStreamsJoin" if it is registered.
+func (this *Registry) DeserializeJoinActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsJoin, error) {
+	if this.deserializeJoinActivityStreams != nil {
+		return this.deserializeJoinActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsJoin, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeLastPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsLastProperty" if it is registered.
+func (this *Registry) DeserializeLastPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLastProperty, error) {
+	if this.deserializeLastPropertyActivityStreams != nil {
+		return this.deserializeLastPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLastProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeLatitudePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsLatitudeProperty" if it is registered.
+func (this *Registry) DeserializeLatitudePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLatitudeProperty, error) {
+	if this.deserializeLatitudePropertyActivityStreams != nil {
+		return this.deserializeLatitudePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLatitudeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeLeaveActivityStreams returns the deserialization method for
+// "ActivityStreamsLeave" if it is registered.
+func (this *Registry) DeserializeLeaveActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLeave, error) {
+	if this.deserializeLeaveActivityStreams != nil {
+		return this.deserializeLeaveActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLeave, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeLikeActivityStreams returns the deserialization method for
+// "ActivityStreamsLike" if it is registered.
+func (this *Registry) DeserializeLikeActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLike, error) {
+	if this.deserializeLikeActivityStreams != nil {
+		return this.deserializeLikeActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLike, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeLikedPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsLikedProperty" if it is registered.
+func (this *Registry) DeserializeLikedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLikedProperty, error) {
+	if this.deserializeLikedPropertyActivityStreams != nil {
+		return this.deserializeLikedPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLikedProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeLikesPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsLikesProperty" if it is registered.
+func (this *Registry) DeserializeLikesPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLikesProperty, error) {
+	if this.deserializeLikesPropertyActivityStreams != nil {
+		return this.deserializeLikesPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLikesProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeLinkActivityStreams returns the deserialization method for
+// "ActivityStreamsLink" if it is registered.
+func (this *Registry) DeserializeLinkActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLink, error) {
+	if this.deserializeLinkActivityStreams != nil {
+		return this.deserializeLinkActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLink, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeListenActivityStreams returns the deserialization method for
+// "ActivityStreamsListen" if it is registered.
+func (this *Registry) DeserializeListenActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsListen, error) {
+	if this.deserializeListenActivityStreams != nil {
+		return this.deserializeListenActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsListen, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeLocationPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsLocationProperty" if it is registered.
+func (this *Registry) DeserializeLocationPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLocationProperty, error) {
+	if this.deserializeLocationPropertyActivityStreams != nil {
+		return this.deserializeLocationPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLocationProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeLongitudePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsLongitudeProperty" if it is registered.
+func (this *Registry) DeserializeLongitudePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsLongitudeProperty, error) {
+	if this.deserializeLongitudePropertyActivityStreams != nil {
+		return this.deserializeLongitudePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLongitudeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeManuallyApprovesFollowersPropertyActivityStreams returns the
+// deserialization method for
+// "ActivityStreamsManuallyApprovesFollowersProperty" if it is registered.
+func (this *Registry) DeserializeManuallyApprovesFollowersPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+	if this.deserializeManuallyApprovesFollowersPropertyActivityStreams != nil {
+		return this.deserializeManuallyApprovesFollowersPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeMediaTypePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsMediaTypeProperty" if it is registered.
+func (this *Registry) DeserializeMediaTypePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMediaTypeProperty, error) {
+	if this.deserializeMediaTypePropertyActivityStreams != nil {
+		return this.deserializeMediaTypePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMediaTypeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeMentionActivityStreams returns the deserialization method for
+// "ActivityStreamsMention" if it is registered.
+func (this *Registry) DeserializeMentionActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMention, error) {
+	if this.deserializeMentionActivityStreams != nil {
+		return this.deserializeMentionActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMention, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeMoveActivityStreams returns the deserialization method for
+// "ActivityStreamsMove" if it is registered.
+func (this *Registry) DeserializeMoveActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsMove, error) {
+	if this.deserializeMoveActivityStreams != nil {
+		return this.deserializeMoveActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMove, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeNamePropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsNameProperty" if it is registered.
+func (this *Registry) DeserializeNamePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsNameProperty, error) {
+	if this.deserializeNamePropertyActivityStreams != nil {
+		return this.deserializeNamePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNameProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeNextPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsNextProperty" if it is registered.
+func (this *Registry) DeserializeNextPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsNextProperty, error) {
+	if this.deserializeNextPropertyActivityStreams != nil {
+		return this.deserializeNextPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNextProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeNoteActivityStreams returns the deserialization method for
+// "ActivityStreamsNote" if it is registered.
+func (this *Registry) DeserializeNoteActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsNote, error) {
+	if this.deserializeNoteActivityStreams != nil {
+		return this.deserializeNoteActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNote, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeObjectActivityStreams returns the deserialization method for
+// "ActivityStreamsObject" if it is registered.
+func (this *Registry) DeserializeObjectActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsObject, error) {
+	if this.deserializeObjectActivityStreams != nil {
+		return this.deserializeObjectActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsObject, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeObjectPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsObjectProperty" if it is registered.
+func (this *Registry) DeserializeObjectPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsObjectProperty, error) {
+	if this.deserializeObjectPropertyActivityStreams != nil {
+		return this.deserializeObjectPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsObjectProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeOfferActivityStreams returns the deserialization method for
+// "ActivityStreamsOffer" if it is registered.
+func (this *Registry) DeserializeOfferActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOffer, error) {
+	if this.deserializeOfferActivityStreams != nil {
+		return this.deserializeOfferActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOffer, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeOneOfPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsOneOfProperty" if it is registered.
+func (this *Registry) DeserializeOneOfPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOneOfProperty, error) {
+	if this.deserializeOneOfPropertyActivityStreams != nil {
+		return this.deserializeOneOfPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOneOfProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeOrderedCollectionActivityStreams returns the deserialization method
+// for "ActivityStreamsOrderedCollection" if it is registered.
+func (this *Registry) DeserializeOrderedCollectionActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedCollection, error) {
+	if this.deserializeOrderedCollectionActivityStreams != nil {
+		return this.deserializeOrderedCollectionActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedCollection, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeOrderedCollectionPageActivityStreams returns the deserialization
+// method for "ActivityStreamsOrderedCollectionPage" if it is registered.
+func (this *Registry) DeserializeOrderedCollectionPageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	if this.deserializeOrderedCollectionPageActivityStreams != nil {
+		return this.deserializeOrderedCollectionPageActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeOrderedItemsPropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsOrderedItemsProperty" if it is registered.
+func (this *Registry) DeserializeOrderedItemsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrderedItemsProperty, error) {
+	if this.deserializeOrderedItemsPropertyActivityStreams != nil {
+		return this.deserializeOrderedItemsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedItemsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeOrganizationActivityStreams returns the deserialization method for
+// "ActivityStreamsOrganization" if it is registered.
+func (this *Registry) DeserializeOrganizationActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOrganization, error) {
+	if this.deserializeOrganizationActivityStreams != nil {
+		return this.deserializeOrganizationActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrganization, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeOriginPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsOriginProperty" if it is registered.
+func (this *Registry) DeserializeOriginPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOriginProperty, error) {
+	if this.deserializeOriginPropertyActivityStreams != nil {
+		return this.deserializeOriginPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOriginProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeOutboxPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsOutboxProperty" if it is registered.
+func (this *Registry) DeserializeOutboxPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOutboxProperty, error) {
+	if this.deserializeOutboxPropertyActivityStreams != nil {
+		return this.deserializeOutboxPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOutboxProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeOwnerPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsOwnerProperty" if it is registered.
+func (this *Registry) DeserializeOwnerPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsOwnerProperty, error) {
+	if this.deserializeOwnerPropertyActivityStreams != nil {
+		return this.deserializeOwnerPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOwnerProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializePageActivityStreams returns the deserialization method for
+// "ActivityStreamsPage" if it is registered.
+func (this *Registry) DeserializePageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPage, error) {
+	if this.deserializePageActivityStreams != nil {
+		return this.deserializePageActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPage, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializePartOfPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsPartOfProperty" if it is registered.
+func (this *Registry) DeserializePartOfPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPartOfProperty, error) {
+	if this.deserializePartOfPropertyActivityStreams != nil {
+		return this.deserializePartOfPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPartOfProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializePersonActivityStreams returns the deserialization method for
+// "ActivityStreamsPerson" if it is registered.
+func (this *Registry) DeserializePersonActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPerson, error) {
+	if this.deserializePersonActivityStreams != nil {
+		return this.deserializePersonActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPerson, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializePlaceActivityStreams returns the deserialization method for
+// "ActivityStreamsPlace" if it is registered.
+func (this *Registry) DeserializePlaceActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPlace, error) {
+	if this.deserializePlaceActivityStreams != nil {
+		return this.deserializePlaceActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPlace, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializePreferredUsernamePropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsPreferredUsernameProperty" if it is registered.
+func (this *Registry) DeserializePreferredUsernamePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreferredUsernameProperty, error) {
+	if this.deserializePreferredUsernamePropertyActivityStreams != nil {
+		return this.deserializePreferredUsernamePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPreferredUsernameProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializePrevPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsPrevProperty" if it is registered.
+func (this *Registry) DeserializePrevPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPrevProperty, error) {
+	if this.deserializePrevPropertyActivityStreams != nil {
+		return this.deserializePrevPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPrevProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializePreviewPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsPreviewProperty" if it is registered.
+func (this *Registry) DeserializePreviewPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreviewProperty, error) {
+	if this.deserializePreviewPropertyActivityStreams != nil {
+		return this.deserializePreviewPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPreviewProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeProfileActivityStreams returns the deserialization method for
+// "ActivityStreamsProfile" if it is registered.
+func (this *Registry) DeserializeProfileActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsProfile, error) {
+	if this.deserializeProfileActivityStreams != nil {
+		return this.deserializeProfileActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsProfile, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializePropertyValueActivityStreams returns the deserialization method for
+// "ActivityStreamsPropertyValue" if it is registered.
+func (this *Registry) DeserializePropertyValueActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPropertyValue, error) {
+	if this.deserializePropertyValueActivityStreams != nil {
+		return this.deserializePropertyValueActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPropertyValue, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializePublicKeyActivityStreams returns the deserialization method for
+// "ActivityStreamsPublicKey" if it is registered.
+func (this *Registry) DeserializePublicKeyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublicKey, error) {
+	if this.deserializePublicKeyActivityStreams != nil {
+		return this.deserializePublicKeyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKey, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializePublicKeyPemPropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsPublicKeyPemProperty" if it is registered.
+func (this *Registry) DeserializePublicKeyPemPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublicKeyPemProperty, error) {
+	if this.deserializePublicKeyPemPropertyActivityStreams != nil {
+		return this.deserializePublicKeyPemPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKeyPemProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializePublicKeyPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsPublicKeyProperty" if it is registered.
+func (this *Registry) DeserializePublicKeyPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublicKeyProperty, error) {
+	if this.deserializePublicKeyPropertyActivityStreams != nil {
+		return this.deserializePublicKeyPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKeyProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializePublishedPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsPublishedProperty" if it is registered.
+func (this *Registry) DeserializePublishedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPublishedProperty, error) {
+	if this.deserializePublishedPropertyActivityStreams != nil {
+		return this.deserializePublishedPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublishedProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeQuestionActivityStreams returns the deserialization method for
+// "ActivityStreamsQuestion" if it is registered.
+func (this *Registry) DeserializeQuestionActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsQuestion, error) {
+	if this.deserializeQuestionActivityStreams != nil {
+		return this.deserializeQuestionActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsQuestion, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeRadiusPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsRadiusProperty" if it is registered.
+func (this *Registry) DeserializeRadiusPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRadiusProperty, error) {
+	if this.deserializeRadiusPropertyActivityStreams != nil {
+		return this.deserializeRadiusPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRadiusProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeReadActivityStreams returns the deserialization method for
+// "ActivityStreamsRead" if it is registered.
+func (this *Registry) DeserializeReadActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRead, error) {
+	if this.deserializeReadActivityStreams != nil {
+		return this.deserializeReadActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRead, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeRejectActivityStreams returns the deserialization method for
+// "ActivityStreamsReject" if it is registered.
+func (this *Registry) DeserializeRejectActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsReject, error) {
+	if this.deserializeRejectActivityStreams != nil {
+		return this.deserializeRejectActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsReject, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeRelPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsRelProperty" if it is registered.
+func (this *Registry) DeserializeRelPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelProperty, error) {
+	if this.deserializeRelPropertyActivityStreams != nil {
+		return this.deserializeRelPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeRelationshipActivityStreams returns the deserialization method for
+// "ActivityStreamsRelationship" if it is registered.
+func (this *Registry) DeserializeRelationshipActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelationship, error) {
+	if this.deserializeRelationshipActivityStreams != nil {
+		return this.deserializeRelationshipActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelationship, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeRelationshipPropertyActivityStreams returns the deserialization
+// method for "ActivityStreamsRelationshipProperty" if it is registered.
+func (this *Registry) DeserializeRelationshipPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRelationshipProperty, error) {
+	if this.deserializeRelationshipPropertyActivityStreams != nil {
+		return this.deserializeRelationshipPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelationshipProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeRemoveActivityStreams returns the deserialization method for
+// "ActivityStreamsRemove" if it is registered.
+func (this *Registry) DeserializeRemoveActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRemove, error) {
+	if this.deserializeRemoveActivityStreams != nil {
+		return this.deserializeRemoveActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRemove, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeRepliesPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsRepliesProperty" if it is registered.
+func (this *Registry) DeserializeRepliesPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsRepliesProperty, error) {
+	if this.deserializeRepliesPropertyActivityStreams != nil {
+		return this.deserializeRepliesPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRepliesProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeResultPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsResultProperty" if it is registered.
+func (this *Registry) DeserializeResultPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsResultProperty, error) {
+	if this.deserializeResultPropertyActivityStreams != nil {
+		return this.deserializeResultPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsResultProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeServiceActivityStreams returns the deserialization method for
+// "ActivityStreamsService" if it is registered.
+func (this *Registry) DeserializeServiceActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsService, error) {
+	if this.deserializeServiceActivityStreams != nil {
+		return this.deserializeServiceActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsService, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeSharesPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsSharesProperty" if it is registered.
+func (this *Registry) DeserializeSharesPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSharesProperty, error) {
+	if this.deserializeSharesPropertyActivityStreams != nil {
+		return this.deserializeSharesPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSharesProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeStartIndexPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsStartIndexProperty" if it is registered.
+func (this *Registry) DeserializeStartIndexPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsStartIndexProperty, error) {
+	if this.deserializeStartIndexPropertyActivityStreams != nil {
+		return this.deserializeStartIndexPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStartIndexProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeStartTimePropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsStartTimeProperty" if it is registered.
+func (this *Registry) DeserializeStartTimePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsStartTimeProperty, error) {
+	if this.deserializeStartTimePropertyActivityStreams != nil {
+		return this.deserializeStartTimePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStartTimeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeStreamsPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsStreamsProperty" if it is registered.
+func (this *Registry) DeserializeStreamsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsStreamsProperty, error) {
+	if this.deserializeStreamsPropertyActivityStreams != nil {
+		return this.deserializeStreamsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStreamsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeSubjectPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsSubjectProperty" if it is registered.
+func (this *Registry) DeserializeSubjectPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSubjectProperty, error) {
+	if this.deserializeSubjectPropertyActivityStreams != nil {
+		return this.deserializeSubjectPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSubjectProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeSummaryPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsSummaryProperty" if it is registered.
+func (this *Registry) DeserializeSummaryPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSummaryProperty, error) {
+	if this.deserializeSummaryPropertyActivityStreams != nil {
+		return this.deserializeSummaryPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSummaryProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeTagPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsTagProperty" if it is registered.
+func (this *Registry) DeserializeTagPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTagProperty, error) {
+	if this.deserializeTagPropertyActivityStreams != nil {
+		return this.deserializeTagPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTagProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeTargetPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsTargetProperty" if it is registered.
+func (this *Registry) DeserializeTargetPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTargetProperty, error) {
+	if this.deserializeTargetPropertyActivityStreams != nil {
+		return this.deserializeTargetPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTargetProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeTentativeAcceptActivityStreams returns the deserialization method
+// for "ActivityStreamsTentativeAccept" if it is registered.
+func (this *Registry) DeserializeTentativeAcceptActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeAccept, error) {
+	if this.deserializeTentativeAcceptActivityStreams != nil {
+		return this.deserializeTentativeAcceptActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTentativeAccept, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeTentativeRejectActivityStreams returns the deserialization method
+// for "ActivityStreamsTentativeReject" if it is registered.
+func (this *Registry) DeserializeTentativeRejectActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTentativeReject, error) {
+	if this.deserializeTentativeRejectActivityStreams != nil {
+		return this.deserializeTentativeRejectActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTentativeReject, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeToPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsToProperty" if it is registered.
+func (this *Registry) DeserializeToPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsToProperty, error) {
+	if this.deserializeToPropertyActivityStreams != nil {
+		return this.deserializeToPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsToProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeTombstoneActivityStreams returns the deserialization method for
+// "ActivityStreamsTombstone" if it is registered.
+func (this *Registry) DeserializeTombstoneActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTombstone, error) {
+	if this.deserializeTombstoneActivityStreams != nil {
+		return this.deserializeTombstoneActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTombstone, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeTotalItemsPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsTotalItemsProperty" if it is registered.
+func (this *Registry) DeserializeTotalItemsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error) {
+	if this.deserializeTotalItemsPropertyActivityStreams != nil {
+		return this.deserializeTotalItemsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeTravelActivityStreams returns the deserialization method for
+// "ActivityStreamsTravel" if it is registered.
+func (this *Registry) DeserializeTravelActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTravel, error) {
+	if this.deserializeTravelActivityStreams != nil {
+		return this.deserializeTravelActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTravel, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeTypePropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsTypeProperty" if it is registered.
+func (this *Registry) DeserializeTypePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsTypeProperty, error) {
+	if this.deserializeTypePropertyActivityStreams != nil {
+		return this.deserializeTypePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTypeProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeUndoActivityStreams returns the deserialization method for
+// "ActivityStreamsUndo" if it is registered.
+func (this *Registry) DeserializeUndoActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUndo, error) {
+	if this.deserializeUndoActivityStreams != nil {
+		return this.deserializeUndoActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUndo, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeUnitsPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsUnitsProperty" if it is registered.
+func (this *Registry) DeserializeUnitsPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUnitsProperty, error) {
+	if this.deserializeUnitsPropertyActivityStreams != nil {
+		return this.deserializeUnitsPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUnitsProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeUpdateActivityStreams returns the deserialization method for
+// "ActivityStreamsUpdate" if it is registered.
+func (this *Registry) DeserializeUpdateActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUpdate, error) {
+	if this.deserializeUpdateActivityStreams != nil {
+		return this.deserializeUpdateActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUpdate, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeUpdatedPropertyActivityStreams returns the deserialization method
+// for "ActivityStreamsUpdatedProperty" if it is registered.
+func (this *Registry) DeserializeUpdatedPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUpdatedProperty, error) {
+	if this.deserializeUpdatedPropertyActivityStreams != nil {
+		return this.deserializeUpdatedPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUpdatedProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeUrlPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsUrlProperty" if it is registered.
+func (this *Registry) DeserializeUrlPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsUrlProperty, error) {
+	if this.deserializeUrlPropertyActivityStreams != nil {
+		return this.deserializeUrlPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUrlProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeValuePropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsValueProperty" if it is registered.
+func (this *Registry) DeserializeValuePropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsValueProperty, error) {
+	if this.deserializeValuePropertyActivityStreams != nil {
+		return this.deserializeValuePropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsValueProperty, error) {
+		return nil, nil
+	}
+}
+
+// DeserializeVideoActivityStreams returns the deserialization method for
+// "ActivityStreamsVideo" if it is registered.
+func (this *Registry) DeserializeVideoActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsVideo, error) {
+	if this.deserializeVideoActivityStreams != nil {
+		return this.deserializeVideoActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsVideo, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeViewActivityStreams returns the deserialization method for
+// "ActivityStreamsView" if it is registered.
+func (this *Registry) DeserializeViewActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsView, error) {
+	if this.deserializeViewActivityStreams != nil {
+		return this.deserializeViewActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsView, error) {
+		return nil, ErrUnregisteredType
+	}
+}
+
+// DeserializeWidthPropertyActivityStreams returns the deserialization method for
+// "ActivityStreamsWidthProperty" if it is registered.
+func (this *Registry) DeserializeWidthPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsWidthProperty, error) {
+	if this.deserializeWidthPropertyActivityStreams != nil {
+		return this.deserializeWidthPropertyActivityStreams
+	}
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsWidthProperty, error) {
+		return nil, nil
+	}
+}
+
+// RegisterActivityStreamsAccept registers the "Accept" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsAccept() {
+	if this.deserializeAcceptActivityStreams != nil {
+		return
+	}
+	this.deserializeAcceptActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAccept, error) {
+		i, err := typeaccept.DeserializeAccept(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeAcceptActivityStreams(m, aliasMap)
+		},
+		name:     "Accept",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeaccept.SetManager(this)
+	typeaccept.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsActivity registers the "Activity" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsActivity() {
+	if this.deserializeActivityActivityStreams != nil {
+		return
+	}
+	this.deserializeActivityActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsActivity, error) {
+		i, err := typeactivity.DeserializeActivity(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeActivityActivityStreams(m, aliasMap)
+		},
+		name:     "Activity",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeactivity.SetManager(this)
+	typeactivity.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsAdd registers the "Add" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsAdd() {
+	if this.deserializeAddActivityStreams != nil {
+		return
+	}
+	this.deserializeAddActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAdd, error) {
+		i, err := typeadd.DeserializeAdd(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeAddActivityStreams(m, aliasMap)
+		},
+		name:     "Add",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeadd.SetManager(this)
+	typeadd.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsAnnounce registers the "Announce" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsAnnounce() {
+	if this.deserializeAnnounceActivityStreams != nil {
+		return
+	}
+	this.deserializeAnnounceActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAnnounce, error) {
+		i, err := typeannounce.DeserializeAnnounce(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeAnnounceActivityStreams(m, aliasMap)
+		},
+		name:     "Announce",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeannounce.SetManager(this)
+	typeannounce.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsApplication registers the "Application" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsApplication() {
+	if this.deserializeApplicationActivityStreams != nil {
+		return
+	}
+	this.deserializeApplicationActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsApplication, error) {
+		i, err := typeapplication.DeserializeApplication(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeApplicationActivityStreams(m, aliasMap)
+		},
+		name:     "Application",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeapplication.SetManager(this)
+	typeapplication.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAlsoKnownAsPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFeaturedPropertyActivityStreams()
+	this.registerFeaturedTagsPropertyActivityStreams()
+	this.registerFollowersPropertyActivityStreams()
+	this.registerFollowingPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInboxPropertyActivityStreams()
+	this.registerLikedPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerManuallyApprovesFollowersPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOutboxPropertyActivityStreams()
+	this.registerPreferredUsernamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublicKeyPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerStreamsPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsArrive registers the "Arrive" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsArrive() {
+	if this.deserializeArriveActivityStreams != nil {
+		return
+	}
+	this.deserializeArriveActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsArrive, error) {
+		i, err := typearrive.DeserializeArrive(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeArriveActivityStreams(m, aliasMap)
+		},
+		name:     "Arrive",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typearrive.SetManager(this)
+	typearrive.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsArticle registers the "Article" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsArticle() {
+	if this.deserializeArticleActivityStreams != nil {
+		return
+	}
+	this.deserializeArticleActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsArticle, error) {
+		i, err := typearticle.DeserializeArticle(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeArticleActivityStreams(m, aliasMap)
+		},
+		name:     "Article",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typearticle.SetManager(this)
+	typearticle.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsAudio registers the "Audio" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsAudio() {
+	if this.deserializeAudioActivityStreams != nil {
+		return
+	}
+	this.deserializeAudioActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAudio, error) {
+		i, err := typeaudio.DeserializeAudio(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeAudioActivityStreams(m, aliasMap)
+		},
+		name:     "Audio",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeaudio.SetManager(this)
+	typeaudio.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsBlock registers the "Block" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsBlock() {
+	if this.deserializeBlockActivityStreams != nil {
+		return
+	}
+	this.deserializeBlockActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBlock, error) {
+		i, err := typeblock.DeserializeBlock(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeBlockActivityStreams(m, aliasMap)
+		},
+		name:     "Block",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeblock.SetManager(this)
+	typeblock.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsCollection registers the "Collection" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsCollection() {
+	if this.deserializeCollectionActivityStreams != nil {
+		return
+	}
+	this.deserializeCollectionActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCollection, error) {
+		i, err := typecollection.DeserializeCollection(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeCollectionActivityStreams(m, aliasMap)
+		},
+		name:     "Collection",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typecollection.SetManager(this)
+	typecollection.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerCurrentPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFirstPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerItemsPropertyActivityStreams()
+	this.registerLastPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTotalItemsPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsCollectionPage registers the "CollectionPage" type of
+// the ActivityStreams vocabulary, and its properties. Values of the other
+// types are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsCollectionPage() {
+	if this.deserializeCollectionPageActivityStreams != nil {
+		return
+	}
+	this.deserializeCollectionPageActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCollectionPage, error) {
+		i, err := typecollectionpage.DeserializeCollectionPage(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeCollectionPageActivityStreams(m, aliasMap)
+		},
+		name:     "CollectionPage",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typecollectionpage.SetManager(this)
+	typecollectionpage.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerCurrentPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFirstPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerItemsPropertyActivityStreams()
+	this.registerLastPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerNextPropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPartOfPropertyActivityStreams()
+	this.registerPrevPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTotalItemsPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsCreate registers the "Create" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsCreate() {
+	if this.deserializeCreateActivityStreams != nil {
+		return
+	}
+	this.deserializeCreateActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCreate, error) {
+		i, err := typecreate.DeserializeCreate(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeCreateActivityStreams(m, aliasMap)
+		},
+		name:     "Create",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typecreate.SetManager(this)
+	typecreate.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsDelete registers the "Delete" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsDelete() {
+	if this.deserializeDeleteActivityStreams != nil {
+		return
+	}
+	this.deserializeDeleteActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDelete, error) {
+		i, err := typedelete.DeserializeDelete(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeDeleteActivityStreams(m, aliasMap)
+		},
+		name:     "Delete",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typedelete.SetManager(this)
+	typedelete.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsDislike registers the "Dislike" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsDislike() {
+	if this.deserializeDislikeActivityStreams != nil {
+		return
+	}
+	this.deserializeDislikeActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDislike, error) {
+		i, err := typedislike.DeserializeDislike(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeDislikeActivityStreams(m, aliasMap)
+		},
+		name:     "Dislike",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typedislike.SetManager(this)
+	typedislike.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsDocument registers the "Document" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsDocument() {
+	if this.deserializeDocumentActivityStreams != nil {
+		return
+	}
+	this.deserializeDocumentActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDocument, error) {
+		i, err := typedocument.DeserializeDocument(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeDocumentActivityStreams(m, aliasMap)
+		},
+		name:     "Document",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typedocument.SetManager(this)
+	typedocument.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsEmoji registers the "Emoji" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsEmoji() {
+	if this.deserializeEmojiActivityStreams != nil {
+		return
+	}
+	this.deserializeEmojiActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEmoji, error) {
+		i, err := typeemoji.DeserializeEmoji(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeEmojiActivityStreams(m, aliasMap)
+		},
+		name:     "Emoji",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeemoji.SetManager(this)
+	typeemoji.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsEvent registers the "Event" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsEvent() {
+	if this.deserializeEventActivityStreams != nil {
+		return
+	}
+	this.deserializeEventActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEvent, error) {
+		i, err := typeevent.DeserializeEvent(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeEventActivityStreams(m, aliasMap)
+		},
+		name:     "Event",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeevent.SetManager(this)
+	typeevent.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsFlag registers the "Flag" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsFlag() {
+	if this.deserializeFlagActivityStreams != nil {
+		return
+	}
+	this.deserializeFlagActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFlag, error) {
+		i, err := typeflag.DeserializeFlag(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeFlagActivityStreams(m, aliasMap)
+		},
+		name:     "Flag",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeflag.SetManager(this)
+	typeflag.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsFollow registers the "Follow" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsFollow() {
+	if this.deserializeFollowActivityStreams != nil {
+		return
+	}
+	this.deserializeFollowActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollow, error) {
+		i, err := typefollow.DeserializeFollow(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeFollowActivityStreams(m, aliasMap)
+		},
+		name:     "Follow",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typefollow.SetManager(this)
+	typefollow.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsGroup registers the "Group" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsGroup() {
+	if this.deserializeGroupActivityStreams != nil {
+		return
+	}
+	this.deserializeGroupActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsGroup, error) {
+		i, err := typegroup.DeserializeGroup(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeGroupActivityStreams(m, aliasMap)
+		},
+		name:     "Group",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typegroup.SetManager(this)
+	typegroup.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAlsoKnownAsPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFeaturedPropertyActivityStreams()
+	this.registerFeaturedTagsPropertyActivityStreams()
+	this.registerFollowersPropertyActivityStreams()
+	this.registerFollowingPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInboxPropertyActivityStreams()
+	this.registerLikedPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerManuallyApprovesFollowersPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOutboxPropertyActivityStreams()
+	this.registerPreferredUsernamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublicKeyPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerStreamsPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsHashtag registers the "Hashtag" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsHashtag() {
+	if this.deserializeHashtagActivityStreams != nil {
+		return
+	}
+	this.deserializeHashtagActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHashtag, error) {
+		i, err := typehashtag.DeserializeHashtag(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeHashtagActivityStreams(m, aliasMap)
+		},
+		name:     "Hashtag",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typehashtag.SetManager(this)
+	typehashtag.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerHeightPropertyActivityStreams()
+	this.registerHrefPropertyActivityStreams()
+	this.registerHreflangPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerRelPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerWidthPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsIgnore registers the "Ignore" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsIgnore() {
+	if this.deserializeIgnoreActivityStreams != nil {
+		return
+	}
+	this.deserializeIgnoreActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIgnore, error) {
+		i, err := typeignore.DeserializeIgnore(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeIgnoreActivityStreams(m, aliasMap)
+		},
+		name:     "Ignore",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeignore.SetManager(this)
+	typeignore.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsImage registers the "Image" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsImage() {
+	if this.deserializeImageActivityStreams != nil {
+		return
+	}
+	this.deserializeImageActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsImage, error) {
+		i, err := typeimage.DeserializeImage(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeImageActivityStreams(m, aliasMap)
+		},
+		name:     "Image",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeimage.SetManager(this)
+	typeimage.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerHeightPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+	this.registerWidthPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsIntransitiveActivity registers the
+// "IntransitiveActivity" type of the ActivityStreams vocabulary, and its
+// properties. Values of the other types are only deserialized as IRIs or
+// unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsIntransitiveActivity() {
+	if this.deserializeIntransitiveActivityActivityStreams != nil {
+		return
+	}
+	this.deserializeIntransitiveActivityActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIntransitiveActivity, error) {
+		i, err := typeintransitiveactivity.DeserializeIntransitiveActivity(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeIntransitiveActivityActivityStreams(m, aliasMap)
+		},
+		name:     "IntransitiveActivity",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeintransitiveactivity.SetManager(this)
+	typeintransitiveactivity.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsInvite registers the "Invite" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsInvite() {
+	if this.deserializeInviteActivityStreams != nil {
+		return
+	}
+	this.deserializeInviteActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInvite, error) {
+		i, err := typeinvite.DeserializeInvite(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeInviteActivityStreams(m, aliasMap)
+		},
+		name:     "Invite",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeinvite.SetManager(this)
+	typeinvite.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsJoin registers the "Join" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsJoin() {
+	if this.deserializeJoinActivityStreams != nil {
+		return
+	}
+	this.deserializeJoinActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsJoin, error) {
+		i, err := typejoin.DeserializeJoin(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeJoinActivityStreams(m, aliasMap)
+		},
+		name:     "Join",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typejoin.SetManager(this)
+	typejoin.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsLeave registers the "Leave" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsLeave() {
+	if this.deserializeLeaveActivityStreams != nil {
+		return
+	}
+	this.deserializeLeaveActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLeave, error) {
+		i, err := typeleave.DeserializeLeave(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeLeaveActivityStreams(m, aliasMap)
+		},
+		name:     "Leave",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeleave.SetManager(this)
+	typeleave.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsLike registers the "Like" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsLike() {
+	if this.deserializeLikeActivityStreams != nil {
+		return
+	}
+	this.deserializeLikeActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLike, error) {
+		i, err := typelike.DeserializeLike(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeLikeActivityStreams(m, aliasMap)
+		},
+		name:     "Like",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typelike.SetManager(this)
+	typelike.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsLink registers the "Link" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsLink() {
+	if this.deserializeLinkActivityStreams != nil {
+		return
+	}
+	this.deserializeLinkActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLink, error) {
+		i, err := typelink.DeserializeLink(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeLinkActivityStreams(m, aliasMap)
+		},
+		name:     "Link",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typelink.SetManager(this)
+	typelink.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerHeightPropertyActivityStreams()
+	this.registerHrefPropertyActivityStreams()
+	this.registerHreflangPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerRelPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerWidthPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsListen registers the "Listen" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsListen() {
+	if this.deserializeListenActivityStreams != nil {
+		return
+	}
+	this.deserializeListenActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsListen, error) {
+		i, err := typelisten.DeserializeListen(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeListenActivityStreams(m, aliasMap)
+		},
+		name:     "Listen",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typelisten.SetManager(this)
+	typelisten.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsMention registers the "Mention" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsMention() {
+	if this.deserializeMentionActivityStreams != nil {
+		return
+	}
+	this.deserializeMentionActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMention, error) {
+		i, err := typemention.DeserializeMention(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeMentionActivityStreams(m, aliasMap)
+		},
+		name:     "Mention",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typemention.SetManager(this)
+	typemention.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerHeightPropertyActivityStreams()
+	this.registerHrefPropertyActivityStreams()
+	this.registerHreflangPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerRelPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerWidthPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsMove registers the "Move" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsMove() {
+	if this.deserializeMoveActivityStreams != nil {
+		return
+	}
+	this.deserializeMoveActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMove, error) {
+		i, err := typemove.DeserializeMove(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeMoveActivityStreams(m, aliasMap)
+		},
+		name:     "Move",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typemove.SetManager(this)
+	typemove.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsNote registers the "Note" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsNote() {
+	if this.deserializeNoteActivityStreams != nil {
+		return
+	}
+	this.deserializeNoteActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNote, error) {
+		i, err := typenote.DeserializeNote(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeNoteActivityStreams(m, aliasMap)
+		},
+		name:     "Note",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typenote.SetManager(this)
+	typenote.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsObject registers the "Object" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsObject() {
+	if this.deserializeObjectActivityStreams != nil {
+		return
+	}
+	this.deserializeObjectActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsObject, error) {
+		i, err := typeobject.DeserializeObject(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeObjectActivityStreams(m, aliasMap)
+		},
+		name:     "Object",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeobject.SetManager(this)
+	typeobject.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsOffer registers the "Offer" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsOffer() {
+	if this.deserializeOfferActivityStreams != nil {
+		return
+	}
+	this.deserializeOfferActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOffer, error) {
+		i, err := typeoffer.DeserializeOffer(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeOfferActivityStreams(m, aliasMap)
+		},
+		name:     "Offer",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeoffer.SetManager(this)
+	typeoffer.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsOrderedCollection registers the "OrderedCollection" type
+// of the ActivityStreams vocabulary, and its properties. Values of the other
+// types are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsOrderedCollection() {
+	if this.deserializeOrderedCollectionActivityStreams != nil {
+		return
+	}
+	this.deserializeOrderedCollectionActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedCollection, error) {
+		i, err := typeorderedcollection.DeserializeOrderedCollection(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeOrderedCollectionActivityStreams(m, aliasMap)
+		},
+		name:     "OrderedCollection",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeorderedcollection.SetManager(this)
+	typeorderedcollection.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerCurrentPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFirstPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLastPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOrderedItemsPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTotalItemsPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsOrderedCollectionPage registers the
+// "OrderedCollectionPage" type of the ActivityStreams vocabulary, and its
+// properties. Values of the other types are only deserialized as IRIs or
+// unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsOrderedCollectionPage() {
+	if this.deserializeOrderedCollectionPageActivityStreams != nil {
+		return
+	}
+	this.deserializeOrderedCollectionPageActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+		i, err := typeorderedcollectionpage.DeserializeOrderedCollectionPage(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeOrderedCollectionPageActivityStreams(m, aliasMap)
+		},
+		name:     "OrderedCollectionPage",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeorderedcollectionpage.SetManager(this)
+	typeorderedcollectionpage.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerCurrentPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFirstPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLastPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerNextPropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOrderedItemsPropertyActivityStreams()
+	this.registerPartOfPropertyActivityStreams()
+	this.registerPrevPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartIndexPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTotalItemsPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsOrganization registers the "Organization" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsOrganization() {
+	if this.deserializeOrganizationActivityStreams != nil {
+		return
+	}
+	this.deserializeOrganizationActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrganization, error) {
+		i, err := typeorganization.DeserializeOrganization(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeOrganizationActivityStreams(m, aliasMap)
+		},
+		name:     "Organization",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeorganization.SetManager(this)
+	typeorganization.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAlsoKnownAsPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFeaturedPropertyActivityStreams()
+	this.registerFeaturedTagsPropertyActivityStreams()
+	this.registerFollowersPropertyActivityStreams()
+	this.registerFollowingPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInboxPropertyActivityStreams()
+	this.registerLikedPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerManuallyApprovesFollowersPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOutboxPropertyActivityStreams()
+	this.registerPreferredUsernamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublicKeyPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerStreamsPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsPage registers the "Page" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsPage() {
+	if this.deserializePageActivityStreams != nil {
+		return
+	}
+	this.deserializePageActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPage, error) {
+		i, err := typepage.DeserializePage(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializePageActivityStreams(m, aliasMap)
+		},
+		name:     "Page",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typepage.SetManager(this)
+	typepage.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsPerson registers the "Person" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsPerson() {
+	if this.deserializePersonActivityStreams != nil {
+		return
+	}
+	this.deserializePersonActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPerson, error) {
+		i, err := typeperson.DeserializePerson(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializePersonActivityStreams(m, aliasMap)
+		},
+		name:     "Person",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeperson.SetManager(this)
+	typeperson.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAlsoKnownAsPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFeaturedPropertyActivityStreams()
+	this.registerFeaturedTagsPropertyActivityStreams()
+	this.registerFollowersPropertyActivityStreams()
+	this.registerFollowingPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInboxPropertyActivityStreams()
+	this.registerLikedPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerManuallyApprovesFollowersPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOutboxPropertyActivityStreams()
+	this.registerPreferredUsernamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublicKeyPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerStreamsPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsPlace registers the "Place" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsPlace() {
+	if this.deserializePlaceActivityStreams != nil {
+		return
+	}
+	this.deserializePlaceActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPlace, error) {
+		i, err := typeplace.DeserializePlace(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializePlaceActivityStreams(m, aliasMap)
+		},
+		name:     "Place",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeplace.SetManager(this)
+	typeplace.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAccuracyPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLatitudePropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerLongitudePropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRadiusPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUnitsPropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsProfile registers the "Profile" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsProfile() {
+	if this.deserializeProfileActivityStreams != nil {
+		return
+	}
+	this.deserializeProfileActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsProfile, error) {
+		i, err := typeprofile.DeserializeProfile(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeProfileActivityStreams(m, aliasMap)
+		},
+		name:     "Profile",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeprofile.SetManager(this)
+	typeprofile.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDescribesPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsPropertyValue registers the "PropertyValue" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsPropertyValue() {
+	if this.deserializePropertyValueActivityStreams != nil {
+		return
+	}
+	this.deserializePropertyValueActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPropertyValue, error) {
+		i, err := typepropertyvalue.DeserializePropertyValue(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializePropertyValueActivityStreams(m, aliasMap)
+		},
+		name:     "PropertyValue",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typepropertyvalue.SetManager(this)
+	typepropertyvalue.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+	this.registerValuePropertyActivityStreams()
+}
+
+// RegisterActivityStreamsPublicKey registers the "PublicKey" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsPublicKey() {
+	if this.deserializePublicKeyActivityStreams != nil {
+		return
+	}
+	this.deserializePublicKeyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKey, error) {
+		i, err := typepublickey.DeserializePublicKey(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializePublicKeyActivityStreams(m, aliasMap)
+		},
+		name:     "PublicKey",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typepublickey.SetManager(this)
+	typepublickey.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerIdPropertyActivityStreams()
+	this.registerOwnerPropertyActivityStreams()
+	this.registerPublicKeyPemPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+}
+
+// RegisterActivityStreamsQuestion registers the "Question" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsQuestion() {
+	if this.deserializeQuestionActivityStreams != nil {
+		return
+	}
+	this.deserializeQuestionActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsQuestion, error) {
+		i, err := typequestion.DeserializeQuestion(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeQuestionActivityStreams(m, aliasMap)
+		},
+		name:     "Question",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typequestion.SetManager(this)
+	typequestion.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAnyOfPropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerClosedPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerOneOfPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsRead registers the "Read" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsRead() {
+	if this.deserializeReadActivityStreams != nil {
+		return
+	}
+	this.deserializeReadActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRead, error) {
+		i, err := typeread.DeserializeRead(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeReadActivityStreams(m, aliasMap)
+		},
+		name:     "Read",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeread.SetManager(this)
+	typeread.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsReject registers the "Reject" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsReject() {
+	if this.deserializeRejectActivityStreams != nil {
+		return
+	}
+	this.deserializeRejectActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsReject, error) {
+		i, err := typereject.DeserializeReject(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeRejectActivityStreams(m, aliasMap)
+		},
+		name:     "Reject",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typereject.SetManager(this)
+	typereject.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsRelationship registers the "Relationship" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsRelationship() {
+	if this.deserializeRelationshipActivityStreams != nil {
+		return
+	}
+	this.deserializeRelationshipActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelationship, error) {
+		i, err := typerelationship.DeserializeRelationship(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeRelationshipActivityStreams(m, aliasMap)
+		},
+		name:     "Relationship",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typerelationship.SetManager(this)
+	typerelationship.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRelationshipPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSubjectPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsRemove registers the "Remove" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsRemove() {
+	if this.deserializeRemoveActivityStreams != nil {
+		return
+	}
+	this.deserializeRemoveActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRemove, error) {
+		i, err := typeremove.DeserializeRemove(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeRemoveActivityStreams(m, aliasMap)
+		},
+		name:     "Remove",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeremove.SetManager(this)
+	typeremove.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsService registers the "Service" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsService() {
+	if this.deserializeServiceActivityStreams != nil {
+		return
+	}
+	this.deserializeServiceActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsService, error) {
+		i, err := typeservice.DeserializeService(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeServiceActivityStreams(m, aliasMap)
+		},
+		name:     "Service",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeservice.SetManager(this)
+	typeservice.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAlsoKnownAsPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFeaturedPropertyActivityStreams()
+	this.registerFeaturedTagsPropertyActivityStreams()
+	this.registerFollowersPropertyActivityStreams()
+	this.registerFollowingPropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInboxPropertyActivityStreams()
+	this.registerLikedPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerManuallyApprovesFollowersPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOutboxPropertyActivityStreams()
+	this.registerPreferredUsernamePropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublicKeyPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerStreamsPropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsTentativeAccept registers the "TentativeAccept" type of
+// the ActivityStreams vocabulary, and its properties. Values of the other
+// types are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsTentativeAccept() {
+	if this.deserializeTentativeAcceptActivityStreams != nil {
+		return
+	}
+	this.deserializeTentativeAcceptActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTentativeAccept, error) {
+		i, err := typetentativeaccept.DeserializeTentativeAccept(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeTentativeAcceptActivityStreams(m, aliasMap)
+		},
+		name:     "TentativeAccept",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typetentativeaccept.SetManager(this)
+	typetentativeaccept.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsTentativeReject registers the "TentativeReject" type of
+// the ActivityStreams vocabulary, and its properties. Values of the other
+// types are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsTentativeReject() {
+	if this.deserializeTentativeRejectActivityStreams != nil {
+		return
+	}
+	this.deserializeTentativeRejectActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTentativeReject, error) {
+		i, err := typetentativereject.DeserializeTentativeReject(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeTentativeRejectActivityStreams(m, aliasMap)
+		},
+		name:     "TentativeReject",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typetentativereject.SetManager(this)
+	typetentativereject.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsTombstone registers the "Tombstone" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsTombstone() {
+	if this.deserializeTombstoneActivityStreams != nil {
+		return
+	}
+	this.deserializeTombstoneActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTombstone, error) {
+		i, err := typetombstone.DeserializeTombstone(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeTombstoneActivityStreams(m, aliasMap)
+		},
+		name:     "Tombstone",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typetombstone.SetManager(this)
+	typetombstone.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDeletedPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerFormerTypePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsTravel registers the "Travel" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsTravel() {
+	if this.deserializeTravelActivityStreams != nil {
+		return
+	}
+	this.deserializeTravelActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTravel, error) {
+		i, err := typetravel.DeserializeTravel(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeTravelActivityStreams(m, aliasMap)
+		},
+		name:     "Travel",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typetravel.SetManager(this)
+	typetravel.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsUndo registers the "Undo" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsUndo() {
+	if this.deserializeUndoActivityStreams != nil {
+		return
+	}
+	this.deserializeUndoActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUndo, error) {
+		i, err := typeundo.DeserializeUndo(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeUndoActivityStreams(m, aliasMap)
+		},
+		name:     "Undo",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeundo.SetManager(this)
+	typeundo.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsUpdate registers the "Update" type of the
+// ActivityStreams vocabulary, and its properties. Values of the other types
+// are only deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsUpdate() {
+	if this.deserializeUpdateActivityStreams != nil {
+		return
+	}
+	this.deserializeUpdateActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUpdate, error) {
+		i, err := typeupdate.DeserializeUpdate(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeUpdateActivityStreams(m, aliasMap)
+		},
+		name:     "Update",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeupdate.SetManager(this)
+	typeupdate.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsVideo registers the "Video" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsVideo() {
+	if this.deserializeVideoActivityStreams != nil {
+		return
+	}
+	this.deserializeVideoActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsVideo, error) {
+		i, err := typevideo.DeserializeVideo(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeVideoActivityStreams(m, aliasMap)
+		},
+		name:     "Video",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typevideo.SetManager(this)
+	typevideo.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// RegisterActivityStreamsView registers the "View" type of the ActivityStreams
+// vocabulary, and its properties. Values of the other types are only
+// deserialized as IRIs or unknown values of these properties.
+func (this *Registry) RegisterActivityStreamsView() {
+	if this.deserializeViewActivityStreams != nil {
+		return
+	}
+	this.deserializeViewActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsView, error) {
+		i, err := typeview.DeserializeView(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	this.types = append(this.types, registeredType{
+		deserialize: func(m map[string]interface{}, aliasMap map[string]string) (vocab.Type, error) {
+			return this.deserializeViewActivityStreams(m, aliasMap)
+		},
+		name:     "View",
+		vocabURI: "https://www.w3.org/ns/activitystreams",
+	})
+	typeview.SetManager(this)
+	typeview.SetTypePropertyConstructor(func() vocab.ActivityStreamsTypeProperty {
+		return propertytype.NewActivityStreamsTypeProperty()
+	})
+	this.registerActorPropertyActivityStreams()
+	this.registerAltitudePropertyActivityStreams()
+	this.registerAttachmentPropertyActivityStreams()
+	this.registerAttributedToPropertyActivityStreams()
+	this.registerAudiencePropertyActivityStreams()
+	this.registerBccPropertyActivityStreams()
+	this.registerBtoPropertyActivityStreams()
+	this.registerCcPropertyActivityStreams()
+	this.registerContentPropertyActivityStreams()
+	this.registerContextPropertyActivityStreams()
+	this.registerDurationPropertyActivityStreams()
+	this.registerEndTimePropertyActivityStreams()
+	this.registerGeneratorPropertyActivityStreams()
+	this.registerIconPropertyActivityStreams()
+	this.registerIdPropertyActivityStreams()
+	this.registerImagePropertyActivityStreams()
+	this.registerInReplyToPropertyActivityStreams()
+	this.registerInstrumentPropertyActivityStreams()
+	this.registerLikesPropertyActivityStreams()
+	this.registerLocationPropertyActivityStreams()
+	this.registerMediaTypePropertyActivityStreams()
+	this.registerNamePropertyActivityStreams()
+	this.registerObjectPropertyActivityStreams()
+	this.registerOriginPropertyActivityStreams()
+	this.registerPreviewPropertyActivityStreams()
+	this.registerPublishedPropertyActivityStreams()
+	this.registerRepliesPropertyActivityStreams()
+	this.registerResultPropertyActivityStreams()
+	this.registerSharesPropertyActivityStreams()
+	this.registerStartTimePropertyActivityStreams()
+	this.registerSummaryPropertyActivityStreams()
+	this.registerTagPropertyActivityStreams()
+	this.registerTargetPropertyActivityStreams()
+	this.registerToPropertyActivityStreams()
+	this.registerTypePropertyActivityStreams()
+	this.registerUpdatedPropertyActivityStreams()
+	this.registerUrlPropertyActivityStreams()
+}
+
+// ToType attempts to deserialize the generic JSON map into the registered type it
+// is. Returns ErrUnregisteredType if its type is not registered.
+func (this *Registry) ToType(c context.Context, m map[string]interface{}) (vocab.Type, error) {
+	var typeStrings []string
+	switch v := m["type"].(type) {
+	case string:
+		typeStrings = []string{v}
+	case []interface{}:
+		for _, elem := range v {
+			if s, ok := elem.(string); ok {
+				typeStrings = append(typeStrings, s)
+			}
+		}
+	}
+	aliasMap := toAliasMap(m["@context"])
+	for _, typeString := range typeStrings {
+		for _, t := range this.types {
+			prefix := ""
+			if a := aliasMap[t.vocabURI]; len(a) > 0 {
+				prefix = a + ":"
+			}
+			if typeString == prefix+t.name {
+				return t.deserialize(m, aliasMap)
+			}
+		}
+	}
+	return nil, ErrUnregisteredType
+}
+
+// registerAccuracyPropertyActivityStreams registers the "accuracy" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerAccuracyPropertyActivityStreams() {
+	if this.deserializeAccuracyPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAccuracyPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAccuracyProperty, error) {
+		i, err := propertyaccuracy.DeserializeAccuracyProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyaccuracy.SetManager(this)
+}
+
+// registerActorPropertyActivityStreams registers the "actor" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerActorPropertyActivityStreams() {
+	if this.deserializeActorPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeActorPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsActorProperty, error) {
+		i, err := propertyactor.DeserializeActorProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyactor.SetManager(this)
+}
+
+// registerAlsoKnownAsPropertyActivityStreams registers the "alsoKnownAs" property
+// of the ActivityStreams vocabulary.
+func (this *Registry) registerAlsoKnownAsPropertyActivityStreams() {
+	if this.deserializeAlsoKnownAsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAlsoKnownAsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAlsoKnownAsProperty, error) {
+		i, err := propertyalsoknownas.DeserializeAlsoKnownAsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyalsoknownas.SetManager(this)
+}
+
+// registerAltitudePropertyActivityStreams registers the "altitude" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerAltitudePropertyActivityStreams() {
+	if this.deserializeAltitudePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAltitudePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAltitudeProperty, error) {
+		i, err := propertyaltitude.DeserializeAltitudeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyaltitude.SetManager(this)
+}
+
+// registerAnyOfPropertyActivityStreams registers the "anyOf" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerAnyOfPropertyActivityStreams() {
+	if this.deserializeAnyOfPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAnyOfPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAnyOfProperty, error) {
+		i, err := propertyanyof.DeserializeAnyOfProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyanyof.SetManager(this)
+}
+
+// registerAttachmentPropertyActivityStreams registers the "attachment" property
+// of the ActivityStreams vocabulary.
+func (this *Registry) registerAttachmentPropertyActivityStreams() {
+	if this.deserializeAttachmentPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAttachmentPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAttachmentProperty, error) {
+		i, err := propertyattachment.DeserializeAttachmentProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyattachment.SetManager(this)
+}
+
+// registerAttributedToPropertyActivityStreams registers the "attributedTo"
+// property of the ActivityStreams vocabulary.
+func (this *Registry) registerAttributedToPropertyActivityStreams() {
+	if this.deserializeAttributedToPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAttributedToPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAttributedToProperty, error) {
+		i, err := propertyattributedto.DeserializeAttributedToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyattributedto.SetManager(this)
+}
+
+// registerAudiencePropertyActivityStreams registers the "audience" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerAudiencePropertyActivityStreams() {
+	if this.deserializeAudiencePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeAudiencePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsAudienceProperty, error) {
+		i, err := propertyaudience.DeserializeAudienceProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyaudience.SetManager(this)
+}
+
+// registerBccPropertyActivityStreams registers the "bcc" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerBccPropertyActivityStreams() {
+	if this.deserializeBccPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeBccPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBccProperty, error) {
+		i, err := propertybcc.DeserializeBccProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertybcc.SetManager(this)
+}
+
+// registerBtoPropertyActivityStreams registers the "bto" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerBtoPropertyActivityStreams() {
+	if this.deserializeBtoPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeBtoPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsBtoProperty, error) {
+		i, err := propertybto.DeserializeBtoProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertybto.SetManager(this)
+}
+
+// registerCcPropertyActivityStreams registers the "cc" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerCcPropertyActivityStreams() {
+	if this.deserializeCcPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeCcPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCcProperty, error) {
+		i, err := propertycc.DeserializeCcProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertycc.SetManager(this)
+}
+
+// registerClosedPropertyActivityStreams registers the "closed" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerClosedPropertyActivityStreams() {
+	if this.deserializeClosedPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeClosedPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsClosedProperty, error) {
+		i, err := propertyclosed.DeserializeClosedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyclosed.SetManager(this)
+}
+
+// registerContentPropertyActivityStreams registers the "content" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerContentPropertyActivityStreams() {
+	if this.deserializeContentPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeContentPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsContentProperty, error) {
+		i, err := propertycontent.DeserializeContentProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertycontent.SetManager(this)
+}
+
+// registerContextPropertyActivityStreams registers the "context" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerContextPropertyActivityStreams() {
+	if this.deserializeContextPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeContextPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsContextProperty, error) {
+		i, err := propertycontext.DeserializeContextProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertycontext.SetManager(this)
+}
+
+// registerCurrentPropertyActivityStreams registers the "current" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerCurrentPropertyActivityStreams() {
+	if this.deserializeCurrentPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeCurrentPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsCurrentProperty, error) {
+		i, err := propertycurrent.DeserializeCurrentProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertycurrent.SetManager(this)
+}
+
+// registerDeletedPropertyActivityStreams registers the "deleted" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerDeletedPropertyActivityStreams() {
+	if this.deserializeDeletedPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeDeletedPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDeletedProperty, error) {
+		i, err := propertydeleted.DeserializeDeletedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertydeleted.SetManager(this)
+}
+
+// registerDescribesPropertyActivityStreams registers the "describes" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerDescribesPropertyActivityStreams() {
+	if this.deserializeDescribesPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeDescribesPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDescribesProperty, error) {
+		i, err := propertydescribes.DeserializeDescribesProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertydescribes.SetManager(this)
+}
+
+// registerDurationPropertyActivityStreams registers the "duration" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerDurationPropertyActivityStreams() {
+	if this.deserializeDurationPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeDurationPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsDurationProperty, error) {
+		i, err := propertyduration.DeserializeDurationProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyduration.SetManager(this)
+}
+
+// registerEndTimePropertyActivityStreams registers the "endTime" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerEndTimePropertyActivityStreams() {
+	if this.deserializeEndTimePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeEndTimePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsEndTimeProperty, error) {
+		i, err := propertyendtime.DeserializeEndTimeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyendtime.SetManager(this)
+}
+
+// registerFeaturedPropertyActivityStreams registers the "featured" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerFeaturedPropertyActivityStreams() {
+	if this.deserializeFeaturedPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeFeaturedPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFeaturedProperty, error) {
+		i, err := propertyfeatured.DeserializeFeaturedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyfeatured.SetManager(this)
+}
+
+// registerFeaturedTagsPropertyActivityStreams registers the "featuredTags"
+// property of the ActivityStreams vocabulary.
+func (this *Registry) registerFeaturedTagsPropertyActivityStreams() {
+	if this.deserializeFeaturedTagsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeFeaturedTagsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFeaturedTagsProperty, error) {
+		i, err := propertyfeaturedtags.DeserializeFeaturedTagsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyfeaturedtags.SetManager(this)
+}
+
+// registerFirstPropertyActivityStreams registers the "first" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerFirstPropertyActivityStreams() {
+	if this.deserializeFirstPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeFirstPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFirstProperty, error) {
+		i, err := propertyfirst.DeserializeFirstProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyfirst.SetManager(this)
+}
+
+// registerFollowersPropertyActivityStreams registers the "followers" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerFollowersPropertyActivityStreams() {
+	if this.deserializeFollowersPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeFollowersPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollowersProperty, error) {
+		i, err := propertyfollowers.DeserializeFollowersProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyfollowers.SetManager(this)
+}
+
+// registerFollowingPropertyActivityStreams registers the "following" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerFollowingPropertyActivityStreams() {
+	if this.deserializeFollowingPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeFollowingPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFollowingProperty, error) {
+		i, err := propertyfollowing.DeserializeFollowingProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyfollowing.SetManager(this)
+}
+
+// registerFormerTypePropertyActivityStreams registers the "formerType" property
+// of the ActivityStreams vocabulary.
+func (this *Registry) registerFormerTypePropertyActivityStreams() {
+	if this.deserializeFormerTypePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeFormerTypePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsFormerTypeProperty, error) {
+		i, err := propertyformertype.DeserializeFormerTypeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyformertype.SetManager(this)
+}
+
+// registerGeneratorPropertyActivityStreams registers the "generator" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerGeneratorPropertyActivityStreams() {
+	if this.deserializeGeneratorPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeGeneratorPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsGeneratorProperty, error) {
+		i, err := propertygenerator.DeserializeGeneratorProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertygenerator.SetManager(this)
+}
+
+// registerHeightPropertyActivityStreams registers the "height" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerHeightPropertyActivityStreams() {
+	if this.deserializeHeightPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeHeightPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHeightProperty, error) {
+		i, err := propertyheight.DeserializeHeightProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyheight.SetManager(this)
+}
+
+// registerHrefPropertyActivityStreams registers the "href" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerHrefPropertyActivityStreams() {
+	if this.deserializeHrefPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeHrefPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHrefProperty, error) {
+		i, err := propertyhref.DeserializeHrefProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyhref.SetManager(this)
+}
+
+// registerHreflangPropertyActivityStreams registers the "hreflang" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerHreflangPropertyActivityStreams() {
+	if this.deserializeHreflangPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeHreflangPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsHreflangProperty, error) {
+		i, err := propertyhreflang.DeserializeHreflangProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyhreflang.SetManager(this)
+}
+
+// registerIconPropertyActivityStreams registers the "icon" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerIconPropertyActivityStreams() {
+	if this.deserializeIconPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeIconPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIconProperty, error) {
+		i, err := propertyicon.DeserializeIconProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyicon.SetManager(this)
+}
+
+// registerIdPropertyActivityStreams registers the "id" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerIdPropertyActivityStreams() {
+	if this.deserializeIdPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeIdPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsIdProperty, error) {
+		i, err := propertyid.DeserializeIdProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyid.SetManager(this)
+}
+
+// registerImagePropertyActivityStreams registers the "image" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerImagePropertyActivityStreams() {
+	if this.deserializeImagePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeImagePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsImageProperty, error) {
+		i, err := propertyimage.DeserializeImageProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyimage.SetManager(this)
+}
+
+// registerInReplyToPropertyActivityStreams registers the "inReplyTo" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerInReplyToPropertyActivityStreams() {
+	if this.deserializeInReplyToPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeInReplyToPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInReplyToProperty, error) {
+		i, err := propertyinreplyto.DeserializeInReplyToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyinreplyto.SetManager(this)
+}
+
+// registerInboxPropertyActivityStreams registers the "inbox" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerInboxPropertyActivityStreams() {
+	if this.deserializeInboxPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeInboxPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInboxProperty, error) {
+		i, err := propertyinbox.DeserializeInboxProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyinbox.SetManager(this)
+}
+
+// registerInstrumentPropertyActivityStreams registers the "instrument" property
+// of the ActivityStreams vocabulary.
+func (this *Registry) registerInstrumentPropertyActivityStreams() {
+	if this.deserializeInstrumentPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeInstrumentPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsInstrumentProperty, error) {
+		i, err := propertyinstrument.DeserializeInstrumentProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyinstrument.SetManager(this)
+}
+
+// registerItemsPropertyActivityStreams registers the "items" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerItemsPropertyActivityStreams() {
+	if this.deserializeItemsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeItemsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsItemsProperty, error) {
+		i, err := propertyitems.DeserializeItemsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyitems.SetManager(this)
+}
+
+// registerLastPropertyActivityStreams registers the "last" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerLastPropertyActivityStreams() {
+	if this.deserializeLastPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeLastPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLastProperty, error) {
+		i, err := propertylast.DeserializeLastProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertylast.SetManager(this)
+}
+
+// registerLatitudePropertyActivityStreams registers the "latitude" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerLatitudePropertyActivityStreams() {
+	if this.deserializeLatitudePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeLatitudePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLatitudeProperty, error) {
+		i, err := propertylatitude.DeserializeLatitudeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertylatitude.SetManager(this)
+}
+
+// registerLikedPropertyActivityStreams registers the "liked" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerLikedPropertyActivityStreams() {
+	if this.deserializeLikedPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeLikedPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLikedProperty, error) {
+		i, err := propertyliked.DeserializeLikedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyliked.SetManager(this)
+}
+
+// registerLikesPropertyActivityStreams registers the "likes" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerLikesPropertyActivityStreams() {
+	if this.deserializeLikesPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeLikesPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLikesProperty, error) {
+		i, err := propertylikes.DeserializeLikesProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertylikes.SetManager(this)
+}
+
+// registerLocationPropertyActivityStreams registers the "location" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerLocationPropertyActivityStreams() {
+	if this.deserializeLocationPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeLocationPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLocationProperty, error) {
+		i, err := propertylocation.DeserializeLocationProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertylocation.SetManager(this)
+}
+
+// registerLongitudePropertyActivityStreams registers the "longitude" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerLongitudePropertyActivityStreams() {
+	if this.deserializeLongitudePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeLongitudePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsLongitudeProperty, error) {
+		i, err := propertylongitude.DeserializeLongitudeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertylongitude.SetManager(this)
+}
+
+// registerManuallyApprovesFollowersPropertyActivityStreams registers the
+// "manuallyApprovesFollowers" property of the ActivityStreams vocabulary.
+func (this *Registry) registerManuallyApprovesFollowersPropertyActivityStreams() {
+	if this.deserializeManuallyApprovesFollowersPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeManuallyApprovesFollowersPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsManuallyApprovesFollowersProperty, error) {
+		i, err := propertymanuallyapprovesfollowers.DeserializeManuallyApprovesFollowersProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertymanuallyapprovesfollowers.SetManager(this)
+}
+
+// registerMediaTypePropertyActivityStreams registers the "mediaType" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerMediaTypePropertyActivityStreams() {
+	if this.deserializeMediaTypePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeMediaTypePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsMediaTypeProperty, error) {
+		i, err := propertymediatype.DeserializeMediaTypeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertymediatype.SetManager(this)
+}
+
+// registerNamePropertyActivityStreams registers the "name" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerNamePropertyActivityStreams() {
+	if this.deserializeNamePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeNamePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNameProperty, error) {
+		i, err := propertyname.DeserializeNameProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyname.SetManager(this)
+}
+
+// registerNextPropertyActivityStreams registers the "next" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerNextPropertyActivityStreams() {
+	if this.deserializeNextPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeNextPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsNextProperty, error) {
+		i, err := propertynext.DeserializeNextProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertynext.SetManager(this)
+}
+
+// registerObjectPropertyActivityStreams registers the "object" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerObjectPropertyActivityStreams() {
+	if this.deserializeObjectPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeObjectPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsObjectProperty, error) {
+		i, err := propertyobject.DeserializeObjectProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyobject.SetManager(this)
+}
+
+// registerOneOfPropertyActivityStreams registers the "oneOf" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerOneOfPropertyActivityStreams() {
+	if this.deserializeOneOfPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeOneOfPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOneOfProperty, error) {
+		i, err := propertyoneof.DeserializeOneOfProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyoneof.SetManager(this)
+}
+
+// registerOrderedItemsPropertyActivityStreams registers the "orderedItems"
+// property of the ActivityStreams vocabulary.
+func (this *Registry) registerOrderedItemsPropertyActivityStreams() {
+	if this.deserializeOrderedItemsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeOrderedItemsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOrderedItemsProperty, error) {
+		i, err := propertyordereditems.DeserializeOrderedItemsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyordereditems.SetManager(this)
+}
+
+// registerOriginPropertyActivityStreams registers the "origin" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerOriginPropertyActivityStreams() {
+	if this.deserializeOriginPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeOriginPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOriginProperty, error) {
+		i, err := propertyorigin.DeserializeOriginProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyorigin.SetManager(this)
+}
+
+// registerOutboxPropertyActivityStreams registers the "outbox" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerOutboxPropertyActivityStreams() {
+	if this.deserializeOutboxPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeOutboxPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOutboxProperty, error) {
+		i, err := propertyoutbox.DeserializeOutboxProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyoutbox.SetManager(this)
+}
+
+// registerOwnerPropertyActivityStreams registers the "owner" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerOwnerPropertyActivityStreams() {
+	if this.deserializeOwnerPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeOwnerPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsOwnerProperty, error) {
+		i, err := propertyowner.DeserializeOwnerProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyowner.SetManager(this)
+}
+
+// registerPartOfPropertyActivityStreams registers the "partOf" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerPartOfPropertyActivityStreams() {
+	if this.deserializePartOfPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePartOfPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPartOfProperty, error) {
+		i, err := propertypartof.DeserializePartOfProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertypartof.SetManager(this)
+}
+
+// registerPreferredUsernamePropertyActivityStreams registers the
+// "preferredUsername" property of the ActivityStreams vocabulary.
+func (this *Registry) registerPreferredUsernamePropertyActivityStreams() {
+	if this.deserializePreferredUsernamePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePreferredUsernamePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPreferredUsernameProperty, error) {
+		i, err := propertypreferredusername.DeserializePreferredUsernameProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertypreferredusername.SetManager(this)
+}
+
+// registerPrevPropertyActivityStreams registers the "prev" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerPrevPropertyActivityStreams() {
+	if this.deserializePrevPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePrevPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPrevProperty, error) {
+		i, err := propertyprev.DeserializePrevProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyprev.SetManager(this)
+}
+
+// registerPreviewPropertyActivityStreams registers the "preview" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerPreviewPropertyActivityStreams() {
+	if this.deserializePreviewPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePreviewPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPreviewProperty, error) {
+		i, err := propertypreview.DeserializePreviewProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertypreview.SetManager(this)
+}
+
+// registerPublicKeyPemPropertyActivityStreams registers the "publicKeyPem"
+// property of the ActivityStreams vocabulary.
+func (this *Registry) registerPublicKeyPemPropertyActivityStreams() {
+	if this.deserializePublicKeyPemPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePublicKeyPemPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKeyPemProperty, error) {
+		i, err := propertypublickeypem.DeserializePublicKeyPemProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertypublickeypem.SetManager(this)
+}
+
+// registerPublicKeyPropertyActivityStreams registers the "publicKey" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerPublicKeyPropertyActivityStreams() {
+	if this.deserializePublicKeyPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePublicKeyPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublicKeyProperty, error) {
+		i, err := propertypublickey.DeserializePublicKeyProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertypublickey.SetManager(this)
+}
+
+// registerPublishedPropertyActivityStreams registers the "published" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerPublishedPropertyActivityStreams() {
+	if this.deserializePublishedPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializePublishedPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsPublishedProperty, error) {
+		i, err := propertypublished.DeserializePublishedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertypublished.SetManager(this)
+}
+
+// registerRadiusPropertyActivityStreams registers the "radius" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerRadiusPropertyActivityStreams() {
+	if this.deserializeRadiusPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeRadiusPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRadiusProperty, error) {
+		i, err := propertyradius.DeserializeRadiusProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyradius.SetManager(this)
+}
+
+// registerRelPropertyActivityStreams registers the "rel" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerRelPropertyActivityStreams() {
+	if this.deserializeRelPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeRelPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelProperty, error) {
+		i, err := propertyrel.DeserializeRelProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyrel.SetManager(this)
+}
+
+// registerRelationshipPropertyActivityStreams registers the "relationship"
+// property of the ActivityStreams vocabulary.
+func (this *Registry) registerRelationshipPropertyActivityStreams() {
+	if this.deserializeRelationshipPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeRelationshipPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRelationshipProperty, error) {
+		i, err := propertyrelationship.DeserializeRelationshipProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyrelationship.SetManager(this)
+}
+
+// registerRepliesPropertyActivityStreams registers the "replies" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerRepliesPropertyActivityStreams() {
+	if this.deserializeRepliesPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeRepliesPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsRepliesProperty, error) {
+		i, err := propertyreplies.DeserializeRepliesProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyreplies.SetManager(this)
+}
+
+// registerResultPropertyActivityStreams registers the "result" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerResultPropertyActivityStreams() {
+	if this.deserializeResultPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeResultPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsResultProperty, error) {
+		i, err := propertyresult.DeserializeResultProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyresult.SetManager(this)
+}
+
+// registerSharesPropertyActivityStreams registers the "shares" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerSharesPropertyActivityStreams() {
+	if this.deserializeSharesPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeSharesPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSharesProperty, error) {
+		i, err := propertyshares.DeserializeSharesProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyshares.SetManager(this)
+}
+
+// registerStartIndexPropertyActivityStreams registers the "startIndex" property
+// of the ActivityStreams vocabulary.
+func (this *Registry) registerStartIndexPropertyActivityStreams() {
+	if this.deserializeStartIndexPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeStartIndexPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStartIndexProperty, error) {
+		i, err := propertystartindex.DeserializeStartIndexProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertystartindex.SetManager(this)
+}
+
+// registerStartTimePropertyActivityStreams registers the "startTime" property of
+// the ActivityStreams vocabulary.
+func (this *Registry) registerStartTimePropertyActivityStreams() {
+	if this.deserializeStartTimePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeStartTimePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStartTimeProperty, error) {
+		i, err := propertystarttime.DeserializeStartTimeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertystarttime.SetManager(this)
+}
+
+// registerStreamsPropertyActivityStreams registers the "streams" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerStreamsPropertyActivityStreams() {
+	if this.deserializeStreamsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeStreamsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsStreamsProperty, error) {
+		i, err := propertystreams.DeserializeStreamsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertystreams.SetManager(this)
+}
+
+// registerSubjectPropertyActivityStreams registers the "subject" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerSubjectPropertyActivityStreams() {
+	if this.deserializeSubjectPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeSubjectPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSubjectProperty, error) {
+		i, err := propertysubject.DeserializeSubjectProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertysubject.SetManager(this)
+}
+
+// registerSummaryPropertyActivityStreams registers the "summary" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerSummaryPropertyActivityStreams() {
+	if this.deserializeSummaryPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeSummaryPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsSummaryProperty, error) {
+		i, err := propertysummary.DeserializeSummaryProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertysummary.SetManager(this)
+}
+
+// registerTagPropertyActivityStreams registers the "tag" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerTagPropertyActivityStreams() {
+	if this.deserializeTagPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeTagPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTagProperty, error) {
+		i, err := propertytag.DeserializeTagProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertytag.SetManager(this)
+}
+
+// registerTargetPropertyActivityStreams registers the "target" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerTargetPropertyActivityStreams() {
+	if this.deserializeTargetPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeTargetPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTargetProperty, error) {
+		i, err := propertytarget.DeserializeTargetProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertytarget.SetManager(this)
+}
+
+// registerToPropertyActivityStreams registers the "to" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerToPropertyActivityStreams() {
+	if this.deserializeToPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeToPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsToProperty, error) {
+		i, err := propertyto.DeserializeToProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyto.SetManager(this)
+}
+
+// registerTotalItemsPropertyActivityStreams registers the "totalItems" property
+// of the ActivityStreams vocabulary.
+func (this *Registry) registerTotalItemsPropertyActivityStreams() {
+	if this.deserializeTotalItemsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeTotalItemsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTotalItemsProperty, error) {
+		i, err := propertytotalitems.DeserializeTotalItemsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertytotalitems.SetManager(this)
+}
+
+// registerTypePropertyActivityStreams registers the "type" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerTypePropertyActivityStreams() {
+	if this.deserializeTypePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeTypePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsTypeProperty, error) {
+		i, err := propertytype.DeserializeTypeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertytype.SetManager(this)
+}
+
+// registerUnitsPropertyActivityStreams registers the "units" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerUnitsPropertyActivityStreams() {
+	if this.deserializeUnitsPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeUnitsPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUnitsProperty, error) {
+		i, err := propertyunits.DeserializeUnitsProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyunits.SetManager(this)
+}
+
+// registerUpdatedPropertyActivityStreams registers the "updated" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerUpdatedPropertyActivityStreams() {
+	if this.deserializeUpdatedPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeUpdatedPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUpdatedProperty, error) {
+		i, err := propertyupdated.DeserializeUpdatedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyupdated.SetManager(this)
+}
+
+// registerUrlPropertyActivityStreams registers the "url" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerUrlPropertyActivityStreams() {
+	if this.deserializeUrlPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeUrlPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsUrlProperty, error) {
+		i, err := propertyurl.DeserializeUrlProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyurl.SetManager(this)
+}
+
+// registerValuePropertyActivityStreams registers the "value" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerValuePropertyActivityStreams() {
+	if this.deserializeValuePropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeValuePropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsValueProperty, error) {
+		i, err := propertyvalue.DeserializeValueProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertyvalue.SetManager(this)
+}
+
+// registerWidthPropertyActivityStreams registers the "width" property of the
+// ActivityStreams vocabulary.
+func (this *Registry) registerWidthPropertyActivityStreams() {
+	if this.deserializeWidthPropertyActivityStreams != nil {
+		return
+	}
+	this.deserializeWidthPropertyActivityStreams = func(m map[string]interface{}, aliasMap map[string]string) (vocab.ActivityStreamsWidthProperty, error) {
+		i, err := propertywidth.DeserializeWidthProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+	propertywidth.SetManager(this)
+}
+
+// toAliasMap converts a JSONLD context into a map of vocabulary name to alias.
+func toAliasMap(i interface{}) (m map[string]string) {
+	m = make(map[string]string)
+	toHttpHttpsFn := func(s string) (ok bool, http, https string) {
+		if strings.HasPrefix(s, "http://") {
+			ok = true
+			http = s
+			https = "https" + strings.TrimPrefix(s, "http")
+		} else if strings.HasPrefix(s, "https://") {
+			ok = true
+			https = s
+			http = "http" + strings.TrimPrefix(s, "https")
+		}
+		return
+	}
+	switch v := i.(type) {
+	case string:
+		// Single entry, no alias.
+		if ok, http, https := toHttpHttpsFn(v); ok {
+			m[http] = ""
+			m[https] = ""
+		} else {
+			m[v] = ""
+		}
+	case []interface{}:
+		// Recursively apply.
+		for _, elem := range v {
+			r := toAliasMap(elem)
+			for k, val := range r {
+				m[k] = val
+			}
+		}
+	case map[string]interface{}:
+		// Map any aliases.
+		for k, val := range v {
+			// Only handle string aliases.
+			switch conc := val.(type) {
+			case string:
+				m[k] = conc
+			}
+		}
+	}
+	return
+}
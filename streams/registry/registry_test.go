@@ -0,0 +1,56 @@
+package registry
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams/vocab"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterActivityStreamsNote()
+	r.RegisterActivityStreamsCreate()
+	r.RegisterActivityStreamsCreate()
+	if len(r.types) != 2 {
+		t.Errorf("expected 2 registered types, got %d", len(r.types))
+	}
+	v, err := r.ToType(context.Background(), map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type":     "Create",
+		"object": []interface{}{
+			map[string]interface{}{
+				"type":    "Note",
+				"content": "Hello",
+			},
+			map[string]interface{}{
+				"type": "Person",
+				"name": "Sally",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	create, ok := v.(vocab.ActivityStreamsCreate)
+	if !ok {
+		t.Fatalf("expected a Create, got %T", v)
+	}
+	obj := create.GetActivityStreamsObject()
+	if obj == nil || obj.Len() != 2 {
+		t.Fatalf("expected 2 objects")
+	}
+	if !obj.At(0).IsActivityStreamsNote() {
+		t.Errorf("expected the registered Note to be deserialized")
+	} else if c := obj.At(0).GetActivityStreamsNote().GetActivityStreamsContent(); c == nil || c.At(0).GetXMLSchemaString() != "Hello" {
+		t.Errorf("expected the content of the Note to be deserialized")
+	}
+	if obj.At(1).GetType() != nil {
+		t.Errorf("expected the unregistered Person not to be deserialized")
+	}
+	if _, err := r.ToType(context.Background(), map[string]interface{}{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"type":     "Person",
+	}); err != ErrUnregisteredType {
+		t.Errorf("expected ErrUnregisteredType, got %v", err)
+	}
+}
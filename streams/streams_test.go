@@ -3,10 +3,13 @@ package streams
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/go-test/deep"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 )
 
 type serializer interface {
@@ -586,6 +589,200 @@ func TestNulls(t *testing.T) {
 	}
 }
 
+func TestShallowView(t *testing.T) {
+	createIRI, err := url.Parse("https://example.com/create/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	samIRI, err := url.Parse("https://example.com/sam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	followersIRI, err := url.Parse("https://example.com/sam/followers")
+	if err != nil {
+		t.Fatal(err)
+	}
+	published := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	create := NewActivityStreamsCreate()
+	id := NewActivityStreamsIdProperty()
+	id.Set(createIRI)
+	create.SetActivityStreamsId(id)
+	pub := NewActivityStreamsPublishedProperty()
+	pub.Set(published)
+	create.SetActivityStreamsPublished(pub)
+	sam := NewActivityStreamsPerson()
+	samId := NewActivityStreamsIdProperty()
+	samId.Set(samIRI)
+	sam.SetActivityStreamsId(samId)
+	actor := NewActivityStreamsActorProperty()
+	actor.AppendActivityStreamsPerson(sam)
+	create.SetActivityStreamsActor(actor)
+	cc := NewActivityStreamsCcProperty()
+	cc.AppendIRI(followersIRI)
+	create.SetActivityStreamsCc(cc)
+	expected := ShallowView{
+		Id:        createIRI,
+		Type:      "Create",
+		Published: published,
+		Actor:     []*url.URL{samIRI},
+		Cc:        []*url.URL{followersIRI},
+	}
+	if diff := deep.Equal(ToShallowView(create), expected); diff != nil {
+		t.Errorf("ToShallowView: %v", diff)
+	}
+	if diff := deep.Equal(ToActivityStreamsCreateShallowView(create), expected); diff != nil {
+		t.Errorf("ToActivityStreamsCreateShallowView: %v", diff)
+	}
+}
+
+func TestToShallowViewFromMap(t *testing.T) {
+	var m map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/create/1",
+  "type": ["Create", "https://example.com/ns#Extension"],
+  "published": "2019-01-02T03:04:05Z",
+  "actor": {"id": "https://example.com/sam", "type": "Person"},
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "cc": ["https://example.com/sam/followers", {"type": "Collection"}],
+  "object": {
+    "type": "Note",
+    "attachment": [{"type": "Image", "url": "https://example.com/1.png"}]
+  }
+}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iri := func(s string) *url.URL {
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u
+	}
+	expected := ShallowView{
+		Id:        iri("https://example.com/create/1"),
+		Type:      "Create",
+		Published: time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+		Actor:     []*url.URL{iri("https://example.com/sam")},
+		To:        []*url.URL{iri("https://www.w3.org/ns/activitystreams#Public")},
+		Cc:        []*url.URL{iri("https://example.com/sam/followers")},
+	}
+	if diff := deep.Equal(ToShallowViewFromMap(m), expected); diff != nil {
+		t.Errorf("ToShallowViewFromMap: %v", diff)
+	}
+	if diff := deep.Equal(ToShallowViewFromMap(map[string]interface{}{}), ShallowView{}); diff != nil {
+		t.Errorf("ToShallowViewFromMap of an empty map: %v", diff)
+	}
+}
+
+func TestDeferredDeserialization(t *testing.T) {
+	var m map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/create/1",
+  "type": "Create",
+  "actor": "https://example.com/sam",
+  "object": {
+    "type": "Note",
+    "content": "Hello",
+    "attachment": {"type": "Image", "url": "https://example.com/1.png"}
+  }
+}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	create, err := ToActivityStreamsCreate(v)
+	if err != nil {
+		t.Fatalf("ToActivityStreamsCreate returned error: %s", err)
+	}
+	// The Note is deserialized when first accessed, so a change to its
+	// decoded form beforehand is seen by its getter.
+	note := m["object"].(map[string]interface{})
+	note["content"] = "Changed"
+	it := create.GetActivityStreamsObject().At(0)
+	if !it.IsActivityStreamsNote() {
+		t.Fatalf("expected the object to be a Note, got %s", it)
+	} else if c := it.GetActivityStreamsNote().GetActivityStreamsContent(); c.At(0).GetXMLSchemaString() != "Changed" {
+		t.Errorf("expected the Note to be deserialized when first accessed, got %s", c.At(0))
+	}
+	// Once deserialized, it no longer depends on its decoded form.
+	note["content"] = "Hello"
+	delete(note, "attachment")
+	if c := it.GetActivityStreamsNote().GetActivityStreamsContent(); c.At(0).GetXMLSchemaString() != "Changed" {
+		t.Errorf("expected the Note to be deserialized once, got %s", c.At(0))
+	}
+	it.GetActivityStreamsNote().GetActivityStreamsContent().At(0).SetXMLSchemaString("Hello")
+	// Both the accessed and the untouched values serialize as received.
+	var received map[string]interface{}
+	if err := json.Unmarshal(expected, &received); err != nil {
+		t.Fatal(err)
+	}
+	v, err = ToType(context.Background(), received)
+	if err != nil {
+		t.Fatalf("ToType returned error: %s", err)
+	}
+	for _, typ := range []vocab.Type{create, v} {
+		actual, err := Serialize(typ)
+		if err != nil {
+			t.Fatalf("Serialize returned error: %s", err)
+		}
+		b, err := json.Marshal(actual)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != string(expected) {
+			t.Errorf("expected %s to round-trip, got %s", expected, b)
+		}
+	}
+	// Setting a value discards the one not yet deserialized.
+	create, err = ToActivityStreamsCreate(v)
+	if err != nil {
+		t.Fatalf("ToActivityStreamsCreate returned error: %s", err)
+	}
+	create.GetActivityStreamsObject().At(0).SetActivityStreamsArticle(NewActivityStreamsArticle())
+	if it := create.GetActivityStreamsObject().At(0); it.IsActivityStreamsNote() || !it.IsActivityStreamsArticle() {
+		t.Errorf("expected the set Article to replace the Note, got %s", it)
+	}
+}
+
+func TestToType(t *testing.T) {
+	var note vocab.Type = NewActivityStreamsNote()
+	if n, err := ToActivityStreamsNote(note); err != nil {
+		t.Errorf("ToActivityStreamsNote returned error: %s", err)
+	} else if n == nil {
+		t.Errorf("ToActivityStreamsNote returned nil")
+	}
+	if _, err := ToActivityStreamsArticle(note); err == nil {
+		t.Errorf("ToActivityStreamsArticle expected error for a Note")
+	}
+	if _, err := ToActivityStreamsObject(note); err == nil {
+		t.Errorf("ToActivityStreamsObject expected error for a Note")
+	} else if !IsOrExtendsActivityStreamsObject(note) {
+		t.Errorf("IsOrExtendsActivityStreamsObject expected true for a Note")
+	}
+}
+
+func TestGetActivityPairing(t *testing.T) {
+	p, ok := GetActivityPairing(NewActivityStreamsFollow())
+	if !ok {
+		t.Fatalf("GetActivityPairing returned false for Follow")
+	} else if p.SideEffect != SideEffectFollow {
+		t.Errorf("expected %q side effect, got %q", SideEffectFollow, p.SideEffect)
+	}
+	if _, ok := GetActivityPairing(NewActivityStreamsNote()); ok {
+		t.Errorf("GetActivityPairing returned true for Note")
+	}
+}
+
 func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	var i1 interface{}
 	var i2 interface{}
@@ -600,3 +797,291 @@ func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	}
 	return deep.Equal(i1, i2), nil
 }
+
+func TestJSONLDContext(t *testing.T) {
+	var m map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/create/1",
+  "type": "Create",
+  "object": {
+    "type": "Note",
+    "attachment": [{"type": "Image", "url": "https://example.com/1.png"}]
+  }
+}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	create, err := ToType(context.Background(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"https://www.w3.org/ns/activitystreams": ""}
+	if diff := deep.Equal(create.JSONLDContext(), expected); diff != nil {
+		t.Errorf("JSONLDContext of a deserialized type: %v", diff)
+	}
+	note := NewActivityStreamsNote()
+	if diff := deep.Equal(note.JSONLDContext(), expected); diff != nil {
+		t.Errorf("JSONLDContext of a new type: %v", diff)
+	}
+	s, err := Serialize(create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(s["@context"], "https://www.w3.org/ns/activitystreams"); diff != nil {
+		t.Errorf("Serialize: %v", diff)
+	}
+}
+
+func BenchmarkJSONLDContext(b *testing.B) {
+	create := NewActivityStreamsCreate()
+	object := NewActivityStreamsObjectProperty()
+	for i := 0; i < 10; i++ {
+		note := NewActivityStreamsNote()
+		attachment := NewActivityStreamsAttachmentProperty()
+		for j := 0; j < 10; j++ {
+			attachment.AppendActivityStreamsImage(NewActivityStreamsImage())
+		}
+		note.SetActivityStreamsAttachment(attachment)
+		object.AppendActivityStreamsNote(note)
+	}
+	create.SetActivityStreamsObject(object)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		create.JSONLDContext()
+	}
+}
+
+func TestForEach(t *testing.T) {
+	to := NewActivityStreamsToProperty()
+	for _, s := range []string{"https://example.com/a", "https://example.com/b", "https://example.com/c"} {
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		to.AppendIRI(u)
+	}
+	var got []string
+	to.ForEach(func(idx int, it vocab.ActivityStreamsToPropertyIterator) bool {
+		if it != to.At(idx) {
+			t.Errorf("ForEach iterator %d is not the iterator at its index", idx)
+		}
+		got = append(got, it.GetIRI().String())
+		return idx < 1
+	})
+	if diff := deep.Equal(got, []string{"https://example.com/a", "https://example.com/b"}); diff != nil {
+		t.Errorf("ForEach: %v", diff)
+	}
+}
+
+func BenchmarkForEach(b *testing.B) {
+	to := NewActivityStreamsToProperty()
+	for i := 0; i < 100; i++ {
+		to.AppendIRI(&url.URL{Scheme: "https", Host: "example.com"})
+	}
+	n := 0
+	fn := func(idx int, it vocab.ActivityStreamsToPropertyIterator) bool {
+		if it.IsIRI() {
+			n++
+		}
+		return true
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		to.ForEach(fn)
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	note := NewActivityStreamsNote()
+	if _, ok := note.ContentString(); ok {
+		t.Errorf("ContentString of a Note without content returned true")
+	}
+	if _, ok := note.PublishedDateTime(); ok {
+		t.Errorf("PublishedDateTime of an unpublished Note returned true")
+	}
+	content := NewActivityStreamsContentProperty()
+	content.AppendRDFLangString(map[string]string{"en": "Hello"})
+	content.AppendXMLSchemaString("Hallo")
+	note.SetActivityStreamsContent(content)
+	if s, ok := note.ContentString(); !ok || s != "Hallo" {
+		t.Errorf("ContentString: got %q, %v", s, ok)
+	}
+	if _, ok := note.ContentIRI(); ok {
+		t.Errorf("ContentIRI of a Note with only string content returned true")
+	}
+	now := time.Now()
+	published := NewActivityStreamsPublishedProperty()
+	published.Set(now)
+	note.SetActivityStreamsPublished(published)
+	if p, ok := note.PublishedDateTime(); !ok || !p.Equal(now) {
+		t.Errorf("PublishedDateTime: got %v, %v", p, ok)
+	}
+	iri, err := url.Parse("https://example.com/notes/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	create := NewActivityStreamsCreate()
+	object := NewActivityStreamsObjectProperty()
+	object.AppendActivityStreamsNote(note)
+	object.AppendIRI(iri)
+	create.SetActivityStreamsObject(object)
+	if u, ok := create.ObjectIRI(); !ok || u != iri {
+		t.Errorf("ObjectIRI: got %v, %v", u, ok)
+	}
+	if v, ok := create.ObjectType(); !ok || v != note {
+		t.Errorf("ObjectType: got %v, %v", v, ok)
+	}
+	if _, ok := create.TargetIRI(); ok {
+		t.Errorf("TargetIRI of a Create without target returned true")
+	}
+}
+
+func TestPropertyByName(t *testing.T) {
+	note := NewActivityStreamsNote()
+	if p, ok := note.GetProperty("content"); !ok || p != nil {
+		t.Errorf("GetProperty of unset content: got %v, %v", p, ok)
+	}
+	if _, ok := note.GetProperty("actor"); ok {
+		t.Errorf("GetProperty of the actor of a Note returned true")
+	}
+	content := NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("Hello")
+	if err := note.SetProperty("content", content); err != nil {
+		t.Fatalf("SetProperty returned error: %s", err)
+	}
+	if note.GetActivityStreamsContent() != content {
+		t.Errorf("SetProperty did not set the content")
+	}
+	if p, ok := note.GetProperty("content"); !ok || p != content {
+		t.Errorf("GetProperty of content: got %v, %v", p, ok)
+	}
+	if err := note.SetProperty("content", NewActivityStreamsNameProperty()); err == nil {
+		t.Errorf("SetProperty of content to a name property returned no error")
+	}
+	if err := note.SetProperty("actor", NewActivityStreamsActorProperty()); err == nil {
+		t.Errorf("SetProperty of the actor of a Note returned no error")
+	}
+	if err := note.SetProperty("content", nil); err != nil {
+		t.Fatalf("SetProperty to nil returned error: %s", err)
+	}
+	if note.GetActivityStreamsContent() != nil {
+		t.Errorf("SetProperty to nil did not clear the content")
+	}
+}
+
+func TestApplyMap(t *testing.T) {
+	note := NewActivityStreamsNote()
+	published := time.Date(2019, 2, 24, 12, 0, 0, 0, time.UTC)
+	actor, _ := url.Parse("https://example.com/users/alice")
+	err := note.ApplyMap(map[string]interface{}{
+		"content":      "Hello",
+		"published":    published,
+		"attributedTo": actor,
+		"to":           []string{"https://example.com/users/alice/followers"},
+		"summary":      nil,
+	})
+	if err != nil {
+		t.Fatalf("ApplyMap returned error: %s", err)
+	}
+	if v, ok := note.ContentString(); !ok || v != "Hello" {
+		t.Errorf("unexpected content: %q, %v", v, ok)
+	}
+	if v, ok := note.PublishedDateTime(); !ok || !v.Equal(published) {
+		t.Errorf("unexpected published: %v, %v", v, ok)
+	}
+	if v, ok := note.AttributedToIRI(); !ok || v.String() != actor.String() {
+		t.Errorf("unexpected attributedTo: %v, %v", v, ok)
+	}
+	if p := note.GetActivityStreamsTo(); p == nil || p.Len() != 1 || !p.At(0).IsIRI() {
+		t.Errorf("unexpected to: %v", p)
+	}
+	// A submitted form gives every value as a string.
+	image := NewActivityStreamsImage()
+	err = image.ApplyMap(map[string]interface{}{
+		"width":  "640",
+		"height": "tall",
+		"actor":  "https://example.com/users/alice",
+	})
+	if err == nil {
+		t.Fatalf("ApplyMap of an invalid height and an unknown property returned no error")
+	} else if !strings.Contains(err.Error(), `"height"`) || !strings.Contains(err.Error(), `"actor"`) || strings.Contains(err.Error(), `"width"`) {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if v, ok := image.WidthNonNegativeInteger(); !ok || v != 640 {
+		t.Errorf("unexpected width: %v, %v", v, ok)
+	}
+	if image.GetActivityStreamsHeight() != nil {
+		t.Errorf("ApplyMap set the invalid height")
+	}
+}
+
+func TestString(t *testing.T) {
+	note := NewActivityStreamsNote()
+	id, _ := url.Parse("https://example.com/notes/1")
+	idProp := NewActivityStreamsIdProperty()
+	idProp.Set(id)
+	note.SetActivityStreamsId(idProp)
+	content := NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("Hello")
+	note.SetActivityStreamsContent(content)
+	if got, want := note.String(), `Note{id: https://example.com/notes/1, content: ["Hello"]}`; got != want {
+		t.Errorf("unexpected String: got %s, want %s", got, want)
+	}
+	if got := fmt.Sprintf("%#v", note); got != note.String() {
+		t.Errorf("unexpected GoString: %s", got)
+	}
+	create := NewActivityStreamsCreate()
+	object := NewActivityStreamsObjectProperty()
+	object.AppendActivityStreamsNote(note)
+	for i := 0; i < 4; i++ {
+		object.AppendIRI(id)
+	}
+	create.SetActivityStreamsObject(object)
+	if got, want := create.String(), `Create{object: [Note(https://example.com/notes/1), https://example.com/notes/1, https://example.com/notes/1, ...+2]}`; got != want {
+		t.Errorf("unexpected String: got %s, want %s", got, want)
+	}
+}
+
+type hookTestKey struct{}
+
+func TestHooks(t *testing.T) {
+	// The hooks only act on contexts of this test, since they stay
+	// registered for the other tests.
+	RegisterSerializeHook(func(c context.Context, v vocab.Type, m map[string]interface{}) error {
+		if c.Value(hookTestKey{}) != nil {
+			delete(m, "content")
+		}
+		return nil
+	})
+	RegisterDeserializeHook(func(c context.Context, m map[string]interface{}, v vocab.Type) error {
+		if c.Value(hookTestKey{}) != nil && v.GetTypeName() == "Note" {
+			return fmt.Errorf("rejected")
+		}
+		return nil
+	})
+	c := context.WithValue(context.Background(), hookTestKey{}, true)
+	note := NewActivityStreamsNote()
+	content := NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("Hello")
+	note.SetActivityStreamsContent(content)
+	m, err := SerializeContext(c, note)
+	if err != nil {
+		t.Fatalf("SerializeContext returned error: %s", err)
+	} else if _, ok := m["content"]; ok {
+		t.Errorf("SerializeContext did not call the hook: %v", m)
+	}
+	if m, err = Serialize(note); err != nil {
+		t.Fatalf("Serialize returned error: %s", err)
+	} else if _, ok := m["content"]; !ok {
+		t.Errorf("Serialize called the hook with the test's context: %v", m)
+	}
+	if _, err := DeserializeContext(c, m); err == nil {
+		t.Errorf("DeserializeContext did not call the hook")
+	}
+	if _, err := DeserializeContext(context.Background(), m); err != nil {
+		t.Errorf("DeserializeContext returned error: %s", err)
+	}
+}
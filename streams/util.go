@@ -1,7 +1,10 @@
 package streams
 
 import (
+	"context"
+	datetime "github.com/go-fed/activity/streams/values/dateTime"
 	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
 )
 
 const (
@@ -14,7 +17,15 @@ const (
 
 // Serialize adds the context vocabularies contained within the type
 // into the JSON-LD @context field, and aliases them appropriately.
+//
+// The registered SerializeHooks are called with a background context; use
+// SerializeContext to pass them the context of a request instead.
 func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
+	return SerializeContext(context.Background(), a)
+}
+
+// serialize is Serialize without the SerializeHooks.
+func serialize(a vocab.Type) (m map[string]interface{}, e error) {
 	m, e = a.Serialize()
 	if e != nil {
 		return
@@ -60,3 +71,85 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 	cleanFnRecur(m)
 	return
 }
+
+// ToShallowViewFromMap extracts the ShallowView of an ActivityStreams value
+// from its decoded JSON, without deserializing it. It is much cheaper than
+// ToType for values embedding large objects, such as attachments or reply
+// chains, and lets the value be inspected before deciding whether to
+// deserialize it at all.
+//
+// Unlike ToShallowView, the value is not validated: properties that cannot be
+// interpreted are left empty, and the type is the first one listed even if it
+// is not known to this package.
+func ToShallowViewFromMap(m map[string]interface{}) ShallowView {
+	v := ShallowView{
+		Id:   shallowIRI(firstOf(m, "id", "@id")),
+		Type: shallowType(firstOf(m, "type", "@type")),
+	}
+	if p, ok := m["published"]; ok {
+		v.Published, _ = datetime.DeserializeDateTime(p)
+	}
+	v.Actor = shallowIRIs(m["actor"])
+	v.To = shallowIRIs(m["to"])
+	v.Bto = shallowIRIs(m["bto"])
+	v.Cc = shallowIRIs(m["cc"])
+	v.Bcc = shallowIRIs(m["bcc"])
+	v.Audience = shallowIRIs(m["audience"])
+	return v
+}
+
+// firstOf returns the value of the first of the keys present in the map.
+func firstOf(m map[string]interface{}, keys ...string) interface{} {
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// shallowType returns the type of a decoded JSON value, the first one if it
+// lists several.
+func shallowType(i interface{}) string {
+	switch v := i.(type) {
+	case string:
+		return v
+	case []interface{}:
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// shallowIRI returns the IRI of a decoded JSON value, which is either the IRI
+// itself or an embedded value captured by its id.
+func shallowIRI(i interface{}) *url.URL {
+	switch v := i.(type) {
+	case string:
+		if u, err := url.Parse(v); err == nil && len(v) > 0 {
+			return u
+		}
+	case map[string]interface{}:
+		return shallowIRI(firstOf(v, "id", "@id"))
+	}
+	return nil
+}
+
+// shallowIRIs returns the IRIs of a decoded JSON value that is a single value
+// or an array of them.
+func shallowIRIs(i interface{}) []*url.URL {
+	arr, ok := i.([]interface{})
+	if !ok {
+		arr = []interface{}{i}
+	}
+	var iris []*url.URL
+	for _, elem := range arr {
+		if u := shallowIRI(elem); u != nil {
+			iris = append(iris, u)
+		}
+	}
+	return iris
+}
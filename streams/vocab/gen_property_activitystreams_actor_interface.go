@@ -65,6 +65,10 @@ type ActivityStreamsActorPropertyIterator interface {
 	// IsActivityStreamsDocument returns false, GetActivityStreamsDocument
 	// will return an arbitrary value.
 	GetActivityStreamsDocument() ActivityStreamsDocument
+	// GetActivityStreamsEmoji returns the value of this property. When
+	// IsActivityStreamsEmoji returns false, GetActivityStreamsEmoji will
+	// return an arbitrary value.
+	GetActivityStreamsEmoji() ActivityStreamsEmoji
 	// GetActivityStreamsEvent returns the value of this property. When
 	// IsActivityStreamsEvent returns false, GetActivityStreamsEvent will
 	// return an arbitrary value.
@@ -81,6 +85,10 @@ type ActivityStreamsActorPropertyIterator interface {
 	// IsActivityStreamsGroup returns false, GetActivityStreamsGroup will
 	// return an arbitrary value.
 	GetActivityStreamsGroup() ActivityStreamsGroup
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsIgnore returns the value of this property. When
 	// IsActivityStreamsIgnore returns false, GetActivityStreamsIgnore
 	// will return an arbitrary value.
@@ -167,6 +175,10 @@ type ActivityStreamsActorPropertyIterator interface {
 	// IsActivityStreamsProfile returns false, GetActivityStreamsProfile
 	// will return an arbitrary value.
 	GetActivityStreamsProfile() ActivityStreamsProfile
+	// GetActivityStreamsPropertyValue returns the value of this property.
+	// When IsActivityStreamsPropertyValue returns false,
+	// GetActivityStreamsPropertyValue will return an arbitrary value.
+	GetActivityStreamsPropertyValue() ActivityStreamsPropertyValue
 	// GetActivityStreamsQuestion returns the value of this property. When
 	// IsActivityStreamsQuestion returns false, GetActivityStreamsQuestion
 	// will return an arbitrary value.
@@ -296,6 +308,10 @@ type ActivityStreamsActorPropertyIterator interface {
 	// "Document". When true, use the GetActivityStreamsDocument and
 	// SetActivityStreamsDocument methods to access and set this property.
 	IsActivityStreamsDocument() bool
+	// IsActivityStreamsEmoji returns true if this property has a type of
+	// "Emoji". When true, use the GetActivityStreamsEmoji and
+	// SetActivityStreamsEmoji methods to access and set this property.
+	IsActivityStreamsEmoji() bool
 	// IsActivityStreamsEvent returns true if this property has a type of
 	// "Event". When true, use the GetActivityStreamsEvent and
 	// SetActivityStreamsEvent methods to access and set this property.
@@ -312,6 +328,10 @@ type ActivityStreamsActorPropertyIterator interface {
 	// "Group". When true, use the GetActivityStreamsGroup and
 	// SetActivityStreamsGroup methods to access and set this property.
 	IsActivityStreamsGroup() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsIgnore returns true if this property has a type of
 	// "Ignore". When true, use the GetActivityStreamsIgnore and
 	// SetActivityStreamsIgnore methods to access and set this property.
@@ -403,6 +423,11 @@ type ActivityStreamsActorPropertyIterator interface {
 	// "Profile". When true, use the GetActivityStreamsProfile and
 	// SetActivityStreamsProfile methods to access and set this property.
 	IsActivityStreamsProfile() bool
+	// IsActivityStreamsPropertyValue returns true if this property has a type
+	// of "PropertyValue". When true, use the
+	// GetActivityStreamsPropertyValue and SetActivityStreamsPropertyValue
+	// methods to access and set this property.
+	IsActivityStreamsPropertyValue() bool
 	// IsActivityStreamsQuestion returns true if this property has a type of
 	// "Question". When true, use the GetActivityStreamsQuestion and
 	// SetActivityStreamsQuestion methods to access and set this property.
@@ -533,6 +558,9 @@ type ActivityStreamsActorPropertyIterator interface {
 	// SetActivityStreamsDocument sets the value of this property. Calling
 	// IsActivityStreamsDocument afterwards returns true.
 	SetActivityStreamsDocument(v ActivityStreamsDocument)
+	// SetActivityStreamsEmoji sets the value of this property. Calling
+	// IsActivityStreamsEmoji afterwards returns true.
+	SetActivityStreamsEmoji(v ActivityStreamsEmoji)
 	// SetActivityStreamsEvent sets the value of this property. Calling
 	// IsActivityStreamsEvent afterwards returns true.
 	SetActivityStreamsEvent(v ActivityStreamsEvent)
@@ -545,6 +573,9 @@ type ActivityStreamsActorPropertyIterator interface {
 	// SetActivityStreamsGroup sets the value of this property. Calling
 	// IsActivityStreamsGroup afterwards returns true.
 	SetActivityStreamsGroup(v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets the value of this property. Calling
 	// IsActivityStreamsIgnore afterwards returns true.
 	SetActivityStreamsIgnore(v ActivityStreamsIgnore)
@@ -610,6 +641,9 @@ type ActivityStreamsActorPropertyIterator interface {
 	// SetActivityStreamsProfile sets the value of this property. Calling
 	// IsActivityStreamsProfile afterwards returns true.
 	SetActivityStreamsProfile(v ActivityStreamsProfile)
+	// SetActivityStreamsPropertyValue sets the value of this property.
+	// Calling IsActivityStreamsPropertyValue afterwards returns true.
+	SetActivityStreamsPropertyValue(v ActivityStreamsPropertyValue)
 	// SetActivityStreamsQuestion sets the value of this property. Calling
 	// IsActivityStreamsQuestion afterwards returns true.
 	SetActivityStreamsQuestion(v ActivityStreamsQuestion)
@@ -658,6 +692,11 @@ type ActivityStreamsActorPropertyIterator interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // Describes one or more entities that either performed or are expected to perform
@@ -759,6 +798,10 @@ type ActivityStreamsActorProperty interface {
 	// list of the property "actor". Invalidates iterators that are
 	// traversing using Prev.
 	AppendActivityStreamsDocument(v ActivityStreamsDocument)
+	// AppendActivityStreamsEmoji appends a Emoji value to the back of a list
+	// of the property "actor". Invalidates iterators that are traversing
+	// using Prev.
+	AppendActivityStreamsEmoji(v ActivityStreamsEmoji)
 	// AppendActivityStreamsEvent appends a Event value to the back of a list
 	// of the property "actor". Invalidates iterators that are traversing
 	// using Prev.
@@ -775,6 +818,10 @@ type ActivityStreamsActorProperty interface {
 	// of the property "actor". Invalidates iterators that are traversing
 	// using Prev.
 	AppendActivityStreamsGroup(v ActivityStreamsGroup)
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "actor". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsIgnore appends a Ignore value to the back of a
 	// list of the property "actor". Invalidates iterators that are
 	// traversing using Prev.
@@ -859,6 +906,10 @@ type ActivityStreamsActorProperty interface {
 	// list of the property "actor". Invalidates iterators that are
 	// traversing using Prev.
 	AppendActivityStreamsProfile(v ActivityStreamsProfile)
+	// AppendActivityStreamsPropertyValue appends a PropertyValue value to the
+	// back of a list of the property "actor". Invalidates iterators that
+	// are traversing using Prev.
+	AppendActivityStreamsPropertyValue(v ActivityStreamsPropertyValue)
 	// AppendActivityStreamsQuestion appends a Question value to the back of a
 	// list of the property "actor". Invalidates iterators that are
 	// traversing using Prev.
@@ -936,6 +987,12 @@ type ActivityStreamsActorProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsActorPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsActorPropertyIterator) bool)
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "actor". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
@@ -997,6 +1054,10 @@ type ActivityStreamsActorProperty interface {
 	// index for a property "actor". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
 	InsertActivityStreamsDocument(idx int, v ActivityStreamsDocument)
+	// InsertActivityStreamsEmoji inserts a Emoji value at the specified index
+	// for a property "actor". Existing elements at that index and higher
+	// are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsEmoji(idx int, v ActivityStreamsEmoji)
 	// InsertActivityStreamsEvent inserts a Event value at the specified index
 	// for a property "actor". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -1013,6 +1074,10 @@ type ActivityStreamsActorProperty interface {
 	// for a property "actor". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
 	InsertActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "actor". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsIgnore inserts a Ignore value at the specified
 	// index for a property "actor". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
@@ -1100,6 +1165,10 @@ type ActivityStreamsActorProperty interface {
 	// index for a property "actor". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
 	InsertActivityStreamsProfile(idx int, v ActivityStreamsProfile)
+	// InsertActivityStreamsPropertyValue inserts a PropertyValue value at the
+	// specified index for a property "actor". Existing elements at that
+	// index and higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsPropertyValue(idx int, v ActivityStreamsPropertyValue)
 	// InsertActivityStreamsQuestion inserts a Question value at the specified
 	// index for a property "actor". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
@@ -1234,6 +1303,9 @@ type ActivityStreamsActorProperty interface {
 	// PrependActivityStreamsDocument prepends a Document value to the front
 	// of a list of the property "actor". Invalidates all iterators.
 	PrependActivityStreamsDocument(v ActivityStreamsDocument)
+	// PrependActivityStreamsEmoji prepends a Emoji value to the front of a
+	// list of the property "actor". Invalidates all iterators.
+	PrependActivityStreamsEmoji(v ActivityStreamsEmoji)
 	// PrependActivityStreamsEvent prepends a Event value to the front of a
 	// list of the property "actor". Invalidates all iterators.
 	PrependActivityStreamsEvent(v ActivityStreamsEvent)
@@ -1246,6 +1318,9 @@ type ActivityStreamsActorProperty interface {
 	// PrependActivityStreamsGroup prepends a Group value to the front of a
 	// list of the property "actor". Invalidates all iterators.
 	PrependActivityStreamsGroup(v ActivityStreamsGroup)
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "actor". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsIgnore prepends a Ignore value to the front of a
 	// list of the property "actor". Invalidates all iterators.
 	PrependActivityStreamsIgnore(v ActivityStreamsIgnore)
@@ -1312,6 +1387,10 @@ type ActivityStreamsActorProperty interface {
 	// PrependActivityStreamsProfile prepends a Profile value to the front of
 	// a list of the property "actor". Invalidates all iterators.
 	PrependActivityStreamsProfile(v ActivityStreamsProfile)
+	// PrependActivityStreamsPropertyValue prepends a PropertyValue value to
+	// the front of a list of the property "actor". Invalidates all
+	// iterators.
+	PrependActivityStreamsPropertyValue(v ActivityStreamsPropertyValue)
 	// PrependActivityStreamsQuestion prepends a Question value to the front
 	// of a list of the property "actor". Invalidates all iterators.
 	PrependActivityStreamsQuestion(v ActivityStreamsQuestion)
@@ -1433,6 +1512,10 @@ type ActivityStreamsActorProperty interface {
 	// index for the property "actor". Panics if the index is out of
 	// bounds. Invalidates all iterators.
 	SetActivityStreamsDocument(idx int, v ActivityStreamsDocument)
+	// SetActivityStreamsEmoji sets a Emoji value to be at the specified index
+	// for the property "actor". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetActivityStreamsEmoji(idx int, v ActivityStreamsEmoji)
 	// SetActivityStreamsEvent sets a Event value to be at the specified index
 	// for the property "actor". Panics if the index is out of bounds.
 	// Invalidates all iterators.
@@ -1449,6 +1532,10 @@ type ActivityStreamsActorProperty interface {
 	// for the property "actor". Panics if the index is out of bounds.
 	// Invalidates all iterators.
 	SetActivityStreamsGroup(idx int, v ActivityStreamsGroup)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "actor". Panics if the index is out of
+	// bounds. Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsIgnore sets a Ignore value to be at the specified
 	// index for the property "actor". Panics if the index is out of
 	// bounds. Invalidates all iterators.
@@ -1533,6 +1620,10 @@ type ActivityStreamsActorProperty interface {
 	// index for the property "actor". Panics if the index is out of
 	// bounds. Invalidates all iterators.
 	SetActivityStreamsProfile(idx int, v ActivityStreamsProfile)
+	// SetActivityStreamsPropertyValue sets a PropertyValue value to be at the
+	// specified index for the property "actor". Panics if the index is
+	// out of bounds. Invalidates all iterators.
+	SetActivityStreamsPropertyValue(idx int, v ActivityStreamsPropertyValue)
 	// SetActivityStreamsQuestion sets a Question value to be at the specified
 	// index for the property "actor". Panics if the index is out of
 	// bounds. Invalidates all iterators.
@@ -1597,6 +1688,10 @@ type ActivityStreamsActorProperty interface {
 	// the type is not a valid one to set for this property. Panics if the
 	// index is out of bounds.
 	SetType(idx int, t Type) error
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "actor"
 	// property.
 	Swap(i, j int)
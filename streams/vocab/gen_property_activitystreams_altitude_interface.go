@@ -59,4 +59,9 @@ type ActivityStreamsAltitudeProperty interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards will
 	// return true.
 	SetIRI(v *url.URL)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
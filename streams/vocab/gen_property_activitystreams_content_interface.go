@@ -79,6 +79,11 @@ type ActivityStreamsContentPropertyIterator interface {
 	// natural language map. Calling IsXMLSchemaString afterwards will
 	// return true. Calling IsRDFLangString afterwards returns false.
 	SetXMLSchemaString(v string)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // The content or textual representation of the Object encoded as a JSON string.
@@ -136,6 +141,12 @@ type ActivityStreamsContentProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsContentPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsContentPropertyIterator) bool)
 	// Insert inserts an IRI value at the specified index for a property
 	// "content". Existing elements at that index and higher are shifted
 	// back once. Invalidates all iterators.
@@ -200,6 +211,10 @@ type ActivityStreamsContentProperty interface {
 	// the property "content". Panics if the index is out of bounds.
 	// Invalidates all iterators.
 	SetXMLSchemaString(idx int, v string)
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "content"
 	// property.
 	Swap(i, j int)
@@ -32,6 +32,10 @@ type ActivityStreamsFirstProperty interface {
 	// When IsActivityStreamsCollectionPage returns false,
 	// GetActivityStreamsCollectionPage will return an arbitrary value.
 	GetActivityStreamsCollectionPage() ActivityStreamsCollectionPage
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsLink returns the value of this property. When
 	// IsActivityStreamsLink returns false, GetActivityStreamsLink will
 	// return an arbitrary value.
@@ -60,6 +64,10 @@ type ActivityStreamsFirstProperty interface {
 	// SetActivityStreamsCollectionPage methods to access and set this
 	// property.
 	IsActivityStreamsCollectionPage() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsLink returns true if this property has a type of
 	// "Link". When true, use the GetActivityStreamsLink and
 	// SetActivityStreamsLink methods to access and set this property.
@@ -102,6 +110,9 @@ type ActivityStreamsFirstProperty interface {
 	// SetActivityStreamsCollectionPage sets the value of this property.
 	// Calling IsActivityStreamsCollectionPage afterwards returns true.
 	SetActivityStreamsCollectionPage(v ActivityStreamsCollectionPage)
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsLink sets the value of this property. Calling
 	// IsActivityStreamsLink afterwards returns true.
 	SetActivityStreamsLink(v ActivityStreamsLink)
@@ -118,4 +129,9 @@ type ActivityStreamsFirstProperty interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
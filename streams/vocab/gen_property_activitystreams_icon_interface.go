@@ -5,6 +5,10 @@ import "net/url"
 // ActivityStreamsIconPropertyIterator represents a single value for the "icon"
 // property.
 type ActivityStreamsIconPropertyIterator interface {
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsImage returns the value of this property. When
 	// IsActivityStreamsImage returns false, GetActivityStreamsImage will
 	// return an arbitrary value.
@@ -26,6 +30,10 @@ type ActivityStreamsIconPropertyIterator interface {
 	GetType() Type
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsImage returns true if this property has a type of
 	// "Image". When true, use the GetActivityStreamsImage and
 	// SetActivityStreamsImage methods to access and set this property.
@@ -62,6 +70,9 @@ type ActivityStreamsIconPropertyIterator interface {
 	// Prev returns the previous iterator, or nil if there is no previous
 	// iterator.
 	Prev() ActivityStreamsIconPropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets the value of this property. Calling
 	// IsActivityStreamsImage afterwards returns true.
 	SetActivityStreamsImage(v ActivityStreamsImage)
@@ -77,6 +88,11 @@ type ActivityStreamsIconPropertyIterator interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // Indicates an entity that describes an icon for this object. The image should
@@ -120,6 +136,10 @@ type ActivityStreamsIconPropertyIterator interface {
 //     "type": "Note"
 //   }
 type ActivityStreamsIconProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "icon". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
 	// of the property "icon". Invalidates iterators that are traversing
 	// using Prev.
@@ -153,6 +173,16 @@ type ActivityStreamsIconProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsIconPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsIconPropertyIterator) bool)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "icon". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "icon". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -195,6 +225,9 @@ type ActivityStreamsIconProperty interface {
 	LessThan(o ActivityStreamsIconProperty) bool
 	// Name returns the name of this property: "icon".
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "icon". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
 	// list of the property "icon". Invalidates all iterators.
 	PrependActivityStreamsImage(v ActivityStreamsImage)
@@ -221,6 +254,10 @@ type ActivityStreamsIconProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "icon". Panics if the index is out of
+	// bounds. Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "icon". Panics if the index is out of bounds.
 	// Invalidates all iterators.
@@ -241,6 +278,10 @@ type ActivityStreamsIconProperty interface {
 	// type is not a valid one to set for this property. Panics if the
 	// index is out of bounds.
 	SetType(idx int, t Type) error
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "icon"
 	// property.
 	Swap(i, j int)
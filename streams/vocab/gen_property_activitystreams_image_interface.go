@@ -5,6 +5,10 @@ import "net/url"
 // ActivityStreamsImagePropertyIterator represents a single value for the "image"
 // property.
 type ActivityStreamsImagePropertyIterator interface {
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsImage returns the value of this property. When
 	// IsActivityStreamsImage returns false, GetActivityStreamsImage will
 	// return an arbitrary value.
@@ -26,6 +30,10 @@ type ActivityStreamsImagePropertyIterator interface {
 	GetType() Type
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsImage returns true if this property has a type of
 	// "Image". When true, use the GetActivityStreamsImage and
 	// SetActivityStreamsImage methods to access and set this property.
@@ -62,6 +70,9 @@ type ActivityStreamsImagePropertyIterator interface {
 	// Prev returns the previous iterator, or nil if there is no previous
 	// iterator.
 	Prev() ActivityStreamsImagePropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets the value of this property. Calling
 	// IsActivityStreamsImage afterwards returns true.
 	SetActivityStreamsImage(v ActivityStreamsImage)
@@ -77,6 +88,11 @@ type ActivityStreamsImagePropertyIterator interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // Indicates an entity that describes an image for this object. Unlike the icon
@@ -113,6 +129,10 @@ type ActivityStreamsImagePropertyIterator interface {
 //     "type": "Note"
 //   }
 type ActivityStreamsImageProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "image". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsImage appends a Image value to the back of a list
 	// of the property "image". Invalidates iterators that are traversing
 	// using Prev.
@@ -146,6 +166,16 @@ type ActivityStreamsImageProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsImagePropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsImagePropertyIterator) bool)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "image". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsImage inserts a Image value at the specified index
 	// for a property "image". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -188,6 +218,9 @@ type ActivityStreamsImageProperty interface {
 	LessThan(o ActivityStreamsImageProperty) bool
 	// Name returns the name of this property: "image".
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "image". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsImage prepends a Image value to the front of a
 	// list of the property "image". Invalidates all iterators.
 	PrependActivityStreamsImage(v ActivityStreamsImage)
@@ -214,6 +247,10 @@ type ActivityStreamsImageProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "image". Panics if the index is out of
+	// bounds. Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsImage sets a Image value to be at the specified index
 	// for the property "image". Panics if the index is out of bounds.
 	// Invalidates all iterators.
@@ -234,6 +271,10 @@ type ActivityStreamsImageProperty interface {
 	// the type is not a valid one to set for this property. Panics if the
 	// index is out of bounds.
 	SetType(idx int, t Type) error
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "image"
 	// property.
 	Swap(i, j int)
@@ -79,6 +79,11 @@ type ActivityStreamsNamePropertyIterator interface {
 	// natural language map. Calling IsXMLSchemaString afterwards will
 	// return true. Calling IsRDFLangString afterwards returns false.
 	SetXMLSchemaString(v string)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // A simple, human-readable, plain-text name for the object. HTML markup MUST NOT
@@ -125,6 +130,12 @@ type ActivityStreamsNameProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsNamePropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsNamePropertyIterator) bool)
 	// Insert inserts an IRI value at the specified index for a property
 	// "name". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
@@ -189,6 +200,10 @@ type ActivityStreamsNameProperty interface {
 	// the property "name". Panics if the index is out of bounds.
 	// Invalidates all iterators.
 	SetXMLSchemaString(idx int, v string)
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "name"
 	// property.
 	Swap(i, j int)
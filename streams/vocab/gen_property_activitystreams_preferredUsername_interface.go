@@ -84,4 +84,9 @@ type ActivityStreamsPreferredUsernameProperty interface {
 	// natural language map. Calling IsXMLSchemaString afterwards will
 	// return true. Calling IsRDFLangString afterwards returns false.
 	SetXMLSchemaString(v string)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
@@ -2,11 +2,9 @@ package vocab
 
 import "net/url"
 
-// The public key for an ActivityStreams actor
-type ActivityStreamsPublicKeyProperty interface {
-	// Clear ensures no value of this property is set. Calling
-	// IsActivityStreamsPublicKey afterwards will return false.
-	Clear()
+// ActivityStreamsPublicKeyPropertyIterator represents a single value for the
+// "publicKey" property.
+type ActivityStreamsPublicKeyPropertyIterator interface {
 	// Get returns the value of this property. When IsActivityStreamsPublicKey
 	// returns false, Get will return any arbitrary value.
 	Get() ActivityStreamsPublicKey
@@ -37,15 +35,14 @@ type ActivityStreamsPublicKeyProperty interface {
 	// stable comparison. Applications should not use this because it is
 	// only meant to help alternative implementations to go-fed to be able
 	// to normalize nonfunctional properties.
-	LessThan(o ActivityStreamsPublicKeyProperty) bool
-	// Name returns the name of this property: "publicKey".
+	LessThan(o ActivityStreamsPublicKeyPropertyIterator) bool
+	// Name returns the name of this property: "ActivityStreamsPublicKey".
 	Name() string
-	// Serialize converts this into an interface representation suitable for
-	// marshalling into a text or binary format. Applications should not
-	// need this function as most typical use cases serialize types
-	// instead of individual properties. It is exposed for alternatives to
-	// go-fed implementations to use.
-	Serialize() (interface{}, error)
+	// Next returns the next iterator, or nil if there is no next iterator.
+	Next() ActivityStreamsPublicKeyPropertyIterator
+	// Prev returns the previous iterator, or nil if there is no previous
+	// iterator.
+	Prev() ActivityStreamsPublicKeyPropertyIterator
 	// Set sets the value of this property. Calling IsActivityStreamsPublicKey
 	// afterwards will return true.
 	Set(v ActivityStreamsPublicKey)
@@ -55,4 +52,119 @@ type ActivityStreamsPublicKeyProperty interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
+}
+
+// The public key for an ActivityStreams actor
+type ActivityStreamsPublicKeyProperty interface {
+	// AppendActivityStreamsPublicKey appends a PublicKey value to the back of
+	// a list of the property "publicKey". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsPublicKey(v ActivityStreamsPublicKey)
+	// AppendIRI appends an IRI value to the back of a list of the property
+	// "publicKey"
+	AppendIRI(v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "publicKey". Invalidates iterators that are traversing
+	// using Prev. Returns an error if the type is not a valid one to set
+	// for this property.
+	AppendType(t Type) error
+	// At returns the property value for the specified index. Panics if the
+	// index is out of bounds.
+	At(index int) ActivityStreamsPublicKeyPropertyIterator
+	// Begin returns the first iterator, or nil if empty. Can be used with the
+	// iterator's Next method and this property's End method to iterate
+	// from front to back through all values.
+	Begin() ActivityStreamsPublicKeyPropertyIterator
+	// Empty returns returns true if there are no elements.
+	Empty() bool
+	// End returns beyond-the-last iterator, which is nil. Can be used with
+	// the iterator's Next method and this property's Begin method to
+	// iterate from front to back through all values.
+	End() ActivityStreamsPublicKeyPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsPublicKeyPropertyIterator) bool)
+	// InsertActivityStreamsPublicKey inserts a PublicKey value at the
+	// specified index for a property "publicKey". Existing elements at
+	// that index and higher are shifted back once. Invalidates all
+	// iterators.
+	InsertActivityStreamsPublicKey(idx int, v ActivityStreamsPublicKey)
+	// Insert inserts an IRI value at the specified index for a property
+	// "publicKey". Existing elements at that index and higher are shifted
+	// back once. Invalidates all iterators.
+	InsertIRI(idx int, v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "publicKey". Invalidates all iterators. Returns an
+	// error if the type is not a valid one to set for this property.
+	InsertType(idx int, t Type) error
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API method specifically needed only for alternate
+	// implementations for go-fed. Applications should not use this
+	// method. Panics if the index is out of bounds.
+	KindIndex(idx int) int
+	// Len returns the number of values that exist for the "publicKey"
+	// property.
+	Len() (length int)
+	// Less computes whether another property is less than this one. Mixing
+	// types results in a consistent but arbitrary ordering
+	Less(i, j int) bool
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o ActivityStreamsPublicKeyProperty) bool
+	// Name returns the name of this property: "publicKey".
+	Name() string
+	// PrependActivityStreamsPublicKey prepends a PublicKey value to the front
+	// of a list of the property "publicKey". Invalidates all iterators.
+	PrependActivityStreamsPublicKey(v ActivityStreamsPublicKey)
+	// PrependIRI prepends an IRI value to the front of a list of the property
+	// "publicKey".
+	PrependIRI(v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "publicKey". Invalidates all iterators. Returns an
+	// error if the type is not a valid one to set for this property.
+	PrependType(t Type) error
+	// Remove deletes an element at the specified index from a list of the
+	// property "publicKey", regardless of its type. Panics if the index
+	// is out of bounds. Invalidates all iterators.
+	Remove(idx int)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format. Applications should not
+	// need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// Set sets a PublicKey value to be at the specified index for the
+	// property "publicKey". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	Set(idx int, v ActivityStreamsPublicKey)
+	// SetIRI sets an IRI value to be at the specified index for the property
+	// "publicKey". Panics if the index is out of bounds.
+	SetIRI(idx int, v *url.URL)
+	// SetType sets an arbitrary type value to the specified index of the
+	// property "publicKey". Invalidates all iterators. Returns an error
+	// if the type is not a valid one to set for this property. Panics if
+	// the index is out of bounds.
+	SetType(idx int, t Type) error
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
+	// Swap swaps the location of values at two indices for the "publicKey"
+	// property.
+	Swap(i, j int)
 }
@@ -44,6 +44,11 @@ type ActivityStreamsRelPropertyIterator interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards will
 	// return true.
 	SetIRI(v *url.URL)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // A link relation associated with a Link. The value MUST conform to both the
@@ -85,6 +90,12 @@ type ActivityStreamsRelProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsRelPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsRelPropertyIterator) bool)
 	// Insert inserts an IRI value at the specified index for a property
 	// "rel". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
@@ -138,6 +149,10 @@ type ActivityStreamsRelProperty interface {
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "rel". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "rel" property.
 	Swap(i, j int)
 }
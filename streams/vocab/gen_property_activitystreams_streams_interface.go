@@ -97,6 +97,11 @@ type ActivityStreamsStreamsPropertyIterator interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // A list of supplementary Collections which may be of interest
@@ -138,6 +143,12 @@ type ActivityStreamsStreamsProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsStreamsPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsStreamsPropertyIterator) bool)
 	// InsertActivityStreamsCollection inserts a Collection value at the
 	// specified index for a property "streams". Existing elements at that
 	// index and higher are shifted back once. Invalidates all iterators.
@@ -244,6 +255,10 @@ type ActivityStreamsStreamsProperty interface {
 	// the type is not a valid one to set for this property. Panics if the
 	// index is out of bounds.
 	SetType(idx int, t Type) error
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "streams"
 	// property.
 	Swap(i, j int)
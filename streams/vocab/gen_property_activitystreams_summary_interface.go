@@ -79,6 +79,11 @@ type ActivityStreamsSummaryPropertyIterator interface {
 	// natural language map. Calling IsXMLSchemaString afterwards will
 	// return true. Calling IsRDFLangString afterwards returns false.
 	SetXMLSchemaString(v string)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // A natural language summarization of the object encoded as HTML. Multiple
@@ -126,6 +131,12 @@ type ActivityStreamsSummaryProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsSummaryPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsSummaryPropertyIterator) bool)
 	// Insert inserts an IRI value at the specified index for a property
 	// "summary". Existing elements at that index and higher are shifted
 	// back once. Invalidates all iterators.
@@ -190,6 +201,10 @@ type ActivityStreamsSummaryProperty interface {
 	// the property "summary". Panics if the index is out of bounds.
 	// Invalidates all iterators.
 	SetXMLSchemaString(idx int, v string)
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "summary"
 	// property.
 	Swap(i, j int)
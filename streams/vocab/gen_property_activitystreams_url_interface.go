@@ -5,6 +5,10 @@ import "net/url"
 // ActivityStreamsUrlPropertyIterator represents a single value for the "url"
 // property.
 type ActivityStreamsUrlPropertyIterator interface {
+	// GetActivityStreamsHashtag returns the value of this property. When
+	// IsActivityStreamsHashtag returns false, GetActivityStreamsHashtag
+	// will return an arbitrary value.
+	GetActivityStreamsHashtag() ActivityStreamsHashtag
 	// GetActivityStreamsLink returns the value of this property. When
 	// IsActivityStreamsLink returns false, GetActivityStreamsLink will
 	// return an arbitrary value.
@@ -26,6 +30,10 @@ type ActivityStreamsUrlPropertyIterator interface {
 	GetXMLSchemaAnyURI() *url.URL
 	// HasAny returns true if any of the different values is set.
 	HasAny() bool
+	// IsActivityStreamsHashtag returns true if this property has a type of
+	// "Hashtag". When true, use the GetActivityStreamsHashtag and
+	// SetActivityStreamsHashtag methods to access and set this property.
+	IsActivityStreamsHashtag() bool
 	// IsActivityStreamsLink returns true if this property has a type of
 	// "Link". When true, use the GetActivityStreamsLink and
 	// SetActivityStreamsLink methods to access and set this property.
@@ -62,6 +70,9 @@ type ActivityStreamsUrlPropertyIterator interface {
 	// Prev returns the previous iterator, or nil if there is no previous
 	// iterator.
 	Prev() ActivityStreamsUrlPropertyIterator
+	// SetActivityStreamsHashtag sets the value of this property. Calling
+	// IsActivityStreamsHashtag afterwards returns true.
+	SetActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// SetActivityStreamsLink sets the value of this property. Calling
 	// IsActivityStreamsLink afterwards returns true.
 	SetActivityStreamsLink(v ActivityStreamsLink)
@@ -77,6 +88,11 @@ type ActivityStreamsUrlPropertyIterator interface {
 	// SetXMLSchemaAnyURI sets the value of this property. Calling
 	// IsXMLSchemaAnyURI afterwards returns true.
 	SetXMLSchemaAnyURI(v *url.URL)
+	// String returns a compact, human-readable form of the value of this
+	// property for logs and debugging: an IRI, a quoted string shortened
+	// to 64 characters, the name and id of a type, or another value as
+	// printed by fmt. It is not a serialization.
+	String() string
 }
 
 // Identifies one or more links to representations of the object
@@ -116,6 +132,10 @@ type ActivityStreamsUrlPropertyIterator interface {
 //     ]
 //   }
 type ActivityStreamsUrlProperty interface {
+	// AppendActivityStreamsHashtag appends a Hashtag value to the back of a
+	// list of the property "url". Invalidates iterators that are
+	// traversing using Prev.
+	AppendActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// AppendActivityStreamsLink appends a Link value to the back of a list of
 	// the property "url". Invalidates iterators that are traversing using
 	// Prev.
@@ -149,6 +169,16 @@ type ActivityStreamsUrlProperty interface {
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsUrlPropertyIterator
+	// ForEach calls fn with the index and iterator of each value, from front
+	// to back, until fn returns false. Unlike the iterator's Next method,
+	// which copies the iterator it is called on, it neither copies nor
+	// allocates per value, so that it suits hot loops over long
+	// properties. The property must not be modified by fn.
+	ForEach(fn func(idx int, it ActivityStreamsUrlPropertyIterator) bool)
+	// InsertActivityStreamsHashtag inserts a Hashtag value at the specified
+	// index for a property "url". Existing elements at that index and
+	// higher are shifted back once. Invalidates all iterators.
+	InsertActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// InsertActivityStreamsLink inserts a Link value at the specified index
 	// for a property "url". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -191,6 +221,9 @@ type ActivityStreamsUrlProperty interface {
 	LessThan(o ActivityStreamsUrlProperty) bool
 	// Name returns the name of this property: "url".
 	Name() string
+	// PrependActivityStreamsHashtag prepends a Hashtag value to the front of
+	// a list of the property "url". Invalidates all iterators.
+	PrependActivityStreamsHashtag(v ActivityStreamsHashtag)
 	// PrependActivityStreamsLink prepends a Link value to the front of a list
 	// of the property "url". Invalidates all iterators.
 	PrependActivityStreamsLink(v ActivityStreamsLink)
@@ -217,6 +250,10 @@ type ActivityStreamsUrlProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SetActivityStreamsHashtag sets a Hashtag value to be at the specified
+	// index for the property "url". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	SetActivityStreamsHashtag(idx int, v ActivityStreamsHashtag)
 	// SetActivityStreamsLink sets a Link value to be at the specified index
 	// for the property "url". Panics if the index is out of bounds.
 	// Invalidates all iterators.
@@ -237,6 +274,10 @@ type ActivityStreamsUrlProperty interface {
 	// the property "url". Panics if the index is out of bounds.
 	// Invalidates all iterators.
 	SetXMLSchemaAnyURI(idx int, v *url.URL)
+	// String returns a compact, human-readable form of the values of this
+	// property for logs and debugging, listing at most the first 3. It is
+	// not a serialization.
+	String() string
 	// Swap swaps the location of values at two indices for the "url" property.
 	Swap(i, j int)
 }
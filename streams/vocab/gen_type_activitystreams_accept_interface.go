@@ -1,5 +1,10 @@
 package vocab
 
+import (
+	"net/url"
+	"time"
+)
+
 // Indicates that the actor accepts the object. The target property can be used in
 // certain circumstances to indicate the context into which the object has
 // been accepted.
@@ -40,6 +45,113 @@ package vocab
 //     "type": "Accept"
 //   }
 type ActivityStreamsAccept interface {
+	// ActorIRI returns the first value of the "actor" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ActorIRI() (v *url.URL, ok bool)
+	// ActorType returns the first value of the "actor" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ActorType() (v Type, ok bool)
+	// AltitudeFloat returns the value of the "altitude" property if it is of
+	// type "float", and false if the property is not set or has another
+	// value.
+	AltitudeFloat() (v float64, ok bool)
+	// AltitudeIRI returns the value of the "altitude" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	AltitudeIRI() (v *url.URL, ok bool)
+	// ApplyMap sets the properties named by the keys of the map, named as by
+	// GetProperty, to its values. A nil value clears the property. The
+	// values may be those of a map unmarshalled from JSON, or plain Go
+	// values such as strings, numbers, bools, time.Time, *url.URL, and
+	// slices of them. A string is also tried as a number or a bool if the
+	// property does not accept it as is, such as the values of a
+	// submitted form. Every value that can be applied is, and the
+	// returned error lists each key that could not be.
+	ApplyMap(m map[string]interface{}) error
+	// AttachmentIRI returns the first value of the "attachment" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	AttachmentIRI() (v *url.URL, ok bool)
+	// AttachmentType returns the first value of the "attachment" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	AttachmentType() (v Type, ok bool)
+	// AttributedToIRI returns the first value of the "attributedTo" property
+	// that is an IRI, and false if the property is not set or has no such
+	// value.
+	AttributedToIRI() (v *url.URL, ok bool)
+	// AttributedToType returns the first value of the "attributedTo" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	AttributedToType() (v Type, ok bool)
+	// AudienceIRI returns the first value of the "audience" property that is
+	// an IRI, and false if the property is not set or has no such value.
+	AudienceIRI() (v *url.URL, ok bool)
+	// AudienceType returns the first value of the "audience" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	AudienceType() (v Type, ok bool)
+	// BccIRI returns the first value of the "bcc" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	BccIRI() (v *url.URL, ok bool)
+	// BccType returns the first value of the "bcc" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	BccType() (v Type, ok bool)
+	// BtoIRI returns the first value of the "bto" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	BtoIRI() (v *url.URL, ok bool)
+	// BtoType returns the first value of the "bto" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	BtoType() (v Type, ok bool)
+	// CcIRI returns the first value of the "cc" property that is an IRI, and
+	// false if the property is not set or has no such value.
+	CcIRI() (v *url.URL, ok bool)
+	// CcType returns the first value of the "cc" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	CcType() (v Type, ok bool)
+	// ContentIRI returns the first value of the "content" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ContentIRI() (v *url.URL, ok bool)
+	// ContentLangString returns the first value of the "content" property
+	// that is of type "langString", and false if the property is not set
+	// or has no such value.
+	ContentLangString() (v map[string]string, ok bool)
+	// ContentString returns the first value of the "content" property that is
+	// of type "string", and false if the property is not set or has no
+	// such value.
+	ContentString() (v string, ok bool)
+	// ContextIRI returns the first value of the "context" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ContextIRI() (v *url.URL, ok bool)
+	// ContextType returns the first value of the "context" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	ContextType() (v Type, ok bool)
+	// DurationDuration returns the value of the "duration" property if it is
+	// of type "duration", and false if the property is not set or has
+	// another value.
+	DurationDuration() (v time.Duration, ok bool)
+	// DurationIRI returns the value of the "duration" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	DurationIRI() (v *url.URL, ok bool)
+	// EndTimeDateTime returns the value of the "endTime" property if it is of
+	// type "dateTime", and false if the property is not set or has
+	// another value.
+	EndTimeDateTime() (v time.Time, ok bool)
+	// EndTimeIRI returns the value of the "endTime" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	EndTimeIRI() (v *url.URL, ok bool)
+	// GeneratorIRI returns the first value of the "generator" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	GeneratorIRI() (v *url.URL, ok bool)
+	// GeneratorType returns the first value of the "generator" property that
+	// is an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	GeneratorType() (v Type, ok bool)
 	// GetActivityStreamsActor returns the "actor" property if it exists, and
 	// nil otherwise.
 	GetActivityStreamsActor() ActivityStreamsActorProperty
@@ -151,6 +263,13 @@ type ActivityStreamsAccept interface {
 	// GetActivityStreamsUrl returns the "url" property if it exists, and nil
 	// otherwise.
 	GetActivityStreamsUrl() ActivityStreamsUrlProperty
+	// GetProperty returns the property with the name, such as "actor", which
+	// is nil if it is not set. Returns false if this type has no such
+	// property. A property whose name is shared with another property of
+	// this type is named with its vocabulary as a prefix, such as
+	// "ActivityStreams:name". The value may be passed to SetProperty on
+	// another value of this type.
+	GetProperty(name string) (interface{}, bool)
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the Accept
@@ -161,6 +280,46 @@ type ActivityStreamsAccept interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GoString returns the same form as String, so that printing this value
+	// with the %#v verb or in a debugger is readable instead of a dump of
+	// its members.
+	GoString() string
+	// IconIRI returns the first value of the "icon" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	IconIRI() (v *url.URL, ok bool)
+	// IconType returns the first value of the "icon" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	IconType() (v Type, ok bool)
+	// IdAnyURI returns the value of the "id" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	IdAnyURI() (v *url.URL, ok bool)
+	// IdIRI returns the value of the "id" property if it is an IRI, and false
+	// if the property is not set or has another value.
+	IdIRI() (v *url.URL, ok bool)
+	// ImageIRI returns the first value of the "image" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ImageIRI() (v *url.URL, ok bool)
+	// ImageType returns the first value of the "image" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ImageType() (v Type, ok bool)
+	// InReplyToIRI returns the first value of the "inReplyTo" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	InReplyToIRI() (v *url.URL, ok bool)
+	// InReplyToType returns the first value of the "inReplyTo" property that
+	// is an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	InReplyToType() (v Type, ok bool)
+	// InstrumentIRI returns the first value of the "instrument" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	InstrumentIRI() (v *url.URL, ok bool)
+	// InstrumentType returns the first value of the "instrument" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	InstrumentType() (v Type, ok bool)
 	// IsExtending returns true if the Accept type extends from the other type.
 	IsExtending(other Type) bool
 	// JSONLDContext returns the JSONLD URIs required in the context string
@@ -170,6 +329,80 @@ type ActivityStreamsAccept interface {
 	// LessThan computes if this Accept is lesser, with an arbitrary but
 	// stable determination.
 	LessThan(o ActivityStreamsAccept) bool
+	// LikesIRI returns the value of the "likes" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	LikesIRI() (v *url.URL, ok bool)
+	// LikesType returns the value of the "likes" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	LikesType() (v Type, ok bool)
+	// LocationIRI returns the first value of the "location" property that is
+	// an IRI, and false if the property is not set or has no such value.
+	LocationIRI() (v *url.URL, ok bool)
+	// LocationType returns the first value of the "location" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	LocationType() (v Type, ok bool)
+	// MediaTypeIRI returns the value of the "mediaType" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	MediaTypeIRI() (v *url.URL, ok bool)
+	// MediaTypeRfc2045 returns the value of the "mediaType" property if it is
+	// of type "rfc2045", and false if the property is not set or has
+	// another value.
+	MediaTypeRfc2045() (v string, ok bool)
+	// NameIRI returns the first value of the "name" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	NameIRI() (v *url.URL, ok bool)
+	// NameLangString returns the first value of the "name" property that is
+	// of type "langString", and false if the property is not set or has
+	// no such value.
+	NameLangString() (v map[string]string, ok bool)
+	// NameString returns the first value of the "name" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	NameString() (v string, ok bool)
+	// ObjectIRI returns the first value of the "object" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ObjectIRI() (v *url.URL, ok bool)
+	// ObjectType returns the first value of the "object" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ObjectType() (v Type, ok bool)
+	// OriginIRI returns the first value of the "origin" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	OriginIRI() (v *url.URL, ok bool)
+	// OriginType returns the first value of the "origin" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	OriginType() (v Type, ok bool)
+	// PreviewIRI returns the first value of the "preview" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	PreviewIRI() (v *url.URL, ok bool)
+	// PreviewType returns the first value of the "preview" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	PreviewType() (v Type, ok bool)
+	// PublishedDateTime returns the value of the "published" property if it
+	// is of type "dateTime", and false if the property is not set or has
+	// another value.
+	PublishedDateTime() (v time.Time, ok bool)
+	// PublishedIRI returns the value of the "published" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	PublishedIRI() (v *url.URL, ok bool)
+	// RepliesIRI returns the value of the "replies" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	RepliesIRI() (v *url.URL, ok bool)
+	// RepliesType returns the value of the "replies" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	RepliesType() (v Type, ok bool)
+	// ResultIRI returns the first value of the "result" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ResultIRI() (v *url.URL, ok bool)
+	// ResultType returns the first value of the "result" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ResultType() (v Type, ok bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
@@ -247,6 +480,91 @@ type ActivityStreamsAccept interface {
 	SetActivityStreamsUpdated(i ActivityStreamsUpdatedProperty)
 	// SetActivityStreamsUrl sets the "url" property.
 	SetActivityStreamsUrl(i ActivityStreamsUrlProperty)
+	// SetProperty sets the property with the name, named as by GetProperty,
+	// to the value, which must be that property's interface. A nil value
+	// clears the property. Returns an error if this type has no such
+	// property or the value is of another type.
+	SetProperty(name string, v interface{}) error
+	// SharesIRI returns the value of the "shares" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	SharesIRI() (v *url.URL, ok bool)
+	// SharesType returns the value of the "shares" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	SharesType() (v Type, ok bool)
+	// StartTimeDateTime returns the value of the "startTime" property if it
+	// is of type "dateTime", and false if the property is not set or has
+	// another value.
+	StartTimeDateTime() (v time.Time, ok bool)
+	// StartTimeIRI returns the value of the "startTime" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	StartTimeIRI() (v *url.URL, ok bool)
+	// String returns a compact, human-readable form of this Accept for logs
+	// and debugging, such as Accept{id: https://example.com/1, name:
+	// "Example"}. It lists the id first and then each other property that
+	// is set, with long values shortened. It is not a serialization; use
+	// Serialize for that.
+	String() string
+	// SummaryIRI returns the first value of the "summary" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	SummaryIRI() (v *url.URL, ok bool)
+	// SummaryLangString returns the first value of the "summary" property
+	// that is of type "langString", and false if the property is not set
+	// or has no such value.
+	SummaryLangString() (v map[string]string, ok bool)
+	// SummaryString returns the first value of the "summary" property that is
+	// of type "string", and false if the property is not set or has no
+	// such value.
+	SummaryString() (v string, ok bool)
+	// TagIRI returns the first value of the "tag" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TagIRI() (v *url.URL, ok bool)
+	// TagType returns the first value of the "tag" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	TagType() (v Type, ok bool)
+	// TargetIRI returns the first value of the "target" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	TargetIRI() (v *url.URL, ok bool)
+	// TargetType returns the first value of the "target" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	TargetType() (v Type, ok bool)
+	// ToIRI returns the first value of the "to" property that is an IRI, and
+	// false if the property is not set or has no such value.
+	ToIRI() (v *url.URL, ok bool)
+	// ToType returns the first value of the "to" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ToType() (v Type, ok bool)
+	// TypeAnyURI returns the first value of the "type" property that is of
+	// type "anyURI", and false if the property is not set or has no such
+	// value.
+	TypeAnyURI() (v *url.URL, ok bool)
+	// TypeIRI returns the first value of the "type" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TypeIRI() (v *url.URL, ok bool)
+	// TypeString returns the first value of the "type" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	TypeString() (v string, ok bool)
+	// UpdatedDateTime returns the value of the "updated" property if it is of
+	// type "dateTime", and false if the property is not set or has
+	// another value.
+	UpdatedDateTime() (v time.Time, ok bool)
+	// UpdatedIRI returns the value of the "updated" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	UpdatedIRI() (v *url.URL, ok bool)
+	// UrlAnyURI returns the first value of the "url" property that is of type
+	// "anyURI", and false if the property is not set or has no such value.
+	UrlAnyURI() (v *url.URL, ok bool)
+	// UrlIRI returns the first value of the "url" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	UrlIRI() (v *url.URL, ok bool)
+	// UrlType returns the first value of the "url" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	UrlType() (v Type, ok bool)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
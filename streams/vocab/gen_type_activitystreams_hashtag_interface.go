@@ -0,0 +1,227 @@
+package vocab
+
+import "net/url"
+
+// A specialized Link that represents a #hashtag, whose href is the page of the
+// hashtag. It is not part of the ActivityStreams vocabulary, but is used as
+// as:Hashtag by Mastodon and other software.
+//
+// Hashtag Example (https://docs.joinmastodon.org/spec/activitypub/#ex-hashtag-jsonld):
+//   {
+//     "href": "https://example.com/tags/activitypub",
+//     "name": "#activitypub",
+//     "type": "Hashtag"
+//   }
+type ActivityStreamsHashtag interface {
+	// ApplyMap sets the properties named by the keys of the map, named as by
+	// GetProperty, to its values. A nil value clears the property. The
+	// values may be those of a map unmarshalled from JSON, or plain Go
+	// values such as strings, numbers, bools, time.Time, *url.URL, and
+	// slices of them. A string is also tried as a number or a bool if the
+	// property does not accept it as is, such as the values of a
+	// submitted form. Every value that can be applied is, and the
+	// returned error lists each key that could not be.
+	ApplyMap(m map[string]interface{}) error
+	// AttributedToIRI returns the first value of the "attributedTo" property
+	// that is an IRI, and false if the property is not set or has no such
+	// value.
+	AttributedToIRI() (v *url.URL, ok bool)
+	// AttributedToType returns the first value of the "attributedTo" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	AttributedToType() (v Type, ok bool)
+	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
+	// it exists, and nil otherwise.
+	GetActivityStreamsAttributedTo() ActivityStreamsAttributedToProperty
+	// GetActivityStreamsHeight returns the "height" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsHeight() ActivityStreamsHeightProperty
+	// GetActivityStreamsHref returns the "href" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsHref() ActivityStreamsHrefProperty
+	// GetActivityStreamsHreflang returns the "hreflang" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsHreflang() ActivityStreamsHreflangProperty
+	// GetActivityStreamsId returns the "id" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsId() ActivityStreamsIdProperty
+	// GetActivityStreamsMediaType returns the "mediaType" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsMediaType() ActivityStreamsMediaTypeProperty
+	// GetActivityStreamsName returns the "name" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsName() ActivityStreamsNameProperty
+	// GetActivityStreamsPreview returns the "preview" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsPreview() ActivityStreamsPreviewProperty
+	// GetActivityStreamsRel returns the "rel" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsRel() ActivityStreamsRelProperty
+	// GetActivityStreamsSummary returns the "summary" property if it exists,
+	// and nil otherwise.
+	GetActivityStreamsSummary() ActivityStreamsSummaryProperty
+	// GetActivityStreamsType returns the "type" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsType() ActivityStreamsTypeProperty
+	// GetActivityStreamsWidth returns the "width" property if it exists, and
+	// nil otherwise.
+	GetActivityStreamsWidth() ActivityStreamsWidthProperty
+	// GetProperty returns the property with the name, such as "attributedTo",
+	// which is nil if it is not set. Returns false if this type has no
+	// such property. A property whose name is shared with another
+	// property of this type is named with its vocabulary as a prefix,
+	// such as "ActivityStreams:name". The value may be passed to
+	// SetProperty on another value of this type.
+	GetProperty(name string) (interface{}, bool)
+	// GetTypeName returns the name of this type.
+	GetTypeName() string
+	// GetUnknownProperties returns the unknown properties for the Hashtag
+	// type. Note that this should not be used by app developers. It is
+	// only used to help determine which implementation is LessThan the
+	// other. Developers who are creating a different implementation of
+	// this type's interface can use this method in their LessThan
+	// implementation, but routine ActivityPub applications should not use
+	// this to bypass the code generation tool.
+	GetUnknownProperties() map[string]interface{}
+	// GoString returns the same form as String, so that printing this value
+	// with the %#v verb or in a debugger is readable instead of a dump of
+	// its members.
+	GoString() string
+	// HeightIRI returns the value of the "height" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	HeightIRI() (v *url.URL, ok bool)
+	// HeightNonNegativeInteger returns the value of the "height" property if
+	// it is of type "nonNegativeInteger", and false if the property is
+	// not set or has another value.
+	HeightNonNegativeInteger() (v int, ok bool)
+	// HrefAnyURI returns the value of the "href" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	HrefAnyURI() (v *url.URL, ok bool)
+	// HrefIRI returns the value of the "href" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	HrefIRI() (v *url.URL, ok bool)
+	// HreflangBcp47 returns the value of the "hreflang" property if it is of
+	// type "bcp47", and false if the property is not set or has another
+	// value.
+	HreflangBcp47() (v string, ok bool)
+	// HreflangIRI returns the value of the "hreflang" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	HreflangIRI() (v *url.URL, ok bool)
+	// IdAnyURI returns the value of the "id" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	IdAnyURI() (v *url.URL, ok bool)
+	// IdIRI returns the value of the "id" property if it is an IRI, and false
+	// if the property is not set or has another value.
+	IdIRI() (v *url.URL, ok bool)
+	// IsExtending returns true if the Hashtag type extends from the other
+	// type.
+	IsExtending(other Type) bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this type and the specific properties that are set. The value
+	// in the map is the alias used to import the type and its properties.
+	JSONLDContext() map[string]string
+	// LessThan computes if this Hashtag is lesser, with an arbitrary but
+	// stable determination.
+	LessThan(o ActivityStreamsHashtag) bool
+	// MediaTypeIRI returns the value of the "mediaType" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	MediaTypeIRI() (v *url.URL, ok bool)
+	// MediaTypeRfc2045 returns the value of the "mediaType" property if it is
+	// of type "rfc2045", and false if the property is not set or has
+	// another value.
+	MediaTypeRfc2045() (v string, ok bool)
+	// NameIRI returns the first value of the "name" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	NameIRI() (v *url.URL, ok bool)
+	// NameLangString returns the first value of the "name" property that is
+	// of type "langString", and false if the property is not set or has
+	// no such value.
+	NameLangString() (v map[string]string, ok bool)
+	// NameString returns the first value of the "name" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	NameString() (v string, ok bool)
+	// PreviewIRI returns the first value of the "preview" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	PreviewIRI() (v *url.URL, ok bool)
+	// PreviewType returns the first value of the "preview" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	PreviewType() (v Type, ok bool)
+	// RelIRI returns the first value of the "rel" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	RelIRI() (v *url.URL, ok bool)
+	// RelRfc5988 returns the first value of the "rel" property that is of
+	// type "rfc5988", and false if the property is not set or has no such
+	// value.
+	RelRfc5988() (v string, ok bool)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format.
+	Serialize() (map[string]interface{}, error)
+	// SetActivityStreamsAttributedTo sets the "attributedTo" property.
+	SetActivityStreamsAttributedTo(i ActivityStreamsAttributedToProperty)
+	// SetActivityStreamsHeight sets the "height" property.
+	SetActivityStreamsHeight(i ActivityStreamsHeightProperty)
+	// SetActivityStreamsHref sets the "href" property.
+	SetActivityStreamsHref(i ActivityStreamsHrefProperty)
+	// SetActivityStreamsHreflang sets the "hreflang" property.
+	SetActivityStreamsHreflang(i ActivityStreamsHreflangProperty)
+	// SetActivityStreamsId sets the "id" property.
+	SetActivityStreamsId(i ActivityStreamsIdProperty)
+	// SetActivityStreamsMediaType sets the "mediaType" property.
+	SetActivityStreamsMediaType(i ActivityStreamsMediaTypeProperty)
+	// SetActivityStreamsName sets the "name" property.
+	SetActivityStreamsName(i ActivityStreamsNameProperty)
+	// SetActivityStreamsPreview sets the "preview" property.
+	SetActivityStreamsPreview(i ActivityStreamsPreviewProperty)
+	// SetActivityStreamsRel sets the "rel" property.
+	SetActivityStreamsRel(i ActivityStreamsRelProperty)
+	// SetActivityStreamsSummary sets the "summary" property.
+	SetActivityStreamsSummary(i ActivityStreamsSummaryProperty)
+	// SetActivityStreamsType sets the "type" property.
+	SetActivityStreamsType(i ActivityStreamsTypeProperty)
+	// SetActivityStreamsWidth sets the "width" property.
+	SetActivityStreamsWidth(i ActivityStreamsWidthProperty)
+	// SetProperty sets the property with the name, named as by GetProperty,
+	// to the value, which must be that property's interface. A nil value
+	// clears the property. Returns an error if this type has no such
+	// property or the value is of another type.
+	SetProperty(name string, v interface{}) error
+	// String returns a compact, human-readable form of this Hashtag for logs
+	// and debugging, such as Hashtag{id: https://example.com/1, name:
+	// "Example"}. It lists the id first and then each other property that
+	// is set, with long values shortened. It is not a serialization; use
+	// Serialize for that.
+	String() string
+	// SummaryIRI returns the first value of the "summary" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	SummaryIRI() (v *url.URL, ok bool)
+	// SummaryLangString returns the first value of the "summary" property
+	// that is of type "langString", and false if the property is not set
+	// or has no such value.
+	SummaryLangString() (v map[string]string, ok bool)
+	// SummaryString returns the first value of the "summary" property that is
+	// of type "string", and false if the property is not set or has no
+	// such value.
+	SummaryString() (v string, ok bool)
+	// TypeAnyURI returns the first value of the "type" property that is of
+	// type "anyURI", and false if the property is not set or has no such
+	// value.
+	TypeAnyURI() (v *url.URL, ok bool)
+	// TypeIRI returns the first value of the "type" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TypeIRI() (v *url.URL, ok bool)
+	// TypeString returns the first value of the "type" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	TypeString() (v string, ok bool)
+	// VocabularyURI returns the vocabulary's URI as a string.
+	VocabularyURI() string
+	// WidthIRI returns the value of the "width" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	WidthIRI() (v *url.URL, ok bool)
+	// WidthNonNegativeInteger returns the value of the "width" property if it
+	// is of type "nonNegativeInteger", and false if the property is not
+	// set or has another value.
+	WidthNonNegativeInteger() (v int, ok bool)
+}
@@ -1,5 +1,7 @@
 package vocab
 
+import "net/url"
+
 // A specialized Link that represents an @mention.
 //
 // Example 58 (https://www.w3.org/TR/activitystreams-vocabulary/#ex181-jsonld):
@@ -10,6 +12,23 @@ package vocab
 //     "url": "http://example.org/joe"
 //   }
 type ActivityStreamsMention interface {
+	// ApplyMap sets the properties named by the keys of the map, named as by
+	// GetProperty, to its values. A nil value clears the property. The
+	// values may be those of a map unmarshalled from JSON, or plain Go
+	// values such as strings, numbers, bools, time.Time, *url.URL, and
+	// slices of them. A string is also tried as a number or a bool if the
+	// property does not accept it as is, such as the values of a
+	// submitted form. Every value that can be applied is, and the
+	// returned error lists each key that could not be.
+	ApplyMap(m map[string]interface{}) error
+	// AttributedToIRI returns the first value of the "attributedTo" property
+	// that is an IRI, and false if the property is not set or has no such
+	// value.
+	AttributedToIRI() (v *url.URL, ok bool)
+	// AttributedToType returns the first value of the "attributedTo" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	AttributedToType() (v Type, ok bool)
 	// GetActivityStreamsAttributedTo returns the "attributedTo" property if
 	// it exists, and nil otherwise.
 	GetActivityStreamsAttributedTo() ActivityStreamsAttributedToProperty
@@ -46,6 +65,13 @@ type ActivityStreamsMention interface {
 	// GetActivityStreamsWidth returns the "width" property if it exists, and
 	// nil otherwise.
 	GetActivityStreamsWidth() ActivityStreamsWidthProperty
+	// GetProperty returns the property with the name, such as "attributedTo",
+	// which is nil if it is not set. Returns false if this type has no
+	// such property. A property whose name is shared with another
+	// property of this type is named with its vocabulary as a prefix,
+	// such as "ActivityStreams:name". The value may be passed to
+	// SetProperty on another value of this type.
+	GetProperty(name string) (interface{}, bool)
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the Mention
@@ -56,6 +82,36 @@ type ActivityStreamsMention interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GoString returns the same form as String, so that printing this value
+	// with the %#v verb or in a debugger is readable instead of a dump of
+	// its members.
+	GoString() string
+	// HeightIRI returns the value of the "height" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	HeightIRI() (v *url.URL, ok bool)
+	// HeightNonNegativeInteger returns the value of the "height" property if
+	// it is of type "nonNegativeInteger", and false if the property is
+	// not set or has another value.
+	HeightNonNegativeInteger() (v int, ok bool)
+	// HrefAnyURI returns the value of the "href" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	HrefAnyURI() (v *url.URL, ok bool)
+	// HrefIRI returns the value of the "href" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	HrefIRI() (v *url.URL, ok bool)
+	// HreflangBcp47 returns the value of the "hreflang" property if it is of
+	// type "bcp47", and false if the property is not set or has another
+	// value.
+	HreflangBcp47() (v string, ok bool)
+	// HreflangIRI returns the value of the "hreflang" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	HreflangIRI() (v *url.URL, ok bool)
+	// IdAnyURI returns the value of the "id" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	IdAnyURI() (v *url.URL, ok bool)
+	// IdIRI returns the value of the "id" property if it is an IRI, and false
+	// if the property is not set or has another value.
+	IdIRI() (v *url.URL, ok bool)
 	// IsExtending returns true if the Mention type extends from the other
 	// type.
 	IsExtending(other Type) bool
@@ -66,6 +122,38 @@ type ActivityStreamsMention interface {
 	// LessThan computes if this Mention is lesser, with an arbitrary but
 	// stable determination.
 	LessThan(o ActivityStreamsMention) bool
+	// MediaTypeIRI returns the value of the "mediaType" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	MediaTypeIRI() (v *url.URL, ok bool)
+	// MediaTypeRfc2045 returns the value of the "mediaType" property if it is
+	// of type "rfc2045", and false if the property is not set or has
+	// another value.
+	MediaTypeRfc2045() (v string, ok bool)
+	// NameIRI returns the first value of the "name" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	NameIRI() (v *url.URL, ok bool)
+	// NameLangString returns the first value of the "name" property that is
+	// of type "langString", and false if the property is not set or has
+	// no such value.
+	NameLangString() (v map[string]string, ok bool)
+	// NameString returns the first value of the "name" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	NameString() (v string, ok bool)
+	// PreviewIRI returns the first value of the "preview" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	PreviewIRI() (v *url.URL, ok bool)
+	// PreviewType returns the first value of the "preview" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	PreviewType() (v Type, ok bool)
+	// RelIRI returns the first value of the "rel" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	RelIRI() (v *url.URL, ok bool)
+	// RelRfc5988 returns the first value of the "rel" property that is of
+	// type "rfc5988", and false if the property is not set or has no such
+	// value.
+	RelRfc5988() (v string, ok bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
@@ -93,6 +181,46 @@ type ActivityStreamsMention interface {
 	SetActivityStreamsType(i ActivityStreamsTypeProperty)
 	// SetActivityStreamsWidth sets the "width" property.
 	SetActivityStreamsWidth(i ActivityStreamsWidthProperty)
+	// SetProperty sets the property with the name, named as by GetProperty,
+	// to the value, which must be that property's interface. A nil value
+	// clears the property. Returns an error if this type has no such
+	// property or the value is of another type.
+	SetProperty(name string, v interface{}) error
+	// String returns a compact, human-readable form of this Mention for logs
+	// and debugging, such as Mention{id: https://example.com/1, name:
+	// "Example"}. It lists the id first and then each other property that
+	// is set, with long values shortened. It is not a serialization; use
+	// Serialize for that.
+	String() string
+	// SummaryIRI returns the first value of the "summary" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	SummaryIRI() (v *url.URL, ok bool)
+	// SummaryLangString returns the first value of the "summary" property
+	// that is of type "langString", and false if the property is not set
+	// or has no such value.
+	SummaryLangString() (v map[string]string, ok bool)
+	// SummaryString returns the first value of the "summary" property that is
+	// of type "string", and false if the property is not set or has no
+	// such value.
+	SummaryString() (v string, ok bool)
+	// TypeAnyURI returns the first value of the "type" property that is of
+	// type "anyURI", and false if the property is not set or has no such
+	// value.
+	TypeAnyURI() (v *url.URL, ok bool)
+	// TypeIRI returns the first value of the "type" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TypeIRI() (v *url.URL, ok bool)
+	// TypeString returns the first value of the "type" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	TypeString() (v string, ok bool)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
+	// WidthIRI returns the value of the "width" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	WidthIRI() (v *url.URL, ok bool)
+	// WidthNonNegativeInteger returns the value of the "width" property if it
+	// is of type "nonNegativeInteger", and false if the property is not
+	// set or has another value.
+	WidthNonNegativeInteger() (v int, ok bool)
 }
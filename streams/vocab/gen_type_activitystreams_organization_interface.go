@@ -1,5 +1,10 @@
 package vocab
 
+import (
+	"net/url"
+	"time"
+)
+
 // Represents an organization.
 //
 // Example 44 (https://www.w3.org/TR/activitystreams-vocabulary/#ex186-jsonld):
@@ -8,6 +13,146 @@ package vocab
 //     "type": "Organization"
 //   }
 type ActivityStreamsOrganization interface {
+	// AlsoKnownAsAnyURI returns the first value of the "alsoKnownAs" property
+	// that is of type "anyURI", and false if the property is not set or
+	// has no such value.
+	AlsoKnownAsAnyURI() (v *url.URL, ok bool)
+	// AlsoKnownAsIRI returns the first value of the "alsoKnownAs" property
+	// that is an IRI, and false if the property is not set or has no such
+	// value.
+	AlsoKnownAsIRI() (v *url.URL, ok bool)
+	// AltitudeFloat returns the value of the "altitude" property if it is of
+	// type "float", and false if the property is not set or has another
+	// value.
+	AltitudeFloat() (v float64, ok bool)
+	// AltitudeIRI returns the value of the "altitude" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	AltitudeIRI() (v *url.URL, ok bool)
+	// ApplyMap sets the properties named by the keys of the map, named as by
+	// GetProperty, to its values. A nil value clears the property. The
+	// values may be those of a map unmarshalled from JSON, or plain Go
+	// values such as strings, numbers, bools, time.Time, *url.URL, and
+	// slices of them. A string is also tried as a number or a bool if the
+	// property does not accept it as is, such as the values of a
+	// submitted form. Every value that can be applied is, and the
+	// returned error lists each key that could not be.
+	ApplyMap(m map[string]interface{}) error
+	// AttachmentIRI returns the first value of the "attachment" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	AttachmentIRI() (v *url.URL, ok bool)
+	// AttachmentType returns the first value of the "attachment" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	AttachmentType() (v Type, ok bool)
+	// AttributedToIRI returns the first value of the "attributedTo" property
+	// that is an IRI, and false if the property is not set or has no such
+	// value.
+	AttributedToIRI() (v *url.URL, ok bool)
+	// AttributedToType returns the first value of the "attributedTo" property
+	// that is an ActivityStreams type, and false if the property is not
+	// set or has no such value.
+	AttributedToType() (v Type, ok bool)
+	// AudienceIRI returns the first value of the "audience" property that is
+	// an IRI, and false if the property is not set or has no such value.
+	AudienceIRI() (v *url.URL, ok bool)
+	// AudienceType returns the first value of the "audience" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	AudienceType() (v Type, ok bool)
+	// BccIRI returns the first value of the "bcc" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	BccIRI() (v *url.URL, ok bool)
+	// BccType returns the first value of the "bcc" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	BccType() (v Type, ok bool)
+	// BtoIRI returns the first value of the "bto" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	BtoIRI() (v *url.URL, ok bool)
+	// BtoType returns the first value of the "bto" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	BtoType() (v Type, ok bool)
+	// CcIRI returns the first value of the "cc" property that is an IRI, and
+	// false if the property is not set or has no such value.
+	CcIRI() (v *url.URL, ok bool)
+	// CcType returns the first value of the "cc" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	CcType() (v Type, ok bool)
+	// ContentIRI returns the first value of the "content" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ContentIRI() (v *url.URL, ok bool)
+	// ContentLangString returns the first value of the "content" property
+	// that is of type "langString", and false if the property is not set
+	// or has no such value.
+	ContentLangString() (v map[string]string, ok bool)
+	// ContentString returns the first value of the "content" property that is
+	// of type "string", and false if the property is not set or has no
+	// such value.
+	ContentString() (v string, ok bool)
+	// ContextIRI returns the first value of the "context" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ContextIRI() (v *url.URL, ok bool)
+	// ContextType returns the first value of the "context" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	ContextType() (v Type, ok bool)
+	// DurationDuration returns the value of the "duration" property if it is
+	// of type "duration", and false if the property is not set or has
+	// another value.
+	DurationDuration() (v time.Duration, ok bool)
+	// DurationIRI returns the value of the "duration" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	DurationIRI() (v *url.URL, ok bool)
+	// EndTimeDateTime returns the value of the "endTime" property if it is of
+	// type "dateTime", and false if the property is not set or has
+	// another value.
+	EndTimeDateTime() (v time.Time, ok bool)
+	// EndTimeIRI returns the value of the "endTime" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	EndTimeIRI() (v *url.URL, ok bool)
+	// FeaturedIRI returns the value of the "featured" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	FeaturedIRI() (v *url.URL, ok bool)
+	// FeaturedTagsIRI returns the value of the "featuredTags" property if it
+	// is an IRI, and false if the property is not set or has another
+	// value.
+	FeaturedTagsIRI() (v *url.URL, ok bool)
+	// FeaturedTagsType returns the value of the "featuredTags" property if it
+	// is an ActivityStreams type, and false if the property is not set or
+	// has another value.
+	FeaturedTagsType() (v Type, ok bool)
+	// FeaturedType returns the value of the "featured" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	FeaturedType() (v Type, ok bool)
+	// FollowersIRI returns the value of the "followers" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	FollowersIRI() (v *url.URL, ok bool)
+	// FollowersType returns the value of the "followers" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	FollowersType() (v Type, ok bool)
+	// FollowingIRI returns the value of the "following" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	FollowingIRI() (v *url.URL, ok bool)
+	// FollowingType returns the value of the "following" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	FollowingType() (v Type, ok bool)
+	// GeneratorIRI returns the first value of the "generator" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	GeneratorIRI() (v *url.URL, ok bool)
+	// GeneratorType returns the first value of the "generator" property that
+	// is an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	GeneratorType() (v Type, ok bool)
+	// GetActivityStreamsAlsoKnownAs returns the "alsoKnownAs" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsAlsoKnownAs() ActivityStreamsAlsoKnownAsProperty
 	// GetActivityStreamsAltitude returns the "altitude" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsAltitude() ActivityStreamsAltitudeProperty
@@ -41,6 +186,12 @@ type ActivityStreamsOrganization interface {
 	// GetActivityStreamsEndTime returns the "endTime" property if it exists,
 	// and nil otherwise.
 	GetActivityStreamsEndTime() ActivityStreamsEndTimeProperty
+	// GetActivityStreamsFeatured returns the "featured" property if it
+	// exists, and nil otherwise.
+	GetActivityStreamsFeatured() ActivityStreamsFeaturedProperty
+	// GetActivityStreamsFeaturedTags returns the "featuredTags" property if
+	// it exists, and nil otherwise.
+	GetActivityStreamsFeaturedTags() ActivityStreamsFeaturedTagsProperty
 	// GetActivityStreamsFollowers returns the "followers" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsFollowers() ActivityStreamsFollowersProperty
@@ -74,6 +225,10 @@ type ActivityStreamsOrganization interface {
 	// GetActivityStreamsLocation returns the "location" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsLocation() ActivityStreamsLocationProperty
+	// GetActivityStreamsManuallyApprovesFollowers returns the
+	// "manuallyApprovesFollowers" property if it exists, and nil
+	// otherwise.
+	GetActivityStreamsManuallyApprovesFollowers() ActivityStreamsManuallyApprovesFollowersProperty
 	// GetActivityStreamsMediaType returns the "mediaType" property if it
 	// exists, and nil otherwise.
 	GetActivityStreamsMediaType() ActivityStreamsMediaTypeProperty
@@ -128,6 +283,13 @@ type ActivityStreamsOrganization interface {
 	// GetActivityStreamsUrl returns the "url" property if it exists, and nil
 	// otherwise.
 	GetActivityStreamsUrl() ActivityStreamsUrlProperty
+	// GetProperty returns the property with the name, such as "alsoKnownAs",
+	// which is nil if it is not set. Returns false if this type has no
+	// such property. A property whose name is shared with another
+	// property of this type is named with its vocabulary as a prefix,
+	// such as "ActivityStreams:name". The value may be passed to
+	// SetProperty on another value of this type.
+	GetProperty(name string) (interface{}, bool)
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the
@@ -138,6 +300,45 @@ type ActivityStreamsOrganization interface {
 	// their LessThan implementation, but routine ActivityPub applications
 	// should not use this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GoString returns the same form as String, so that printing this value
+	// with the %#v verb or in a debugger is readable instead of a dump of
+	// its members.
+	GoString() string
+	// IconIRI returns the first value of the "icon" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	IconIRI() (v *url.URL, ok bool)
+	// IconType returns the first value of the "icon" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	IconType() (v Type, ok bool)
+	// IdAnyURI returns the value of the "id" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	IdAnyURI() (v *url.URL, ok bool)
+	// IdIRI returns the value of the "id" property if it is an IRI, and false
+	// if the property is not set or has another value.
+	IdIRI() (v *url.URL, ok bool)
+	// ImageIRI returns the first value of the "image" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ImageIRI() (v *url.URL, ok bool)
+	// ImageType returns the first value of the "image" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ImageType() (v Type, ok bool)
+	// InReplyToIRI returns the first value of the "inReplyTo" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	InReplyToIRI() (v *url.URL, ok bool)
+	// InReplyToType returns the first value of the "inReplyTo" property that
+	// is an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	InReplyToType() (v Type, ok bool)
+	// InboxIRI returns the value of the "inbox" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	InboxIRI() (v *url.URL, ok bool)
+	// InboxType returns the value of the "inbox" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	InboxType() (v Type, ok bool)
 	// IsExtending returns true if the Organization type extends from the
 	// other type.
 	IsExtending(other Type) bool
@@ -148,9 +349,113 @@ type ActivityStreamsOrganization interface {
 	// LessThan computes if this Organization is lesser, with an arbitrary but
 	// stable determination.
 	LessThan(o ActivityStreamsOrganization) bool
+	// LikedIRI returns the value of the "liked" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	LikedIRI() (v *url.URL, ok bool)
+	// LikedType returns the value of the "liked" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	LikedType() (v Type, ok bool)
+	// LikesIRI returns the value of the "likes" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	LikesIRI() (v *url.URL, ok bool)
+	// LikesType returns the value of the "likes" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	LikesType() (v Type, ok bool)
+	// LocationIRI returns the first value of the "location" property that is
+	// an IRI, and false if the property is not set or has no such value.
+	LocationIRI() (v *url.URL, ok bool)
+	// LocationType returns the first value of the "location" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	LocationType() (v Type, ok bool)
+	// ManuallyApprovesFollowersBoolean returns the value of the
+	// "manuallyApprovesFollowers" property if it is of type "boolean",
+	// and false if the property is not set or has another value.
+	ManuallyApprovesFollowersBoolean() (v bool, ok bool)
+	// ManuallyApprovesFollowersIRI returns the value of the
+	// "manuallyApprovesFollowers" property if it is an IRI, and false if
+	// the property is not set or has another value.
+	ManuallyApprovesFollowersIRI() (v *url.URL, ok bool)
+	// MediaTypeIRI returns the value of the "mediaType" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	MediaTypeIRI() (v *url.URL, ok bool)
+	// MediaTypeRfc2045 returns the value of the "mediaType" property if it is
+	// of type "rfc2045", and false if the property is not set or has
+	// another value.
+	MediaTypeRfc2045() (v string, ok bool)
+	// NameIRI returns the first value of the "name" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	NameIRI() (v *url.URL, ok bool)
+	// NameLangString returns the first value of the "name" property that is
+	// of type "langString", and false if the property is not set or has
+	// no such value.
+	NameLangString() (v map[string]string, ok bool)
+	// NameString returns the first value of the "name" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	NameString() (v string, ok bool)
+	// ObjectIRI returns the first value of the "object" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	ObjectIRI() (v *url.URL, ok bool)
+	// ObjectType returns the first value of the "object" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ObjectType() (v Type, ok bool)
+	// OutboxIRI returns the value of the "outbox" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	OutboxIRI() (v *url.URL, ok bool)
+	// OutboxType returns the value of the "outbox" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	OutboxType() (v Type, ok bool)
+	// PreferredUsernameIRI returns the value of the "preferredUsername"
+	// property if it is an IRI, and false if the property is not set or
+	// has another value.
+	PreferredUsernameIRI() (v *url.URL, ok bool)
+	// PreferredUsernameLangString returns the value of the
+	// "preferredUsername" property if it is of type "langString", and
+	// false if the property is not set or has another value.
+	PreferredUsernameLangString() (v map[string]string, ok bool)
+	// PreferredUsernameString returns the value of the "preferredUsername"
+	// property if it is of type "string", and false if the property is
+	// not set or has another value.
+	PreferredUsernameString() (v string, ok bool)
+	// PreviewIRI returns the first value of the "preview" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	PreviewIRI() (v *url.URL, ok bool)
+	// PreviewType returns the first value of the "preview" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	PreviewType() (v Type, ok bool)
+	// PublicKeyIRI returns the first value of the "publicKey" property that
+	// is an IRI, and false if the property is not set or has no such
+	// value.
+	PublicKeyIRI() (v *url.URL, ok bool)
+	// PublicKeyType returns the first value of the "publicKey" property that
+	// is an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	PublicKeyType() (v Type, ok bool)
+	// PublishedDateTime returns the value of the "published" property if it
+	// is of type "dateTime", and false if the property is not set or has
+	// another value.
+	PublishedDateTime() (v time.Time, ok bool)
+	// PublishedIRI returns the value of the "published" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	PublishedIRI() (v *url.URL, ok bool)
+	// RepliesIRI returns the value of the "replies" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	RepliesIRI() (v *url.URL, ok bool)
+	// RepliesType returns the value of the "replies" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	RepliesType() (v Type, ok bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
+	// SetActivityStreamsAlsoKnownAs sets the "alsoKnownAs" property.
+	SetActivityStreamsAlsoKnownAs(i ActivityStreamsAlsoKnownAsProperty)
 	// SetActivityStreamsAltitude sets the "altitude" property.
 	SetActivityStreamsAltitude(i ActivityStreamsAltitudeProperty)
 	// SetActivityStreamsAttachment sets the "attachment" property.
@@ -173,6 +478,10 @@ type ActivityStreamsOrganization interface {
 	SetActivityStreamsDuration(i ActivityStreamsDurationProperty)
 	// SetActivityStreamsEndTime sets the "endTime" property.
 	SetActivityStreamsEndTime(i ActivityStreamsEndTimeProperty)
+	// SetActivityStreamsFeatured sets the "featured" property.
+	SetActivityStreamsFeatured(i ActivityStreamsFeaturedProperty)
+	// SetActivityStreamsFeaturedTags sets the "featuredTags" property.
+	SetActivityStreamsFeaturedTags(i ActivityStreamsFeaturedTagsProperty)
 	// SetActivityStreamsFollowers sets the "followers" property.
 	SetActivityStreamsFollowers(i ActivityStreamsFollowersProperty)
 	// SetActivityStreamsFollowing sets the "following" property.
@@ -195,6 +504,9 @@ type ActivityStreamsOrganization interface {
 	SetActivityStreamsLikes(i ActivityStreamsLikesProperty)
 	// SetActivityStreamsLocation sets the "location" property.
 	SetActivityStreamsLocation(i ActivityStreamsLocationProperty)
+	// SetActivityStreamsManuallyApprovesFollowers sets the
+	// "manuallyApprovesFollowers" property.
+	SetActivityStreamsManuallyApprovesFollowers(i ActivityStreamsManuallyApprovesFollowersProperty)
 	// SetActivityStreamsMediaType sets the "mediaType" property.
 	SetActivityStreamsMediaType(i ActivityStreamsMediaTypeProperty)
 	// SetActivityStreamsName sets the "name" property.
@@ -232,6 +544,91 @@ type ActivityStreamsOrganization interface {
 	SetActivityStreamsUpdated(i ActivityStreamsUpdatedProperty)
 	// SetActivityStreamsUrl sets the "url" property.
 	SetActivityStreamsUrl(i ActivityStreamsUrlProperty)
+	// SetProperty sets the property with the name, named as by GetProperty,
+	// to the value, which must be that property's interface. A nil value
+	// clears the property. Returns an error if this type has no such
+	// property or the value is of another type.
+	SetProperty(name string, v interface{}) error
+	// SharesIRI returns the value of the "shares" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	SharesIRI() (v *url.URL, ok bool)
+	// SharesType returns the value of the "shares" property if it is an
+	// ActivityStreams type, and false if the property is not set or has
+	// another value.
+	SharesType() (v Type, ok bool)
+	// StartTimeDateTime returns the value of the "startTime" property if it
+	// is of type "dateTime", and false if the property is not set or has
+	// another value.
+	StartTimeDateTime() (v time.Time, ok bool)
+	// StartTimeIRI returns the value of the "startTime" property if it is an
+	// IRI, and false if the property is not set or has another value.
+	StartTimeIRI() (v *url.URL, ok bool)
+	// StreamsIRI returns the first value of the "streams" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	StreamsIRI() (v *url.URL, ok bool)
+	// StreamsType returns the first value of the "streams" property that is
+	// an ActivityStreams type, and false if the property is not set or
+	// has no such value.
+	StreamsType() (v Type, ok bool)
+	// String returns a compact, human-readable form of this Organization for
+	// logs and debugging, such as Organization{id: https://example.com/1,
+	// name: "Example"}. It lists the id first and then each other
+	// property that is set, with long values shortened. It is not a
+	// serialization; use Serialize for that.
+	String() string
+	// SummaryIRI returns the first value of the "summary" property that is an
+	// IRI, and false if the property is not set or has no such value.
+	SummaryIRI() (v *url.URL, ok bool)
+	// SummaryLangString returns the first value of the "summary" property
+	// that is of type "langString", and false if the property is not set
+	// or has no such value.
+	SummaryLangString() (v map[string]string, ok bool)
+	// SummaryString returns the first value of the "summary" property that is
+	// of type "string", and false if the property is not set or has no
+	// such value.
+	SummaryString() (v string, ok bool)
+	// TagIRI returns the first value of the "tag" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TagIRI() (v *url.URL, ok bool)
+	// TagType returns the first value of the "tag" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	TagType() (v Type, ok bool)
+	// ToIRI returns the first value of the "to" property that is an IRI, and
+	// false if the property is not set or has no such value.
+	ToIRI() (v *url.URL, ok bool)
+	// ToType returns the first value of the "to" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	ToType() (v Type, ok bool)
+	// TypeAnyURI returns the first value of the "type" property that is of
+	// type "anyURI", and false if the property is not set or has no such
+	// value.
+	TypeAnyURI() (v *url.URL, ok bool)
+	// TypeIRI returns the first value of the "type" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TypeIRI() (v *url.URL, ok bool)
+	// TypeString returns the first value of the "type" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	TypeString() (v string, ok bool)
+	// UpdatedDateTime returns the value of the "updated" property if it is of
+	// type "dateTime", and false if the property is not set or has
+	// another value.
+	UpdatedDateTime() (v time.Time, ok bool)
+	// UpdatedIRI returns the value of the "updated" property if it is an IRI,
+	// and false if the property is not set or has another value.
+	UpdatedIRI() (v *url.URL, ok bool)
+	// UrlAnyURI returns the first value of the "url" property that is of type
+	// "anyURI", and false if the property is not set or has no such value.
+	UrlAnyURI() (v *url.URL, ok bool)
+	// UrlIRI returns the first value of the "url" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	UrlIRI() (v *url.URL, ok bool)
+	// UrlType returns the first value of the "url" property that is an
+	// ActivityStreams type, and false if the property is not set or has
+	// no such value.
+	UrlType() (v Type, ok bool)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
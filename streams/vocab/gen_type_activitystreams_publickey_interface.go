@@ -1,7 +1,18 @@
 package vocab
 
+import "net/url"
+
 // A public key represents a public cryptographical key for a user
 type ActivityStreamsPublicKey interface {
+	// ApplyMap sets the properties named by the keys of the map, named as by
+	// GetProperty, to its values. A nil value clears the property. The
+	// values may be those of a map unmarshalled from JSON, or plain Go
+	// values such as strings, numbers, bools, time.Time, *url.URL, and
+	// slices of them. A string is also tried as a number or a bool if the
+	// property does not accept it as is, such as the values of a
+	// submitted form. Every value that can be applied is, and the
+	// returned error lists each key that could not be.
+	ApplyMap(m map[string]interface{}) error
 	// GetActivityStreamsId returns the "id" property if it exists, and nil
 	// otherwise.
 	GetActivityStreamsId() ActivityStreamsIdProperty
@@ -14,6 +25,13 @@ type ActivityStreamsPublicKey interface {
 	// GetActivityStreamsType returns the "type" property if it exists, and
 	// nil otherwise.
 	GetActivityStreamsType() ActivityStreamsTypeProperty
+	// GetProperty returns the property with the name, such as "id", which is
+	// nil if it is not set. Returns false if this type has no such
+	// property. A property whose name is shared with another property of
+	// this type is named with its vocabulary as a prefix, such as
+	// "ActivityStreams:name". The value may be passed to SetProperty on
+	// another value of this type.
+	GetProperty(name string) (interface{}, bool)
 	// GetTypeName returns the name of this type.
 	GetTypeName() string
 	// GetUnknownProperties returns the unknown properties for the PublicKey
@@ -24,6 +42,16 @@ type ActivityStreamsPublicKey interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GoString returns the same form as String, so that printing this value
+	// with the %#v verb or in a debugger is readable instead of a dump of
+	// its members.
+	GoString() string
+	// IdAnyURI returns the value of the "id" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	IdAnyURI() (v *url.URL, ok bool)
+	// IdIRI returns the value of the "id" property if it is an IRI, and false
+	// if the property is not set or has another value.
+	IdIRI() (v *url.URL, ok bool)
 	// IsExtending returns true if the PublicKey type extends from the other
 	// type.
 	IsExtending(other Type) bool
@@ -34,6 +62,20 @@ type ActivityStreamsPublicKey interface {
 	// LessThan computes if this PublicKey is lesser, with an arbitrary but
 	// stable determination.
 	LessThan(o ActivityStreamsPublicKey) bool
+	// OwnerAnyURI returns the value of the "owner" property if it is of type
+	// "anyURI", and false if the property is not set or has another value.
+	OwnerAnyURI() (v *url.URL, ok bool)
+	// OwnerIRI returns the value of the "owner" property if it is an IRI, and
+	// false if the property is not set or has another value.
+	OwnerIRI() (v *url.URL, ok bool)
+	// PublicKeyPemIRI returns the value of the "publicKeyPem" property if it
+	// is an IRI, and false if the property is not set or has another
+	// value.
+	PublicKeyPemIRI() (v *url.URL, ok bool)
+	// PublicKeyPemString returns the value of the "publicKeyPem" property if
+	// it is of type "string", and false if the property is not set or has
+	// another value.
+	PublicKeyPemString() (v string, ok bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
@@ -45,6 +87,28 @@ type ActivityStreamsPublicKey interface {
 	SetActivityStreamsPublicKeyPem(i ActivityStreamsPublicKeyPemProperty)
 	// SetActivityStreamsType sets the "type" property.
 	SetActivityStreamsType(i ActivityStreamsTypeProperty)
+	// SetProperty sets the property with the name, named as by GetProperty,
+	// to the value, which must be that property's interface. A nil value
+	// clears the property. Returns an error if this type has no such
+	// property or the value is of another type.
+	SetProperty(name string, v interface{}) error
+	// String returns a compact, human-readable form of this PublicKey for
+	// logs and debugging, such as PublicKey{id: https://example.com/1,
+	// name: "Example"}. It lists the id first and then each other
+	// property that is set, with long values shortened. It is not a
+	// serialization; use Serialize for that.
+	String() string
+	// TypeAnyURI returns the first value of the "type" property that is of
+	// type "anyURI", and false if the property is not set or has no such
+	// value.
+	TypeAnyURI() (v *url.URL, ok bool)
+	// TypeIRI returns the first value of the "type" property that is an IRI,
+	// and false if the property is not set or has no such value.
+	TypeIRI() (v *url.URL, ok bool)
+	// TypeString returns the first value of the "type" property that is of
+	// type "string", and false if the property is not set or has no such
+	// value.
+	TypeString() (v string, ok bool)
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
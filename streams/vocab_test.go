@@ -1319,6 +1319,15 @@ func TestDeserialization(t *testing.T) {
 			t.Errorf("%s: Cannot Deserialize: %s", r.name, err)
 			continue
 		}
+		// Nested values are deserialized when first accessed, so serialize
+		// both to resolve them in the same manner before comparing.
+		if _, err := actual.Serialize(); err != nil {
+			t.Errorf("%s: Cannot Serialize: %s", r.name, err)
+			continue
+		} else if _, err := r.expectedStruct.Serialize(); err != nil {
+			t.Errorf("%s: Cannot Serialize expected struct: %s", r.name, err)
+			continue
+		}
 		if diff := reflect.DeepEqual(actual, r.expectedStruct); !diff {
 			if r.unknown != nil {
 				t.Logf("%s: Got expected difference due to unknown additions", r.name)